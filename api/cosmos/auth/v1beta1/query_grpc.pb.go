@@ -29,6 +29,8 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_AccountByPubKey_FullMethodName      = "/cosmos.auth.v1beta1.Query/AccountByPubKey"
+	Query_FeeSplit_FullMethodName             = "/cosmos.auth.v1beta1.Query/FeeSplit"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +74,13 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts whose public key is the given public
+	// key. Several accounts can share a public key, e.g. after a public key
+	// change.
+	AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error)
+	// FeeSplit returns the weights by which the fees of txs are split between
+	// destinations.
+	FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +181,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error) {
+	out := new(QueryAccountByPubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_AccountByPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error) {
+	out := new(QueryFeeSplitResponse)
+	err := c.cc.Invoke(ctx, Query_FeeSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +240,13 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts whose public key is the given public
+	// key. Several accounts can share a public key, e.g. after a public key
+	// change.
+	AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error)
+	// FeeSplit returns the weights by which the fees of txs are split between
+	// destinations.
+	FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +284,12 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByPubKey not implemented")
+}
+func (UnimplementedQueryServer) FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +483,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountByPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountByPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountByPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountByPubKey(ctx, req.(*QueryAccountByPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplit(ctx, req.(*QueryFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +566,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountByPubKey",
+			Handler:    _Query_AccountByPubKey_Handler,
+		},
+		{
+			MethodName: "FeeSplit",
+			Handler:    _Query_FeeSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_ChangePubKey_FullMethodName       = "/cosmos.auth.v1beta1.Msg/ChangePubKey"
+	Msg_CancelPubKeyChange_FullMethodName = "/cosmos.auth.v1beta1.Msg/CancelPubKeyChange"
+	Msg_SetFeeSplit_FullMethodName        = "/cosmos.auth.v1beta1.Msg/SetFeeSplit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +34,15 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ChangePubKey defines a method for rotating the public key of an account.
+	// It must be signed by the current public key of the account.
+	ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error)
+	// CancelPubKeyChange defines a method for cancelling the pending public key
+	// change of an account.
+	CancelPubKeyChange(ctx context.Context, in *MsgCancelPubKeyChange, opts ...grpc.CallOption) (*MsgCancelPubKeyChangeResponse, error)
+	// SetFeeSplit defines a governance operation for replacing the weights by
+	// which the fees of txs are split between destinations.
+	SetFeeSplit(ctx context.Context, in *MsgSetFeeSplit, opts ...grpc.CallOption) (*MsgSetFeeSplitResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +62,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error) {
+	out := new(MsgChangePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangePubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPubKeyChange(ctx context.Context, in *MsgCancelPubKeyChange, opts ...grpc.CallOption) (*MsgCancelPubKeyChangeResponse, error) {
+	out := new(MsgCancelPubKeyChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPubKeyChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSplit(ctx context.Context, in *MsgSetFeeSplit, opts ...grpc.CallOption) (*MsgSetFeeSplitResponse, error) {
+	out := new(MsgSetFeeSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +98,15 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ChangePubKey defines a method for rotating the public key of an account.
+	// It must be signed by the current public key of the account.
+	ChangePubKey(context.Context, *MsgChangePubKey) (*MsgChangePubKeyResponse, error)
+	// CancelPubKeyChange defines a method for cancelling the pending public key
+	// change of an account.
+	CancelPubKeyChange(context.Context, *MsgCancelPubKeyChange) (*MsgCancelPubKeyChangeResponse, error)
+	// SetFeeSplit defines a governance operation for replacing the weights by
+	// which the fees of txs are split between destinations.
+	SetFeeSplit(context.Context, *MsgSetFeeSplit) (*MsgSetFeeSplitResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +117,15 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ChangePubKey(context.Context, *MsgChangePubKey) (*MsgChangePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePubKey not implemented")
+}
+func (UnimplementedMsgServer) CancelPubKeyChange(context.Context, *MsgCancelPubKeyChange) (*MsgCancelPubKeyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPubKeyChange not implemented")
+}
+func (UnimplementedMsgServer) SetFeeSplit(context.Context, *MsgSetFeeSplit) (*MsgSetFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSplit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +157,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChangePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangePubKey(ctx, req.(*MsgChangePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPubKeyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPubKeyChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPubKeyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPubKeyChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPubKeyChange(ctx, req.(*MsgCancelPubKeyChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSplit(ctx, req.(*MsgSetFeeSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +222,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ChangePubKey",
+			Handler:    _Msg_ChangePubKey_Handler,
+		},
+		{
+			MethodName: "CancelPubKeyChange",
+			Handler:    _Msg_CancelPubKeyChange_Handler,
+		},
+		{
+			MethodName: "SetFeeSplit",
+			Handler:    _Msg_SetFeeSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
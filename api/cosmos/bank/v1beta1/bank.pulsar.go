@@ -68,10 +68,111 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*DustThreshold
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DustThreshold)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DustThreshold)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(DustThreshold)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(DustThreshold)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]string
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field DustExemptions as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_send_enabled         protoreflect.FieldDescriptor
-	fd_Params_default_send_enabled protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_send_enabled           protoreflect.FieldDescriptor
+	fd_Params_default_send_enabled   protoreflect.FieldDescriptor
+	fd_Params_dust_thresholds        protoreflect.FieldDescriptor
+	fd_Params_dust_reap_limit        protoreflect.FieldDescriptor
+	fd_Params_dust_exemptions        protoreflect.FieldDescriptor
+	fd_Params_defer_account_creation protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +180,10 @@ func init() {
 	md_Params = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Params")
 	fd_Params_send_enabled = md_Params.Fields().ByName("send_enabled")
 	fd_Params_default_send_enabled = md_Params.Fields().ByName("default_send_enabled")
+	fd_Params_dust_thresholds = md_Params.Fields().ByName("dust_thresholds")
+	fd_Params_dust_reap_limit = md_Params.Fields().ByName("dust_reap_limit")
+	fd_Params_dust_exemptions = md_Params.Fields().ByName("dust_exemptions")
+	fd_Params_defer_account_creation = md_Params.Fields().ByName("defer_account_creation")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -158,6 +263,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.DustThresholds) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.DustThresholds})
+		if !f(fd_Params_dust_thresholds, value) {
+			return
+		}
+	}
+	if x.DustReapLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DustReapLimit)
+		if !f(fd_Params_dust_reap_limit, value) {
+			return
+		}
+	}
+	if len(x.DustExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.DustExemptions})
+		if !f(fd_Params_dust_exemptions, value) {
+			return
+		}
+	}
+	if x.DeferAccountCreation != false {
+		value := protoreflect.ValueOfBool(x.DeferAccountCreation)
+		if !f(fd_Params_defer_account_creation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -177,6 +306,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SendEnabled) != 0
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		return x.DefaultSendEnabled != false
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		return len(x.DustThresholds) != 0
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		return x.DustReapLimit != uint64(0)
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		return len(x.DustExemptions) != 0
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		return x.DeferAccountCreation != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -197,6 +334,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SendEnabled = nil
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		x.DefaultSendEnabled = false
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		x.DustThresholds = nil
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		x.DustReapLimit = uint64(0)
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		x.DustExemptions = nil
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		x.DeferAccountCreation = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -222,6 +367,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		value := x.DefaultSendEnabled
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		if len(x.DustThresholds) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.DustThresholds}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		value := x.DustReapLimit
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		if len(x.DustExemptions) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.DustExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		value := x.DeferAccountCreation
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -248,6 +411,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SendEnabled = *clv.list
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		x.DefaultSendEnabled = value.Bool()
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.DustThresholds = *clv.list
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		x.DustReapLimit = value.Uint()
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.DustExemptions = *clv.list
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		x.DeferAccountCreation = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -274,8 +449,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.SendEnabled}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		if x.DustThresholds == nil {
+			x.DustThresholds = []*DustThreshold{}
+		}
+		value := &_Params_3_list{list: &x.DustThresholds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		if x.DustExemptions == nil {
+			x.DustExemptions = []string{}
+		}
+		value := &_Params_5_list{list: &x.DustExemptions}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		panic(fmt.Errorf("field default_send_enabled of message cosmos.bank.v1beta1.Params is not mutable"))
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		panic(fmt.Errorf("field dust_reap_limit of message cosmos.bank.v1beta1.Params is not mutable"))
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		panic(fmt.Errorf("field defer_account_creation of message cosmos.bank.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -294,6 +485,16 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.bank.v1beta1.Params.dust_thresholds":
+		list := []*DustThreshold{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "cosmos.bank.v1beta1.Params.dust_reap_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.Params.dust_exemptions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "cosmos.bank.v1beta1.Params.defer_account_creation":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -372,6 +573,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DefaultSendEnabled {
 			n += 2
 		}
+		if len(x.DustThresholds) > 0 {
+			for _, e := range x.DustThresholds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DustReapLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.DustReapLimit))
+		}
+		if len(x.DustExemptions) > 0 {
+			for _, s := range x.DustExemptions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DeferAccountCreation {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -401,6 +620,46 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DeferAccountCreation {
+			i--
+			if x.DeferAccountCreation {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.DustExemptions) > 0 {
+			for iNdEx := len(x.DustExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DustExemptions[iNdEx])
+				copy(dAtA[i:], x.DustExemptions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustExemptions[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.DustReapLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DustReapLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.DustThresholds) > 0 {
+			for iNdEx := len(x.DustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DustThresholds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if x.DefaultSendEnabled {
 			i--
 			if x.DefaultSendEnabled {
@@ -530,6 +789,111 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DefaultSendEnabled = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustThresholds = append(x.DustThresholds, &DustThreshold{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DustThresholds[len(x.DustThresholds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustReapLimit", wireType)
+				}
+				x.DustReapLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DustReapLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustExemptions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustExemptions = append(x.DustExemptions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeferAccountCreation", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DeferAccountCreation = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -566,27 +930,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SendEnabled         protoreflect.MessageDescriptor
-	fd_SendEnabled_denom   protoreflect.FieldDescriptor
-	fd_SendEnabled_enabled protoreflect.FieldDescriptor
+	md_DustThreshold           protoreflect.MessageDescriptor
+	fd_DustThreshold_denom     protoreflect.FieldDescriptor
+	fd_DustThreshold_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_SendEnabled = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SendEnabled")
-	fd_SendEnabled_denom = md_SendEnabled.Fields().ByName("denom")
-	fd_SendEnabled_enabled = md_SendEnabled.Fields().ByName("enabled")
+	md_DustThreshold = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DustThreshold")
+	fd_DustThreshold_denom = md_DustThreshold.Fields().ByName("denom")
+	fd_DustThreshold_threshold = md_DustThreshold.Fields().ByName("threshold")
 }
 
-var _ protoreflect.Message = (*fastReflection_SendEnabled)(nil)
+var _ protoreflect.Message = (*fastReflection_DustThreshold)(nil)
 
-type fastReflection_SendEnabled SendEnabled
+type fastReflection_DustThreshold DustThreshold
 
-func (x *SendEnabled) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SendEnabled)(x)
+func (x *DustThreshold) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DustThreshold)(x)
 }
 
-func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
+func (x *DustThreshold) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -598,43 +962,43 @@ func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SendEnabled_messageType fastReflection_SendEnabled_messageType
-var _ protoreflect.MessageType = fastReflection_SendEnabled_messageType{}
+var _fastReflection_DustThreshold_messageType fastReflection_DustThreshold_messageType
+var _ protoreflect.MessageType = fastReflection_DustThreshold_messageType{}
 
-type fastReflection_SendEnabled_messageType struct{}
+type fastReflection_DustThreshold_messageType struct{}
 
-func (x fastReflection_SendEnabled_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SendEnabled)(nil)
+func (x fastReflection_DustThreshold_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DustThreshold)(nil)
 }
-func (x fastReflection_SendEnabled_messageType) New() protoreflect.Message {
-	return new(fastReflection_SendEnabled)
+func (x fastReflection_DustThreshold_messageType) New() protoreflect.Message {
+	return new(fastReflection_DustThreshold)
 }
-func (x fastReflection_SendEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendEnabled
+func (x fastReflection_DustThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DustThreshold
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SendEnabled) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendEnabled
+func (x *fastReflection_DustThreshold) Descriptor() protoreflect.MessageDescriptor {
+	return md_DustThreshold
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SendEnabled) Type() protoreflect.MessageType {
-	return _fastReflection_SendEnabled_messageType
+func (x *fastReflection_DustThreshold) Type() protoreflect.MessageType {
+	return _fastReflection_DustThreshold_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SendEnabled) New() protoreflect.Message {
-	return new(fastReflection_SendEnabled)
+func (x *fastReflection_DustThreshold) New() protoreflect.Message {
+	return new(fastReflection_DustThreshold)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
-	return (*SendEnabled)(x)
+func (x *fastReflection_DustThreshold) Interface() protoreflect.ProtoMessage {
+	return (*DustThreshold)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -642,16 +1006,16 @@ func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_DustThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_SendEnabled_denom, value) {
+		if !f(fd_DustThreshold_denom, value) {
 			return
 		}
 	}
-	if x.Enabled != false {
-		value := protoreflect.ValueOfBool(x.Enabled)
-		if !f(fd_SendEnabled_enabled, value) {
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_DustThreshold_threshold, value) {
 			return
 		}
 	}
@@ -668,17 +1032,17 @@ func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DustThreshold) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
 		return x.Denom != ""
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		return x.Enabled != false
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
+		return x.Threshold != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -688,17 +1052,17 @@ func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DustThreshold) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
 		x.Denom = ""
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		x.Enabled = false
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
+		x.Threshold = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -708,19 +1072,19 @@ func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		value := x.Enabled
-		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -734,17 +1098,17 @@ func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DustThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
 		x.Denom = value.Interface().(string)
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		x.Enabled = value.Bool()
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
+		x.Threshold = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -758,44 +1122,44 @@ func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		panic(fmt.Errorf("field enabled of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DustThreshold is not mutable"))
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.bank.v1beta1.DustThreshold is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SendEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
+	case "cosmos.bank.v1beta1.DustThreshold.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DustThreshold.threshold":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustThreshold"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustThreshold does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DustThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SendEnabled", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DustThreshold", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -803,7 +1167,7 @@ func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DustThreshold) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -814,7 +1178,7 @@ func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DustThreshold) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -826,7 +1190,7 @@ func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SendEnabled) IsValid() bool {
+func (x *fastReflection_DustThreshold) IsValid() bool {
 	return x != nil
 }
 
@@ -836,9 +1200,9 @@ func (x *fastReflection_SendEnabled) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DustThreshold) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustThreshold)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -854,8 +1218,9 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Enabled {
-			n += 2
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -867,7 +1232,7 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustThreshold)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -886,15 +1251,12 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Enabled {
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
 			i--
-			if x.Enabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if len(x.Denom) > 0 {
 			i -= len(x.Denom)
@@ -914,7 +1276,7 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustThreshold)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -946,10 +1308,10 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DustThreshold: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DustThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -985,10 +1347,10 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -998,12 +1360,24 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Enabled = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1039,79 +1413,28 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Input_2_list)(nil)
-
-type _Input_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Input_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Input_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Input_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Input_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Input_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Input_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Input_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Input_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Input         protoreflect.MessageDescriptor
-	fd_Input_address protoreflect.FieldDescriptor
-	fd_Input_coins   protoreflect.FieldDescriptor
+	md_SendEnabled         protoreflect.MessageDescriptor
+	fd_SendEnabled_denom   protoreflect.FieldDescriptor
+	fd_SendEnabled_enabled protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Input = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Input")
-	fd_Input_address = md_Input.Fields().ByName("address")
-	fd_Input_coins = md_Input.Fields().ByName("coins")
+	md_SendEnabled = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SendEnabled")
+	fd_SendEnabled_denom = md_SendEnabled.Fields().ByName("denom")
+	fd_SendEnabled_enabled = md_SendEnabled.Fields().ByName("enabled")
 }
 
-var _ protoreflect.Message = (*fastReflection_Input)(nil)
+var _ protoreflect.Message = (*fastReflection_SendEnabled)(nil)
 
-type fastReflection_Input Input
+type fastReflection_SendEnabled SendEnabled
 
-func (x *Input) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Input)(x)
+func (x *SendEnabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SendEnabled)(x)
 }
 
-func (x *Input) slowProtoReflect() protoreflect.Message {
+func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1123,43 +1446,43 @@ func (x *Input) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Input_messageType fastReflection_Input_messageType
-var _ protoreflect.MessageType = fastReflection_Input_messageType{}
+var _fastReflection_SendEnabled_messageType fastReflection_SendEnabled_messageType
+var _ protoreflect.MessageType = fastReflection_SendEnabled_messageType{}
 
-type fastReflection_Input_messageType struct{}
+type fastReflection_SendEnabled_messageType struct{}
 
-func (x fastReflection_Input_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Input)(nil)
+func (x fastReflection_SendEnabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SendEnabled)(nil)
 }
-func (x fastReflection_Input_messageType) New() protoreflect.Message {
-	return new(fastReflection_Input)
+func (x fastReflection_SendEnabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_SendEnabled)
 }
-func (x fastReflection_Input_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Input
+func (x fastReflection_SendEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendEnabled
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Input) Descriptor() protoreflect.MessageDescriptor {
-	return md_Input
+func (x *fastReflection_SendEnabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendEnabled
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Input) Type() protoreflect.MessageType {
-	return _fastReflection_Input_messageType
+func (x *fastReflection_SendEnabled) Type() protoreflect.MessageType {
+	return _fastReflection_SendEnabled_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Input) New() protoreflect.Message {
-	return new(fastReflection_Input)
+func (x *fastReflection_SendEnabled) New() protoreflect.Message {
+	return new(fastReflection_SendEnabled)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
-	return (*Input)(x)
+func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
+	return (*SendEnabled)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1167,16 +1490,16 @@ func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Input_address, value) {
+func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_SendEnabled_denom, value) {
 			return
 		}
 	}
-	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_Input_2_list{list: &x.Coins})
-		if !f(fd_Input_coins, value) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_SendEnabled_enabled, value) {
 			return
 		}
 	}
@@ -1193,17 +1516,17 @@ func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		return x.Address != ""
-	case "cosmos.bank.v1beta1.Input.coins":
-		return len(x.Coins) != 0
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		return x.Enabled != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1213,17 +1536,17 @@ func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		x.Address = ""
-	case "cosmos.bank.v1beta1.Input.coins":
-		x.Coins = nil
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		x.Enabled = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1233,22 +1556,19 @@ func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		value := x.Address
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Input.coins":
-		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_Input_2_list{})
-		}
-		listValue := &_Input_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1262,19 +1582,17 @@ func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Input.coins":
-		lv := value.List()
-		clv := lv.(*_Input_2_list)
-		x.Coins = *clv.list
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		x.Enabled = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1288,49 +1606,44 @@ func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.coins":
-		if x.Coins == nil {
-			x.Coins = []*v1beta1.Coin{}
-		}
-		value := &_Input_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Input.address":
-		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Input is not mutable"))
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		panic(fmt.Errorf("field enabled of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Input) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Input.coins":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Input_2_list{list: &list})
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Input", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SendEnabled", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1338,7 +1651,7 @@ func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1349,7 +1662,7 @@ func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1361,7 +1674,7 @@ func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Input) IsValid() bool {
+func (x *fastReflection_SendEnabled) IsValid() bool {
 	return x != nil
 }
 
@@ -1371,9 +1684,9 @@ func (x *fastReflection_Input) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1385,15 +1698,12 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Coins) > 0 {
-			for _, e := range x.Coins {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Enabled {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1405,7 +1715,7 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1424,26 +1734,20 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Coins) > 0 {
-			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Coins[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1458,7 +1762,7 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1490,15 +1794,15 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1526,13 +1830,13 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1542,26 +1846,12 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Coins = append(x.Coins, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
+				x.Enabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1597,79 +1887,79 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Output_2_list)(nil)
+var _ protoreflect.List = (*_Input_2_list)(nil)
 
-type _Output_2_list struct {
+type _Input_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Output_2_list) Len() int {
+func (x *_Input_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Output_2_list) Get(i int) protoreflect.Value {
+func (x *_Input_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Output_2_list) Set(i int, value protoreflect.Value) {
+func (x *_Input_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Output_2_list) Append(value protoreflect.Value) {
+func (x *_Input_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Output_2_list) AppendMutable() protoreflect.Value {
+func (x *_Input_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Output_2_list) Truncate(n int) {
+func (x *_Input_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Output_2_list) NewElement() protoreflect.Value {
+func (x *_Input_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Output_2_list) IsValid() bool {
+func (x *_Input_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Output         protoreflect.MessageDescriptor
-	fd_Output_address protoreflect.FieldDescriptor
-	fd_Output_coins   protoreflect.FieldDescriptor
+	md_Input         protoreflect.MessageDescriptor
+	fd_Input_address protoreflect.FieldDescriptor
+	fd_Input_coins   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Output = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Output")
-	fd_Output_address = md_Output.Fields().ByName("address")
-	fd_Output_coins = md_Output.Fields().ByName("coins")
+	md_Input = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Input")
+	fd_Input_address = md_Input.Fields().ByName("address")
+	fd_Input_coins = md_Input.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_Output)(nil)
+var _ protoreflect.Message = (*fastReflection_Input)(nil)
 
-type fastReflection_Output Output
+type fastReflection_Input Input
 
-func (x *Output) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Output)(x)
+func (x *Input) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Input)(x)
 }
 
-func (x *Output) slowProtoReflect() protoreflect.Message {
+func (x *Input) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,43 +1971,43 @@ func (x *Output) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Output_messageType fastReflection_Output_messageType
-var _ protoreflect.MessageType = fastReflection_Output_messageType{}
+var _fastReflection_Input_messageType fastReflection_Input_messageType
+var _ protoreflect.MessageType = fastReflection_Input_messageType{}
 
-type fastReflection_Output_messageType struct{}
+type fastReflection_Input_messageType struct{}
 
-func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Output)(nil)
+func (x fastReflection_Input_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Input)(nil)
 }
-func (x fastReflection_Output_messageType) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x fastReflection_Input_messageType) New() protoreflect.Message {
+	return new(fastReflection_Input)
 }
-func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x fastReflection_Input_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Input
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x *fastReflection_Input) Descriptor() protoreflect.MessageDescriptor {
+	return md_Input
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Output) Type() protoreflect.MessageType {
-	return _fastReflection_Output_messageType
+func (x *fastReflection_Input) Type() protoreflect.MessageType {
+	return _fastReflection_Input_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Output) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x *fastReflection_Input) New() protoreflect.Message {
+	return new(fastReflection_Input)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
-	return (*Output)(x)
+func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
+	return (*Input)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1725,16 +2015,16 @@ func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Output_address, value) {
+		if !f(fd_Input_address, value) {
 			return
 		}
 	}
 	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_Output_2_list{list: &x.Coins})
-		if !f(fd_Output_coins, value) {
+		value := protoreflect.ValueOfList(&_Input_2_list{list: &x.Coins})
+		if !f(fd_Input_coins, value) {
 			return
 		}
 	}
@@ -1751,17 +2041,17 @@ func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		return x.Address != ""
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1771,17 +2061,17 @@ func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		x.Address = ""
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1791,22 +2081,22 @@ func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_Output_2_list{})
+			return protoreflect.ValueOfList(&_Input_2_list{})
 		}
-		listValue := &_Output_2_list{list: &x.Coins}
+		listValue := &_Input_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1820,19 +2110,19 @@ func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		x.Address = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		lv := value.List()
-		clv := lv.(*_Output_2_list)
+		clv := lv.(*_Input_2_list)
 		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1846,49 +2136,49 @@ func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		if x.Coins == nil {
 			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_Output_2_list{list: &x.Coins}
+		value := &_Input_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Output.address":
-		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Output is not mutable"))
+	case "cosmos.bank.v1beta1.Input.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Input is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Output_2_list{list: &list})
+		return protoreflect.ValueOfList(&_Input_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Output", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Input", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1896,7 +2186,7 @@ func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1907,7 +2197,7 @@ func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1919,7 +2209,7 @@ func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Output) IsValid() bool {
+func (x *fastReflection_Input) IsValid() bool {
 	return x != nil
 }
 
@@ -1929,9 +2219,9 @@ func (x *fastReflection_Output) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1963,7 +2253,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2016,7 +2306,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2048,10 +2338,10 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2155,77 +2445,79 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Supply_1_list)(nil)
+var _ protoreflect.List = (*_Output_2_list)(nil)
 
-type _Supply_1_list struct {
+type _Output_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Supply_1_list) Len() int {
+func (x *_Output_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Supply_1_list) Get(i int) protoreflect.Value {
+func (x *_Output_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Supply_1_list) Set(i int, value protoreflect.Value) {
+func (x *_Output_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Supply_1_list) Append(value protoreflect.Value) {
+func (x *_Output_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Supply_1_list) AppendMutable() protoreflect.Value {
+func (x *_Output_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Supply_1_list) Truncate(n int) {
+func (x *_Output_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Supply_1_list) NewElement() protoreflect.Value {
+func (x *_Output_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Supply_1_list) IsValid() bool {
+func (x *_Output_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Supply       protoreflect.MessageDescriptor
-	fd_Supply_total protoreflect.FieldDescriptor
+	md_Output         protoreflect.MessageDescriptor
+	fd_Output_address protoreflect.FieldDescriptor
+	fd_Output_coins   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Supply = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Supply")
-	fd_Supply_total = md_Supply.Fields().ByName("total")
+	md_Output = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Output")
+	fd_Output_address = md_Output.Fields().ByName("address")
+	fd_Output_coins = md_Output.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_Supply)(nil)
+var _ protoreflect.Message = (*fastReflection_Output)(nil)
 
-type fastReflection_Supply Supply
+type fastReflection_Output Output
 
-func (x *Supply) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Supply)(x)
+func (x *Output) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Output)(x)
 }
 
-func (x *Supply) slowProtoReflect() protoreflect.Message {
+func (x *Output) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2237,43 +2529,43 @@ func (x *Supply) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Supply_messageType fastReflection_Supply_messageType
-var _ protoreflect.MessageType = fastReflection_Supply_messageType{}
+var _fastReflection_Output_messageType fastReflection_Output_messageType
+var _ protoreflect.MessageType = fastReflection_Output_messageType{}
 
-type fastReflection_Supply_messageType struct{}
+type fastReflection_Output_messageType struct{}
 
-func (x fastReflection_Supply_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Supply)(nil)
+func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Output)(nil)
 }
-func (x fastReflection_Supply_messageType) New() protoreflect.Message {
-	return new(fastReflection_Supply)
+func (x fastReflection_Output_messageType) New() protoreflect.Message {
+	return new(fastReflection_Output)
 }
-func (x fastReflection_Supply_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Supply
+func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Supply) Descriptor() protoreflect.MessageDescriptor {
-	return md_Supply
+func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Supply) Type() protoreflect.MessageType {
-	return _fastReflection_Supply_messageType
+func (x *fastReflection_Output) Type() protoreflect.MessageType {
+	return _fastReflection_Output_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Supply) New() protoreflect.Message {
-	return new(fastReflection_Supply)
+func (x *fastReflection_Output) New() protoreflect.Message {
+	return new(fastReflection_Output)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
-	return (*Supply)(x)
+func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
+	return (*Output)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2281,10 +2573,16 @@ func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Total) != 0 {
-		value := protoreflect.ValueOfList(&_Supply_1_list{list: &x.Total})
-		if !f(fd_Supply_total, value) {
+func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Output_address, value) {
+			return
+		}
+	}
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_Output_2_list{list: &x.Coins})
+		if !f(fd_Output_coins, value) {
 			return
 		}
 	}
@@ -2301,15 +2599,17 @@ func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		return len(x.Total) != 0
+	case "cosmos.bank.v1beta1.Output.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.Output.coins":
+		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2319,15 +2619,17 @@ func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		x.Total = nil
+	case "cosmos.bank.v1beta1.Output.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.Output.coins":
+		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2337,19 +2639,22 @@ func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		if len(x.Total) == 0 {
-			return protoreflect.ValueOfList(&_Supply_1_list{})
+	case "cosmos.bank.v1beta1.Output.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Output.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_Output_2_list{})
 		}
-		listValue := &_Supply_1_list{list: &x.Total}
+		listValue := &_Output_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2363,17 +2668,19 @@ func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
+	case "cosmos.bank.v1beta1.Output.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Output.coins":
 		lv := value.List()
-		clv := lv.(*_Supply_1_list)
-		x.Total = *clv.list
+		clv := lv.(*_Output_2_list)
+		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2387,45 +2694,49 @@ func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		if x.Total == nil {
-			x.Total = []*v1beta1.Coin{}
+	case "cosmos.bank.v1beta1.Output.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_Supply_1_list{list: &x.Total}
+		value := &_Output_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Output.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Output is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Supply) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
+	case "cosmos.bank.v1beta1.Output.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Output.coins":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Supply_1_list{list: &list})
+		return protoreflect.ValueOfList(&_Output_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Supply", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Output", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2433,7 +2744,7 @@ func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2444,7 +2755,7 @@ func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2456,7 +2767,7 @@ func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Supply) IsValid() bool {
+func (x *fastReflection_Output) IsValid() bool {
 	return x != nil
 }
 
@@ -2466,9 +2777,9 @@ func (x *fastReflection_Supply) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2480,8 +2791,12 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Total) > 0 {
-			for _, e := range x.Total {
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -2496,7 +2811,7 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2515,11 +2830,11 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Total) > 0 {
-			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Total[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 						Buf:               input.Buf,
 					}, err
@@ -2528,9 +2843,16 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2542,7 +2864,7 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2574,15 +2896,47 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2609,8 +2963,8 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Total = append(x.Total, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2649,76 +3003,77 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DenomUnit_3_list)(nil)
+var _ protoreflect.List = (*_Supply_1_list)(nil)
 
-type _DenomUnit_3_list struct {
-	list *[]string
+type _Supply_1_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_DenomUnit_3_list) Len() int {
+func (x *_Supply_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DenomUnit_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_Supply_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_Supply_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DenomUnit_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_Supply_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DenomUnit_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DenomUnit at list field Aliases as it is not of Message kind"))
+func (x *_Supply_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) Truncate(n int) {
+func (x *_Supply_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DenomUnit_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_Supply_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) IsValid() bool {
+func (x *_Supply_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DenomUnit          protoreflect.MessageDescriptor
-	fd_DenomUnit_denom    protoreflect.FieldDescriptor
-	fd_DenomUnit_exponent protoreflect.FieldDescriptor
-	fd_DenomUnit_aliases  protoreflect.FieldDescriptor
+	md_Supply       protoreflect.MessageDescriptor
+	fd_Supply_total protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_DenomUnit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DenomUnit")
-	fd_DenomUnit_denom = md_DenomUnit.Fields().ByName("denom")
-	fd_DenomUnit_exponent = md_DenomUnit.Fields().ByName("exponent")
-	fd_DenomUnit_aliases = md_DenomUnit.Fields().ByName("aliases")
+	md_Supply = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Supply")
+	fd_Supply_total = md_Supply.Fields().ByName("total")
 }
 
-var _ protoreflect.Message = (*fastReflection_DenomUnit)(nil)
+var _ protoreflect.Message = (*fastReflection_Supply)(nil)
 
-type fastReflection_DenomUnit DenomUnit
+type fastReflection_Supply Supply
 
-func (x *DenomUnit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DenomUnit)(x)
+func (x *Supply) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Supply)(x)
 }
 
-func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
+func (x *Supply) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2730,43 +3085,43 @@ func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DenomUnit_messageType fastReflection_DenomUnit_messageType
-var _ protoreflect.MessageType = fastReflection_DenomUnit_messageType{}
+var _fastReflection_Supply_messageType fastReflection_Supply_messageType
+var _ protoreflect.MessageType = fastReflection_Supply_messageType{}
 
-type fastReflection_DenomUnit_messageType struct{}
+type fastReflection_Supply_messageType struct{}
 
-func (x fastReflection_DenomUnit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DenomUnit)(nil)
+func (x fastReflection_Supply_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Supply)(nil)
 }
-func (x fastReflection_DenomUnit_messageType) New() protoreflect.Message {
-	return new(fastReflection_DenomUnit)
+func (x fastReflection_Supply_messageType) New() protoreflect.Message {
+	return new(fastReflection_Supply)
 }
-func (x fastReflection_DenomUnit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DenomUnit
+func (x fastReflection_Supply_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Supply
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DenomUnit) Descriptor() protoreflect.MessageDescriptor {
-	return md_DenomUnit
+func (x *fastReflection_Supply) Descriptor() protoreflect.MessageDescriptor {
+	return md_Supply
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DenomUnit) Type() protoreflect.MessageType {
-	return _fastReflection_DenomUnit_messageType
+func (x *fastReflection_Supply) Type() protoreflect.MessageType {
+	return _fastReflection_Supply_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DenomUnit) New() protoreflect.Message {
-	return new(fastReflection_DenomUnit)
+func (x *fastReflection_Supply) New() protoreflect.Message {
+	return new(fastReflection_Supply)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
-	return (*DenomUnit)(x)
+func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
+	return (*Supply)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2774,22 +3129,10 @@ func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_DenomUnit_denom, value) {
-			return
-		}
-	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_DenomUnit_exponent, value) {
-			return
-		}
-	}
-	if len(x.Aliases) != 0 {
-		value := protoreflect.ValueOfList(&_DenomUnit_3_list{list: &x.Aliases})
-		if !f(fd_DenomUnit_aliases, value) {
+func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Total) != 0 {
+		value := protoreflect.ValueOfList(&_Supply_1_list{list: &x.Total})
+		if !f(fd_Supply_total, value) {
 			return
 		}
 	}
@@ -2806,19 +3149,15 @@ func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		return x.Denom != ""
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		return x.Exponent != uint32(0)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		return len(x.Aliases) != 0
+	case "cosmos.bank.v1beta1.Supply.total":
+		return len(x.Total) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2828,19 +3167,15 @@ func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		x.Denom = ""
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		x.Exponent = uint32(0)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		x.Aliases = nil
+	case "cosmos.bank.v1beta1.Supply.total":
+		x.Total = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2850,25 +3185,19 @@ func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		value := x.Exponent
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		if len(x.Aliases) == 0 {
-			return protoreflect.ValueOfList(&_DenomUnit_3_list{})
+	case "cosmos.bank.v1beta1.Supply.total":
+		if len(x.Total) == 0 {
+			return protoreflect.ValueOfList(&_Supply_1_list{})
 		}
-		listValue := &_DenomUnit_3_list{list: &x.Aliases}
+		listValue := &_Supply_1_list{list: &x.Total}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2882,21 +3211,17 @@ func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		x.Exponent = uint32(value.Uint())
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+	case "cosmos.bank.v1beta1.Supply.total":
 		lv := value.List()
-		clv := lv.(*_DenomUnit_3_list)
-		x.Aliases = *clv.list
+		clv := lv.(*_Supply_1_list)
+		x.Total = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2910,53 +3235,45 @@ func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		if x.Aliases == nil {
-			x.Aliases = []string{}
+	case "cosmos.bank.v1beta1.Supply.total":
+		if x.Total == nil {
+			x.Total = []*v1beta1.Coin{}
 		}
-		value := &_DenomUnit_3_list{list: &x.Aliases}
+		value := &_Supply_1_list{list: &x.Total}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		panic(fmt.Errorf("field exponent of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DenomUnit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		list := []string{}
-		return protoreflect.ValueOfList(&_DenomUnit_3_list{list: &list})
+	case "cosmos.bank.v1beta1.Supply.total":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Supply_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DenomUnit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Supply", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2964,7 +3281,7 @@ func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2975,7 +3292,7 @@ func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2987,7 +3304,7 @@ func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DenomUnit) IsValid() bool {
+func (x *fastReflection_Supply) IsValid() bool {
 	return x != nil
 }
 
@@ -2997,9 +3314,9 @@ func (x *fastReflection_DenomUnit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3011,16 +3328,9 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
-		}
-		if len(x.Aliases) > 0 {
-			for _, s := range x.Aliases {
-				l = len(s)
+		if len(x.Total) > 0 {
+			for _, e := range x.Total {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -3034,7 +3344,7 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3053,27 +3363,22 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Aliases) > 0 {
-			for iNdEx := len(x.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Aliases[iNdEx])
-				copy(dAtA[i:], x.Aliases[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aliases[iNdEx])))
+		if len(x.Total) > 0 {
+			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Total[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3085,7 +3390,7 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3117,17 +3422,17 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3137,74 +3442,25 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-				}
-				x.Exponent = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Total = append(x.Total, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Aliases = append(x.Aliases, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3241,91 +3497,76 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Metadata_2_list)(nil)
+var _ protoreflect.List = (*_DenomUnit_3_list)(nil)
 
-type _Metadata_2_list struct {
-	list *[]*DenomUnit
+type _DenomUnit_3_list struct {
+	list *[]string
 }
 
-func (x *_Metadata_2_list) Len() int {
+func (x *_DenomUnit_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_DenomUnit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+func (x *_DenomUnit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Metadata_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+func (x *_DenomUnit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
-	v := new(DenomUnit)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_DenomUnit_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomUnit at list field Aliases as it is not of Message kind"))
 }
 
-func (x *_Metadata_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_DenomUnit_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Metadata_2_list) NewElement() protoreflect.Value {
-	v := new(DenomUnit)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_DenomUnit_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_Metadata_2_list) IsValid() bool {
+func (x *_DenomUnit_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Metadata             protoreflect.MessageDescriptor
-	fd_Metadata_description protoreflect.FieldDescriptor
-	fd_Metadata_denom_units protoreflect.FieldDescriptor
-	fd_Metadata_base        protoreflect.FieldDescriptor
-	fd_Metadata_display     protoreflect.FieldDescriptor
-	fd_Metadata_name        protoreflect.FieldDescriptor
-	fd_Metadata_symbol      protoreflect.FieldDescriptor
-	fd_Metadata_uri         protoreflect.FieldDescriptor
-	fd_Metadata_uri_hash    protoreflect.FieldDescriptor
+	md_DenomUnit          protoreflect.MessageDescriptor
+	fd_DenomUnit_denom    protoreflect.FieldDescriptor
+	fd_DenomUnit_exponent protoreflect.FieldDescriptor
+	fd_DenomUnit_aliases  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Metadata = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Metadata")
-	fd_Metadata_description = md_Metadata.Fields().ByName("description")
-	fd_Metadata_denom_units = md_Metadata.Fields().ByName("denom_units")
-	fd_Metadata_base = md_Metadata.Fields().ByName("base")
-	fd_Metadata_display = md_Metadata.Fields().ByName("display")
-	fd_Metadata_name = md_Metadata.Fields().ByName("name")
-	fd_Metadata_symbol = md_Metadata.Fields().ByName("symbol")
-	fd_Metadata_uri = md_Metadata.Fields().ByName("uri")
-	fd_Metadata_uri_hash = md_Metadata.Fields().ByName("uri_hash")
+	md_DenomUnit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DenomUnit")
+	fd_DenomUnit_denom = md_DenomUnit.Fields().ByName("denom")
+	fd_DenomUnit_exponent = md_DenomUnit.Fields().ByName("exponent")
+	fd_DenomUnit_aliases = md_DenomUnit.Fields().ByName("aliases")
 }
 
-var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+var _ protoreflect.Message = (*fastReflection_DenomUnit)(nil)
 
-type fastReflection_Metadata Metadata
+type fastReflection_DenomUnit DenomUnit
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Metadata)(x)
+func (x *DenomUnit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomUnit)(x)
 }
 
-func (x *Metadata) slowProtoReflect() protoreflect.Message {
+func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,43 +3578,43 @@ func (x *Metadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
-var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+var _fastReflection_DenomUnit_messageType fastReflection_DenomUnit_messageType
+var _ protoreflect.MessageType = fastReflection_DenomUnit_messageType{}
 
-type fastReflection_Metadata_messageType struct{}
+type fastReflection_DenomUnit_messageType struct{}
 
-func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Metadata)(nil)
+func (x fastReflection_DenomUnit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomUnit)(nil)
 }
-func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x fastReflection_DenomUnit_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomUnit)
 }
-func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x fastReflection_DenomUnit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomUnit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x *fastReflection_DenomUnit) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomUnit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
-	return _fastReflection_Metadata_messageType
+func (x *fastReflection_DenomUnit) Type() protoreflect.MessageType {
+	return _fastReflection_DenomUnit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Metadata) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x *fastReflection_DenomUnit) New() protoreflect.Message {
+	return new(fastReflection_DenomUnit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
-	return (*Metadata)(x)
+func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
+	return (*DenomUnit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3381,52 +3622,22 @@ func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_Metadata_description, value) {
-			return
-		}
-	}
-	if len(x.DenomUnits) != 0 {
-		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.DenomUnits})
-		if !f(fd_Metadata_denom_units, value) {
-			return
-		}
-	}
-	if x.Base != "" {
-		value := protoreflect.ValueOfString(x.Base)
-		if !f(fd_Metadata_base, value) {
-			return
-		}
-	}
-	if x.Display != "" {
-		value := protoreflect.ValueOfString(x.Display)
-		if !f(fd_Metadata_display, value) {
-			return
-		}
-	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_Metadata_name, value) {
-			return
-		}
-	}
-	if x.Symbol != "" {
-		value := protoreflect.ValueOfString(x.Symbol)
-		if !f(fd_Metadata_symbol, value) {
+func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomUnit_denom, value) {
 			return
 		}
 	}
-	if x.Uri != "" {
-		value := protoreflect.ValueOfString(x.Uri)
-		if !f(fd_Metadata_uri, value) {
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_DenomUnit_exponent, value) {
 			return
 		}
 	}
-	if x.UriHash != "" {
-		value := protoreflect.ValueOfString(x.UriHash)
-		if !f(fd_Metadata_uri_hash, value) {
+	if len(x.Aliases) != 0 {
+		value := protoreflect.ValueOfList(&_DenomUnit_3_list{list: &x.Aliases})
+		if !f(fd_DenomUnit_aliases, value) {
 			return
 		}
 	}
@@ -3443,29 +3654,19 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		return x.Description != ""
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		return len(x.DenomUnits) != 0
-	case "cosmos.bank.v1beta1.Metadata.base":
-		return x.Base != ""
-	case "cosmos.bank.v1beta1.Metadata.display":
-		return x.Display != ""
-	case "cosmos.bank.v1beta1.Metadata.name":
-		return x.Name != ""
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		return x.Symbol != ""
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		return x.Uri != ""
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		return x.UriHash != ""
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		return x.Exponent != uint32(0)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		return len(x.Aliases) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3475,29 +3676,19 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		x.Description = ""
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		x.DenomUnits = nil
-	case "cosmos.bank.v1beta1.Metadata.base":
-		x.Base = ""
-	case "cosmos.bank.v1beta1.Metadata.display":
-		x.Display = ""
-	case "cosmos.bank.v1beta1.Metadata.name":
-		x.Name = ""
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		x.Symbol = ""
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		x.Uri = ""
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		x.UriHash = ""
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		x.Exponent = uint32(0)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		x.Aliases = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3507,40 +3698,25 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		value := x.Description
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		if len(x.DenomUnits) == 0 {
-			return protoreflect.ValueOfList(&_Metadata_2_list{})
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		if len(x.Aliases) == 0 {
+			return protoreflect.ValueOfList(&_DenomUnit_3_list{})
 		}
-		listValue := &_Metadata_2_list{list: &x.DenomUnits}
+		listValue := &_DenomUnit_3_list{list: &x.Aliases}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.bank.v1beta1.Metadata.base":
-		value := x.Base
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.display":
-		value := x.Display
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		value := x.Symbol
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		value := x.Uri
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		value := x.UriHash
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3554,31 +3730,21 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		x.Description = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		x.Exponent = uint32(value.Uint())
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
 		lv := value.List()
-		clv := lv.(*_Metadata_2_list)
-		x.DenomUnits = *clv.list
-	case "cosmos.bank.v1beta1.Metadata.base":
-		x.Base = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.display":
-		x.Display = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		x.Symbol = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		x.Uri = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		x.UriHash = value.Interface().(string)
+		clv := lv.(*_DenomUnit_3_list)
+		x.Aliases = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3592,73 +3758,53 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomUnit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		if x.DenomUnits == nil {
-			x.DenomUnits = []*DenomUnit{}
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		if x.Aliases == nil {
+			x.Aliases = []string{}
 		}
-		value := &_Metadata_2_list{list: &x.DenomUnits}
+		value := &_DenomUnit_3_list{list: &x.Aliases}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Metadata.description":
-		panic(fmt.Errorf("field description of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.base":
-		panic(fmt.Errorf("field base of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.display":
-		panic(fmt.Errorf("field display of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.name":
-		panic(fmt.Errorf("field name of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		panic(fmt.Errorf("field symbol of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		panic(fmt.Errorf("field uri of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		panic(fmt.Errorf("field uri_hash of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		panic(fmt.Errorf("field exponent of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomUnit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		list := []*DenomUnit{}
-		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
-	case "cosmos.bank.v1beta1.Metadata.base":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.display":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.name":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
 		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomUnit_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Metadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DenomUnit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3666,7 +3812,7 @@ func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3677,7 +3823,7 @@ func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3689,7 +3835,7 @@ func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Metadata) IsValid() bool {
+func (x *fastReflection_DenomUnit) IsValid() bool {
 	return x != nil
 }
 
@@ -3699,9 +3845,9 @@ func (x *fastReflection_Metadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*DenomUnit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3713,40 +3859,19 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Description)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.DenomUnits) > 0 {
-			for _, e := range x.DenomUnits {
-				l = options.Size(e)
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if len(x.Aliases) > 0 {
+			for _, s := range x.Aliases {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Base)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Display)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Symbol)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Uri)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.UriHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3757,7 +3882,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*DenomUnit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3776,68 +3901,24 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.UriHash) > 0 {
-			i -= len(x.UriHash)
-			copy(dAtA[i:], x.UriHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UriHash)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Uri) > 0 {
-			i -= len(x.Uri)
-			copy(dAtA[i:], x.Uri)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Symbol) > 0 {
-			i -= len(x.Symbol)
-			copy(dAtA[i:], x.Symbol)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Display) > 0 {
-			i -= len(x.Display)
-			copy(dAtA[i:], x.Display)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Display)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Base) > 0 {
-			i -= len(x.Base)
-			copy(dAtA[i:], x.Base)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Base)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.DenomUnits) > 0 {
-			for iNdEx := len(x.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomUnits[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Aliases) > 0 {
+			for iNdEx := len(x.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Aliases[iNdEx])
+				copy(dAtA[i:], x.Aliases[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aliases[iNdEx])))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3852,7 +3933,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*DenomUnit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3884,15 +3965,15 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3920,13 +4001,13 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 				}
-				var msglen int
+				x.Exponent = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3936,29 +4017,14 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Exponent |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DenomUnits = append(x.DenomUnits, &DenomUnit{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomUnits[len(x.DenomUnits)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3983,48 +4049,1439 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Aliases = append(x.Aliases, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Metadata_2_list)(nil)
+
+type _Metadata_2_list struct {
+	list *[]*DenomUnit
+}
+
+func (x *_Metadata_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
+	v := new(DenomUnit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_2_list) NewElement() protoreflect.Value {
+	v := new(DenomUnit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Metadata             protoreflect.MessageDescriptor
+	fd_Metadata_description protoreflect.FieldDescriptor
+	fd_Metadata_denom_units protoreflect.FieldDescriptor
+	fd_Metadata_base        protoreflect.FieldDescriptor
+	fd_Metadata_display     protoreflect.FieldDescriptor
+	fd_Metadata_name        protoreflect.FieldDescriptor
+	fd_Metadata_symbol      protoreflect.FieldDescriptor
+	fd_Metadata_uri         protoreflect.FieldDescriptor
+	fd_Metadata_uri_hash    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_Metadata = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Metadata")
+	fd_Metadata_description = md_Metadata.Fields().ByName("description")
+	fd_Metadata_denom_units = md_Metadata.Fields().ByName("denom_units")
+	fd_Metadata_base = md_Metadata.Fields().ByName("base")
+	fd_Metadata_display = md_Metadata.Fields().ByName("display")
+	fd_Metadata_name = md_Metadata.Fields().ByName("name")
+	fd_Metadata_symbol = md_Metadata.Fields().ByName("symbol")
+	fd_Metadata_uri = md_Metadata.Fields().ByName("uri")
+	fd_Metadata_uri_hash = md_Metadata.Fields().ByName("uri_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+
+type fastReflection_Metadata Metadata
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Metadata)(x)
+}
+
+func (x *Metadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
+var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+
+type fastReflection_Metadata_messageType struct{}
+
+func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Metadata)(nil)
+}
+func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
+	return _fastReflection_Metadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Metadata) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
+	return (*Metadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_Metadata_description, value) {
+			return
+		}
+	}
+	if len(x.DenomUnits) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.DenomUnits})
+		if !f(fd_Metadata_denom_units, value) {
+			return
+		}
+	}
+	if x.Base != "" {
+		value := protoreflect.ValueOfString(x.Base)
+		if !f(fd_Metadata_base, value) {
+			return
+		}
+	}
+	if x.Display != "" {
+		value := protoreflect.ValueOfString(x.Display)
+		if !f(fd_Metadata_display, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Metadata_name, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_Metadata_symbol, value) {
+			return
+		}
+	}
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_Metadata_uri, value) {
+			return
+		}
+	}
+	if x.UriHash != "" {
+		value := protoreflect.ValueOfString(x.UriHash)
+		if !f(fd_Metadata_uri_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		return x.Description != ""
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		return len(x.DenomUnits) != 0
+	case "cosmos.bank.v1beta1.Metadata.base":
+		return x.Base != ""
+	case "cosmos.bank.v1beta1.Metadata.display":
+		return x.Display != ""
+	case "cosmos.bank.v1beta1.Metadata.name":
+		return x.Name != ""
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		return x.Symbol != ""
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		return x.Uri != ""
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		return x.UriHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		x.Description = ""
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		x.DenomUnits = nil
+	case "cosmos.bank.v1beta1.Metadata.base":
+		x.Base = ""
+	case "cosmos.bank.v1beta1.Metadata.display":
+		x.Display = ""
+	case "cosmos.bank.v1beta1.Metadata.name":
+		x.Name = ""
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		x.Symbol = ""
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		x.Uri = ""
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		x.UriHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		if len(x.DenomUnits) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_2_list{})
+		}
+		listValue := &_Metadata_2_list{list: &x.DenomUnits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.Metadata.base":
+		value := x.Base
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.display":
+		value := x.Display
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		value := x.UriHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		x.Description = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		lv := value.List()
+		clv := lv.(*_Metadata_2_list)
+		x.DenomUnits = *clv.list
+	case "cosmos.bank.v1beta1.Metadata.base":
+		x.Base = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.display":
+		x.Display = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		x.Symbol = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		x.Uri = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		x.UriHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		if x.DenomUnits == nil {
+			x.DenomUnits = []*DenomUnit{}
+		}
+		value := &_Metadata_2_list{list: &x.DenomUnits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Metadata.description":
+		panic(fmt.Errorf("field description of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.base":
+		panic(fmt.Errorf("field base of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.display":
+		panic(fmt.Errorf("field display of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.name":
+		panic(fmt.Errorf("field name of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		panic(fmt.Errorf("field symbol of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		panic(fmt.Errorf("field uri of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		panic(fmt.Errorf("field uri_hash of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		list := []*DenomUnit{}
+		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
+	case "cosmos.bank.v1beta1.Metadata.base":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.display":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Metadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Metadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomUnits) > 0 {
+			for _, e := range x.DenomUnits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Base)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Display)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UriHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UriHash) > 0 {
+			i -= len(x.UriHash)
+			copy(dAtA[i:], x.UriHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UriHash)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Display) > 0 {
+			i -= len(x.Display)
+			copy(dAtA[i:], x.Display)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Display)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Base) > 0 {
+			i -= len(x.Base)
+			copy(dAtA[i:], x.Base)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Base)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DenomUnits) > 0 {
+			for iNdEx := len(x.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomUnits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomUnits = append(x.DenomUnits, &DenomUnit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomUnits[len(x.DenomUnits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Base = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Display = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UriHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SupplyChange        protoreflect.MessageDescriptor
+	fd_SupplyChange_height protoreflect.FieldDescriptor
+	fd_SupplyChange_denom  protoreflect.FieldDescriptor
+	fd_SupplyChange_source protoreflect.FieldDescriptor
+	fd_SupplyChange_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_SupplyChange = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SupplyChange")
+	fd_SupplyChange_height = md_SupplyChange.Fields().ByName("height")
+	fd_SupplyChange_denom = md_SupplyChange.Fields().ByName("denom")
+	fd_SupplyChange_source = md_SupplyChange.Fields().ByName("source")
+	fd_SupplyChange_amount = md_SupplyChange.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SupplyChange)(nil)
+
+type fastReflection_SupplyChange SupplyChange
+
+func (x *SupplyChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SupplyChange)(x)
+}
+
+func (x *SupplyChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SupplyChange_messageType fastReflection_SupplyChange_messageType
+var _ protoreflect.MessageType = fastReflection_SupplyChange_messageType{}
+
+type fastReflection_SupplyChange_messageType struct{}
+
+func (x fastReflection_SupplyChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SupplyChange)(nil)
+}
+func (x fastReflection_SupplyChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_SupplyChange)
+}
+func (x fastReflection_SupplyChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplyChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SupplyChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplyChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SupplyChange) Type() protoreflect.MessageType {
+	return _fastReflection_SupplyChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SupplyChange) New() protoreflect.Message {
+	return new(fastReflection_SupplyChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SupplyChange) Interface() protoreflect.ProtoMessage {
+	return (*SupplyChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SupplyChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_SupplyChange_height, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_SupplyChange_denom, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_SupplyChange_source, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_SupplyChange_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SupplyChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		return x.Height != int64(0)
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		return x.Source != ""
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplyChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		x.Height = int64(0)
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		x.Source = ""
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SupplyChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplyChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		x.Height = value.Int()
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		x.Source = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplyChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		panic(fmt.Errorf("field height of message cosmos.bank.v1beta1.SupplyChange is not mutable"))
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.SupplyChange is not mutable"))
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		panic(fmt.Errorf("field source of message cosmos.bank.v1beta1.SupplyChange is not mutable"))
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		panic(fmt.Errorf("field amount of message cosmos.bank.v1beta1.SupplyChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SupplyChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SupplyChange.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.SupplyChange.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.SupplyChange.source":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.SupplyChange.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SupplyChange"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SupplyChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SupplyChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SupplyChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SupplyChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplyChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SupplyChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SupplyChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SupplyChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SupplyChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SupplyChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Base = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Display = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplyChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var stringLen uint64
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4034,27 +5491,14 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4082,11 +5526,11 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Symbol = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4114,11 +5558,11 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Uri = string(dAtA[iNdEx:postIndex])
+				x.Source = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4146,7 +5590,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UriHash = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4211,6 +5655,17 @@ type Params struct {
 	// Deprecated: Do not use.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// dust_thresholds are the balances under which amounts of their denom are
+	// dust. Accounts holding only dust can be reaped.
+	DustThresholds []*DustThreshold `protobuf:"bytes,3,rep,name=dust_thresholds,json=dustThresholds,proto3" json:"dust_thresholds,omitempty"`
+	// dust_reap_limit is the maximum number of accounts examined for dust at the
+	// end of a block. Zero disables dust reaping.
+	DustReapLimit uint64 `protobuf:"varint,4,opt,name=dust_reap_limit,json=dustReapLimit,proto3" json:"dust_reap_limit,omitempty"`
+	// dust_exemptions are the addresses never reaped.
+	DustExemptions []string `protobuf:"bytes,5,rep,name=dust_exemptions,json=dustExemptions,proto3" json:"dust_exemptions,omitempty"`
+	// defer_account_creation defers the creation of the accounts of addresses
+	// receiving only dust until they receive a non-dust amount or sign a tx.
+	DeferAccountCreation bool `protobuf:"varint,6,opt,name=defer_account_creation,json=deferAccountCreation,proto3" json:"defer_account_creation,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -4248,6 +5703,78 @@ func (x *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (x *Params) GetDustThresholds() []*DustThreshold {
+	if x != nil {
+		return x.DustThresholds
+	}
+	return nil
+}
+
+func (x *Params) GetDustReapLimit() uint64 {
+	if x != nil {
+		return x.DustReapLimit
+	}
+	return 0
+}
+
+func (x *Params) GetDustExemptions() []string {
+	if x != nil {
+		return x.DustExemptions
+	}
+	return nil
+}
+
+func (x *Params) GetDeferAccountCreation() bool {
+	if x != nil {
+		return x.DeferAccountCreation
+	}
+	return false
+}
+
+// DustThreshold is the balance under which an amount of a denom is dust.
+type DustThreshold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *DustThreshold) Reset() {
+	*x = DustThreshold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DustThreshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DustThreshold) ProtoMessage() {}
+
+// Deprecated: Use DustThreshold.ProtoReflect.Descriptor instead.
+func (*DustThreshold) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DustThreshold) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DustThreshold) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -4262,7 +5789,7 @@ type SendEnabled struct {
 func (x *SendEnabled) Reset() {
 	*x = SendEnabled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4276,7 +5803,7 @@ func (*SendEnabled) ProtoMessage() {}
 
 // Deprecated: Use SendEnabled.ProtoReflect.Descriptor instead.
 func (*SendEnabled) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SendEnabled) GetDenom() string {
@@ -4306,7 +5833,7 @@ type Input struct {
 func (x *Input) Reset() {
 	*x = Input{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4320,7 +5847,7 @@ func (*Input) ProtoMessage() {}
 
 // Deprecated: Use Input.ProtoReflect.Descriptor instead.
 func (*Input) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Input) GetAddress() string {
@@ -4350,7 +5877,7 @@ type Output struct {
 func (x *Output) Reset() {
 	*x = Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4364,7 +5891,7 @@ func (*Output) ProtoMessage() {}
 
 // Deprecated: Use Output.ProtoReflect.Descriptor instead.
 func (*Output) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Output) GetAddress() string {
@@ -4397,7 +5924,7 @@ type Supply struct {
 func (x *Supply) Reset() {
 	*x = Supply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4411,7 +5938,7 @@ func (*Supply) ProtoMessage() {}
 
 // Deprecated: Use Supply.ProtoReflect.Descriptor instead.
 func (*Supply) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Supply) GetTotal() []*v1beta1.Coin {
@@ -4443,7 +5970,7 @@ type DenomUnit struct {
 func (x *DenomUnit) Reset() {
 	*x = DenomUnit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4457,7 +5984,7 @@ func (*DenomUnit) ProtoMessage() {}
 
 // Deprecated: Use DenomUnit.ProtoReflect.Descriptor instead.
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DenomUnit) GetDenom() string {
@@ -4519,7 +6046,7 @@ type Metadata struct {
 func (x *Metadata) Reset() {
 	*x = Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4533,7 +6060,7 @@ func (*Metadata) ProtoMessage() {}
 
 // Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Metadata) GetDescription() string {
@@ -4592,6 +6119,69 @@ func (x *Metadata) GetUriHash() string {
 	return ""
 }
 
+// SupplyChange is the net change of the supply of a denom by the coins minted and burned by a source at a height.
+type SupplyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source is the name of the module account minting or burning the coins, or the address of the account burning
+	// them.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// amount is negative if more coins were burned than minted.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SupplyChange) Reset() {
+	*x = SupplyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplyChange) ProtoMessage() {}
+
+// Deprecated: Use SupplyChange.ProtoReflect.Descriptor instead.
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SupplyChange) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SupplyChange) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *SupplyChange) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SupplyChange) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 var File_cosmos_bank_v1beta1_bank_proto protoreflect.FileDescriptor
 
 var file_cosmos_bank_v1beta1_bank_proto_rawDesc = []byte{
@@ -4606,7 +6196,7 @@ var file_cosmos_bank_v1beta1_bank_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d,
 	0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a,
+	0x74, 0x6f, 0x22, 0x9b, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a,
 	0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e,
 	0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6e,
@@ -4614,84 +6204,117 @@ var file_cosmos_bank_v1beta1_bank_proto_rawDesc = []byte{
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
 	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a,
-	0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0x3a, 0x14, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x06, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
-	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e,
-	0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xac, 0x01, 0x0a, 0x06,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x77, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x3a,
-	0x29, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0xca, 0xb4, 0x2d, 0x1b, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x22, 0x57, 0x0a, 0x09, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x19, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xde, 0x1f, 0x03,
-	0x55, 0x52, 0x49, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x08, 0x75, 0x72, 0x69, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07,
-	0x55, 0x52, 0x49, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61, 0x73, 0x68,
-	0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x42, 0x61,
-	0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
-	0x62, 0x61, 0x6e, 0x6b, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42,
-	0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x0f, 0x64, 0x75, 0x73, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0e, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x61, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x64, 0x75, 0x73, 0x74,
+	0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x64, 0x75, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64,
+	0x65, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x64, 0x65, 0x66,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x76, 0x0a, 0x0d, 0x44, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x14, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xac, 0x01, 0x0a,
+	0x06, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x77, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x3a, 0x29, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0xca, 0xb4, 0x2d, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x22, 0x57, 0x0a, 0x09, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x19,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xde, 0x1f,
+	0x03, 0x55, 0x52, 0x49, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x08, 0x75, 0x72, 0x69,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f,
+	0x07, 0x55, 0x52, 0x49, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xc4, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e,
+	0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x42, 0x61, 0x6e, 0x6b, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x62, 0x61, 0x6e,
+	0x6b, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x58, 0xaa, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4706,28 +6329,31 @@ func file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP() []byte {
 	return file_cosmos_bank_v1beta1_bank_proto_rawDescData
 }
 
-var file_cosmos_bank_v1beta1_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_bank_v1beta1_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_cosmos_bank_v1beta1_bank_proto_goTypes = []interface{}{
-	(*Params)(nil),       // 0: cosmos.bank.v1beta1.Params
-	(*SendEnabled)(nil),  // 1: cosmos.bank.v1beta1.SendEnabled
-	(*Input)(nil),        // 2: cosmos.bank.v1beta1.Input
-	(*Output)(nil),       // 3: cosmos.bank.v1beta1.Output
-	(*Supply)(nil),       // 4: cosmos.bank.v1beta1.Supply
-	(*DenomUnit)(nil),    // 5: cosmos.bank.v1beta1.DenomUnit
-	(*Metadata)(nil),     // 6: cosmos.bank.v1beta1.Metadata
-	(*v1beta1.Coin)(nil), // 7: cosmos.base.v1beta1.Coin
+	(*Params)(nil),        // 0: cosmos.bank.v1beta1.Params
+	(*DustThreshold)(nil), // 1: cosmos.bank.v1beta1.DustThreshold
+	(*SendEnabled)(nil),   // 2: cosmos.bank.v1beta1.SendEnabled
+	(*Input)(nil),         // 3: cosmos.bank.v1beta1.Input
+	(*Output)(nil),        // 4: cosmos.bank.v1beta1.Output
+	(*Supply)(nil),        // 5: cosmos.bank.v1beta1.Supply
+	(*DenomUnit)(nil),     // 6: cosmos.bank.v1beta1.DenomUnit
+	(*Metadata)(nil),      // 7: cosmos.bank.v1beta1.Metadata
+	(*SupplyChange)(nil),  // 8: cosmos.bank.v1beta1.SupplyChange
+	(*v1beta1.Coin)(nil),  // 9: cosmos.base.v1beta1.Coin
 }
 var file_cosmos_bank_v1beta1_bank_proto_depIdxs = []int32{
-	1, // 0: cosmos.bank.v1beta1.Params.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
-	7, // 1: cosmos.bank.v1beta1.Input.coins:type_name -> cosmos.base.v1beta1.Coin
-	7, // 2: cosmos.bank.v1beta1.Output.coins:type_name -> cosmos.base.v1beta1.Coin
-	7, // 3: cosmos.bank.v1beta1.Supply.total:type_name -> cosmos.base.v1beta1.Coin
-	5, // 4: cosmos.bank.v1beta1.Metadata.denom_units:type_name -> cosmos.bank.v1beta1.DenomUnit
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 0: cosmos.bank.v1beta1.Params.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
+	1, // 1: cosmos.bank.v1beta1.Params.dust_thresholds:type_name -> cosmos.bank.v1beta1.DustThreshold
+	9, // 2: cosmos.bank.v1beta1.Input.coins:type_name -> cosmos.base.v1beta1.Coin
+	9, // 3: cosmos.bank.v1beta1.Output.coins:type_name -> cosmos.base.v1beta1.Coin
+	9, // 4: cosmos.bank.v1beta1.Supply.total:type_name -> cosmos.base.v1beta1.Coin
+	6, // 5: cosmos.bank.v1beta1.Metadata.denom_units:type_name -> cosmos.bank.v1beta1.DenomUnit
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_bank_v1beta1_bank_proto_init() }
@@ -4749,7 +6375,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendEnabled); i {
+			switch v := v.(*DustThreshold); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4761,7 +6387,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Input); i {
+			switch v := v.(*SendEnabled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4773,7 +6399,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Output); i {
+			switch v := v.(*Input); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4785,7 +6411,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Supply); i {
+			switch v := v.(*Output); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4797,7 +6423,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenomUnit); i {
+			switch v := v.(*Supply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4809,6 +6435,18 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
@@ -4820,6 +6458,18 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplyChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4827,7 +6477,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_bank_v1beta1_bank_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,6 +31,8 @@ const (
 	Query_DenomsMetadata_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_TotalSupplyAt_FullMethodName              = "/cosmos.bank.v1beta1.Query/TotalSupplyAt"
+	Query_SupplyChanges_FullMethodName              = "/cosmos.bank.v1beta1.Query/SupplyChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -95,6 +97,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// TotalSupplyAt queries the supply of all coins after the execution of the block at a height, if the supply
+	// history is tracked since then.
+	TotalSupplyAt(ctx context.Context, in *QueryTotalSupplyAtRequest, opts ...grpc.CallOption) (*QueryTotalSupplyAtResponse, error)
+	// SupplyChanges queries the changes of the supply of all coins from a height, if the supply history is tracked
+	// since then.
+	SupplyChanges(ctx context.Context, in *QuerySupplyChangesRequest, opts ...grpc.CallOption) (*QuerySupplyChangesResponse, error)
 }
 
 type queryClient struct {
@@ -213,6 +221,24 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) TotalSupplyAt(ctx context.Context, in *QueryTotalSupplyAtRequest, opts ...grpc.CallOption) (*QueryTotalSupplyAtResponse, error) {
+	out := new(QueryTotalSupplyAtResponse)
+	err := c.cc.Invoke(ctx, Query_TotalSupplyAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyChanges(ctx context.Context, in *QuerySupplyChangesRequest, opts ...grpc.CallOption) (*QuerySupplyChangesResponse, error) {
+	out := new(QuerySupplyChangesResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -275,6 +301,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// TotalSupplyAt queries the supply of all coins after the execution of the block at a height, if the supply
+	// history is tracked since then.
+	TotalSupplyAt(context.Context, *QueryTotalSupplyAtRequest) (*QueryTotalSupplyAtResponse, error)
+	// SupplyChanges queries the changes of the supply of all coins from a height, if the supply history is tracked
+	// since then.
+	SupplyChanges(context.Context, *QuerySupplyChangesRequest) (*QuerySupplyChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -318,6 +350,12 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) TotalSupplyAt(context.Context, *QueryTotalSupplyAtRequest) (*QueryTotalSupplyAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSupplyAt not implemented")
+}
+func (UnimplementedQueryServer) SupplyChanges(context.Context, *QuerySupplyChangesRequest) (*QuerySupplyChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -547,6 +585,42 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalSupplyAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSupplyAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSupplyAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalSupplyAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSupplyAt(ctx, req.(*QueryTotalSupplyAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyChanges(ctx, req.(*QuerySupplyChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -602,6 +676,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "TotalSupplyAt",
+			Handler:    _Query_TotalSupplyAt_Handler,
+		},
+		{
+			MethodName: "SupplyChanges",
+			Handler:    _Query_SupplyChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
-	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
-	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_Account_FullMethodName         = "/cosmos.circuit.v1.Query/Account"
+	Query_Accounts_FullMethodName        = "/cosmos.circuit.v1.Query/Accounts"
+	Query_DisabledList_FullMethodName    = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_MsgRouteHistory_FullMethodName = "/cosmos.circuit.v1.Query/MsgRouteHistory"
+	Query_MsgRouteStatus_FullMethodName  = "/cosmos.circuit.v1.Query/MsgRouteStatus"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// MsgRouteHistory returns the changes of the availability of a message type, by increasing height.
+	MsgRouteHistory(ctx context.Context, in *QueryMsgRouteHistoryRequest, opts ...grpc.CallOption) (*MsgRouteHistoryResponse, error)
+	// MsgRouteStatus returns the last change of the availability of a message type at or before a height, explaining
+	// why messages of this type were accepted or not.
+	MsgRouteStatus(ctx context.Context, in *QueryMsgRouteStatusRequest, opts ...grpc.CallOption) (*MsgRouteStatusResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) MsgRouteHistory(ctx context.Context, in *QueryMsgRouteHistoryRequest, opts ...grpc.CallOption) (*MsgRouteHistoryResponse, error) {
+	out := new(MsgRouteHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MsgRouteHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgRouteStatus(ctx context.Context, in *QueryMsgRouteStatusRequest, opts ...grpc.CallOption) (*MsgRouteStatusResponse, error) {
+	out := new(MsgRouteStatusResponse)
+	err := c.cc.Invoke(ctx, Query_MsgRouteStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// MsgRouteHistory returns the changes of the availability of a message type, by increasing height.
+	MsgRouteHistory(context.Context, *QueryMsgRouteHistoryRequest) (*MsgRouteHistoryResponse, error)
+	// MsgRouteStatus returns the last change of the availability of a message type at or before a height, explaining
+	// why messages of this type were accepted or not.
+	MsgRouteStatus(context.Context, *QueryMsgRouteStatusRequest) (*MsgRouteStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) MsgRouteHistory(context.Context, *QueryMsgRouteHistoryRequest) (*MsgRouteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRouteHistory not implemented")
+}
+func (UnimplementedQueryServer) MsgRouteStatus(context.Context, *QueryMsgRouteStatusRequest) (*MsgRouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRouteStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgRouteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRouteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRouteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgRouteHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRouteHistory(ctx, req.(*QueryMsgRouteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgRouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgRouteStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRouteStatus(ctx, req.(*QueryMsgRouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "MsgRouteHistory",
+			Handler:    _Query_MsgRouteHistory_Handler,
+		},
+		{
+			MethodName: "MsgRouteStatus",
+			Handler:    _Query_MsgRouteStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -22,6 +22,9 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_SetGuardians_FullMethodName            = "/cosmos.circuit.v1.Msg/SetGuardians"
+	Msg_VotePause_FullMethodName               = "/cosmos.circuit.v1.Msg/VotePause"
+	Msg_VoteUnpause_FullMethodName             = "/cosmos.circuit.v1.Msg/VoteUnpause"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +39,13 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetGuardians allows the module authority to replace the guardians, which
+	// can pause the processing of all Msg's chain-wide.
+	SetGuardians(ctx context.Context, in *MsgSetGuardians, opts ...grpc.CallOption) (*MsgSetGuardiansResponse, error)
+	// VotePause records the vote of a guardian to pause the chain.
+	VotePause(ctx context.Context, in *MsgVotePause, opts ...grpc.CallOption) (*MsgVotePauseResponse, error)
+	// VoteUnpause records the vote of a guardian to unpause the chain.
+	VoteUnpause(ctx context.Context, in *MsgVoteUnpause, opts ...grpc.CallOption) (*MsgVoteUnpauseResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +83,33 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) SetGuardians(ctx context.Context, in *MsgSetGuardians, opts ...grpc.CallOption) (*MsgSetGuardiansResponse, error) {
+	out := new(MsgSetGuardiansResponse)
+	err := c.cc.Invoke(ctx, Msg_SetGuardians_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VotePause(ctx context.Context, in *MsgVotePause, opts ...grpc.CallOption) (*MsgVotePauseResponse, error) {
+	out := new(MsgVotePauseResponse)
+	err := c.cc.Invoke(ctx, Msg_VotePause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteUnpause(ctx context.Context, in *MsgVoteUnpause, opts ...grpc.CallOption) (*MsgVoteUnpauseResponse, error) {
+	out := new(MsgVoteUnpauseResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteUnpause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +122,13 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetGuardians allows the module authority to replace the guardians, which
+	// can pause the processing of all Msg's chain-wide.
+	SetGuardians(context.Context, *MsgSetGuardians) (*MsgSetGuardiansResponse, error)
+	// VotePause records the vote of a guardian to pause the chain.
+	VotePause(context.Context, *MsgVotePause) (*MsgVotePauseResponse, error)
+	// VoteUnpause records the vote of a guardian to unpause the chain.
+	VoteUnpause(context.Context, *MsgVoteUnpause) (*MsgVoteUnpauseResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +145,15 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) SetGuardians(context.Context, *MsgSetGuardians) (*MsgSetGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuardians not implemented")
+}
+func (UnimplementedMsgServer) VotePause(context.Context, *MsgVotePause) (*MsgVotePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePause not implemented")
+}
+func (UnimplementedMsgServer) VoteUnpause(context.Context, *MsgVoteUnpause) (*MsgVoteUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteUnpause not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +221,60 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGuardians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGuardians(ctx, req.(*MsgSetGuardians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VotePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVotePause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VotePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VotePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VotePause(ctx, req.(*MsgVotePause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteUnpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteUnpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteUnpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteUnpause(ctx, req.(*MsgVoteUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +294,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "SetGuardians",
+			Handler:    _Msg_SetGuardians_Handler,
+		},
+		{
+			MethodName: "VotePause",
+			Handler:    _Msg_VotePause_Handler,
+		},
+		{
+			MethodName: "VoteUnpause",
+			Handler:    _Msg_VoteUnpause_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
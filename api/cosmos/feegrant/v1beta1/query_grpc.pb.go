@@ -24,6 +24,8 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_GroupAllowances_FullMethodName     = "/cosmos.feegrant.v1beta1.Query/GroupAllowances"
+	Query_MsgTypeAllowances_FullMethodName   = "/cosmos.feegrant.v1beta1.Query/MsgTypeAllowances"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +40,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GroupAllowances returns the allowances given by an address to the members
+	// of group policies.
+	GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error)
+	// MsgTypeAllowances returns the allowances given by an address to the
+	// accounts executing a message type.
+	MsgTypeAllowances(ctx context.Context, in *QueryMsgTypeAllowancesRequest, opts ...grpc.CallOption) (*QueryMsgTypeAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +83,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error) {
+	out := new(QueryGroupAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_GroupAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgTypeAllowances(ctx context.Context, in *QueryMsgTypeAllowancesRequest, opts ...grpc.CallOption) (*QueryMsgTypeAllowancesResponse, error) {
+	out := new(QueryMsgTypeAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_MsgTypeAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -87,6 +113,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GroupAllowances returns the allowances given by an address to the members
+	// of group policies.
+	GroupAllowances(context.Context, *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error)
+	// MsgTypeAllowances returns the allowances given by an address to the
+	// accounts executing a message type.
+	MsgTypeAllowances(context.Context, *QueryMsgTypeAllowancesRequest) (*QueryMsgTypeAllowancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -103,6 +135,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) GroupAllowances(context.Context, *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowances not implemented")
+}
+func (UnimplementedQueryServer) MsgTypeAllowances(context.Context, *QueryMsgTypeAllowancesRequest) (*QueryMsgTypeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeAllowances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +208,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GroupAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAllowances(ctx, req.(*QueryGroupAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTypeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgTypeAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeAllowances(ctx, req.(*QueryMsgTypeAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +263,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "GroupAllowances",
+			Handler:    _Query_GroupAllowances_Handler,
+		},
+		{
+			MethodName: "MsgTypeAllowances",
+			Handler:    _Query_MsgTypeAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -21,9 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName        = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantGroupAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance"
+	Msg_SyncGroupAllowance_FullMethodName    = "/cosmos.feegrant.v1beta1.Msg/SyncGroupAllowance"
+	Msg_GrantMsgTypeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/GrantMsgTypeAllowance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +43,15 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantGroupAllowance grants fee allowance to the members of the group of a
+	// group policy on the granter's account.
+	GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error)
+	// SyncGroupAllowance updates the members of the group an allowance is
+	// granted to with the current members of the group.
+	SyncGroupAllowance(ctx context.Context, in *MsgSyncGroupAllowance, opts ...grpc.CallOption) (*MsgSyncGroupAllowanceResponse, error)
+	// GrantMsgTypeAllowance grants fee allowance to any account executing
+	// messages of a type on the granter's account.
+	GrantMsgTypeAllowance(ctx context.Context, in *MsgGrantMsgTypeAllowance, opts ...grpc.CallOption) (*MsgGrantMsgTypeAllowanceResponse, error)
 }
 
 type msgClient struct {
@@ -77,6 +89,33 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error) {
+	out := new(MsgGrantGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantGroupAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SyncGroupAllowance(ctx context.Context, in *MsgSyncGroupAllowance, opts ...grpc.CallOption) (*MsgSyncGroupAllowanceResponse, error) {
+	out := new(MsgSyncGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_SyncGroupAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantMsgTypeAllowance(ctx context.Context, in *MsgGrantMsgTypeAllowance, opts ...grpc.CallOption) (*MsgGrantMsgTypeAllowanceResponse, error) {
+	out := new(MsgGrantMsgTypeAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantMsgTypeAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +130,15 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantGroupAllowance grants fee allowance to the members of the group of a
+	// group policy on the granter's account.
+	GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error)
+	// SyncGroupAllowance updates the members of the group an allowance is
+	// granted to with the current members of the group.
+	SyncGroupAllowance(context.Context, *MsgSyncGroupAllowance) (*MsgSyncGroupAllowanceResponse, error)
+	// GrantMsgTypeAllowance grants fee allowance to any account executing
+	// messages of a type on the granter's account.
+	GrantMsgTypeAllowance(context.Context, *MsgGrantMsgTypeAllowance) (*MsgGrantMsgTypeAllowanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -107,6 +155,15 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGroupAllowance not implemented")
+}
+func (UnimplementedMsgServer) SyncGroupAllowance(context.Context, *MsgSyncGroupAllowance) (*MsgSyncGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGroupAllowance not implemented")
+}
+func (UnimplementedMsgServer) GrantMsgTypeAllowance(context.Context, *MsgGrantMsgTypeAllowance) (*MsgGrantMsgTypeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMsgTypeAllowance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +231,60 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantGroupAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, req.(*MsgGrantGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SyncGroupAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncGroupAllowance(ctx, req.(*MsgSyncGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantMsgTypeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantMsgTypeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantMsgTypeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantMsgTypeAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantMsgTypeAllowance(ctx, req.(*MsgGrantMsgTypeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +304,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantGroupAllowance",
+			Handler:    _Msg_GrantGroupAllowance_Handler,
+		},
+		{
+			MethodName: "SyncGroupAllowance",
+			Handler:    _Msg_SyncGroupAllowance_Handler,
+		},
+		{
+			MethodName: "GrantMsgTypeAllowance",
+			Handler:    _Msg_GrantMsgTypeAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
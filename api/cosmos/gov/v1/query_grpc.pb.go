@@ -30,6 +30,7 @@ const (
 	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
 	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
 	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ParamChanges_FullMethodName = "/cosmos.gov.v1.Query/ParamChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +55,10 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ParamChanges queries the history of the param changes of a module, oldest first.
+	//
+	// Since: x/gov v1.0.0
+	ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +150,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error) {
+	out := new(QueryParamChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ParamChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +181,10 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ParamChanges queries the history of the param changes of a module, oldest first.
+	//
+	// Since: x/gov v1.0.0
+	ParamChanges(context.Context, *QueryParamChangesRequest) (*QueryParamChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +219,9 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ParamChanges(context.Context, *QueryParamChangesRequest) (*QueryParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +397,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamChanges(ctx, req.(*QueryParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +458,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ParamChanges",
+			Handler:    _Query_ParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
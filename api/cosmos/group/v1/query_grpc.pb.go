@@ -35,6 +35,7 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_ScheduledMemberUpdates_FullMethodName = "/cosmos.group.v1.Query/ScheduledMemberUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +76,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ScheduledMemberUpdates queries the upcoming member updates of a group.
+	ScheduledMemberUpdates(ctx context.Context, in *QueryScheduledMemberUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledMemberUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +214,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ScheduledMemberUpdates(ctx context.Context, in *QueryScheduledMemberUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledMemberUpdatesResponse, error) {
+	out := new(QueryScheduledMemberUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMemberUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +261,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ScheduledMemberUpdates queries the upcoming member updates of a group.
+	ScheduledMemberUpdates(context.Context, *QueryScheduledMemberUpdatesRequest) (*QueryScheduledMemberUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +312,9 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ScheduledMemberUpdates(context.Context, *QueryScheduledMemberUpdatesRequest) (*QueryScheduledMemberUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMemberUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +580,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledMemberUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMemberUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMemberUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMemberUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMemberUpdates(ctx, req.(*QueryScheduledMemberUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +661,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ScheduledMemberUpdates",
+			Handler:    _Query_ScheduledMemberUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -35,6 +35,8 @@ const (
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
 	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_ScheduleMemberUpdate_FullMethodName            = "/cosmos.group.v1.Msg/ScheduleMemberUpdate"
+	Msg_CancelScheduledMemberUpdate_FullMethodName     = "/cosmos.group.v1.Msg/CancelScheduledMemberUpdate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -69,6 +71,10 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// ScheduleMemberUpdate schedules an update of a group member, or a membership with a term limit.
+	ScheduleMemberUpdate(ctx context.Context, in *MsgScheduleMemberUpdate, opts ...grpc.CallOption) (*MsgScheduleMemberUpdateResponse, error)
+	// CancelScheduledMemberUpdate cancels a scheduled member update.
+	CancelScheduledMemberUpdate(ctx context.Context, in *MsgCancelScheduledMemberUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledMemberUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -205,6 +211,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMemberUpdate(ctx context.Context, in *MsgScheduleMemberUpdate, opts ...grpc.CallOption) (*MsgScheduleMemberUpdateResponse, error) {
+	out := new(MsgScheduleMemberUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMemberUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMemberUpdate(ctx context.Context, in *MsgCancelScheduledMemberUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledMemberUpdateResponse, error) {
+	out := new(MsgCancelScheduledMemberUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledMemberUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -237,6 +261,10 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// ScheduleMemberUpdate schedules an update of a group member, or a membership with a term limit.
+	ScheduleMemberUpdate(context.Context, *MsgScheduleMemberUpdate) (*MsgScheduleMemberUpdateResponse, error)
+	// CancelScheduledMemberUpdate cancels a scheduled member update.
+	CancelScheduledMemberUpdate(context.Context, *MsgCancelScheduledMemberUpdate) (*MsgCancelScheduledMemberUpdateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -286,6 +314,12 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) ScheduleMemberUpdate(context.Context, *MsgScheduleMemberUpdate) (*MsgScheduleMemberUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMemberUpdate not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledMemberUpdate(context.Context, *MsgCancelScheduledMemberUpdate) (*MsgCancelScheduledMemberUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMemberUpdate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -551,6 +585,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMemberUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMemberUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMemberUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMemberUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMemberUpdate(ctx, req.(*MsgScheduleMemberUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMemberUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMemberUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMemberUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledMemberUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMemberUpdate(ctx, req.(*MsgCancelScheduledMemberUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -614,6 +684,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "ScheduleMemberUpdate",
+			Handler:    _Msg_ScheduleMemberUpdate_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMemberUpdate",
+			Handler:    _Msg_CancelScheduledMemberUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
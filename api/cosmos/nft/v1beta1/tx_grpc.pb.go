@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_Send_FullMethodName         = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_BatchMint_FullMethodName    = "/cosmos.nft.v1beta1.Msg/BatchMint"
+	Msg_BatchBurn_FullMethodName    = "/cosmos.nft.v1beta1.Msg/BatchBurn"
+	Msg_BatchSend_FullMethodName    = "/cosmos.nft.v1beta1.Msg/BatchSend"
+	Msg_UpdateParams_FullMethodName = "/cosmos.nft.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +32,18 @@ const (
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// BatchMint defines a method to mint a batch of nfts, reporting the result
+	// for each of them.
+	BatchMint(ctx context.Context, in *MsgBatchMint, opts ...grpc.CallOption) (*MsgBatchMintResponse, error)
+	// BatchBurn defines a method to burn a batch of nfts of their owner,
+	// reporting the result for each of them.
+	BatchBurn(ctx context.Context, in *MsgBatchBurn, opts ...grpc.CallOption) (*MsgBatchBurnResponse, error)
+	// BatchSend defines a method to send a batch of nfts from one account to
+	// another account, reporting the result for each of them.
+	BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error)
+	// UpdateParams defines a governance operation for updating the nft module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +63,60 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) BatchMint(ctx context.Context, in *MsgBatchMint, opts ...grpc.CallOption) (*MsgBatchMintResponse, error) {
+	out := new(MsgBatchMintResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchBurn(ctx context.Context, in *MsgBatchBurn, opts ...grpc.CallOption) (*MsgBatchBurnResponse, error) {
+	out := new(MsgBatchBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchBurn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error) {
+	out := new(MsgBatchSendResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchSend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// BatchMint defines a method to mint a batch of nfts, reporting the result
+	// for each of them.
+	BatchMint(context.Context, *MsgBatchMint) (*MsgBatchMintResponse, error)
+	// BatchBurn defines a method to burn a batch of nfts of their owner,
+	// reporting the result for each of them.
+	BatchBurn(context.Context, *MsgBatchBurn) (*MsgBatchBurnResponse, error)
+	// BatchSend defines a method to send a batch of nfts from one account to
+	// another account, reporting the result for each of them.
+	BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error)
+	// UpdateParams defines a governance operation for updating the nft module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +127,18 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) BatchMint(context.Context, *MsgBatchMint) (*MsgBatchMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMint not implemented")
+}
+func (UnimplementedMsgServer) BatchBurn(context.Context, *MsgBatchBurn) (*MsgBatchBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchBurn not implemented")
+}
+func (UnimplementedMsgServer) BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSend not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +170,78 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchMint(ctx, req.(*MsgBatchMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchBurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchBurn(ctx, req.(*MsgBatchBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSend(ctx, req.(*MsgBatchSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +253,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "BatchMint",
+			Handler:    _Msg_BatchMint_Handler,
+		},
+		{
+			MethodName: "BatchBurn",
+			Handler:    _Msg_BatchBurn_Handler,
+		},
+		{
+			MethodName: "BatchSend",
+			Handler:    _Msg_BatchSend_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -21,6 +21,11 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
 	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_YieldStrategy_FullMethodName   = "/cosmos.protocolpool.v1.Query/YieldStrategy"
+	Query_YieldStrategies_FullMethodName = "/cosmos.protocolpool.v1.Query/YieldStrategies"
+	Query_SubPool_FullMethodName         = "/cosmos.protocolpool.v1.Query/SubPool"
+	Query_SubPools_FullMethodName        = "/cosmos.protocolpool.v1.Query/SubPools"
+	Query_Donations_FullMethodName       = "/cosmos.protocolpool.v1.Query/Donations"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +36,17 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// YieldStrategy queries the state of a registered yield strategy.
+	YieldStrategy(ctx context.Context, in *QueryYieldStrategyRequest, opts ...grpc.CallOption) (*QueryYieldStrategyResponse, error)
+	// YieldStrategies queries the state of all the registered yield strategies.
+	YieldStrategies(ctx context.Context, in *QueryYieldStrategiesRequest, opts ...grpc.CallOption) (*QueryYieldStrategiesResponse, error)
+	// SubPool queries a sub-pool by name.
+	SubPool(ctx context.Context, in *QuerySubPoolRequest, opts ...grpc.CallOption) (*QuerySubPoolResponse, error)
+	// SubPools queries all the sub-pools.
+	SubPools(ctx context.Context, in *QuerySubPoolsRequest, opts ...grpc.CallOption) (*QuerySubPoolsResponse, error)
+	// Donations queries the cumulative donations to the community pool, of all
+	// the donors or of a single donor.
+	Donations(ctx context.Context, in *QueryDonationsRequest, opts ...grpc.CallOption) (*QueryDonationsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +75,51 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) YieldStrategy(ctx context.Context, in *QueryYieldStrategyRequest, opts ...grpc.CallOption) (*QueryYieldStrategyResponse, error) {
+	out := new(QueryYieldStrategyResponse)
+	err := c.cc.Invoke(ctx, Query_YieldStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldStrategies(ctx context.Context, in *QueryYieldStrategiesRequest, opts ...grpc.CallOption) (*QueryYieldStrategiesResponse, error) {
+	out := new(QueryYieldStrategiesResponse)
+	err := c.cc.Invoke(ctx, Query_YieldStrategies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubPool(ctx context.Context, in *QuerySubPoolRequest, opts ...grpc.CallOption) (*QuerySubPoolResponse, error) {
+	out := new(QuerySubPoolResponse)
+	err := c.cc.Invoke(ctx, Query_SubPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubPools(ctx context.Context, in *QuerySubPoolsRequest, opts ...grpc.CallOption) (*QuerySubPoolsResponse, error) {
+	out := new(QuerySubPoolsResponse)
+	err := c.cc.Invoke(ctx, Query_SubPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Donations(ctx context.Context, in *QueryDonationsRequest, opts ...grpc.CallOption) (*QueryDonationsResponse, error) {
+	out := new(QueryDonationsResponse)
+	err := c.cc.Invoke(ctx, Query_Donations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +128,17 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// YieldStrategy queries the state of a registered yield strategy.
+	YieldStrategy(context.Context, *QueryYieldStrategyRequest) (*QueryYieldStrategyResponse, error)
+	// YieldStrategies queries the state of all the registered yield strategies.
+	YieldStrategies(context.Context, *QueryYieldStrategiesRequest) (*QueryYieldStrategiesResponse, error)
+	// SubPool queries a sub-pool by name.
+	SubPool(context.Context, *QuerySubPoolRequest) (*QuerySubPoolResponse, error)
+	// SubPools queries all the sub-pools.
+	SubPools(context.Context, *QuerySubPoolsRequest) (*QuerySubPoolsResponse, error)
+	// Donations queries the cumulative donations to the community pool, of all
+	// the donors or of a single donor.
+	Donations(context.Context, *QueryDonationsRequest) (*QueryDonationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +152,21 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) YieldStrategy(context.Context, *QueryYieldStrategyRequest) (*QueryYieldStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldStrategy not implemented")
+}
+func (UnimplementedQueryServer) YieldStrategies(context.Context, *QueryYieldStrategiesRequest) (*QueryYieldStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldStrategies not implemented")
+}
+func (UnimplementedQueryServer) SubPool(context.Context, *QuerySubPoolRequest) (*QuerySubPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubPool not implemented")
+}
+func (UnimplementedQueryServer) SubPools(context.Context, *QuerySubPoolsRequest) (*QuerySubPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubPools not implemented")
+}
+func (UnimplementedQueryServer) Donations(context.Context, *QueryDonationsRequest) (*QueryDonationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Donations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +216,96 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_YieldStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldStrategy(ctx, req.(*QueryYieldStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldStrategies(ctx, req.(*QueryYieldStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubPool(ctx, req.(*QuerySubPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubPools(ctx, req.(*QuerySubPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Donations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Donations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Donations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Donations(ctx, req.(*QueryDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +321,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "YieldStrategy",
+			Handler:    _Query_YieldStrategy_Handler,
+		},
+		{
+			MethodName: "YieldStrategies",
+			Handler:    _Query_YieldStrategies_Handler,
+		},
+		{
+			MethodName: "SubPool",
+			Handler:    _Query_SubPool_Handler,
+		},
+		{
+			MethodName: "SubPools",
+			Handler:    _Query_SubPools_Handler,
+		},
+		{
+			MethodName: "Donations",
+			Handler:    _Query_Donations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
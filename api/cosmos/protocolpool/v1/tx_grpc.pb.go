@@ -19,12 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_FundCommunityPool_FullMethodName    = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
-	Msg_CommunityPoolSpend_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
-	Msg_SubmitBudgetProposal_FullMethodName = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
-	Msg_ClaimBudget_FullMethodName          = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
-	Msg_CreateContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
-	Msg_CancelContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_FundCommunityPool_FullMethodName              = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
+	Msg_CommunityPoolSpend_FullMethodName             = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
+	Msg_SubmitBudgetProposal_FullMethodName           = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
+	Msg_ClaimBudget_FullMethodName                    = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
+	Msg_CreateContinuousFund_FullMethodName           = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
+	Msg_CancelContinuousFund_FullMethodName           = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_SetYieldStrategyCap_FullMethodName            = "/cosmos.protocolpool.v1.Msg/SetYieldStrategyCap"
+	Msg_DepositToYieldStrategy_FullMethodName         = "/cosmos.protocolpool.v1.Msg/DepositToYieldStrategy"
+	Msg_WithdrawFromYieldStrategy_FullMethodName      = "/cosmos.protocolpool.v1.Msg/WithdrawFromYieldStrategy"
+	Msg_EmergencyWithdrawYieldStrategy_FullMethodName = "/cosmos.protocolpool.v1.Msg/EmergencyWithdrawYieldStrategy"
+	Msg_HarvestYield_FullMethodName                   = "/cosmos.protocolpool.v1.Msg/HarvestYield"
+	Msg_CreateSubPool_FullMethodName                  = "/cosmos.protocolpool.v1.Msg/CreateSubPool"
+	Msg_SetSubPoolCeiling_FullMethodName              = "/cosmos.protocolpool.v1.Msg/SetSubPoolCeiling"
+	Msg_AllocateToSubPool_FullMethodName              = "/cosmos.protocolpool.v1.Msg/AllocateToSubPool"
+	Msg_DisburseFromSubPool_FullMethodName            = "/cosmos.protocolpool.v1.Msg/DisburseFromSubPool"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,6 +56,33 @@ type MsgClient interface {
 	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// SetYieldStrategyCap defines a governance operation for setting the cap of
+	// a yield strategy. A zero cap disables the strategy.
+	SetYieldStrategyCap(ctx context.Context, in *MsgSetYieldStrategyCap, opts ...grpc.CallOption) (*MsgSetYieldStrategyCapResponse, error)
+	// DepositToYieldStrategy defines a governance operation for depositing
+	// community pool funds to a yield strategy.
+	DepositToYieldStrategy(ctx context.Context, in *MsgDepositToYieldStrategy, opts ...grpc.CallOption) (*MsgDepositToYieldStrategyResponse, error)
+	// WithdrawFromYieldStrategy defines a governance operation for withdrawing
+	// principal from a yield strategy back to the community pool.
+	WithdrawFromYieldStrategy(ctx context.Context, in *MsgWithdrawFromYieldStrategy, opts ...grpc.CallOption) (*MsgWithdrawFromYieldStrategyResponse, error)
+	// EmergencyWithdrawYieldStrategy defines a governance operation for
+	// disabling a yield strategy and withdrawing all the funds deposited to it.
+	EmergencyWithdrawYieldStrategy(ctx context.Context, in *MsgEmergencyWithdrawYieldStrategy, opts ...grpc.CallOption) (*MsgEmergencyWithdrawYieldStrategyResponse, error)
+	// HarvestYield defines a method to harvest the yield of a yield strategy
+	// into the community pool. Anyone can harvest it.
+	HarvestYield(ctx context.Context, in *MsgHarvestYield, opts ...grpc.CallOption) (*MsgHarvestYieldResponse, error)
+	// CreateSubPool defines a governance operation for creating a sub-pool
+	// managed by its own authority.
+	CreateSubPool(ctx context.Context, in *MsgCreateSubPool, opts ...grpc.CallOption) (*MsgCreateSubPoolResponse, error)
+	// SetSubPoolCeiling defines a governance operation for replacing the budget
+	// ceiling of a sub-pool.
+	SetSubPoolCeiling(ctx context.Context, in *MsgSetSubPoolCeiling, opts ...grpc.CallOption) (*MsgSetSubPoolCeilingResponse, error)
+	// AllocateToSubPool defines a governance operation for allocating community
+	// pool funds to a sub-pool.
+	AllocateToSubPool(ctx context.Context, in *MsgAllocateToSubPool, opts ...grpc.CallOption) (*MsgAllocateToSubPoolResponse, error)
+	// DisburseFromSubPool defines a method for the authority of a sub-pool to
+	// send funds from its balance to a recipient.
+	DisburseFromSubPool(ctx context.Context, in *MsgDisburseFromSubPool, opts ...grpc.CallOption) (*MsgDisburseFromSubPoolResponse, error)
 }
 
 type msgClient struct {
@@ -111,6 +147,87 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) SetYieldStrategyCap(ctx context.Context, in *MsgSetYieldStrategyCap, opts ...grpc.CallOption) (*MsgSetYieldStrategyCapResponse, error) {
+	out := new(MsgSetYieldStrategyCapResponse)
+	err := c.cc.Invoke(ctx, Msg_SetYieldStrategyCap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositToYieldStrategy(ctx context.Context, in *MsgDepositToYieldStrategy, opts ...grpc.CallOption) (*MsgDepositToYieldStrategyResponse, error) {
+	out := new(MsgDepositToYieldStrategyResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositToYieldStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromYieldStrategy(ctx context.Context, in *MsgWithdrawFromYieldStrategy, opts ...grpc.CallOption) (*MsgWithdrawFromYieldStrategyResponse, error) {
+	out := new(MsgWithdrawFromYieldStrategyResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawFromYieldStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EmergencyWithdrawYieldStrategy(ctx context.Context, in *MsgEmergencyWithdrawYieldStrategy, opts ...grpc.CallOption) (*MsgEmergencyWithdrawYieldStrategyResponse, error) {
+	out := new(MsgEmergencyWithdrawYieldStrategyResponse)
+	err := c.cc.Invoke(ctx, Msg_EmergencyWithdrawYieldStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HarvestYield(ctx context.Context, in *MsgHarvestYield, opts ...grpc.CallOption) (*MsgHarvestYieldResponse, error) {
+	out := new(MsgHarvestYieldResponse)
+	err := c.cc.Invoke(ctx, Msg_HarvestYield_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSubPool(ctx context.Context, in *MsgCreateSubPool, opts ...grpc.CallOption) (*MsgCreateSubPoolResponse, error) {
+	out := new(MsgCreateSubPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSubPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSubPoolCeiling(ctx context.Context, in *MsgSetSubPoolCeiling, opts ...grpc.CallOption) (*MsgSetSubPoolCeilingResponse, error) {
+	out := new(MsgSetSubPoolCeilingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSubPoolCeiling_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AllocateToSubPool(ctx context.Context, in *MsgAllocateToSubPool, opts ...grpc.CallOption) (*MsgAllocateToSubPoolResponse, error) {
+	out := new(MsgAllocateToSubPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_AllocateToSubPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisburseFromSubPool(ctx context.Context, in *MsgDisburseFromSubPool, opts ...grpc.CallOption) (*MsgDisburseFromSubPoolResponse, error) {
+	out := new(MsgDisburseFromSubPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_DisburseFromSubPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -131,6 +248,33 @@ type MsgServer interface {
 	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// SetYieldStrategyCap defines a governance operation for setting the cap of
+	// a yield strategy. A zero cap disables the strategy.
+	SetYieldStrategyCap(context.Context, *MsgSetYieldStrategyCap) (*MsgSetYieldStrategyCapResponse, error)
+	// DepositToYieldStrategy defines a governance operation for depositing
+	// community pool funds to a yield strategy.
+	DepositToYieldStrategy(context.Context, *MsgDepositToYieldStrategy) (*MsgDepositToYieldStrategyResponse, error)
+	// WithdrawFromYieldStrategy defines a governance operation for withdrawing
+	// principal from a yield strategy back to the community pool.
+	WithdrawFromYieldStrategy(context.Context, *MsgWithdrawFromYieldStrategy) (*MsgWithdrawFromYieldStrategyResponse, error)
+	// EmergencyWithdrawYieldStrategy defines a governance operation for
+	// disabling a yield strategy and withdrawing all the funds deposited to it.
+	EmergencyWithdrawYieldStrategy(context.Context, *MsgEmergencyWithdrawYieldStrategy) (*MsgEmergencyWithdrawYieldStrategyResponse, error)
+	// HarvestYield defines a method to harvest the yield of a yield strategy
+	// into the community pool. Anyone can harvest it.
+	HarvestYield(context.Context, *MsgHarvestYield) (*MsgHarvestYieldResponse, error)
+	// CreateSubPool defines a governance operation for creating a sub-pool
+	// managed by its own authority.
+	CreateSubPool(context.Context, *MsgCreateSubPool) (*MsgCreateSubPoolResponse, error)
+	// SetSubPoolCeiling defines a governance operation for replacing the budget
+	// ceiling of a sub-pool.
+	SetSubPoolCeiling(context.Context, *MsgSetSubPoolCeiling) (*MsgSetSubPoolCeilingResponse, error)
+	// AllocateToSubPool defines a governance operation for allocating community
+	// pool funds to a sub-pool.
+	AllocateToSubPool(context.Context, *MsgAllocateToSubPool) (*MsgAllocateToSubPoolResponse, error)
+	// DisburseFromSubPool defines a method for the authority of a sub-pool to
+	// send funds from its balance to a recipient.
+	DisburseFromSubPool(context.Context, *MsgDisburseFromSubPool) (*MsgDisburseFromSubPoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -156,6 +300,33 @@ func (UnimplementedMsgServer) CreateContinuousFund(context.Context, *MsgCreateCo
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) SetYieldStrategyCap(context.Context, *MsgSetYieldStrategyCap) (*MsgSetYieldStrategyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldStrategyCap not implemented")
+}
+func (UnimplementedMsgServer) DepositToYieldStrategy(context.Context, *MsgDepositToYieldStrategy) (*MsgDepositToYieldStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToYieldStrategy not implemented")
+}
+func (UnimplementedMsgServer) WithdrawFromYieldStrategy(context.Context, *MsgWithdrawFromYieldStrategy) (*MsgWithdrawFromYieldStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromYieldStrategy not implemented")
+}
+func (UnimplementedMsgServer) EmergencyWithdrawYieldStrategy(context.Context, *MsgEmergencyWithdrawYieldStrategy) (*MsgEmergencyWithdrawYieldStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyWithdrawYieldStrategy not implemented")
+}
+func (UnimplementedMsgServer) HarvestYield(context.Context, *MsgHarvestYield) (*MsgHarvestYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HarvestYield not implemented")
+}
+func (UnimplementedMsgServer) CreateSubPool(context.Context, *MsgCreateSubPool) (*MsgCreateSubPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubPool not implemented")
+}
+func (UnimplementedMsgServer) SetSubPoolCeiling(context.Context, *MsgSetSubPoolCeiling) (*MsgSetSubPoolCeilingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubPoolCeiling not implemented")
+}
+func (UnimplementedMsgServer) AllocateToSubPool(context.Context, *MsgAllocateToSubPool) (*MsgAllocateToSubPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateToSubPool not implemented")
+}
+func (UnimplementedMsgServer) DisburseFromSubPool(context.Context, *MsgDisburseFromSubPool) (*MsgDisburseFromSubPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseFromSubPool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -277,6 +448,168 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetYieldStrategyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldStrategyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldStrategyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetYieldStrategyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldStrategyCap(ctx, req.(*MsgSetYieldStrategyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToYieldStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToYieldStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToYieldStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositToYieldStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToYieldStrategy(ctx, req.(*MsgDepositToYieldStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromYieldStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromYieldStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromYieldStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawFromYieldStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromYieldStrategy(ctx, req.(*MsgWithdrawFromYieldStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyWithdrawYieldStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyWithdrawYieldStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyWithdrawYieldStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EmergencyWithdrawYieldStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyWithdrawYieldStrategy(ctx, req.(*MsgEmergencyWithdrawYieldStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HarvestYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHarvestYield)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HarvestYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HarvestYield_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HarvestYield(ctx, req.(*MsgHarvestYield))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSubPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubPool(ctx, req.(*MsgCreateSubPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSubPoolCeiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSubPoolCeiling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSubPoolCeiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSubPoolCeiling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSubPoolCeiling(ctx, req.(*MsgSetSubPoolCeiling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AllocateToSubPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateToSubPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateToSubPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AllocateToSubPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateToSubPool(ctx, req.(*MsgAllocateToSubPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisburseFromSubPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisburseFromSubPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisburseFromSubPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisburseFromSubPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisburseFromSubPool(ctx, req.(*MsgDisburseFromSubPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -308,6 +641,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "SetYieldStrategyCap",
+			Handler:    _Msg_SetYieldStrategyCap_Handler,
+		},
+		{
+			MethodName: "DepositToYieldStrategy",
+			Handler:    _Msg_DepositToYieldStrategy_Handler,
+		},
+		{
+			MethodName: "WithdrawFromYieldStrategy",
+			Handler:    _Msg_WithdrawFromYieldStrategy_Handler,
+		},
+		{
+			MethodName: "EmergencyWithdrawYieldStrategy",
+			Handler:    _Msg_EmergencyWithdrawYieldStrategy_Handler,
+		},
+		{
+			MethodName: "HarvestYield",
+			Handler:    _Msg_HarvestYield_Handler,
+		},
+		{
+			MethodName: "CreateSubPool",
+			Handler:    _Msg_CreateSubPool_Handler,
+		},
+		{
+			MethodName: "SetSubPoolCeiling",
+			Handler:    _Msg_SetSubPoolCeiling_Handler,
+		},
+		{
+			MethodName: "AllocateToSubPool",
+			Handler:    _Msg_AllocateToSubPool_Handler,
+		},
+		{
+			MethodName: "DisburseFromSubPool",
+			Handler:    _Msg_DisburseFromSubPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
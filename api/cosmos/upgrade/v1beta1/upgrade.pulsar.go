@@ -2222,6 +2222,715 @@ func (x *fastReflection_ModuleVersion) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_UpgradeReadiness                    protoreflect.MessageDescriptor
+	fd_UpgradeReadiness_plan               protoreflect.FieldDescriptor
+	fd_UpgradeReadiness_blocks_remaining   protoreflect.FieldDescriptor
+	fd_UpgradeReadiness_handler_registered protoreflect.FieldDescriptor
+	fd_UpgradeReadiness_binary_path        protoreflect.FieldDescriptor
+	fd_UpgradeReadiness_binary_present     protoreflect.FieldDescriptor
+	fd_UpgradeReadiness_skipped            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_upgrade_v1beta1_upgrade_proto_init()
+	md_UpgradeReadiness = File_cosmos_upgrade_v1beta1_upgrade_proto.Messages().ByName("UpgradeReadiness")
+	fd_UpgradeReadiness_plan = md_UpgradeReadiness.Fields().ByName("plan")
+	fd_UpgradeReadiness_blocks_remaining = md_UpgradeReadiness.Fields().ByName("blocks_remaining")
+	fd_UpgradeReadiness_handler_registered = md_UpgradeReadiness.Fields().ByName("handler_registered")
+	fd_UpgradeReadiness_binary_path = md_UpgradeReadiness.Fields().ByName("binary_path")
+	fd_UpgradeReadiness_binary_present = md_UpgradeReadiness.Fields().ByName("binary_present")
+	fd_UpgradeReadiness_skipped = md_UpgradeReadiness.Fields().ByName("skipped")
+}
+
+var _ protoreflect.Message = (*fastReflection_UpgradeReadiness)(nil)
+
+type fastReflection_UpgradeReadiness UpgradeReadiness
+
+func (x *UpgradeReadiness) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UpgradeReadiness)(x)
+}
+
+func (x *UpgradeReadiness) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_upgrade_v1beta1_upgrade_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UpgradeReadiness_messageType fastReflection_UpgradeReadiness_messageType
+var _ protoreflect.MessageType = fastReflection_UpgradeReadiness_messageType{}
+
+type fastReflection_UpgradeReadiness_messageType struct{}
+
+func (x fastReflection_UpgradeReadiness_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UpgradeReadiness)(nil)
+}
+func (x fastReflection_UpgradeReadiness_messageType) New() protoreflect.Message {
+	return new(fastReflection_UpgradeReadiness)
+}
+func (x fastReflection_UpgradeReadiness_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UpgradeReadiness
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UpgradeReadiness) Descriptor() protoreflect.MessageDescriptor {
+	return md_UpgradeReadiness
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UpgradeReadiness) Type() protoreflect.MessageType {
+	return _fastReflection_UpgradeReadiness_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UpgradeReadiness) New() protoreflect.Message {
+	return new(fastReflection_UpgradeReadiness)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UpgradeReadiness) Interface() protoreflect.ProtoMessage {
+	return (*UpgradeReadiness)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UpgradeReadiness) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Plan != nil {
+		value := protoreflect.ValueOfMessage(x.Plan.ProtoReflect())
+		if !f(fd_UpgradeReadiness_plan, value) {
+			return
+		}
+	}
+	if x.BlocksRemaining != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlocksRemaining)
+		if !f(fd_UpgradeReadiness_blocks_remaining, value) {
+			return
+		}
+	}
+	if x.HandlerRegistered != false {
+		value := protoreflect.ValueOfBool(x.HandlerRegistered)
+		if !f(fd_UpgradeReadiness_handler_registered, value) {
+			return
+		}
+	}
+	if x.BinaryPath != "" {
+		value := protoreflect.ValueOfString(x.BinaryPath)
+		if !f(fd_UpgradeReadiness_binary_path, value) {
+			return
+		}
+	}
+	if x.BinaryPresent != false {
+		value := protoreflect.ValueOfBool(x.BinaryPresent)
+		if !f(fd_UpgradeReadiness_binary_present, value) {
+			return
+		}
+	}
+	if x.Skipped != false {
+		value := protoreflect.ValueOfBool(x.Skipped)
+		if !f(fd_UpgradeReadiness_skipped, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UpgradeReadiness) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		return x.Plan != nil
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		return x.BlocksRemaining != int64(0)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		return x.HandlerRegistered != false
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		return x.BinaryPath != ""
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		return x.BinaryPresent != false
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		return x.Skipped != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UpgradeReadiness) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		x.Plan = nil
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		x.BlocksRemaining = int64(0)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		x.HandlerRegistered = false
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		x.BinaryPath = ""
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		x.BinaryPresent = false
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		x.Skipped = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UpgradeReadiness) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		value := x.Plan
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		value := x.BlocksRemaining
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		value := x.HandlerRegistered
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		value := x.BinaryPath
+		return protoreflect.ValueOfString(value)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		value := x.BinaryPresent
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		value := x.Skipped
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UpgradeReadiness) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		x.Plan = value.Message().Interface().(*Plan)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		x.BlocksRemaining = value.Int()
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		x.HandlerRegistered = value.Bool()
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		x.BinaryPath = value.Interface().(string)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		x.BinaryPresent = value.Bool()
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		x.Skipped = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UpgradeReadiness) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		if x.Plan == nil {
+			x.Plan = new(Plan)
+		}
+		return protoreflect.ValueOfMessage(x.Plan.ProtoReflect())
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		panic(fmt.Errorf("field blocks_remaining of message cosmos.upgrade.v1beta1.UpgradeReadiness is not mutable"))
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		panic(fmt.Errorf("field handler_registered of message cosmos.upgrade.v1beta1.UpgradeReadiness is not mutable"))
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		panic(fmt.Errorf("field binary_path of message cosmos.upgrade.v1beta1.UpgradeReadiness is not mutable"))
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		panic(fmt.Errorf("field binary_present of message cosmos.upgrade.v1beta1.UpgradeReadiness is not mutable"))
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		panic(fmt.Errorf("field skipped of message cosmos.upgrade.v1beta1.UpgradeReadiness is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UpgradeReadiness) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.plan":
+		m := new(Plan)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.blocks_remaining":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.handler_registered":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_path":
+		return protoreflect.ValueOfString("")
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.binary_present":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.upgrade.v1beta1.UpgradeReadiness.skipped":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.upgrade.v1beta1.UpgradeReadiness"))
+		}
+		panic(fmt.Errorf("message cosmos.upgrade.v1beta1.UpgradeReadiness does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UpgradeReadiness) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.upgrade.v1beta1.UpgradeReadiness", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UpgradeReadiness) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UpgradeReadiness) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UpgradeReadiness) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UpgradeReadiness) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UpgradeReadiness)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Plan != nil {
+			l = options.Size(x.Plan)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlocksRemaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksRemaining))
+		}
+		if x.HandlerRegistered {
+			n += 2
+		}
+		l = len(x.BinaryPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BinaryPresent {
+			n += 2
+		}
+		if x.Skipped {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UpgradeReadiness)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Skipped {
+			i--
+			if x.Skipped {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.BinaryPresent {
+			i--
+			if x.BinaryPresent {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.BinaryPath) > 0 {
+			i -= len(x.BinaryPath)
+			copy(dAtA[i:], x.BinaryPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BinaryPath)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.HandlerRegistered {
+			i--
+			if x.HandlerRegistered {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BlocksRemaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksRemaining))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Plan != nil {
+			encoded, err := options.Marshal(x.Plan)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UpgradeReadiness)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UpgradeReadiness: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Plan == nil {
+					x.Plan = &Plan{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Plan); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+				}
+				x.BlocksRemaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksRemaining |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HandlerRegistered", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.HandlerRegistered = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BinaryPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BinaryPresent", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BinaryPresent = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Skipped = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -2484,6 +3193,92 @@ func (x *ModuleVersion) GetVersion() uint64 {
 	return 0
 }
 
+// UpgradeReadiness reports how ready a node is for the scheduled upgrade.
+type UpgradeReadiness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// plan is the scheduled upgrade plan.
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// blocks_remaining is the number of blocks before the upgrade height, 0 once
+	// it is reached.
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// handler_registered tells whether the running binary registers the upgrade
+	// handler of the plan, i.e. is the upgraded binary.
+	HandlerRegistered bool `protobuf:"varint,3,opt,name=handler_registered,json=handlerRegistered,proto3" json:"handler_registered,omitempty"`
+	// binary_path is the path at which cosmovisor expects the upgraded binary,
+	// empty if the node doesn't run under cosmovisor.
+	BinaryPath string `protobuf:"bytes,4,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+	// binary_present tells whether the upgraded binary is installed at
+	// binary_path.
+	BinaryPresent bool `protobuf:"varint,5,opt,name=binary_present,json=binaryPresent,proto3" json:"binary_present,omitempty"`
+	// skipped tells whether the node is configured to skip the upgrade.
+	Skipped bool `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *UpgradeReadiness) Reset() {
+	*x = UpgradeReadiness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_upgrade_v1beta1_upgrade_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeReadiness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeReadiness) ProtoMessage() {}
+
+// Deprecated: Use UpgradeReadiness.ProtoReflect.Descriptor instead.
+func (*UpgradeReadiness) Descriptor() ([]byte, []int) {
+	return file_cosmos_upgrade_v1beta1_upgrade_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpgradeReadiness) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *UpgradeReadiness) GetBlocksRemaining() int64 {
+	if x != nil {
+		return x.BlocksRemaining
+	}
+	return 0
+}
+
+func (x *UpgradeReadiness) GetHandlerRegistered() bool {
+	if x != nil {
+		return x.HandlerRegistered
+	}
+	return false
+}
+
+func (x *UpgradeReadiness) GetBinaryPath() string {
+	if x != nil {
+		return x.BinaryPath
+	}
+	return ""
+}
+
+func (x *UpgradeReadiness) GetBinaryPresent() bool {
+	if x != nil {
+		return x.BinaryPresent
+	}
+	return false
+}
+
+func (x *UpgradeReadiness) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
 var File_cosmos_upgrade_v1beta1_upgrade_proto protoreflect.FileDescriptor
 
 var file_cosmos_upgrade_v1beta1_upgrade_proto_rawDesc = []byte{
@@ -2543,21 +3338,38 @@ var file_cosmos_upgrade_v1beta1_upgrade_proto_rawDesc = []byte{
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x42, 0xe0, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
-	0x0c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58, 0xaa, 0x02, 0x16,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xc8,
-	0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x8b, 0x02, 0x0a, 0x10, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a,
+	0x12, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x42, 0xe0,
+	0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58, 0xaa, 0x02, 0x16, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x22,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xc8, 0xe1, 0x1e,
+	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2572,24 +3384,26 @@ func file_cosmos_upgrade_v1beta1_upgrade_proto_rawDescGZIP() []byte {
 	return file_cosmos_upgrade_v1beta1_upgrade_proto_rawDescData
 }
 
-var file_cosmos_upgrade_v1beta1_upgrade_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cosmos_upgrade_v1beta1_upgrade_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cosmos_upgrade_v1beta1_upgrade_proto_goTypes = []interface{}{
 	(*Plan)(nil),                          // 0: cosmos.upgrade.v1beta1.Plan
 	(*SoftwareUpgradeProposal)(nil),       // 1: cosmos.upgrade.v1beta1.SoftwareUpgradeProposal
 	(*CancelSoftwareUpgradeProposal)(nil), // 2: cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal
 	(*ModuleVersion)(nil),                 // 3: cosmos.upgrade.v1beta1.ModuleVersion
-	(*timestamppb.Timestamp)(nil),         // 4: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                     // 5: google.protobuf.Any
+	(*UpgradeReadiness)(nil),              // 4: cosmos.upgrade.v1beta1.UpgradeReadiness
+	(*timestamppb.Timestamp)(nil),         // 5: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                     // 6: google.protobuf.Any
 }
 var file_cosmos_upgrade_v1beta1_upgrade_proto_depIdxs = []int32{
-	4, // 0: cosmos.upgrade.v1beta1.Plan.time:type_name -> google.protobuf.Timestamp
-	5, // 1: cosmos.upgrade.v1beta1.Plan.upgraded_client_state:type_name -> google.protobuf.Any
+	5, // 0: cosmos.upgrade.v1beta1.Plan.time:type_name -> google.protobuf.Timestamp
+	6, // 1: cosmos.upgrade.v1beta1.Plan.upgraded_client_state:type_name -> google.protobuf.Any
 	0, // 2: cosmos.upgrade.v1beta1.SoftwareUpgradeProposal.plan:type_name -> cosmos.upgrade.v1beta1.Plan
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0, // 3: cosmos.upgrade.v1beta1.UpgradeReadiness.plan:type_name -> cosmos.upgrade.v1beta1.Plan
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_upgrade_v1beta1_upgrade_proto_init() }
@@ -2646,6 +3460,18 @@ func file_cosmos_upgrade_v1beta1_upgrade_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_upgrade_v1beta1_upgrade_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeReadiness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2653,7 +3479,7 @@ func file_cosmos_upgrade_v1beta1_upgrade_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_upgrade_v1beta1_upgrade_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,7 @@ const (
 	Msg_CreateVestingAccount_FullMethodName         = "/cosmos.vesting.v1beta1.Msg/CreateVestingAccount"
 	Msg_CreatePermanentLockedAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreatePermanentLockedAccount"
 	Msg_CreatePeriodicVestingAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreatePeriodicVestingAccount"
+	Msg_AmendVestingSchedule_FullMethodName         = "/cosmos.vesting.v1beta1.Msg/AmendVestingSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +42,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// AmendVestingSchedule defines a governance operation amending the schedule
+	// of a vesting account, either clawing back its vesting coins or extending
+	// its vesting. The authority is defined in the x/auth keeper.
+	AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -78,6 +83,15 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
+func (c *msgClient) AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error) {
+	out := new(MsgAmendVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendVestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +109,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// AmendVestingSchedule defines a governance operation amending the schedule
+	// of a vesting account, either clawing back its vesting coins or extending
+	// its vesting. The authority is defined in the x/auth keeper.
+	AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -111,6 +129,9 @@ func (UnimplementedMsgServer) CreatePermanentLockedAccount(context.Context, *Msg
 func (UnimplementedMsgServer) CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendVestingSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -178,6 +199,24 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendVestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, req.(*MsgAmendVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +236,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "AmendVestingSchedule",
+			Handler:    _Msg_AmendVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -11,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -160,7 +162,7 @@ func (app *BaseApp) Info(_ *abci.RequestInfo) (*abci.ResponseInfo, error) {
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
-func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
+func (app *BaseApp) Query(ctx context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
 	// add panic recovery for all queries
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
@@ -179,6 +181,12 @@ func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci
 	telemetry.IncrCounter(1, "query", req.Path)
 	defer telemetry.MeasureSince(time.Now(), req.Path)
 
+	release, err := app.queryScheduler.Acquire(ctx, QueryPriorityFromContext(ctx, QueryPriorityLow))
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()), app.trace), nil
+	}
+	defer release()
+
 	if req.Path == QueryPathBroadcastTx {
 		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.trace), nil
 	}
@@ -786,6 +794,17 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
+	if app.txsPreVerifier != nil {
+		txs := make([]sdk.Tx, 0, len(req.Txs))
+		for _, rawTx := range req.Txs {
+			if tx, err := app.decodeTx(rawTx); err == nil {
+				txs = append(txs, tx)
+			}
+		}
+
+		app.txsPreVerifier(app.finalizeBlockState.Context(), txs)
+	}
+
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
@@ -795,7 +814,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	for _, rawTx := range req.Txs {
 		var response *abci.ExecTxResult
 
-		if _, err := app.txDecoder(rawTx); err == nil {
+		if _, err := app.decodeTx(rawTx); err == nil {
 			response = app.deliverTx(rawTx)
 		} else {
 			// In the case where a transaction included in a block proposal is malformed,
@@ -841,6 +860,11 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	events = append(events, endBlock.Events...)
 	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
 
+	// txs included in the block are not expected to be seen again
+	if app.txDecodeCache != nil {
+		app.txDecodeCache.evict(req.Txs)
+	}
+
 	return &abci.ResponseFinalizeBlock{
 		Events:                events,
 		TxResults:             txResults,
@@ -861,8 +885,8 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
 	if app.optimisticExec.Initialized() {
-		// check if the hash we got is the same as the one we are executing
-		aborted := app.optimisticExec.AbortIfNeeded(req.Hash)
+		// check if the block we got is the same as the one we are executing
+		aborted := app.optimisticExec.AbortIfMismatch(req)
 		// Wait for the OE to finish, regardless of whether it was aborted or not
 		res, err := app.optimisticExec.WaitResult()
 
@@ -960,6 +984,8 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 
 	app.finalizeBlockState = nil
 
+	app.evictExpiredTxs(app.checkState.Context())
+
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.Context())
 	}
@@ -970,6 +996,21 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	return resp, nil
 }
 
+// evictExpiredTxs removes the txs of an ExpiringMempool expired at the block
+// of ctx, reporting each with a log line and a telemetry counter labelled with
+// the reason it expired.
+func (app *BaseApp) evictExpiredTxs(ctx sdk.Context) {
+	mp, ok := app.mempool.(mempool.ExpiringMempool)
+	if !ok {
+		return
+	}
+
+	for _, expired := range mp.EvictExpired(ctx) {
+		app.logger.Debug("evicted expired tx from the mempool", "height", ctx.BlockHeight(), "reason", expired.Reason)
+		telemetry.IncrCounterWithLabels([]string{"mempool", "expired_txs"}, 1, []metrics.Label{telemetry.NewLabel("reason", expired.Reason)})
+	}
+}
+
 // workingHash gets the apphash that will be finalized in commit.
 // These writes will be persisted to the root multi-store (app.cms) and flushed to
 // disk in the Commit phase. This means when the ABCI client requests Commit(), the application
@@ -1142,8 +1183,12 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
+	ctx, done := app.profileQuery(ctx, req.Path)
+	defer done()
+
 	resp, err := handler(ctx, req)
 	if err != nil {
+		app.logModuleError(err)
 		resp = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		resp.Height = req.Height
 		return resp
@@ -1152,7 +1197,26 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 	return resp
 }
 
+// ServeGRPCQuery serves the gRPC query req with the gRPC query router of the
+// app, without acquiring the query scheduler. It is meant for the queries served
+// on behalf of another query, such as the queries of a batch query, which
+// already holds the scheduler.
+func (app *BaseApp) ServeGRPCQuery(req *abci.RequestQuery) *abci.ResponseQuery {
+	handler := app.grpcQueryRouter.Route(req.Path)
+	if handler == nil {
+		return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path %s", req.Path), app.trace)
+	}
+
+	return app.handleQueryGRPC(handler, req)
+}
+
 func gRPCErrorToSDKError(err error) error {
+	// module errors keep the codespace and code of their registered error
+	var moduleErr *sdkerrors.ModuleError
+	if errors.As(err, &moduleErr) {
+		return err
+	}
+
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -1176,6 +1240,15 @@ func gRPCErrorToSDKError(err error) error {
 	}
 }
 
+// logModuleError logs the debug message of the module error wrapped by err, if
+// any, which is kept node-side rather than returned in responses.
+func (app *BaseApp) logModuleError(err error) {
+	var moduleErr *sdkerrors.ModuleError
+	if errors.As(err, &moduleErr) {
+		app.logger.Debug("module error", "module", moduleErr.Module(), "err", moduleErr, "debug", moduleErr.DebugMessage())
+	}
+}
+
 func checkNegativeHeight(height int64) error {
 	if height < 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot query with height < 0; please provide a valid height")
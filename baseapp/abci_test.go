@@ -37,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -489,6 +490,162 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// queryAppService performs a query of the app service through ABCI, decoding
+// its response into res if it succeeds. The app service must be registered on
+// app.
+func queryAppService(t *testing.T, app *baseapp.BaseApp, method string, req, res proto.Message) *abci.ResponseQuery {
+	t.Helper()
+
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+	resQuery, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "/cosmos.base.app.v1beta1.Service/" + method, Data: data})
+	require.NoError(t, err)
+	if resQuery.IsOK() {
+		require.NoError(t, proto.Unmarshal(resQuery.Value, res))
+	}
+
+	return resQuery
+}
+
+func TestABCI_BatchQuery(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(
+			bapp.GRPCQueryRouter(),
+			testdata.QueryImpl{},
+		)
+	}
+
+	suite := NewBaseAppSuite(t, grpcQueryOpt)
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+
+	batch := func(queries ...appservice.BatchQuery) (appservice.BatchQueryResponse, *abci.ResponseQuery) {
+		var res appservice.BatchQueryResponse
+		resQuery := queryAppService(t, suite.baseApp, "BatchQuery", &appservice.BatchQueryRequest{Queries: queries}, &res)
+		return res, resQuery
+	}
+
+	_, resQuery := batch()
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code, resQuery)
+
+	// the queries are served at the latest height, and fail on their own
+	res, resQuery := batch(
+		appservice.BatchQuery{Path: "/testpb.Query/SayHello", Data: reqBz},
+		appservice.BatchQuery{Path: "/testpb.Query/Unknown"},
+		appservice.BatchQuery{Path: "/testpb.Query/SayHello", Data: reqBz},
+	)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Equal(t, int64(2), res.Height)
+	require.Len(t, res.Results, 3)
+
+	for _, i := range []int{0, 2} {
+		require.Equal(t, abci.CodeTypeOK, res.Results[i].Code)
+		var hello testdata.SayHelloResponse
+		require.NoError(t, hello.Unmarshal(res.Results[i].Value))
+		require.Equal(t, "Hello foo!", hello.Greeting)
+	}
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Results[1].Code)
+}
+
+func TestABCI_ChainMetadataQuery(t *testing.T) {
+	providerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.AddChainMetadataProvider(func(_ sdk.Context, md *sdk.ChainMetadata) error {
+			md.Modules = append(md.Modules, "foo", "bar")
+			md.Denoms = append(md.Denoms, sdk.DenomMetadata{Base: "uatom", Display: "atom", Exponent: 6})
+			return nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, providerOpt, baseapp.SetChainID("test-chain"))
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ChainId:         "test-chain",
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	var md appservice.ChainMetadataResponse
+	resQuery := queryAppService(t, suite.baseApp, "ChainMetadata", &appservice.ChainMetadataRequest{}, &md)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Equal(t, int64(1), resQuery.Height)
+	require.Equal(t, "test-chain", md.ChainId)
+	require.Equal(t, sdk.RuntimeBaseApp, md.Runtime)
+	require.Equal(t, sdk.GetConfig().GetBech32AccountAddrPrefix(), md.Bech32Prefixes.Account)
+	require.Equal(t, []string{"bar", "foo"}, md.Modules)
+	require.Equal(t, []appservice.DenomMetadata{{Base: "uatom", Display: "atom", Exponent: 6}}, md.Denoms)
+}
+
+func TestABCI_BlockTimeQuery(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	query := func(req *appservice.BlockTimeRequest) (appservice.BlockTimeResponse, *abci.ResponseQuery) {
+		var res appservice.BlockTimeResponse
+		resQuery := queryAppService(t, suite.baseApp, "BlockTime", req, &res)
+		return res, resQuery
+	}
+
+	genesis := time.Unix(1_000_000, 0).UTC()
+	commit := func(height int64, blockTime time.Time) {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	commit(1, genesis)
+	_, resQuery := query(&appservice.BlockTimeRequest{})
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code, resQuery)
+
+	// blocks every 4s then 8s average 6s
+	commit(2, genesis.Add(4*time.Second))
+	commit(3, genesis.Add(12*time.Second))
+
+	res, resQuery := query(&appservice.BlockTimeRequest{})
+	require.True(t, resQuery.IsOK(), resQuery)
+	require.Equal(t, int64(3), res.Estimate.Height)
+	require.Equal(t, 6*time.Second, res.Estimate.BlockTime)
+	require.Equal(t, int64(2), res.Estimate.Window)
+
+	// committed heights have their actual time, future ones an estimate
+	res, _ = query(&appservice.BlockTimeRequest{Height: 2})
+	require.Equal(t, genesis.Add(4*time.Second), res.Time.UTC())
+	res, _ = query(&appservice.BlockTimeRequest{Height: 13})
+	require.Equal(t, genesis.Add(72*time.Second), res.Time.UTC())
+
+	at := genesis.Add(73 * time.Second)
+	res, _ = query(&appservice.BlockTimeRequest{Time: &at})
+	require.Equal(t, int64(14), res.Height)
+
+	_, resQuery = query(&appservice.BlockTimeRequest{Height: 2, Time: &at})
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code, resQuery)
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.ResponseQuery {
@@ -820,6 +977,57 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_Query_SimulateStateChanges(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the app service is queried at the latest committed height
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	expected := make([]byte, binary.MaxVarintLen64)
+	expected = expected[:binary.PutVarint(expected, 1)]
+
+	// simulating twice must yield the same changes as check state is untouched
+	for i := 0; i < 2; i++ {
+		gInfo, result, changes, err := suite.baseApp.SimulateWithStateChanges(txBytes)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.NotZero(t, gInfo.GasUsed)
+		require.Equal(t, []sdk.StateChange{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: expected},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Value: expected},
+		}, changes)
+	}
+
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+
+	var res appservice.SimulateStateChangesResponse
+	queryResult := queryAppService(t, suite.baseApp, "SimulateStateChanges", &appservice.SimulateStateChangesRequest{TxBytes: txBytes}, &res)
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+	require.Equal(t, []appservice.StateChange{
+		{StoreKey: capKey1.Name(), Key: anteKey, Value: expected},
+		{StoreKey: capKey1.Name(), Key: deliverKey, Value: expected},
+	}, res.Changes)
+	require.NotZero(t, res.GasInfo.GasUsed)
+	require.NotEmpty(t, res.Result.Events)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
@@ -87,6 +88,7 @@ type BaseApp struct {
 	verifyVoteExt      sdk.VerifyVoteExtensionHandler // ABCI VerifyVoteExtension handler
 	prepareCheckStater sdk.PrepareCheckStater         // logic to run during commit using the checkState
 	precommiter        sdk.Precommiter                // logic to run during commit using the deliverState
+	txsPreVerifier     sdk.TxsPreVerifier             // logic to run on the txs of a block before executing them
 
 	addrPeerFilter sdk.PeerFilter // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter // filter peers by node ID
@@ -175,6 +177,33 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// stateChecksums computes per-store state checksums at commit, if enabled
+	stateChecksums *stateChecksumListener
+
+	// blockResults persists the results of committed blocks, if enabled
+	blockResults BlockResultsStore
+
+	// eventStore persists the events of committed blocks, if enabled
+	eventStore EventStore
+
+	// rebroadcast rebroadcasts the txs submitted through the tx service until
+	// they are committed, if enabled
+	rebroadcast Rebroadcaster
+
+	// queryScheduler bounds the number of concurrent queries and schedules them
+	// by priority, if set
+	queryScheduler *QueryScheduler
+
+	// queryProfiler records the slowest queries and the store prefixes they
+	// accessed on demand, if enabled
+	queryProfiler QueryProfiler
+
+	// txDecodeCache caches decoded txs between CheckTx and block execution, if enabled
+	txDecodeCache *txDecodeCache
+
+	// txResourceLimits defines the caps on the store accesses of a single tx
+	txResourceLimits limitkv.Limits
+
 	chainID string
 
 	cdc codec.Codec
@@ -183,6 +212,18 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// optimisticExecDisabled disables optimistic execution, even if enabled by
+	// the app.
+	optimisticExecDisabled bool
+
+	// txSelector selects the txs of the proposals built by the default
+	// PrepareProposal handler, if set
+	txSelector TxSelector
+
+	// chainMetadataProviders fill in the chain metadata known to the app and
+	// its modules
+	chainMetadataProviders []sdk.ChainMetadataProvider
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -214,6 +255,9 @@ func NewBaseApp(
 	}
 
 	abciProposalHandler := NewDefaultProposalHandler(app.mempool, app)
+	if app.txSelector != nil {
+		abciProposalHandler.SetTxSelector(app.txSelector)
+	}
 
 	if app.prepareProposal == nil {
 		app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
@@ -672,6 +716,10 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 		ctx, _ = ctx.CacheContext()
 	}
 
+	if !app.txResourceLimits.IsZero() {
+		ctx = ctx.WithResourceMeter(limitkv.NewMeter(app.txResourceLimits))
+	}
+
 	return ctx
 }
 
@@ -756,6 +804,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
+		app.logModuleError(err)
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
@@ -811,12 +860,17 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is like runTx but executes the transaction against the
+// provided Context, which must have been obtained through getContextForTx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -826,7 +880,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, newResourceLimitRecoveryMiddleware(app.runTxRecoveryMiddleware))
 			err, result = processRecovery(r, recoveryMW), nil
 			ctx.Logger().Error("panic recovered in runTx", "err", err)
 		}
@@ -858,7 +912,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
+	tx, err := app.decodeTx(txBytes)
 	if err != nil {
 		return sdk.GasInfo{}, nil, nil, err
 	}
@@ -956,6 +1010,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
+			msCache.Write()
+		} else if mode == execModeSimulate {
+			// The simulation context is already a throwaway branch of the check
+			// state (see getContextForTx), so flushing into it is safe and lets
+			// callers inspect the writes performed by the messages.
 			msCache.Write()
 		}
 
@@ -1093,7 +1152,7 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 // returned if the transaction cannot be decoded. <Tx, nil> will be returned if
 // the transaction is valid, otherwise <Tx, err> will be returned.
 func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
-	tx, err := app.txDecoder(txBz)
+	tx, err := app.decodeTx(txBz)
 	if err != nil {
 		return nil, err
 	}
@@ -1107,7 +1166,7 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 }
 
 func (app *BaseApp) TxDecode(txBytes []byte) (sdk.Tx, error) {
-	return app.txDecoder(txBytes)
+	return app.decodeTx(txBytes)
 }
 
 func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
@@ -1138,5 +1197,21 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close app.blockResults, opened by server/start.go if block results are enabled
+	if app.blockResults != nil {
+		app.logger.Info("Closing block_results.db")
+		if err := app.blockResults.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Close app.eventStore, opened by server/start.go if the event store is enabled
+	if app.eventStore != nil {
+		app.logger.Info("Closing events.db")
+		if err := app.eventStore.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
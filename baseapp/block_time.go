@@ -0,0 +1,59 @@
+package baseapp
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EstimateBlockTime returns the average block time over the last window
+// blocks, or fewer if the chain is younger. It is computed from the block times
+// recorded in the commit infos of the multistore, so it only depends on the
+// committed headers.
+func (app *BaseApp) EstimateBlockTime(window int64) (sdk.BlockTimeEstimate, error) {
+	if window <= 0 {
+		return sdk.BlockTimeEstimate{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "block time window must be positive, got %d", window)
+	}
+
+	latest := app.LastBlockHeight()
+	first := max(latest-window, app.initialHeight, 1)
+	if latest <= first {
+		return sdk.BlockTimeEstimate{}, errorsmod.Wrap(sdkerrors.ErrInvalidHeight, "not enough blocks to estimate the block time")
+	}
+
+	latestTime, err := app.CommittedBlockTime(latest)
+	if err != nil {
+		return sdk.BlockTimeEstimate{}, err
+	}
+	firstTime, err := app.CommittedBlockTime(first)
+	if err != nil {
+		return sdk.BlockTimeEstimate{}, err
+	}
+
+	return sdk.BlockTimeEstimate{
+		Height:    latest,
+		Time:      latestTime,
+		BlockTime: latestTime.Sub(firstTime) / time.Duration(latest-first),
+		Window:    latest - first,
+	}, nil
+}
+
+// CommittedBlockTime returns the time of a committed block, as recorded in the
+// commit info of the multistore.
+func (app *BaseApp) CommittedBlockTime(height int64) (time.Time, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return time.Time{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the multistore does not record block times")
+	}
+
+	cInfo, err := rms.GetCommitInfo(height)
+	if err != nil {
+		return time.Time{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "no block time at height %d: %s", height, err)
+	}
+
+	return cInfo.Timestamp, nil
+}
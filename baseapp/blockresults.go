@@ -0,0 +1,32 @@
+package baseapp
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// BlockResultsStore persists the results of the blocks committed by the app,
+// which it is notified of as an ABCIListener, such as the store of the
+// server/blockresults package.
+type BlockResultsStore interface {
+	storetypes.ABCIListener
+
+	// Close closes the store, it is called when the app is closed.
+	Close() error
+}
+
+// EnableBlockResults persists the results of the blocks committed by the app in
+// store, so that the server can serve them with the block results gRPC service
+// and indexers can query tx results and block events without access to the
+// CometBFT RPC. The store is closed when the app is closed.
+//
+// It must be called before the gRPC server is started.
+func (app *BaseApp) EnableBlockResults(store BlockResultsStore) {
+	app.blockResults = store
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, store)
+}
+
+// BlockResultsStore returns the block results store of the app, or nil if
+// block results are not enabled.
+func (app *BaseApp) BlockResultsStore() BlockResultsStore {
+	return app.blockResults
+}
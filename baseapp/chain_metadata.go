@@ -0,0 +1,60 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ChainMetadata returns the metadata of the chain at the height of ctx. BaseApp
+// fills in what it knows of the chain and of the node, then the providers added
+// with AddChainMetadataProvider fill in the rest.
+func (app *BaseApp) ChainMetadata(ctx sdk.Context) (sdk.ChainMetadata, error) {
+	cfg := sdk.GetConfig()
+	md := sdk.ChainMetadata{
+		ChainID:    app.chainID,
+		AppName:    app.name,
+		AppVersion: app.version,
+		SDKVersion: version.NewInfo().CosmosSdkVersion,
+		Runtime:    sdk.RuntimeBaseApp,
+		Bech32Prefixes: sdk.Bech32Prefixes{
+			Account:   cfg.GetBech32AccountAddrPrefix(),
+			Validator: cfg.GetBech32ValidatorAddrPrefix(),
+			Consensus: cfg.GetBech32ConsensusAddrPrefix(),
+		},
+		Features: app.features(ctx),
+	}
+
+	for _, provider := range app.chainMetadataProviders {
+		if err := provider(ctx, &md); err != nil {
+			return sdk.ChainMetadata{}, err
+		}
+	}
+
+	md.Sort()
+	return md, nil
+}
+
+// features returns the optional features enabled on the node.
+func (app *BaseApp) features(ctx sdk.Context) []string {
+	var features []string
+	add := func(feature string, enabled bool) {
+		if enabled {
+			features = append(features, feature)
+		}
+	}
+
+	_, noOpMempool := app.mempool.(mempool.NoOpMempool)
+	cp := app.GetConsensusParams(ctx)
+
+	add("app-mempool", app.mempool != nil && !noOpMempool)
+	add("block-results", app.blockResults != nil)
+	add("event-store", app.eventStore != nil)
+	add("optimistic-execution", app.optimisticExec != nil)
+	add("snapshots", app.snapshotManager != nil)
+	add("state-checksums", app.stateChecksums != nil)
+	add("tx-decode-cache", app.txDecodeCache != nil)
+	add("vote-extensions", cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && ctx.BlockHeight() >= cp.Abci.VoteExtensionsEnableHeight)
+
+	return features
+}
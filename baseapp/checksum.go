@@ -0,0 +1,147 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreChecksum is the state checksum of a single module store.
+type StoreChecksum struct {
+	// Block is the digest of the changes committed to the store in the block.
+	// It only depends on the block, so it can be compared across nodes
+	// regardless of when they were started.
+	Block []byte `json:"block"`
+	// Cumulative chains the block digests of the store, it is computed as
+	// sha256(previous cumulative checksum || block digest), starting from the
+	// first block committed after the node started.
+	Cumulative []byte `json:"cumulative"`
+}
+
+// StateChecksums holds the checksums of the module stores at a given height.
+type StateChecksums struct {
+	Height int64                    `json:"height"`
+	Stores map[string]StoreChecksum `json:"stores"`
+}
+
+// EnableStateChecksums enables the computation of per-store state checksums at
+// commit, for the given store keys. Checksums are derived from the change set
+// of each block, so they are cheap to compute, and can be retrieved with the
+// StateChecksums query of the app service. When app hashes diverge between
+// nodes, comparing their checksums at the diverging height immediately shows
+// which module diverged.
+//
+// It must be called before the first block is committed.
+func (app *BaseApp) EnableStateChecksums(keys []storetypes.StoreKey) {
+	app.cms.AddListeners(keys)
+
+	app.stateChecksums = &stateChecksumListener{stores: make(map[string]StoreChecksum, len(keys))}
+	for _, key := range keys {
+		// stores without changes in a block still report a checksum
+		app.stateChecksums.stores[key.Name()] = StoreChecksum{}
+	}
+
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, app.stateChecksums)
+}
+
+// StateChecksums returns the state checksums computed at the latest commit. It
+// returns false if state checksums are not enabled.
+func (app *BaseApp) StateChecksums() (StateChecksums, bool) {
+	if app.stateChecksums == nil {
+		return StateChecksums{}, false
+	}
+
+	return app.stateChecksums.latest(), true
+}
+
+var _ storetypes.ABCIListener = (*stateChecksumListener)(nil)
+
+// stateChecksumListener is an ABCIListener updating the store checksums from
+// the change set of each committed block.
+type stateChecksumListener struct {
+	mu     sync.RWMutex
+	height int64
+	stores map[string]StoreChecksum
+}
+
+func (l *stateChecksumListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (l *stateChecksumListener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	// keep the last write of each key. The change set is ordered by store
+	// and, within a store, by write order.
+	type change struct {
+		key, value []byte
+		delete     bool
+	}
+	changes := make(map[string]map[string]change)
+	for _, pair := range changeSet {
+		if _, ok := l.stores[pair.StoreKey]; !ok {
+			continue
+		}
+		if changes[pair.StoreKey] == nil {
+			changes[pair.StoreKey] = make(map[string]change)
+		}
+		changes[pair.StoreKey][string(pair.Key)] = change{key: pair.Key, value: pair.Value, delete: pair.Delete}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for name, prev := range l.stores {
+		storeChanges := make([]change, 0, len(changes[name]))
+		for _, c := range changes[name] {
+			storeChanges = append(storeChanges, c)
+		}
+		sort.Slice(storeChanges, func(i, j int) bool { return bytes.Compare(storeChanges[i].key, storeChanges[j].key) < 0 })
+
+		h := sha256.New()
+		for _, c := range storeChanges {
+			writeLengthPrefixed(h, c.key)
+			if c.delete {
+				h.Write([]byte{1})
+			} else {
+				h.Write([]byte{0})
+				writeLengthPrefixed(h, c.value)
+			}
+		}
+		block := h.Sum(nil)
+
+		h.Reset()
+		h.Write(prev.Cumulative)
+		h.Write(block)
+
+		l.stores[name] = StoreChecksum{Block: block, Cumulative: h.Sum(nil)}
+	}
+
+	return nil
+}
+
+func (l *stateChecksumListener) latest() StateChecksums {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	stores := make(map[string]StoreChecksum, len(l.stores))
+	for name, checksum := range l.stores {
+		stores[name] = checksum
+	}
+
+	return StateChecksums{Height: l.height, Stores: stores}
+}
+
+func writeLengthPrefixed(w interface{ Write([]byte) (int, error) }, bz []byte) {
+	var n [binary.MaxVarintLen64]byte
+	_, _ = w.Write(n[:binary.PutUvarint(n[:], uint64(len(bz)))])
+	_, _ = w.Write(bz)
+}
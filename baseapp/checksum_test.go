@@ -0,0 +1,70 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
+)
+
+func TestStateChecksums(t *testing.T) {
+	newApp := func() *baseapp.BaseApp {
+		distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+		checksumOpt := func(bapp *baseapp.BaseApp) {
+			bapp.EnableStateChecksums([]storetypes.StoreKey{capKey1, distKey1})
+		}
+		suite := NewBaseAppSuite(t, distOpt, checksumOpt)
+		appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+		require.NoError(t, err)
+		return suite.baseApp
+	}
+
+	commitBlock := func(app *baseapp.BaseApp, height int64, key, value []byte) appservice.StateChecksumsResponse {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		getFinalizeBlockStateCtx(app).KVStore(distKey1).Set(key, value)
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		var checksums appservice.StateChecksumsResponse
+		res := queryAppService(t, app, "StateChecksums", &appservice.StateChecksumsRequest{}, &checksums)
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, height, checksums.Height)
+		return checksums
+	}
+
+	app1, app2 := newApp(), newApp()
+
+	// both nodes apply the same changes
+	c1 := commitBlock(app1, 1, []byte("key"), []byte("value"))
+	c2 := commitBlock(app2, 1, []byte("key"), []byte("value"))
+	require.Equal(t, c1, c2)
+	require.Len(t, c1.Stores, 2)
+
+	// the second node diverges, only the diverging store reports a different checksum
+	c1 = commitBlock(app1, 2, []byte("key"), []byte("value2"))
+	c2 = commitBlock(app2, 2, []byte("key"), []byte("other"))
+
+	// stores are sorted by store key
+	dist1, cap1 := c1.Stores[0], c1.Stores[1]
+	dist2, cap2 := c2.Stores[0], c2.Stores[1]
+	require.Equal(t, capKey1.Name(), cap1.StoreKey)
+	require.Equal(t, distKey1.Name(), dist1.StoreKey)
+	require.Equal(t, cap1, cap2)
+	require.NotEqual(t, dist1.Block, dist2.Block)
+	require.NotEqual(t, dist1.Cumulative, dist2.Cumulative)
+
+	// state checksums are not enabled by default
+	_, ok := NewBaseAppSuite(t).baseApp.StateChecksums()
+	require.False(t, ok)
+}
@@ -0,0 +1,32 @@
+package baseapp
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// EventStore persists the events emitted by the blocks committed by the app,
+// which it is notified of as an ABCIListener, such as the store of the
+// server/eventstore package.
+type EventStore interface {
+	storetypes.ABCIListener
+
+	// Close closes the store, it is called when the app is closed.
+	Close() error
+}
+
+// EnableEventStore persists the events emitted by the blocks committed by the
+// app in store, with the retention policy of the store rather than the pruning
+// options of the app state. Events are queried with the Events query of the app
+// service. The store is closed when the app is closed.
+//
+// It must be called before the first block is replayed or executed.
+func (app *BaseApp) EnableEventStore(store EventStore) {
+	app.eventStore = store
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, store)
+}
+
+// EventStore returns the event store of the app, or nil if the event store is
+// not enabled.
+func (app *BaseApp) EventStore() EventStore {
+	return app.eventStore
+}
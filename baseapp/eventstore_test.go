@@ -0,0 +1,98 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ appservice.EventStore = (*memEventStore)(nil)
+
+// memEventStore is an in-memory event store, storing a "block" event for each
+// committed block.
+type memEventStore struct {
+	pending []appservice.IndexedEvent
+	events  []appservice.IndexedEvent
+	closed  bool
+}
+
+func (s *memEventStore) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	s.pending = []appservice.IndexedEvent{{Height: req.Height, TxIndex: -1, Event: abci.Event{Type: "block"}}}
+	return nil
+}
+
+func (s *memEventStore) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	s.events, s.pending = append(s.events, s.pending...), nil
+	return nil
+}
+
+func (s *memEventStore) Events(req *appservice.EventsRequest) (*appservice.EventsResponse, error) {
+	res := &appservice.EventsResponse{}
+	for _, event := range s.events {
+		if event.Height >= req.FromHeight && (req.EventType == "" || req.EventType == event.Event.Type) {
+			res.Events = append(res.Events, event)
+		}
+		res.LatestHeight = event.Height
+	}
+
+	return res, nil
+}
+
+func (s *memEventStore) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestEventStore(t *testing.T) {
+	store := &memEventStore{}
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) { bapp.EnableEventStore(store) })
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+	require.Equal(t, baseapp.EventStore(store), suite.baseApp.EventStore())
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the events are served from the store
+	var res appservice.EventsResponse
+	resQuery := queryAppService(t, suite.baseApp, "Events", &appservice.EventsRequest{EventType: "block", FromHeight: 2}, &res)
+	require.True(t, resQuery.IsOK(), resQuery.Log)
+	require.Equal(t, []appservice.IndexedEvent{
+		{Height: 2, TxIndex: -1, Event: abci.Event{Type: "block"}},
+		{Height: 3, TxIndex: -1, Event: abci.Event{Type: "block"}},
+	}, res.Events)
+	require.Equal(t, int64(3), res.LatestHeight)
+
+	// the store is closed along with the app
+	require.NoError(t, suite.baseApp.Close())
+	require.True(t, store.closed)
+
+	// the event store is not enabled by default
+	suite = NewBaseAppSuite(t)
+	appservice.RegisterAppService(suite.baseApp.GRPCQueryRouter(), suite.baseApp)
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	resQuery = queryAppService(t, suite.baseApp, "Events", &appservice.EventsRequest{}, &res)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), resQuery.Code, resQuery)
+	require.Nil(t, suite.baseApp.EventStore())
+}
@@ -26,7 +26,7 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
 		if !ok {
@@ -47,6 +47,12 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		release, err := app.queryScheduler.Acquire(grpcCtx, QueryPriorityFromContext(grpcCtx, QueryPriorityLow))
+		if err != nil {
+			return nil, status.Error(codes.Canceled, err.Error())
+		}
+		defer release()
+
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
 		sdkCtx, err := app.CreateQueryContext(height, false)
@@ -54,6 +60,9 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			return nil, err
 		}
 
+		sdkCtx, done := app.profileQuery(sdkCtx, info.FullMethod)
+		defer done()
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
@@ -67,7 +76,11 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
-		return handler(grpcCtx, req)
+		resp, err = handler(grpcCtx, req)
+		if err != nil {
+			app.logModuleError(err)
+		}
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -84,6 +97,7 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(
 						grpcrecovery.UnaryServerInterceptor(),
 						interceptor,
+						app.rebroadcastInterceptor,
 					))
 				},
 			}
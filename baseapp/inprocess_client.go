@@ -0,0 +1,121 @@
+package baseapp
+
+import (
+	gocontext "context"
+	"fmt"
+	"reflect"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InProcessClientConn is a gRPC ClientConn routing calls directly to the query
+// and msg service handlers registered on the routers of an app, without
+// encoding requests and responses. Typed clients generated by protoc, such as
+// banktypes.NewQueryClient, can be bound to it to query and execute msgs from
+// tests and embedded tooling without a gRPC server or a loopback connection.
+//
+// Msgs are executed like msgs of a tx, running their ValidateBasic and the
+// circuit breaker, and their events are emitted on the event manager of the
+// context. No ante handler is run, and state changes are written directly to
+// the context multistore.
+//
+// Queries are internally originated, so the client conn returned by the app
+// runs them with high priority on its query scheduler, unless another priority
+// is set on the call context with WithQueryPriority.
+type InProcessClientConn struct {
+	queryRouter    *GRPCQueryRouter
+	msgRouter      *MsgServiceRouter
+	queryScheduler *QueryScheduler
+
+	// Ctx is the context used to run the calls whose context doesn't hold an
+	// sdk.Context.
+	Ctx sdk.Context
+}
+
+var _ gogogrpc.ClientConn = &InProcessClientConn{}
+
+// NewInProcessClientConn returns a client conn routing calls to the handlers of
+// queryRouter and msgRouter, either of which can be nil.
+func NewInProcessClientConn(ctx sdk.Context, queryRouter *GRPCQueryRouter, msgRouter *MsgServiceRouter) *InProcessClientConn {
+	return &InProcessClientConn{
+		queryRouter: queryRouter,
+		msgRouter:   msgRouter,
+		Ctx:         ctx,
+	}
+}
+
+// InProcessClientConn returns a client conn routing calls to the query and msg
+// service handlers of the app, run with ctx.
+func (app *BaseApp) InProcessClientConn(ctx sdk.Context) *InProcessClientConn {
+	conn := NewInProcessClientConn(ctx, app.grpcQueryRouter, app.msgServiceRouter)
+	conn.queryScheduler = app.queryScheduler
+	return conn
+}
+
+// Invoke implements the grpc ClientConn.Invoke method.
+func (c *InProcessClientConn) Invoke(goCtx gocontext.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	ctx := c.Ctx
+	if sdkCtx, ok := goCtx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		ctx = sdkCtx
+	}
+
+	req, ok := args.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%s: expected proto.Message request, got %T", method, args)
+	}
+
+	if c.msgRouter != nil {
+		if handler := c.msgRouter.Handler(req); handler != nil {
+			res, err := handler(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range res.Events {
+				ctx.EventManager().EmitEvent(sdk.Event(event))
+			}
+
+			return setReply(method, res.MsgResponses[0].GetCachedValue(), reply)
+		}
+	}
+
+	if c.queryRouter != nil {
+		if handlers := c.queryRouter.HybridHandlerByRequestName(proto.MessageName(req)); len(handlers) > 0 {
+			resp, ok := reply.(protoiface.MessageV1)
+			if !ok {
+				return fmt.Errorf("%s: expected proto.Message response, got %T", method, reply)
+			}
+
+			release, err := c.queryScheduler.Acquire(goCtx, QueryPriorityFromContext(goCtx, QueryPriorityHigh))
+			if err != nil {
+				return err
+			}
+			defer release()
+
+			return handlers[0](ctx, req, resp)
+		}
+	}
+
+	return fmt.Errorf("handler not found for %s", method)
+}
+
+// NewStream implements the grpc ClientConn.NewStream method
+func (c *InProcessClientConn) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+// setReply copies the msg response res into reply.
+func setReply(method string, res, reply interface{}) error {
+	resValue, replyValue := reflect.ValueOf(res), reflect.ValueOf(reply)
+	if resValue.Type() != replyValue.Type() || replyValue.Kind() != reflect.Pointer {
+		return fmt.Errorf("%s: expected %T response, got %T", method, reply, res)
+	}
+
+	replyValue.Elem().Set(resValue.Elem())
+	return nil
+}
@@ -0,0 +1,46 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInProcessClientConn(t *testing.T) {
+	registry := testdata.NewTestInterfaceRegistry()
+
+	qr := baseapp.NewGRPCQueryRouter()
+	qr.SetInterfaceRegistry(registry)
+	testdata.RegisterQueryServer(qr, testdata.QueryImpl{})
+
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(registry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+	conn := baseapp.NewInProcessClientConn(ctx, qr, msr)
+
+	queryClient := testdata.NewQueryClient(conn)
+	res, err := queryClient.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", res.Message)
+
+	// the sdk.Context of the call context takes precedence
+	res2, err := queryClient.SayHello(ctx, &testdata.SayHelloRequest{Name: "Foo"})
+	require.NoError(t, err)
+	require.Equal(t, "Hello Foo!", res2.Greeting)
+
+	msgClient := testdata.NewMsgClient(conn)
+	res3, err := msgClient.CreateDog(context.Background(), &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}})
+	require.NoError(t, err)
+	require.Equal(t, "Spot", res3.Name)
+
+	// calls to services not registered on the routers fail
+	err = conn.Invoke(context.Background(), "/testpb.Unknown/Method", &testdata.Dog{}, &testdata.Dog{})
+	require.ErrorContains(t, err, "handler not found")
+}
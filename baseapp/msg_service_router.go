@@ -2,7 +2,9 @@ package baseapp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -33,6 +35,9 @@ type MsgServiceRouter struct {
 	hybridHandlers    map[string]func(ctx context.Context, req, resp protoiface.MessageV1) error
 	responseByRequest map[string]string
 	circuitBreaker    CircuitBreaker
+
+	paramChangeRecorder ParamChangeRecorder
+	paramsQueryRouter   *GRPCQueryRouter
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -65,6 +70,18 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 	return msr.routes[typeURL]
 }
 
+// MsgTypeURLs returns the sorted type URLs of the messages with a registered
+// handler.
+func (msr *MsgServiceRouter) MsgTypeURLs() []string {
+	typeURLs := make([]string, 0, len(msr.routes))
+	for typeURL := range msr.routes {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs)
+
+	return typeURLs
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -182,6 +199,7 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
+	paramsModule, isParamsUpdate := paramsModuleOf(requestTypeName)
 	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -207,6 +225,16 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			}
 		}
 
+		// Read the params before a MsgUpdateParams to record their change.
+		recordParams := isParamsUpdate && msr.paramChangeRecorder != nil
+		var oldParams json.RawMessage
+		if recordParams {
+			var err error
+			if oldParams, err = msr.queryParams(ctx, paramsModule); err != nil {
+				return nil, err
+			}
+		}
+
 		// Call the method handler from the service description with the handler object.
 		// We don't do any decoding here because the decoding was already done.
 		res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
@@ -214,6 +242,12 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			return nil, err
 		}
 
+		if recordParams {
+			if err := msr.recordParamChange(ctx, paramsModule, msg, oldParams); err != nil {
+				return nil, err
+			}
+		}
+
 		resMsg, ok := res.(proto.Message)
 		if !ok {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
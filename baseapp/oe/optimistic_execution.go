@@ -3,6 +3,7 @@ package oe
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"math/rand"
 	"sync"
@@ -26,6 +27,7 @@ type OptimisticExecution struct {
 	mtx         sync.Mutex
 	stopCh      chan struct{}
 	request     *abci.RequestFinalizeBlock
+	txsHash     []byte // hash of the ordered txs of the request
 	response    *abci.ResponseFinalizeBlock
 	err         error
 	cancelFunc  func() // cancel function for the context
@@ -60,6 +62,7 @@ func (oe *OptimisticExecution) Reset() {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 	oe.request = nil
+	oe.txsHash = nil
 	oe.response = nil
 	oe.err = nil
 	oe.initialized = false
@@ -97,6 +100,7 @@ func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 		NextValidatorsHash: req.NextValidatorsHash,
 		ProposerAddress:    req.ProposerAddress,
 	}
+	oe.txsHash = txsHash(req.Txs)
 
 	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
 	ctx, cancel := context.WithCancel(context.Background())
@@ -126,7 +130,7 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	defer oe.mtx.Unlock()
 
 	if !bytes.Equal(oe.request.Hash, reqHash) {
-		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height, "req_height", oe.request.Height)
+		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height)
 		oe.cancelFunc()
 		return true
 	} else if oe.abortRate > 0 && rand.Intn(100) < oe.abortRate {
@@ -140,6 +144,37 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	return false
 }
 
+// AbortIfMismatch aborts the OE if the block to finalize is not the one being
+// executed, i.e. if its height, hash or ordered txs differ, so that a block
+// with extra, missing or reordered txs is never accepted. Returns true if the
+// OE was aborted.
+func (oe *OptimisticExecution) AbortIfMismatch(req *abci.RequestFinalizeBlock) bool {
+	if oe == nil {
+		return false
+	}
+
+	oe.mtx.Lock()
+	if oe.request.Height != req.Height || !bytes.Equal(oe.txsHash, txsHash(req.Txs)) {
+		oe.logger.Error("OE aborted due to block mismatch", "oe_height", oe.request.Height, "req_height", req.Height, "oe_txs", len(oe.request.Txs), "req_txs", len(req.Txs))
+		oe.cancelFunc()
+		oe.mtx.Unlock()
+		return true
+	}
+	oe.mtx.Unlock()
+
+	return oe.AbortIfNeeded(req.Hash)
+}
+
+// txsHash returns the hash of a list of txs, which commits to their order.
+func txsHash(txs [][]byte) []byte {
+	h := sha256.New()
+	for _, tx := range txs {
+		txHash := sha256.Sum256(tx)
+		h.Write(txHash[:])
+	}
+	return h.Sum(nil)
+}
+
 // Abort aborts the OE unconditionally and waits for it to finish.
 func (oe *OptimisticExecution) Abort() {
 	if oe == nil || oe.cancelFunc == nil {
@@ -32,3 +32,27 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestOptimisticExecutionMismatch(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock)
+	proposal := &abci.RequestProcessProposal{
+		Hash:   []byte("test"),
+		Height: 2,
+		Txs:    [][]byte{[]byte("tx1"), []byte("tx2")},
+	}
+	oe.Execute(proposal)
+	_, _ = oe.WaitResult()
+
+	block := &abci.RequestFinalizeBlock{Hash: proposal.Hash, Height: proposal.Height, Txs: proposal.Txs}
+	assert.False(t, oe.AbortIfMismatch(block))
+
+	for _, req := range []*abci.RequestFinalizeBlock{
+		{Hash: proposal.Hash, Height: 3, Txs: proposal.Txs},
+		{Hash: proposal.Hash, Height: 2, Txs: [][]byte{[]byte("tx2"), []byte("tx1")}},
+		{Hash: proposal.Hash, Height: 2, Txs: [][]byte{[]byte("tx1")}},
+		{Hash: proposal.Hash, Height: 2, Txs: [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}},
+		{Hash: []byte("wrong_hash"), Height: 2, Txs: proposal.Txs},
+	} {
+		assert.True(t, oe.AbortIfMismatch(req))
+	}
+}
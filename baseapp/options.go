@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
@@ -49,6 +50,13 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetQueryScheduler returns an option that bounds the number of queries run
+// concurrently with scheduler, serving internally originated queries first.
+// A nil scheduler doesn't bound queries.
+func SetQueryScheduler(scheduler *QueryScheduler) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.queryScheduler = scheduler }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -102,18 +110,89 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetTxDecodeCacheSize returns a BaseApp option function that caches up to size
+// decoded txs, so that txs are decoded once between CheckTx, the proposal
+// handlers and FinalizeBlock. A size of zero disables the cache.
+func SetTxDecodeCacheSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if size <= 0 {
+			app.txDecodeCache = nil
+			return
+		}
+
+		cache, err := newTxDecodeCache(size)
+		if err != nil {
+			panic(err)
+		}
+
+		app.txDecodeCache = cache
+	}
+}
+
+// SetTxDecodeCacheBudget returns a BaseApp option function that caches decoded
+// txs like SetTxDecodeCacheSize, but sizes the cache by its hit rate instead of
+// a fixed size, within a memory budget in bytes, approximated by the size of the
+// cached tx bytes. The size, bytes and hit rate of the cache are reported as
+// telemetry gauges. A budget of zero leaves the cache as is.
+func SetTxDecodeCacheBudget(budget int) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if budget <= 0 {
+			return
+		}
+
+		cache, err := newAdaptiveTxDecodeCache(budget)
+		if err != nil {
+			panic(err)
+		}
+
+		app.txDecodeCache = cache
+	}
+}
+
+// SetTxSelector returns a BaseApp option function that sets the TxSelector of
+// the default PrepareProposal handler, such as one of the built-in strategies
+// returned by NewTxSelector. It has no effect if the app sets its own
+// PrepareProposal handler.
+func SetTxSelector(ts TxSelector) func(*BaseApp) {
+	return func(app *BaseApp) { app.txSelector = ts }
+}
+
+// SetTxResourceLimits returns a BaseApp option function that caps the store
+// reads, writes, distinct keys touched and iterator steps of each tx. Those caps
+// are a defense-in-depth layer against mispriced gas, as a tx exceeding them
+// fails with a dedicated error code regardless of the gas it paid for.
+func SetTxResourceLimits(limits limitkv.Limits) func(*BaseApp) {
+	return func(app *BaseApp) { app.txResourceLimits = limits }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
-// SetOptimisticExecution enables optimistic execution.
+// SetOptimisticExecution enables optimistic execution, unless it is disabled
+// by SetOptimisticExecutionDisabled.
 func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
 	return func(app *BaseApp) {
+		if app.optimisticExecDisabled {
+			return
+		}
+
 		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
 	}
 }
 
+// SetOptimisticExecutionDisabled lets node operators disable optimistic
+// execution even if the app enables it, whatever the order of the options.
+func SetOptimisticExecutionDisabled(disabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.optimisticExecDisabled = disabled
+		if disabled {
+			app.optimisticExec = nil
+		}
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -228,6 +307,14 @@ func (app *BaseApp) SetPrecommiter(precommiter sdk.Precommiter) {
 	app.precommiter = precommiter
 }
 
+func (app *BaseApp) SetTxsPreVerifier(txsPreVerifier sdk.TxsPreVerifier) {
+	if app.sealed {
+		panic("SetTxsPreVerifier() on sealed BaseApp")
+	}
+
+	app.txsPreVerifier = txsPreVerifier
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -312,6 +399,11 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
+
+	// txs decoded by the previous decoder must be decoded again
+	if app.txDecodeCache != nil {
+		app.txDecodeCache.purge()
+	}
 }
 
 // SetTxEncoder sets the TxEncoder if it wasn't provided in the BaseApp constructor.
@@ -342,6 +434,17 @@ func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	app.processProposal = handler
 }
 
+// AddChainMetadataProvider adds a provider filling in the chain metadata
+// returned by the ChainMetadata query of the app service, such as the denoms or
+// the modules of the app.
+func (app *BaseApp) AddChainMetadataProvider(provider sdk.ChainMetadataProvider) {
+	if app.sealed {
+		panic("AddChainMetadataProvider() on sealed BaseApp")
+	}
+
+	app.chainMetadataProviders = append(app.chainMetadataProviders, provider)
+}
+
 // SetPrepareProposal sets the prepare proposal function for the BaseApp.
 func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
 	if app.sealed {
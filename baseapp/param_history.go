@@ -0,0 +1,129 @@
+package baseapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// updateParamsMsgName is the name of the messages whose execution is recorded
+// as a change of the params of the module of their protobuf package.
+const updateParamsMsgName = "MsgUpdateParams"
+
+// ParamChangeRecorder records the changes of module params applied by the
+// MsgUpdateParams executed through the msg service router, e.g. in a
+// queryable history.
+type ParamChangeRecorder interface {
+	RecordParamChange(ctx context.Context, change sdk.ParamChange) error
+}
+
+// SetParamChangeRecorder sets the recorder of the param changes applied by the
+// messages executed by the BaseApp. The params before a change are read with
+// the Query/Params service of the module, if it has one.
+func (app *BaseApp) SetParamChangeRecorder(recorder ParamChangeRecorder) {
+	if app.msgServiceRouter == nil {
+		panic("cannot set param change recorder with no msg service router set")
+	}
+	app.msgServiceRouter.SetParamChangeRecorder(recorder, app.grpcQueryRouter)
+}
+
+// SetParamChangeRecorder sets the recorder of the param changes applied by the
+// routed messages, and the router with which the params before a change are
+// queried, which may be nil.
+func (msr *MsgServiceRouter) SetParamChangeRecorder(recorder ParamChangeRecorder, queryRouter *GRPCQueryRouter) {
+	msr.paramChangeRecorder = recorder
+	msr.paramsQueryRouter = queryRouter
+}
+
+// paramsModuleOf returns the protobuf package of the module whose params are
+// updated by the message of typeURL, if it is a MsgUpdateParams.
+func paramsModuleOf(typeURL string) (string, bool) {
+	name := strings.TrimPrefix(typeURL, "/")
+	i := strings.LastIndexByte(name, '.')
+	if i <= 0 || name[i+1:] != updateParamsMsgName {
+		return "", false
+	}
+
+	return name[:i], true
+}
+
+// queryParams returns the JSON encoded params of the module, read with its
+// Query/Params service, or nil if it has none.
+func (msr *MsgServiceRouter) queryParams(ctx sdk.Context, module string) (json.RawMessage, error) {
+	if msr.paramsQueryRouter == nil {
+		return nil, nil
+	}
+
+	handler := msr.paramsQueryRouter.Route(fmt.Sprintf("/%s.Query/Params", module))
+	resType := proto.MessageType(module + ".QueryParamsResponse")
+	if handler == nil || resType == nil || resType.Kind() != reflect.Ptr {
+		return nil, nil
+	}
+
+	res, err := handler(ctx, &abci.RequestQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	resMsg, ok := reflect.New(resType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+	if err := proto.Unmarshal(res.Value, resMsg); err != nil {
+		return nil, err
+	}
+
+	return msr.paramsJSON(resMsg)
+}
+
+// paramsJSON returns the JSON encoded params field of msg, or msg without its
+// authority if it has no params field.
+func (msr *MsgServiceRouter) paramsJSON(msg proto.Message) (json.RawMessage, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, msr.interfaceRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if params, ok := fields["params"]; ok {
+		return params, nil
+	}
+
+	delete(fields, "authority")
+	return json.Marshal(fields)
+}
+
+// recordParamChange records the change of the params of module applied by
+// msg, from old.
+func (msr *MsgServiceRouter) recordParamChange(ctx sdk.Context, module string, msg sdk.Msg, old json.RawMessage) error {
+	newParams, err := msr.paramsJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	var authority string
+	if m, ok := msg.(interface{ GetAuthority() string }); ok {
+		authority = m.GetAuthority()
+	}
+	proposalID, _ := sdk.ProposalIDFromContext(ctx)
+
+	return msr.paramChangeRecorder.RecordParamChange(ctx, sdk.ParamChange{
+		Module:     module,
+		Height:     ctx.BlockHeight(),
+		Authority:  authority,
+		ProposalID: proposalID,
+		Old:        old,
+		New:        newParams,
+	})
+}
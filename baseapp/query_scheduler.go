@@ -0,0 +1,197 @@
+package baseapp
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// QueryPriority is the priority class of a query run by the QueryScheduler.
+type QueryPriority int
+
+const (
+	// QueryPriorityLow is the priority of queries originated externally, i.e.
+	// served by the gRPC server or ABCI Query.
+	QueryPriorityLow QueryPriority = iota
+	// QueryPriorityHigh is the priority of queries originated internally, e.g.
+	// from the PrepareProposal or vote extension handlers, which must never be
+	// delayed by external load.
+	QueryPriorityHigh
+)
+
+// clamp returns p within the range of the priority classes, so that any
+// QueryPriority value can be scheduled.
+func (p QueryPriority) clamp() QueryPriority {
+	switch {
+	case p < QueryPriorityLow:
+		return QueryPriorityLow
+	case p > QueryPriorityHigh:
+		return QueryPriorityHigh
+	default:
+		return p
+	}
+}
+
+// String implements fmt.Stringer.
+func (p QueryPriority) String() string {
+	if p == QueryPriorityHigh {
+		return "high"
+	}
+
+	return "low"
+}
+
+type queryPriorityKey struct{}
+
+// WithQueryPriority returns a copy of ctx with which queries are scheduled with
+// priority p.
+func WithQueryPriority(ctx context.Context, p QueryPriority) context.Context {
+	return context.WithValue(ctx, queryPriorityKey{}, p)
+}
+
+// QueryPriorityFromContext returns the query priority set on ctx, or def if
+// none is set.
+func QueryPriorityFromContext(ctx context.Context, def QueryPriority) QueryPriority {
+	if p, ok := ctx.Value(queryPriorityKey{}).(QueryPriority); ok {
+		return p
+	}
+
+	return def
+}
+
+// QueryScheduler bounds the number of queries run concurrently. Queries
+// waiting for a slot are served by priority, high priority queries first, and
+// a number of slots is reserved to high priority queries, so that external
+// query load cannot delay the internally originated ones, e.g. while building
+// a proposal.
+//
+// The time spent by queries waiting for a slot is reported with the
+// query_queue_wait telemetry metric, labeled by priority class.
+type QueryScheduler struct {
+	maxConcurrent int
+	reserved      int
+
+	mtx     sync.Mutex
+	running int
+	waiting [2]*list.List // waiting queries by priority, each a chan struct{}
+}
+
+// NewQueryScheduler returns a QueryScheduler running up to maxConcurrent
+// queries at once, reserved of which only to high priority queries. reserved
+// is capped to maxConcurrent - 1 so that low priority queries can still run.
+// It returns nil, disabling scheduling, if maxConcurrent is 0.
+func NewQueryScheduler(maxConcurrent, reserved int) *QueryScheduler {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+
+	if reserved >= maxConcurrent {
+		reserved = maxConcurrent - 1
+	}
+	if reserved < 0 {
+		reserved = 0
+	}
+
+	return &QueryScheduler{
+		maxConcurrent: maxConcurrent,
+		reserved:      reserved,
+		waiting:       [2]*list.List{list.New(), list.New()},
+	}
+}
+
+// Acquire waits for a slot to run a query with priority p, returning a function
+// releasing it once the query is done. It returns the error of ctx if ctx is
+// done before a slot is available. A nil scheduler doesn't bound queries.
+// Priorities below QueryPriorityLow or above QueryPriorityHigh are clamped to
+// them.
+func (s *QueryScheduler) Acquire(ctx context.Context, p QueryPriority) (release func(), err error) {
+	if s == nil {
+		return func() {}, nil
+	}
+
+	p = p.clamp()
+
+	start := time.Now()
+	defer telemetry.MeasureSince(start, "query", "queue_wait", p.String())
+
+	s.mtx.Lock()
+	if s.canRun(p) {
+		s.running++
+		s.mtx.Unlock()
+		return s.release, nil
+	}
+
+	ready := make(chan struct{})
+	elem := s.waiting[p].PushBack(ready)
+	s.mtx.Unlock()
+
+	select {
+	case <-ready:
+		return s.release, nil
+
+	case <-ctx.Done():
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		select {
+		case <-ready:
+			// the slot was handed over concurrently, give it back
+			s.handOver()
+		default:
+			s.waiting[p].Remove(elem)
+		}
+
+		return nil, ctx.Err()
+	}
+}
+
+// canRun returns whether a query with priority p can run right away. Queries
+// never overtake waiting queries of the same or a higher priority.
+func (s *QueryScheduler) canRun(p QueryPriority) bool {
+	if s.waiting[QueryPriorityHigh].Len() > 0 {
+		return false
+	}
+
+	if p == QueryPriorityHigh {
+		return s.running < s.maxConcurrent
+	}
+
+	return s.waiting[QueryPriorityLow].Len() == 0 && s.running < s.maxConcurrent-s.reserved
+}
+
+func (s *QueryScheduler) release() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.handOver()
+}
+
+// handOver hands the slot of a finished query over to the next waiting query,
+// if any can run, or frees it.
+func (s *QueryScheduler) handOver() {
+	if front := s.waiting[QueryPriorityHigh].Front(); front != nil {
+		s.waiting[QueryPriorityHigh].Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+
+	// a low priority query can only take over the slot if it isn't reserved
+	if front := s.waiting[QueryPriorityLow].Front(); front != nil && s.running <= s.maxConcurrent-s.reserved {
+		s.waiting[QueryPriorityLow].Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+
+	s.running--
+}
+
+// Running returns the number of queries currently running.
+func (s *QueryScheduler) Running() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.running
+}
@@ -0,0 +1,90 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+func TestQueryScheduler(t *testing.T) {
+	// a nil scheduler doesn't bound queries
+	require.Nil(t, baseapp.NewQueryScheduler(0, 0))
+	var disabled *baseapp.QueryScheduler
+	release, err := disabled.Acquire(context.Background(), baseapp.QueryPriorityLow)
+	require.NoError(t, err)
+	release()
+
+	s := baseapp.NewQueryScheduler(2, 1)
+	releaseLow, err := s.Acquire(context.Background(), baseapp.QueryPriorityLow)
+	require.NoError(t, err)
+
+	// the second slot is reserved to high priority queries
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, baseapp.QueryPriorityLow)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	releaseHigh, err := s.Acquire(context.Background(), baseapp.QueryPriorityHigh)
+	require.NoError(t, err)
+	require.Equal(t, 2, s.Running())
+
+	// once all slots are taken, waiting high priority queries are served first
+	order := make(chan baseapp.QueryPriority, 2)
+	waitFor := func(p baseapp.QueryPriority) {
+		release, err := s.Acquire(context.Background(), p)
+		require.NoError(t, err)
+		order <- p
+		release()
+	}
+
+	go waitFor(baseapp.QueryPriorityLow)
+	time.Sleep(10 * time.Millisecond)
+	go waitFor(baseapp.QueryPriorityHigh)
+	time.Sleep(10 * time.Millisecond)
+
+	releaseHigh()
+	require.Equal(t, baseapp.QueryPriorityHigh, <-order)
+
+	releaseLow()
+	require.Equal(t, baseapp.QueryPriorityLow, <-order)
+
+	require.Eventually(t, func() bool { return s.Running() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestQuerySchedulerOutOfRangePriority(t *testing.T) {
+	s := baseapp.NewQueryScheduler(2, 1)
+
+	// priorities out of range are clamped rather than indexing out of the queues
+	releaseLow, err := s.Acquire(context.Background(), baseapp.QueryPriority(-1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, baseapp.QueryPriority(-1))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	releaseHigh, err := s.Acquire(context.Background(), baseapp.QueryPriority(5))
+	require.NoError(t, err)
+	require.Equal(t, 2, s.Running())
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, baseapp.QueryPriority(5))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	releaseHigh()
+	releaseLow()
+	require.Equal(t, 0, s.Running())
+}
+
+func TestQueryPriorityFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, baseapp.QueryPriorityHigh, baseapp.QueryPriorityFromContext(ctx, baseapp.QueryPriorityHigh))
+
+	ctx = baseapp.WithQueryPriority(ctx, baseapp.QueryPriorityLow)
+	require.Equal(t, baseapp.QueryPriorityLow, baseapp.QueryPriorityFromContext(ctx, baseapp.QueryPriorityHigh))
+}
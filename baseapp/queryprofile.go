@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryProfiler tracks the store accesses of the queries served by the app,
+// such as the profiler of the server/queryprofile package.
+type QueryProfiler interface {
+	// Track wraps ms, the multi-store of the query at path and height, so that
+	// its store accesses are tracked, and returns the function to call once
+	// the query is served.
+	Track(ms storetypes.MultiStore, path string, height int64) (storetypes.MultiStore, func())
+}
+
+// EnableQueryProfiler tracks the queries served by the app with profiler,
+// while it records a profile, so that the slowest queries and the store
+// prefixes they accessed can be reported on demand. Queries aren't tracked
+// while no profile is recorded.
+func (app *BaseApp) EnableQueryProfiler(profiler QueryProfiler) {
+	app.queryProfiler = profiler
+}
+
+// QueryProfiler returns the query profiler of the app, or nil if query
+// profiling is not enabled.
+func (app *BaseApp) QueryProfiler() QueryProfiler {
+	return app.queryProfiler
+}
+
+// profileQuery tracks the store accesses of the query at path served with
+// ctx, if a profile is being recorded, and returns the function to call once
+// the query is served.
+func (app *BaseApp) profileQuery(ctx sdk.Context, path string) (sdk.Context, func()) {
+	if app.queryProfiler == nil {
+		return ctx, func() {}
+	}
+
+	ms, done := app.queryProfiler.Track(ctx.MultiStore(), path, ctx.BlockHeight())
+	return ctx.WithMultiStore(ms), done
+}
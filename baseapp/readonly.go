@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/readonlykv"
+)
+
+// ReadOnlyMsgExecutor executes Msg service handlers without writing state, so
+// that queries can report what the execution of a message would yield without
+// duplicating the logic of its handler.
+type ReadOnlyMsgExecutor interface {
+	ExecReadOnly(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+}
+
+var _ ReadOnlyMsgExecutor = &MsgServiceRouter{}
+
+// ExecReadOnly executes msg with its handler in read-only mode: the stores of
+// the context can be read, but any write aborts the execution, which fails with
+// sdkerrors.ErrReadOnlyWrite. Events are emitted on a new event manager and
+// only returned in the result.
+func (msr *MsgServiceRouter) ExecReadOnly(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+	handler := msr.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			writeErr, ok := r.(readonlykv.ErrorWriteAttempted)
+			if !ok {
+				panic(r)
+			}
+
+			res, err = nil, errorsmod.Wrap(sdkerrors.ErrReadOnlyWrite, writeErr.Error())
+		}
+	}()
+
+	return handler(ctx.WithReadOnly(true).WithEventManager(sdk.NewEventManager()), msg)
+}
@@ -0,0 +1,45 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestExecReadOnly(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	registry := testdata.NewTestInterfaceRegistry()
+	baseapptestutil.RegisterInterfaces(registry)
+
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(registry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	// handlers which only read state are executed
+	res, err := msr.ExecReadOnly(ctx, &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}})
+	require.NoError(t, err)
+	require.Len(t, res.MsgResponses, 1)
+
+	// handlers writing state fail and leave it untouched
+	msr = baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(registry)
+	baseapptestutil.RegisterCounterServer(msr, CounterServerImpl{t, key, []byte("counter")})
+
+	_, err = msr.ExecReadOnly(ctx, &baseapptestutil.MsgCounter{Counter: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrReadOnlyWrite)
+	require.Nil(t, ctx.KVStore(key).Get([]byte("counter")))
+
+	// messages without handler are rejected
+	_, err = msr.ExecReadOnly(ctx, &testdata.MsgCreateDog{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
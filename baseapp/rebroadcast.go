@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// broadcastTxMethod is the gRPC method of the tx service submitting txs.
+const broadcastTxMethod = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+
+// Rebroadcaster rebroadcasts the txs submitted through the tx service until
+// they are committed, which it is notified of as an ABCIListener, such as the
+// manager of the server/rebroadcast package.
+type Rebroadcaster interface {
+	storetypes.ABCIListener
+
+	// IsPending returns whether txBytes was submitted and isn't committed yet.
+	IsPending(txBytes []byte) bool
+	// Track starts tracking txBytes, successfully submitted to the network,
+	// until it is committed or expires.
+	Track(txBytes []byte) bool
+}
+
+// EnableRebroadcast tracks the txs submitted through the tx service of the gRPC
+// server with manager, which rebroadcasts them until they are committed. The
+// submission of a tx already pending isn't broadcast again.
+//
+// It must be called before the gRPC server is started.
+func (app *BaseApp) EnableRebroadcast(manager Rebroadcaster) {
+	app.rebroadcast = manager
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, manager)
+}
+
+// RebroadcastManager returns the rebroadcast manager of the app, or nil if
+// rebroadcast is not enabled.
+func (app *BaseApp) RebroadcastManager() Rebroadcaster {
+	return app.rebroadcast
+}
+
+// rebroadcastInterceptor tracks the txs successfully submitted with the
+// BroadcastTx method of the tx service, and suppresses duplicate submissions.
+func (app *BaseApp) rebroadcastInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	broadcastReq, ok := req.(*txtypes.BroadcastTxRequest)
+	if app.rebroadcast == nil || info.FullMethod != broadcastTxMethod || !ok {
+		return handler(ctx, req)
+	}
+
+	if app.rebroadcast.IsPending(broadcastReq.TxBytes) {
+		return &txtypes.BroadcastTxResponse{
+			TxResponse: &sdk.TxResponse{
+				TxHash: fmt.Sprintf("%X", cmttypes.Tx(broadcastReq.TxBytes).Hash()),
+				RawLog: "tx already submitted and pending",
+			},
+		}, nil
+	}
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	if broadcastRes, ok := res.(*txtypes.BroadcastTxResponse); ok && broadcastRes.TxResponse != nil && broadcastRes.TxResponse.Code == 0 {
+		app.rebroadcast.Track(broadcastReq.TxBytes)
+	}
+
+	return res, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
 )
 
 // RecoveryHandler handles recovery() object.
@@ -66,6 +67,21 @@ func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recov
 	return newRecoveryMiddleware(handler, next)
 }
 
+// newResourceLimitRecoveryMiddleware creates a recovery middleware for the tx
+// store access limits, returning the error code of the exceeded limit.
+func newResourceLimitRecoveryMiddleware(next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		err, ok := recoveryObj.(limitkv.ErrorLimitExceeded)
+		if !ok {
+			return nil
+		}
+
+		return errorsmod.Wrapf(err.Err, "limit: %d", err.Limit)
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
 // newDefaultRecoveryMiddleware creates a default (last in chain) recovery middleware for app.runTx method.
 func newDefaultRecoveryMiddleware() recoveryMiddleware {
 	handler := func(recoveryObj interface{}) error {
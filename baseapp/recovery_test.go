@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
 )
 
 // Test that recovery chain produces expected error at specific middleware layer
@@ -62,3 +65,14 @@ func TestRecoveryChain(t *testing.T) {
 		require.Nil(t, receivedErr)
 	}
 }
+
+func TestResourceLimitRecovery(t *testing.T) {
+	mw := newResourceLimitRecoveryMiddleware(newDefaultRecoveryMiddleware())
+
+	err := processRecovery(limitkv.ErrorLimitExceeded{Err: sdkerrors.ErrStoreWriteLimit, Limit: 5}, mw)
+	require.ErrorIs(t, err, sdkerrors.ErrStoreWriteLimit)
+	require.Contains(t, err.Error(), "limit: 5")
+
+	err = processRecovery("other panic", mw)
+	require.ErrorIs(t, err, sdkerrors.ErrPanic)
+}
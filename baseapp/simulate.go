@@ -0,0 +1,101 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// traceStoreNameKey is the trace context metadata key set by the cache
+// multi-store to identify the store which emitted a trace.
+const traceStoreNameKey = "store_name"
+
+// traceOperation mirrors the JSON records written by the tracing KVStore.
+type traceOperation struct {
+	Operation string                 `json:"operation"`
+	Key       string                 `json:"key"`
+	Value     string                 `json:"value"`
+	Metadata  map[string]interface{} `json:"metadata"`
+}
+
+// SimulateWithStateChanges executes a tx in simulate mode and, in addition to
+// the result and gas info, returns the state changes the tx would perform if
+// it were included in a block. Changes are collapsed per store and key, so
+// only the final value of each written key is reported.
+func (app *BaseApp) SimulateWithStateChanges(txBytes []byte) (sdk.GasInfo, *sdk.Result, []sdk.StateChange, error) {
+	var buf bytes.Buffer
+
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+	ctx = ctx.WithMultiStore(ctx.MultiStore().SetTracer(&buf))
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	if err != nil {
+		return gasInfo, result, nil, err
+	}
+
+	changes, err := parseStateChanges(&buf)
+	if err != nil {
+		return gasInfo, result, nil, err
+	}
+
+	return gasInfo, result, changes, nil
+}
+
+// parseStateChanges decodes the write and delete operations recorded by the
+// tracing KVStore and returns them sorted by store name and key.
+func parseStateChanges(buf *bytes.Buffer) ([]sdk.StateChange, error) {
+	type storeKey struct {
+		store string
+		key   string
+	}
+
+	changes := make(map[storeKey]sdk.StateChange)
+
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var op traceOperation
+		if err := dec.Decode(&op); err != nil {
+			return nil, fmt.Errorf("failed to decode store trace: %w", err)
+		}
+
+		if op.Operation != "write" && op.Operation != "delete" {
+			continue
+		}
+
+		storeName, _ := op.Metadata[traceStoreNameKey].(string)
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode store trace key: %w", err)
+		}
+
+		change := sdk.StateChange{StoreKey: storeName, Key: key}
+		if op.Operation == "delete" {
+			change.Delete = true
+		} else {
+			change.Value, err = base64.StdEncoding.DecodeString(op.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode store trace value: %w", err)
+			}
+		}
+
+		changes[storeKey{storeName, string(key)}] = change
+	}
+
+	res := make([]sdk.StateChange, 0, len(changes))
+	for _, change := range changes {
+		res = append(res, change)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].StoreKey != res[j].StoreKey {
+			return res[i].StoreKey < res[j].StoreKey
+		}
+		return bytes.Compare(res[i].Key, res[j].Key) < 0
+	})
+
+	return res, nil
+}
@@ -71,9 +71,10 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
+	// keep the listeners registered by the application itself, e.g. state checksums
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append([]storetypes.ABCIListener{abciListener}, app.streamingManager.ABCIListeners...),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
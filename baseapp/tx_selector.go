@@ -0,0 +1,255 @@
+package baseapp
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"strconv"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The strategies of the built-in TxSelectors, selectable by name with
+// NewTxSelector.
+const (
+	// TxSelectorFIFO selects txs in the order of the mempool, until the block
+	// is full. It is the default TxSelector.
+	TxSelectorFIFO = "fifo"
+	// TxSelectorFeePriority selects the txs paying the highest gas price first.
+	TxSelectorFeePriority = "fee-priority"
+	// TxSelectorGasKnapsack packs the block so that it collects the most fees
+	// within its gas and byte limits.
+	TxSelectorGasKnapsack = "gas-knapsack"
+)
+
+// candidateBytesFactor bounds the txs considered by the ordering TxSelectors to
+// this many times the maximum bytes of a proposal, so that they do not
+// enumerate a whole large mempool.
+const candidateBytesFactor = 4
+
+// NewTxSelector returns the built-in TxSelector of a strategy. An empty
+// strategy is the default one.
+func NewTxSelector(strategy string) (TxSelector, error) {
+	switch strategy {
+	case "", TxSelectorFIFO:
+		return NewDefaultTxSelector(), nil
+	case TxSelectorFeePriority:
+		return NewFeePriorityTxSelector(), nil
+	case TxSelectorGasKnapsack:
+		return NewGasKnapsackTxSelector(), nil
+	default:
+		return nil, fmt.Errorf("unknown tx selector %q; expected one of %s, %s or %s", strategy, TxSelectorFIFO, TxSelectorFeePriority, TxSelectorGasKnapsack)
+	}
+}
+
+// NewFeePriorityTxSelector returns a TxSelector which selects the txs paying
+// the highest gas price first, skipping those which no longer fit in the
+// block. The gas price of a tx is the lowest of the gas prices of its fee
+// coins, as for the priority computed by the default ante handler.
+//
+// The txs of a sender, identified by their first signer, keep their relative
+// order so that their sequences remain valid.
+func NewFeePriorityTxSelector() TxSelector {
+	return &orderedTxSelector{
+		score: func(tx candidateTx, _, _ uint64) math.LegacyDec {
+			return tx.gasPrice
+		},
+	}
+}
+
+// NewGasKnapsackTxSelector returns a TxSelector which packs the block so that it
+// collects the most fees within its gas and byte limits. It greedily selects
+// the txs paying the most fees for the share of the block they take, that is
+// the largest of their share of the block gas and of the block bytes, skipping
+// those which no longer fit in the block.
+//
+// The txs of a sender, identified by their first signer, keep their relative
+// order so that their sequences remain valid.
+func NewGasKnapsackTxSelector() TxSelector {
+	return &orderedTxSelector{
+		score: func(tx candidateTx, maxTxBytes, maxBlockGas uint64) math.LegacyDec {
+			share := math.LegacyNewDec(int64(tx.size)).QuoInt64(int64(max(maxTxBytes, 1)))
+			if maxBlockGas > 0 {
+				gasShare := math.LegacyNewDec(int64(tx.gas)).QuoInt64(int64(maxBlockGas))
+				share = math.LegacyMaxDec(share, gasShare)
+			}
+			if !share.IsPositive() {
+				return tx.fees
+			}
+			return tx.fees.Quo(share)
+		},
+	}
+}
+
+// candidateTx is a tx considered by an orderedTxSelector.
+type candidateTx struct {
+	bz       []byte
+	sender   string
+	size     uint64
+	gas      uint64
+	gasPrice math.LegacyDec
+	fees     math.LegacyDec
+}
+
+// orderedTxSelector collects the candidate txs, then selects them in the order
+// of their score, highest first, while keeping the order of the txs of each
+// sender.
+type orderedTxSelector struct {
+	score func(tx candidateTx, maxTxBytes, maxBlockGas uint64) math.LegacyDec
+
+	maxTxBytes     uint64
+	maxBlockGas    uint64
+	candidateBytes uint64
+	candidates     []candidateTx
+	selectedTxs    [][]byte
+	selected       bool
+}
+
+var _ TxSelector = (*orderedTxSelector)(nil)
+
+func (ts *orderedTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	if !ts.selected {
+		ts.selectedTxs = ts.selectTxs()
+		ts.selected = true
+	}
+
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *orderedTxSelector) Clear() {
+	ts.maxTxBytes = 0
+	ts.maxBlockGas = 0
+	ts.candidateBytes = 0
+	ts.candidates = nil
+	ts.selectedTxs = nil
+	ts.selected = false
+}
+
+func (ts *orderedTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	ts.maxTxBytes = maxTxBytes
+	ts.maxBlockGas = maxBlockGas
+
+	tx := candidateTx{
+		bz:       txBz,
+		sender:   txSender(memTx, len(ts.candidates)),
+		size:     uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})),
+		gasPrice: math.LegacyZeroDec(),
+		fees:     math.LegacyZeroDec(),
+	}
+	if gasTx, ok := memTx.(GasTx); ok {
+		tx.gas = gasTx.GetGas()
+	}
+	if feeTx, ok := memTx.(sdk.FeeTx); ok {
+		tx.gasPrice = gasPrice(feeTx.GetFee(), tx.gas)
+		tx.fees = tx.gasPrice.MulInt64(int64(max(tx.gas, 1)))
+	}
+
+	ts.candidates = append(ts.candidates, tx)
+	ts.candidateBytes += tx.size
+
+	return ts.candidateBytes >= candidateBytesFactor*maxTxBytes
+}
+
+// selectTxs selects the candidate txs fitting in the block by score. Only the
+// first tx of each sender is eligible at a time; if it does not fit, the
+// following txs of the sender are skipped too.
+func (ts *orderedTxSelector) selectTxs() [][]byte {
+	queues := make(map[string][]candidateTx)
+	h := &candidateHeap{}
+	for i, tx := range ts.candidates {
+		if _, ok := queues[tx.sender]; !ok {
+			heap.Push(h, scoredSender{sender: tx.sender, index: i, score: ts.score(tx, ts.maxTxBytes, ts.maxBlockGas)})
+		}
+		queues[tx.sender] = append(queues[tx.sender], tx)
+	}
+
+	var (
+		selected   [][]byte
+		totalBytes uint64
+		totalGas   uint64
+	)
+	for h.Len() > 0 {
+		next := heap.Pop(h).(scoredSender)
+		queue := queues[next.sender]
+		tx := queue[0]
+
+		if totalBytes+tx.size > ts.maxTxBytes || (ts.maxBlockGas > 0 && totalGas+tx.gas > ts.maxBlockGas) {
+			continue
+		}
+
+		selected = append(selected, tx.bz)
+		totalBytes += tx.size
+		totalGas += tx.gas
+
+		queues[next.sender] = queue[1:]
+		if len(queue) > 1 {
+			heap.Push(h, scoredSender{sender: next.sender, index: next.index, score: ts.score(queue[1], ts.maxTxBytes, ts.maxBlockGas)})
+		}
+	}
+
+	return selected
+}
+
+// txSender returns the first signer of a tx, which identifies the txs whose
+// order must be kept. A tx without signers is its own sender.
+func txSender(tx sdk.Tx, index int) string {
+	if sigTx, ok := tx.(interface{ GetSigners() ([][]byte, error) }); ok {
+		if signers, err := sigTx.GetSigners(); err == nil && len(signers) > 0 {
+			return string(signers[0])
+		}
+	}
+
+	return "tx/" + strconv.Itoa(index)
+}
+
+// gasPrice returns the lowest of the gas prices of the fee coins.
+func gasPrice(fee sdk.Coins, gas uint64) math.LegacyDec {
+	price := math.LegacyZeroDec()
+	for i, c := range fee {
+		p := math.LegacyNewDecFromInt(c.Amount).QuoInt64(int64(max(gas, 1)))
+		if i == 0 || p.LT(price) {
+			price = p
+		}
+	}
+
+	return price
+}
+
+// scoredSender is the first tx of a sender yet to be selected, along with its
+// score. index is the position of the first tx of the sender among the
+// candidates, which breaks ties deterministically.
+type scoredSender struct {
+	sender string
+	index  int
+	score  math.LegacyDec
+}
+
+// candidateHeap is a max-heap of scoredSender by score.
+type candidateHeap []scoredSender
+
+func (h candidateHeap) Len() int { return len(h) }
+
+func (h candidateHeap) Less(i, j int) bool {
+	if !h[i].score.Equal(h[j].score) {
+		return h[i].score.GT(h[j].score)
+	}
+	return h[i].index < h[j].index
+}
+
+func (h candidateHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *candidateHeap) Push(x any) { *h = append(*h, x.(scoredSender)) }
+
+func (h *candidateHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
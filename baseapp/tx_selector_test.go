@@ -0,0 +1,83 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type selectorTx struct {
+	signer string
+	gas    uint64
+	fee    int64
+}
+
+func (tx selectorTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx selectorTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx selectorTx) GetGas() uint64                        { return tx.gas }
+func (tx selectorTx) GetFee() sdk.Coins                     { return sdk.NewCoins(sdk.NewInt64Coin("stake", tx.fee)) }
+func (tx selectorTx) FeePayer() []byte                      { return []byte(tx.signer) }
+func (tx selectorTx) FeeGranter() []byte                    { return nil }
+func (tx selectorTx) GetSigners() ([][]byte, error)         { return [][]byte{[]byte(tx.signer)}, nil }
+
+func TestTxSelectors(t *testing.T) {
+	type candidate struct {
+		name string
+		size int
+		tx   selectorTx
+	}
+
+	selectTxs := func(strategy string, maxTxBytes, maxBlockGas uint64, candidates []candidate) []string {
+		ts, err := baseapp.NewTxSelector(strategy)
+		require.NoError(t, err)
+
+		// run twice to check that the selector is cleared
+		var names []string
+		for i := 0; i < 2; i++ {
+			for _, c := range candidates {
+				bz := append([]byte(c.name), bytes.Repeat([]byte{0}, c.size-len(c.name))...)
+				if ts.SelectTxForProposal(context.Background(), maxTxBytes, maxBlockGas, c.tx, bz) {
+					break
+				}
+			}
+
+			names = nil
+			for _, bz := range ts.SelectedTxs(context.Background()) {
+				names = append(names, string(bytes.TrimRight(bz, "\x00")))
+			}
+			ts.Clear()
+		}
+		return names
+	}
+
+	// the txs of a sender keep their order, even if a later one pays more
+	byPrice := []candidate{
+		{"a1", 10, selectorTx{signer: "a", gas: 100, fee: 100}},
+		{"a2", 10, selectorTx{signer: "a", gas: 100, fee: 1000}},
+		{"b1", 10, selectorTx{signer: "b", gas: 100, fee: 500}},
+		{"c1", 10, selectorTx{signer: "c", gas: 300, fee: 1200}},
+	}
+	require.Equal(t, []string{"a1", "a2", "b1"}, selectTxs(baseapp.TxSelectorFIFO, 1000, 300, byPrice))
+	require.Equal(t, []string{"b1", "a1", "a2"}, selectTxs(baseapp.TxSelectorFeePriority, 1000, 300, byPrice))
+	require.Equal(t, []string{"b1", "c1"}, selectTxs(baseapp.TxSelectorFeePriority, 1000, 400, byPrice))
+
+	// a large tx paying the highest gas price takes most of the block bytes,
+	// while smaller ones collect more fees in total
+	bySize := []candidate{
+		{"x", 100, selectorTx{signer: "x", gas: 100, fee: 600}},
+		{"y", 10, selectorTx{signer: "y", gas: 100, fee: 500}},
+		{"z", 10, selectorTx{signer: "z", gas: 100, fee: 500}},
+		{"w", 10, selectorTx{signer: "w", gas: 100, fee: 500}},
+	}
+	require.Equal(t, []string{"x", "y"}, selectTxs(baseapp.TxSelectorFeePriority, 120, 300, bySize))
+	require.Equal(t, []string{"y", "z", "w"}, selectTxs(baseapp.TxSelectorGasKnapsack, 120, 300, bySize))
+
+	_, err := baseapp.NewTxSelector("random")
+	require.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// minAdaptiveTxDecodeCacheSize and maxAdaptiveTxDecodeCacheSize bound the
+	// number of txs cached by an adaptive tx decode cache.
+	minAdaptiveTxDecodeCacheSize = 256
+	maxAdaptiveTxDecodeCacheSize = 1 << 16
+
+	// txDecodeCacheWindow is the number of lookups after which an adaptive tx
+	// decode cache is resized according to its hit rate over the window.
+	txDecodeCacheWindow = 1000
+
+	// an adaptive tx decode cache grows while full with a hit rate of at least
+	// txDecodeCacheGrowHitRate, and shrinks below txDecodeCacheShrinkHitRate.
+	txDecodeCacheGrowHitRate   = 0.5
+	txDecodeCacheShrinkHitRate = 0.1
+)
+
+// txDecodeCache caches decoded txs, keyed by the hash of their bytes, so that a
+// tx decoded in CheckTx is not decoded again when proposed, verified and
+// delivered. Decoding only depends on the tx bytes and on the decoder, so
+// entries never need to be invalidated on state changes. The cache is purged
+// when the decoder is replaced, and txs are evicted once included in a block.
+//
+// An adaptive cache is sized by its hit rate instead of a fixed size: it grows
+// while it is full and most lookups hit, and shrinks when few do, within a
+// memory budget approximated by the size of the cached tx bytes.
+type txDecodeCache struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+
+	size, minSize, maxSize int
+	budget, bytes          int
+	lookups, hits          int
+}
+
+type txDecodeCacheEntry struct {
+	tx   sdk.Tx
+	size int
+}
+
+func newTxDecodeCache(size int) (*txDecodeCache, error) {
+	return newTxDecodeCacheWithBounds(size, size, size, 0)
+}
+
+func newAdaptiveTxDecodeCache(budget int) (*txDecodeCache, error) {
+	return newTxDecodeCacheWithBounds(minAdaptiveTxDecodeCacheSize, minAdaptiveTxDecodeCacheSize, maxAdaptiveTxDecodeCacheSize, budget)
+}
+
+func newTxDecodeCacheWithBounds(size, minSize, maxSize, budget int) (*txDecodeCache, error) {
+	c := &txDecodeCache{size: size, minSize: minSize, maxSize: maxSize, budget: budget}
+
+	cache, err := lru.NewWithEvict(size, func(_, value interface{}) {
+		c.bytes -= value.(txDecodeCacheEntry).size
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache = cache
+	return c, nil
+}
+
+func (c *txDecodeCache) get(txBytes []byte) (sdk.Tx, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.cache.Get(sha256.Sum256(txBytes))
+	c.lookups++
+	if ok {
+		c.hits++
+	}
+	if c.lookups == txDecodeCacheWindow {
+		c.adapt()
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	return entry.(txDecodeCacheEntry).tx, true
+}
+
+func (c *txDecodeCache) add(txBytes []byte, tx sdk.Tx) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := sha256.Sum256(txBytes)
+	c.cache.Remove(key)
+	c.cache.Add(key, txDecodeCacheEntry{tx: tx, size: len(txBytes)})
+	c.bytes += len(txBytes)
+
+	for c.budget > 0 && c.bytes > c.budget && c.cache.Len() > 0 {
+		c.cache.RemoveOldest()
+	}
+}
+
+func (c *txDecodeCache) evict(txs [][]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, txBytes := range txs {
+		c.cache.Remove(sha256.Sum256(txBytes))
+	}
+}
+
+func (c *txDecodeCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache.Purge()
+}
+
+// adapt resizes the cache according to its hit rate over the last window of
+// lookups, and reports its size. It must be called with the lock held.
+func (c *txDecodeCache) adapt() {
+	hitRate := float64(c.hits) / float64(c.lookups)
+	c.lookups, c.hits = 0, 0
+
+	switch {
+	case hitRate >= txDecodeCacheGrowHitRate && c.cache.Len() >= c.size && c.size < c.maxSize &&
+		(c.budget == 0 || 2*c.bytes <= c.budget):
+		c.size = min(2*c.size, c.maxSize)
+		c.cache.Resize(c.size)
+
+	case hitRate < txDecodeCacheShrinkHitRate && c.size > c.minSize:
+		c.size = max(c.size/2, c.minSize)
+		c.cache.Resize(c.size)
+	}
+
+	telemetry.SetGauge(float32(c.size), "tx_decode_cache", "size")
+	telemetry.SetGauge(float32(c.bytes), "tx_decode_cache", "bytes")
+	telemetry.SetGauge(float32(hitRate), "tx_decode_cache", "hit_rate")
+}
+
+// decodeTx decodes txBytes with the tx decoder of the app, through the tx
+// decode cache if enabled. Decoding errors are not cached.
+func (app *BaseApp) decodeTx(txBytes []byte) (sdk.Tx, error) {
+	if app.txDecodeCache == nil {
+		return app.txDecoder(txBytes)
+	}
+
+	if tx, ok := app.txDecodeCache.get(txBytes); ok {
+		return tx, nil
+	}
+
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	app.txDecodeCache.add(txBytes, tx)
+	return tx, nil
+}
@@ -0,0 +1,88 @@
+package baseapp_test
+
+import (
+	"errors"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type decodedTx struct{ bz string }
+
+func (decodedTx) GetMsgs() []sdk.Msg                    { return nil }
+func (decodedTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestTxDecodeCache(t *testing.T) {
+	var decoded int
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		decoded++
+		if len(bz) == 0 {
+			return nil, errors.New("empty tx")
+		}
+		return decodedTx{bz: string(bz)}, nil
+	}
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), decoder, baseapp.SetTxDecodeCacheSize(2))
+
+	tx, err := app.TxDecode([]byte("tx1"))
+	require.NoError(t, err)
+	require.Equal(t, decodedTx{bz: "tx1"}, tx)
+
+	tx, err = app.TxDecode([]byte("tx1"))
+	require.NoError(t, err)
+	require.Equal(t, decodedTx{bz: "tx1"}, tx)
+	require.Equal(t, 1, decoded)
+
+	// decoding errors are not cached
+	_, err = app.TxDecode(nil)
+	require.Error(t, err)
+	_, err = app.TxDecode(nil)
+	require.Error(t, err)
+	require.Equal(t, 3, decoded)
+
+	// the least recently used tx is evicted
+	for _, bz := range []string{"tx2", "tx3", "tx1"} {
+		_, err = app.TxDecode([]byte(bz))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 6, decoded)
+
+	// replacing the decoder purges the cache
+	app.SetTxDecoder(decoder)
+	_, err = app.TxDecode([]byte("tx1"))
+	require.NoError(t, err)
+	require.Equal(t, 7, decoded)
+}
+
+func TestTxDecodeCacheBudget(t *testing.T) {
+	var decoded int
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		decoded++
+		return decodedTx{bz: string(bz)}, nil
+	}
+
+	// the budget only fits two txs
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), decoder, baseapp.SetTxDecodeCacheBudget(6))
+
+	for _, bz := range []string{"tx1", "tx2", "tx2", "tx3", "tx2", "tx1"} {
+		_, err := app.TxDecode([]byte(bz))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, decoded)
+
+	// a zero budget leaves the cache as is
+	app = baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), decoder, baseapp.SetTxDecodeCacheSize(1), baseapp.SetTxDecodeCacheBudget(0))
+	decoded = 0
+	for _, bz := range []string{"tx1", "tx1"} {
+		_, err := app.TxDecode([]byte(bz))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, decoded)
+}
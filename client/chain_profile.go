@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+// DefaultChainRegistryURL is the base URL of the Cosmos chain registry, holding
+// a <chain-name>/chain.json file per network.
+const DefaultChainRegistryURL = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+// ChainProfile holds the settings of a network, selected with the --profile
+// flag or the profile entry of client.toml, so that a single client can be used
+// with several networks. Flags set explicitly take precedence over the profile.
+type ChainProfile struct {
+	ChainID      string `mapstructure:"chain-id" json:"chain-id"`
+	Node         string `mapstructure:"node" json:"node"`
+	GRPCAddress  string `mapstructure:"grpc-address" json:"grpc-address"`
+	GRPCInsecure bool   `mapstructure:"grpc-insecure" json:"grpc-insecure"`
+	// AddressPrefix is the bech32 prefix of account addresses, validator and
+	// consensus addresses using the <prefix>valoper and <prefix>valcons prefixes.
+	AddressPrefix string `mapstructure:"address-prefix" json:"address-prefix"`
+	// FeeDenom and GasPrice define the default gas prices of transactions,
+	// used when neither --fees nor --gas-prices is set.
+	FeeDenom string `mapstructure:"fee-denom" json:"fee-denom"`
+	GasPrice string `mapstructure:"gas-price" json:"gas-price"`
+	// ChainRegistry is the name of the network in the chain registry. When set,
+	// the settings left empty are populated from the registry.
+	ChainRegistry string `mapstructure:"chain-registry" json:"chain-registry"`
+}
+
+// GasPrices returns the default gas prices of the profile, or an empty string
+// if the profile has none.
+func (p ChainProfile) GasPrices() string {
+	if p.FeeDenom == "" || p.GasPrice == "" {
+		return ""
+	}
+
+	return p.GasPrice + p.FeeDenom
+}
+
+func (p ChainProfile) complete() bool {
+	return p.ChainID != "" && p.Node != "" && p.AddressPrefix != "" && p.FeeDenom != "" && p.GasPrice != ""
+}
+
+// WithChainProfiles returns a copy of the context with updated chain profiles.
+func (ctx Context) WithChainProfiles(profiles map[string]ChainProfile) Context {
+	ctx.ChainProfiles = profiles
+	return ctx
+}
+
+// WithChainRegistryURL returns a copy of the context with an updated chain
+// registry base URL.
+func (ctx Context) WithChainRegistryURL(url string) Context {
+	ctx.ChainRegistryURL = url
+	return ctx
+}
+
+// WithGasPrices returns a copy of the context with updated default gas prices.
+func (ctx Context) WithGasPrices(gasPrices string) Context {
+	ctx.GasPrices = gasPrices
+	return ctx
+}
+
+// WithChainProfile returns a copy of the context configured for the chain
+// profile name: chain ID, CometBFT and gRPC clients, address codecs and default
+// gas prices. Settings left empty in the profile are populated from the chain
+// registry when the profile references it, and are left unchanged otherwise.
+func (ctx Context) WithChainProfile(name string) (Context, error) {
+	profile, ok := ctx.ChainProfiles[name]
+	if !ok {
+		return ctx, fmt.Errorf("unknown chain profile %q", name)
+	}
+
+	if profile.ChainRegistry != "" && !profile.complete() {
+		registryURL := ctx.ChainRegistryURL
+		if registryURL == "" {
+			registryURL = DefaultChainRegistryURL
+		}
+
+		cmdCtx := ctx.CmdContext
+		if cmdCtx == nil {
+			cmdCtx = context.Background()
+		}
+
+		var err error
+		profile, err = FetchChainProfile(cmdCtx, http.DefaultClient, registryURL, profile)
+		if err != nil {
+			return ctx, fmt.Errorf("chain profile %s: %w", name, err)
+		}
+	}
+
+	ctx = ctx.WithProfile(name)
+
+	if profile.ChainID != "" {
+		ctx = ctx.WithChainID(profile.ChainID)
+	}
+
+	if profile.Node != "" {
+		client, err := NewClientFromNode(profile.Node)
+		if err != nil {
+			return ctx, fmt.Errorf("chain profile %s: %w", name, err)
+		}
+		ctx = ctx.WithNodeURI(profile.Node).WithClient(client)
+	}
+
+	if profile.GRPCAddress != "" {
+		grpcClient, err := newGRPCClient(profile.GRPCAddress, profile.GRPCInsecure)
+		if err != nil {
+			return ctx, fmt.Errorf("chain profile %s: %w", name, err)
+		}
+		ctx = ctx.WithGRPCClient(grpcClient)
+	}
+
+	if profile.AddressPrefix != "" {
+		ctx = ctx.
+			WithAddressCodec(addresscodec.NewBech32Codec(profile.AddressPrefix)).
+			WithValidatorAddressCodec(addresscodec.NewBech32Codec(profile.AddressPrefix + "valoper")).
+			WithConsensusAddressCodec(addresscodec.NewBech32Codec(profile.AddressPrefix + "valcons"))
+	}
+
+	if gasPrices := profile.GasPrices(); gasPrices != "" {
+		ctx = ctx.WithGasPrices(gasPrices)
+	}
+
+	return ctx, nil
+}
+
+// WithProfile returns a copy of the context with an updated selected profile
+// name.
+func (ctx Context) WithProfile(name string) Context {
+	ctx.Profile = name
+	return ctx
+}
+
+// chainRegistryEntry is the subset of a chain registry chain.json file used to
+// populate chain profiles.
+type chainRegistryEntry struct {
+	ChainID      string `json:"chain_id"`
+	Bech32Prefix string `json:"bech32_prefix"`
+	Fees         struct {
+		FeeTokens []struct {
+			Denom           string      `json:"denom"`
+			AverageGasPrice json.Number `json:"average_gas_price"`
+		} `json:"fee_tokens"`
+	} `json:"fees"`
+	APIs struct {
+		RPC  []struct{ Address string } `json:"rpc"`
+		GRPC []struct{ Address string } `json:"grpc"`
+	} `json:"apis"`
+}
+
+// FetchChainProfile populates the settings left empty in profile from the
+// chain registry entry of profile.ChainRegistry, using the first endpoints and
+// fee token listed in the registry.
+func FetchChainProfile(ctx context.Context, httpClient *http.Client, registryURL string, profile ChainProfile) (ChainProfile, error) {
+	if profile.ChainRegistry == "" {
+		return profile, errors.New("no chain registry name set")
+	}
+
+	url := strings.TrimRight(registryURL, "/") + "/" + profile.ChainRegistry + "/chain.json"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return profile, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return profile, fmt.Errorf("failed to query chain registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return profile, fmt.Errorf("chain registry returned status %d for %s", resp.StatusCode, profile.ChainRegistry)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return profile, err
+	}
+
+	var entry chainRegistryEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return profile, fmt.Errorf("invalid chain registry entry for %s: %w", profile.ChainRegistry, err)
+	}
+
+	if profile.ChainID == "" {
+		profile.ChainID = entry.ChainID
+	}
+	if profile.AddressPrefix == "" {
+		profile.AddressPrefix = entry.Bech32Prefix
+	}
+	if profile.Node == "" && len(entry.APIs.RPC) > 0 {
+		profile.Node = entry.APIs.RPC[0].Address
+	}
+	if profile.GRPCAddress == "" && len(entry.APIs.GRPC) > 0 {
+		profile.GRPCAddress = entry.APIs.GRPC[0].Address
+	}
+	if len(entry.Fees.FeeTokens) > 0 {
+		token := entry.Fees.FeeTokens[0]
+		if profile.FeeDenom == "" {
+			profile.FeeDenom = token.Denom
+		}
+		if profile.GasPrice == "" && token.Denom == profile.FeeDenom && token.AverageGasPrice != "" {
+			if _, err := strconv.ParseFloat(token.AverageGasPrice.String(), 64); err == nil {
+				profile.GasPrice = token.AverageGasPrice.String()
+			}
+		}
+	}
+
+	return profile, nil
+}
+
+// newGRPCClient dials the gRPC endpoint at addr, using TLS unless useInsecure
+// is set.
+func newGRPCClient(addr string, useInsecure bool) (*grpc.ClientConn, error) {
+	var dialOpts []grpc.DialOption
+	if useInsecure {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})))
+	}
+
+	return grpc.Dial(addr, dialOpts...)
+}
@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const chainRegistryEntry = `{
+	"chain_name": "testhub",
+	"chain_id": "testhub-4",
+	"bech32_prefix": "test",
+	"fees": {"fee_tokens": [{"denom": "utest", "average_gas_price": 0.025}]},
+	"apis": {
+		"rpc": [{"address": "https://rpc.testhub.example:443"}],
+		"grpc": [{"address": "grpc.testhub.example:443"}]
+	}
+}`
+
+func TestFetchChainProfile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/testhub/chain.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(chainRegistryEntry))
+	}))
+	defer srv.Close()
+
+	// settings set in the profile take precedence over the registry
+	profile, err := client.FetchChainProfile(context.Background(), srv.Client(), srv.URL, client.ChainProfile{
+		ChainRegistry: "testhub",
+		Node:          "http://localhost:26657",
+	})
+	require.NoError(t, err)
+	require.Equal(t, client.ChainProfile{
+		ChainID:       "testhub-4",
+		Node:          "http://localhost:26657",
+		GRPCAddress:   "grpc.testhub.example:443",
+		AddressPrefix: "test",
+		FeeDenom:      "utest",
+		GasPrice:      "0.025",
+		ChainRegistry: "testhub",
+	}, profile)
+	require.Equal(t, "0.025utest", profile.GasPrices())
+
+	_, err = client.FetchChainProfile(context.Background(), srv.Client(), srv.URL, client.ChainProfile{ChainRegistry: "unknown"})
+	require.ErrorContains(t, err, "status 404")
+}
+
+func TestWithChainProfile(t *testing.T) {
+	ctx := client.Context{}.WithChainID("default-1").WithChainProfiles(map[string]client.ChainProfile{
+		"testnet": {
+			ChainID:       "testnet-1",
+			Node:          "http://localhost:26657",
+			AddressPrefix: "test",
+			FeeDenom:      "utest",
+			GasPrice:      "0.1",
+		},
+	})
+
+	_, err := ctx.WithChainProfile("mainnet")
+	require.ErrorContains(t, err, `unknown chain profile "mainnet"`)
+
+	ctx, err = ctx.WithChainProfile("testnet")
+	require.NoError(t, err)
+	require.Equal(t, "testnet", ctx.Profile)
+	require.Equal(t, "testnet-1", ctx.ChainID)
+	require.Equal(t, "http://localhost:26657", ctx.NodeURI)
+	require.NotNil(t, ctx.Client)
+	require.Equal(t, "0.1utest", ctx.GasPrices)
+
+	addr, err := ctx.AddressCodec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	require.Regexp(t, "^test1", addr)
+
+	valAddr, err := ctx.ValidatorAddressCodec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	require.Regexp(t, "^testvaloper1", valAddr)
+}
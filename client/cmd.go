@@ -1,7 +1,6 @@
 package client
 
 import (
-	"crypto/tls"
 	"fmt"
 	"strings"
 
@@ -9,9 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/exp/slices"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 
@@ -121,6 +117,18 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithKeyringDir(keyringDir)
 	}
 
+	// the profile is applied first, so that the flags set explicitly take
+	// precedence over its settings
+	if flagSet.Changed(flags.FlagProfile) {
+		profile, _ := flagSet.GetString(flags.FlagProfile)
+
+		var err error
+		clientCtx, err = clientCtx.WithChainProfile(profile)
+		if err != nil {
+			return clientCtx, err
+		}
+	}
+
 	if clientCtx.ChainID == "" || flagSet.Changed(flags.FlagChainID) {
 		chainID, _ := flagSet.GetString(flags.FlagChainID)
 		clientCtx = clientCtx.WithChainID(chainID)
@@ -156,18 +164,8 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.GRPCClient == nil || flagSet.Changed(flags.FlagGRPC) {
 		grpcURI, _ := flagSet.GetString(flags.FlagGRPC)
 		if grpcURI != "" {
-			var dialOpts []grpc.DialOption
-
 			useInsecure, _ := flagSet.GetBool(flags.FlagGRPCInsecure)
-			if useInsecure {
-				dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-			} else {
-				dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-					MinVersion: tls.VersionTLS12,
-				})))
-			}
-
-			grpcClient, err := grpc.Dial(grpcURI, dialOpts...)
+			grpcClient, err := newGRPCClient(grpcURI, useInsecure)
 			if err != nil {
 				return Context{}, err
 			}
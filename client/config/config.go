@@ -18,6 +18,7 @@ func DefaultConfig() *Config {
 		Output:                "text",
 		Node:                  "tcp://localhost:26657",
 		BroadcastMode:         "sync",
+		ChainRegistryURL:      client.DefaultChainRegistryURL,
 	}
 }
 
@@ -32,6 +33,11 @@ type Config struct {
 	Output                string `mapstructure:"output" json:"output"`
 	Node                  string `mapstructure:"node" json:"node"`
 	BroadcastMode         string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+
+	// Profile is the name of the chain profile applied by default.
+	Profile          string                         `mapstructure:"profile" json:"profile"`
+	ChainRegistryURL string                         `mapstructure:"chain-registry-url" json:"chain-registry-url"`
+	Profiles         map[string]client.ChainProfile `mapstructure:"profiles" json:"profiles"`
 }
 
 // ReadFromClientConfig reads values from client.toml file and updates them in client.Context
@@ -115,7 +121,15 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 		WithNodeURI(conf.Node).
 		WithBroadcastMode(conf.BroadcastMode).
 		WithClient(client).
-		WithKeyring(keyring)
+		WithKeyring(keyring).
+		WithChainProfiles(conf.Profiles).
+		WithChainRegistryURL(conf.ChainRegistryURL)
+
+	if conf.Profile != "" {
+		if ctx, err = ctx.WithChainProfile(conf.Profile); err != nil {
+			return ctx, fmt.Errorf("couldn't apply chain profile: %w", err)
+		}
+	}
 
 	return ctx, nil
 }
@@ -27,6 +27,35 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async)
 broadcast-mode = "{{ .BroadcastMode }}"
+# Chain profile applied by default, among the profiles defined below
+profile = "{{ .Profile }}"
+# Base URL of the chain registry, used to populate the profiles referencing it
+chain-registry-url = "{{ .ChainRegistryURL }}"
+
+###############################################################################
+###                              Chain Profiles                             ###
+###############################################################################
+
+# Chain profiles hold the settings of several networks, and are selected with
+# the --profile flag. Settings left empty in a profile referencing the chain
+# registry are populated from it, e.g.
+#
+# [profiles.cosmoshub]
+# chain-registry = "cosmoshub"
+# node = "https://rpc.cosmos.network:443"
+# gas-price = "0.025"
+{{- range $name, $profile := .Profiles }}
+
+[profiles.{{ $name }}]
+chain-id = "{{ $profile.ChainID }}"
+node = "{{ $profile.Node }}"
+grpc-address = "{{ $profile.GRPCAddress }}"
+grpc-insecure = {{ $profile.GRPCInsecure }}
+address-prefix = "{{ $profile.AddressPrefix }}"
+fee-denom = "{{ $profile.FeeDenom }}"
+gas-price = "{{ $profile.GasPrice }}"
+chain-registry = "{{ $profile.ChainRegistry }}"
+{{- end }}
 `
 
 var configTemplate *template.Template
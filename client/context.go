@@ -75,6 +75,15 @@ type Context struct {
 	AddressCodec          address.Codec
 	ValidatorAddressCodec address.Codec
 	ConsensusAddressCodec address.Codec
+
+	// ChainProfiles are the network profiles which can be selected with the
+	// --profile flag, Profile being the name of the selected one.
+	ChainProfiles    map[string]ChainProfile
+	ChainRegistryURL string
+	Profile          string
+	// GasPrices are the default gas prices of transactions, used when neither
+	// fees nor gas prices are set by flags.
+	GasPrices string
 }
 
 // WithCmdContext returns a copy of the context with an updated context.Context,
@@ -0,0 +1,254 @@
+// Package denom converts coin amounts between the units of a denomination, as
+// described by the denom metadata of the x/bank module, formats them for
+// display and parses human input such as "12.5 atom".
+//
+// Conversions are exact: converting an amount which can't be represented in
+// the target unit, e.g. 0.0000001 atom in uatom, fails instead of rounding.
+package denom
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// maxExponent is the maximum exponent of a denom unit supported by the
+// converter, as display amounts are represented by decimals with 18 decimal places.
+const maxExponent = math.LegacyPrecision
+
+var amountRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*(\S+)$`)
+
+// unit is a unit of a denomination.
+type unit struct {
+	metadata *banktypes.Metadata
+	exponent uint32
+}
+
+// Converter converts coin amounts between the units of the denominations it
+// knows the metadata of.
+type Converter struct {
+	metadata []banktypes.Metadata
+	// units maps the denoms and aliases of all denom units to their unit
+	units map[string]unit
+}
+
+// NewConverter returns a converter between the units of the denominations
+// described by metadata.
+func NewConverter(metadata ...banktypes.Metadata) (*Converter, error) {
+	c := &Converter{
+		metadata: metadata,
+		units:    make(map[string]unit),
+	}
+
+	for i := range c.metadata {
+		m := &c.metadata[i]
+		if err := m.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid metadata of %s: %w", m.Base, err)
+		}
+
+		for _, denomUnit := range m.DenomUnits {
+			if denomUnit.Exponent > maxExponent {
+				return nil, fmt.Errorf("exponent %d of %s is greater than the maximum of %d", denomUnit.Exponent, denomUnit.Denom, maxExponent)
+			}
+
+			for _, name := range append([]string{denomUnit.Denom}, denomUnit.Aliases...) {
+				if _, ok := c.units[name]; ok {
+					return nil, fmt.Errorf("denom unit %s is defined more than once", name)
+				}
+				c.units[name] = unit{metadata: m, exponent: denomUnit.Exponent}
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// NewConverterFromChain returns a converter between the units of all the
+// denominations whose metadata is registered in the x/bank module of the chain
+// queried by clientCtx.
+func NewConverterFromChain(ctx context.Context, clientCtx client.Context) (*Converter, error) {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+
+	var (
+		metadata []banktypes.Metadata
+		nextKey  []byte
+	)
+	for {
+		res, err := queryClient.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		metadata = append(metadata, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	return NewConverter(metadata...)
+}
+
+// Metadata returns the metadata of the denomination of which denom is a unit or
+// an alias of a unit, or false if it is unknown.
+func (c *Converter) Metadata(denom string) (banktypes.Metadata, bool) {
+	u, ok := c.units[denom]
+	if !ok {
+		return banktypes.Metadata{}, false
+	}
+
+	return *u.metadata, true
+}
+
+// ToBase converts coin, in any unit of its denomination, to its base unit. It
+// fails if the amount is not a whole number of base units.
+func (c *Converter) ToBase(coin sdk.DecCoin) (sdk.Coin, error) {
+	u, ok := c.units[coin.Denom]
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("unknown denom %s", coin.Denom)
+	}
+
+	amount := coin.Amount.MulInt(pow10(u.exponent))
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s is not a whole number of %s", coin, u.metadata.Base)
+	}
+
+	return sdk.NewCoin(u.metadata.Base, amount.TruncateInt()), nil
+}
+
+// Convert converts coin, in any unit of its denomination, to the toDenom unit
+// of the same denomination.
+func (c *Converter) Convert(coin sdk.DecCoin, toDenom string) (sdk.DecCoin, error) {
+	to, ok := c.units[toDenom]
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("unknown denom %s", toDenom)
+	}
+
+	base, err := c.ToBase(coin)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if base.Denom != to.metadata.Base {
+		return sdk.DecCoin{}, fmt.Errorf("cannot convert %s to %s: not a unit of %s", coin.Denom, toDenom, base.Denom)
+	}
+
+	// the converted amount is exact, as the exponent is at most the precision of decimals
+	amount := math.LegacyNewDecFromBigIntWithPrec(base.Amount.BigInt(), int64(to.exponent))
+	return sdk.NewDecCoinFromDec(toDenom, amount), nil
+}
+
+// ToDisplay converts coin, in any unit of its denomination, to the display unit
+// of its denomination.
+func (c *Converter) ToDisplay(coin sdk.DecCoin) (sdk.DecCoin, error) {
+	u, ok := c.units[coin.Denom]
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("unknown denom %s", coin.Denom)
+	}
+
+	return c.Convert(coin, u.metadata.Display)
+}
+
+// Format formats coin in the display unit of its denomination, without
+// trailing zeros, e.g. "12.5 atom" for 12500000uatom. Coins of unknown
+// denominations are formatted in their base unit.
+func (c *Converter) Format(coin sdk.Coin) (string, error) {
+	if _, ok := c.units[coin.Denom]; !ok {
+		return fmt.Sprintf("%s %s", coin.Amount, coin.Denom), nil
+	}
+
+	display, err := c.ToDisplay(sdk.NewDecCoinFromCoin(coin))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s %s", formatDec(display.Amount), display.Denom), nil
+}
+
+// FormatCoins formats each of coins with Format, separated by commas.
+func (c *Converter) FormatCoins(coins sdk.Coins) (string, error) {
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		var err error
+		if formatted[i], err = c.Format(coin); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// Parse parses a coin amount typed by a user, such as "12.5 atom", "12.5atom"
+// or "12500000uatom", in any unit or unit alias of a denomination, and returns
+// it in the base unit of the denomination. Amounts of unknown denominations
+// must be whole numbers and are returned as they are.
+func (c *Converter) Parse(input string) (sdk.Coin, error) {
+	matches := amountRegex.FindStringSubmatch(strings.TrimSpace(input))
+	if matches == nil {
+		return sdk.Coin{}, fmt.Errorf("invalid amount %q: expected an amount followed by a denom", input)
+	}
+
+	amount, err := math.LegacyNewDecFromStr(matches[1])
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid amount %q: %w", input, err)
+	}
+
+	denom := matches[2]
+	if _, ok := c.units[denom]; !ok {
+		if !amount.IsInteger() {
+			return sdk.Coin{}, fmt.Errorf("invalid amount %q: unknown denom %s must be a whole number", input, denom)
+		}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		return sdk.NewCoin(denom, amount.TruncateInt()), nil
+	}
+
+	return c.ToBase(sdk.DecCoin{Denom: denom, Amount: amount})
+}
+
+// ParseCoins parses comma separated coin amounts with Parse.
+func (c *Converter) ParseCoins(input string) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, s := range strings.Split(input, ",") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+
+		coin, err := c.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+func pow10(exponent uint32) math.Int {
+	return math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil))
+}
+
+// formatDec formats d without trailing zeros.
+func formatDec(d math.LegacyDec) string {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
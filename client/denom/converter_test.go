@@ -0,0 +1,104 @@
+package denom_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client/denom"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Description: "The native staking token of the Cosmos Hub.",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6, Aliases: []string{"ATOM"}},
+	},
+	Base:    "uatom",
+	Display: "atom",
+	Name:    "Cosmos Hub Atom",
+	Symbol:  "ATOM",
+}
+
+func TestConverter(t *testing.T) {
+	c, err := denom.NewConverter(atomMetadata)
+	require.NoError(t, err)
+
+	m, ok := c.Metadata("ATOM")
+	require.True(t, ok)
+	require.Equal(t, "uatom", m.Base)
+
+	display, err := c.ToDisplay(sdk.NewInt64DecCoin("uatom", 12_500_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("12.5")), display)
+
+	converted, err := c.Convert(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.0015")), "matom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("matom", math.LegacyMustNewDecFromStr("1.5")), converted)
+
+	base, err := c.ToBase(sdk.NewDecCoinFromDec("matom", math.LegacyMustNewDecFromStr("1.5")))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1500), base)
+
+	// fractions of base units are rejected rather than rounded
+	_, err = c.ToBase(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.0000001")))
+	require.Error(t, err)
+
+	_, err = c.Convert(sdk.NewInt64DecCoin("uatom", 1), "stake")
+	require.Error(t, err)
+
+	formatted, err := c.Format(sdk.NewInt64Coin("uatom", 12_500_000))
+	require.NoError(t, err)
+	require.Equal(t, "12.5 atom", formatted)
+
+	formatted, err = c.FormatCoins(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("stake", 3)))
+	require.NoError(t, err)
+	require.Equal(t, "3 stake, 2 atom", formatted)
+
+	testCases := []struct {
+		input  string
+		exp    sdk.Coin
+		expErr bool
+	}{
+		{"12.5 atom", sdk.NewInt64Coin("uatom", 12_500_000), false},
+		{"12.5atom", sdk.NewInt64Coin("uatom", 12_500_000), false},
+		{" 1 ATOM ", sdk.NewInt64Coin("uatom", 1_000_000), false},
+		{"3 microatom", sdk.NewInt64Coin("uatom", 3), false},
+		{"0.001 matom", sdk.NewInt64Coin("uatom", 1), false},
+		{"10stake", sdk.NewInt64Coin("stake", 10), false},
+		{"0.0001 matom", sdk.Coin{}, true},
+		{"1.5stake", sdk.Coin{}, true},
+		{"-1 atom", sdk.Coin{}, true},
+		{"atom", sdk.Coin{}, true},
+		{"1 2 atom", sdk.Coin{}, true},
+	}
+
+	for _, tc := range testCases {
+		coin, err := c.Parse(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.exp, coin, tc.input)
+	}
+
+	coins, err := c.ParseCoins("1.5 atom, 500000uatom,10stake")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("stake", 10)), coins)
+}
+
+func TestNewConverterInvalidMetadata(t *testing.T) {
+	invalid := atomMetadata
+	invalid.Display = "unknown"
+	_, err := denom.NewConverter(invalid)
+	require.Error(t, err)
+
+	_, err = denom.NewConverter(atomMetadata, atomMetadata)
+	require.Error(t, err)
+}
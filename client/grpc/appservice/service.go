@@ -0,0 +1,211 @@
+package appservice
+
+import (
+	"context"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBatchQueries is the maximum number of queries of a batch query.
+	MaxBatchQueries = 100
+
+	// DefaultBlockTimeWindow is the number of blocks the block time is averaged
+	// over by the BlockTime query.
+	DefaultBlockTimeWindow = 100
+)
+
+// RegisterAppService registers the app service of app on the provided gRPC
+// router. Registered on the gRPC query router of the app, the service is served
+// both by the gRPC server and by ABCI queries.
+func RegisterAppService(server gogogrpc.Server, app *baseapp.BaseApp) {
+	RegisterServiceServer(server, NewQueryServer(app))
+}
+
+var _ ServiceServer = queryServer{}
+
+// queryServer serves the queries of the app itself rather than of its modules,
+// such as the simulation of the state changes of a tx.
+type queryServer struct {
+	app *baseapp.BaseApp
+}
+
+// NewQueryServer returns the server of the app service of app.
+func NewQueryServer(app *baseapp.BaseApp) ServiceServer {
+	return queryServer{app: app}
+}
+
+// SimulateStateChanges implements the Service/SimulateStateChanges gRPC method.
+func (s queryServer) SimulateStateChanges(_ context.Context, req *SimulateStateChangesRequest) (*SimulateStateChangesResponse, error) {
+	if len(req.GetTxBytes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	gasInfo, result, changes, err := s.app.SimulateWithStateChanges(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to simulate tx: %v", err)
+	}
+
+	res := &SimulateStateChangesResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+		Changes: make([]StateChange, len(changes)),
+	}
+	for i, change := range changes {
+		res.Changes[i] = StateChange{StoreKey: change.StoreKey, Key: change.Key, Value: change.Value, Delete: change.Delete}
+	}
+
+	return res, nil
+}
+
+// StateChecksums implements the Service/StateChecksums gRPC method.
+func (s queryServer) StateChecksums(context.Context, *StateChecksumsRequest) (*StateChecksumsResponse, error) {
+	checksums, ok := s.app.StateChecksums()
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "state checksums are not enabled")
+	}
+
+	res := &StateChecksumsResponse{
+		Height: checksums.Height,
+		Stores: make([]StoreChecksum, 0, len(checksums.Stores)),
+	}
+	for name, checksum := range checksums.Stores {
+		res.Stores = append(res.Stores, StoreChecksum{StoreKey: name, Block: checksum.Block, Cumulative: checksum.Cumulative})
+	}
+	sort.Slice(res.Stores, func(i, j int) bool { return res.Stores[i].StoreKey < res.Stores[j].StoreKey })
+
+	return res, nil
+}
+
+// EventStore is an event store serving the Events query of the app service,
+// such as the store of the server/eventstore package.
+type EventStore interface {
+	baseapp.EventStore
+
+	// Events returns the events selected by req.
+	Events(req *EventsRequest) (*EventsResponse, error)
+}
+
+// Events implements the Service/Events gRPC method.
+func (s queryServer) Events(_ context.Context, req *EventsRequest) (*EventsResponse, error) {
+	store, ok := s.app.EventStore().(EventStore)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "event store is not enabled")
+	}
+	if req == nil {
+		req = &EventsRequest{}
+	}
+
+	res, err := store.Events(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+// BatchQuery implements the Service/BatchQuery gRPC method. It serves the gRPC
+// queries of req all at the height of ctx, so that clients get a consistent
+// view of the state instead of racing blocks with separate queries.
+func (s queryServer) BatchQuery(ctx context.Context, req *BatchQueryRequest) (*BatchQueryResponse, error) {
+	if n := len(req.GetQueries()); n == 0 || n > MaxBatchQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "a batch query must have between 1 and %d queries, got %d", MaxBatchQueries, n)
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	res := &BatchQueryResponse{
+		Height:  height,
+		Results: make([]BatchQueryResult, len(req.Queries)),
+	}
+	for i, q := range req.Queries {
+		resp := s.app.ServeGRPCQuery(&abci.RequestQuery{Path: q.Path, Data: q.Data, Height: height})
+		res.Results[i] = BatchQueryResult{
+			Code:      resp.Code,
+			Codespace: resp.Codespace,
+			Log:       resp.Log,
+			Value:     resp.Value,
+		}
+	}
+
+	return res, nil
+}
+
+// ChainMetadata implements the Service/ChainMetadata gRPC method, serving the
+// chain metadata at the height of ctx.
+func (s queryServer) ChainMetadata(ctx context.Context, _ *ChainMetadataRequest) (*ChainMetadataResponse, error) {
+	md, err := s.app.ChainMetadata(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get chain metadata: %v", err)
+	}
+
+	res := &ChainMetadataResponse{
+		ChainId:    md.ChainID,
+		AppName:    md.AppName,
+		AppVersion: md.AppVersion,
+		SdkVersion: md.SDKVersion,
+		Runtime:    md.Runtime,
+		Bech32Prefixes: Bech32Prefixes{
+			Account:   md.Bech32Prefixes.Account,
+			Validator: md.Bech32Prefixes.Validator,
+			Consensus: md.Bech32Prefixes.Consensus,
+		},
+		BondDenom: md.BondDenom,
+		Modules:   md.Modules,
+		Features:  md.Features,
+	}
+	for _, denom := range md.Denoms {
+		res.Denoms = append(res.Denoms, DenomMetadata{Base: denom.Base, Display: denom.Display, Exponent: denom.Exponent})
+	}
+
+	return res, nil
+}
+
+// BlockTime implements the Service/BlockTime gRPC method. It serves the block
+// time estimate and converts the height or time of req.
+func (s queryServer) BlockTime(_ context.Context, req *BlockTimeRequest) (*BlockTimeResponse, error) {
+	if req == nil {
+		req = &BlockTimeRequest{}
+	}
+	if req.Height != 0 && req.Time != nil {
+		return nil, status.Error(codes.InvalidArgument, "cannot convert both a height and a time")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative height %d", req.Height)
+	}
+
+	estimate, err := s.app.EstimateBlockTime(DefaultBlockTimeWindow)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res := &BlockTimeResponse{
+		Estimate: BlockTimeEstimate{
+			Height:    estimate.Height,
+			Time:      estimate.Time,
+			BlockTime: estimate.BlockTime,
+			Window:    estimate.Window,
+		},
+	}
+	switch {
+	case req.Height != 0:
+		t := estimate.TimeAt(req.Height)
+		if req.Height <= estimate.Height {
+			if actual, err := s.app.CommittedBlockTime(req.Height); err == nil {
+				t = actual
+			}
+		}
+		res.Height, res.Time = req.Height, &t
+
+	case req.Time != nil:
+		res.Height, res.Time = estimate.HeightAt(*req.Time), req.Time
+	}
+
+	return res, nil
+}
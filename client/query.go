@@ -17,9 +17,17 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// GetNode returns an RPC client. If the context's client is not defined, an
-// error is returned.
+// ErrOfflineMode is returned when an operation would require a network lookup
+// while the client context is in offline mode.
+var ErrOfflineMode = errors.New("network lookups are not allowed in offline mode")
+
+// GetNode returns an RPC client. If the context's client is not defined, or the
+// context is in offline mode, an error is returned.
 func (ctx Context) GetNode() (CometRPC, error) {
+	if ctx.Offline {
+		return nil, ErrOfflineMode
+	}
+
 	if ctx.Client == nil {
 		return nil, errors.New("no RPC client is defined in offline mode")
 	}
@@ -19,6 +19,8 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
+		ExportMirrorCmd(),
+		FetchSnapshotCmd(),
 	)
 	return cmd
 }
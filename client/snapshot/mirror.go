@@ -0,0 +1,231 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+// A snapshot mirror is a static file tree, served over HTTPS e.g. from an S3
+// bucket, which nodes can fetch snapshots from instead of using p2p state sync:
+//
+//	<base-url>/manifest.json                     manifest of the latest snapshot
+//	<base-url>/<height>/<format>/manifest.json   manifest of a snapshot
+//	<base-url>/<height>/<format>/<chunk>         chunks of a snapshot
+//
+// Manifests list the hashes of the snapshot chunks and are signed with the
+// ed25519 key of the mirror operator, which nodes trust explicitly, so that
+// any chunk tampered with is rejected.
+
+// MirrorManifestFileName is the file name of snapshot manifests in mirrors.
+const MirrorManifestFileName = "manifest.json"
+
+// MirrorManifest describes a snapshot served by a mirror.
+type MirrorManifest struct {
+	Height      uint64   `json:"height"`
+	Format      uint32   `json:"format"`
+	Hash        []byte   `json:"hash"`
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+	Signature   []byte   `json:"signature,omitempty"`
+}
+
+// NewMirrorManifest returns the unsigned manifest of snapshot.
+func NewMirrorManifest(snapshot *snapshottypes.Snapshot) *MirrorManifest {
+	return &MirrorManifest{
+		Height:      snapshot.Height,
+		Format:      snapshot.Format,
+		Hash:        snapshot.Hash,
+		ChunkHashes: snapshot.Metadata.ChunkHashes,
+	}
+}
+
+// SignBytes returns the bytes signed by the mirror operator: the JSON encoding
+// of the manifest without signature.
+func (m MirrorManifest) SignBytes() ([]byte, error) {
+	m.Signature = nil
+	return json.Marshal(m)
+}
+
+// Sign signs the manifest with key.
+func (m *MirrorManifest) Sign(key ed25519.PrivateKey) error {
+	bz, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	m.Signature = ed25519.Sign(key, bz)
+	return nil
+}
+
+// Verify checks that the manifest is well formed and signed by trustedKey.
+func (m MirrorManifest) Verify(trustedKey ed25519.PublicKey) error {
+	if m.Height == 0 {
+		return errors.New("invalid manifest: snapshot height cannot be 0")
+	}
+
+	if len(m.ChunkHashes) == 0 {
+		return errors.New("invalid manifest: no chunks")
+	}
+
+	for i, hash := range m.ChunkHashes {
+		if len(hash) != sha256.Size {
+			return fmt.Errorf("invalid manifest: invalid hash of chunk %d", i)
+		}
+	}
+
+	bz, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(trustedKey, bz, m.Signature) {
+		return errors.New("invalid manifest signature")
+	}
+
+	return nil
+}
+
+// VerifyChunk checks that chunk is the chunk at index of the snapshot.
+func (m MirrorManifest) VerifyChunk(index uint32, chunk []byte) error {
+	if int(index) >= len(m.ChunkHashes) {
+		return fmt.Errorf("unexpected chunk %d", index)
+	}
+
+	hash := sha256.Sum256(chunk)
+	if !bytes.Equal(hash[:], m.ChunkHashes[index]) {
+		return fmt.Errorf("chunk %d hash mismatch: expected %X, got %X", index, m.ChunkHashes[index], hash[:])
+	}
+
+	return nil
+}
+
+// mirrorSnapshotPath returns the path of a snapshot relative to the mirror base.
+func mirrorSnapshotPath(height uint64, format uint32) string {
+	return strconv.FormatUint(height, 10) + "/" + strconv.FormatUint(uint64(format), 10)
+}
+
+// mirrorClient fetches snapshots from a list of mirrors, falling back to the
+// next mirror when a request fails.
+type mirrorClient struct {
+	client  *http.Client
+	mirrors []string
+}
+
+// get returns the content of path, relative to the mirror base, from the first
+// mirror serving it for which verify succeeds. verify may be nil.
+func (c mirrorClient) get(ctx context.Context, path string, maxSize int64, verify func([]byte) error) ([]byte, error) {
+	var errs []error
+	for _, mirror := range c.mirrors {
+		bz, err := c.getFrom(ctx, strings.TrimSuffix(mirror, "/")+"/"+path, maxSize)
+		if err == nil && verify != nil {
+			err = verify(bz)
+		}
+		if err == nil {
+			return bz, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", mirror, err))
+	}
+
+	return nil, fmt.Errorf("failed to fetch %s: %w", path, errors.Join(errs...))
+}
+
+func (c mirrorClient) getFrom(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(bz)) > maxSize {
+		return nil, fmt.Errorf("response exceeds %d bytes", maxSize)
+	}
+
+	return bz, nil
+}
+
+// fetchManifest returns the manifest of the snapshot at path, relative to the
+// mirror base, verified with trustedKey.
+func (c mirrorClient) fetchManifest(ctx context.Context, path string, trustedKey ed25519.PublicKey) (*MirrorManifest, error) {
+	var manifest MirrorManifest
+	_, err := c.get(ctx, path, maxManifestSize, func(bz []byte) error {
+		manifest = MirrorManifest{}
+		if err := json.Unmarshal(bz, &manifest); err != nil {
+			return err
+		}
+
+		return manifest.Verify(trustedKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+const (
+	// maxManifestSize is the maximum size of a manifest fetched from a mirror.
+	maxManifestSize = 16 << 20
+	// maxChunkSize is the maximum size of a chunk fetched from a mirror, way
+	// above the size of the chunks written by the snapshot manager.
+	maxChunkSize = 128 << 20
+)
+
+// parseTrustedKey parses a hex encoded ed25519 public key.
+func parseTrustedKey(s string) (ed25519.PublicKey, error) {
+	bz, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted key: %w", err)
+	}
+
+	if len(bz) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid trusted key: expected %d bytes, got %d", ed25519.PublicKeySize, len(bz))
+	}
+
+	return ed25519.PublicKey(bz), nil
+}
+
+// readSigningKey reads the hex encoded ed25519 seed of the file at path.
+func readSigningKey(path string) (ed25519.PrivateKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	seed, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid signing key: expected %d bytes seed, got %d", ed25519.SeedSize, len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
@@ -0,0 +1,139 @@
+package snapshot
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagSigningKey = "signing-key"
+	flagLatest     = "latest"
+)
+
+// ExportMirrorCmd returns a command to write a local snapshot as a signed
+// snapshot mirror tree, to be uploaded to an HTTPS server or an S3 bucket.
+func ExportMirrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-mirror <height> <format> <output-dir>",
+		Short: "Write a local snapshot as a signed snapshot mirror tree",
+		Long: `Write a local snapshot as a signed snapshot mirror tree in output-dir, to be
+uploaded to an HTTPS server or an S3 bucket from which nodes fetch it with the
+fetch command.
+
+The snapshot manifest is signed with the ed25519 key whose hex encoded 32 bytes
+seed is read from the --signing-key file, e.g. generated with
+"openssl rand -hex 32". Nodes fetching the snapshot must trust the public key
+printed by this command.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			keyFile, err := cmd.Flags().GetString(flagSigningKey)
+			if err != nil {
+				return err
+			}
+			if keyFile == "" {
+				return fmt.Errorf("--%s is required", flagSigningKey)
+			}
+			key, err := readSigningKey(keyFile)
+			if err != nil {
+				return err
+			}
+
+			latest, err := cmd.Flags().GetBool(flagLatest)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := snapshotStore.Get(height, uint32(format))
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return errors.New("snapshot doesn't exist")
+			}
+
+			dir := filepath.Join(args[2], filepath.FromSlash(mirrorSnapshotPath(height, uint32(format))))
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return err
+			}
+
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				src := snapshotStore.PathChunk(height, uint32(format), i)
+				if err := copyFile(src, filepath.Join(dir, strconv.FormatUint(uint64(i), 10))); err != nil {
+					return err
+				}
+			}
+
+			manifest := NewMirrorManifest(snapshot)
+			if err := manifest.Sign(key); err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(filepath.Join(dir, MirrorManifestFileName), bz, 0o644); err != nil {
+				return err
+			}
+			if latest {
+				if err := os.WriteFile(filepath.Join(args[2], MirrorManifestFileName), bz, 0o644); err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("exported snapshot %d format %d, trusted key: %s\n", height, format, hex.EncodeToString(key.Public().(ed25519.PublicKey)))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSigningKey, "", "File holding the hex encoded ed25519 seed the manifest is signed with")
+	cmd.Flags().Bool(flagLatest, true, "Also write the manifest as the latest snapshot of the mirror")
+
+	return cmd
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open chunk file %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy chunk file %s: %w", src, err)
+	}
+
+	return out.Close()
+}
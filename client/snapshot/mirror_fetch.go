@@ -0,0 +1,150 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagTrustedKey = "trusted-key"
+	flagHeight     = "height"
+	flagFormat     = "format"
+	flagTimeout    = "timeout"
+)
+
+// FetchSnapshotCmd returns a command to fetch a snapshot from snapshot mirrors
+// into the local snapshot store, as an alternative to p2p state sync.
+func FetchSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch <mirror-url>...",
+		Short: "Fetch a snapshot from HTTPS or S3 snapshot mirrors into snapshot store",
+		Long: `Fetch a snapshot from HTTPS or S3 snapshot mirrors, written with the
+export-mirror command, into the local snapshot store. The snapshot can then be
+applied with the restore command.
+
+The snapshot manifest must be signed by the --trusted-key, and every chunk is
+verified against the hash listed in the manifest. When several mirrors are
+given, each file is fetched from the first mirror serving it correctly.
+
+The latest snapshot of the mirrors is fetched unless --height is set.`,
+		Example: "fetch https://snapshots.example.com/mychain https://mybucket.s3.amazonaws.com/mychain --trusted-key <hex>",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			trustedKeyStr, err := cmd.Flags().GetString(flagTrustedKey)
+			if err != nil {
+				return err
+			}
+			trustedKey, err := parseTrustedKey(trustedKeyStr)
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetUint64(flagHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetUint32(flagFormat)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration(flagTimeout)
+			if err != nil {
+				return err
+			}
+
+			client := mirrorClient{
+				client:  &http.Client{Timeout: timeout},
+				mirrors: args,
+			}
+
+			manifestPath := MirrorManifestFileName
+			if height != 0 {
+				manifestPath = mirrorSnapshotPath(height, format) + "/" + MirrorManifestFileName
+			}
+
+			manifest, err := client.fetchManifest(cmd.Context(), manifestPath, trustedKey)
+			if err != nil {
+				return err
+			}
+			if height != 0 && (manifest.Height != height || manifest.Format != format) {
+				return fmt.Errorf("mirrors served snapshot %d format %d, expected %d format %d", manifest.Height, manifest.Format, height, format)
+			}
+
+			existing, err := snapshotStore.Get(manifest.Height, manifest.Format)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return fmt.Errorf("snapshot %d format %d already exists", manifest.Height, manifest.Format)
+			}
+
+			cmd.Printf("fetching snapshot %d format %d (%d chunks)\n", manifest.Height, manifest.Format, len(manifest.ChunkHashes))
+
+			chunks := make(chan io.ReadCloser)
+			quitChan := make(chan *snapshottypes.Snapshot)
+			go func() {
+				defer close(quitChan)
+
+				savedSnapshot, err := snapshotStore.Save(manifest.Height, manifest.Format, chunks)
+				if err != nil {
+					cmd.Println("failed to save snapshot", err)
+					return
+				}
+				quitChan <- savedSnapshot
+			}()
+
+			basePath := mirrorSnapshotPath(manifest.Height, manifest.Format)
+			for i := range manifest.ChunkHashes {
+				index := uint32(i)
+				bz, err := client.get(cmd.Context(), basePath+"/"+strconv.FormatUint(uint64(index), 10), maxChunkSize, func(bz []byte) error {
+					return manifest.VerifyChunk(index, bz)
+				})
+				if err != nil {
+					close(chunks)
+					<-quitChan
+					_ = snapshotStore.Delete(manifest.Height, manifest.Format)
+					return err
+				}
+
+				chunks <- io.NopCloser(bytes.NewReader(bz))
+			}
+			close(chunks)
+
+			savedSnapshot := <-quitChan
+			if savedSnapshot == nil {
+				return fmt.Errorf("failed to save snapshot")
+			}
+
+			if !bytes.Equal(savedSnapshot.Hash, manifest.Hash) {
+				_ = snapshotStore.Delete(manifest.Height, manifest.Format)
+				return fmt.Errorf("snapshot hash mismatch: expected %X, got %X", manifest.Hash, savedSnapshot.Hash)
+			}
+
+			cmd.Printf("fetched snapshot %d format %d\n", manifest.Height, manifest.Format)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTrustedKey, "", "Hex encoded ed25519 public key the snapshot manifest must be signed with")
+	cmd.Flags().Uint64(flagHeight, 0, "Height of the snapshot to fetch, the latest if 0")
+	cmd.Flags().Uint32(flagFormat, snapshottypes.CurrentFormat, "Format of the snapshot to fetch, used with --height")
+	cmd.Flags().Duration(flagTimeout, 5*time.Minute, "Timeout of each request to the mirrors")
+
+	return cmd
+}
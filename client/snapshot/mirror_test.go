@@ -0,0 +1,129 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManifest(t *testing.T, key ed25519.PrivateKey, chunks ...[]byte) *MirrorManifest {
+	t.Helper()
+
+	manifest := &MirrorManifest{Height: 10, Format: 3, Hash: []byte("hash")}
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		manifest.ChunkHashes = append(manifest.ChunkHashes, hash[:])
+	}
+	require.NoError(t, manifest.Sign(key))
+
+	return manifest
+}
+
+// newTestMirror returns a mirror serving files, keyed by path.
+func newTestMirror(t *testing.T, files map[string][]byte) *httptest.Server {
+	t.Helper()
+
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(bz)
+	}))
+	t.Cleanup(mirror.Close)
+
+	return mirror
+}
+
+func TestMirrorManifestVerify(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	manifest := newTestManifest(t, key, []byte("chunk"))
+	require.NoError(t, manifest.Verify(pub))
+
+	// signed by an untrusted key
+	untrusted := newTestManifest(t, otherKey, []byte("chunk"))
+	require.ErrorContains(t, untrusted.Verify(pub), "invalid manifest signature")
+
+	// tampered with after signing
+	tampered := *manifest
+	tampered.Height++
+	require.ErrorContains(t, tampered.Verify(pub), "invalid manifest signature")
+
+	unsigned := *manifest
+	unsigned.Signature = nil
+	require.ErrorContains(t, unsigned.Verify(pub), "invalid manifest signature")
+}
+
+func TestMirrorManifestVerifyChunk(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	manifest := newTestManifest(t, key, []byte("chunk 0"), []byte("chunk 1"))
+	require.NoError(t, manifest.VerifyChunk(0, []byte("chunk 0")))
+	require.NoError(t, manifest.VerifyChunk(1, []byte("chunk 1")))
+
+	require.ErrorContains(t, manifest.VerifyChunk(0, []byte("chunk 1")), "chunk 0 hash mismatch")
+	require.ErrorContains(t, manifest.VerifyChunk(2, []byte("chunk 2")), "unexpected chunk 2")
+}
+
+func TestMirrorClient(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	chunk := []byte("chunk 0")
+	manifestBz, err := json.Marshal(newTestManifest(t, key, chunk))
+	require.NoError(t, err)
+	badManifestBz, err := json.Marshal(newTestManifest(t, otherKey, chunk))
+	require.NoError(t, err)
+
+	good := newTestMirror(t, map[string][]byte{
+		"/manifest.json":      manifestBz,
+		"/10/3/manifest.json": manifestBz,
+		"/10/3/0":             chunk,
+	})
+	bad := newTestMirror(t, map[string][]byte{
+		"/manifest.json":      badManifestBz,
+		"/10/3/manifest.json": badManifestBz,
+		"/10/3/0":             []byte("tampered chunk"),
+	})
+
+	ctx := context.Background()
+
+	// a mirror serving a manifest with a bad signature is rejected
+	client := mirrorClient{client: http.DefaultClient, mirrors: []string{bad.URL}}
+	_, err = client.fetchManifest(ctx, MirrorManifestFileName, pub)
+	require.ErrorContains(t, err, "invalid manifest signature")
+
+	// a chunk not matching the manifest hash is rejected
+	manifest := newTestManifest(t, key, chunk)
+	verifyChunk := func(bz []byte) error { return manifest.VerifyChunk(0, bz) }
+	_, err = client.get(ctx, "10/3/0", maxChunkSize, verifyChunk)
+	require.ErrorContains(t, err, "chunk 0 hash mismatch")
+
+	// files are fetched from the next mirror serving them correctly
+	client.mirrors = []string{bad.URL, good.URL + "/"}
+	fetched, err := client.fetchManifest(ctx, mirrorSnapshotPath(10, 3)+"/"+MirrorManifestFileName, pub)
+	require.NoError(t, err)
+	require.Equal(t, manifest, fetched)
+
+	bz, err := client.get(ctx, "10/3/0", maxChunkSize, verifyChunk)
+	require.NoError(t, err)
+	require.Equal(t, chunk, bz)
+
+	// files larger than the maximum size are rejected
+	_, err = client.get(ctx, "10/3/0", int64(len(chunk)-1), nil)
+	require.ErrorContains(t, err, "response exceeds")
+}
@@ -166,6 +166,31 @@ func (b *AuxTxBuilder) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any
 	b.auxSignerData.SignDoc.BodyBytes = nil
 }
 
+// SetTip sets the tip paid by the aux signer to the fee payer of the tx. The
+// tip is a critical extension option of the tx body, replacing any tip set
+// previously, so it is only accepted by chains supporting tips. The aux signer
+// must sign with DIRECT_AUX, as LEGACY_AMINO_JSON doesn't cover extension
+// options.
+func (b *AuxTxBuilder) SetTip(tip *tx.Tip) error { //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+	anyTip, err := codectypes.NewAnyWithValue(tip)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	extOpts := []*anypb.Any{{TypeUrl: anyTip.TypeUrl, Value: anyTip.Value}}
+	for _, extOpt := range b.body.ExtensionOptions {
+		if extOpt.TypeUrl != anyTip.TypeUrl {
+			extOpts = append(extOpts, extOpt)
+		}
+	}
+	b.body.ExtensionOptions = extOpts
+	b.auxSignerData.SignDoc.BodyBytes = nil
+
+	return nil
+}
+
 // GetSignBytes returns the builder's sign bytes.
 func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
 	auxTx := b.auxSignerData
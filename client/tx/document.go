@@ -0,0 +1,200 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Document is a declarative description of a transaction. Messages are
+// JSON encoded Any values, i.e. objects whose "@type" field holds the
+// message type URL, and are resolved through the interface registry of the
+// codec used to decode them.
+//
+// Documents can be written either in JSON or in YAML, for example:
+//
+//	messages:
+//	  - "@type": /cosmos.bank.v1beta1.MsgSend
+//	    from_address: cosmos1...
+//	    to_address: cosmos1...
+//	    amount: [{denom: stake, amount: "10"}]
+//	memo: weekly payout
+//	fees: 200stake
+//	gas: 200000
+type Document struct {
+	Messages      []json.RawMessage `json:"messages"`
+	Memo          string            `json:"memo,omitempty"`
+	Fees          string            `json:"fees,omitempty"`
+	GasPrices     string            `json:"gas_prices,omitempty"`
+	Gas           uint64            `json:"gas,omitempty"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty"`
+	FeeGranter    string            `json:"fee_granter,omitempty"`
+	FeePayer      string            `json:"fee_payer,omitempty"`
+}
+
+// ParseDocument decodes a transaction document from its JSON or YAML
+// representation.
+func ParseDocument(bz []byte) (Document, error) {
+	var doc Document
+
+	// YAML is a superset of JSON, so converting first handles both formats.
+	jsonBz, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return doc, fmt.Errorf("failed to parse transaction document: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jsonBz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&doc); err != nil {
+		return doc, fmt.Errorf("failed to parse transaction document: %w", err)
+	}
+
+	if len(doc.Messages) == 0 {
+		return doc, errors.New("transaction document must contain at least one message")
+	}
+
+	return doc, nil
+}
+
+// ReadDocument reads and decodes a transaction document from a file.
+func ReadDocument(path string) (Document, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Document{}, err
+	}
+
+	return ParseDocument(bz)
+}
+
+// Msgs resolves the messages of the document through the codec interface
+// registry and validates them.
+func (d Document) Msgs(cdc codec.Codec) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(d.Messages))
+	for i, anyJSON := range d.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return nil, fmt.Errorf("invalid message at index %d: %w", i, err)
+		}
+
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("invalid message at index %d: %w", i, err)
+			}
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ApplyToFactory returns a copy of the Factory updated with the transaction
+// parameters set in the document. Parameters left empty in the document keep
+// the value already present in the Factory.
+func (d Document) ApplyToFactory(clientCtx client.Context, txf Factory) (Factory, error) {
+	if d.Memo != "" {
+		txf = txf.WithMemo(d.Memo)
+	}
+
+	if d.Fees != "" {
+		if _, err := sdk.ParseCoinsNormalized(d.Fees); err != nil {
+			return txf, fmt.Errorf("invalid fees: %w", err)
+		}
+		txf = txf.WithFees(d.Fees)
+	}
+
+	if d.GasPrices != "" {
+		if _, err := sdk.ParseDecCoins(d.GasPrices); err != nil {
+			return txf, fmt.Errorf("invalid gas prices: %w", err)
+		}
+		txf = txf.WithGasPrices(d.GasPrices)
+	}
+
+	if d.Gas != 0 {
+		txf = txf.WithGas(d.Gas).WithSimulateAndExecute(false)
+	}
+
+	if d.TimeoutHeight != 0 {
+		txf = txf.WithTimeoutHeight(d.TimeoutHeight)
+	}
+
+	if d.FeeGranter != "" {
+		granter, err := clientCtx.AddressCodec.StringToBytes(d.FeeGranter)
+		if err != nil {
+			return txf, fmt.Errorf("invalid fee granter: %w", err)
+		}
+		txf = txf.WithFeeGranter(granter)
+	}
+
+	if d.FeePayer != "" {
+		payer, err := clientCtx.AddressCodec.StringToBytes(d.FeePayer)
+		if err != nil {
+			return txf, fmt.Errorf("invalid fee payer: %w", err)
+		}
+		txf = txf.WithFeePayer(payer)
+	}
+
+	return txf, nil
+}
+
+// BuildUnsignedTxFromDocument resolves and validates the messages of the
+// document and builds an unsigned transaction out of them.
+func BuildUnsignedTxFromDocument(clientCtx client.Context, txf Factory, doc Document) (client.TxBuilder, error) {
+	msgs, err := doc.Msgs(clientCtx.Codec)
+	if err != nil {
+		return nil, err
+	}
+
+	txf, err = doc.ApplyToFactory(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	return txf.BuildUnsignedTx(msgs...)
+}
+
+// SignDocument builds a transaction from the document and signs it with the
+// key named fromName, returning the signed transaction builder.
+func SignDocument(clientCtx client.Context, txf Factory, fromName string, doc Document) (client.TxBuilder, error) {
+	msgs, err := doc.Msgs(clientCtx.Codec)
+	if err != nil {
+		return nil, err
+	}
+
+	txf, err = doc.ApplyToFactory(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Sign(clientCtx.CmdContext, txf, fromName, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
@@ -0,0 +1,109 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestParseDocument(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expErr string
+	}{
+		{
+			name:  "json document",
+			input: `{"messages":[{"@type":"/testpb.TestMsg","signers":[]}],"memo":"hello","gas":100}`,
+		},
+		{
+			name: "yaml document",
+			input: `
+messages:
+  - "@type": /testpb.TestMsg
+    signers: []
+memo: hello
+gas: 100
+`,
+		},
+		{
+			name:   "no messages",
+			input:  `{"memo":"hello"}`,
+			expErr: "at least one message",
+		},
+		{
+			name:   "unknown field",
+			input:  `{"messages":[{"@type":"/testpb.TestMsg"}],"mem0":"typo"}`,
+			expErr: "unknown field",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := ParseDocument([]byte(tc.input))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, doc.Messages, 1)
+			require.Equal(t, "hello", doc.Memo)
+			require.Equal(t, uint64(100), doc.Gas)
+		})
+	}
+}
+
+func TestBuildUnsignedTxFromDocument(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithTxConfig(encCfg.TxConfig).
+		WithAddressCodec(address.NewBech32Codec("cosmos"))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	txf := mockTxFactory(encCfg.TxConfig)
+
+	doc, err := ParseDocument([]byte(`
+messages:
+  - "@type": /testpb.TestMsg
+    signers: ["` + addr.String() + `"]
+memo: from document
+fees: 10stake
+gas: 12345
+timeout_height: 99
+fee_granter: ` + addr.String() + `
+`))
+	require.NoError(t, err)
+
+	txBuilder, err := BuildUnsignedTxFromDocument(clientCtx, txf, doc)
+	require.NoError(t, err)
+
+	tx := txBuilder.GetTx()
+	require.Len(t, tx.GetMsgs(), 1)
+	require.Equal(t, "from document", tx.GetMemo())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), tx.GetFee())
+	require.Equal(t, uint64(12345), tx.GetGas())
+	require.Equal(t, uint64(99), tx.GetTimeoutHeight())
+	require.Equal(t, []byte(addr), tx.FeeGranter())
+
+	// messages failing stateless validation are rejected
+	doc, err = ParseDocument([]byte(`{"messages":[{"@type":"/testpb.TestMsg","signers":["invalid"]}]}`))
+	require.NoError(t, err)
+	_, err = BuildUnsignedTxFromDocument(clientCtx, txf, doc)
+	require.ErrorContains(t, err, "invalid message at index 0")
+
+	// unregistered message types are rejected
+	doc, err = ParseDocument([]byte(`{"messages":[{"@type":"/foo.MsgUnknown"}]}`))
+	require.NoError(t, err)
+	_, err = BuildUnsignedTxFromDocument(clientCtx, txf, doc)
+	require.ErrorContains(t, err, "invalid message at index 0")
+}
@@ -0,0 +1,150 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// ExternalSignBytes prepares txBuilder to be signed by the key stored under
+// name and returns the bytes that must be signed. Only the public key of name
+// is used, so name may refer to a watch-only keyring entry (see
+// keyring.SaveOfflineKey) whose private key lives on a separate device.
+//
+// The signer info of the key is added to the transaction with an empty
+// signature, which is later filled in by AddExternalSignature. Calling
+// ExternalSignBytes again for the same key replaces its signer info.
+func ExternalSignBytes(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) ([]byte, error) {
+	pubKey, signMode, err := externalSigner(txf, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return setExternalSignature(ctx, txf, txBuilder, pubKey, signMode, nil, overwriteSig)
+}
+
+// AddExternalSignature sets a signature produced outside of the keyring for
+// the key stored under name. The transaction must have been prepared with
+// ExternalSignBytes using the same Factory settings, and the signature is
+// verified against the key before being added to the transaction.
+func AddExternalSignature(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, sigBytes []byte) error {
+	if len(sigBytes) == 0 {
+		return errors.New("signature cannot be empty")
+	}
+
+	pubKey, signMode, err := externalSigner(txf, name)
+	if err != nil {
+		return err
+	}
+
+	bytesToSign, err := setExternalSignature(ctx, txf, txBuilder, pubKey, signMode, nil, false)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(bytesToSign, sigBytes) {
+		return fmt.Errorf("signature does not match the sign bytes of key %s", name)
+	}
+
+	if _, err := setExternalSignature(ctx, txf, txBuilder, pubKey, signMode, sigBytes, false); err != nil {
+		return err
+	}
+
+	return txf.PreprocessTx(name, txBuilder)
+}
+
+// externalSigner returns the public key stored under name and the sign mode
+// to use with it.
+func externalSigner(txf Factory, name string) (cryptotypes.PubKey, signing.SignMode, error) {
+	if txf.keybase == nil {
+		return nil, 0, errors.New("keybase must be set prior to signing a transaction")
+	}
+
+	signMode := txf.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		var err error
+		signMode, err = authsigning.APISignModeToInternal(txf.txConfig.SignModeHandler().DefaultMode())
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	k, err := txf.keybase.Key(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pubKey, signMode, nil
+}
+
+// setExternalSignature sets the signature of pubKey on the transaction,
+// replacing any previous signature of the same key, and returns the sign bytes
+// of the transaction for that key.
+func setExternalSignature(
+	ctx context.Context,
+	txf Factory,
+	txBuilder client.TxBuilder,
+	pubKey cryptotypes.PubKey,
+	signMode signing.SignMode,
+	sigBytes []byte,
+	overwriteSig bool,
+) ([]byte, error) {
+	sig := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sigBytes,
+		},
+		Sequence: txf.Sequence(),
+	}
+
+	sigs := []signing.SignatureV2{sig}
+	if !overwriteSig {
+		prevSignatures, err := txBuilder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return nil, err
+		}
+
+		sigs = make([]signing.SignatureV2, 0, len(prevSignatures)+1)
+		replaced := false
+		for _, prev := range prevSignatures {
+			if prev.PubKey != nil && prev.PubKey.Equals(pubKey) {
+				prev, replaced = sig, true
+			}
+			sigs = append(sigs, prev)
+		}
+		if !replaced {
+			sigs = append(sigs, sig)
+		}
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, fmt.Errorf("unable to set signatures on payload: %w", err)
+	}
+
+	if err := checkMultipleSigners(txBuilder.GetTx()); err != nil {
+		return nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.chainID,
+		AccountNumber: txf.accountNumber,
+		Sequence:      txf.sequence,
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+
+	return authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
+}
@@ -40,12 +40,14 @@ type Factory struct {
 	generateOnly       bool
 	memo               string
 	fees               sdk.Coins
+	tip                sdk.Coins
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	dryRunChanges      bool
 	preprocessTxHook   client.PreprocessTxFn
 }
 
@@ -90,6 +92,17 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasStr := clientCtx.Viper.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
 
+	if clientCtx.Offline {
+		if clientCtx.ChainID == "" {
+			return Factory{}, errors.New("chain-id must be set in offline mode")
+		}
+
+		if gasSetting.Simulate {
+			return Factory{}, errors.New("gas cannot be set to auto in offline mode as it requires a simulation")
+		}
+	}
+	dryRunChanges := clientCtx.Viper.GetBool(flags.FlagDryRunChanges)
+
 	f := Factory{
 		txConfig:           clientCtx.TxConfig,
 		accountRetriever:   clientCtx.AccountRetriever,
@@ -100,6 +113,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		generateOnly:       clientCtx.GenerateOnly,
 		gas:                gasSetting.Gas,
 		simulateAndExecute: gasSetting.Simulate,
+		dryRunChanges:      dryRunChanges,
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
@@ -114,8 +128,14 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	f = f.WithFees(feesStr)
 
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
+	if gasPricesStr == "" && feesStr == "" {
+		// fall back to the default gas prices of the chain profile
+		gasPricesStr = clientCtx.GasPrices
+	}
 	f = f.WithGasPrices(gasPricesStr)
 
+	f = f.WithTip(clientCtx.Viper.GetString(flags.FlagTip))
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -129,15 +149,21 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) Tip() sdk.Coins                            { return f.tip }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) FromName() string                          { return f.fromName }
+func (f Factory) Offline() bool                             { return f.offline }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// DryRunChanges returns whether a dry-run simulation should also report the
+// state changes performed by the transaction.
+func (f Factory) DryRunChanges() bool { return f.dryRunChanges }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -173,6 +199,18 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithTip returns a copy of the Factory with an updated tip, paid by an aux
+// signer to the fee payer of the tx.
+func (f Factory) WithTip(tip string) Factory {
+	parsedTip, err := sdk.ParseCoinsNormalized(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tip = parsedTip
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -228,6 +266,22 @@ func (f Factory) WithSimulateAndExecute(sim bool) Factory {
 	return f
 }
 
+// WithOffline returns a copy of the Factory with an updated offline mode. An
+// offline Factory never queries the node: account number, sequence and chain-id
+// must be set explicitly, and Prepare fails if the chain-id is missing or gas
+// would have to be estimated through a simulation.
+func (f Factory) WithOffline(offline bool) Factory {
+	f.offline = offline
+	return f
+}
+
+// WithDryRunChanges returns a copy of the Factory with an updated dry-run
+// state changes reporting option.
+func (f Factory) WithDryRunChanges(dryRunChanges bool) Factory {
+	f.dryRunChanges = dryRunChanges
+	return f
+}
+
 // SignMode returns the sign mode configured in the Factory
 func (f Factory) SignMode() signing.SignMode {
 	return f.signMode
@@ -486,12 +540,18 @@ func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureDat
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory.
 // A new Factory with the updated fields will be returned.
-// Note: When in offline mode, the Prepare does nothing and returns the original factory.
+// Note: When the client context is in offline mode, the Prepare does nothing and
+// returns the original factory. When the factory itself is set offline, Prepare
+// only verifies that no network lookup is needed to sign the transaction.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	if clientCtx.Offline {
 		return f, nil
 	}
 
+	if f.offline {
+		return f, f.validateOffline()
+	}
+
 	fc := f
 	from := clientCtx.FromAddress
 
@@ -517,3 +577,16 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 
 	return fc, nil
 }
+
+// validateOffline checks that the factory holds all the values that would
+// otherwise be fetched from a node.
+func (f Factory) validateOffline() error {
+	switch {
+	case f.chainID == "":
+		return fmt.Errorf("%w: chain-id must be set", client.ErrOfflineMode)
+	case f.simulateAndExecute:
+		return fmt.Errorf("%w: gas cannot be estimated through simulation", client.ErrOfflineMode)
+	}
+
+	return nil
+}
@@ -43,6 +43,31 @@ func TestFactoryPrepare(t *testing.T) {
 	require.Equal(t, output.Sequence(), uint64(1))
 }
 
+func TestFactoryPrepareOffline(t *testing.T) {
+	t.Parallel()
+
+	// the account retriever must never be used by an offline factory
+	retriever := client.MockAccountRetriever{ReturnAccNum: 10, ReturnAccSeq: 1}
+	clientCtx := client.Context{}.WithFrom("foo")
+
+	factory := Factory{}.
+		WithOffline(true).
+		WithAccountRetriever(retriever).
+		WithChainID("test-chain").
+		WithAccountNumber(5).
+		WithSequence(0)
+	output, err := factory.Prepare(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, factory, output)
+	require.Equal(t, uint64(0), output.Sequence())
+
+	_, err = factory.WithChainID("").Prepare(clientCtx)
+	require.ErrorIs(t, err, client.ErrOfflineMode)
+
+	_, err = factory.WithSimulateAndExecute(true).Prepare(clientCtx)
+	require.ErrorIs(t, err, client.ErrOfflineMode)
+}
+
 func TestFactory_getSimPKType(t *testing.T) {
 	// setup keyring
 	registry := codectypes.NewInterfaceRegistry()
@@ -14,6 +14,8 @@ import (
 	authsigning "cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,7 +94,20 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if clientCtx.Simulate {
-		return nil
+		if !txf.DryRunChanges() {
+			return nil
+		}
+
+		res, err := SimulateStateChanges(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	if clientCtx.Offline {
+		return fmt.Errorf("%w: cannot broadcast tx, use --%s instead", client.ErrOfflineMode, flags.FlagGenerateOnly)
 	}
 
 	tx, err := txf.BuildUnsignedTx(msgs...)
@@ -166,6 +181,23 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// SimulateStateChanges simulates the execution of a transaction and returns,
+// next to the gas and result of the simulation, the state changes the
+// transaction would perform if it were included in a block.
+func SimulateStateChanges(
+	clientCtx gogogrpc.ClientConn, txf Factory, msgs ...sdk.Msg,
+) (*appservice.SimulateStateChangesResponse, error) {
+	txBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	appSvcClient := appservice.NewServiceClient(clientCtx)
+	return appSvcClient.SimulateStateChanges(context.Background(), &appservice.SimulateStateChangesRequest{
+		TxBytes: txBytes,
+	})
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -401,6 +433,17 @@ func makeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx
 		return tx.AuxSignerData{}, err
 	}
 
+	if !f.tip.IsZero() {
+		if f.SignMode() != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			return tx.AuxSignerData{}, fmt.Errorf("tips can only be signed with %s", signing.SignMode_SIGN_MODE_DIRECT_AUX)
+		}
+
+		err = b.SetTip(&tx.Tip{Amount: f.tip, Tipper: fromAddress.String()}) //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+		if err != nil {
+			return tx.AuxSignerData{}, err
+		}
+	}
+
 	key, err := clientCtx.Keyring.Key(name)
 	if err != nil {
 		return tx.AuxSignerData{}, err
@@ -437,3 +437,52 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestExternalSign(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+
+	// the signing device holds the private key, the broadcasting host only its public key
+	device := keyring.NewInMemory(cdc)
+	k, _, err := device.NewMnemonic("device", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	pubKey, err := k.GetPubKey()
+	requireT.NoError(err)
+
+	host := keyring.NewInMemory(cdc)
+	_, err = host.SaveOfflineKey("watch", pubKey)
+	requireT.NoError(err)
+
+	addr := sdk.AccAddress(pubKey.Address())
+	msg := &countertypes.MsgIncreaseCounter{Signer: addr.String(), Count: 1}
+
+	for _, signMode := range []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			txf := mockTxFactory(txConfig).WithKeybase(host).WithSignMode(signMode)
+			txb, err := txf.BuildUnsignedTx(msg)
+			require.NoError(t, err)
+
+			// watch-only keys cannot sign through the keyring
+			require.ErrorIs(t, Sign(context.Background(), txf, "watch", txb, true), keyring.ErrOfflineSign)
+
+			signBytes, err := ExternalSignBytes(context.Background(), txf, "watch", txb, true)
+			require.NoError(t, err)
+
+			sigBytes, _, err := device.Sign("device", signBytes, signMode)
+			require.NoError(t, err)
+
+			require.ErrorContains(t, AddExternalSignature(context.Background(), txf, "watch", txb, []byte("bogus")), "signature does not match")
+			require.NoError(t, AddExternalSignature(context.Background(), txf, "watch", txb, sigBytes))
+
+			sigs, err := txb.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.True(t, sigs[0].PubKey.Equals(pubKey))
+			require.Equal(t, sigBytes, sigs[0].Data.(*signingtypes.SingleSignatureData).Signature)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package collections
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// LazyMigrationProgress reports the progress of a LazyMigration.
+type LazyMigrationProgress struct {
+	// Cursor is the raw key, without the map prefix, from which the next
+	// sweep resumes.
+	Cursor []byte
+	// Migrated is the number of values rewritten in the canonical encoding so far.
+	Migrated uint64
+	// Done reports whether the sweeper went through every entry of the map.
+	Done bool
+}
+
+// LazyMigration migrates the values of a Map to a new encoding without
+// rewriting the whole collection in a single block. Values are migrated when
+// read through Get, and a sweeper, usually called from an EndBlocker with a
+// bounded amount of work, migrates the remainder over time.
+//
+// The Map must be instantiated with a codec.AltValueCodec built from the
+// canonical codec and a decoder of the legacy encoding, so that reads made
+// through the Map itself keep working while the migration is in progress.
+type LazyMigration[K, V any] struct {
+	m         Map[K, V]
+	canonical codec.ValueCodec[V]
+	progress  Item[[]byte]
+}
+
+// NewLazyMigration instantiates a LazyMigration of the values of m into the
+// canonical encoding. The progress of the migration is stored under prefix,
+// with the fixed binary layout of encodeLazyMigrationProgress.
+func NewLazyMigration[K, V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	m Map[K, V],
+	canonical codec.ValueCodec[V],
+) *LazyMigration[K, V] {
+	return &LazyMigration[K, V]{
+		m:         m,
+		canonical: canonical,
+		progress:  NewItem(schema, prefix, name, BytesValue),
+	}
+}
+
+// Get returns the value of key, migrating it to the canonical encoding if it
+// is still stored in the legacy one.
+func (l *LazyMigration[K, V]) Get(ctx context.Context, key K) (v V, err error) {
+	rawKey, err := EncodeKeyWithPrefix(l.m.prefix, l.m.kc, key)
+	if err != nil {
+		return v, err
+	}
+
+	bz, err := l.m.sa(ctx).Get(rawKey)
+	if err != nil {
+		return v, err
+	}
+	if bz == nil {
+		return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, l.m.kc.Stringify(key), l.m.vc.ValueType())
+	}
+
+	migrated, v, err := l.migrate(ctx, rawKey, bz)
+	if err != nil {
+		return v, err
+	}
+
+	if migrated {
+		if err := l.addMigrated(ctx, 1); err != nil {
+			return v, err
+		}
+	}
+
+	return v, nil
+}
+
+// Sweep migrates at most limit entries, starting where the previous sweep
+// stopped, and returns the updated progress. Calling Sweep once the migration
+// is done is a no-op.
+func (l *LazyMigration[K, V]) Sweep(ctx context.Context, limit int) (LazyMigrationProgress, error) {
+	if limit <= 0 {
+		return LazyMigrationProgress{}, errors.New("sweep limit must be positive")
+	}
+
+	progress, err := l.Progress(ctx)
+	if err != nil {
+		return progress, err
+	}
+
+	if progress.Done {
+		return progress, nil
+	}
+
+	type entry struct{ key, value []byte }
+	batch := make([]entry, 0, limit)
+
+	start := append(append([]byte{}, l.m.prefix...), progress.Cursor...)
+	it, err := l.m.sa(ctx).Iterator(start, nextBytesPrefixKey(l.m.prefix))
+	if err != nil {
+		return progress, err
+	}
+	for ; it.Valid() && len(batch) < limit; it.Next() {
+		batch = append(batch, entry{key: append([]byte{}, it.Key()...), value: append([]byte{}, it.Value()...)})
+	}
+	progress.Done = !it.Valid()
+	if err := it.Close(); err != nil {
+		return progress, err
+	}
+
+	var migrated uint64
+	for _, e := range batch {
+		ok, _, err := l.migrate(ctx, e.key, e.value)
+		if err != nil {
+			return progress, err
+		}
+		if ok {
+			migrated++
+		}
+	}
+
+	if len(batch) > 0 {
+		// the smallest key greater than the last processed one
+		last := batch[len(batch)-1].key[len(l.m.prefix):]
+		progress.Cursor = append(append([]byte{}, last...), 0)
+	}
+	progress.Migrated += migrated
+
+	return progress, l.progress.Set(ctx, encodeLazyMigrationProgress(progress))
+}
+
+// Progress returns the progress of the migration.
+func (l *LazyMigration[K, V]) Progress(ctx context.Context) (LazyMigrationProgress, error) {
+	bz, err := l.progress.Get(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return LazyMigrationProgress{}, nil
+	} else if err != nil {
+		return LazyMigrationProgress{}, err
+	}
+	return decodeLazyMigrationProgress(bz)
+}
+
+// migrate rewrites the raw value stored under rawKey in the canonical
+// encoding if it is not already, and returns whether it did along with the
+// decoded value.
+func (l *LazyMigration[K, V]) migrate(ctx context.Context, rawKey, bz []byte) (bool, V, error) {
+	v, err := l.canonical.Decode(bz)
+	if err == nil {
+		return false, v, nil
+	}
+
+	// the map value codec falls back to the legacy encoding
+	v, err = l.m.vc.Decode(bz)
+	if err != nil {
+		return false, v, fmt.Errorf("%w: value of key %X: %s", ErrEncoding, rawKey, err)
+	}
+
+	newBz, err := l.canonical.Encode(v)
+	if err != nil {
+		return false, v, fmt.Errorf("%w: value of key %X: %s", ErrEncoding, rawKey, err)
+	}
+
+	return true, v, l.m.sa(ctx).Set(rawKey, newBz)
+}
+
+func (l *LazyMigration[K, V]) addMigrated(ctx context.Context, n uint64) error {
+	progress, err := l.Progress(ctx)
+	if err != nil {
+		return err
+	}
+
+	progress.Migrated += n
+	return l.progress.Set(ctx, encodeLazyMigrationProgress(progress))
+}
+
+// encodeLazyMigrationProgress encodes progress as the done flag, followed by
+// the big endian migrated count and the cursor.
+func encodeLazyMigrationProgress(progress LazyMigrationProgress) []byte {
+	bz := make([]byte, 9, 9+len(progress.Cursor))
+	if progress.Done {
+		bz[0] = 1
+	}
+	binary.BigEndian.PutUint64(bz[1:], progress.Migrated)
+	return append(bz, progress.Cursor...)
+}
+
+// decodeLazyMigrationProgress decodes a progress encoded with
+// encodeLazyMigrationProgress.
+func decodeLazyMigrationProgress(bz []byte) (LazyMigrationProgress, error) {
+	if len(bz) < 9 {
+		return LazyMigrationProgress{}, fmt.Errorf("%w: invalid lazy migration progress length %d", ErrEncoding, len(bz))
+	}
+
+	return LazyMigrationProgress{
+		Done:     bz[0] == 1,
+		Migrated: binary.BigEndian.Uint64(bz[1:9]),
+		Cursor:   append([]byte{}, bz[9:]...),
+	}, nil
+}
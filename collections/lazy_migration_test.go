@@ -0,0 +1,86 @@
+package collections
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+)
+
+func TestLazyMigration(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+
+	// values used to be stored as decimal strings, they are now stored as big endian uint64.
+	legacyDecoder := func(b []byte) (uint64, error) { return strconv.ParseUint(string(b), 10, 64) }
+	m := NewMap(schema, NewPrefix(1), "m", StringKey, codec.NewAltValueCodec(Uint64Value, legacyDecoder))
+	lm := NewLazyMigration(schema, NewPrefix(2), "m_migration", m, Uint64Value)
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	kv := sk.OpenKVStore(ctx)
+	for i, k := range []string{"a", "b", "c", "d", "e"} {
+		rawKey, err := EncodeKeyWithPrefix(m.prefix, StringKey, k)
+		require.NoError(t, err)
+		require.NoError(t, kv.Set(rawKey, []byte(strconv.Itoa(i+10))))
+	}
+	// values written after the upgrade already use the canonical encoding
+	require.NoError(t, m.Set(ctx, "f", 15))
+
+	// reads migrate the value
+	v, err := lm.Get(ctx, "c")
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), v)
+	progress, err := lm.Progress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), progress.Migrated)
+
+	_, err = lm.Get(ctx, "unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	progress, err = lm.Sweep(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, progress.Done)
+	require.Equal(t, uint64(3), progress.Migrated)
+
+	progress, err = lm.Sweep(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, progress.Done)
+	require.Equal(t, uint64(4), progress.Migrated) // c was already migrated
+
+	progress, err = lm.Sweep(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, progress.Done)
+	require.Equal(t, uint64(5), progress.Migrated) // f was never in the legacy encoding
+
+	// every value is now in the canonical encoding
+	for i, k := range []string{"a", "b", "c", "d", "e", "f"} {
+		rawKey, err := EncodeKeyWithPrefix(m.prefix, StringKey, k)
+		require.NoError(t, err)
+		bz, err := kv.Get(rawKey)
+		require.NoError(t, err)
+		v, err := Uint64Value.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+10), v)
+	}
+
+	// sweeping a completed migration is a no-op
+	again, err := lm.Sweep(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, progress, again)
+}
+
+func TestLazyMigrationProgressEncoding(t *testing.T) {
+	progress := LazyMigrationProgress{Cursor: []byte("cursor"), Migrated: 42, Done: true}
+	bz := encodeLazyMigrationProgress(progress)
+	require.Equal(t, append([]byte{1, 0, 0, 0, 0, 0, 0, 0, 42}, "cursor"...), bz)
+
+	decoded, err := decodeLazyMigrationProgress(bz)
+	require.NoError(t, err)
+	require.Equal(t, progress, decoded)
+
+	_, err = decodeLazyMigrationProgress([]byte{1})
+	require.ErrorIs(t, err, ErrEncoding)
+}
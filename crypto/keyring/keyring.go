@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"bufio"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -121,6 +122,10 @@ type Importer interface {
 	ImportPrivKeyHex(uid, privKey, algoStr string) error
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid, armor string) error
+	// ImportPrivKeyPKCS8 imports PEM encoded, passphrase-encrypted PKCS#8 private keys.
+	ImportPrivKeyPKCS8(uid, pemKey, passphrase string) error
+	// ImportPrivKeyWrapped imports ASCII armored private keys wrapped by an external key management service.
+	ImportPrivKeyWrapped(ctx context.Context, uid, armor string, unwrapper KeyWrapper) error
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -138,6 +143,12 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address []byte, encryptPassphrase string) (armor string, err error)
+
+	// ExportPrivKeyPKCS8 returns a private key as a PEM encoded, passphrase-encrypted PKCS#8 document.
+	ExportPrivKeyPKCS8(uid, encryptPassphrase string) (pemKey string, err error)
+	// ExportPrivKeyWrapped returns a private key in ASCII armored format, encrypted by an external
+	// key management service through the given KeyWrapper.
+	ExportPrivKeyWrapped(ctx context.Context, uid string, wrapper KeyWrapper) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -318,6 +329,21 @@ func (ks keystore) ExportPrivKeyArmorByAddress(address []byte, encryptPassphrase
 	return ks.ExportPrivKeyArmor(k.Name, encryptPassphrase)
 }
 
+// ExportPrivKeyPKCS8 exports a private key as a PEM encoded, passphrase-encrypted PKCS#8 document.
+func (ks keystore) ExportPrivKeyPKCS8(uid, encryptPassphrase string) (string, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := crypto.EncryptPKCS8PrivKey(priv, encryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
 func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	if k, err := ks.Key(uid); err == nil {
 		if uid == k.Name {
@@ -338,6 +364,21 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+// ImportPrivKeyPKCS8 imports a PEM encoded, passphrase-encrypted PKCS#8 private key.
+func (ks keystore) ImportPrivKeyPKCS8(uid, pemKey, passphrase string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	privKey, _, err := crypto.DecryptPKCS8PrivKey([]byte(pemKey), passphrase)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to decrypt private key")
+	}
+
+	_, err = ks.writeLocalKey(uid, privKey)
+	return err
+}
+
 func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return errorsmod.Wrap(ErrOverwriteKey, uid)
@@ -0,0 +1,148 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+const (
+	blockTypeWrappedPrivKey = "TENDERMINT WRAPPED PRIVATE KEY"
+
+	headerWrapKeyID = "kms-key-id"
+	headerKeyType   = "type"
+
+	// kmsWrapContext binds ciphertexts to their purpose, so that data encrypted
+	// by the same KMS key for other applications cannot be imported as a key.
+	kmsWrapContext = "cosmos-sdk/keyring/private-key"
+)
+
+// KeyWrapper encrypts and decrypts key material with a key that never leaves an
+// external key management service (KMS), such as AWS KMS or Google Cloud KMS.
+// Exporting a key through a KeyWrapper lets operators keep their keys under an
+// existing key management policy: the exported armor can only be imported back
+// by principals allowed to use the KMS key.
+type KeyWrapper interface {
+	// KeyID returns an identifier of the KMS key, recorded in the exported armor.
+	KeyID() string
+	// Wrap encrypts plaintext with the KMS key.
+	Wrap(ctx context.Context, plaintext []byte) ([]byte, error)
+	// Unwrap decrypts a ciphertext previously produced by Wrap.
+	Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// ExportPrivKeyWrapped exports a private key wrapped by the given KeyWrapper in
+// ASCII armored format.
+func (ks keystore) ExportPrivKeyWrapped(ctx context.Context, uid string, wrapper KeyWrapper) (string, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	wrapped, err := wrapper.Wrap(ctx, legacy.Cdc.MustMarshal(priv))
+	if err != nil {
+		return "", errorsmod.Wrap(err, "failed to wrap private key")
+	}
+
+	header := map[string]string{
+		headerWrapKeyID: wrapper.KeyID(),
+		headerKeyType:   priv.Type(),
+	}
+
+	return crypto.EncodeArmor(blockTypeWrappedPrivKey, header, wrapped), nil
+}
+
+// ImportPrivKeyWrapped imports an ASCII armored private key produced by
+// ExportPrivKeyWrapped, unwrapping it with the given KeyWrapper.
+func (ks keystore) ImportPrivKeyWrapped(ctx context.Context, uid, armor string, unwrapper KeyWrapper) error {
+	if _, err := ks.Key(uid); err == nil {
+		return errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	blockType, header, wrapped, err := crypto.DecodeArmor(armor)
+	if err != nil {
+		return err
+	}
+
+	if blockType != blockTypeWrappedPrivKey {
+		return fmt.Errorf("unrecognized armor type %q, expected: %q", blockType, blockTypeWrappedPrivKey)
+	}
+
+	if keyID := header[headerWrapKeyID]; keyID != "" && keyID != unwrapper.KeyID() {
+		return fmt.Errorf("key was wrapped by KMS key %q, got %q", keyID, unwrapper.KeyID())
+	}
+
+	bz, err := unwrapper.Unwrap(ctx, wrapped)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unwrap private key")
+	}
+
+	privKey, err := legacy.PrivKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+
+	_, err = ks.writeLocalKey(uid, privKey)
+	return err
+}
+
+// AWSKMSClient is the subset of the AWS KMS API used to wrap keys. It is meant
+// to be implemented by a thin adapter around the Encrypt and Decrypt operations
+// of the AWS SDK KMS client, keeping the SDK out of this package's dependencies.
+type AWSKMSClient interface {
+	Encrypt(ctx context.Context, keyID string, plaintext []byte, encryptionContext map[string]string) ([]byte, error)
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte, encryptionContext map[string]string) ([]byte, error)
+}
+
+// NewAWSKMSKeyWrapper returns a KeyWrapper backed by the AWS KMS key keyID.
+func NewAWSKMSKeyWrapper(client AWSKMSClient, keyID string) KeyWrapper {
+	return awsKMSKeyWrapper{client: client, keyID: keyID}
+}
+
+type awsKMSKeyWrapper struct {
+	client AWSKMSClient
+	keyID  string
+}
+
+func (w awsKMSKeyWrapper) KeyID() string { return w.keyID }
+
+func (w awsKMSKeyWrapper) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	return w.client.Encrypt(ctx, w.keyID, plaintext, map[string]string{"purpose": kmsWrapContext})
+}
+
+func (w awsKMSKeyWrapper) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	return w.client.Decrypt(ctx, w.keyID, ciphertext, map[string]string{"purpose": kmsWrapContext})
+}
+
+// GCPKMSClient is the subset of the Google Cloud KMS API used to wrap keys. It
+// is meant to be implemented by a thin adapter around the Encrypt and Decrypt
+// operations of the Cloud KMS key management client.
+type GCPKMSClient interface {
+	Encrypt(ctx context.Context, keyName string, plaintext, additionalAuthenticatedData []byte) ([]byte, error)
+	Decrypt(ctx context.Context, keyName string, ciphertext, additionalAuthenticatedData []byte) ([]byte, error)
+}
+
+// NewGCPKMSKeyWrapper returns a KeyWrapper backed by the Cloud KMS crypto key
+// keyName, in the projects/*/locations/*/keyRings/*/cryptoKeys/* format.
+func NewGCPKMSKeyWrapper(client GCPKMSClient, keyName string) KeyWrapper {
+	return gcpKMSKeyWrapper{client: client, keyName: keyName}
+}
+
+type gcpKMSKeyWrapper struct {
+	client  GCPKMSClient
+	keyName string
+}
+
+func (w gcpKMSKeyWrapper) KeyID() string { return w.keyName }
+
+func (w gcpKMSKeyWrapper) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	return w.client.Encrypt(ctx, w.keyName, plaintext, []byte(kmsWrapContext))
+}
+
+func (w gcpKMSKeyWrapper) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	return w.client.Decrypt(ctx, w.keyName, ciphertext, []byte(kmsWrapContext))
+}
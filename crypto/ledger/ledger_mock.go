@@ -82,7 +82,22 @@ func (mock LedgerSECP256K1Mock) GetAddressPubKeySECP256K1(derivationPath []uint3
 	return pk, addr, err
 }
 
+// userMessageChunkSize is the maximum payload carried by a single SIGN APDU
+// in the Ledger Cosmos app transport.
+const userMessageChunkSize = 250
+
 func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message []byte, p2 byte) ([]byte, error) {
+	if p2 != signModeAminoJSON && p2 != signModeTextual {
+		return nil, fmt.Errorf("unsupported sign mode P2 value %d", p2)
+	}
+
+	// emulate the chunked transfer of the message to the device so that long
+	// SIGN_MODE_TEXTUAL envelopes exercise the same path as on real hardware
+	received := make([]byte, 0, len(message))
+	for _, chunk := range chunkMessage(message, userMessageChunkSize) {
+		received = append(received, chunk...)
+	}
+
 	path := hd.NewParams(derivationPath[0], derivationPath[1], derivationPath[2], derivationPath[3] != 0, derivationPath[4])
 	seed, err := bip39.NewSeedWithErrorChecking(testdata.TestMnemonic, "")
 	if err != nil {
@@ -96,11 +111,22 @@ func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message [
 	}
 
 	priv := secp.PrivKeyFromBytes(derivedPriv)
-	sig := ecdsa.Sign(priv, crypto.Sha256(message))
+	sig := ecdsa.Sign(priv, crypto.Sha256(received))
 
 	return sig.Serialize(), nil
 }
 
+// chunkMessage splits a message in chunks of at most chunkSize bytes.
+func chunkMessage(message []byte, chunkSize int) [][]byte {
+	chunks := make([][]byte, 0, len(message)/chunkSize+1)
+	for len(message) > chunkSize {
+		chunks = append(chunks, message[:chunkSize])
+		message = message[chunkSize:]
+	}
+
+	return append(chunks, message)
+}
+
 // ShowAddressSECP256K1 shows the address for the corresponding bip32 derivation path
 func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp string) error {
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
@@ -18,6 +18,14 @@ import (
 // options stores the Ledger Options that can be used to customize Ledger usage
 var options Options
 
+// Sign modes understood by the Ledger Cosmos app. They are sent as the P2
+// value of the SIGN APDU, see
+// https://github.com/cosmos/ledger-cosmos/blob/main/docs/APDUSPEC.md
+const (
+	signModeAminoJSON byte = 0
+	signModeTextual   byte = 1
+)
+
 type (
 	// discoverLedgerFn defines a Ledger discovery function that returns a
 	// connected device or an error upon failure. Its allows a method to avoid CGO
@@ -142,7 +150,7 @@ func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
 	}
 	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message, 1)
+	return sign(device, pkl, message, signModeTextual)
 }
 
 // SignLedgerAminoJSON returns a secp256k1 signature for the corresponding message using
@@ -154,7 +162,7 @@ func (pkl PrivKeyLedgerSecp256k1) SignLedgerAminoJSON(message []byte) ([]byte, e
 	}
 	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message, 0)
+	return sign(device, pkl, message, signModeAminoJSON)
 }
 
 // ShowAddress triggers a ledger device to show the corresponding address.
@@ -287,7 +295,10 @@ func validateKey(device SECP256K1, pkl PrivKeyLedgerSecp256k1) error {
 // an error, so this should only trigger if the private key is held in memory
 // for a while before use.
 //
-// Last byte P2 is 0 for LEGACY_AMINO_JSON, and 1 for TEXTUAL.
+// Last byte P2 is signModeAminoJSON for LEGACY_AMINO_JSON, and signModeTextual
+// for TEXTUAL. For TEXTUAL the message is the CBOR encoded envelope, which can
+// render any registered msg type; long envelopes are split in APDU chunks by
+// the device transport.
 func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, p2 byte) ([]byte, error) {
 	err := validateKey(device, pkl)
 	if err != nil {
@@ -203,6 +203,26 @@ func TestSignaturesHD(t *testing.T) {
 	}
 }
 
+func TestSignLongTextualEnvelope(t *testing.T) {
+	// larger than a single APDU payload so the envelope is sent in chunks
+	msg := make([]byte, 10*250+17)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+	priv, err := NewPrivKeySecp256k1Unsafe(path)
+	require.NoError(t, err)
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().VerifySignature(msg, sig))
+
+	aminoSig, err := priv.SignLedgerAminoJSON(msg)
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().VerifySignature(msg, aminoSig))
+}
+
 func TestRealDeviceSecp256k1(t *testing.T) {
 	msg := getFakeTx(50)
 	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
@@ -0,0 +1,342 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"golang.org/x/crypto/pbkdf2"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	blockTypeEncryptedPKCS8 = "ENCRYPTED PRIVATE KEY"
+
+	pkcs8SaltSize = 16
+	// pkcs8PBKDF2Iterations follows the OWASP recommendation for PBKDF2-HMAC-SHA256.
+	pkcs8PBKDF2Iterations = 600_000
+)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidCurveSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// pkcs8 is the PrivateKeyInfo structure defined in RFC 5208.
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ecPrivateKey is the ECPrivateKey structure defined in RFC 5915.
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// encryptedPrivateKeyInfo is the EncryptedPrivateKeyInfo structure defined in RFC 5208.
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbes2Params is the PBES2-params structure defined in RFC 8018.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params is the PBKDF2-params structure defined in RFC 8018.
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// EncryptPKCS8PrivKey encodes the private key as a PKCS#8 PrivateKeyInfo,
+// encrypts it with PBES2 (PBKDF2-HMAC-SHA256 and AES-256-CBC) using the given
+// passphrase and returns it PEM encoded. The output can be consumed by standard
+// tooling such as openssl and most key management systems.
+// Only secp256k1 and ed25519 keys are supported.
+func EncryptPKCS8PrivKey(privKey cryptotypes.PrivKey, passphrase string) ([]byte, error) {
+	der, err := marshalPKCS8PrivKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := crypto.CRandBytes(pkcs8SaltSize)
+	iv := crypto.CRandBytes(aes.BlockSize)
+	key := pbkdf2.Key([]byte(passphrase), salt, pkcs8PBKDF2Iterations, 32, sha256.New)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error generating cypher from key")
+	}
+
+	plaintext := pkcs7Pad(der, aes.BlockSize)
+	encrypted := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plaintext)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pkcs8PBKDF2Iterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+
+	schemeParams, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: schemeParams}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: blockTypeEncryptedPKCS8, Bytes: bz}), nil
+}
+
+// DecryptPKCS8PrivKey decrypts a PEM encoded, PBES2 encrypted PKCS#8 private key
+// as produced by EncryptPKCS8PrivKey or `openssl pkcs8 -topk8 -v2 aes-256-cbc`.
+// It returns the private key and its algorithm name.
+func DecryptPKCS8PrivKey(pemBz []byte, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
+	block, _ := pem.Decode(pemBz)
+	if block == nil {
+		return nil, "", errors.New("no PEM block found")
+	}
+
+	if block.Type != blockTypeEncryptedPKCS8 {
+		return nil, "", fmt.Errorf("unrecognized PEM block type %q, expected: %q", block.Type, blockTypeEncryptedPKCS8)
+	}
+
+	var info encryptedPrivateKeyInfo
+	if err := unmarshalDER(block.Bytes, &info); err != nil {
+		return nil, "", fmt.Errorf("invalid encrypted private key info: %w", err)
+	}
+
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, "", fmt.Errorf("unsupported encryption algorithm %s, only PBES2 is supported", info.Algo.Algorithm)
+	}
+
+	var scheme pbes2Params
+	if err := unmarshalDER(info.Algo.Parameters.FullBytes, &scheme); err != nil {
+		return nil, "", fmt.Errorf("invalid PBES2 parameters: %w", err)
+	}
+
+	if !scheme.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, "", fmt.Errorf("unsupported key derivation function %s", scheme.KeyDerivationFunc.Algorithm)
+	}
+
+	var kdf pbkdf2Params
+	if err := unmarshalDER(scheme.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, "", fmt.Errorf("invalid PBKDF2 parameters: %w", err)
+	}
+
+	if len(kdf.PRF.Algorithm) != 0 && !kdf.PRF.Algorithm.Equal(oidHMACWithSHA256) {
+		return nil, "", fmt.Errorf("unsupported PBKDF2 pseudorandom function %s", kdf.PRF.Algorithm)
+	}
+
+	if !scheme.EncryptionScheme.Algorithm.Equal(oidAES256CBC) {
+		return nil, "", fmt.Errorf("unsupported encryption scheme %s", scheme.EncryptionScheme.Algorithm)
+	}
+
+	var iv []byte
+	if err := unmarshalDER(scheme.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, "", fmt.Errorf("invalid encryption scheme parameters: %w", err)
+	}
+
+	if len(iv) != aes.BlockSize {
+		return nil, "", fmt.Errorf("invalid IV length %d", len(iv))
+	}
+
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, "", errors.New("encrypted data is not a multiple of the block size")
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), kdf.Salt, kdf.IterationCount, 32, sha256.New)
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, "", errorsmod.Wrap(err, "error generating cypher from key")
+	}
+
+	der := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(c, iv).CryptBlocks(der, info.EncryptedData)
+
+	// CBC is not authenticated: a wrong passphrase shows up either as invalid
+	// padding or as garbage that does not decode as a PrivateKeyInfo.
+	der, ok := pkcs7Unpad(der, aes.BlockSize)
+	if !ok {
+		return nil, "", sdkerrors.ErrWrongPassword
+	}
+
+	privKey, err = unmarshalPKCS8PrivKey(der)
+	if err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrWrongPassword, err.Error())
+	}
+
+	return privKey, privKey.Type(), nil
+}
+
+func marshalPKCS8PrivKey(privKey cryptotypes.PrivKey) ([]byte, error) {
+	var info pkcs8
+
+	switch key := privKey.(type) {
+	case *secp256k1.PrivKey:
+		curve, err := asn1.Marshal(oidCurveSecp256k1)
+		if err != nil {
+			return nil, err
+		}
+
+		pub := key.PubKey().(*secp256k1.PubKey)
+		ecKey, err := asn1.Marshal(ecPrivateKey{
+			Version:    1,
+			PrivateKey: key.Key,
+			PublicKey:  asn1.BitString{Bytes: pub.Key, BitLength: 8 * len(pub.Key)},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		info.Algo = pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}}
+		info.PrivateKey = ecKey
+
+	case *cosmosed25519.PrivKey:
+		seed, err := asn1.Marshal(ed25519.PrivateKey(key.Key).Seed())
+		if err != nil {
+			return nil, err
+		}
+
+		info.Algo = pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyEd25519}
+		info.PrivateKey = seed
+
+	default:
+		return nil, fmt.Errorf("PKCS#8 encoding is not supported for %s keys", privKey.Type())
+	}
+
+	return asn1.Marshal(info)
+}
+
+func unmarshalPKCS8PrivKey(der []byte) (cryptotypes.PrivKey, error) {
+	var info pkcs8
+	if err := unmarshalDER(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid private key info: %w", err)
+	}
+
+	switch {
+	case info.Algo.Algorithm.Equal(oidPublicKeyECDSA):
+		var curve asn1.ObjectIdentifier
+		if err := unmarshalDER(info.Algo.Parameters.FullBytes, &curve); err != nil {
+			return nil, fmt.Errorf("invalid EC parameters: %w", err)
+		}
+
+		if !curve.Equal(oidCurveSecp256k1) {
+			return nil, fmt.Errorf("unsupported elliptic curve %s", curve)
+		}
+
+		var ecKey ecPrivateKey
+		if err := unmarshalDER(info.PrivateKey, &ecKey); err != nil {
+			return nil, fmt.Errorf("invalid EC private key: %w", err)
+		}
+
+		if len(ecKey.PrivateKey) > secp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 private key length %d", len(ecKey.PrivateKey))
+		}
+
+		// RFC 5915 mandates a fixed size, but some encoders strip leading zeros.
+		key := make([]byte, secp256k1.PrivKeySize)
+		copy(key[secp256k1.PrivKeySize-len(ecKey.PrivateKey):], ecKey.PrivateKey)
+
+		return &secp256k1.PrivKey{Key: key}, nil
+
+	case info.Algo.Algorithm.Equal(oidPublicKeyEd25519):
+		var seed []byte
+		if err := unmarshalDER(info.PrivateKey, &seed); err != nil {
+			return nil, fmt.Errorf("invalid ed25519 private key: %w", err)
+		}
+
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid ed25519 seed length %d", len(seed))
+		}
+
+		return &cosmosed25519.PrivKey{Key: ed25519.NewKeyFromSeed(seed)}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported private key algorithm %s", info.Algo.Algorithm)
+	}
+}
+
+// unmarshalDER decodes a DER value and rejects trailing data.
+func unmarshalDER(bz []byte, val any) error {
+	rest, err := asn1.Unmarshal(bz, val)
+	if err != nil {
+		return err
+	}
+
+	if len(rest) != 0 {
+		return errors.New("trailing data after ASN.1 value")
+	}
+
+	return nil
+}
+
+func pkcs7Pad(bz []byte, blockSize int) []byte {
+	n := blockSize - len(bz)%blockSize
+	return append(bz, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+func pkcs7Unpad(bz []byte, blockSize int) ([]byte, bool) {
+	if len(bz) == 0 || len(bz)%blockSize != 0 {
+		return nil, false
+	}
+
+	n := int(bz[len(bz)-1])
+	if n == 0 || n > blockSize {
+		return nil, false
+	}
+
+	for _, b := range bz[len(bz)-n:] {
+		if int(b) != n {
+			return nil, false
+		}
+	}
+
+	return bz[:len(bz)-n], true
+}
@@ -63,7 +63,7 @@ func (c *container) call(provider *providerDescriptor, moduleKey *moduleKey) ([]
 	markGraphNodeAsFailed(graphNode)
 
 	if c.callerMap[loc] {
-		return nil, errors.Errorf("cyclic dependency: %s -> %s", loc.Name(), loc.Name())
+		return nil, c.cyclicDependencyError(loc)
 	}
 
 	c.callerMap[loc] = true
@@ -409,8 +409,11 @@ func (c *container) resolve(in providerInput, moduleKey *moduleKey, caller Locat
 		}
 
 		markGraphNodeAsFailed(typeGraphNode)
-		return reflect.Value{}, errors.Errorf("can't resolve type %v for %s:\n%s",
-			fullyQualifiedTypeName(in.Type), caller, c.formatResolveStack())
+		return reflect.Value{}, ErrMissingProvider{
+			Type:         fullyQualifiedTypeName(in.Type),
+			Caller:       caller,
+			ResolveStack: c.formatResolveStack(),
+		}
 	}
 
 	res, err := vr.resolve(c, moduleKey, caller)
@@ -510,6 +513,20 @@ func (c container) formatResolveStack() string {
 	return buf.String()
 }
 
+// cyclicDependencyError returns the error of a cycle of providers ending with
+// loc, which is already being called.
+func (c container) cyclicDependencyError(loc Location) error {
+	cycle := []Location{loc}
+	for i := len(c.callerStack) - 1; i >= 0; i-- {
+		cycle = append([]Location{c.callerStack[i]}, cycle...)
+		if c.callerStack[i] == loc {
+			break
+		}
+	}
+
+	return ErrCyclicDependency{Cycle: cycle}
+}
+
 func fullyQualifiedTypeName(typ reflect.Type) string {
 	pkgType := typ
 	if typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map || typ.Kind() == reflect.Array {
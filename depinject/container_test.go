@@ -1,6 +1,7 @@
 package depinject_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
@@ -278,27 +279,40 @@ func TestScenario(t *testing.T) {
 
 func TestResolveError(t *testing.T) {
 	var x string
-	require.Error(t, depinject.Inject(
+	err := depinject.Inject(
 		depinject.Provide(
-			func(x float64) string { return fmt.Sprintf("%f", x) },
-			func(x int) float64 { return float64(x) },
-			func(x float32) int { return int(x) },
+			ProvideStringFromFloat64,
+			ProvideFloat64FromInt,
+			ProvideIntFromFloat32,
 		),
 		&x,
-	))
+	)
+	var missingErr depinject.ErrMissingProvider
+	require.ErrorAs(t, err, &missingErr)
+	require.Equal(t, "float32", missingErr.Type)
 }
 
 func TestCyclic(t *testing.T) {
 	var x string
-	require.Error(t, depinject.Inject(
+	err := depinject.Inject(
 		depinject.Provide(
-			func(x int) float64 { return float64(x) },
-			func(x float64) (int, string) { return int(x), "hi" },
+			ProvideFloat64FromInt,
+			ProvideIntAndStringFromFloat64,
 		),
 		&x,
-	))
+	)
+	var cycleErr depinject.ErrCyclicDependency
+	require.ErrorAs(t, err, &cycleErr)
+	require.Len(t, cycleErr.Cycle, 3)
+	require.Equal(t, cycleErr.Cycle[0], cycleErr.Cycle[2])
+	require.NotEqual(t, cycleErr.Cycle[0], cycleErr.Cycle[1])
 }
 
+func ProvideStringFromFloat64(x float64) string { return fmt.Sprintf("%f", x) }
+func ProvideIntFromFloat32(x float32) int       { return int(x) }
+
+func ProvideIntAndStringFromFloat64(x float64) (int, string) { return int(x), "hi" }
+
 func TestErrorOption(t *testing.T) {
 	err := depinject.Inject(depinject.Error(fmt.Errorf("an error")))
 	require.Error(t, err)
@@ -713,6 +727,37 @@ func TestGraphAndLogOutput(t *testing.T) {
 	golden.Assert(t, graphOut, "example_error.dot")
 }
 
+func TestJSONGraphOutput(t *testing.T) {
+	var graphOut string
+	var b KeeperB
+	debugOpts := depinject.JSONVisualizer(func(jsonGraph string) {
+		graphOut = jsonGraph
+	})
+	require.NoError(t, depinject.InjectDebug(debugOpts, scenarioConfig, &b))
+
+	var graph struct {
+		Nodes []struct {
+			Name     string            `json:"name"`
+			SubGraph string            `json:"subgraph"`
+			Attrs    map[string]string `json:"attrs"`
+		} `json:"nodes"`
+		Edges []struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"edges"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(graphOut), &graph))
+	require.NotEmpty(t, graph.Nodes)
+	require.NotEmpty(t, graph.Edges)
+
+	subGraphs := map[string]bool{}
+	for _, node := range graph.Nodes {
+		subGraphs[node.SubGraph] = true
+	}
+	require.True(t, subGraphs["cluster_a"])
+	require.True(t, subGraphs["cluster_b"])
+}
+
 func TestConditionalDebugging(t *testing.T) {
 	logs := ""
 	success := false
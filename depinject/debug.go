@@ -1,6 +1,7 @@
 package depinject
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -85,6 +86,34 @@ func FileVisualizer(filename string) DebugOption {
 	})
 }
 
+// JSONVisualizer creates an option which provides a visualizer function which
+// will receive a rendering of the container in JSON whenever the container
+// finishes building or fails due to an error. The JSON graph has the same
+// nodes, edges and color-coding attributes as the DOT graph of Visualizer,
+// module-scoped providers belonging to the "cluster_<module>" sub-graph, so
+// that it can be processed by tools.
+func JSONVisualizer(visualizer func(jsonGraph string)) DebugOption {
+	return debugOption(func(c *debugConfig) error {
+		c.addFuncVisualizer(func(_ string) {
+			visualizer(c.jsonGraph())
+		})
+		return nil
+	})
+}
+
+// FileJSONVisualizer is a debug option which dumps a JSON rendering of the
+// container to the specified file.
+func FileJSONVisualizer(filename string) DebugOption {
+	return debugOption(func(c *debugConfig) error {
+		c.addFuncVisualizer(func(_ string) {
+			if err := os.WriteFile(filename, []byte(c.jsonGraph()), 0o600); err != nil {
+				c.logf("Error saving JSON graph file %s: %+v", filename, err)
+			}
+		})
+		return nil
+	})
+}
+
 // Logger creates an option which provides a logger function which will
 // receive all log messages from the container.
 func Logger(logger func(string)) DebugOption {
@@ -248,6 +277,14 @@ func (c *debugConfig) generateGraph() {
 	}
 }
 
+func (c *debugConfig) jsonGraph() string {
+	buf := &bytes.Buffer{}
+	if err := c.graph.RenderJSON(buf); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
 func (c *debugConfig) addFuncVisualizer(f func(string)) {
 	c.visualizers = append(c.visualizers, func(dot string) {
 		f(dot)
@@ -3,6 +3,7 @@ package depinject
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 )
@@ -66,3 +67,38 @@ func duplicateDefinitionError(typ reflect.Type, duplicateLoc Location, existingL
 	return errors.Errorf("duplicate provision of type %v by %s\n\talready provided by %s",
 		typ, duplicateLoc, existingLoc)
 }
+
+// ErrCyclicDependency defines an error condition where providers depend on
+// each other in a cycle. Cycle lists the providers of the cycle, starting and
+// ending with the same provider.
+type ErrCyclicDependency struct {
+	Cycle []Location
+}
+
+func (err ErrCyclicDependency) Error() string {
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprint(buf, "cyclic dependency:")
+	for i, loc := range err.Cycle {
+		arrow := "-> "
+		if i == 0 {
+			arrow = ""
+		}
+		_, _ = fmt.Fprintf(buf, "\n\t%s%s", arrow, loc)
+	}
+	return buf.String()
+}
+
+// ErrMissingProvider defines an error condition where no provider of Type,
+// which Caller depends on, was registered in the container.
+type ErrMissingProvider struct {
+	Type         string
+	Caller       Location
+	ResolveStack string
+}
+
+func (err ErrMissingProvider) Error() string {
+	return fmt.Sprintf("can't resolve type %s for %s: no provider of %s is registered.\n"+
+		"Provide it with depinject.Provide or depinject.Supply, bind an implementation to it if it is an interface, "+
+		"or mark the dependency as optional.\n%s",
+		err.Type, err.Caller, err.Type, err.ResolveStack)
+}
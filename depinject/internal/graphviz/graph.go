@@ -3,8 +3,10 @@ package graphviz
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 
 	"cosmossdk.io/depinject/internal/util"
 )
@@ -154,3 +156,53 @@ func (g *Graph) String() string {
 	}
 	return buf.String()
 }
+
+type jsonGraph struct {
+	Nodes []jsonNode `json:"nodes"`
+	Edges []jsonEdge `json:"edges"`
+}
+
+type jsonNode struct {
+	Name     string            `json:"name"`
+	SubGraph string            `json:"subgraph,omitempty"`
+	Attrs    map[string]string `json:"attrs,omitempty"`
+}
+
+type jsonEdge struct {
+	From  string            `json:"from"`
+	To    string            `json:"to"`
+	Attrs map[string]string `json:"attrs,omitempty"`
+}
+
+// RenderJSON renders the graph to JSON, as a list of nodes, each with the name
+// of the sub-graph it belongs to if any, and a list of edges. Nodes are sorted
+// by name so that outputs are stable.
+func (g *Graph) RenderJSON(w io.Writer) error {
+	out := jsonGraph{Nodes: []jsonNode{}, Edges: []jsonEdge{}}
+	g.collectJSON(&out)
+	sort.SliceStable(out.Nodes, func(i, j int) bool { return out.Nodes[i].Name < out.Nodes[j].Name })
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func (g *Graph) collectJSON(out *jsonGraph) {
+	subGraph := ""
+	if g.parent != nil {
+		subGraph = g.name
+	}
+
+	for _, name := range util.OrderedMapKeys(g.myNodes) {
+		node := g.myNodes[name]
+		out.Nodes = append(out.Nodes, jsonNode{Name: node.name, SubGraph: subGraph, Attrs: node.attrs})
+	}
+
+	for _, edge := range g.edges {
+		out.Edges = append(out.Edges, jsonEdge{From: edge.from.name, To: edge.to.name, Attrs: edge.attrs})
+	}
+
+	for _, name := range util.OrderedMapKeys(g.subgraphs) {
+		g.subgraphs[name].collectJSON(out)
+	}
+}
@@ -0,0 +1,358 @@
+package math
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MaxDecContextPrecision is the maximum number of decimal places of a
+// DecContext.
+const MaxDecContextPrecision = 64
+
+// Decimal context errors
+var (
+	ErrInvalidDecContext   = errors.New("invalid decimal context")
+	ErrInvalidDecimalStr   = errors.New("invalid decimal string")
+	ErrDecimalOutOfRange   = errors.New("decimal out of range")
+	ErrDecimalDivByZero    = errors.New("decimal division by zero")
+	ErrUnknownRoundingMode = errors.New("unknown rounding mode")
+)
+
+// RoundingMode selects how the results of the operations of a DecContext are
+// rounded to its precision.
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the value with an
+	// even last digit (banker's rounding).
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero.
+	RoundHalfUp
+	// RoundDown rounds toward zero (truncation).
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "half_even"
+	case RoundHalfUp:
+		return "half_up"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", uint8(m))
+	}
+}
+
+// Decimal is an arbitrary precision decimal number, the result of the
+// operations of a DecContext. The zero value is 0. Decimals are immutable.
+type Decimal struct {
+	// the value is coeff * 10^-scale
+	coeff *big.Int
+	scale uint32
+}
+
+// NewDecimalFromInt64 returns the decimal i.
+func NewDecimalFromInt64(i int64) Decimal {
+	return Decimal{coeff: big.NewInt(i)}
+}
+
+// NewDecimalFromInt returns the decimal i.
+func NewDecimalFromInt(i Int) Decimal {
+	return Decimal{coeff: i.BigInt()}
+}
+
+// NewDecimalFromLegacyDec returns the decimal d, with 18 decimal places.
+func NewDecimalFromLegacyDec(d LegacyDec) Decimal {
+	return Decimal{coeff: d.BigInt(), scale: LegacyPrecision}
+}
+
+// NewDecimalFromString parses str, a decimal number with at most
+// MaxDecContextPrecision decimal places and an integer part of at most
+// MaxBitLen bits, e.g. "-12.345". The exponent notation is not supported.
+func NewDecimalFromString(str string) (Decimal, error) {
+	digits := strings.TrimPrefix(str, "-")
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" || (hasPoint && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimalStr, str)
+	}
+	if len(fracPart) > MaxDecContextPrecision {
+		return Decimal{}, fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidDecimalStr, str, MaxDecContextPrecision)
+	}
+
+	coeff, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimalStr, str)
+	}
+	if len(digits) < len(str) {
+		coeff.Neg(coeff)
+	}
+
+	return checkDecimalRange(Decimal{coeff: coeff, scale: uint32(len(fracPart))})
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (x Decimal) bigInt() *big.Int {
+	if x.coeff == nil {
+		return zeroInt
+	}
+	return x.coeff
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of x.
+func (x Decimal) Sign() int { return x.bigInt().Sign() }
+
+// IsZero returns whether x is 0.
+func (x Decimal) IsZero() bool { return x.Sign() == 0 }
+
+// IsNegative returns whether x is lower than 0.
+func (x Decimal) IsNegative() bool { return x.Sign() < 0 }
+
+// Neg returns -x.
+func (x Decimal) Neg() Decimal {
+	return Decimal{coeff: new(big.Int).Neg(x.bigInt()), scale: x.scale}
+}
+
+// Cmp compares x and y, returning -1 if x < y, 0 if x == y and 1 if x > y.
+func (x Decimal) Cmp(y Decimal) int {
+	xc, yc, _ := alignScales(x, y)
+	return xc.Cmp(yc)
+}
+
+// Equal returns whether x and y are the same number, whatever their number of
+// decimal places.
+func (x Decimal) Equal(y Decimal) bool { return x.Cmp(y) == 0 }
+
+// String returns the canonical representation of x, without trailing zeros,
+// e.g. "-12.345" or "100".
+func (x Decimal) String() string {
+	coeff := x.bigInt()
+	digits := new(big.Int).Abs(coeff).String()
+
+	var sb strings.Builder
+	if coeff.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+
+	scale := int(x.scale)
+	if scale == 0 {
+		sb.WriteString(digits)
+		return sb.String()
+	}
+
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	intPart, fracPart := digits[:len(digits)-scale], strings.TrimRight(digits[len(digits)-scale:], "0")
+	sb.WriteString(intPart)
+	if fracPart != "" {
+		sb.WriteByte('.')
+		sb.WriteString(fracPart)
+	}
+	return sb.String()
+}
+
+// ToLegacyDec returns x rounded to 18 decimal places with mode.
+func (x Decimal) ToLegacyDec(mode RoundingMode) (LegacyDec, error) {
+	rounded, err := DecContext{Precision: LegacyPrecision, Rounding: mode}.Round(x)
+	if err != nil {
+		return LegacyDec{}, err
+	}
+
+	coeff := rescale(rounded.bigInt(), rounded.scale, LegacyPrecision)
+	if coeff.BitLen() > maxDecBitLen {
+		return LegacyDec{}, fmt.Errorf("%w: %s exceeds the range of LegacyDec", ErrDecimalOutOfRange, x)
+	}
+	return LegacyNewDecFromBigIntWithPrec(coeff, LegacyPrecision), nil
+}
+
+// DecContext performs deterministic decimal arithmetic, rounding results to
+// Precision decimal places with the Rounding mode. Unlike LegacyDec, whose
+// precision is fixed to 18 decimal places and whose operations each round in
+// their own way, the precision and rounding of the operations of a context are
+// chosen by its user, e.g. a fee market or an oracle, and results are exact
+// before being rounded once.
+//
+// The integer part of results is limited to MaxBitLen bits.
+type DecContext struct {
+	Precision uint32
+	Rounding  RoundingMode
+}
+
+// NewDecContext returns a decimal context rounding results to precision decimal
+// places with rounding.
+func NewDecContext(precision uint32, rounding RoundingMode) (DecContext, error) {
+	c := DecContext{Precision: precision, Rounding: rounding}
+	return c, c.Validate()
+}
+
+// Validate returns an error if the precision or rounding mode of c is invalid.
+func (c DecContext) Validate() error {
+	if c.Precision > MaxDecContextPrecision {
+		return fmt.Errorf("%w: precision %d exceeds maximum %d", ErrInvalidDecContext, c.Precision, MaxDecContextPrecision)
+	}
+	if c.Rounding > RoundCeiling {
+		return fmt.Errorf("%w: %w: %s", ErrInvalidDecContext, ErrUnknownRoundingMode, c.Rounding)
+	}
+	return nil
+}
+
+// Parse parses str as NewDecimalFromString does, and rounds it.
+func (c DecContext) Parse(str string) (Decimal, error) {
+	x, err := NewDecimalFromString(str)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.Round(x)
+}
+
+// Round returns x rounded to the precision of c.
+func (c DecContext) Round(x Decimal) (Decimal, error) {
+	return c.round(x.bigInt(), x.scale)
+}
+
+// Add returns x + y, rounded.
+func (c DecContext) Add(x, y Decimal) (Decimal, error) {
+	xc, yc, scale := alignScales(x, y)
+	return c.round(xc.Add(xc, yc), scale)
+}
+
+// Sub returns x - y, rounded.
+func (c DecContext) Sub(x, y Decimal) (Decimal, error) {
+	xc, yc, scale := alignScales(x, y)
+	return c.round(xc.Sub(xc, yc), scale)
+}
+
+// Mul returns x * y, rounded.
+func (c DecContext) Mul(x, y Decimal) (Decimal, error) {
+	return c.round(new(big.Int).Mul(x.bigInt(), y.bigInt()), x.scale+y.scale)
+}
+
+// Quo returns x / y, rounded.
+func (c DecContext) Quo(x, y Decimal) (Decimal, error) {
+	if err := c.Validate(); err != nil {
+		return Decimal{}, err
+	}
+	if y.IsZero() {
+		return Decimal{}, ErrDecimalDivByZero
+	}
+
+	// x / y = (xc * 10^(ys+p)) / (yc * 10^xs) * 10^-p
+	num := new(big.Int).Mul(x.bigInt(), pow10(y.scale+c.Precision))
+	den := new(big.Int).Mul(y.bigInt(), pow10(x.scale))
+	return c.result(roundQuo(num, den, c.Rounding))
+}
+
+// round returns coeff * 10^-scale rounded to the precision of c.
+func (c DecContext) round(coeff *big.Int, scale uint32) (Decimal, error) {
+	if err := c.Validate(); err != nil {
+		return Decimal{}, err
+	}
+
+	if scale <= c.Precision {
+		return c.result(rescale(coeff, scale, c.Precision))
+	}
+	return c.result(roundQuo(coeff, pow10(scale-c.Precision), c.Rounding))
+}
+
+func (c DecContext) result(coeff *big.Int) (Decimal, error) {
+	return checkDecimalRange(Decimal{coeff: coeff, scale: c.Precision})
+}
+
+func checkDecimalRange(x Decimal) (Decimal, error) {
+	intPart := new(big.Int).Quo(x.bigInt(), pow10(x.scale))
+	if intPart.BitLen() > MaxBitLen {
+		return Decimal{}, fmt.Errorf("%w: integer part exceeds %d bits", ErrDecimalOutOfRange, MaxBitLen)
+	}
+	return x, nil
+}
+
+// roundQuo returns num / den rounded to an integer with mode.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// the sign of the exact quotient, q being truncated toward zero
+	sign := num.Sign() * den.Sign()
+	away := false
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = sign < 0
+	case RoundCeiling:
+		away = sign > 0
+	default:
+		// compare the remainder to half the divisor
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		switch half.CmpAbs(den) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == RoundHalfUp || q.Bit(0) == 1
+		}
+	}
+
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// alignScales returns the coefficients of x and y with the same scale, and that
+// scale.
+func alignScales(x, y Decimal) (*big.Int, *big.Int, uint32) {
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+	return rescale(x.bigInt(), x.scale, scale), rescale(y.bigInt(), y.scale, scale), scale
+}
+
+// rescale returns coeff * 10^(to-from), to being at least from.
+func rescale(coeff *big.Int, from, to uint32) *big.Int {
+	return new(big.Int).Mul(coeff, pow10(to-from))
+}
+
+var pow10Cache = func() []*big.Int {
+	cache := make([]*big.Int, 2*MaxDecContextPrecision+1)
+	for i := range cache {
+		cache[i] = new(big.Int).Exp(tenInt, big.NewInt(int64(i)), nil)
+	}
+	return cache
+}()
+
+// pow10 returns 10^n, which must not be modified.
+func pow10(n uint32) *big.Int {
+	if int(n) < len(pow10Cache) {
+		return pow10Cache[n]
+	}
+	return new(big.Int).Exp(tenInt, big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,92 @@
+package math_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+// TestDecContextVectors checks the operations of DecContext against vectors
+// computed with the Python decimal module, in every rounding mode.
+func TestDecContextVectors(t *testing.T) {
+	type vector struct {
+		Op        string
+		X, Y      string
+		Precision uint32
+		Results   []string // by rounding mode
+	}
+
+	raw, err := os.ReadFile("./testdata/dec_context.json")
+	require.NoError(t, err)
+	var rows [][]json.RawMessage
+	require.NoError(t, json.Unmarshal(raw, &rows))
+
+	ops := map[string]func(math.DecContext, math.Decimal, math.Decimal) (math.Decimal, error){
+		"add": math.DecContext.Add,
+		"sub": math.DecContext.Sub,
+		"mul": math.DecContext.Mul,
+		"quo": math.DecContext.Quo,
+	}
+
+	for _, row := range rows {
+		var v vector
+		for i, field := range []any{&v.Op, &v.X, &v.Y, &v.Precision, &v.Results} {
+			require.NoError(t, json.Unmarshal(row[i], field))
+		}
+
+		x, err := math.NewDecimalFromString(v.X)
+		require.NoError(t, err)
+		y, err := math.NewDecimalFromString(v.Y)
+		require.NoError(t, err)
+
+		for mode, expected := range v.Results {
+			c, err := math.NewDecContext(v.Precision, math.RoundingMode(mode))
+			require.NoError(t, err)
+
+			name := fmt.Sprintf("%s(%s,%s)/%d/%s", v.Op, v.X, v.Y, v.Precision, c.Rounding)
+			res, err := ops[v.Op](c, x, y)
+			require.NoError(t, err, name)
+			require.Equal(t, expected, res.String(), name)
+		}
+	}
+}
+
+func TestDecContext(t *testing.T) {
+	_, err := math.NewDecContext(math.MaxDecContextPrecision+1, math.RoundHalfEven)
+	require.ErrorIs(t, err, math.ErrInvalidDecContext)
+	_, err = math.NewDecContext(6, math.RoundCeiling+1)
+	require.ErrorIs(t, err, math.ErrUnknownRoundingMode)
+
+	c, err := math.NewDecContext(6, math.RoundHalfEven)
+	require.NoError(t, err)
+
+	_, err = c.Quo(math.NewDecimalFromInt64(1), math.Decimal{})
+	require.ErrorIs(t, err, math.ErrDecimalDivByZero)
+
+	x, err := c.Parse("2.71828182845904523536")
+	require.NoError(t, err)
+	require.Equal(t, "2.718282", x.String())
+	require.True(t, x.Equal(math.NewDecimalFromLegacyDec(math.LegacyMustNewDecFromStr("2.718282"))))
+	require.Equal(t, -1, x.Neg().Cmp(x))
+
+	dec, err := x.ToLegacyDec(math.RoundDown)
+	require.NoError(t, err)
+	require.True(t, math.LegacyMustNewDecFromStr("2.718282").Equal(dec))
+
+	// the integer part of results is limited to MaxBitLen bits
+	huge, err := math.NewDecimalFromString(math.NewIntWithDecimal(1, 77).String())
+	require.NoError(t, err)
+	_, err = c.Mul(huge, huge)
+	require.ErrorIs(t, err, math.ErrDecimalOutOfRange)
+
+	for _, str := range []string{"", "-", ".5", "5.", "1e5", "+1", "1.2.3", "0x10", "1." + strings.Repeat("0", math.MaxDecContextPrecision+1)} {
+		_, err := math.NewDecimalFromString(str)
+		require.ErrorIs(t, err, math.ErrInvalidDecimalStr, str)
+	}
+}
@@ -2,5 +2,9 @@
 Package math implements custom Cosmos SDK math types used for arithmetic
 operations. Signed and unsigned integer types utilize Golang's standard library
 big integers types, having a maximum bit length of 256 bits.
+
+Decimal arithmetic with a chosen precision and rounding mode is performed with
+a DecContext, whose results are exact before being rounded once, as opposed to
+LegacyDec which has a fixed precision of 18 decimal places.
 */
 package math
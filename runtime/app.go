@@ -145,6 +145,8 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	a.AddChainMetadataProvider(a.ModuleManager.ChainMetadataProvider())
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
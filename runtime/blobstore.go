@@ -0,0 +1,295 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/core/store"
+)
+
+// The blob store keeps the keys of the wrapped store under blobDataPrefix,
+// each value prefixed by a tag telling whether it is stored inline or in the
+// blobs database, referenced by its hash, and the number of references to each
+// blob under blobRefCountPrefix.
+var (
+	blobDataPrefix     = []byte{0x00}
+	blobRefCountPrefix = []byte{0x01}
+)
+
+const (
+	blobTagInline byte = iota
+	blobTagRef
+)
+
+// BlobKVStoreService is a store.KVStoreService keeping the values larger than
+// a threshold, such as wasm code or large metadata, out of the state: they are
+// stored in a content-addressed blobs database next to the state, the state
+// only holding their hash, so that they don't bloat the commitment tree.
+//
+// The layout of the wrapped store differs from the layout of a plain store, so
+// enabling the blob store on an existing store requires a store migration.
+// Blobs are not part of state sync snapshots: nodes restored from a snapshot
+// can't read the blobs written before the snapshot height.
+type BlobKVStoreService struct {
+	storeService store.KVStoreService
+	blobs        dbm.DB
+	threshold    int
+}
+
+var _ store.KVStoreService = (*BlobKVStoreService)(nil)
+
+// NewBlobKVStoreService returns a store service wrapping storeService, whose
+// values larger than threshold bytes are kept in blobs. blobs must not be
+// shared with other stores.
+func NewBlobKVStoreService(storeService store.KVStoreService, blobs dbm.DB, threshold int) *BlobKVStoreService {
+	return &BlobKVStoreService{storeService: storeService, blobs: blobs, threshold: threshold}
+}
+
+// OpenKVStore implements store.KVStoreService.
+func (s *BlobKVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return blobKVStore{parent: s.storeService.OpenKVStore(ctx), blobs: s.blobs, threshold: s.threshold}
+}
+
+// CollectGarbage deletes the blobs which are no longer referenced by the state
+// and returns the number of deleted blobs. Blobs are written to the blobs
+// database as soon as they are set, including by txs whose writes are later
+// discarded, and kept until collected. It must be called between blocks, with
+// the context of the block being finalized, for instance from an end blocker,
+// and typically every few blocks as it iterates over all blobs.
+func (s *BlobKVStoreService) CollectGarbage(ctx context.Context) (int, error) {
+	kv := s.storeService.OpenKVStore(ctx)
+
+	it, err := s.blobs.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var unreferenced [][]byte
+	for ; it.Valid(); it.Next() {
+		has, err := kv.Has(blobRefCountKey(it.Key()))
+		if err != nil {
+			_ = it.Close()
+			return 0, err
+		}
+
+		if !has {
+			unreferenced = append(unreferenced, bytes.Clone(it.Key()))
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		_ = it.Close()
+		return 0, err
+	}
+
+	if err := it.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, hash := range unreferenced {
+		if err := s.blobs.Delete(hash); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(unreferenced), nil
+}
+
+type blobKVStore struct {
+	parent    store.KVStore
+	blobs     dbm.DB
+	threshold int
+}
+
+func (s blobKVStore) Get(key []byte) ([]byte, error) {
+	bz, err := s.parent.Get(blobDataKey(key))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	return s.decode(bz)
+}
+
+func (s blobKVStore) Has(key []byte) (bool, error) {
+	return s.parent.Has(blobDataKey(key))
+}
+
+func (s blobKVStore) Set(key, value []byte) error {
+	if err := s.release(key); err != nil {
+		return err
+	}
+
+	if len(value) <= s.threshold {
+		return s.parent.Set(blobDataKey(key), append([]byte{blobTagInline}, value...))
+	}
+
+	hash := sha256.Sum256(value)
+	has, err := s.blobs.Has(hash[:])
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		if err := s.blobs.Set(hash[:], value); err != nil {
+			return err
+		}
+	}
+
+	if err := s.addRef(hash[:], 1); err != nil {
+		return err
+	}
+
+	return s.parent.Set(blobDataKey(key), append([]byte{blobTagRef}, hash[:]...))
+}
+
+func (s blobKVStore) Delete(key []byte) error {
+	if err := s.release(key); err != nil {
+		return err
+	}
+
+	return s.parent.Delete(blobDataKey(key))
+}
+
+func (s blobKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	start, end = blobDataRange(start, end)
+	it, err := s.parent.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobIterator{Iterator: it, store: s}, nil
+}
+
+func (s blobKVStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	start, end = blobDataRange(start, end)
+	it, err := s.parent.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobIterator{Iterator: it, store: s}, nil
+}
+
+// release drops the reference of the value of key to its blob, if any.
+func (s blobKVStore) release(key []byte) error {
+	bz, err := s.parent.Get(blobDataKey(key))
+	if err != nil || len(bz) == 0 || bz[0] != blobTagRef {
+		return err
+	}
+
+	return s.addRef(bz[1:], -1)
+}
+
+// addRef adds delta to the reference count of the blob with hash.
+func (s blobKVStore) addRef(hash []byte, delta int64) error {
+	key := blobRefCountKey(hash)
+	bz, err := s.parent.Get(key)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	if bz != nil {
+		count = int64(binary.BigEndian.Uint64(bz))
+	}
+
+	if count += delta; count <= 0 {
+		return s.parent.Delete(key)
+	}
+
+	return s.parent.Set(key, binary.BigEndian.AppendUint64(nil, uint64(count)))
+}
+
+// decode returns the value of a stored value, reading it from the blobs
+// database if it is a reference.
+func (s blobKVStore) decode(bz []byte) ([]byte, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("blob store: empty stored value")
+	}
+
+	switch bz[0] {
+	case blobTagInline:
+		return bz[1:], nil
+
+	case blobTagRef:
+		hash := bz[1:]
+		value, err := s.blobs.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, fmt.Errorf("blob store: blob %X not found", hash)
+		}
+		if sum := sha256.Sum256(value); !bytes.Equal(sum[:], hash) {
+			return nil, fmt.Errorf("blob store: blob %X is corrupted", hash)
+		}
+		return value, nil
+
+	default:
+		return nil, fmt.Errorf("blob store: unknown value tag %d", bz[0])
+	}
+}
+
+// blobIterator strips the data prefix from the keys of the wrapped store, and
+// decodes their values.
+type blobIterator struct {
+	store.Iterator
+	store blobKVStore
+	err   error
+}
+
+func (it *blobIterator) Domain() (start, end []byte) {
+	start, end = it.Iterator.Domain()
+	if start = bytes.TrimPrefix(start, blobDataPrefix); len(start) == 0 {
+		start = nil
+	}
+	if bytes.Equal(end, blobRefCountPrefix) {
+		end = nil
+	} else {
+		end = bytes.TrimPrefix(end, blobDataPrefix)
+	}
+	return start, end
+}
+
+func (it *blobIterator) Key() []byte {
+	return it.Iterator.Key()[len(blobDataPrefix):]
+}
+
+func (it *blobIterator) Value() []byte {
+	value, err := it.store.decode(it.Iterator.Value())
+	if err != nil {
+		it.err = err
+	}
+	return value
+}
+
+func (it *blobIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.Iterator.Error()
+}
+
+func blobDataKey(key []byte) []byte {
+	return append(append([]byte{}, blobDataPrefix...), key...)
+}
+
+func blobRefCountKey(hash []byte) []byte {
+	return append(append([]byte{}, blobRefCountPrefix...), hash...)
+}
+
+// blobDataRange returns the range of the wrapped store matching the range of
+// keys [start, end), nil bounds being open.
+func blobDataRange(start, end []byte) ([]byte, []byte) {
+	start = blobDataKey(start)
+	if end == nil {
+		return start, blobRefCountPrefix
+	}
+
+	return start, blobDataKey(end)
+}
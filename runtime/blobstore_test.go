@@ -0,0 +1,78 @@
+package runtime_test
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestBlobKVStoreService(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	blobs := dbm.NewMemDB()
+	service := runtime.NewBlobKVStoreService(runtime.NewKVStoreService(key), blobs, 16)
+	kv := service.OpenKVStore(ctx)
+
+	small := []byte("small")
+	large := bytes.Repeat([]byte("large"), 100)
+	require.NoError(t, kv.Set([]byte("a"), small))
+	require.NoError(t, kv.Set([]byte("b"), large))
+	require.NoError(t, kv.Set([]byte("c"), large))
+
+	// large values are kept out of the state, once per content
+	for _, k := range [][]byte{[]byte("a"), []byte("b"), []byte("c")} {
+		raw := ctx.KVStore(key).Get(append([]byte{0x00}, k...))
+		require.NotNil(t, raw)
+		require.LessOrEqual(t, len(raw), 33)
+	}
+	stats, err := blobs.Iterator(nil, nil)
+	require.NoError(t, err)
+	var count int
+	for ; stats.Valid(); stats.Next() {
+		count++
+	}
+	require.NoError(t, stats.Close())
+	require.Equal(t, 1, count)
+
+	value, err := kv.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, large, value)
+	value, err = kv.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, small, value)
+
+	// iterators decode values and strip the internal prefix
+	it, err := kv.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+		require.NotEmpty(t, it.Value())
+	}
+	require.NoError(t, it.Error())
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+
+	// blobs are collected once no longer referenced
+	require.NoError(t, kv.Delete([]byte("b")))
+	collected, err := service.CollectGarbage(ctx)
+	require.NoError(t, err)
+	require.Zero(t, collected)
+
+	require.NoError(t, kv.Set([]byte("c"), small))
+	collected, err = service.CollectGarbage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, collected)
+
+	value, err = kv.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, small, value)
+}
@@ -5,6 +5,7 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
 	"github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -19,5 +20,7 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error {
 	}
 	reflectionv1.RegisterReflectionServiceServer(cfg.QueryServer(), reflectionSvc)
 
+	appservice.RegisterAppService(cfg.QueryServer(), a.BaseApp)
+
 	return nil
 }
@@ -0,0 +1,84 @@
+package blockresults
+
+import (
+	"context"
+	"errors"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterService registers the block results gRPC service serving the
+// results of store.
+func RegisterService(server gogogrpc.Server, store *Store) {
+	RegisterServiceServer(server, service{store: store})
+}
+
+type service struct {
+	store *Store
+}
+
+var _ ServiceServer = service{}
+
+// BlockResults implements the Service.BlockResults gRPC method. The tx results
+// of the response are paginated with the offset and limit of the page request.
+func (s service) BlockResults(_ context.Context, req *BlockResultsRequest) (*BlockResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	page := req.Pagination
+	if page == nil {
+		page = &query.PageRequest{}
+	}
+
+	if len(page.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	height := req.Height
+	if height == 0 {
+		var err error
+		if height, err = s.store.LatestHeight(); err != nil {
+			return nil, blockResultsStatus(err)
+		}
+	}
+
+	res, err := s.store.BlockResults(height)
+	if err != nil {
+		return nil, blockResultsStatus(err)
+	}
+
+	total := uint64(len(res.TxResults))
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := min(page.Offset, total)
+	end := min(start+limit, total)
+	if page.Reverse {
+		start, end = total-end, total-start
+	}
+	res.TxResults = res.TxResults[start:end]
+
+	return &BlockResultsResponse{
+		Height:     height,
+		Results:    res,
+		Pagination: &query.PageResponse{Total: total},
+	}, nil
+}
+
+func blockResultsStatus(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
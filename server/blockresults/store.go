@@ -0,0 +1,164 @@
+package blockresults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrNotFound is returned when the results of a block are not in the store,
+// because the block isn't committed yet, was pruned, or was committed before
+// the store was enabled.
+var ErrNotFound = errors.New("block results not found")
+
+var (
+	resultsPrefix   = []byte{0x00}
+	latestHeightKey = []byte{0x01}
+)
+
+var _ baseapp.BlockResultsStore = (*Store)(nil)
+
+// Store persists the results of the blocks executed by the node, as returned
+// to CometBFT by FinalizeBlock: tx results, block events, validator and
+// consensus params updates. It is an ABCIListener, so it is filled as blocks
+// are committed, and it only keeps the results of the most recent blocks.
+type Store struct {
+	db         dbm.DB
+	keepRecent int64
+
+	mtx sync.Mutex
+	// pending holds the results of the block being finalized, written at commit
+	pending *abci.ResponseFinalizeBlock
+	height  int64
+}
+
+// NewStore returns a store of block results backed by db, keeping the results
+// of the keepRecent most recent blocks, or of all blocks if keepRecent is 0.
+func NewStore(db dbm.DB, keepRecent uint64) *Store {
+	return &Store{db: db, keepRecent: int64(keepRecent)}
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (s *Store) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending, s.height = &res, req.Height
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. The results of the block
+// are written once it is committed, and the results of the blocks older than
+// the retained ones are pruned.
+func (s *Store) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.pending == nil {
+		return nil
+	}
+
+	bz, err := s.pending.Marshal()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(resultsKey(s.height), bz); err != nil {
+		return err
+	}
+
+	if err := batch.Set(latestHeightKey, sdk.Uint64ToBigEndian(uint64(s.height))); err != nil {
+		return err
+	}
+
+	if s.keepRecent > 0 && s.height > s.keepRecent {
+		// prune all the blocks older than the retained ones, in case the number
+		// of retained blocks was lowered since the node was last started
+		if err := s.pruneBefore(batch, s.height-s.keepRecent+1); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	s.pending = nil
+	return nil
+}
+
+func (s *Store) pruneBefore(batch dbm.Batch, height int64) error {
+	it, err := s.db.Iterator(resultsKey(0), resultsKey(height))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// LatestHeight returns the height of the latest block whose results are in
+// the store, or 0 if the store is empty.
+func (s *Store) LatestHeight() (int64, error) {
+	bz, err := s.db.Get(latestHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// BlockResults returns the results of the block at height, or of the latest
+// block if height is 0.
+func (s *Store) BlockResults(height int64) (*abci.ResponseFinalizeBlock, error) {
+	if height == 0 {
+		latest, err := s.LatestHeight()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+
+	bz, err := s.db.Get(resultsKey(height))
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return nil, fmt.Errorf("%w at height %d", ErrNotFound, height)
+	}
+
+	var res abci.ResponseFinalizeBlock
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func resultsKey(height int64) []byte {
+	return append(resultsPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,129 @@
+package blockresults_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/blockresults"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func commitBlock(t *testing.T, store *blockresults.Store, height int64, numTxs int) {
+	t.Helper()
+
+	res := abci.ResponseFinalizeBlock{
+		Events:    []abci.Event{{Type: "block"}},
+		TxResults: make([]*abci.ExecTxResult, numTxs),
+	}
+	for i := range res.TxResults {
+		res.TxResults[i] = &abci.ExecTxResult{GasUsed: int64(i)}
+	}
+
+	require.NoError(t, store.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, res))
+	require.NoError(t, store.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestStore(t *testing.T) {
+	store := blockresults.NewStore(dbm.NewMemDB(), 2)
+
+	latest, err := store.LatestHeight()
+	require.NoError(t, err)
+	require.Zero(t, latest)
+
+	_, err = store.BlockResults(0)
+	require.ErrorIs(t, err, blockresults.ErrNotFound)
+
+	for height := int64(1); height <= 3; height++ {
+		commitBlock(t, store, height, int(height))
+	}
+
+	latest, err = store.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+
+	res, err := store.BlockResults(0)
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 3)
+	require.Equal(t, "block", res.Events[0].Type)
+
+	res, err = store.BlockResults(2)
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+
+	// the results of the first block are pruned
+	_, err = store.BlockResults(1)
+	require.ErrorIs(t, err, blockresults.ErrNotFound)
+
+	// a commit without finalized block doesn't write anything
+	require.NoError(t, store.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	latest, err = store.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+}
+
+func TestService(t *testing.T) {
+	store := blockresults.NewStore(dbm.NewMemDB(), 0)
+	commitBlock(t, store, 1, 5)
+	commitBlock(t, store, 2, 1)
+
+	grpcCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+	srv := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	blockresults.RegisterService(srv, store)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx := context.Background()
+	client := blockresults.NewServiceClient(conn)
+
+	res, err := client.BlockResults(ctx, &blockresults.BlockResultsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	require.Len(t, res.Results.TxResults, 1)
+
+	res, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: 1, Pagination: &query.PageRequest{Offset: 1, Limit: 3}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.Len(t, res.Results.TxResults, 3)
+	require.Equal(t, int64(1), res.Results.TxResults[0].GasUsed)
+	require.Equal(t, int64(3), res.Results.TxResults[2].GasUsed)
+
+	res, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: 1, Pagination: &query.PageRequest{Offset: 4, Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Results.TxResults, 1)
+
+	res, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: 1, Pagination: &query.PageRequest{Limit: 2, Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Results.TxResults, 2)
+	require.Equal(t, int64(3), res.Results.TxResults[0].GasUsed)
+
+	_, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: 1, Pagination: &query.PageRequest{Key: []byte("key")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.BlockResults(ctx, &blockresults.BlockResultsRequest{Height: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"cosmossdk.io/log"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	flagCompactStart = "start"
+	flagCompactEnd   = "end"
+)
+
+// ErrCompactionUnsupported is returned when compacting a database whose backend
+// doesn't support manual compactions.
+var ErrCompactionUnsupported = errors.New("the database backend doesn't support compactions")
+
+// CompactionStats are the compaction statistics of a database.
+type CompactionStats struct {
+	// Compactions is the number of compactions run since the database was opened.
+	Compactions int64
+	// WriteAmplification is the ratio of the bytes written to disk by flushes
+	// and compactions to the bytes flushed from memory.
+	WriteAmplification float64
+}
+
+// CompactRange compacts the keys of db in [start, end). nil bounds are open,
+// so that CompactRange(db, nil, nil) compacts the whole database. Only the
+// goleveldb and pebbledb backends are supported, the latter in builds with the
+// pebbledb build tag.
+func CompactRange(db dbm.DB, start, end []byte) error {
+	switch db := db.(type) {
+	case *dbm.GoLevelDB:
+		return db.DB().CompactRange(util.Range{Start: start, Limit: end})
+
+	default:
+		return compactPebbleRange(db, start, end)
+	}
+}
+
+// GetCompactionStats returns the compaction statistics of db. Only the goleveldb
+// and pebbledb backends are supported, the latter in builds with the pebbledb
+// build tag.
+func GetCompactionStats(db dbm.DB) (CompactionStats, error) {
+	switch db := db.(type) {
+	case *dbm.GoLevelDB:
+		var stats leveldb.DBStats
+		if err := db.DB().Stats(&stats); err != nil {
+			return CompactionStats{}, err
+		}
+
+		var written int64
+		for _, w := range stats.LevelWrite {
+			written += w
+		}
+
+		var writeAmp float64
+		if len(stats.LevelWrite) > 0 && stats.LevelWrite[0] > 0 {
+			writeAmp = float64(written) / float64(stats.LevelWrite[0])
+		}
+
+		return CompactionStats{
+			Compactions:        int64(stats.MemComp + stats.Level0Comp + stats.NonLevel0Comp + stats.SeekComp),
+			WriteAmplification: writeAmp,
+		}, nil
+
+	default:
+		return getPebbleCompactionStats(db)
+	}
+}
+
+// startCompaction starts the scheduled compactions and the compaction metrics
+// of the application database, as configured. The returned function stops
+// them, waiting for a running compaction to complete, and must be called
+// before the database is closed.
+func startCompaction(cfg serverconfig.CompactionConfig, db dbm.DB, logger log.Logger) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	if cfg.MetricsInterval > 0 {
+		if _, err := GetCompactionStats(db); err != nil {
+			logger.Info("compaction metrics are not reported", "err", err)
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				reportCompactionMetrics(ctx, db, cfg.MetricsInterval, logger)
+			}()
+		}
+	}
+
+	if cfg.Scheduled {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scheduleCompactions(ctx, db, func(now time.Time) time.Time { return nextCompaction(now, cfg.Hour) }, logger)
+		}()
+	}
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+func reportCompactionMetrics(ctx context.Context, db dbm.DB, interval time.Duration, logger log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stats, err := GetCompactionStats(db)
+		if err != nil {
+			logger.Error("failed to get compaction stats", "err", err)
+			continue
+		}
+
+		telemetry.SetGauge(float32(stats.Compactions), "store", "db", "compactions")
+		telemetry.SetGauge(float32(stats.WriteAmplification), "store", "db", "write_amplification")
+	}
+}
+
+// scheduleCompactions compacts db at the times returned by next, given the
+// current time, until ctx is done.
+func scheduleCompactions(ctx context.Context, db dbm.DB, next func(now time.Time) time.Time, logger log.Logger) {
+	for {
+		at := next(time.Now().UTC())
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		logger.Info("starting scheduled compaction")
+		start := time.Now()
+		if err := CompactRange(db, nil, nil); err != nil {
+			logger.Error("scheduled compaction failed", "err", err)
+			continue
+		}
+
+		telemetry.MeasureSince(start, "store", "db", "scheduled_compaction")
+		logger.Info("scheduled compaction completed", "duration", time.Since(start))
+	}
+}
+
+// nextCompaction returns the first time after now at hour, in UTC.
+func nextCompaction(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// NewCompactDBCmd creates a command compacting the application database, while
+// the node is stopped.
+func NewCompactDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact-db",
+		Short: "Compact the application database",
+		Long: `Compact the application database, or the range of keys between --start and --end
+(hex encoded, end excluded), reclaiming the space of deleted and overwritten keys.
+The node must be stopped. Only the goleveldb and pebbledb backends are supported.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+
+			start, err := hexFlag(cmd, flagCompactStart)
+			if err != nil {
+				return err
+			}
+			end, err := hexFlag(cmd, flagCompactEnd)
+			if err != nil {
+				return err
+			}
+
+			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			began := time.Now()
+			if err := CompactRange(db, start, end); err != nil {
+				return err
+			}
+
+			cmd.Printf("Compacted the application database in %s\n", time.Since(began))
+			if stats, err := GetCompactionStats(db); err == nil {
+				cmd.Printf("Compactions: %d, write amplification: %.2f\n", stats.Compactions, stats.WriteAmplification)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCompactStart, "", "first key of the range to compact, hex encoded (default: first key)")
+	cmd.Flags().String(flagCompactEnd, "", "end of the range to compact, excluded, hex encoded (default: after the last key)")
+	return cmd
+}
+
+func hexFlag(cmd *cobra.Command, name string) ([]byte, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return bz, nil
+}
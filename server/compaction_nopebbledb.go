@@ -0,0 +1,17 @@
+//go:build !pebbledb
+
+package server
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// compactPebbleRange is a stub for builds without the pebbledb backend.
+func compactPebbleRange(dbm.DB, []byte, []byte) error {
+	return ErrCompactionUnsupported
+}
+
+// getPebbleCompactionStats is a stub for builds without the pebbledb backend.
+func getPebbleCompactionStats(dbm.DB) (CompactionStats, error) {
+	return CompactionStats{}, ErrCompactionUnsupported
+}
@@ -0,0 +1,74 @@
+//go:build pebbledb
+
+package server
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// compactPebbleRange compacts the keys of db in [start, end) if db is a
+// pebbledb database.
+func compactPebbleRange(db dbm.DB, start, end []byte) error {
+	pdb, ok := db.(*dbm.PebbleDB)
+	if !ok {
+		return ErrCompactionUnsupported
+	}
+
+	// pebble requires explicit bounds, its end bound being exclusive
+	if start == nil || end == nil {
+		first, last, err := keyRange(db)
+		if err != nil || first == nil {
+			return err
+		}
+		if start == nil {
+			start = first
+		}
+		if end == nil {
+			end = append(last, 0x00)
+		}
+	}
+	return pdb.DB().Compact(start, end, true)
+}
+
+// getPebbleCompactionStats returns the compaction statistics of db if db is a
+// pebbledb database.
+func getPebbleCompactionStats(db dbm.DB) (CompactionStats, error) {
+	pdb, ok := db.(*dbm.PebbleDB)
+	if !ok {
+		return CompactionStats{}, ErrCompactionUnsupported
+	}
+
+	metrics := pdb.DB().Metrics()
+	total := metrics.Total()
+	return CompactionStats{
+		Compactions:        metrics.Compact.Count,
+		WriteAmplification: total.WriteAmp(),
+	}, nil
+}
+
+// keyRange returns the first and last keys of db, or nil if db is empty.
+func keyRange(db dbm.DB) (first, last []byte, err error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if it.Valid() {
+		first = append([]byte{}, it.Key()...)
+	}
+	if err := it.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	rit, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rit.Valid() {
+		last = append([]byte{}, rit.Key()...)
+	}
+	if err := rit.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return first, last, nil
+}
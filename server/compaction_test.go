@@ -0,0 +1,123 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestNextCompaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		hour     int
+		expected time.Time
+	}{
+		{
+			name:     "later the same day",
+			now:      time.Date(2024, 3, 10, 1, 30, 0, 0, time.UTC),
+			hour:     3,
+			expected: time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "at the compaction hour",
+			now:      time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC),
+			hour:     3,
+			expected: time.Date(2024, 3, 11, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "past the compaction hour",
+			now:      time.Date(2024, 3, 10, 3, 0, 1, 0, time.UTC),
+			hour:     3,
+			expected: time.Date(2024, 3, 11, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "next month",
+			now:      time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC),
+			hour:     0,
+			expected: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, nextCompaction(tt.now, tt.hour))
+		})
+	}
+}
+
+func TestScheduleCompactions(t *testing.T) {
+	db, err := dbm.NewGoLevelDB("application", t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%03d", i)), []byte("value")))
+	}
+
+	tests := []struct {
+		name     string
+		db       dbm.DB
+		expected string
+	}{
+		{"compaction triggered", db, "scheduled compaction completed"},
+		{"unsupported backend", dbm.NewMemDB(), "scheduled compaction failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs syncBuffer
+			logger := log.NewLogger(&logs, log.ColorOption(false))
+
+			// compactions are scheduled every 10ms, and keep being scheduled
+			// after a failure
+			next := func(now time.Time) time.Time { return now.Add(10 * time.Millisecond) }
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				scheduleCompactions(ctx, tt.db, next, logger)
+			}()
+
+			require.Eventually(t, func() bool {
+				return strings.Count(logs.String(), tt.expected) >= 2
+			}, 5*time.Second, 10*time.Millisecond)
+
+			// the scheduler stops when its context is done
+			cancel()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("the compaction scheduler did not stop")
+			}
+		})
+	}
+}
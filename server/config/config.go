@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -22,6 +23,10 @@ const (
 	// DefaultGRPCAddress defines the default address to bind the gRPC server to.
 	DefaultGRPCAddress = "localhost:9090"
 
+	// DefaultQueryProfilerAddress defines the default address to bind the query
+	// profiler admin endpoint to.
+	DefaultQueryProfilerAddress = "localhost:6061"
+
 	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
 	// bytes the server can receive.
 	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
@@ -42,6 +47,11 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// The maximum number of grpc/Rest queries run concurrently, a quarter of
+	// which is reserved to queries originated by the app itself, e.g. while
+	// building a proposal. If set to 0, it is unbounded.
+	QueryMaxConcurrency uint64 `mapstructure:"query-max-concurrency"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -85,6 +95,15 @@ type BaseConfig struct {
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
+	// CacheMemoryBudget is the memory budget, in bytes, of the caches of the app
+	// sized by their hit rate, i.e. the tx decode cache. If set to 0, they are
+	// disabled.
+	CacheMemoryBudget uint64 `mapstructure:"cache-memory-budget"`
+
+	// DisableOptimisticExecution disables the optimistic execution of accepted
+	// proposals, even if the app enables it.
+	DisableOptimisticExecution bool `mapstructure:"disable-optimistic-execution"`
+
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
@@ -158,6 +177,77 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// BlockResultsConfig defines the configuration of the local block results store
+// and of the gRPC service serving it.
+type BlockResultsConfig struct {
+	// Enable defines if the results of committed blocks should be persisted and
+	// served by the gRPC server.
+	Enable bool `mapstructure:"enable"`
+
+	// KeepRecent sets the number of recent blocks whose results are kept.
+	// 0 keeps the results of all blocks.
+	KeepRecent uint64 `mapstructure:"keep-recent"`
+}
+
+// EventStoreConfig defines the configuration of the local event store.
+type EventStoreConfig struct {
+	// Enable defines if the events of committed blocks should be persisted and
+	// served by the Events query of the app service.
+	Enable bool `mapstructure:"enable"`
+
+	// KeepRecent sets the number of recent blocks whose events are kept,
+	// regardless of the pruning of the application state.
+	// 0 keeps the events of all blocks.
+	KeepRecent uint64 `mapstructure:"keep-recent"`
+}
+
+// RebroadcastConfig defines the configuration of the rebroadcast of the txs
+// submitted through the tx service.
+type RebroadcastConfig struct {
+	// Enable defines if the txs submitted through the tx service should be
+	// rebroadcast until they are committed.
+	Enable bool `mapstructure:"enable"`
+
+	// RebroadcastAfter sets the number of blocks after which a tx which wasn't
+	// committed is broadcast again.
+	RebroadcastAfter uint64 `mapstructure:"rebroadcast-after"`
+
+	// TTL sets the number of blocks after its submission after which a tx which
+	// wasn't committed stops being rebroadcast. 0 rebroadcasts txs until they
+	// are committed.
+	TTL uint64 `mapstructure:"ttl"`
+
+	// MaxPending sets the maximum number of txs rebroadcast. 0 disables the limit.
+	MaxPending int `mapstructure:"max-pending"`
+}
+
+// CompactionConfig defines the configuration of the compactions and of the
+// compaction metrics of the application database.
+type CompactionConfig struct {
+	// Scheduled defines if the whole application database should be compacted
+	// once a day at Hour, so that compactions are run off-peak.
+	Scheduled bool `mapstructure:"scheduled"`
+
+	// Hour sets the hour of the day, in UTC, at which scheduled compactions run.
+	Hour int `mapstructure:"hour"`
+
+	// MetricsInterval sets the interval at which the compaction and write
+	// amplification metrics of the database are reported to telemetry. 0
+	// disables the metrics.
+	MetricsInterval time.Duration `mapstructure:"metrics-interval"`
+}
+
+// QueryProfilerConfig defines the configuration of the admin endpoint
+// profiling the queries served by the node.
+type QueryProfilerConfig struct {
+	// Enable defines if the queries can be profiled through the admin endpoint.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the admin endpoint listens on. It should not
+	// be reachable from the public network.
+	Address string `mapstructure:"address"`
+}
+
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
 // implementations.
 type MempoolConfig struct {
@@ -166,6 +256,27 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// TxSelector defines how the default PrepareProposal handler selects the
+	// txs of a proposal: "fifo", "fee-priority" or "gas-knapsack".
+	TxSelector string `mapstructure:"tx-selector"`
+
+	// TxTTLBlocks defines the number of blocks after which a tx expires and
+	// is evicted from the mempool, 0 to never expire txs by height.
+	TxTTLBlocks int64 `mapstructure:"tx-ttl-blocks"`
+
+	// TxTTL defines the duration of block time after which a tx expires and
+	// is evicted from the mempool, 0 to never expire txs by time.
+	TxTTL time.Duration `mapstructure:"tx-ttl"`
+
+	// MaxTxsPerSender defines the maximum number of txs of a single sender in
+	// the mempool, 0 for no limit.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// ReplaceByFeeBump defines by how many percent the fee of a tx must exceed
+	// the fee of the tx of the same sender and nonce to replace it, 0 to let
+	// the new tx always replace the existing one.
+	ReplaceByFeeBump uint64 `mapstructure:"replace-by-fee-bump"`
 }
 
 // State Streaming configuration
@@ -187,13 +298,18 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Streaming StreamingConfig  `mapstructure:"streaming"`
-	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Telemetry     telemetry.Config    `mapstructure:"telemetry"`
+	API           APIConfig           `mapstructure:"api"`
+	GRPC          GRPCConfig          `mapstructure:"grpc"`
+	GRPCWeb       GRPCWebConfig       `mapstructure:"grpc-web"`
+	StateSync     StateSyncConfig     `mapstructure:"state-sync"`
+	Streaming     StreamingConfig     `mapstructure:"streaming"`
+	Mempool       MempoolConfig       `mapstructure:"mempool"`
+	BlockResults  BlockResultsConfig  `mapstructure:"block-results"`
+	EventStore    EventStoreConfig    `mapstructure:"event-store"`
+	Rebroadcast   RebroadcastConfig   `mapstructure:"rebroadcast"`
+	Compaction    CompactionConfig    `mapstructure:"compaction"`
+	QueryProfiler QueryProfilerConfig `mapstructure:"query-profiler"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -219,17 +335,20 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:               defaultMinGasPrices,
+			QueryGasLimit:              0,
+			QueryMaxConcurrency:        0,
+			InterBlockCache:            true,
+			Pruning:                    pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:          "0",
+			PruningInterval:            "0",
+			MinRetainBlocks:            0,
+			IndexEvents:                make([]string, 0),
+			IAVLCacheSize:              781250,
+			CacheMemoryBudget:          0,
+			DisableOptimisticExecution: false,
+			IAVLDisableFastNode:        false,
+			AppDBBackend:               "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -263,7 +382,31 @@ func DefaultConfig() *Config {
 			},
 		},
 		Mempool: MempoolConfig{
-			MaxTxs: 5_000,
+			MaxTxs:     5_000,
+			TxSelector: "fifo",
+		},
+		BlockResults: BlockResultsConfig{
+			Enable:     false,
+			KeepRecent: 100_000,
+		},
+		EventStore: EventStoreConfig{
+			Enable:     false,
+			KeepRecent: 1_000_000,
+		},
+		Rebroadcast: RebroadcastConfig{
+			Enable:           false,
+			RebroadcastAfter: 5,
+			TTL:              100,
+			MaxPending:       1_000,
+		},
+		Compaction: CompactionConfig{
+			Scheduled:       false,
+			Hour:            3,
+			MetricsInterval: time.Minute,
+		},
+		QueryProfiler: QueryProfilerConfig{
+			Enable:  false,
+			Address: DefaultQueryProfilerAddress,
 		},
 	}
 }
@@ -287,6 +430,20 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Compaction.Hour < 0 || c.Compaction.Hour > 23 {
+		return sdkerrors.ErrAppConfig.Wrapf("compaction hour must be in [0, 23]: %d", c.Compaction.Hour)
+	}
+	switch c.Mempool.TxSelector {
+	case "", "fifo", "fee-priority", "gas-knapsack":
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unknown mempool tx selector: %s", c.Mempool.TxSelector)
+	}
+	if c.Mempool.TxTTLBlocks < 0 || c.Mempool.TxTTL < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool tx ttl cannot be negative: %d blocks, %s", c.Mempool.TxTTLBlocks, c.Mempool.TxTTL)
+	}
+	if c.Mempool.MaxTxsPerSender < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool max txs per sender cannot be negative: %d", c.Mempool.MaxTxsPerSender)
+	}
 
 	return nil
 }
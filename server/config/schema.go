@@ -0,0 +1,326 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// SectionSchema describes a custom section of app.toml registered by a module.
+type SectionSchema struct {
+	// Name is the name of the top-level table holding the section in app.toml.
+	Name string
+	// Description is a short description of the section, used in the generated
+	// documentation.
+	Description string
+	// Default is a pointer to a struct holding the default values of the section.
+	// Its fields are mapped to app.toml keys with mapstructure tags, and can be
+	// documented with a doc tag.
+	Default interface{}
+	// Validate, when set, is called at startup with a pointer to the decoded
+	// section, of the same type as Default.
+	Validate func(cfg interface{}) error
+}
+
+// SectionField describes a single key of a registered section.
+type SectionField struct {
+	// Key is the key of the field, relative to the section.
+	Key     string
+	Type    string
+	Default string
+	Doc     string
+
+	// wildcard is set for map fields, which accept any sub-key.
+	wildcard bool
+}
+
+var (
+	sectionsMu sync.RWMutex
+	sections   = map[string]SectionSchema{}
+)
+
+// RegisterSection registers the schema of a custom app.toml section. Registered
+// sections are decoded and validated when the configuration is loaded, and
+// unknown keys in them are reported instead of being silently ignored.
+//
+// Sections must be registered before the configuration is loaded, i.e. before
+// the root command pre-run handler is executed, usually from an init function.
+func RegisterSection(schema SectionSchema) error {
+	if schema.Name == "" {
+		return errors.New("section name cannot be empty")
+	}
+
+	t := reflect.TypeOf(schema.Default)
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct || reflect.ValueOf(schema.Default).IsNil() {
+		return fmt.Errorf("section %s: default must be a non-nil pointer to a struct, got %T", schema.Name, schema.Default)
+	}
+
+	sectionsMu.Lock()
+	defer sectionsMu.Unlock()
+
+	if _, ok := sections[schema.Name]; ok {
+		return fmt.Errorf("section %s already registered", schema.Name)
+	}
+
+	sections[schema.Name] = schema
+	return nil
+}
+
+// MustRegisterSection calls RegisterSection and panics on error.
+func MustRegisterSection(schema SectionSchema) {
+	if err := RegisterSection(schema); err != nil {
+		panic(err)
+	}
+}
+
+// RegisteredSections returns the registered section schemas sorted by name.
+func RegisteredSections() []SectionSchema {
+	sectionsMu.RLock()
+	defer sectionsMu.RUnlock()
+
+	schemas := make([]SectionSchema, 0, len(sections))
+	for _, schema := range sections {
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+
+	return schemas
+}
+
+// Fields returns the keys of the section, in declaration order.
+func (s SectionSchema) Fields() []SectionField {
+	v := reflect.ValueOf(s.Default).Elem()
+	return sectionFields("", v.Type(), v)
+}
+
+// ValidateSections checks the registered sections of the configuration held by
+// v. It reports unknown keys, values which cannot be decoded into the section
+// type, and errors returned by the section validators.
+func ValidateSections(v *viper.Viper) error {
+	var errs []error
+	for _, schema := range RegisteredSections() {
+		if err := validateSection(v, schema); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateSection(v *viper.Viper, schema SectionSchema) error {
+	fields := schema.Fields()
+
+	var unknown []string
+	prefix := strings.ToLower(schema.Name) + "."
+	for _, key := range v.AllKeys() {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		key = strings.TrimPrefix(key, prefix)
+		if !hasField(fields, key) {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		errs := make([]error, 0, len(unknown))
+		for _, key := range unknown {
+			msg := fmt.Sprintf("[%s]: unknown key %q", schema.Name, key)
+			if suggestion := closestField(fields, key); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			errs = append(errs, errors.New(msg))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	cfg := reflect.New(reflect.TypeOf(schema.Default).Elem())
+	cfg.Elem().Set(reflect.ValueOf(schema.Default).Elem())
+
+	if v.IsSet(schema.Name) {
+		if err := v.UnmarshalKey(schema.Name, cfg.Interface()); err != nil {
+			return fmt.Errorf("[%s]: %w", schema.Name, err)
+		}
+	}
+
+	if schema.Validate != nil {
+		if err := schema.Validate(cfg.Interface()); err != nil {
+			return fmt.Errorf("[%s]: %w", schema.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// WriteSectionsDoc writes the documentation of the registered sections to w,
+// as an annotated app.toml excerpt holding the default values.
+func WriteSectionsDoc(w io.Writer) error {
+	var sb strings.Builder
+	for i, schema := range RegisteredSections() {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		fmt.Fprintf(&sb, "###############################################################################\n")
+		fmt.Fprintf(&sb, "###%s###\n", centerTitle(schema.Name, 73))
+		fmt.Fprintf(&sb, "###############################################################################\n\n")
+		if schema.Description != "" {
+			for _, line := range strings.Split(schema.Description, "\n") {
+				fmt.Fprintf(&sb, "# %s\n", line)
+			}
+		}
+		fmt.Fprintf(&sb, "[%s]\n", schema.Name)
+
+		for _, field := range schema.Fields() {
+			sb.WriteString("\n")
+			if field.Doc != "" {
+				fmt.Fprintf(&sb, "# %s\n", field.Doc)
+			}
+			fmt.Fprintf(&sb, "# Type: %s\n", field.Type)
+			fmt.Fprintf(&sb, "%s = %s\n", field.Key, field.Default)
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func sectionFields(prefix string, t reflect.Type, v reflect.Value) []SectionField {
+	var fields []SectionField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if opts == "squash" {
+			fields = append(fields, sectionFields(prefix, f.Type, fv)...)
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		key := prefix + strings.ToLower(name)
+
+		if f.Type.Kind() == reflect.Struct && f.Type != reflect.TypeOf(time.Time{}) {
+			fields = append(fields, sectionFields(key+".", f.Type, fv)...)
+			continue
+		}
+
+		fields = append(fields, SectionField{
+			Key:      key,
+			Type:     f.Type.String(),
+			Default:  formatDefault(fv),
+			Doc:      f.Tag.Get("doc"),
+			wildcard: f.Type.Kind() == reflect.Map,
+		})
+	}
+
+	return fields
+}
+
+func hasField(fields []SectionField, key string) bool {
+	for _, f := range fields {
+		if f.Key == key || (f.wildcard && strings.HasPrefix(key, f.Key+".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// closestField returns the field key closest to key, if close enough to be a
+// likely typo.
+func closestField(fields []SectionField, key string) string {
+	best, bestDist := "", len(key)/2+1
+	for _, f := range fields {
+		if d := levenshtein(key, f.Key); d < bestDist {
+			best, bestDist = f.Key, d
+		}
+	}
+
+	return best
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func formatDefault(v reflect.Value) string {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return strconv.Quote(d.String())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Slice, reflect.Array:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatDefault(v.Index(i))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case reflect.Map:
+		keys := v.MapKeys()
+		elems := make([]string, 0, len(keys))
+		for _, k := range keys {
+			elems = append(elems, fmt.Sprintf("%v = %s", k.Interface(), formatDefault(v.MapIndex(k))))
+		}
+		sort.Strings(elems)
+		return "{ " + strings.Join(elems, ", ") + " }"
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+func centerTitle(title string, width int) string {
+	words := strings.Fields(strings.ReplaceAll(title, "-", " "))
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+
+	title = strings.Join(words, " ") + " Configuration"
+	if len(title) >= width {
+		return " " + title + " "
+	}
+
+	left := (width - len(title)) / 2
+	return strings.Repeat(" ", left) + title + strings.Repeat(" ", width-len(title)-left)
+}
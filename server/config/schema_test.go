@@ -0,0 +1,153 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type oracleConfig struct {
+	Enabled  bool              `mapstructure:"enabled" doc:"Enable the price feeder."`
+	Endpoint string            `mapstructure:"endpoint" doc:"Endpoint of the price provider."`
+	Timeout  time.Duration     `mapstructure:"timeout"`
+	Pairs    []string          `mapstructure:"pairs"`
+	Weights  map[string]uint64 `mapstructure:"weights"`
+	Retry    struct {
+		Attempts int `mapstructure:"attempts"`
+	} `mapstructure:"retry"`
+}
+
+func registerTestSection(t *testing.T) {
+	t.Helper()
+
+	def := &oracleConfig{Endpoint: "localhost:8080", Timeout: 5 * time.Second, Pairs: []string{"atom/usd"}}
+	def.Retry.Attempts = 3
+
+	require.NoError(t, RegisterSection(SectionSchema{
+		Name:        "oracle",
+		Description: "Oracle price feeder configuration.",
+		Default:     def,
+		Validate: func(cfg interface{}) error {
+			if cfg.(*oracleConfig).Retry.Attempts < 0 {
+				return errors.New("retry attempts cannot be negative")
+			}
+			return nil
+		},
+	}))
+	t.Cleanup(func() {
+		sectionsMu.Lock()
+		delete(sections, "oracle")
+		sectionsMu.Unlock()
+	})
+}
+
+func readTestConfig(t *testing.T, cfg string) *viper.Viper {
+	t.Helper()
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(cfg)))
+	return v
+}
+
+func TestRegisterSection(t *testing.T) {
+	registerTestSection(t)
+
+	require.ErrorContains(t, RegisterSection(SectionSchema{Name: "oracle", Default: &oracleConfig{}}), "already registered")
+	require.Error(t, RegisterSection(SectionSchema{Default: &oracleConfig{}}))
+	require.Error(t, RegisterSection(SectionSchema{Name: "other", Default: oracleConfig{}}))
+	require.Error(t, RegisterSection(SectionSchema{Name: "other", Default: (*oracleConfig)(nil)}))
+}
+
+func TestValidateSections(t *testing.T) {
+	registerTestSection(t)
+
+	testCases := []struct {
+		name   string
+		cfg    string
+		expErr string
+	}{
+		{
+			name: "missing section uses defaults",
+			cfg:  "minimum-gas-prices = \"0stake\"\n",
+		},
+		{
+			name: "valid section",
+			cfg: `
+[oracle]
+enabled = true
+endpoint = "localhost:9000"
+timeout = "10s"
+weights = { atom = 2, osmo = 1 }
+retry.attempts = 5
+`,
+		},
+		{
+			name: "typo",
+			cfg: `
+[oracle]
+endpiont = "localhost:9000"
+`,
+			expErr: `[oracle]: unknown key "endpiont", did you mean "endpoint"?`,
+		},
+		{
+			name: "unknown key",
+			cfg: `
+[oracle]
+foo = "bar"
+`,
+			expErr: `[oracle]: unknown key "foo"`,
+		},
+		{
+			name: "invalid type",
+			cfg: `
+[oracle]
+enabled = "maybe"
+`,
+			expErr: "[oracle]:",
+		},
+		{
+			name: "validator",
+			cfg: `
+[oracle]
+retry.attempts = -1
+`,
+			expErr: "[oracle]: retry attempts cannot be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSections(readTestConfig(t, tc.cfg))
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestWriteSectionsDoc(t *testing.T) {
+	registerTestSection(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSectionsDoc(&buf))
+
+	doc := buf.String()
+	require.Contains(t, doc, "###                          Oracle Configuration                           ###")
+	require.Contains(t, doc, "# Enable the price feeder.\n# Type: bool\nenabled = false\n")
+	require.Contains(t, doc, `endpoint = "localhost:8080"`)
+	require.Contains(t, doc, `timeout = "5s"`)
+	require.Contains(t, doc, `pairs = ["atom/usd"]`)
+	require.Contains(t, doc, "retry.attempts = 3")
+
+	// the generated documentation is a valid configuration
+	require.NoError(t, ValidateSections(readTestConfig(t, doc)))
+}
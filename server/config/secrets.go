@@ -0,0 +1,321 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// SecretRefPrefix prefixes configuration values referencing a secret, in
+	// the form secret://<provider>/<ref>[#<field>]. When a field is given, the
+	// secret must be a JSON object and the value of the field is used.
+	SecretRefPrefix = "secret://"
+
+	// RemoteConfigKey is the configuration key listing secret references of
+	// TOML documents merged into the configuration, after the configuration
+	// files. It is usually set through the environment.
+	RemoteConfigKey = "remote-config"
+)
+
+// SecretProvider resolves references to secrets held outside of the
+// configuration files, e.g. in the environment, Vault or a cloud secret manager.
+type SecretProvider interface {
+	// Name is the name of the provider in secret references.
+	Name() string
+	// Secret returns the secret referenced by ref.
+	Secret(ctx context.Context, ref string) (string, error)
+}
+
+var (
+	secretProvidersMu sync.RWMutex
+	secretProviders   = map[string]SecretProvider{}
+)
+
+func init() {
+	MustRegisterSecretProvider(EnvSecretProvider{})
+	MustRegisterSecretProvider(FileSecretProvider{})
+}
+
+// RegisterSecretProvider registers a secret provider. Like sections, providers
+// must be registered before the configuration is loaded.
+func RegisterSecretProvider(provider SecretProvider) error {
+	if provider == nil {
+		return errors.New("secret provider cannot be nil")
+	}
+
+	name := provider.Name()
+	if name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid secret provider name %q", name)
+	}
+
+	secretProvidersMu.Lock()
+	defer secretProvidersMu.Unlock()
+
+	if _, ok := secretProviders[name]; ok {
+		return fmt.Errorf("secret provider %s already registered", name)
+	}
+
+	secretProviders[name] = provider
+	return nil
+}
+
+// MustRegisterSecretProvider calls RegisterSecretProvider and panics on error.
+func MustRegisterSecretProvider(provider SecretProvider) {
+	if err := RegisterSecretProvider(provider); err != nil {
+		panic(err)
+	}
+}
+
+// IsSecretRef returns whether value is a secret reference.
+func IsSecretRef(value string) bool {
+	return strings.HasPrefix(value, SecretRefPrefix)
+}
+
+// ResolveSecret returns the secret referenced by ref, which must be of the
+// form secret://<provider>/<ref>[#<field>].
+func ResolveSecret(ctx context.Context, ref string) (string, error) {
+	if !IsSecretRef(ref) {
+		return "", fmt.Errorf("invalid secret reference %q: missing %s prefix", ref, SecretRefPrefix)
+	}
+
+	name, path, ok := strings.Cut(strings.TrimPrefix(ref, SecretRefPrefix), "/")
+	if !ok || path == "" {
+		return "", fmt.Errorf("invalid secret reference %q: expected %s<provider>/<ref>", ref, SecretRefPrefix)
+	}
+	path, field, hasField := strings.Cut(path, "#")
+
+	secretProvidersMu.RLock()
+	provider, ok := secretProviders[name]
+	secretProvidersMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("invalid secret reference %q: unknown secret provider %s", ref, name)
+	}
+
+	secret, err := provider.Secret(ctx, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret %s: %w", redactSecretRef(ref), err)
+	}
+
+	if !hasField {
+		return secret, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("failed to resolve secret %s: secret is not a JSON object", redactSecretRef(ref))
+	}
+
+	value, ok := fields[field]
+	if !ok {
+		return "", fmt.Errorf("failed to resolve secret %s: field %s not found", redactSecretRef(ref), field)
+	}
+
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	bz, err := json.Marshal(value)
+	return string(bz), err
+}
+
+// ResolveSecrets replaces every string value of v which is a secret reference
+// with the referenced secret.
+func ResolveSecrets(ctx context.Context, v *viper.Viper) error {
+	for _, key := range v.AllKeys() {
+		value, ok := v.Get(key).(string)
+		if !ok || !IsSecretRef(value) {
+			continue
+		}
+
+		secret, err := ResolveSecret(ctx, value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		v.Set(key, secret)
+	}
+
+	return nil
+}
+
+// MergeRemoteConfig merges into v the TOML configuration documents referenced
+// by the secret references listed under RemoteConfigKey, in order.
+func MergeRemoteConfig(ctx context.Context, v *viper.Viper) error {
+	for _, ref := range v.GetStringSlice(RemoteConfigKey) {
+		doc, err := ResolveSecret(ctx, ref)
+		if err != nil {
+			return err
+		}
+
+		v.SetConfigType("toml")
+		if err := v.MergeConfig(strings.NewReader(doc)); err != nil {
+			return fmt.Errorf("failed to merge remote configuration %s: %w", redactSecretRef(ref), err)
+		}
+	}
+
+	return nil
+}
+
+// redactSecretRef strips the field from a secret reference, to avoid leaking
+// the layout of secrets in logs.
+func redactSecretRef(ref string) string {
+	ref, _, _ = strings.Cut(ref, "#")
+	return ref
+}
+
+// EnvSecretProvider resolves secrets from environment variables, e.g.
+// secret://env/SIGNER_API_KEY.
+type EnvSecretProvider struct{}
+
+func (EnvSecretProvider) Name() string { return "env" }
+
+func (EnvSecretProvider) Secret(_ context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", ref)
+	}
+
+	return value, nil
+}
+
+// FileSecretProvider resolves secrets from files, such as the ones mounted by
+// container orchestrators, e.g. secret://file//run/secrets/db-key. Trailing
+// newlines are trimmed.
+type FileSecretProvider struct{}
+
+func (FileSecretProvider) Name() string { return "file" }
+
+func (FileSecretProvider) Secret(_ context.Context, ref string) (string, error) {
+	bz, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimRight(bz, "\r\n")), nil
+}
+
+// VaultSecretProvider resolves secrets from a HashiCorp Vault server, e.g.
+// secret://vault/secret/data/signer#api-key. The reference is the API path of
+// the secret, relative to /v1/. The secret data is returned as a JSON object,
+// so references usually select a field of it.
+type VaultSecretProvider struct {
+	addr   string
+	token  string
+	client *http.Client
+}
+
+// NewVaultSecretProvider returns a VaultSecretProvider querying the Vault
+// server at addr with token. When client is nil, http.DefaultClient is used.
+func NewVaultSecretProvider(addr, token string, client *http.Client) *VaultSecretProvider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &VaultSecretProvider{addr: strings.TrimRight(addr, "/"), token: token, client: client}
+}
+
+func (*VaultSecretProvider) Name() string { return "vault" }
+
+func (p *VaultSecretProvider) Secret(ctx context.Context, ref string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.addr+"/v1/"+strings.TrimLeft(ref, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var secret struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("invalid vault response: %w", err)
+	}
+
+	// KV version 2 engines nest the secret data and its metadata
+	var kv2 struct {
+		Data     json.RawMessage `json:"data"`
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(secret.Data, &kv2); err == nil && kv2.Data != nil && kv2.Metadata != nil {
+		return string(kv2.Data), nil
+	}
+
+	return string(secret.Data), nil
+}
+
+// AWSSecretsManagerClient is the subset of the AWS Secrets Manager API used by
+// AWSSecretsManagerProvider. It is satisfied by a thin wrapper around the
+// GetSecretValue operation of the AWS SDK, returning its SecretString.
+type AWSSecretsManagerClient interface {
+	GetSecretValue(ctx context.Context, secretID string) (string, error)
+}
+
+// AWSSecretsManagerProvider resolves secrets from AWS Secrets Manager, e.g.
+// secret://aws/prod/signer#api-key.
+type AWSSecretsManagerProvider struct {
+	client AWSSecretsManagerClient
+}
+
+// NewAWSSecretsManagerProvider returns an AWSSecretsManagerProvider using client.
+func NewAWSSecretsManagerProvider(client AWSSecretsManagerClient) *AWSSecretsManagerProvider {
+	return &AWSSecretsManagerProvider{client: client}
+}
+
+func (*AWSSecretsManagerProvider) Name() string { return "aws" }
+
+func (p *AWSSecretsManagerProvider) Secret(ctx context.Context, ref string) (string, error) {
+	return p.client.GetSecretValue(ctx, ref)
+}
+
+// GCPSecretManagerClient is the subset of the GCP Secret Manager API used by
+// GCPSecretManagerProvider. It is satisfied by a thin wrapper around the
+// AccessSecretVersion operation of the GCP SDK, returning the payload data.
+type GCPSecretManagerClient interface {
+	AccessSecretVersion(ctx context.Context, name string) ([]byte, error)
+}
+
+// GCPSecretManagerProvider resolves secrets from GCP Secret Manager, e.g.
+// secret://gcp/projects/my-project/secrets/signer/versions/latest.
+type GCPSecretManagerProvider struct {
+	client GCPSecretManagerClient
+}
+
+// NewGCPSecretManagerProvider returns a GCPSecretManagerProvider using client.
+func NewGCPSecretManagerProvider(client GCPSecretManagerClient) *GCPSecretManagerProvider {
+	return &GCPSecretManagerProvider{client: client}
+}
+
+func (*GCPSecretManagerProvider) Name() string { return "gcp" }
+
+func (p *GCPSecretManagerProvider) Secret(ctx context.Context, ref string) (string, error) {
+	bz, err := p.client.AccessSecretVersion(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
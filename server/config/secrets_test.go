@@ -0,0 +1,113 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecret(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("TEST_SIGNER_API_KEY", "s3cr3t")
+	t.Setenv("TEST_SIGNER_JSON", `{"api-key":"k3y","port":9000}`)
+
+	secretFile := filepath.Join(t.TempDir(), "db-key")
+	require.NoError(t, os.WriteFile(secretFile, []byte("db-s3cr3t\n"), 0o600))
+
+	testCases := []struct {
+		ref    string
+		exp    string
+		expErr string
+	}{
+		{ref: "secret://env/TEST_SIGNER_API_KEY", exp: "s3cr3t"},
+		{ref: "secret://env/TEST_SIGNER_JSON#api-key", exp: "k3y"},
+		{ref: "secret://env/TEST_SIGNER_JSON#port", exp: "9000"},
+		{ref: "secret://file/" + secretFile, exp: "db-s3cr3t"},
+		{ref: "secret://env/TEST_SIGNER_JSON#missing", expErr: "field missing not found"},
+		{ref: "secret://env/TEST_SIGNER_API_KEY#field", expErr: "not a JSON object"},
+		{ref: "secret://env/TEST_UNSET_VARIABLE", expErr: "is not set"},
+		{ref: "secret://unknown/foo", expErr: "unknown secret provider"},
+		{ref: "secret://env", expErr: "invalid secret reference"},
+		{ref: "env/TEST_SIGNER_API_KEY", expErr: "missing secret:// prefix"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ref, func(t *testing.T) {
+			secret, err := ResolveSecret(ctx, tc.ref)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, secret)
+		})
+	}
+}
+
+func TestResolveSecretsAndRemoteConfig(t *testing.T) {
+	t.Setenv("TEST_REMOTE_CONFIG", "[api]\nenable = true\naddress = \"secret://env/TEST_API_ADDRESS\"\n")
+	t.Setenv("TEST_API_ADDRESS", "tcp://0.0.0.0:1317")
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+remote-config = ["secret://env/TEST_REMOTE_CONFIG"]
+minimum-gas-prices = "0stake"
+
+[api]
+enable = false
+`)))
+
+	ctx := context.Background()
+	require.NoError(t, MergeRemoteConfig(ctx, v))
+	require.NoError(t, ResolveSecrets(ctx, v))
+
+	cfg, err := GetConfig(v)
+	require.NoError(t, err)
+	require.True(t, cfg.API.Enable)
+	require.Equal(t, "tcp://0.0.0.0:1317", cfg.API.Address)
+	require.Equal(t, "0stake", cfg.MinGasPrices)
+}
+
+func TestVaultSecretProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/signer":
+			_, _ = w.Write([]byte(`{"data":{"data":{"api-key":"k3y"},"metadata":{"version":1}}}`))
+		case "/v1/kv/signer":
+			_, _ = w.Write([]byte(`{"data":{"api-key":"v1-k3y"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	secret, err := NewVaultSecretProvider(server.URL, "token", nil).Secret(ctx, "secret/data/signer")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"api-key":"k3y"}`, secret)
+
+	secret, err = NewVaultSecretProvider(server.URL, "token", nil).Secret(ctx, "kv/signer")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"api-key":"v1-k3y"}`, secret)
+
+	_, err = NewVaultSecretProvider(server.URL, "invalid", nil).Secret(ctx, "secret/data/signer")
+	require.ErrorContains(t, err, "status 403")
+
+	_, err = NewVaultSecretProvider(server.URL, "token", nil).Secret(ctx, "secret/data/unknown")
+	require.ErrorContains(t, err, "status 404")
+}
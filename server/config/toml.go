@@ -25,6 +25,12 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# The maximum number of queries coming over rest/grpc run concurrently. A quarter
+# of the slots is reserved to queries originated by the app itself, e.g. while
+# building a proposal, so that they are never delayed by external query load.
+# If this is set to zero, queries are unbounded.
+query-max-concurrency = "{{ .BaseConfig.QueryMaxConcurrency }}"
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -77,6 +83,17 @@ index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 # IavlCacheSize set the size of the iavl tree cache (in number of nodes).
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
+# CacheMemoryBudget is the memory budget, in bytes, of the caches of the app
+# sized by their hit rate instead of a fixed size, i.e. the cache of decoded txs,
+# which avoids decoding txs again between CheckTx and block execution. Their
+# sizes and hit rates are reported by telemetry. If this is set to zero, they
+# are disabled.
+cache-memory-budget = "{{ .BaseConfig.CacheMemoryBudget }}"
+
+# DisableOptimisticExecution disables the execution of accepted proposals
+# before they are finalized, even if the app enables it.
+disable-optimistic-execution = {{ .BaseConfig.DisableOptimisticExecution }}
+
 # IAVLDisableFastNode enables or disables the fast node feature of IAVL. 
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
@@ -246,6 +263,116 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# TxSelector defines how the default PrepareProposal handler selects the txs of
+# a proposal:
+# - fifo: in the order of the mempool, until the block is full
+# - fee-priority: the txs paying the highest gas price first
+# - gas-knapsack: the txs collecting the most fees within the gas and byte limits of the block
+# Both fee-priority and gas-knapsack keep the order of the txs of each sender.
+tx-selector = "{{ .Mempool.TxSelector }}"
+
+# tx-ttl-blocks and tx-ttl define after how many blocks, and how long in block
+# time, a tx expires and is evicted from the app-side mempool (0 to disable).
+# Txs also expire once their timeout height is reached when either is set.
+tx-ttl-blocks = {{ .Mempool.TxTTLBlocks }}
+tx-ttl = "{{ .Mempool.TxTTL }}"
+
+# max-txs-per-sender limits the number of txs of a single sender in the
+# app-side mempool, so that an account cannot monopolize it (0 for no limit).
+# A tx replacing one of the txs of the sender is always accepted.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# replace-by-fee-bump defines by how many percent the fee of a tx must exceed,
+# in each denom, the fee of the tx of the same sender and nonce to replace it in
+# the app-side mempool. If 0, the new tx always replaces the existing one.
+replace-by-fee-bump = {{ .Mempool.ReplaceByFeeBump }}
+
+###############################################################################
+###                         Block Results                                   ###
+###############################################################################
+
+# Block results are persisted in a local store and served by the gRPC server, so that
+# indexers can query the tx results and events of recent blocks without CometBFT RPC access.
+[block-results]
+
+# enable defines if the results of committed blocks are persisted and served.
+enable = {{ .BlockResults.Enable }}
+
+# keep-recent specifies the number of recent blocks whose results are kept (0 to keep all).
+keep-recent = {{ .BlockResults.KeepRecent }}
+
+###############################################################################
+###                         Event Store                                     ###
+###############################################################################
+
+# The event store persists the events of committed blocks, indexed by type, and serves
+# them with the Events query of the app service. Its retention is independent of state pruning,
+# so that events can be kept for a long history of blocks without an archive node.
+[event-store]
+
+# enable defines if the events of committed blocks are persisted and served.
+enable = {{ .EventStore.Enable }}
+
+# keep-recent specifies the number of recent blocks whose events are kept (0 to keep all).
+keep-recent = {{ .EventStore.KeepRecent }}
+
+###############################################################################
+###                         Rebroadcast                                     ###
+###############################################################################
+
+# The txs submitted through the tx service of the gRPC server are rebroadcast until they
+# are committed, improving their inclusion when peers are flaky. A tx replacing a pending
+# one with the same signer and sequence stops its rebroadcast, and duplicate submissions
+# of a pending tx aren't broadcast again.
+[rebroadcast]
+
+# enable defines if the submitted txs are rebroadcast.
+enable = {{ .Rebroadcast.Enable }}
+
+# rebroadcast-after specifies the number of blocks after which a tx which wasn't committed is broadcast again.
+rebroadcast-after = {{ .Rebroadcast.RebroadcastAfter }}
+
+# ttl specifies the number of blocks after its submission after which a tx stops being rebroadcast (0 to rebroadcast until committed).
+ttl = {{ .Rebroadcast.TTL }}
+
+# max-pending specifies the maximum number of txs rebroadcast (0 for no limit).
+max-pending = {{ .Rebroadcast.MaxPending }}
+
+###############################################################################
+###                         Compaction                                      ###
+###############################################################################
+
+# Compactions of the application database are normally triggered by the database backend as
+# data is written, causing latency spikes at random times. Scheduled compactions compact the
+# whole database off-peak, so that fewer compactions are triggered during busy hours.
+# Compactions are supported by the goleveldb and pebbledb backends.
+[compaction]
+
+# scheduled defines if the whole application database is compacted once a day.
+scheduled = {{ .Compaction.Scheduled }}
+
+# hour specifies the hour of the day (UTC, 0-23) at which scheduled compactions run.
+hour = {{ .Compaction.Hour }}
+
+# metrics-interval specifies the interval at which the compaction and write amplification
+# metrics of the database are reported to telemetry (0 to disable).
+metrics-interval = "{{ .Compaction.MetricsInterval }}"
+
+###############################################################################
+###                         Query Profiler                                  ###
+###############################################################################
+
+# The query profiler records, on demand, the slowest queries served by the node and the store
+# prefixes they read, to find queries scanning large parts of the state. A profile is recorded
+# with a GET request to /query_profile on the admin endpoint, e.g. /query_profile?window=30s&limit=20.
+[query-profiler]
+
+# enable defines if queries can be profiled through the admin endpoint.
+enable = {{ .QueryProfiler.Enable }}
+
+# address defines the address the admin endpoint listens on, which must not be publicly reachable.
+address = "{{ .QueryProfiler.Address }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,22 @@
+package server
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// NewConfigDocCmd creates a command printing the documentation of the custom
+// app.toml sections registered by the application modules.
+func NewConfigDocCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doc",
+		Short: "Print the documentation of the app.toml sections registered by modules",
+		Long: `Print the documentation of the app.toml sections registered by modules, as an
+annotated app.toml excerpt holding the default value of each key.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return config.WriteSectionsDoc(cmd.OutOrStdout())
+		},
+	}
+}
@@ -0,0 +1,260 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultQueryLimit is the number of events returned by a query without limit.
+	DefaultQueryLimit = 100
+
+	// MaxQueryLimit is the maximum number of events returned by a query.
+	MaxQueryLimit = 1000
+
+	// blockEventsTxIndex is the tx index under which block events are stored,
+	// tx events being stored under their tx index plus one.
+	blockEventsTxIndex = 0
+)
+
+var (
+	eventsPrefix    = []byte{0x00}
+	typeIndexPrefix = []byte{0x01}
+	latestHeightKey = []byte{0x02}
+)
+
+var _ appservice.EventStore = (*Store)(nil)
+
+// Store persists the events emitted by the blocks committed by the node, with
+// a retention policy independent of the pruning of the application state, so
+// that events can be queried for a long history of blocks without running an
+// archive node. Events are indexed by type.
+type Store struct {
+	db         dbm.DB
+	keepRecent int64
+
+	mtx sync.Mutex
+	// pending holds the events of the block being finalized, written at commit
+	pending *abci.ResponseFinalizeBlock
+	height  int64
+}
+
+// NewStore returns an event store backed by db, keeping the events of the
+// keepRecent most recent blocks, or of all blocks if keepRecent is 0.
+func NewStore(db dbm.DB, keepRecent uint64) *Store {
+	return &Store{db: db, keepRecent: int64(keepRecent)}
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (s *Store) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending, s.height = &res, req.Height
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. The events of the block
+// are written once it is committed, and the events of the blocks older than
+// the retained ones are pruned.
+func (s *Store) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.pending == nil {
+		return nil
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.writeEvents(batch, blockEventsTxIndex, s.pending.Events); err != nil {
+		return err
+	}
+
+	for i, txResult := range s.pending.TxResults {
+		if err := s.writeEvents(batch, uint32(i+1), txResult.Events); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(latestHeightKey, sdk.Uint64ToBigEndian(uint64(s.height))); err != nil {
+		return err
+	}
+
+	if s.keepRecent > 0 && s.height > s.keepRecent {
+		// prune all the blocks older than the retained ones, in case the number
+		// of retained blocks was lowered since the node was last started
+		if err := s.pruneBefore(batch, s.height-s.keepRecent+1); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	s.pending = nil
+	return nil
+}
+
+func (s *Store) writeEvents(batch dbm.Batch, txIndex uint32, events []abci.Event) error {
+	for i := range events {
+		bz, err := events[i].Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Set(eventKey(s.height, txIndex, uint32(i)), bz); err != nil {
+			return err
+		}
+
+		if err := batch.Set(typeIndexKey(events[i].Type, s.height, txIndex, uint32(i)), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) pruneBefore(batch dbm.Batch, height int64) error {
+	it, err := s.db.Iterator(heightPrefix(eventsPrefix, 0), heightPrefix(eventsPrefix, height))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var event abci.Event
+		if err := event.Unmarshal(it.Value()); err != nil {
+			return err
+		}
+
+		// the event key suffix is the height, tx index and event index of the event
+		suffix := it.Key()[len(eventsPrefix):]
+		if err := batch.Delete(append(typePrefix(event.Type), suffix...)); err != nil {
+			return err
+		}
+
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// LatestHeight returns the height of the latest block whose events are in the
+// store, or 0 if the store is empty.
+func (s *Store) LatestHeight() (int64, error) {
+	bz, err := s.db.Get(latestHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// Events returns the events selected by req, in the order they were emitted,
+// along with the latest height of the store. The events of a block are never
+// split between responses, so more than the limit of events can be returned.
+func (s *Store) Events(req *appservice.EventsRequest) (*appservice.EventsResponse, error) {
+	limit := int(req.Limit)
+	switch {
+	case limit == 0:
+		limit = DefaultQueryLimit
+	case limit > MaxQueryLimit:
+		return nil, fmt.Errorf("query limit %d exceeds the maximum of %d", limit, MaxQueryLimit)
+	}
+
+	if req.FromHeight < 0 || req.ToHeight < 0 || (req.ToHeight > 0 && req.ToHeight < req.FromHeight) {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+
+	latest, err := s.LatestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := eventsPrefix
+	if req.EventType != "" {
+		prefix = typePrefix(req.EventType)
+	}
+
+	end := storetypes.PrefixEndBytes(prefix)
+	if req.ToHeight > 0 {
+		end = heightPrefix(prefix, req.ToHeight+1)
+	}
+
+	it, err := s.db.Iterator(heightPrefix(prefix, req.FromHeight), end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	res := &appservice.EventsResponse{LatestHeight: latest}
+	for ; it.Valid(); it.Next() {
+		suffix := it.Key()[len(prefix):]
+		height, txIndex := int64(binary.BigEndian.Uint64(suffix)), binary.BigEndian.Uint32(suffix[8:])
+
+		if len(res.Events) >= limit && height != res.Events[len(res.Events)-1].Height {
+			res.NextHeight = height
+			break
+		}
+
+		bz := it.Value()
+		if req.EventType != "" {
+			if bz, err = s.db.Get(append(eventsPrefix, suffix...)); err != nil {
+				return nil, err
+			}
+		}
+
+		event := appservice.IndexedEvent{Height: height, TxIndex: int64(txIndex) - 1}
+		if err := event.Event.Unmarshal(bz); err != nil {
+			return nil, err
+		}
+
+		res.Events = append(res.Events, event)
+	}
+
+	return res, it.Error()
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func heightPrefix(prefix []byte, height int64) []byte {
+	return append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func eventKey(height int64, txIndex, eventIndex uint32) []byte {
+	key := heightPrefix(eventsPrefix, height)
+	key = binary.BigEndian.AppendUint32(key, txIndex)
+	return binary.BigEndian.AppendUint32(key, eventIndex)
+}
+
+// typePrefix returns the prefix of the type index keys of the events of
+// eventType, length-prefixed so that no type is a prefix of another.
+func typePrefix(eventType string) []byte {
+	key := append([]byte{}, typeIndexPrefix...)
+	key = binary.BigEndian.AppendUint16(key, uint16(len(eventType)))
+	return append(key, eventType...)
+}
+
+func typeIndexKey(eventType string, height int64, txIndex, eventIndex uint32) []byte {
+	key := heightPrefix(typePrefix(eventType), height)
+	key = binary.BigEndian.AppendUint32(key, txIndex)
+	return binary.BigEndian.AppendUint32(key, eventIndex)
+}
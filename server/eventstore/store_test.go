@@ -0,0 +1,76 @@
+package eventstore_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
+	"github.com/cosmos/cosmos-sdk/server/eventstore"
+)
+
+func commitBlock(t *testing.T, store *eventstore.Store, height int64) {
+	t.Helper()
+
+	res := abci.ResponseFinalizeBlock{
+		Events: []abci.Event{{Type: "mint"}},
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{{Type: "transfer"}, {Type: "message"}}},
+			{Events: []abci.Event{{Type: "transfer"}}},
+		},
+	}
+
+	require.NoError(t, store.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, res))
+	require.NoError(t, store.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestStore(t *testing.T) {
+	store := eventstore.NewStore(dbm.NewMemDB(), 3)
+	for height := int64(1); height <= 5; height++ {
+		commitBlock(t, store, height)
+	}
+
+	latest, err := store.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+
+	// the events of the first two blocks are pruned
+	res, err := store.Events(&appservice.EventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 12)
+	require.Zero(t, res.NextHeight)
+	require.Equal(t, int64(5), res.LatestHeight)
+	require.Equal(t, appservice.IndexedEvent{Height: 3, TxIndex: -1, Event: abci.Event{Type: "mint"}}, res.Events[0])
+	require.Equal(t, appservice.IndexedEvent{Height: 3, TxIndex: 1, Event: abci.Event{Type: "transfer"}}, res.Events[3])
+
+	res, err = store.Events(&appservice.EventsRequest{EventType: "transfer", FromHeight: 4})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 4)
+	for _, event := range res.Events {
+		require.Equal(t, "transfer", event.Event.Type)
+		require.GreaterOrEqual(t, event.Height, int64(4))
+	}
+
+	res, err = store.Events(&appservice.EventsRequest{EventType: "mint", FromHeight: 1, ToHeight: 4})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+
+	// the events of a block are not split between results
+	res, err = store.Events(&appservice.EventsRequest{EventType: "transfer", Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 4)
+	require.Equal(t, int64(5), res.NextHeight)
+
+	res, err = store.Events(&appservice.EventsRequest{EventType: "unknown"})
+	require.NoError(t, err)
+	require.Empty(t, res.Events)
+
+	_, err = store.Events(&appservice.EventsRequest{Limit: eventstore.MaxQueryLimit + 1})
+	require.Error(t, err)
+
+	_, err = store.Events(&appservice.EventsRequest{FromHeight: 4, ToHeight: 3})
+	require.Error(t, err)
+}
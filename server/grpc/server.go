@@ -9,8 +9,10 @@ import (
 
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/blockresults"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
@@ -40,6 +42,15 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 
 	app.RegisterGRPCServer(grpcSrv)
 
+	// block results are served from their own store rather than from app state
+	if resultsApp, ok := app.(interface {
+		BlockResultsStore() baseapp.BlockResultsStore
+	}); ok {
+		if store, ok := resultsApp.BlockResultsStore().(*blockresults.Store); ok {
+			blockresults.RegisterService(grpcSrv, store)
+		}
+	}
+
 	// Reflection allows consumers to build dynamic clients that can write to any
 	// Cosmos SDK application without relying on application packages at compile
 	// time.
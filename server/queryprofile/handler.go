@@ -0,0 +1,87 @@
+package queryprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultWindow is the recording window of a profile requested without
+	// window.
+	DefaultWindow = 10 * time.Second
+
+	// MaxWindow is the maximum recording window of a profile.
+	MaxWindow = 5 * time.Minute
+
+	// DefaultLimit is the number of queries reported by a profile requested
+	// without limit.
+	DefaultLimit = 20
+
+	// MaxLimit is the maximum number of queries reported by a profile.
+	MaxLimit = 1000
+)
+
+// Handler returns the HTTP handler of the admin endpoint profiling queries.
+// A GET request records a profile for the duration of its "window" parameter,
+// e.g. "30s", and responds with the JSON encoded report of the "limit" slowest
+// queries.
+func (p *Profiler) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		window, limit, err := parseProfileParams(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		report, err := p.Profile(req.Context(), window, limit)
+		if errors.Is(err, ErrRecording) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+func parseProfileParams(req *http.Request) (time.Duration, int, error) {
+	window, limit := DefaultWindow, DefaultLimit
+
+	if s := req.URL.Query().Get("window"); s != "" {
+		var err error
+		window, err = time.ParseDuration(s)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid window: %w", err)
+		}
+		if window <= 0 || window > MaxWindow {
+			return 0, 0, fmt.Errorf("window must be positive and at most %s", MaxWindow)
+		}
+	}
+
+	if s := req.URL.Query().Get("limit"); s != "" {
+		var err error
+		limit, err = strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid limit: %w", err)
+		}
+		if limit <= 0 || limit > MaxLimit {
+			return 0, 0, fmt.Errorf("limit must be positive and at most %d", MaxLimit)
+		}
+	}
+
+	return window, limit, nil
+}
@@ -0,0 +1,205 @@
+package queryprofile
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// ErrRecording is returned when a profile is requested while another one is
+// being recorded.
+var ErrRecording = errors.New("a query profile is already being recorded")
+
+// StoreAccess sums the accesses of a query to the keys of a store sharing the
+// same first byte, which is the prefix of most module collections.
+type StoreAccess struct {
+	Store  string `json:"store"`
+	Prefix string `json:"prefix"`
+	// Keys is the number of keys read, or visited by iterators.
+	Keys uint64 `json:"keys"`
+	// Bytes is the number of bytes of the keys and values read.
+	Bytes uint64 `json:"bytes"`
+}
+
+// QueryProfile is the profile of a single query.
+type QueryProfile struct {
+	Path     string        `json:"path"`
+	Height   int64         `json:"height"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	// Stores are the store prefixes accessed by the query, by decreasing
+	// number of keys.
+	Stores []StoreAccess `json:"stores"`
+}
+
+// Report is the report of the queries served during a recording window.
+type Report struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Queries is the number of queries served during the window.
+	Queries int `json:"queries"`
+	// Slowest are the slowest queries, by decreasing duration.
+	Slowest []QueryProfile `json:"slowest"`
+}
+
+var _ baseapp.QueryProfiler = (*Profiler)(nil)
+
+// Profiler records, during a time window, the slowest queries served by the
+// app and the store prefixes they accessed, so that queries scanning large
+// parts of the state can be found before they take RPC nodes down. Queries are
+// only tracked while a profile is recorded.
+type Profiler struct {
+	recording atomic.Bool
+
+	mu      sync.Mutex
+	limit   int
+	queries int
+	slowest []QueryProfile
+}
+
+// NewProfiler returns a new profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{}
+}
+
+// Recording reports whether a profile is being recorded.
+func (p *Profiler) Recording() bool {
+	return p.recording.Load()
+}
+
+// Profile records the queries served during window, or until ctx is done, and
+// returns the report of the limit slowest ones. Only one profile can be
+// recorded at a time.
+func (p *Profiler) Profile(ctx context.Context, window time.Duration, limit int) (Report, error) {
+	if limit <= 0 {
+		return Report{}, errors.New("the limit of a query profile must be positive")
+	}
+
+	p.mu.Lock()
+	if p.recording.Load() {
+		p.mu.Unlock()
+		return Report{}, ErrRecording
+	}
+	p.limit, p.queries, p.slowest = limit, 0, nil
+	p.recording.Store(true)
+	p.mu.Unlock()
+
+	start := time.Now()
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.recording.Store(false)
+
+	return Report{
+		Start:   start,
+		End:     time.Now(),
+		Queries: p.queries,
+		Slowest: p.slowest,
+	}, nil
+}
+
+// Track wraps ms, the multi-store of a query at height, so that the accesses
+// of the query to its stores are tracked, and returns a function recording the
+// query once it is served. If no profile is being recorded, ms is returned
+// unchanged.
+func (p *Profiler) Track(ms storetypes.MultiStore, path string, height int64) (storetypes.MultiStore, func()) {
+	if !p.Recording() {
+		return ms, func() {}
+	}
+
+	t := &tracker{accesses: make(map[accessKey]*StoreAccess)}
+	start := time.Now()
+	return &trackingMultiStore{MultiStore: ms, tracker: t}, func() {
+		p.record(QueryProfile{
+			Path:     path,
+			Height:   height,
+			Start:    start,
+			Duration: time.Since(start),
+			Stores:   t.stores(),
+		})
+	}
+}
+
+func (p *Profiler) record(query QueryProfile) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// the query may complete after the end of the window
+	if !p.recording.Load() {
+		return
+	}
+	p.queries++
+
+	i := sort.Search(len(p.slowest), func(i int) bool { return p.slowest[i].Duration < query.Duration })
+	if i == p.limit {
+		return
+	}
+	if len(p.slowest) < p.limit {
+		p.slowest = append(p.slowest, QueryProfile{})
+	}
+	copy(p.slowest[i+1:], p.slowest[i:])
+	p.slowest[i] = query
+}
+
+type accessKey struct {
+	store  string
+	prefix byte
+}
+
+// tracker sums the store accesses of a query.
+type tracker struct {
+	mu       sync.Mutex
+	accesses map[accessKey]*StoreAccess
+}
+
+func (t *tracker) access(store string, key []byte, keys, bytes int) {
+	if len(key) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	k := accessKey{store: store, prefix: key[0]}
+	access, ok := t.accesses[k]
+	if !ok {
+		access = &StoreAccess{Store: store, Prefix: hex.EncodeToString(key[:1])}
+		t.accesses[k] = access
+	}
+	access.Keys += uint64(keys)
+	access.Bytes += uint64(bytes)
+}
+
+func (t *tracker) stores() []StoreAccess {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stores := make([]StoreAccess, 0, len(t.accesses))
+	for _, access := range t.accesses {
+		stores = append(stores, *access)
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		if stores[i].Keys != stores[j].Keys {
+			return stores[i].Keys > stores[j].Keys
+		}
+		if stores[i].Store != stores[j].Store {
+			return stores[i].Store < stores[j].Store
+		}
+		return stores[i].Prefix < stores[j].Prefix
+	})
+	return stores
+}
@@ -0,0 +1,80 @@
+package queryprofile_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/queryprofile"
+)
+
+type testMultiStore struct {
+	storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.KVStore
+}
+
+func (ms testMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return ms.stores[key]
+}
+
+func TestProfiler(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	store := &dbadapter.Store{DB: dbm.NewMemDB()}
+	store.Set([]byte{0x01, 'a'}, []byte("v"))
+	for _, k := range []string{"a", "b", "c"} {
+		store.Set([]byte{0x02, k[0]}, []byte("balance"))
+	}
+	ms := testMultiStore{stores: map[storetypes.StoreKey]storetypes.KVStore{key: store}}
+
+	p := queryprofile.NewProfiler()
+
+	// queries are not tracked while no profile is recorded
+	tracked, done := p.Track(ms, "/cosmos.bank.v1beta1.Query/Balance", 1)
+	require.Equal(t, ms, tracked)
+	done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reportCh := make(chan queryprofile.Report)
+	errCh := make(chan error)
+	go func() {
+		report, err := p.Profile(ctx, time.Hour, 1)
+		errCh <- err
+		reportCh <- report
+	}()
+	require.Eventually(t, p.Recording, time.Second, time.Millisecond)
+
+	_, err := p.Profile(ctx, time.Second, 1)
+	require.ErrorIs(t, err, queryprofile.ErrRecording)
+
+	tracked, done = p.Track(ms, "/cosmos.bank.v1beta1.Query/Balance", 1)
+	require.Equal(t, []byte("v"), tracked.GetKVStore(key).Get([]byte{0x01, 'a'}))
+	done()
+
+	tracked, done = p.Track(ms, "/cosmos.bank.v1beta1.Query/AllBalances", 1)
+	iter := tracked.GetKVStore(key).Iterator([]byte{0x02}, []byte{0x03})
+	for ; iter.Valid(); iter.Next() {
+		_ = iter.Value()
+	}
+	require.NoError(t, iter.Close())
+	time.Sleep(10 * time.Millisecond)
+	done()
+
+	cancel()
+	require.NoError(t, <-errCh)
+	report := <-reportCh
+	require.False(t, p.Recording())
+	require.Equal(t, 2, report.Queries)
+
+	// only the slowest query is reported, with the prefixes it scanned
+	require.Len(t, report.Slowest, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.Query/AllBalances", report.Slowest[0].Path)
+	require.Equal(t, []queryprofile.StoreAccess{
+		{Store: "bank", Prefix: "02", Keys: 3, Bytes: 3 * (2 + 7)},
+	}, report.Slowest[0].Stores)
+}
@@ -0,0 +1,79 @@
+package queryprofile
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// trackingMultiStore tracks the accesses to the KV stores of a multi-store.
+type trackingMultiStore struct {
+	storetypes.MultiStore
+	tracker *tracker
+}
+
+func (ms *trackingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &trackingStore{
+		KVStore: ms.MultiStore.GetKVStore(key),
+		name:    key.Name(),
+		tracker: ms.tracker,
+	}
+}
+
+// trackingStore tracks the reads of a KV store. Writes are not tracked, as
+// queries run on a branch of the state which is discarded.
+type trackingStore struct {
+	storetypes.KVStore
+	name    string
+	tracker *tracker
+}
+
+func (s *trackingStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.tracker.access(s.name, key, 1, len(key)+len(value))
+	return value
+}
+
+func (s *trackingStore) Has(key []byte) bool {
+	s.tracker.access(s.name, key, 1, len(key))
+	return s.KVStore.Has(key)
+}
+
+func (s *trackingStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newTrackingIterator(s.KVStore.Iterator(start, end), s.name, s.tracker)
+}
+
+func (s *trackingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newTrackingIterator(s.KVStore.ReverseIterator(start, end), s.name, s.tracker)
+}
+
+// trackingIterator counts the keys an iterator visits, and the bytes of the
+// keys and values read.
+type trackingIterator struct {
+	storetypes.Iterator
+	name    string
+	tracker *tracker
+}
+
+func newTrackingIterator(iter storetypes.Iterator, name string, t *tracker) storetypes.Iterator {
+	ti := &trackingIterator{Iterator: iter, name: name, tracker: t}
+	ti.visit()
+	return ti
+}
+
+// visit counts the key the iterator is positioned at, if any.
+func (ti *trackingIterator) visit() {
+	if ti.Iterator.Valid() {
+		key := ti.Iterator.Key()
+		ti.tracker.access(ti.name, key, 1, len(key))
+	}
+}
+
+func (ti *trackingIterator) Next() {
+	ti.Iterator.Next()
+	ti.visit()
+}
+
+func (ti *trackingIterator) Value() []byte {
+	value := ti.Iterator.Value()
+	ti.tracker.access(ti.name, ti.Iterator.Key(), 0, len(value))
+	return value
+}
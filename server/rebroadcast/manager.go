@@ -0,0 +1,242 @@
+package rebroadcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Config defines when locally submitted txs are rebroadcast.
+type Config struct {
+	// RebroadcastAfter is the number of blocks after which a tx which wasn't
+	// committed is broadcast again.
+	RebroadcastAfter uint64
+	// TTL is the number of blocks after its submission after which a tx which
+	// wasn't committed is dropped. 0 keeps txs until they are committed.
+	TTL uint64
+	// MaxPending is the maximum number of txs tracked, further txs not being
+	// rebroadcast. 0 disables the limit.
+	MaxPending int
+}
+
+// Broadcaster broadcasts a tx to the network, e.g. by submitting it to the
+// mempool of the local CometBFT node.
+type Broadcaster func(txBytes []byte) error
+
+// SenderKeyFn returns the key identifying the sender and nonce of a tx, so that
+// a tx replacing another one with the same key, e.g. with a higher fee, stops
+// the rebroadcast of the replaced tx. An empty key disables replacement.
+type SenderKeyFn func(txBytes []byte) (string, error)
+
+// NewSenderNonceKey returns a SenderKeyFn keying txs by the address and
+// sequence of their first signer, as the priority nonce mempool does.
+func NewSenderNonceKey(txDecoder sdk.TxDecoder) SenderKeyFn {
+	return func(txBytes []byte) (string, error) {
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			return "", err
+		}
+
+		sigTx, ok := tx.(signing.SigVerifiableTx)
+		if !ok {
+			return "", nil
+		}
+
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil || len(sigs) == 0 {
+			return "", err
+		}
+
+		return fmt.Sprintf("%X/%d", sigs[0].PubKey.Address(), sigs[0].Sequence), nil
+	}
+}
+
+type pendingTx struct {
+	bytes           []byte
+	senderKey       string
+	submittedHeight int64
+	broadcastHeight int64
+}
+
+var _ baseapp.Rebroadcaster = (*Manager)(nil)
+
+// Manager tracks the txs submitted through the node and broadcasts them again
+// while they aren't committed, improving their inclusion when peers are flaky
+// or the tx was evicted from the mempool. It also reports txs already pending,
+// so that duplicate submissions aren't broadcast again.
+//
+// Manager is an ABCIListener: committed txs stop being tracked at FinalizeBlock
+// and pending txs are rebroadcast once the block is committed.
+type Manager struct {
+	cfg       Config
+	senderKey SenderKeyFn
+	logger    log.Logger
+
+	mtx         sync.Mutex
+	broadcaster Broadcaster
+	height      int64
+	pending     map[string]*pendingTx
+	bySender    map[string]string
+}
+
+// NewManager returns a Manager rebroadcasting txs with cfg. senderKey may be nil
+// to disable replacement. Txs are only rebroadcast once a Broadcaster is set.
+func NewManager(cfg Config, senderKey SenderKeyFn, logger log.Logger) *Manager {
+	return &Manager{
+		cfg:       cfg,
+		senderKey: senderKey,
+		logger:    logger,
+		pending:   make(map[string]*pendingTx),
+		bySender:  make(map[string]string),
+	}
+}
+
+// SetBroadcaster sets the function used to rebroadcast txs.
+func (m *Manager) SetBroadcaster(broadcaster Broadcaster) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.broadcaster = broadcaster
+}
+
+// IsPending returns whether txBytes was submitted and isn't committed yet.
+func (m *Manager) IsPending(txBytes []byte) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	_, ok := m.pending[TxHash(txBytes)]
+	return ok
+}
+
+// Pending returns the number of txs tracked.
+func (m *Manager) Pending() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return len(m.pending)
+}
+
+// Track starts tracking txBytes, successfully submitted to the network, until
+// it is committed or expires. A tx with the same sender key replaces the
+// pending one. It returns false if the tx is already tracked or the maximum
+// number of pending txs is reached.
+func (m *Manager) Track(txBytes []byte) bool {
+	var senderKey string
+	if m.senderKey != nil {
+		key, err := m.senderKey(txBytes)
+		if err != nil {
+			m.logger.Debug("failed to get tx sender key", "err", err)
+		}
+		senderKey = key
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	hash := TxHash(txBytes)
+	if _, ok := m.pending[hash]; ok {
+		return false
+	}
+
+	if senderKey != "" {
+		if replaced, ok := m.bySender[senderKey]; ok {
+			m.remove(replaced)
+		}
+	}
+
+	if m.cfg.MaxPending > 0 && len(m.pending) >= m.cfg.MaxPending {
+		return false
+	}
+
+	m.pending[hash] = &pendingTx{
+		bytes:           txBytes,
+		senderKey:       senderKey,
+		submittedHeight: m.height,
+		broadcastHeight: m.height,
+	}
+	if senderKey != "" {
+		m.bySender[senderKey] = hash
+	}
+
+	return true
+}
+
+func (m *Manager) remove(hash string) {
+	tx, ok := m.pending[hash]
+	if !ok {
+		return
+	}
+
+	delete(m.pending, hash)
+	if tx.senderKey != "" && m.bySender[tx.senderKey] == hash {
+		delete(m.bySender, tx.senderKey)
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, to stop tracking the
+// committed txs.
+func (m *Manager) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.height = req.Height
+	for _, tx := range req.Txs {
+		m.remove(TxHash(tx))
+	}
+
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, to drop the expired txs and
+// rebroadcast the pending ones in the background.
+func (m *Manager) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.broadcaster == nil {
+		return nil
+	}
+
+	var rebroadcast [][]byte
+	for hash, tx := range m.pending {
+		if m.cfg.TTL > 0 && m.height-tx.submittedHeight >= int64(m.cfg.TTL) {
+			m.remove(hash)
+			continue
+		}
+
+		if m.height-tx.broadcastHeight >= int64(m.cfg.RebroadcastAfter) {
+			tx.broadcastHeight = m.height
+			rebroadcast = append(rebroadcast, tx.bytes)
+		}
+	}
+
+	if len(rebroadcast) == 0 {
+		return nil
+	}
+
+	broadcaster := m.broadcaster
+	go func() {
+		for _, tx := range rebroadcast {
+			if err := broadcaster(tx); err != nil {
+				m.logger.Debug("failed to rebroadcast tx", "hash", TxHash(tx), "err", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// TxHash returns the hex encoded hash of a tx, as reported in tx responses.
+func TxHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+}
@@ -0,0 +1,82 @@
+package rebroadcast_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server/rebroadcast"
+)
+
+// senderKey keys txs of the form "sender/nonce/payload" by "sender/nonce".
+func senderKey(txBytes []byte) (string, error) {
+	parts := strings.SplitN(string(txBytes), "/", 3)
+	if len(parts) < 3 {
+		return "", nil
+	}
+	return parts[0] + "/" + parts[1], nil
+}
+
+func commitBlock(t *testing.T, m *rebroadcast.Manager, height int64, txs ...string) {
+	t.Helper()
+
+	req := abci.RequestFinalizeBlock{Height: height}
+	for _, tx := range txs {
+		req.Txs = append(req.Txs, []byte(tx))
+	}
+
+	require.NoError(t, m.ListenFinalizeBlock(context.Background(), req, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, m.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestManager(t *testing.T) {
+	m := rebroadcast.NewManager(rebroadcast.Config{RebroadcastAfter: 2, TTL: 5, MaxPending: 3}, senderKey, log.NewNopLogger())
+
+	broadcast := make(chan string, 10)
+	m.SetBroadcaster(func(txBytes []byte) error {
+		broadcast <- string(txBytes)
+		return nil
+	})
+
+	commitBlock(t, m, 1)
+
+	require.True(t, m.Track([]byte("alice/1/a")))
+	require.True(t, m.IsPending([]byte("alice/1/a")))
+
+	// duplicates are suppressed
+	require.False(t, m.Track([]byte("alice/1/a")))
+
+	// a tx with the same sender and nonce replaces the pending one
+	require.True(t, m.Track([]byte("alice/1/b")))
+	require.False(t, m.IsPending([]byte("alice/1/a")))
+	require.True(t, m.Track([]byte("bob/1/a")))
+	require.True(t, m.Track([]byte("carol/1/a")))
+	require.Equal(t, 3, m.Pending())
+
+	// the number of pending txs is capped
+	require.False(t, m.Track([]byte("dave/1/a")))
+
+	// committed txs stop being tracked
+	commitBlock(t, m, 2, "bob/1/a")
+	require.Equal(t, 2, m.Pending())
+	require.Empty(t, broadcast)
+
+	// pending txs are rebroadcast after 2 blocks
+	commitBlock(t, m, 3)
+	require.ElementsMatch(t, []string{"alice/1/b", "carol/1/a"}, []string{<-broadcast, <-broadcast})
+
+	commitBlock(t, m, 4, "carol/1/a")
+	require.Empty(t, broadcast)
+
+	commitBlock(t, m, 5)
+	require.Equal(t, "alice/1/b", <-broadcast)
+
+	// and dropped once expired
+	commitBlock(t, m, 6)
+	require.Zero(t, m.Pending())
+}
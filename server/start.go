@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 	"time"
 
@@ -30,12 +33,17 @@ import (
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/blockresults"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/eventstore"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/queryprofile"
+	"github.com/cosmos/cosmos-sdk/server/rebroadcast"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -52,6 +60,7 @@ const (
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
 	FlagQueryGasLimit      = "query-gas-limit"
+	FlagQueryConcurrency   = "query-max-concurrency"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
@@ -65,6 +74,8 @@ const (
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
+	FlagCacheMemoryBudget   = "cache-memory-budget"
+	FlagDisableOE           = "disable-optimistic-execution"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
 
@@ -89,7 +100,12 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs           = "mempool.max-txs"
+	FlagMempoolTxSelector       = "mempool.tx-selector"
+	FlagMempoolTxTTLBlocks      = "mempool.tx-ttl-blocks"
+	FlagMempoolTxTTL            = "mempool.tx-ttl"
+	FlagMempoolMaxTxsPerSender  = "mempool.max-txs-per-sender"
+	FlagMempoolReplaceByFeeBump = "mempool.replace-by-fee-bump"
 )
 
 // StartCmdOptions defines options that can be customized in `StartCmdWithOptions`,
@@ -186,6 +202,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
+	cmd.Flags().Uint64(FlagQueryConcurrency, 0, "Maximum number of Rest/Grpc queries run concurrently. Blank and 0 imply unbounded.")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
@@ -212,7 +229,14 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Uint64(FlagCacheMemoryBudget, 0, "Memory budget in bytes of the caches sized by their hit rate, i.e. the tx decode cache. Blank and 0 disable them.")
+	cmd.Flags().Bool(FlagDisableOE, false, "Disable optimistic execution of accepted proposals, even if the app enables it")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolTxSelector, baseapp.TxSelectorFIFO, "How proposals select mempool txs: fifo, fee-priority or gas-knapsack")
+	cmd.Flags().Int64(FlagMempoolTxTTLBlocks, 0, "Number of blocks after which app-side mempool txs expire (0 to disable)")
+	cmd.Flags().Duration(FlagMempoolTxTTL, 0, "Block time after which app-side mempool txs expire (0 to disable)")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Maximum number of txs of a single sender in the app-side mempool (0 for no limit)")
+	cmd.Flags().Uint64(FlagMempoolReplaceByFeeBump, 0, "Percent by which a tx fee must exceed the fee of the app-side mempool tx of the same sender and nonce to replace it (0 to always replace)")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
@@ -239,12 +263,39 @@ func start(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreato
 		return err
 	}
 
-	app, appCleanupFn, err := startApp(svrCtx, appCreator, opts)
+	app, db, appCleanupFn, err := startApp(svrCtx, appCreator, opts)
 	if err != nil {
 		return err
 	}
 	defer appCleanupFn()
 
+	stopCompaction := startCompaction(svrCfg.Compaction, db, svrCtx.Logger.With("module", "compaction"))
+	defer stopCompaction()
+
+	if svrCfg.BlockResults.Enable {
+		if err := enableBlockResults(svrCtx, svrCfg.BlockResults, app); err != nil {
+			return err
+		}
+	}
+
+	if svrCfg.EventStore.Enable {
+		if err := enableEventStore(svrCtx, svrCfg.EventStore, app); err != nil {
+			return err
+		}
+	}
+
+	if svrCfg.Rebroadcast.Enable {
+		if err := enableRebroadcast(svrCtx, svrCfg.Rebroadcast, clientCtx, app); err != nil {
+			return err
+		}
+	}
+
+	if svrCfg.QueryProfiler.Enable {
+		if err := enableQueryProfiler(app); err != nil {
+			return err
+		}
+	}
+
 	metrics, err := startTelemetry(svrCfg)
 	if err != nil {
 		return err
@@ -288,6 +339,7 @@ func startStandAlone(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clie
 
 		// use the provided clientCtx to register the services
 		app.RegisterTxService(clientCtx)
+		setRebroadcaster(app, clientCtx)
 		app.RegisterTendermintService(clientCtx)
 		app.RegisterNodeService(clientCtx, svrCfg)
 	}
@@ -305,6 +357,10 @@ func startStandAlone(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clie
 		return err
 	}
 
+	if err := startQueryProfiler(ctx, g, svrCtx, svrCfg.QueryProfiler, app); err != nil {
+		return err
+	}
+
 	g.Go(func() error {
 		if err := svr.Start(); err != nil {
 			svrCtx.Logger.Error("failed to start out-of-process ABCI server", "err", err)
@@ -352,6 +408,7 @@ func startInProcess(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clien
 			clientCtx = clientCtx.WithClient(local.New(tmNode))
 
 			app.RegisterTxService(clientCtx)
+			setRebroadcaster(app, clientCtx)
 			app.RegisterTendermintService(clientCtx)
 			app.RegisterNodeService(clientCtx, svrCfg)
 		}
@@ -367,6 +424,10 @@ func startInProcess(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clien
 		return err
 	}
 
+	if err := startQueryProfiler(ctx, g, svrCtx, svrCfg.QueryProfiler, app); err != nil {
+		return err
+	}
+
 	if opts.PostSetup != nil {
 		if err := opts.PostSetup(svrCtx, clientCtx, ctx, g); err != nil {
 			return err
@@ -553,6 +614,50 @@ func startAPIServer(
 	return nil
 }
 
+// startQueryProfiler serves the admin endpoint profiling queries, if query
+// profiling is enabled.
+func startQueryProfiler(ctx context.Context, g *errgroup.Group, svrCtx *Context, cfg serverconfig.QueryProfilerConfig, app types.Application) error {
+	if !cfg.Enable {
+		return nil
+	}
+
+	profilerApp, ok := app.(interface {
+		QueryProfiler() baseapp.QueryProfiler
+	})
+	if !ok {
+		return fmt.Errorf("query profiling is enabled but the app doesn't support it")
+	}
+
+	profiler, ok := profilerApp.QueryProfiler().(*queryprofile.Profiler)
+	if !ok {
+		return fmt.Errorf("query profiling is enabled but the app doesn't support it")
+	}
+
+	listener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on query profiler address %s: %w", cfg.Address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/query_profile", profiler.Handler())
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	logger := svrCtx.Logger.With("module", "query-profiler")
+	g.Go(func() error {
+		logger.Info("serving query profiles", "address", cfg.Address)
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		logger.Info("stopping the query profiler...")
+		return srv.Close()
+	})
+	return nil
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -619,16 +724,118 @@ func getCtx(svrCtx *Context, block bool) (*errgroup.Group, context.Context) {
 	return g, ctx
 }
 
-func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions) (app types.Application, cleanupFn func(), err error) {
+// enableBlockResults opens the block results store and enables it on the app,
+// before any block is replayed or executed.
+func enableBlockResults(svrCtx *Context, cfg serverconfig.BlockResultsConfig, app types.Application) error {
+	blockResultsApp, ok := app.(interface {
+		EnableBlockResults(baseapp.BlockResultsStore)
+	})
+	if !ok {
+		return fmt.Errorf("block results are enabled but the app doesn't support them")
+	}
+
+	db, err := dbm.NewDB("block_results", GetAppDBBackend(svrCtx.Viper), filepath.Join(svrCtx.Config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+
+	blockResultsApp.EnableBlockResults(blockresults.NewStore(db, cfg.KeepRecent))
+	return nil
+}
+
+// enableEventStore opens the event store and enables it on the app, before
+// any block is replayed or executed.
+func enableEventStore(svrCtx *Context, cfg serverconfig.EventStoreConfig, app types.Application) error {
+	eventStoreApp, ok := app.(interface {
+		EnableEventStore(baseapp.EventStore)
+	})
+	if !ok {
+		return fmt.Errorf("the event store is enabled but the app doesn't support it")
+	}
+
+	db, err := dbm.NewDB("events", GetAppDBBackend(svrCtx.Viper), filepath.Join(svrCtx.Config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+
+	eventStoreApp.EnableEventStore(eventstore.NewStore(db, cfg.KeepRecent))
+	return nil
+}
+
+// enableRebroadcast enables the rebroadcast of the txs submitted through the tx
+// service on the app.
+func enableRebroadcast(svrCtx *Context, cfg serverconfig.RebroadcastConfig, clientCtx client.Context, app types.Application) error {
+	rebroadcastApp, ok := app.(interface {
+		EnableRebroadcast(baseapp.Rebroadcaster)
+	})
+	if !ok {
+		return fmt.Errorf("rebroadcast is enabled but the app doesn't support it")
+	}
+
+	var senderKey rebroadcast.SenderKeyFn
+	if clientCtx.TxConfig != nil {
+		senderKey = rebroadcast.NewSenderNonceKey(clientCtx.TxConfig.TxDecoder())
+	}
+
+	rebroadcastApp.EnableRebroadcast(rebroadcast.NewManager(rebroadcast.Config{
+		RebroadcastAfter: cfg.RebroadcastAfter,
+		TTL:              cfg.TTL,
+		MaxPending:       cfg.MaxPending,
+	}, senderKey, svrCtx.Logger.With("module", "rebroadcast")))
+	return nil
+}
+
+// enableQueryProfiler enables query profiling on the app, so that queries are
+// tracked while a profile is recorded through the admin endpoint.
+func enableQueryProfiler(app types.Application) error {
+	profilerApp, ok := app.(interface {
+		EnableQueryProfiler(baseapp.QueryProfiler)
+	})
+	if !ok {
+		return fmt.Errorf("query profiling is enabled but the app doesn't support it")
+	}
+
+	profilerApp.EnableQueryProfiler(queryprofile.NewProfiler())
+	return nil
+}
+
+// setRebroadcaster rebroadcasts txs with the CometBFT client of clientCtx, if
+// rebroadcast is enabled on the app.
+func setRebroadcaster(app types.Application, clientCtx client.Context) {
+	rebroadcastApp, ok := app.(interface {
+		RebroadcastManager() baseapp.Rebroadcaster
+	})
+	if !ok {
+		return
+	}
+
+	manager, ok := rebroadcastApp.RebroadcastManager().(*rebroadcast.Manager)
+	if !ok {
+		return
+	}
+
+	manager.SetBroadcaster(func(txBytes []byte) error {
+		res, err := clientCtx.BroadcastTxSync(txBytes)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("tx rejected with code %d: %s", res.Code, res.RawLog)
+		}
+		return nil
+	})
+}
+
+func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions) (app types.Application, db dbm.DB, cleanupFn func(), err error) {
 	traceWriter, traceCleanupFn, err := SetupTraceWriter(svrCtx.Logger, svrCtx.Viper.GetString(flagTraceStore))
 	if err != nil {
-		return app, traceCleanupFn, err
+		return app, db, traceCleanupFn, err
 	}
 
 	home := svrCtx.Config.RootDir
-	db, err := opts.DBOpener(home, GetAppDBBackend(svrCtx.Viper))
+	db, err = opts.DBOpener(home, GetAppDBBackend(svrCtx.Viper))
 	if err != nil {
-		return app, traceCleanupFn, err
+		return app, db, traceCleanupFn, err
 	}
 
 	app = appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
@@ -638,5 +845,5 @@ func startApp(svrCtx *Context, appCreator types.AppCreator, opts StartCmdOptions
 			svrCtx.Logger.Error(localErr.Error())
 		}
 	}
-	return app, cleanupFn, nil
+	return app, db, cleanupFn, nil
 }
@@ -152,7 +152,12 @@ func InterceptConfigsAndCreateContext(cmd *cobra.Command, customAppConfigTemplat
 	serverCtx.Viper.AutomaticEnv()
 
 	// intercept configuration files, using both Viper instances separately
-	config, err := interceptConfigs(serverCtx.Viper, customAppConfigTemplate, customAppConfig, cmtConfig)
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	config, err := interceptConfigs(ctx, serverCtx.Viper, customAppConfigTemplate, customAppConfig, cmtConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -230,8 +235,9 @@ func SetCmdServerContext(cmd *cobra.Command, serverCtx *Context) error {
 // creates a new one and saves it. It also parses and saves the application
 // configuration file. The CometBFT configuration file is parsed given a root
 // Viper object, whereas the application is parsed with the private package-aware
-// viperCfg object.
-func interceptConfigs(rootViper *viper.Viper, customAppTemplate string, customConfig interface{}, cmtConfig *cmtcfg.Config) (*cmtcfg.Config, error) {
+// viperCfg object. Remote configuration documents are then merged, and secret
+// references resolved.
+func interceptConfigs(ctx context.Context, rootViper *viper.Viper, customAppTemplate string, customConfig interface{}, cmtConfig *cmtcfg.Config) (*cmtcfg.Config, error) {
 	rootDir := rootViper.GetString(flags.FlagHome)
 	configPath := filepath.Join(rootDir, "config")
 	cmtCfgFile := filepath.Join(configPath, "config.toml")
@@ -318,6 +324,18 @@ func interceptConfigs(rootViper *viper.Viper, customAppTemplate string, customCo
 		return nil, fmt.Errorf("failed to merge configuration: %w", err)
 	}
 
+	if err := config.MergeRemoteConfig(ctx, rootViper); err != nil {
+		return nil, err
+	}
+
+	if err := config.ResolveSecrets(ctx, rootViper); err != nil {
+		return nil, err
+	}
+
+	if err := config.ValidateSections(rootViper); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", appCfgFilePath, err)
+	}
+
 	return conf, nil
 }
 
@@ -349,6 +367,7 @@ func AddCommands(rootCmd *cobra.Command, appCreator types.AppCreator, addStartFl
 		cometCmd,
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator),
+		NewCompactDBCmd(),
 	)
 }
 
@@ -512,11 +531,30 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+		opts := []mempool.SenderNonceOptions{
+			mempool.SenderNonceMaxTxOpt(maxTxs),
+			mempool.SenderNonceMaxTxPerSenderOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))),
+		}
+		if bump := cast.ToUint64(appOpts.Get(FlagMempoolReplaceByFeeBump)); bump > 0 {
+			opts = append(opts, mempool.SenderNonceTxReplacementOpt(mempool.ReplaceByFee(bump)))
+		}
+
+		var mp mempool.Mempool = mempool.NewSenderNonceMempool(opts...)
+
+		ttl := mempool.TTLConfig{
+			MaxBlocks: cast.ToInt64(appOpts.Get(FlagMempoolTxTTLBlocks)),
+			MaxAge:    cast.ToDuration(appOpts.Get(FlagMempoolTxTTL)),
+		}
+		if ttl.MaxBlocks > 0 || ttl.MaxAge > 0 {
+			mp = mempool.NewTTLMempool(mp, ttl)
+		}
+
+		defaultMempool = baseapp.SetMempool(mp)
+	}
+
+	txSelector, err := baseapp.NewTxSelector(cast.ToString(appOpts.Get(FlagMempoolTxSelector)))
+	if err != nil {
+		panic(err)
 	}
 
 	return []func(*baseapp.BaseApp){
@@ -531,12 +569,23 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
+		baseapp.SetTxDecodeCacheBudget(cast.ToInt(appOpts.Get(FlagCacheMemoryBudget))),
+		baseapp.SetOptimisticExecutionDisabled(cast.ToBool(appOpts.Get(FlagDisableOE))),
 		defaultMempool,
+		baseapp.SetTxSelector(txSelector),
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetQueryScheduler(newQueryScheduler(cast.ToInt(appOpts.Get(FlagQueryConcurrency)))),
 	}
 }
 
+// newQueryScheduler returns a query scheduler running up to maxConcurrent
+// queries, a quarter of which, and at least one, is reserved to internally
+// originated queries. It returns nil if maxConcurrent is 0.
+func newQueryScheduler(maxConcurrent int) *baseapp.QueryScheduler {
+	return baseapp.NewQueryScheduler(maxConcurrent, max(maxConcurrent/4, 1))
+}
+
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
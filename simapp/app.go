@@ -84,6 +84,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/appservice"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -348,9 +349,16 @@ func NewSimApp(
 
 	app.CircuitKeeper = circuitkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[circuittypes.StoreKey]), authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
+	app.CircuitKeeper.SetMsgRouteLister(app.MsgServiceRouter())
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), appCodec, app.MsgServiceRouter(), app.AuthKeeper)
 
+	// modules keeping state by account register it so that dust reaping skips those accounts
+	app.BankKeeper.AppendAccountStateCheck(app.StakingKeeper.HasAccountState)
+	app.BankKeeper.AppendAccountStateCheck(app.DistrKeeper.HasAccountState)
+	app.BankKeeper.AppendAccountStateCheck(app.FeeGrantKeeper.HasAccountState)
+	app.BankKeeper.AppendAccountStateCheck(app.AuthzKeeper.HasAccountState)
+
 	groupConfig := group.DefaultConfig()
 	/*
 		Example of group params:
@@ -393,8 +401,10 @@ func NewSimApp(
 		// register the governance hooks
 		),
 	)
+	// record the history of the param changes in x/gov
+	app.BaseApp.SetParamChangeRecorder(app.GovKeeper)
 
-	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), appCodec, app.AuthKeeper, app.BankKeeper)
+	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), appCodec, app.AuthKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -463,6 +473,7 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
+		circuittypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
@@ -471,6 +482,7 @@ func NewSimApp(
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		banktypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -507,6 +519,8 @@ func NewSimApp(
 	}
 	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 
+	appservice.RegisterAppService(app.GRPCQueryRouter(), app.BaseApp)
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
 
@@ -546,6 +560,11 @@ func NewSimApp(
 	// upgrade.
 	app.setPostHandler()
 
+	// fill in the denoms and modules of the chain metadata served to clients
+	app.AddChainMetadataProvider(app.BankKeeper.ChainMetadataProvider())
+	app.AddChainMetadataProvider(app.StakingKeeper.ChainMetadataProvider())
+	app.AddChainMetadataProvider(app.ModuleManager.ChainMetadataProvider())
+
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
 	protoFiles, err := proto.MergedRegistry()
@@ -577,6 +596,8 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				// accept tips, paid by the post handler
+				ExtensionOptionChecker: ante.TipExtensionOptionChecker,
 			},
 			&app.CircuitKeeper,
 		},
@@ -587,11 +608,19 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
+
+	// Read the accounts of the signers of a block before executing its txs
+	app.SetTxsPreVerifier(ante.NewAccountPrefetcher(app.AuthKeeper).Prefetch)
 }
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			AccountKeeper:      app.AuthKeeper,
+			BankKeeper:         app.BankKeeper,
+			FeeSplitKeeper:     app.AuthKeeper,
+			FeeSplitBankKeeper: app.BankKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -256,6 +256,13 @@ func NewSimApp(
 	// 	return app.App.InitChainer(ctx, req)
 	// })
 
+	// fill in the denoms of the chain metadata served to clients
+	app.AddChainMetadataProvider(app.BankKeeper.ChainMetadataProvider())
+	app.AddChainMetadataProvider(app.StakingKeeper.ChainMetadataProvider())
+
+	// record the history of the param changes in x/gov
+	app.SetParamChangeRecorder(app.GovKeeper)
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
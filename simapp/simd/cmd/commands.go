@@ -41,11 +41,14 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	configCmd := confixcmd.ConfigCommand()
+	configCmd.AddCommand(server.NewConfigDocCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
-		confixcmd.ConfigCommand(),
+		configCmd,
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
 	)
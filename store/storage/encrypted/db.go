@@ -0,0 +1,413 @@
+package encrypted
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	// formatVersion is the first byte of every encrypted value.
+	formatVersion = 1
+	// headerSize is the size of the format version and key ID prefixing the nonce.
+	headerSize = 1 + 4
+
+	// reencryptBatchSize defines the number of entries re-encrypted in a single
+	// batch by Reencrypt.
+	reencryptBatchSize = 1000
+
+	// canaryStoreKey is the reserved store holding the integrity check records
+	// of the encryption keys.
+	canaryStoreKey = "_encryption"
+	canaryValue    = "cosmossdk.io/store/v2/storage/encrypted"
+)
+
+var (
+	_ storage.Database = (*Database)(nil)
+	_ store.Batch      = (*batch)(nil)
+	_ store.Iterator   = (*iterator)(nil)
+
+	// ErrIntegrityCheck is returned when the database cannot be opened or read
+	// with the configured keys.
+	ErrIntegrityCheck = errors.New("encrypted storage integrity check failed")
+)
+
+// Key is an AES-256 key used to encrypt the storage, identified by ID in the
+// encrypted values.
+type Key struct {
+	ID     uint32
+	Secret []byte
+}
+
+// Database wraps a storage.Database, encrypting values at rest with AES-GCM.
+// Keys are stored in clear, as the backends rely on their ordering for
+// iteration, so sensitive data must not be used as store keys. Each value is
+// authenticated along with its store key and key, so values cannot be swapped
+// between keys without being detected.
+//
+// Keys are rotated by opening the database with a new active key and the
+// previous keys as retired keys: new writes use the active key while values
+// written with retired keys stay readable. Reencrypt rewrites the latest
+// values with the active key; a retired key can be discarded once every store
+// has been re-encrypted and the versions written before have been pruned.
+type Database struct {
+	db     storage.Database
+	active uint32
+	aeads  map[uint32]cipher.AEAD
+}
+
+// New opens the encrypted database wrapping db, using active to encrypt new
+// values. Retired keys are only used to decrypt values written before a key
+// rotation.
+//
+// On startup, each key is checked against the integrity record written the
+// first time it was used, failing with ErrIntegrityCheck if a key is wrong or
+// if db holds data but none of the keys were ever used with it, e.g. because
+// it is not encrypted.
+func New(db storage.Database, active Key, retired ...Key) (*Database, error) {
+	edb := &Database{
+		db:     db,
+		active: active.ID,
+		aeads:  make(map[uint32]cipher.AEAD, len(retired)+1),
+	}
+
+	for _, key := range append([]Key{active}, retired...) {
+		if _, ok := edb.aeads[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate encryption key ID %d", key.ID)
+		}
+
+		if len(key.Secret) != 32 {
+			return nil, fmt.Errorf("encryption key %d: invalid key length %d, expected 32 bytes", key.ID, len(key.Secret))
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %d: %w", key.ID, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %d: %w", key.ID, err)
+		}
+
+		edb.aeads[key.ID] = aead
+	}
+
+	if err := edb.checkIntegrity(); err != nil {
+		return nil, err
+	}
+
+	return edb, nil
+}
+
+// checkIntegrity verifies the integrity records of the keys, writing the one
+// of the active key if it was never used.
+func (db *Database) checkIntegrity() error {
+	latest, err := db.db.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	var found int
+	for id := range db.aeads {
+		bz, err := db.db.Get(canaryStoreKey, latest, canaryKey(id))
+		if err != nil {
+			return err
+		}
+		if bz == nil {
+			continue
+		}
+
+		value, err := db.decrypt(canaryStoreKey, canaryKey(id), bz)
+		if err != nil || string(value) != canaryValue {
+			return fmt.Errorf("%w: wrong encryption key %d", ErrIntegrityCheck, id)
+		}
+		found++
+	}
+
+	if found == 0 && latest > 0 {
+		return fmt.Errorf("%w: database at version %d was not written with any of the configured keys", ErrIntegrityCheck, latest)
+	}
+
+	if bz, err := db.db.Get(canaryStoreKey, latest, canaryKey(db.active)); err != nil || bz != nil {
+		return err
+	}
+
+	// the batch is written at the latest version, leaving it unchanged
+	b, err := db.NewBatch(latest)
+	if err != nil {
+		return err
+	}
+	if err := b.Set(canaryStoreKey, canaryKey(db.active), []byte(canaryValue)); err != nil {
+		return err
+	}
+
+	return b.Write()
+}
+
+// NewBatch returns a batch encrypting the values written to version.
+func (db *Database) NewBatch(version uint64) (store.Batch, error) {
+	b, err := db.db.NewBatch(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batch{Batch: b, db: db}, nil
+}
+
+func (db *Database) Has(storeKey string, version uint64, key []byte) (bool, error) {
+	return db.db.Has(storeKey, version, key)
+}
+
+func (db *Database) Get(storeKey string, version uint64, key []byte) ([]byte, error) {
+	bz, err := db.db.Get(storeKey, version, key)
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	return db.decrypt(storeKey, key, bz)
+}
+
+func (db *Database) GetLatestVersion() (uint64, error) {
+	return db.db.GetLatestVersion()
+}
+
+func (db *Database) SetLatestVersion(version uint64) error {
+	return db.db.SetLatestVersion(version)
+}
+
+func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	itr, err := db.db.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(db, storeKey, itr), nil
+}
+
+func (db *Database) ReverseIterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	itr, err := db.db.ReverseIterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(db, storeKey, itr), nil
+}
+
+func (db *Database) Prune(version uint64) error {
+	return db.db.Prune(version)
+}
+
+func (db *Database) Close() error {
+	return db.db.Close()
+}
+
+// Reencrypt rewrites the latest values of the given stores which are not
+// encrypted with the active key, and returns the number of values rewritten.
+// Values are rewritten at the latest version, so it must not be called
+// concurrently with commits.
+func (db *Database) Reencrypt(storeKeys ...string) (int, error) {
+	latest, err := db.db.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, storeKey := range storeKeys {
+		var start []byte
+		for {
+			// stores do not allow writes while iterating, so entries are
+			// collected in batches first.
+			type entry struct{ key, value []byte }
+			var entries []entry
+
+			itr, err := db.db.Iterator(storeKey, latest, start, nil)
+			if err != nil {
+				return count, err
+			}
+			for ; itr.Valid() && len(entries) < reencryptBatchSize; itr.Next() {
+				if id, ok := keyID(itr.Value()); ok && id == db.active {
+					continue
+				}
+
+				entries = append(entries, entry{key: bytes.Clone(itr.Key()), value: bytes.Clone(itr.Value())})
+			}
+			more := itr.Valid()
+			if more {
+				start = bytes.Clone(itr.Key())
+			}
+			err = itr.Error()
+			itr.Close()
+			if err != nil {
+				return count, err
+			}
+
+			if len(entries) > 0 {
+				b, err := db.NewBatch(latest)
+				if err != nil {
+					return count, err
+				}
+
+				for _, e := range entries {
+					value, err := db.decrypt(storeKey, e.key, e.value)
+					if err != nil {
+						return count, err
+					}
+					if err := b.Set(storeKey, e.key, value); err != nil {
+						return count, err
+					}
+				}
+
+				if err := b.Write(); err != nil {
+					return count, err
+				}
+				count += len(entries)
+			}
+
+			if !more {
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func (db *Database) encrypt(storeKey string, key, value []byte) ([]byte, error) {
+	aead := db.aeads[db.active]
+
+	bz := make([]byte, headerSize+aead.NonceSize(), headerSize+aead.NonceSize()+len(value)+aead.Overhead())
+	bz[0] = formatVersion
+	binary.BigEndian.PutUint32(bz[1:headerSize], db.active)
+
+	nonce := bz[headerSize:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(bz, nonce, value, additionalData(storeKey, key)), nil
+}
+
+func (db *Database) decrypt(storeKey string, key, bz []byte) ([]byte, error) {
+	id, ok := keyID(bz)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid encrypted value for key %X in store %s", ErrIntegrityCheck, key, storeKey)
+	}
+
+	aead, ok := db.aeads[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown encryption key %d for key %X in store %s", ErrIntegrityCheck, id, key, storeKey)
+	}
+
+	if len(bz) < headerSize+aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid encrypted value for key %X in store %s", ErrIntegrityCheck, key, storeKey)
+	}
+
+	nonce, ciphertext := bz[headerSize:headerSize+aead.NonceSize()], bz[headerSize+aead.NonceSize():]
+	value, err := aead.Open(nil, nonce, ciphertext, additionalData(storeKey, key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decrypt key %X in store %s", ErrIntegrityCheck, key, storeKey)
+	}
+
+	return value, nil
+}
+
+// keyID returns the ID of the key an encrypted value was encrypted with.
+func keyID(bz []byte) (uint32, bool) {
+	if len(bz) < headerSize || bz[0] != formatVersion {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(bz[1:headerSize]), true
+}
+
+// additionalData binds an encrypted value to its store key and key.
+func additionalData(storeKey string, key []byte) []byte {
+	ad := make([]byte, 0, binary.MaxVarintLen64+len(storeKey)+len(key))
+	ad = binary.AppendUvarint(ad, uint64(len(storeKey)))
+	ad = append(ad, storeKey...)
+	return append(ad, key...)
+}
+
+func canaryKey(id uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte("canary/"), id)
+}
+
+// batch encrypts the values written to the underlying batch.
+type batch struct {
+	store.Batch
+	db *Database
+}
+
+func (b *batch) Set(storeKey string, key, value []byte) error {
+	bz, err := b.db.encrypt(storeKey, key, value)
+	if err != nil {
+		return err
+	}
+
+	return b.Batch.Set(storeKey, key, bz)
+}
+
+// iterator decrypts the values of the underlying iterator. A value failing to
+// decrypt invalidates the iterator, the error being returned by Error.
+type iterator struct {
+	store.Iterator
+	db       *Database
+	storeKey string
+	value    []byte
+	err      error
+}
+
+func newIterator(db *Database, storeKey string, itr store.Iterator) *iterator {
+	it := &iterator{Iterator: itr, db: db, storeKey: storeKey}
+	it.decrypt()
+	return it
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.err == nil && itr.Iterator.Valid()
+}
+
+func (itr *iterator) Error() error {
+	if itr.err != nil {
+		return itr.err
+	}
+
+	return itr.Iterator.Error()
+}
+
+func (itr *iterator) Value() []byte {
+	if !itr.Valid() {
+		return nil
+	}
+
+	return itr.value
+}
+
+func (itr *iterator) Next() bool {
+	if itr.err != nil {
+		return false
+	}
+
+	itr.Iterator.Next()
+	itr.decrypt()
+
+	return itr.Valid()
+}
+
+func (itr *iterator) decrypt() {
+	itr.value = nil
+	if !itr.Iterator.Valid() {
+		return
+	}
+
+	itr.value, itr.err = itr.db.decrypt(itr.storeKey, itr.Iterator.Key(), itr.Iterator.Value())
+}
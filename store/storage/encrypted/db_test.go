@@ -0,0 +1,183 @@
+package encrypted
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+var (
+	key1 = Key{ID: 1, Secret: bytes.Repeat([]byte{1}, 32)}
+	key2 = Key{ID: 2, Secret: bytes.Repeat([]byte{2}, 32)}
+)
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (store.VersionedDatabase, error) {
+			db, err := pebbledb.New(dir)
+			if err != nil {
+				return nil, err
+			}
+			db.SetSync(false)
+
+			edb, err := New(db, key1)
+			return storage.NewStorageStore(edb), err
+		},
+		EmptyBatchSize: 12,
+	}
+
+	suite.Run(t, s)
+}
+
+func openPebble(t *testing.T, dir string) *pebbledb.Database {
+	t.Helper()
+
+	db, err := pebbledb.New(dir)
+	require.NoError(t, err)
+	db.SetSync(false)
+
+	return db
+}
+
+func writeVersion(t *testing.T, db storage.Database, version uint64, storeKey string, kvs ...string) {
+	t.Helper()
+
+	b, err := db.NewBatch(version)
+	require.NoError(t, err)
+	for i := 0; i < len(kvs); i += 2 {
+		require.NoError(t, b.Set(storeKey, []byte(kvs[i]), []byte(kvs[i+1])))
+	}
+	require.NoError(t, b.Write())
+}
+
+func TestEncryptedDatabase(t *testing.T) {
+	dir := t.TempDir()
+	pdb := openPebble(t, dir)
+
+	db, err := New(pdb, key1)
+	require.NoError(t, err)
+
+	writeVersion(t, db, 1, "bank", "alice", "100", "bob", "200")
+
+	value, err := db.Get("bank", 1, []byte("alice"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("100"), value)
+
+	// values are encrypted in the underlying database
+	raw, err := pdb.Get("bank", 1, []byte("alice"))
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "100")
+
+	itr, err := db.Iterator("bank", 1, nil, nil)
+	require.NoError(t, err)
+	var values []string
+	for ; itr.Valid(); itr.Next() {
+		values = append(values, string(itr.Value()))
+	}
+	require.NoError(t, itr.Error())
+	itr.Close()
+	require.Equal(t, []string{"100", "200"}, values)
+
+	// swapping encrypted values between keys is detected
+	b, err := pdb.NewBatch(2)
+	require.NoError(t, err)
+	require.NoError(t, b.Set("bank", []byte("bob"), raw))
+	require.NoError(t, b.Write())
+
+	_, err = db.Get("bank", 2, []byte("bob"))
+	require.ErrorIs(t, err, ErrIntegrityCheck)
+
+	itr, err = db.Iterator("bank", 2, nil, nil)
+	require.NoError(t, err)
+	for itr.Valid() {
+		itr.Next()
+	}
+	require.ErrorIs(t, itr.Error(), ErrIntegrityCheck)
+	itr.Close()
+
+	require.NoError(t, db.Close())
+}
+
+func TestEncryptedDatabaseIntegrityCheck(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := New(openPebble(t, dir), key1)
+	require.NoError(t, err)
+	writeVersion(t, db, 1, "bank", "alice", "100")
+	require.NoError(t, db.Close())
+
+	// wrong key material
+	pdb := openPebble(t, dir)
+	_, err = New(pdb, Key{ID: 1, Secret: bytes.Repeat([]byte{9}, 32)})
+	require.ErrorIs(t, err, ErrIntegrityCheck)
+
+	// key never used with the database
+	_, err = New(pdb, key2)
+	require.ErrorIs(t, err, ErrIntegrityCheck)
+	require.NoError(t, pdb.Close())
+
+	// unencrypted database
+	pdb = openPebble(t, t.TempDir())
+	writeVersion(t, pdb, 1, "bank", "alice", "100")
+	_, err = New(pdb, key1)
+	require.ErrorIs(t, err, ErrIntegrityCheck)
+	require.NoError(t, pdb.Close())
+
+	// invalid keys
+	pdb = openPebble(t, t.TempDir())
+	_, err = New(pdb, Key{ID: 1, Secret: []byte("short")})
+	require.Error(t, err)
+	_, err = New(pdb, key1, key1)
+	require.Error(t, err)
+	require.NoError(t, pdb.Close())
+}
+
+func TestEncryptedDatabaseKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := New(openPebble(t, dir), key1)
+	require.NoError(t, err)
+	writeVersion(t, db, 1, "bank", "alice", "100", "bob", "200")
+	require.NoError(t, db.Close())
+
+	// rotate to key2, values written with key1 remain readable
+	db, err = New(openPebble(t, dir), key2, key1)
+	require.NoError(t, err)
+	writeVersion(t, db, 2, "bank", "carol", "300")
+
+	for k, v := range map[string]string{"alice": "100", "bob": "200", "carol": "300"} {
+		value, err := db.Get("bank", 2, []byte(k))
+		require.NoError(t, err)
+		require.Equal(t, v, string(value))
+	}
+
+	n, err := db.Reencrypt("bank")
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	n, err = db.Reencrypt("bank")
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest)
+
+	require.NoError(t, db.Close())
+
+	// once re-encrypted, key1 is not needed to read the latest version
+	db, err = New(openPebble(t, dir), key2)
+	require.NoError(t, err)
+	for k, v := range map[string]string{"alice": "100", "bob": "200", "carol": "300"} {
+		value, err := db.Get("bank", 2, []byte(k))
+		require.NoError(t, err)
+		require.Equal(t, v, string(value))
+	}
+	require.NoError(t, db.Close())
+}
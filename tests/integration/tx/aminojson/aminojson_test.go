@@ -350,12 +350,14 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 				Params: stakingtypes.Params{
 					UnbondingTime:  0,
 					KeyRotationFee: types.Coin{},
+					StakeFlowLimit: math.ZeroInt(),
 				},
 			},
 			pulsar: &stakingapi.MsgUpdateParams{
 				Params: &stakingapi.Params{
 					UnbondingTime:  &durationpb.Duration{Seconds: 0},
 					KeyRotationFee: &v1beta1.Coin{},
+					StakeFlowLimit: "0",
 				},
 			},
 		},
@@ -0,0 +1,56 @@
+package textual
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	signing_testutil "cosmossdk.io/x/tx/signing/testutil"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/tests/integration/rapidgen"
+)
+
+// TestTextual_RendererCoverage checks that every signable msg type known to the
+// SDK can be rendered into a SIGN_MODE_TEXTUAL envelope, which is what Ledger
+// devices sign when no amino-json encoding exists for a message.
+func TestTextual_RendererCoverage(t *testing.T) {
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(_ context.Context, _ string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+
+	for _, tt := range rapidgen.SignableTypes {
+		desc := tt.Pulsar.ProtoReflect().Descriptor()
+		if !proto.HasExtension(desc.Options(), msgv1.E_Signer) {
+			continue
+		}
+
+		t.Run(string(desc.FullName()), func(t *testing.T) {
+			signerData, txData, err := signing_testutil.MakeHandlerArguments(signing_testutil.HandlerArgumentOptions{
+				ChainID:       "test-chain",
+				Memo:          "sometestmemo",
+				Msg:           tt.Pulsar,
+				AccNum:        1,
+				AccSeq:        2,
+				SignerAddress: "signerAddress",
+				Fee: &txv1beta1.Fee{
+					Amount: []*v1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+				},
+			})
+			require.NoError(t, err)
+
+			signBz, err := handler.GetSignBytes(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			require.NotEmpty(t, signBz)
+		})
+	}
+}
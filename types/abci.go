@@ -14,6 +14,23 @@ type PrepareCheckStater func(ctx Context)
 // Precommiter runs code during commit immediately before the `deliverState` is written to the `rootMultiStore`.
 type Precommiter func(ctx Context)
 
+// TxsPreVerifier runs before the txs of a block are executed, with the txs of
+// the block which could be decoded. It is intended to perform expensive and
+// stateless verifications, such as signature verifications, concurrently and
+// ahead of the sequential execution of the txs. It must not write to state, and
+// its outcome must not affect the execution of the block.
+type TxsPreVerifier func(ctx Context, txs []Tx)
+
+// ChainTxsPreVerifiers returns a TxsPreVerifier running verifiers in order,
+// e.g. prefetching the state the next ones read.
+func ChainTxsPreVerifiers(verifiers ...TxsPreVerifier) TxsPreVerifier {
+	return func(ctx Context, txs []Tx) {
+		for _, verifier := range verifiers {
+			verifier(ctx, txs)
+		}
+	}
+}
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) *abci.ResponseQuery
 
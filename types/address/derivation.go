@@ -0,0 +1,158 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrAddressCollision is returned when two different derivation paths resolve
+// to the same address.
+var ErrAddressCollision = errors.New("derived address collision")
+
+// DerivationPath describes how a module or sub-account address is derived:
+// a module name followed by an ordered list of derivation keys, as accepted
+// by Module.
+type DerivationPath struct {
+	Module string
+	Keys   [][]byte
+}
+
+// NewDerivationPath creates a DerivationPath from a module name and derivation keys.
+func NewDerivationPath(moduleName string, keys ...[]byte) DerivationPath {
+	return DerivationPath{Module: moduleName, Keys: keys}
+}
+
+// Validate checks that the derivation path can not collide with another path
+// by construction. Module separates the module name from the first derivation
+// key with a zero byte, so the module name must not contain one.
+func (p DerivationPath) Validate() error {
+	if p.Module == "" {
+		return errors.New("module name cannot be empty")
+	}
+
+	if strings.IndexByte(p.Module, 0) >= 0 {
+		return fmt.Errorf("module name %q cannot contain a zero byte", p.Module)
+	}
+
+	return nil
+}
+
+// Address returns the address derived from the path.
+func (p DerivationPath) Address() []byte {
+	return Module(p.Module, p.Keys...)
+}
+
+// Equal reports whether both paths derive from the same module and keys.
+func (p DerivationPath) Equal(other DerivationPath) bool {
+	if p.Module != other.Module || len(p.Keys) != len(other.Keys) {
+		return false
+	}
+
+	for i := range p.Keys {
+		if !bytes.Equal(p.Keys[i], other.Keys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the path as the module name followed by the hex encoded keys,
+// separated by slashes, e.g. "group/0a/01".
+func (p DerivationPath) String() string {
+	parts := make([]string, 0, len(p.Keys)+1)
+	parts = append(parts, p.Module)
+	for _, k := range p.Keys {
+		parts = append(parts, hex.EncodeToString(k))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// DerivationRegistry derives module and sub-account addresses and remembers
+// their derivation paths, so that a derived address can be resolved back to
+// the module and keys it was built from.
+//
+// Deriving through a registry guarantees that no two distinct paths map to the
+// same address within the registry. It is safe for concurrent use.
+type DerivationRegistry struct {
+	mu    sync.RWMutex
+	paths map[string]DerivationPath
+}
+
+// NewDerivationRegistry creates an empty DerivationRegistry.
+func NewDerivationRegistry() *DerivationRegistry {
+	return &DerivationRegistry{paths: make(map[string]DerivationPath)}
+}
+
+// Derive returns the address of the module sub-account identified by keys and
+// records its derivation path. Calling Derive with no keys returns the
+// address of the module account itself.
+func (r *DerivationRegistry) Derive(moduleName string, keys ...[]byte) ([]byte, error) {
+	path := NewDerivationPath(moduleName, keys...)
+	if err := path.Validate(); err != nil {
+		return nil, err
+	}
+
+	addr := path.Address()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.paths[string(addr)]; ok {
+		if !existing.Equal(path) {
+			return nil, fmt.Errorf("%w: %s and %s both derive %X", ErrAddressCollision, existing, path, addr)
+		}
+
+		return addr, nil
+	}
+
+	// copy the keys, so that callers reusing their buffers don't alter the registry
+	stored := DerivationPath{Module: moduleName, Keys: make([][]byte, len(keys))}
+	for i, k := range keys {
+		stored.Keys[i] = bytes.Clone(k)
+	}
+	r.paths[string(addr)] = stored
+
+	return addr, nil
+}
+
+// MustDerive is like Derive but panics on error.
+func (r *DerivationRegistry) MustDerive(moduleName string, keys ...[]byte) []byte {
+	addr, err := r.Derive(moduleName, keys...)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// Resolve returns the derivation path of an address previously derived
+// through the registry.
+func (r *DerivationRegistry) Resolve(addr []byte) (DerivationPath, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	path, ok := r.paths[string(addr)]
+	return path, ok
+}
+
+// Paths returns all the derivation paths recorded in the registry, sorted by
+// their string representation.
+func (r *DerivationRegistry) Paths() []DerivationPath {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	paths := make([]DerivationPath, 0, len(r.paths))
+	for _, p := range r.paths {
+		paths = append(paths, p)
+	}
+
+	sort.Slice(paths, func(i, j int) bool { return paths[i].String() < paths[j].String() })
+	return paths
+}
@@ -120,3 +120,39 @@ func (a addrMock) AddressWithLen(t *testing.T) []byte {
 	assert.NoError(t, err)
 	return addr
 }
+
+func (suite *AddressSuite) TestDerivationRegistry() {
+	assert := suite.Assert()
+	r := NewDerivationRegistry()
+
+	modAddr, err := r.Derive("group")
+	assert.NoError(err)
+	assert.Equal(Module("group"), modAddr)
+
+	key := []byte{1, 2}
+	subAddr, err := r.Derive("group", key, []byte{3})
+	assert.NoError(err)
+	assert.Equal(Module("group", []byte{1, 2}, []byte{3}), subAddr)
+
+	// mutating the caller's buffer must not alter the recorded path
+	key[0] = 9
+	path, ok := r.Resolve(subAddr)
+	assert.True(ok)
+	assert.True(path.Equal(NewDerivationPath("group", []byte{1, 2}, []byte{3})))
+	assert.Equal("group/0102/03", path.String())
+
+	// deriving the same path twice is idempotent
+	again, err := r.Derive("group", []byte{1, 2}, []byte{3})
+	assert.NoError(err)
+	assert.Equal(subAddr, again)
+
+	_, ok = r.Resolve(Module("unknown"))
+	assert.False(ok)
+
+	_, err = r.Derive("")
+	assert.Error(err)
+	_, err = r.Derive("gr\x00oup", []byte{1})
+	assert.Error(err)
+
+	assert.Len(r.Paths(), 2)
+}
@@ -0,0 +1,36 @@
+package types
+
+import "time"
+
+// BlockTimeEstimate is the average block time over the window of blocks up to
+// a height, used to convert between future heights and times.
+type BlockTimeEstimate struct {
+	// Height and Time are those of the latest block of the window.
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+	// BlockTime is the average time between two blocks of the window.
+	BlockTime time.Duration `json:"block_time"`
+	// Window is the number of blocks the average is computed over.
+	Window int64 `json:"window"`
+}
+
+// TimeAt returns the estimated time of a height.
+func (e BlockTimeEstimate) TimeAt(height int64) time.Time {
+	return e.Time.Add(time.Duration(height-e.Height) * e.BlockTime)
+}
+
+// HeightAt returns the estimated height of the first block at or after t,
+// which is at least 1.
+func (e BlockTimeEstimate) HeightAt(t time.Time) int64 {
+	if e.BlockTime <= 0 {
+		return e.Height
+	}
+
+	d := t.Sub(e.Time)
+	blocks := int64(d / e.BlockTime)
+	if d > 0 && d%e.BlockTime != 0 {
+		blocks++
+	}
+
+	return max(e.Height+blocks, 1)
+}
@@ -0,0 +1,55 @@
+package types
+
+import "sort"
+
+// RuntimeBaseApp is the runtime of the chains whose app is built on BaseApp.
+const RuntimeBaseApp = "baseapp"
+
+// ChainMetadata is the machine-readable metadata of a chain, returned by the
+// ChainMetadata query of the app service, so that clients such as wallets can
+// configure themselves for a chain.
+type ChainMetadata struct {
+	ChainID    string `json:"chain_id"`
+	AppName    string `json:"app_name"`
+	AppVersion string `json:"app_version,omitempty"`
+	SDKVersion string `json:"sdk_version,omitempty"`
+	// Runtime is the runtime of the app, e.g. "baseapp".
+	Runtime        string         `json:"runtime"`
+	Bech32Prefixes Bech32Prefixes `json:"bech32_prefixes"`
+	// BondDenom is the staking denom, if the chain has one.
+	BondDenom string          `json:"bond_denom,omitempty"`
+	Denoms    []DenomMetadata `json:"denoms,omitempty"`
+	// Modules are the names of the modules of the app, sorted.
+	Modules []string `json:"modules,omitempty"`
+	// Features are the optional features enabled on the node, sorted, e.g.
+	// "optimistic-execution".
+	Features []string `json:"features,omitempty"`
+}
+
+// Bech32Prefixes are the bech32 human-readable parts of the addresses of a
+// chain.
+type Bech32Prefixes struct {
+	Account   string `json:"account"`
+	Validator string `json:"validator"`
+	Consensus string `json:"consensus"`
+}
+
+// DenomMetadata is the display metadata of a denom: an amount of Display is an
+// amount of Base divided by 10^Exponent.
+type DenomMetadata struct {
+	Base     string `json:"base"`
+	Display  string `json:"display"`
+	Exponent uint32 `json:"exponent"`
+}
+
+// ChainMetadataProvider fills in the parts of the chain metadata known to the
+// app or to a module, such as the denoms or the modules.
+type ChainMetadataProvider func(ctx Context, md *ChainMetadata) error
+
+// Sort sorts the lists of the metadata, so that it does not depend on the
+// order of the providers.
+func (md *ChainMetadata) Sort() {
+	sort.Slice(md.Denoms, func(i, j int) bool { return md.Denoms[i].Base < md.Denoms[j].Base })
+	sort.Strings(md.Modules)
+	sort.Strings(md.Features)
+}
@@ -316,10 +316,9 @@ func (coins Coins) Add(coinsB ...Coin) Coins {
 }
 
 // safeAdd will perform addition of two coins sets. If both coin sets are
-// empty, then an empty set is returned. If only a single set is empty, the
-// other set is returned. Otherwise, the coins are compared in order of their
-// denomination and addition only occurs when the denominations match, otherwise
-// the coin is simply added to the sum assuming it's not zero.
+// empty, then an empty set is returned. Otherwise, the coins are merged in
+// order of their denomination and addition only occurs when the denominations
+// match, otherwise the coin is simply added to the sum assuming it's not zero.
 // The function panics if `coins` or  `coinsB` are not sorted (ascending).
 func (coins Coins) safeAdd(coinsB Coins) (coalesced Coins) {
 	// probably the best way will be to make Coins and interface and hide the structure
@@ -331,27 +330,55 @@ func (coins Coins) safeAdd(coinsB Coins) (coalesced Coins) {
 		panic("Wrong argument: coins must be sorted")
 	}
 
-	uniqCoins := make(map[string]Coin, len(coins)+len(coinsB))
-	// Traverse all the coins for each of the coins and coinsB.
-	for _, cL := range []Coins{coins, coinsB} {
-		for _, c := range cL {
-			if uc, ok := uniqCoins[c.Denom]; ok {
-				uniqCoins[c.Denom] = uc.Add(c)
-			} else {
-				uniqCoins[c.Denom] = c
-			}
+	return mergeCoins(coins, coinsB, false)
+}
+
+// mergeCoins returns the sum of the sorted coin sets coins and coinsB or, if
+// sub is set, their difference, in a single pass allocating only the result.
+// Coins of the same denomination within a set are coalesced, and zero coins
+// are removed.
+func mergeCoins(coins, coinsB Coins, sub bool) Coins {
+	combine := math.Int.Add
+	if sub {
+		combine = math.Int.Sub
+	}
+
+	// fast path for the single denomination sets of most balances and fees
+	if len(coins) == 1 && len(coinsB) == 1 && coins[0].Denom == coinsB[0].Denom {
+		coin := Coin{coins[0].Denom, combine(coins[0].Amount, coinsB[0].Amount)}
+		if coin.IsZero() {
+			return Coins{}
 		}
+		return Coins{coin}
 	}
 
-	coalesced = make(Coins, 0, len(uniqCoins))
-	for denom, c := range uniqCoins { //#nosec
-		if c.IsZero() {
+	merged := make(Coins, 0, len(coins)+len(coinsB))
+	for i, j := 0, 0; i < len(coins) || j < len(coinsB); {
+		var coin Coin
+		switch {
+		case j == len(coinsB) || (i < len(coins) && coins[i].Denom < coinsB[j].Denom):
+			coin = coins[i]
+			i++
+		case i == len(coins) || coinsB[j].Denom < coins[i].Denom:
+			coin = coinsB[j]
+			if sub {
+				coin.Amount = coin.Amount.Neg()
+			}
+			j++
+		default:
+			coin = Coin{coins[i].Denom, combine(coins[i].Amount, coinsB[j].Amount)}
+			i++
+			j++
+		}
+
+		if last := len(merged) - 1; last >= 0 && merged[last].Denom == coin.Denom {
+			merged[last].Amount = merged[last].Amount.Add(coin.Amount)
 			continue
 		}
-		c.Denom = denom
-		coalesced = append(coalesced, c)
+		merged = append(merged, coin)
 	}
-	return coalesced.Sort()
+
+	return removeZeroCoinsInPlace(merged)
 }
 
 // DenomsSubsetOf returns true if receiver's denom set
@@ -393,7 +420,18 @@ func (coins Coins) Sub(coinsB ...Coin) Coins {
 // negative coin amount was returned.
 // The function panics if `coins` or  `coinsB` are not sorted (ascending).
 func (coins Coins) SafeSub(coinsB ...Coin) (Coins, bool) {
-	diff := coins.safeAdd(NewCoins(coinsB...).negative())
+	if !coins.IsSorted() {
+		panic("Coins (self) must be sorted")
+	}
+
+	// coinsB is sanitized and validated as by NewCoins, without being copied
+	// when it is already a valid set
+	subtrahend := Coins(coinsB)
+	if subtrahend.Validate() != nil {
+		subtrahend = NewCoins(coinsB...)
+	}
+
+	diff := mergeCoins(coins, subtrahend, true)
 	return diff, diff.IsAnyNegative()
 }
 
@@ -587,7 +625,10 @@ func (coins Coins) IsAllGTE(coinsB Coins) bool {
 	}
 
 	for _, coinB := range coinsB {
-		if coinB.Amount.GT(coins.AmountOf(coinB.Denom)) {
+		// coins are looked up directly rather than with AmountOf, which
+		// allocates a zero amount for missing denoms
+		found, coin := coins.Find(coinB.Denom)
+		if (found && coinB.Amount.GT(coin.Amount)) || (!found && coinB.IsPositive()) {
 			return false
 		}
 	}
@@ -778,20 +819,17 @@ func (coins Coins) IsAnyNil() bool {
 	return false
 }
 
-// negative returns a set of coins with all amount negative.
-//
-// TODO: Remove once unsigned integers are used.
-func (coins Coins) negative() Coins {
-	res := make([]Coin, 0, len(coins))
-
+// removeZeroCoinsInPlace removes all zero coins from the given coin set,
+// reusing its backing array.
+func removeZeroCoinsInPlace(coins Coins) Coins {
+	nonZeros := coins[:0]
 	for _, coin := range coins {
-		res = append(res, Coin{
-			Denom:  coin.Denom,
-			Amount: coin.Amount.Neg(),
-		})
+		if !coin.IsZero() {
+			nonZeros = append(nonZeros, coin)
+		}
 	}
 
-	return res
+	return nonZeros
 }
 
 // removeZeroCoins removes all zero coins from the given coin set in-place.
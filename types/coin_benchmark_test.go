@@ -143,3 +143,58 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkCoinsSingleDenom(b *testing.B) {
+	coinsA := NewCoins(NewCoin("stake", math.NewInt(1_000_000)))
+	coinsB := NewCoins(NewCoin("stake", math.NewInt(1_000)))
+
+	b.Run("Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			coinsA.Add(coinsB...)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			coinsA.Sub(coinsB...)
+		}
+	})
+	b.Run("IsAllGTE", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			coinsA.IsAllGTE(coinsB)
+		}
+	})
+}
+
+func BenchmarkCoinsSafeSub(b *testing.B) {
+	benchmarkingFunc := func(numCoinsA, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.Helper()
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)*2))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), math.NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.SafeSub(coinsB...)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 2}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
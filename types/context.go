@@ -13,6 +13,9 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/gaskv"
 	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
+	"github.com/cosmos/cosmos-sdk/types/readonlykv"
 )
 
 // ExecMode defines the execution mode which can be set on a Context.
@@ -61,6 +64,8 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	resourceMeter        *limitkv.Meter
+	readOnly             bool
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -89,6 +94,8 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) ResourceMeter() *limitkv.Meter                 { return c.resourceMeter }
+func (c Context) IsReadOnly() bool                              { return c.readOnly }
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -239,6 +246,20 @@ func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Contex
 	return c
 }
 
+// WithResourceMeter returns a Context with the store accesses of its KVStores
+// accounted by meter, enforcing its limits. A nil meter disables accounting.
+func (c Context) WithResourceMeter(meter *limitkv.Meter) Context {
+	c.resourceMeter = meter
+	return c
+}
+
+// WithReadOnly returns a Context whose KVStore and TransientStore panic with
+// readonlykv.ErrorWriteAttempted when written to.
+func (c Context) WithReadOnly(readOnly bool) Context {
+	c.readOnly = readOnly
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -337,12 +358,26 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return c.limitStore(key, gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return c.limitStore(key, gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig))
+}
+
+// limitStore wraps store with the resource meter of the context, if any, and
+// rejects its writes in read-only mode.
+func (c Context) limitStore(key storetypes.StoreKey, store storetypes.KVStore) storetypes.KVStore {
+	if c.resourceMeter != nil {
+		store = limitkv.NewStore(store, key.Name(), c.resourceMeter)
+	}
+
+	if c.readOnly {
+		store = readonlykv.NewStore(store, key.Name())
+	}
+
+	return store
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
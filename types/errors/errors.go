@@ -140,6 +140,24 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrStoreReadLimit defines an error when a tx exceeds its store reads limit.
+	ErrStoreReadLimit = errorsmod.Register(RootCodespace, 42, "store reads limit exceeded")
+
+	// ErrStoreWriteLimit defines an error when a tx exceeds its store writes limit.
+	ErrStoreWriteLimit = errorsmod.Register(RootCodespace, 43, "store writes limit exceeded")
+
+	// ErrStoreKeysLimit defines an error when a tx exceeds its limit of distinct
+	// store keys touched.
+	ErrStoreKeysLimit = errorsmod.Register(RootCodespace, 44, "store keys limit exceeded")
+
+	// ErrIteratorStepsLimit defines an error when a tx exceeds its store
+	// iterator steps limit.
+	ErrIteratorStepsLimit = errorsmod.Register(RootCodespace, 45, "iterator steps limit exceeded")
+
+	// ErrReadOnlyWrite defines an error when a store is written to in read-only
+	// execution mode.
+	ErrReadOnlyWrite = errorsmod.Register(RootCodespace, 46, "store write in read-only mode")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
@@ -0,0 +1,153 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Metadata keys of the ErrorInfo details of the gRPC status of a ModuleError.
+const (
+	ErrorInfoModule    = "module"
+	ErrorInfoCodespace = "codespace"
+	ErrorInfoCode      = "code"
+)
+
+// ModuleError is an error returned by a module, carrying structured metadata
+// through tx results and query responses up to clients.
+//
+// Its message only holds the safe message given by the module and the
+// description of its registered error, which are deterministic and can be
+// exposed to clients. The messages of the errors it wraps and its debug
+// message, which may hold node-specific or sensitive information, are kept
+// node-side: BaseApp logs them, and they are never part of tx results or query
+// responses.
+type ModuleError struct {
+	module      string
+	safeMessage string
+	debug       string
+	err         error
+}
+
+// NewModuleError returns the error of module wrapping err, which should be or
+// wrap a registered error. safeMessage is returned to clients, while
+// debugMessage is only logged by the node.
+func NewModuleError(module string, err error, safeMessage, debugMessage string) *ModuleError {
+	return &ModuleError{module: module, safeMessage: safeMessage, debug: debugMessage, err: err}
+}
+
+// Module returns the module which returned the error.
+func (e *ModuleError) Module() string { return e.module }
+
+// SafeMessage returns the message of the error exposed to clients.
+func (e *ModuleError) SafeMessage() string { return e.safeMessage }
+
+// DebugMessage returns the node-side message of the error, holding its debug
+// message and the message of the error it wraps.
+func (e *ModuleError) DebugMessage() string {
+	if e.debug == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.debug, e.err.Error())
+}
+
+// Error implements error, returning the safe message of the error and the
+// description of its registered error.
+func (e *ModuleError) Error() string {
+	desc := "internal"
+	if root := rootError(e.err); root != nil {
+		desc = root.Error()
+	}
+
+	if e.safeMessage == "" {
+		return desc
+	}
+	return fmt.Sprintf("%s: %s", e.safeMessage, desc)
+}
+
+// Cause returns the wrapped error, so that the ABCI code and codespace of the
+// registered error are used in ABCI responses.
+func (e *ModuleError) Cause() error { return e.err }
+
+// Unwrap implements errors.Unwrap.
+func (e *ModuleError) Unwrap() error { return e.err }
+
+// GRPCStatus returns the gRPC status of the error, whose code is the gRPC code
+// of its registered error and whose details hold an ErrorInfo with the module,
+// codespace and ABCI code of the error.
+func (e *ModuleError) GRPCStatus() *grpcstatus.Status {
+	code := codes.Unknown
+	if root := rootError(e.err); root != nil {
+		code = root.GRPCStatus().Code()
+	}
+
+	codespace, abciCode, _ := errorsmod.ABCIInfo(e.err, false)
+	status := grpcstatus.New(code, e.Error())
+	withDetails, err := status.WithDetails(&errdetails.ErrorInfo{
+		Reason: fmt.Sprintf("%s:%d", codespace, abciCode),
+		Domain: e.module,
+		Metadata: map[string]string{
+			ErrorInfoModule:    e.module,
+			ErrorInfoCodespace: codespace,
+			ErrorInfoCode:      strconv.FormatUint(uint64(abciCode), 10),
+		},
+	})
+	if err != nil {
+		return status
+	}
+	return withDetails
+}
+
+// ModuleErrorFromGRPC returns the ModuleError carried by the gRPC status of
+// err, as received by clients, or false if err has no such status. Registered
+// errors are mapped back to their canonical error, so that errors.Is can be
+// used on the returned error.
+func ModuleErrorFromGRPC(err error) (*ModuleError, bool) {
+	status, ok := grpcstatus.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	for _, detail := range status.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+
+		codespace, hasCodespace := info.Metadata[ErrorInfoCodespace]
+		code, err := strconv.ParseUint(info.Metadata[ErrorInfoCode], 10, 32)
+		if !hasCodespace || err != nil {
+			continue
+		}
+
+		cause := errorsmod.ABCIError(codespace, uint32(code), status.Message())
+		safeMessage := status.Message()
+		if root := rootError(cause); root != nil {
+			safeMessage = strings.TrimSuffix(strings.TrimSuffix(safeMessage, root.Error()), ": ")
+		}
+
+		return &ModuleError{
+			module:      info.Metadata[ErrorInfoModule],
+			safeMessage: safeMessage,
+			err:         cause,
+		}, true
+	}
+
+	return nil, false
+}
+
+// rootError returns the registered error wrapped by err, if any.
+func rootError(err error) *errorsmod.Error {
+	var root *errorsmod.Error
+	if errors.As(err, &root) {
+		return root
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestModuleError(t *testing.T) {
+	err := sdkerrors.NewModuleError("bank", errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "balance of node-local account"), "cannot send coins", "sender balance 10stake")
+	require.Equal(t, "cannot send coins: insufficient funds", err.Error())
+	require.Equal(t, "sender balance 10stake: balance of node-local account: insufficient funds", err.DebugMessage())
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// ABCI responses only hold the safe message, with the registered code
+	res := sdkerrors.ResponseExecTxResultWithEvents(errorsmod.Wrap(err, "failed to execute message"), 0, 0, nil, false)
+	require.Equal(t, sdkerrors.RootCodespace, res.Codespace)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.ABCICode(), res.Code)
+	require.Equal(t, "failed to execute message: cannot send coins: insufficient funds", res.Log)
+
+	// gRPC clients get the metadata of the error back from its status
+	decoded, ok := sdkerrors.ModuleErrorFromGRPC(err.GRPCStatus().Err())
+	require.True(t, ok)
+	require.Equal(t, "bank", decoded.Module())
+	require.Equal(t, "cannot send coins", decoded.SafeMessage())
+	require.Equal(t, err.Error(), decoded.Error())
+	require.ErrorIs(t, decoded, sdkerrors.ErrInsufficientFunds)
+
+	_, ok = sdkerrors.ModuleErrorFromGRPC(sdkerrors.ErrInsufficientFunds)
+	require.False(t, ok)
+}
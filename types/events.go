@@ -155,6 +155,55 @@ func ParseTypedEvent(event abci.Event) (proto.Message, error) {
 	return protoMsg, nil
 }
 
+// ParseTypedEvents converts the typed events among events, e.g. the events of
+// a TxResponse, back to typed events, in order. Events which are not typed
+// events, i.e. whose type is not a registered proto message, are skipped.
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	var tevs []proto.Message
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		tev, err := ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		tevs = append(tevs, tev)
+	}
+
+	return tevs, nil
+}
+
+// GetTypedEvents returns the typed events of type T among events, e.g. the
+// events of a TxResponse, in order. Since the type of typed events is the full
+// name of their proto message, which includes its package version, events of
+// another version of T are not returned, and fields unknown to T are ignored.
+func GetTypedEvents[T proto.Message](events []abci.Event) ([]T, error) {
+	var zero T
+	name := proto.MessageName(zero)
+
+	var tevs []T
+	for _, event := range events {
+		if event.Type != name {
+			continue
+		}
+
+		tev, err := ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		t, ok := tev.(T)
+		if !ok {
+			return nil, fmt.Errorf("typed event %q is a %T, not a %T", name, tev, zero)
+		}
+		tevs = append(tevs, t)
+	}
+
+	return tevs, nil
+}
+
 // ----------------------------------------------------------------------------
 // Events
 // ----------------------------------------------------------------------------
@@ -259,6 +308,8 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyTip             = "tip"
+	AttributeKeyTipper          = "tipper"
 
 	EventTypeMessage = "message"
 
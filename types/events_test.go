@@ -134,6 +134,36 @@ func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	s.Require().Equal(hasAnimal.Animal.String(), response.Animal.String())
 }
 
+func (s *eventsTestSuite) TestGetTypedEvents() {
+	em := sdk.NewEventManager()
+
+	coin1 := sdk.NewCoin("fakedenom", math.NewInt(1))
+	coin2 := sdk.NewCoin("fakedenom", math.NewInt(2))
+	cat := testdata.Cat{Moniker: "Garfield", Lives: 6}
+
+	s.Require().NoError(em.EmitTypedEvent(&coin1))
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", "1fakedenom")))
+	s.Require().NoError(em.EmitTypedEvents(&cat, &coin2))
+	events := em.Events().ToABCIEvents()
+
+	// events which are not typed events are skipped
+	tevs, err := sdk.ParseTypedEvents(events)
+	s.Require().NoError(err)
+	s.Require().Len(tevs, 3)
+	s.Require().Equal(cat.String(), tevs[1].String())
+
+	coins, err := sdk.GetTypedEvents[*sdk.Coin](events)
+	s.Require().NoError(err)
+	s.Require().Len(coins, 2)
+	s.Require().Equal(coin1.String(), coins[0].String())
+	s.Require().Equal(coin2.String(), coins[1].String())
+
+	cats, err := sdk.GetTypedEvents[*testdata.Cat](events)
+	s.Require().NoError(err)
+	s.Require().Len(cats, 1)
+	s.Require().Equal(cat.Moniker, cats[0].Moniker)
+}
+
 func (s *eventsTestSuite) TestStringifyEvents() {
 	cases := []struct {
 		name       string
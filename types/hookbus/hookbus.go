@@ -0,0 +1,262 @@
+/*
+Package hookbus implements a typed hook bus, through which modules publish
+events on topics to which other modules subscribe, replacing the per-module
+hooks interfaces and the N×M wiring they require in app construction.
+
+A module publishing events declares a topic per event type:
+
+	var AfterValidatorCreated = hookbus.NewTopic[ValidatorCreated]("staking/after_validator_created")
+
+and publishes events on it through the bus it was given:
+
+	err := hookbus.Publish(ctx, k.bus, types.AfterValidatorCreated, types.ValidatorCreated{ValAddr: valAddr})
+
+Modules subscribe to the topics they handle, with an error policy:
+
+	hookbus.Subscribe(bus, stakingtypes.AfterValidatorCreated, distrtypes.ModuleName, k.AfterValidatorCreated, hookbus.Abort)
+
+Subscribers are called in subscription order, unless the app declares the order
+of the subscribers of a topic with SetOrder, similarly to the order of the
+begin and end blockers of the module manager. Apps call Validate once the bus
+is wired.
+
+Existing hooks interfaces can be migrated progressively: a module publishes
+its topics from an implementation of its hooks interface, registered as its
+hooks, while subscribers move to the bus.
+*/
+package hookbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Topic is a named topic of events of type E.
+type Topic[E any] struct {
+	name string
+}
+
+// NewTopic returns the topic named name, which must be unique in the app.
+// Names are conventionally prefixed by the name of the publishing module.
+func NewTopic[E any](name string) Topic[E] {
+	return Topic[E]{name: name}
+}
+
+// Name returns the name of the topic.
+func (t Topic[E]) Name() string {
+	return t.name
+}
+
+// ErrorPolicy defines how the error of a subscriber is handled.
+type ErrorPolicy uint8
+
+const (
+	// Abort returns the error of the subscriber to the publisher, without
+	// calling the subsequent subscribers.
+	Abort ErrorPolicy = iota
+	// Continue calls the subsequent subscribers, and returns the errors of all
+	// subscribers to the publisher once all of them were called.
+	Continue
+	// Isolate runs the subscriber in a cached context whose writes are
+	// discarded if it fails, and logs its error instead of returning it: the
+	// publisher never fails because of the subscriber.
+	Isolate
+)
+
+// String implements fmt.Stringer.
+func (p ErrorPolicy) String() string {
+	switch p {
+	case Abort:
+		return "abort"
+	case Continue:
+		return "continue"
+	case Isolate:
+		return "isolate"
+	default:
+		return fmt.Sprintf("ErrorPolicy(%d)", uint8(p))
+	}
+}
+
+type subscriber struct {
+	module  string
+	policy  ErrorPolicy
+	handler func(ctx context.Context, event any) error
+}
+
+// Bus dispatches the events published on topics to their subscribers. It is
+// built and wired during app construction, and only read afterwards.
+type Bus struct {
+	mu          sync.RWMutex
+	topics      map[string]string // topic name -> event type
+	subscribers map[string][]subscriber
+	orders      map[string][]string
+}
+
+// NewBus returns an empty bus.
+func NewBus() *Bus {
+	return &Bus{
+		topics:      make(map[string]string),
+		subscribers: make(map[string][]subscriber),
+		orders:      make(map[string][]string),
+	}
+}
+
+// Subscribe subscribes module to topic with handler. It panics if module
+// already subscribed to topic, or if another topic with the same name has a
+// different event type.
+func Subscribe[E any](bus *Bus, topic Topic[E], module string, handler func(ctx context.Context, event E) error, policy ErrorPolicy) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.registerTopic(topic.name, reflect.TypeOf((*E)(nil)).Elem().String())
+	for _, sub := range bus.subscribers[topic.name] {
+		if sub.module == module {
+			panic(fmt.Sprintf("module %s already subscribed to topic %s", module, topic.name))
+		}
+	}
+
+	bus.subscribers[topic.name] = append(bus.subscribers[topic.name], subscriber{
+		module: module,
+		policy: policy,
+		handler: func(ctx context.Context, event any) error {
+			return handler(ctx, event.(E))
+		},
+	})
+}
+
+// Publish calls the subscribers of topic with event, in order, handling their
+// errors according to their error policy.
+func Publish[E any](ctx context.Context, bus *Bus, topic Topic[E], event E) error {
+	bus.mu.RLock()
+	subscribers := bus.orderedSubscribers(topic.name)
+	bus.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subscribers {
+		switch sub.policy {
+		case Isolate:
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			cacheCtx, write := sdkCtx.CacheContext()
+			if err := sub.handler(cacheCtx, event); err != nil {
+				sdkCtx.Logger().Error("hook subscriber failed", "topic", topic.name, "module", sub.module, "err", err)
+				continue
+			}
+			write()
+
+		case Continue:
+			if err := sub.handler(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", sub.module, err))
+			}
+
+		default:
+			if err := sub.handler(ctx, event); err != nil {
+				return fmt.Errorf("%s: %w", sub.module, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetOrder sets the order in which the subscribers of topic are called.
+// modules must list every subscriber of topic once the bus is wired, which
+// Validate checks.
+func (b *Bus) SetOrder(topic string, modules ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.orders[topic] = modules
+}
+
+// Topics returns the subscribed topics and the modules subscribed to each, in
+// the order they are called.
+func (b *Bus) Topics() map[string][]string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	topics := make(map[string][]string, len(b.subscribers))
+	for topic := range b.subscribers {
+		for _, sub := range b.orderedSubscribers(topic) {
+			topics[topic] = append(topics[topic], sub.module)
+		}
+	}
+
+	return topics
+}
+
+// Validate returns an error if the order of the subscribers of a topic was set
+// but doesn't list exactly its subscribers.
+func (b *Bus) Validate() error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for topic, order := range b.orders {
+		listed := make(map[string]bool, len(order))
+		for _, module := range order {
+			if listed[module] {
+				return fmt.Errorf("topic %s: module %s is ordered twice", topic, module)
+			}
+			listed[module] = true
+		}
+
+		for _, sub := range b.subscribers[topic] {
+			if !listed[sub.module] {
+				return fmt.Errorf("topic %s: subscriber %s is not ordered", topic, sub.module)
+			}
+			delete(listed, sub.module)
+		}
+
+		for _, module := range order {
+			if listed[module] {
+				return fmt.Errorf("topic %s: module %s is ordered but not subscribed", topic, module)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *Bus) registerTopic(name, eventType string) {
+	if registered, ok := b.topics[name]; ok && registered != eventType {
+		panic(fmt.Sprintf("topic %s has event type %s, not %s", name, registered, eventType))
+	}
+
+	b.topics[name] = eventType
+}
+
+// orderedSubscribers returns the subscribers of topic in the order they are
+// called: the declared order, or else the subscription order. Subscribers
+// missing from the declared order, which Validate reports, are called last.
+func (b *Bus) orderedSubscribers(topic string) []subscriber {
+	subscribers := b.subscribers[topic]
+	order, ok := b.orders[topic]
+	if !ok {
+		return subscribers
+	}
+
+	ordered := make([]subscriber, 0, len(subscribers))
+	for _, module := range order {
+		for _, sub := range subscribers {
+			if sub.module == module {
+				ordered = append(ordered, sub)
+			}
+		}
+	}
+
+	for _, sub := range subscribers {
+		found := false
+		for _, module := range order {
+			found = found || sub.module == module
+		}
+		if !found {
+			ordered = append(ordered, sub)
+		}
+	}
+
+	return ordered
+}
@@ -0,0 +1,100 @@
+package hookbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/hookbus"
+)
+
+type validatorCreated struct {
+	moniker string
+}
+
+var (
+	afterValidatorCreated = hookbus.NewTopic[validatorCreated]("staking/after_validator_created")
+	errHook               = errors.New("hook failed")
+)
+
+func TestPublishOrder(t *testing.T) {
+	bus := hookbus.NewBus()
+
+	var calls []string
+	subscriber := func(module string) func(context.Context, validatorCreated) error {
+		return func(_ context.Context, event validatorCreated) error {
+			calls = append(calls, module+":"+event.moniker)
+			return nil
+		}
+	}
+
+	hookbus.Subscribe(bus, afterValidatorCreated, "distribution", subscriber("distribution"), hookbus.Abort)
+	hookbus.Subscribe(bus, afterValidatorCreated, "slashing", subscriber("slashing"), hookbus.Abort)
+	require.Panics(t, func() {
+		hookbus.Subscribe(bus, afterValidatorCreated, "slashing", subscriber("slashing"), hookbus.Abort)
+	})
+	require.Panics(t, func() {
+		hookbus.Subscribe(bus, hookbus.NewTopic[string](afterValidatorCreated.Name()), "gov", func(context.Context, string) error { return nil }, hookbus.Abort)
+	})
+
+	// subscribers are called in subscription order by default
+	require.NoError(t, hookbus.Publish(context.Background(), bus, afterValidatorCreated, validatorCreated{moniker: "val"}))
+	require.Equal(t, []string{"distribution:val", "slashing:val"}, calls)
+
+	calls = nil
+	bus.SetOrder(afterValidatorCreated.Name(), "slashing", "distribution")
+	require.NoError(t, bus.Validate())
+	require.NoError(t, hookbus.Publish(context.Background(), bus, afterValidatorCreated, validatorCreated{moniker: "val"}))
+	require.Equal(t, []string{"slashing:val", "distribution:val"}, calls)
+	require.Equal(t, map[string][]string{afterValidatorCreated.Name(): {"slashing", "distribution"}}, bus.Topics())
+
+	bus.SetOrder(afterValidatorCreated.Name(), "slashing")
+	require.ErrorContains(t, bus.Validate(), "subscriber distribution is not ordered")
+	bus.SetOrder(afterValidatorCreated.Name(), "slashing", "distribution", "gov")
+	require.ErrorContains(t, bus.Validate(), "module gov is ordered but not subscribed")
+}
+
+func TestPublishErrorPolicies(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	failing := func(ctx context.Context, _ validatorCreated) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(key).Set([]byte("failing"), []byte{1})
+		return errHook
+	}
+	var called bool
+	succeeding := func(ctx context.Context, _ validatorCreated) error {
+		called = true
+		sdk.UnwrapSDKContext(ctx).KVStore(key).Set([]byte("succeeding"), []byte{1})
+		return nil
+	}
+
+	// abort stops at the first error
+	bus := hookbus.NewBus()
+	hookbus.Subscribe(bus, afterValidatorCreated, "a", failing, hookbus.Abort)
+	hookbus.Subscribe(bus, afterValidatorCreated, "b", succeeding, hookbus.Abort)
+	require.ErrorIs(t, hookbus.Publish(ctx, bus, afterValidatorCreated, validatorCreated{}), errHook)
+	require.False(t, called)
+
+	// continue calls all subscribers, then returns the errors
+	bus = hookbus.NewBus()
+	hookbus.Subscribe(bus, afterValidatorCreated, "a", failing, hookbus.Continue)
+	hookbus.Subscribe(bus, afterValidatorCreated, "b", succeeding, hookbus.Abort)
+	require.ErrorIs(t, hookbus.Publish(ctx, bus, afterValidatorCreated, validatorCreated{}), errHook)
+	require.True(t, called)
+
+	// isolate discards the writes and the error of the failing subscriber
+	ctx = testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	bus = hookbus.NewBus()
+	hookbus.Subscribe(bus, afterValidatorCreated, "a", failing, hookbus.Isolate)
+	hookbus.Subscribe(bus, afterValidatorCreated, "b", succeeding, hookbus.Isolate)
+	require.NoError(t, hookbus.Publish(ctx, bus, afterValidatorCreated, validatorCreated{}))
+	require.False(t, ctx.KVStore(key).Has([]byte("failing")))
+	require.True(t, ctx.KVStore(key).Has([]byte("succeeding")))
+}
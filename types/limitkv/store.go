@@ -0,0 +1,193 @@
+package limitkv
+
+import (
+	"fmt"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Limits defines caps on the store accesses of a single transaction. A zero
+// value disables the corresponding cap.
+type Limits struct {
+	// MaxReads is the maximum number of Get and Has calls.
+	MaxReads uint64
+	// MaxWrites is the maximum number of Set and Delete calls.
+	MaxWrites uint64
+	// MaxKeys is the maximum number of distinct keys read or written, across
+	// all stores. Keys visited by iterators are accounted as iterator steps.
+	MaxKeys uint64
+	// MaxIteratorSteps is the maximum number of iterator seeks, counting the
+	// creation of an iterator and each call to Next.
+	MaxIteratorSteps uint64
+}
+
+// IsZero returns true if no cap is set.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// Usage reports the store accesses accounted by a Meter.
+type Usage struct {
+	Reads         uint64
+	Writes        uint64
+	Keys          uint64
+	IteratorSteps uint64
+}
+
+// ErrorLimitExceeded is the panic value raised by a Store when a limit is
+// exceeded, similarly to storetypes.ErrorOutOfGas. It is recovered by baseapp,
+// which fails the transaction with Err.
+type ErrorLimitExceeded struct {
+	Err   *errorsmod.Error
+	Limit uint64
+}
+
+func (e ErrorLimitExceeded) Error() string {
+	return fmt.Sprintf("%s: limit %d", e.Err, e.Limit)
+}
+
+// Meter accounts the store accesses of a transaction against its Limits. It is
+// not safe for concurrent use, like the gas meter.
+type Meter struct {
+	limits Limits
+	usage  Usage
+	keys   map[string]struct{}
+}
+
+// NewMeter returns a Meter enforcing limits.
+func NewMeter(limits Limits) *Meter {
+	return &Meter{limits: limits, keys: make(map[string]struct{})}
+}
+
+// Limits returns the limits enforced by the meter.
+func (m *Meter) Limits() Limits {
+	return m.limits
+}
+
+// Usage returns the store accesses accounted so far.
+func (m *Meter) Usage() Usage {
+	return m.usage
+}
+
+func (m *Meter) read(storeKey string, key []byte) {
+	m.usage.Reads++
+	check(m.usage.Reads, m.limits.MaxReads, sdkerrors.ErrStoreReadLimit)
+	m.touch(storeKey, key)
+}
+
+func (m *Meter) write(storeKey string, key []byte) {
+	m.usage.Writes++
+	check(m.usage.Writes, m.limits.MaxWrites, sdkerrors.ErrStoreWriteLimit)
+	m.touch(storeKey, key)
+}
+
+func (m *Meter) iteratorStep() {
+	m.usage.IteratorSteps++
+	check(m.usage.IteratorSteps, m.limits.MaxIteratorSteps, sdkerrors.ErrIteratorStepsLimit)
+}
+
+func (m *Meter) touch(storeKey string, key []byte) {
+	if m.limits.MaxKeys == 0 {
+		return
+	}
+
+	k := storeKey + "/" + string(key)
+	if _, ok := m.keys[k]; ok {
+		return
+	}
+
+	m.keys[k] = struct{}{}
+	m.usage.Keys++
+	check(m.usage.Keys, m.limits.MaxKeys, sdkerrors.ErrStoreKeysLimit)
+}
+
+func check(used, limit uint64, err *errorsmod.Error) {
+	if limit > 0 && used > limit {
+		panic(ErrorLimitExceeded{Err: err, Limit: limit})
+	}
+}
+
+var _ storetypes.KVStore = &Store{}
+
+// Store enforces the limits of a Meter on an underlying KVStore. It implements
+// the KVStore interface.
+type Store struct {
+	parent   storetypes.KVStore
+	storeKey string
+	meter    *Meter
+}
+
+// NewStore returns a reference to a new limit KVStore.
+func NewStore(parent storetypes.KVStore, storeKey string, meter *Meter) *Store {
+	return &Store{
+		parent:   parent,
+		storeKey: storeKey,
+		meter:    meter,
+	}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.meter.read(s.storeKey, key)
+	return s.parent.Get(key)
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.meter.read(s.storeKey, key)
+	return s.parent.Has(key)
+}
+
+// Implements KVStore.
+func (s *Store) Set(key, value []byte) {
+	s.meter.write(s.storeKey, key)
+	s.parent.Set(key, value)
+}
+
+// Implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.meter.write(s.storeKey, key)
+	s.parent.Delete(key)
+}
+
+// Implements KVStore.
+func (s *Store) Iterator(start, end []byte) storetypes.Iterator {
+	s.meter.iteratorStep()
+	return &iterator{Iterator: s.parent.Iterator(start, end), meter: s.meter}
+}
+
+// Implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.meter.iteratorStep()
+	return &iterator{Iterator: s.parent.ReverseIterator(start, end), meter: s.meter}
+}
+
+// Implements KVStore.
+func (s *Store) CacheWrap() storetypes.CacheWrap {
+	panic("cannot CacheWrap a limit KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	panic("cannot CacheWrapWithTrace a limit KVStore")
+}
+
+type iterator struct {
+	storetypes.Iterator
+	meter *Meter
+}
+
+// Next implements the Iterator interface, accounting an iterator step.
+func (it *iterator) Next() {
+	it.meter.iteratorStep()
+	it.Iterator.Next()
+}
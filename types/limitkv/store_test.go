@@ -0,0 +1,89 @@
+package limitkv_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/limitkv"
+)
+
+func requireLimitExceeded(t *testing.T, expErr error, f func()) {
+	t.Helper()
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+
+		err, ok := r.(limitkv.ErrorLimitExceeded)
+		require.True(t, ok)
+		require.ErrorIs(t, err.Err, expErr)
+	}()
+
+	f()
+}
+
+func TestReadsAndWritesLimits(t *testing.T) {
+	meter := limitkv.NewMeter(limitkv.Limits{MaxReads: 2, MaxWrites: 2})
+	st := limitkv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "bank", meter)
+
+	st.Set([]byte("a"), []byte("1"))
+	st.Delete([]byte("a"))
+	requireLimitExceeded(t, sdkerrors.ErrStoreWriteLimit, func() { st.Set([]byte("b"), []byte("2")) })
+
+	require.Nil(t, st.Get([]byte("a")))
+	require.False(t, st.Has([]byte("a")))
+	requireLimitExceeded(t, sdkerrors.ErrStoreReadLimit, func() { st.Get([]byte("a")) })
+
+	require.Equal(t, limitkv.Usage{Reads: 3, Writes: 3}, meter.Usage())
+}
+
+func TestKeysLimit(t *testing.T) {
+	meter := limitkv.NewMeter(limitkv.Limits{MaxKeys: 2})
+	bank := limitkv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "bank", meter)
+	staking := limitkv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "staking", meter)
+
+	// touching the same key again is free
+	bank.Set([]byte("a"), []byte("1"))
+	bank.Get([]byte("a"))
+	bank.Has([]byte("a"))
+	require.Equal(t, uint64(1), meter.Usage().Keys)
+
+	// the same key in another store is distinct
+	staking.Get([]byte("a"))
+	requireLimitExceeded(t, sdkerrors.ErrStoreKeysLimit, func() { bank.Get([]byte("b")) })
+}
+
+func TestIteratorStepsLimit(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, k := range []string{"a", "b", "c", "d"} {
+		mem.Set([]byte(k), []byte(k))
+	}
+
+	meter := limitkv.NewMeter(limitkv.Limits{MaxIteratorSteps: 4})
+	st := limitkv.NewStore(mem, "bank", meter)
+
+	it := st.Iterator(nil, nil)
+	var keys []string
+	requireLimitExceeded(t, sdkerrors.ErrIteratorStepsLimit, func() {
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, string(it.Key()))
+		}
+	})
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	require.Equal(t, uint64(5), meter.Usage().IteratorSteps)
+
+	// unlimited meter
+	meter = limitkv.NewMeter(limitkv.Limits{})
+	st = limitkv.NewStore(mem, "bank", meter)
+	it = st.ReverseIterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, uint64(5), meter.Usage().IteratorSteps)
+}
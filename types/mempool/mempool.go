@@ -40,4 +40,5 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrMempoolSenderMaxTxs  = errors.New("sender reached max txs in pool")
 )
@@ -41,6 +41,12 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// MaxTxPerSender caps the number of transactions of a single sender in the
+		// mempool, so that an account cannot monopolize it. A tx with a new nonce
+		// of a sender already at the cap is rejected, while a tx replacing one of
+		// its txs is not. If MaxTxPerSender <= 0 there is no cap.
+		MaxTxPerSender int
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -219,7 +225,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	nonce := sig.Sequence
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
+	sk := txMeta[C]{nonce: nonce, sender: sender}
 	senderIndex, ok := mp.senderIndices[sender]
+	if _, txExists := mp.scores[sk]; !txExists && ok && mp.cfg.MaxTxPerSender > 0 &&
+		senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+		return ErrMempoolSenderMaxTxs
+	}
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
 			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
@@ -236,7 +247,6 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
@@ -0,0 +1,36 @@
+package mempool
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ReplaceByFee returns a tx replacement rule, for SenderNonceTxReplacementOpt,
+// admitting a tx replacing the tx of the same sender and nonce only if it pays
+// a higher fee: at least minBumpPercent percent more in each denom of the fee
+// of the replaced tx, and not the same fee. Txs which are not sdk.FeeTx never
+// replace one another.
+//
+// It can be used as the TxReplacement of a PriorityNonceMempoolConfig with:
+//
+//	rbf := ReplaceByFee(10)
+//	cfg.TxReplacement = func(_, _ int64, oTx, nTx sdk.Tx) bool { return rbf(oTx, nTx) }
+func ReplaceByFee(minBumpPercent uint64) func(oTx, nTx sdk.Tx) bool {
+	return func(oTx, nTx sdk.Tx) bool {
+		oFeeTx, ok := oTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+		nFeeTx, ok := nTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		oldFee, newFee := oFeeTx.GetFee(), nFeeTx.GetFee()
+		minFee := make(sdk.Coins, 0, len(oldFee))
+		for _, coin := range oldFee {
+			// round the bumped amount up, so that a bump is never free
+			bumped := coin.Amount.MulRaw(int64(100 + minBumpPercent)).AddRaw(99).QuoRaw(100)
+			minFee = append(minFee, sdk.NewCoin(coin.Denom, bumped))
+		}
+
+		return newFee.IsAllGTE(minFee) && !newFee.Equal(oldFee)
+	}
+}
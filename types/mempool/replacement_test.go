@@ -0,0 +1,85 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// feeTx is a testTx paying a fee.
+type feeTx struct {
+	testTx
+	fee sdk.Coins
+}
+
+func (tx feeTx) GetGas() uint64 { return 0 }
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTx) FeeGranter() []byte { return nil }
+
+func TestSenderNonceMempool_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	withFee := func(id int, amount int64) feeTx {
+		return feeTx{testTx: testTx{id: id, nonce: 1, address: sa}, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", amount))}
+	}
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceTxReplacementOpt(mempool.ReplaceByFee(10)))
+	require.NoError(t, mp.Insert(ctx, withFee(0, 100)))
+
+	// the same fee, and a fee less than 10% higher, do not replace the tx
+	require.Error(t, mp.Insert(ctx, withFee(1, 100)))
+	require.Error(t, mp.Insert(ctx, withFee(2, 109)))
+	require.Equal(t, withFee(0, 100), mp.NextSenderTx(sa.String()))
+
+	require.NoError(t, mp.Insert(ctx, withFee(3, 110)))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, withFee(3, 110), mp.NextSenderTx(sa.String()))
+
+	// a fee in another denom does not replace the tx
+	other := withFee(4, 1000)
+	other.fee = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	require.Error(t, mp.Insert(ctx, other))
+}
+
+func TestMempool_MaxTxPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mempools := map[string]mempool.Mempool{
+		"sender nonce": mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxPerSenderOpt(2)),
+		"priority nonce": mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:     mempool.NewDefaultTxPriority(),
+			MaxTxPerSender: 2,
+		}),
+	}
+	for name, mp := range mempools {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, mp.Insert(ctx, testTx{id: 0, nonce: 0, address: sa}))
+			require.NoError(t, mp.Insert(ctx, testTx{id: 1, nonce: 1, address: sa}))
+			require.ErrorIs(t, mp.Insert(ctx, testTx{id: 2, nonce: 2, address: sa}), mempool.ErrMempoolSenderMaxTxs)
+
+			// a replacement and the txs of other senders are still accepted
+			require.NoError(t, mp.Insert(ctx, testTx{id: 3, nonce: 1, address: sa}))
+			require.NoError(t, mp.Insert(ctx, testTx{id: 4, nonce: 0, address: sb}))
+			require.Equal(t, 3, mp.CountTx())
+
+			// removing a tx of the sender makes room for a new one
+			require.NoError(t, mp.Remove(testTx{id: 0, nonce: 0, address: sa}))
+			require.NoError(t, mp.Insert(ctx, testTx{id: 2, nonce: 2, address: sa}))
+			require.Equal(t, 3, mp.CountTx())
+		})
+	}
+}
@@ -38,6 +38,9 @@ type SenderNonceMempool struct {
 	rnd        *rand.Rand
 	maxTx      int
 	existingTx map[txKey]bool
+
+	maxTxPerSender int
+	txReplacement  func(oTx, nTx sdk.Tx) bool
 }
 
 type SenderNonceOptions func(*SenderNonceMempool)
@@ -98,6 +101,33 @@ func SenderNonceMaxTxOpt(maxTx int) SenderNonceOptions {
 	}
 }
 
+// SenderNonceMaxTxPerSenderOpt Option To cap the number of txs of a single
+// sender when calling the constructor NewSenderNonceMempool. A tx with a new
+// nonce of a sender already at the cap is rejected, while a tx replacing one of
+// its txs is not. If maxTxPerSender <= 0 there is no cap.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceMaxTxPerSenderOpt(16))
+func SenderNonceMaxTxPerSenderOpt(maxTxPerSender int) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.maxTxPerSender = maxTxPerSender
+	}
+}
+
+// SenderNonceTxReplacementOpt Option To set the rule a tx must fit to replace
+// the tx of the same sender and nonce when calling the constructor
+// NewSenderNonceMempool. Without it, such a tx always replaces the existing one.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceTxReplacementOpt(ReplaceByFee(10)))
+func SenderNonceTxReplacementOpt(txReplacement func(oTx, nTx sdk.Tx) bool) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.txReplacement = txReplacement
+	}
+}
+
 func (snm *SenderNonceMempool) setSeed(seed int64) {
 	s1 := rand.NewSource(seed)
 	snm.rnd = rand.New(s1) //#nosec // math/rand is seeded from crypto/rand by default
@@ -146,6 +176,14 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		snm.senders[sender] = senderTxs
 	}
 
+	if existing := senderTxs.Get(nonce); existing != nil {
+		if snm.txReplacement != nil && !snm.txReplacement(existing.Value.(sdk.Tx), tx) {
+			return fmt.Errorf("tx doesn't fit the replacement rule, sender: %s, nonce: %d", sender, nonce)
+		}
+	} else if snm.maxTxPerSender > 0 && senderTxs.Len() >= snm.maxTxPerSender {
+		return ErrMempoolSenderMaxTxs
+	}
+
 	senderTxs.Set(nonce, tx)
 
 	key := txKey{nonce: nonce, address: sender}
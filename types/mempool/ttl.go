@@ -0,0 +1,190 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ExpiringMempool = (*TTLMempool)(nil)
+
+// The reasons for which a tx expires.
+const (
+	ExpiryMaxBlocks     = "max_blocks"
+	ExpiryMaxAge        = "max_age"
+	ExpiryTimeoutHeight = "timeout_height"
+)
+
+// ExpiringMempool is a mempool whose txs expire, evicted with EvictExpired.
+// BaseApp evicts the expired txs after each commit.
+type ExpiringMempool interface {
+	Mempool
+
+	// EvictExpired removes the txs expired at the height and block time of
+	// ctx and returns them.
+	EvictExpired(ctx context.Context) []ExpiredTx
+}
+
+// ExpiredTx is a tx evicted from an ExpiringMempool, and the reason it
+// expired.
+type ExpiredTx struct {
+	Tx     sdk.Tx
+	Reason string
+}
+
+// TTLConfig defines how long txs stay in a TTLMempool.
+type TTLConfig struct {
+	// MaxBlocks is the number of blocks after which a tx expires, 0 to never
+	// expire txs by height.
+	MaxBlocks int64
+	// MaxAge is the duration of block time after which a tx expires, 0 to never
+	// expire txs by time.
+	MaxAge time.Duration
+}
+
+// TTLMempool wraps a mempool so that its txs expire MaxBlocks blocks or MaxAge
+// after their insertion, whichever comes first, or once their timeout height
+// is reached. The insertion height and time of a tx are those of the context
+// of Insert, i.e. of the latest committed block when inserted by CheckTx, so
+// that expiry only depends on the chain.
+//
+// Txs are identified by their first signer and its sequence, as in the
+// SenderNonceMempool and PriorityNonceMempool, a tx replacing another
+// restarting its TTL.
+type TTLMempool struct {
+	Mempool
+
+	mtx     sync.Mutex
+	cfg     TTLConfig
+	entries map[txKey]ttlEntry
+}
+
+type ttlEntry struct {
+	tx     sdk.Tx
+	height int64
+	time   time.Time
+}
+
+// NewTTLMempool returns mp with the txs expiring as configured by cfg.
+func NewTTLMempool(mp Mempool, cfg TTLConfig) *TTLMempool {
+	return &TTLMempool{
+		Mempool: mp,
+		cfg:     cfg,
+		entries: make(map[txKey]ttlEntry),
+	}
+}
+
+// Insert inserts tx in the wrapped mempool, recording its insertion height and
+// time.
+func (mp *TTLMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	key, err := ttlTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.Mempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.entries[key] = ttlEntry{tx: tx, height: sdkCtx.BlockHeight(), time: sdkCtx.BlockHeader().Time}
+
+	return nil
+}
+
+// Remove removes tx from the wrapped mempool.
+func (mp *TTLMempool) Remove(tx sdk.Tx) error {
+	key, err := ttlTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	delete(mp.entries, key)
+	mp.mtx.Unlock()
+
+	return mp.Mempool.Remove(tx)
+}
+
+// EvictExpired implements ExpiringMempool. The txs are evicted by sender and
+// nonce.
+func (mp *TTLMempool) EvictExpired(ctx context.Context) []ExpiredTx {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, blockTime := sdkCtx.BlockHeight(), sdkCtx.BlockHeader().Time
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	var expired []txKey
+	for key := range mp.entries {
+		if mp.expiry(mp.entries[key], height, blockTime) != "" {
+			expired = append(expired, key)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		if expired[i].address != expired[j].address {
+			return expired[i].address < expired[j].address
+		}
+		return expired[i].nonce < expired[j].nonce
+	})
+
+	evicted := make([]ExpiredTx, 0, len(expired))
+	for _, key := range expired {
+		entry := mp.entries[key]
+		delete(mp.entries, key)
+
+		// the tx may have been removed from the wrapped mempool on its own,
+		// e.g. when evicted for lack of capacity
+		if err := mp.Mempool.Remove(entry.tx); err != nil {
+			continue
+		}
+		evicted = append(evicted, ExpiredTx{Tx: entry.tx, Reason: mp.expiry(entry, height, blockTime)})
+	}
+
+	return evicted
+}
+
+// expiry returns the reason entry is expired at height and blockTime, or an
+// empty string if it is not.
+func (mp *TTLMempool) expiry(entry ttlEntry, height int64, blockTime time.Time) string {
+	if tx, ok := entry.tx.(sdk.TxWithTimeoutHeight); ok {
+		if timeout := tx.GetTimeoutHeight(); timeout > 0 && uint64(height) >= timeout {
+			return ExpiryTimeoutHeight
+		}
+	}
+	if mp.cfg.MaxBlocks > 0 && height-entry.height >= mp.cfg.MaxBlocks {
+		return ExpiryMaxBlocks
+	}
+	if mp.cfg.MaxAge > 0 && blockTime.Sub(entry.time) >= mp.cfg.MaxAge {
+		return ExpiryMaxAge
+	}
+
+	return ""
+}
+
+// ttlTxKey returns the first signer and sequence of tx.
+func ttlTxKey(tx sdk.Tx) (txKey, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return txKey{}, fmt.Errorf("tx of type %T does not have signatures", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return txKey{}, err
+	}
+	if len(sigs) == 0 {
+		return txKey{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	return txKey{address: sdk.AccAddress(sigs[0].PubKey.Address()).String(), nonce: sigs[0].Sequence}, nil
+}
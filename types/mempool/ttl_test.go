@@ -0,0 +1,62 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// timeoutTx is a testTx with a timeout height.
+type timeoutTx struct {
+	testTx
+	timeoutHeight uint64
+}
+
+func (tx timeoutTx) GetTimeoutHeight() uint64 { return tx.timeoutHeight }
+
+func TestTTLMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	start := time.Unix(1_000_000, 0)
+
+	mp := mempool.NewTTLMempool(mempool.NewSenderNonceMempool(), mempool.TTLConfig{
+		MaxBlocks: 10,
+		MaxAge:    time.Minute,
+	})
+	ctxAt := func(height int64, t time.Time) sdk.Context {
+		return sdk.NewContext(nil, false, log.NewNopLogger()).WithBlockHeader(cmtproto.Header{Height: height, Time: t})
+	}
+
+	byHeight := testTx{id: 0, nonce: 0, address: sa}
+	byAge := testTx{id: 1, nonce: 1, address: sa}
+	byTimeout := timeoutTx{testTx: testTx{id: 2, nonce: 0, address: sb}, timeoutHeight: 4}
+	removed := testTx{id: 3, nonce: 1, address: sb}
+
+	require.NoError(t, mp.Insert(ctxAt(1, start), byHeight))
+	require.NoError(t, mp.Insert(ctxAt(5, start.Add(10*time.Second)), byAge))
+	require.NoError(t, mp.Insert(ctxAt(1, start), byTimeout))
+	require.NoError(t, mp.Insert(ctxAt(1, start), removed))
+	require.NoError(t, mp.Remove(removed))
+	require.Equal(t, 3, mp.CountTx())
+
+	require.Empty(t, mp.EvictExpired(ctxAt(3, start.Add(20*time.Second))))
+
+	// the timeout height is reached
+	require.Equal(t, []mempool.ExpiredTx{{Tx: byTimeout, Reason: mempool.ExpiryTimeoutHeight}}, mp.EvictExpired(ctxAt(4, start.Add(25*time.Second))))
+
+	// 10 blocks since the insertion of byHeight
+	require.Equal(t, []mempool.ExpiredTx{{Tx: byHeight, Reason: mempool.ExpiryMaxBlocks}}, mp.EvictExpired(ctxAt(11, start.Add(60*time.Second))))
+
+	// a minute since the insertion of byAge
+	require.Equal(t, []mempool.ExpiredTx{{Tx: byAge, Reason: mempool.ExpiryMaxAge}}, mp.EvictExpired(ctxAt(12, start.Add(70*time.Second))))
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -21,9 +21,10 @@ import (
 var (
 	_ appmodule.AppModule = coreAppModuleBasicAdaptor{}
 
-	_ AppModuleBasic = coreAppModuleBasicAdaptor{}
-	_ HasABCIGenesis = coreAppModuleBasicAdaptor{}
-	_ HasServices    = coreAppModuleBasicAdaptor{}
+	_ AppModuleBasic   = coreAppModuleBasicAdaptor{}
+	_ HasABCIGenesis   = coreAppModuleBasicAdaptor{}
+	_ HasServices      = coreAppModuleBasicAdaptor{}
+	_ HasGenesisChecks = coreAppModuleBasicAdaptor{}
 )
 
 // CoreAppModuleAdaptor wraps the core API module as an AppModule that this version of the SDK can use.
@@ -88,6 +89,15 @@ func (c coreAppModuleBasicAdaptor) ValidateGenesis(cdc codec.JSONCodec, txConfig
 	return nil
 }
 
+// GenesisChecks implements HasGenesisChecks
+func (c coreAppModuleBasicAdaptor) GenesisChecks() []GenesisCheck {
+	if mod, ok := c.module.(HasGenesisChecks); ok {
+		return mod.GenesisChecks()
+	}
+
+	return nil
+}
+
 // ExportGenesis implements HasGenesis
 func (c coreAppModuleBasicAdaptor) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json.RawMessage {
 	if module, ok := c.module.(appmodule.HasGenesis); ok {
@@ -0,0 +1,84 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// GenesisCheck is a consistency check between the genesis states of several
+// modules, e.g. between the staking pools and their bank balances, which
+// cannot be performed by the ValidateGenesis of a single module.
+type GenesisCheck struct {
+	// Name identifies the check in errors.
+	Name string
+	// Modules are the modules whose genesis states are checked. The check is
+	// skipped when the genesis state of any of them is missing.
+	Modules []string
+	// Check validates the genesis states of the modules.
+	Check func(cdc codec.JSONCodec, genesisData map[string]json.RawMessage) error
+}
+
+// HasGenesisChecks is the extension interface for modules registering checks
+// on the consistency of their genesis state with the genesis states of other
+// modules. The checks are run after the per module validation, by the
+// validate-genesis command and before InitGenesis.
+type HasGenesisChecks interface {
+	GenesisChecks() []GenesisCheck
+}
+
+// ValidateGenesisChecks runs the genesis checks of all modules, and returns
+// the errors of all failing checks.
+func (bm BasicManager) ValidateGenesisChecks(cdc codec.JSONCodec, genesisData map[string]json.RawMessage) error {
+	modules := make(map[string]interface{}, len(bm))
+	for name, b := range bm {
+		modules[name] = b
+	}
+
+	return runGenesisChecks(modules, cdc, genesisData)
+}
+
+// ValidateGenesisChecks runs the genesis checks of all modules, and returns
+// the errors of all failing checks.
+func (m *Manager) ValidateGenesisChecks(cdc codec.JSONCodec, genesisData map[string]json.RawMessage) error {
+	modules := make(map[string]interface{}, len(m.Modules))
+	for name, mod := range m.Modules {
+		modules[name] = mod
+	}
+
+	return runGenesisChecks(modules, cdc, genesisData)
+}
+
+func runGenesisChecks(modules map[string]interface{}, cdc codec.JSONCodec, genesisData map[string]json.RawMessage) error {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		mod, ok := modules[name].(HasGenesisChecks)
+		if !ok {
+			continue
+		}
+
+	checks:
+		for _, check := range mod.GenesisChecks() {
+			for _, dep := range check.Modules {
+				if genesisData[dep] == nil {
+					continue checks
+				}
+			}
+
+			if err := check.Check(cdc, genesisData); err != nil {
+				errs = append(errs, fmt.Errorf("%s genesis check %s failed: %w", name, check.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
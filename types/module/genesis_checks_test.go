@@ -0,0 +1,57 @@
+package module_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type genesisChecksModule struct {
+	module.AppModuleBasic
+
+	name   string
+	checks []module.GenesisCheck
+}
+
+func (m genesisChecksModule) Name() string { return m.name }
+
+func (m genesisChecksModule) GenesisChecks() []module.GenesisCheck { return m.checks }
+
+func TestValidateGenesisChecks(t *testing.T) {
+	var ran []string
+	check := func(name string, err error, modules ...string) module.GenesisCheck {
+		return module.GenesisCheck{
+			Name:    name,
+			Modules: modules,
+			Check: func(codec.JSONCodec, map[string]json.RawMessage) error {
+				ran = append(ran, name)
+				return err
+			},
+		}
+	}
+
+	bm := module.NewBasicManager(
+		genesisChecksModule{name: "a", checks: []module.GenesisCheck{
+			check("ok", nil, "a", "b"),
+			check("missing", errors.New("unreachable"), "a", "c"),
+		}},
+		genesisChecksModule{name: "b", checks: []module.GenesisCheck{
+			check("failing", errors.New("inconsistent"), "a", "b"),
+		}},
+	)
+
+	genesisData := map[string]json.RawMessage{
+		"a": json.RawMessage(`{}`),
+		"b": json.RawMessage(`{}`),
+	}
+
+	err := bm.ValidateGenesisChecks(nil, genesisData)
+	require.ErrorContains(t, err, "b genesis check failing failed: inconsistent")
+	require.NotContains(t, err.Error(), "unreachable")
+	require.Equal(t, []string{"ok", "failing"}, ran)
+}
@@ -481,6 +481,10 @@ func (m *Manager) RegisterServices(cfg Configurator) error {
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData map[string]json.RawMessage) (*abci.ResponseInitChain, error) {
 	var validatorUpdates []abci.ValidatorUpdate
 	ctx.Logger().Info("initializing blockchain state from genesis.json")
+	if err := m.ValidateGenesisChecks(cdc, genesisData); err != nil {
+		return &abci.ResponseInitChain{}, err
+	}
+
 	for _, moduleName := range m.OrderInitGenesis {
 		if genesisData[moduleName] == nil {
 			continue
@@ -875,6 +879,15 @@ func (m *Manager) ModuleNames() []string {
 	return maps.Keys(m.Modules)
 }
 
+// ChainMetadataProvider returns a provider filling in the names of the modules
+// in the chain metadata.
+func (m *Manager) ChainMetadataProvider() sdk.ChainMetadataProvider {
+	return func(_ sdk.Context, md *sdk.ChainMetadata) error {
+		md.Modules = append(md.Modules, m.ModuleNames()...)
+		return nil
+	}
+}
+
 // DefaultMigrationsOrder returns a default migrations order: ascending alphabetical by module name,
 // except x/auth which will run last, see:
 // https://github.com/cosmos/cosmos-sdk/issues/10591
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ParamChange is the record of a change of the params of a module, applied by
+// a MsgUpdateParams.
+type ParamChange struct {
+	// Module is the protobuf package of the MsgUpdateParams, e.g.
+	// cosmos.bank.v1beta1.
+	Module string `json:"module"`
+	// Height is the height at which the change was applied.
+	Height int64 `json:"height"`
+	// Authority is the authority of the MsgUpdateParams.
+	Authority string `json:"authority"`
+	// ProposalID is the id of the governance proposal which executed the
+	// MsgUpdateParams, if any.
+	ProposalID uint64 `json:"proposal_id,omitempty"`
+	// Old and New are the JSON encoded params before and after the change. Old
+	// is empty if the module does not serve its params with a Query/Params.
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new"`
+}
+
+type proposalIDContextKey struct{}
+
+// WithProposalID returns a copy of ctx carrying the id of the governance
+// proposal whose messages are executed with it.
+func WithProposalID(ctx Context, proposalID uint64) Context {
+	return ctx.WithValue(proposalIDContextKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns the id of the governance proposal whose
+// messages are executed with ctx, if any.
+func ProposalIDFromContext(ctx context.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDContextKey{}).(uint64)
+	return proposalID, ok
+}
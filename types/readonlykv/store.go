@@ -0,0 +1,81 @@
+package readonlykv
+
+import (
+	"fmt"
+	"io"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ErrorWriteAttempted is the panic value raised by a Store when written to,
+// similarly to storetypes.ErrorOutOfGas. It is recovered by the read-only
+// execution of messages, which fails with sdkerrors.ErrReadOnlyWrite.
+type ErrorWriteAttempted struct {
+	StoreKey string
+}
+
+func (e ErrorWriteAttempted) Error() string {
+	return fmt.Sprintf("write to store %s in read-only mode", e.StoreKey)
+}
+
+var _ storetypes.KVStore = &Store{}
+
+// Store rejects the writes to an underlying KVStore, which can still be read.
+// It implements the KVStore interface.
+type Store struct {
+	parent   storetypes.KVStore
+	storeKey string
+}
+
+// NewStore returns a reference to a new read-only KVStore.
+func NewStore(parent storetypes.KVStore, storeKey string) *Store {
+	return &Store{
+		parent:   parent,
+		storeKey: storeKey,
+	}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Implements KVStore. It panics with ErrorWriteAttempted.
+func (s *Store) Set(_, _ []byte) {
+	panic(ErrorWriteAttempted{StoreKey: s.storeKey})
+}
+
+// Implements KVStore. It panics with ErrorWriteAttempted.
+func (s *Store) Delete(_ []byte) {
+	panic(ErrorWriteAttempted{StoreKey: s.storeKey})
+}
+
+// Implements KVStore.
+func (s *Store) Iterator(start, end []byte) storetypes.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// Implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// Implements KVStore.
+func (s *Store) CacheWrap() storetypes.CacheWrap {
+	panic("cannot CacheWrap a read-only KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	panic("cannot CacheWrapWithTrace a read-only KVStore")
+}
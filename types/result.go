@@ -205,3 +205,12 @@ func calcTotalPages(totalCount, limit int64) int64 {
 	}
 	return totalPages
 }
+
+// StateChange describes a single key written or deleted in a store by a
+// simulated transaction.
+type StateChange struct {
+	StoreKey string `json:"store_key"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value,omitempty"`
+	Delete   bool   `json:"delete,omitempty"`
+}
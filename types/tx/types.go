@@ -233,4 +233,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.HasMsgs)(nil), &Tx{})
 
 	registry.RegisterInterface("cosmos.tx.v1beta1.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
+	// Tip is carried as an extension option of the tx body, see the auth
+	// TipDecorator.
+	registry.RegisterImplementations((*TxExtensionOptionI)(nil), &Tip{})
 }
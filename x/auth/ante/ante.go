@@ -3,6 +3,7 @@ package ante
 import (
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	authsigning "cosmossdk.io/x/auth/signing"
 	"cosmossdk.io/x/auth/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -20,6 +21,12 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// SignatureCache, when set, caches successful signature verifications
+	// between CheckTx and the execution of the block.
+	SignatureCache *authsigning.SignatureCache
+	// Policy, when set, adds the policy decorators it configures to the
+	// AnteHandler.
+	Policy *PolicyConfig
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -38,18 +45,32 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	var policyDecorators []sdk.AnteDecorator
+	if options.Policy != nil {
+		var err error
+		policyDecorators, err = NewPolicyDecorators(*options.Policy, options.AccountKeeper.AddressCodec())
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
+	}
+	anteDecorators = append(anteDecorators, policyDecorators...)
+	anteDecorators = append(anteDecorators,
+		NewTipDecorator(options.AccountKeeper, options.BankKeeper),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewDeferredAccountDecorator(options.BankKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer),
-	}
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer).WithSignatureCache(options.SignatureCache),
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -0,0 +1,64 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DeferredAccountKeeper is implemented by bank keepers able to defer the
+// creation of the accounts of addresses receiving only dust.
+type DeferredAccountKeeper interface {
+	InstantiateDeferredAccount(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// DeferredAccountDecorator creates the accounts of the signers and fee payer of
+// a tx whose creation was deferred by the bank module, so that addresses which
+// received only dust can pay fees and sign txs. It does nothing if the bank
+// keeper doesn't support deferred account creation. It must run before the
+// DeductFeeDecorator.
+// CONTRACT: Tx must implement FeeTx and SigVerifiableTx.
+type DeferredAccountDecorator struct {
+	bk types.BankKeeper
+}
+
+func NewDeferredAccountDecorator(bk types.BankKeeper) DeferredAccountDecorator {
+	return DeferredAccountDecorator{
+		bk: bk,
+	}
+}
+
+func (dad DeferredAccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	dak, ok := dad.bk.(DeferredAccountKeeper)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	addrs := signers
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		addrs = append([][]byte{feeTx.FeePayer()}, signers...)
+	}
+
+	for _, addr := range addrs {
+		if _, err := dak.InstantiateDeferredAccount(ctx, addr); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/keeper"
+	authtestutil "cosmossdk.io/x/auth/testutil"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// deferredBankKeeper is a bank keeper deferring the creation of the accounts
+// of the addresses in balances, as the bank module does for dust.
+type deferredBankKeeper struct {
+	*authtestutil.MockBankKeeper
+
+	ak       keeper.AccountKeeper
+	balances map[string]sdk.Coins
+}
+
+func (k deferredBankKeeper) InstantiateDeferredAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if k.ak.HasAccount(ctx, addr) || k.balances[addr.String()].IsZero() {
+		return false, nil
+	}
+
+	k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+	return true, nil
+}
+
+func TestDeferredAccountDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	// the address holds dust but has no account
+	priv, _, addr := testdata.KeyTestPubAddr()
+	bk := deferredBankKeeper{
+		MockBankKeeper: s.bankKeeper,
+		ak:             s.accountKeeper,
+		balances:       map[string]sdk.Coins{addr.String(): sdk.NewCoins(sdk.NewInt64Coin("atom", 1))},
+	}
+	require.False(t, s.accountKeeper.HasAccount(s.ctx, addr))
+
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	accNum, err := s.accountKeeper.AccountNumber.Peek(s.ctx)
+	require.NoError(t, err)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	spkd := ante.NewSetPubKeyDecorator(s.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(s.accountKeeper, s.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer)
+
+	// without the decorator the signer is unknown
+	_, err = sdk.ChainAnteDecorators(spkd, svd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+
+	// the decorator is a no-op for bank keepers not deferring account creation
+	_, err = sdk.ChainAnteDecorators(ante.NewDeferredAccountDecorator(s.bankKeeper), spkd, svd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+
+	// the account of the signer is created before its signature is verified
+	_, err = sdk.ChainAnteDecorators(ante.NewDeferredAccountDecorator(bk), spkd, svd)(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	acc := s.accountKeeper.GetAccount(s.ctx, addr)
+	require.NotNil(t, acc)
+	require.Equal(t, accNum, acc.GetAccountNumber())
+	require.Equal(t, priv.PubKey(), acc.GetPubKey())
+}
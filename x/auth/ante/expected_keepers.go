@@ -19,6 +19,12 @@ type AccountKeeper interface {
 	AddressCodec() address.Codec
 }
 
+// PubKeyChangeKeeper is implemented by account keepers supporting time-locked
+// public key changes, applied by the SetPubKeyDecorator once their delay elapsed.
+type PubKeyChangeKeeper interface {
+	ApplyPubKeyChange(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -0,0 +1,228 @@
+package ante
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PolicyConfig configures the policy decorators, so that chains assemble the
+// policy of their ante handler from configuration rather than forking
+// NewAnteHandler. Zero values disable the corresponding check.
+type PolicyConfig struct {
+	// AllowedSigners, if not empty, lists the only addresses allowed to sign txs.
+	AllowedSigners []string
+	// DeniedSigners lists the addresses not allowed to sign txs.
+	DeniedSigners []string
+	// DisallowedMsgTypes lists the type URLs of the messages not allowed in txs.
+	DisallowedMsgTypes []string
+	// MaxMsgs is the maximum number of messages of a tx.
+	MaxMsgs int
+	// MaxMsgSize is the maximum size in bytes of each message of a tx.
+	MaxMsgSize int
+	// MinFeePerMsgType maps message type URLs to the minimum fee paid for each
+	// message of that type. A tx must pay at least the sum of the minimum fees
+	// of its messages.
+	MinFeePerMsgType map[string]sdk.Coins
+	// DeniedFeeDenoms lists the denoms which can't be used to pay fees.
+	DeniedFeeDenoms []string
+}
+
+// NewPolicyDecorators returns the decorators enforcing cfg, addresses being
+// decoded with ac. They are meant to run right after the ValidateBasicDecorator,
+// before fees are deducted.
+func NewPolicyDecorators(cfg PolicyConfig, ac address.Codec) ([]sdk.AnteDecorator, error) {
+	var decorators []sdk.AnteDecorator
+
+	if len(cfg.AllowedSigners) > 0 || len(cfg.DeniedSigners) > 0 {
+		allowed, err := decodeAddresses(ac, cfg.AllowedSigners)
+		if err != nil {
+			return nil, err
+		}
+		denied, err := decodeAddresses(ac, cfg.DeniedSigners)
+		if err != nil {
+			return nil, err
+		}
+		decorators = append(decorators, NewSignerPolicyDecorator(allowed, denied))
+	}
+
+	if len(cfg.DisallowedMsgTypes) > 0 {
+		decorators = append(decorators, NewMsgTypeFilterDecorator(cfg.DisallowedMsgTypes...))
+	}
+
+	if cfg.MaxMsgs > 0 || cfg.MaxMsgSize > 0 {
+		decorators = append(decorators, NewMsgLimitsDecorator(cfg.MaxMsgs, cfg.MaxMsgSize))
+	}
+
+	if len(cfg.MinFeePerMsgType) > 0 || len(cfg.DeniedFeeDenoms) > 0 {
+		for typeURL, fee := range cfg.MinFeePerMsgType {
+			if err := fee.Validate(); err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "minimum fee of %s: %s", typeURL, err)
+			}
+		}
+		decorators = append(decorators, NewFeePolicyDecorator(cfg.MinFeePerMsgType, cfg.DeniedFeeDenoms...))
+	}
+
+	return decorators, nil
+}
+
+func decodeAddresses(ac address.Codec, addrs []string) ([]sdk.AccAddress, error) {
+	decoded := make([]sdk.AccAddress, len(addrs))
+	for i, addr := range addrs {
+		bz, err := ac.StringToBytes(addr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", addr, err)
+		}
+		decoded[i] = bz
+	}
+	return decoded, nil
+}
+
+// SignerPolicyDecorator rejects txs signed by denied addresses or, if allowed
+// addresses are set, by addresses which are not allowed.
+type SignerPolicyDecorator struct {
+	allowed map[string]struct{}
+	denied  map[string]struct{}
+}
+
+func NewSignerPolicyDecorator(allowed, denied []sdk.AccAddress) SignerPolicyDecorator {
+	spd := SignerPolicyDecorator{denied: make(map[string]struct{}, len(denied))}
+	if len(allowed) > 0 {
+		spd.allowed = make(map[string]struct{}, len(allowed))
+		for _, addr := range allowed {
+			spd.allowed[string(addr)] = struct{}{}
+		}
+	}
+	for _, addr := range denied {
+		spd.denied[string(addr)] = struct{}{}
+	}
+	return spd
+}
+
+func (spd SignerPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, signer := range signers {
+		if _, denied := spd.denied[string(signer)]; denied {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is denied", sdk.AccAddress(signer))
+		}
+		if _, allowed := spd.allowed[string(signer)]; spd.allowed != nil && !allowed {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not allowed", sdk.AccAddress(signer))
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MsgTypeFilterDecorator rejects txs holding messages of disallowed types,
+// identified by their type URL.
+type MsgTypeFilterDecorator struct {
+	disallowed map[string]struct{}
+}
+
+func NewMsgTypeFilterDecorator(disallowedTypeURLs ...string) MsgTypeFilterDecorator {
+	mfd := MsgTypeFilterDecorator{disallowed: make(map[string]struct{}, len(disallowedTypeURLs))}
+	for _, typeURL := range disallowedTypeURLs {
+		mfd.disallowed[typeURL] = struct{}{}
+	}
+	return mfd
+}
+
+func (mfd MsgTypeFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if typeURL := sdk.MsgTypeURL(msg); mfd.isDisallowed(typeURL) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message type %s is not allowed", typeURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (mfd MsgTypeFilterDecorator) isDisallowed(typeURL string) bool {
+	_, disallowed := mfd.disallowed[typeURL]
+	return disallowed
+}
+
+// MsgLimitsDecorator rejects txs with more than maxMsgs messages, or holding a
+// message larger than maxMsgSize bytes. Zero limits are not enforced.
+type MsgLimitsDecorator struct {
+	maxMsgs    int
+	maxMsgSize int
+}
+
+func NewMsgLimitsDecorator(maxMsgs, maxMsgSize int) MsgLimitsDecorator {
+	return MsgLimitsDecorator{maxMsgs: maxMsgs, maxMsgSize: maxMsgSize}
+}
+
+func (mld MsgLimitsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if mld.maxMsgs > 0 && len(msgs) > mld.maxMsgs {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx has %d messages, maximum is %d", len(msgs), mld.maxMsgs)
+	}
+
+	if mld.maxMsgSize > 0 {
+		for i, msg := range msgs {
+			if size := proto.Size(msg); size > mld.maxMsgSize {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "message %d is %d bytes, maximum is %d", i, size, mld.maxMsgSize)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// FeePolicyDecorator rejects txs paying fees in denied denoms, or paying less
+// than the sum of the minimum fees of their messages. Unlike the minimum gas
+// prices, which are only checked in CheckTx, the policy is part of consensus
+// and enforced in every mode.
+type FeePolicyDecorator struct {
+	minFeePerMsgType map[string]sdk.Coins
+	deniedDenoms     map[string]struct{}
+}
+
+func NewFeePolicyDecorator(minFeePerMsgType map[string]sdk.Coins, deniedDenoms ...string) FeePolicyDecorator {
+	fpd := FeePolicyDecorator{minFeePerMsgType: minFeePerMsgType, deniedDenoms: make(map[string]struct{}, len(deniedDenoms))}
+	for _, denom := range deniedDenoms {
+		fpd.deniedDenoms[denom] = struct{}{}
+	}
+	return fpd
+}
+
+func (fpd FeePolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	for _, coin := range fee {
+		if _, denied := fpd.deniedDenoms[coin.Denom]; denied {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fees can't be paid in %s", coin.Denom)
+		}
+	}
+
+	minFee := sdk.NewCoins()
+	for _, msg := range tx.GetMsgs() {
+		minFee = minFee.Add(fpd.minFeePerMsgType[sdk.MsgTypeURL(msg)]...)
+	}
+
+	// fees are not paid in simulation mode, as for the minimum gas prices
+	if !simulate && !minFee.IsZero() && !fee.IsAllGTE(minFee) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, minFee)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestPolicyDecorators(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	addr1Str, err := suite.accountKeeper.AddressCodec().BytesToString(addr1)
+	require.NoError(t, err)
+	addr2Str, err := suite.accountKeeper.AddressCodec().BytesToString(addr2)
+	require.NoError(t, err)
+
+	msg := testdata.NewTestMsg(addr1)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	require.NoError(t, suite.txBuilder.SetMsgs(msg, msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(150))))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		cfg    ante.PolicyConfig
+		expErr error
+	}{
+		{"no policy", ante.PolicyConfig{}, nil},
+		{"allowed signer", ante.PolicyConfig{AllowedSigners: []string{addr1Str}}, nil},
+		{"signer not allowed", ante.PolicyConfig{AllowedSigners: []string{addr2Str}}, sdkerrors.ErrUnauthorized},
+		{"denied signer", ante.PolicyConfig{DeniedSigners: []string{addr1Str}}, sdkerrors.ErrUnauthorized},
+		{"disallowed msg type", ante.PolicyConfig{DisallowedMsgTypes: []string{msgTypeURL}}, sdkerrors.ErrUnauthorized},
+		{"max msgs", ante.PolicyConfig{MaxMsgs: 2}, nil},
+		{"too many msgs", ante.PolicyConfig{MaxMsgs: 1}, sdkerrors.ErrInvalidRequest},
+		{"msg too large", ante.PolicyConfig{MaxMsgSize: 1}, sdkerrors.ErrInvalidRequest},
+		{"min fee per msg", ante.PolicyConfig{MinFeePerMsgType: map[string]sdk.Coins{msgTypeURL: sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(75)))}}, nil},
+		{"insufficient fee per msg", ante.PolicyConfig{MinFeePerMsgType: map[string]sdk.Coins{msgTypeURL: sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(76)))}}, sdkerrors.ErrInsufficientFee},
+		{"denied fee denom", ante.PolicyConfig{DeniedFeeDenoms: []string{"atom"}}, sdkerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorators, err := ante.NewPolicyDecorators(tc.cfg, suite.accountKeeper.AddressCodec())
+			require.NoError(t, err)
+			if len(decorators) == 0 {
+				// without policy, no decorator is chained
+				require.Nil(t, tc.expErr)
+				return
+			}
+
+			_, err = sdk.ChainAnteDecorators(decorators...)(suite.ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = ante.NewPolicyDecorators(ante.PolicyConfig{DeniedSigners: []string{"invalid"}}, suite.accountKeeper.AddressCodec())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,77 @@
+package ante
+
+import (
+	"bytes"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountPrefetcher reads the accounts of the signers and fee payers of the
+// txs of a block, with their public keys, in a single pass before the txs are
+// executed. The accounts are read through the block state, so that the
+// execution of the txs finds them in its cache rather than reading them one
+// by one from disk, and they are read in address order, so that the reads of
+// neighbouring accounts share the nodes of the store cache.
+//
+// Prefetching is purely an optimization: it does not write to state and its
+// reads are not accounted to the block.
+type AccountPrefetcher struct {
+	ak AccountKeeper
+}
+
+// NewAccountPrefetcher returns an AccountPrefetcher reading accounts with ak.
+func NewAccountPrefetcher(ak AccountKeeper) AccountPrefetcher {
+	return AccountPrefetcher{ak: ak}
+}
+
+// Prefetch reads the accounts of the signers and fee payers of txs. It can be
+// used as the baseapp TxsPreVerifier, before a SigPreVerifier which then reads
+// the accounts from the cache.
+func (p AccountPrefetcher) Prefetch(ctx sdk.Context, txs []sdk.Tx) {
+	// reads must not be accounted to the block
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithResourceMeter(nil)
+
+	for _, addr := range prefetchedAddresses(txs) {
+		p.ak.GetAccount(ctx, addr)
+	}
+}
+
+// prefetchedAddresses returns the distinct signers and fee payers of txs, in
+// address order.
+func prefetchedAddresses(txs []sdk.Tx) []sdk.AccAddress {
+	seen := make(map[string]struct{})
+	var addrs []sdk.AccAddress
+	add := func(addr []byte) {
+		if len(addr) == 0 {
+			return
+		}
+		if _, ok := seen[string(addr)]; ok {
+			return
+		}
+		seen[string(addr)] = struct{}{}
+		addrs = append(addrs, addr)
+	}
+
+	for _, tx := range txs {
+		if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+			if signers, err := sigTx.GetSigners(); err == nil {
+				for _, signer := range signers {
+					add(signer)
+				}
+			}
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			add(feeTx.FeePayer())
+		}
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i], addrs[j]) < 0
+	})
+
+	return addrs
+}
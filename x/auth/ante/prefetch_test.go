@@ -0,0 +1,64 @@
+package ante_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	xauthsigning "cosmossdk.io/x/auth/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordingAccountKeeper records the addresses of the accounts read.
+type recordingAccountKeeper struct {
+	ante.AccountKeeper
+	read []sdk.AccAddress
+}
+
+func (ak *recordingAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	ak.read = append(ak.read, addr)
+	return ak.AccountKeeper.GetAccount(ctx, addr)
+}
+
+func TestAccountPrefetcher(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+	signMode, err := xauthsigning.APISignModeToInternal(signModeHandler.DefaultMode())
+	require.NoError(t, err)
+
+	accs := suite.CreateTestAccounts(3)
+
+	newTx := func(acc TestAccount) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{acc.priv}, []uint64{acc.acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signMode)
+		require.NoError(t, err)
+		return tx
+	}
+
+	txs := []sdk.Tx{newTx(accs[2]), newTx(accs[0]), newTx(accs[2])}
+
+	ak := &recordingAccountKeeper{AccountKeeper: suite.accountKeeper}
+	gasConsumed := suite.ctx.GasMeter().GasConsumed()
+	ante.NewAccountPrefetcher(ak).Prefetch(suite.ctx, txs)
+
+	// each signer is read once, in address order
+	expected := []sdk.AccAddress{accs[0].acc.GetAddress(), accs[2].acc.GetAddress()}
+	sort.Slice(expected, func(i, j int) bool { return bytes.Compare(expected[i], expected[j]) < 0 })
+	require.Equal(t, expected, ak.read)
+
+	// no gas is consumed from the block context
+	require.Equal(t, gasConsumed, suite.ctx.GasMeter().GasConsumed())
+}
@@ -84,6 +84,14 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			return sdk.Context{}, err
 		}
 
+		// a pending public key change whose delay elapsed takes effect before
+		// the signatures of the account are verified
+		if pkc, ok := spkd.ak.(PubKeyChangeKeeper); ok {
+			if _, err := pkc.ApplyPubKeyChange(ctx, signers[i]); err != nil {
+				return ctx, err
+			}
+		}
+
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
 			if !simulate {
@@ -91,8 +99,9 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
+		// Only make check if simulate=false. The public key of an account whose
+		// key was changed doesn't match its address, but matches the account.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() && !spkd.isChangedPubKey(ctx, signers[i], pk) {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
 		}
@@ -146,6 +155,13 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// isChangedPubKey returns true if pk is the public key of the account at addr,
+// set by a public key change.
+func (spkd SetPubKeyDecorator) isChangedPubKey(ctx sdk.Context, addr sdk.AccAddress, pk cryptotypes.PubKey) bool {
+	acc := spkd.ak.GetAccount(ctx, addr)
+	return acc != nil && acc.GetPubKey() != nil && acc.GetPubKey().Equals(pk)
+}
+
 // SigVerificationDecorator verifies all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
 // It will also increase the sequence number, and consume gas for signature verification.
@@ -156,6 +172,7 @@ type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+	sigCache        *authsigning.SignatureCache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer) SigVerificationDecorator {
@@ -166,6 +183,14 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithSignatureCache returns a copy of the decorator skipping the verification
+// of signatures already verified successfully, e.g. in CheckTx. Gas is still
+// consumed for every signature, so the cache does not affect determinism.
+func (svd SigVerificationDecorator) WithSignatureCache(cache *authsigning.SignatureCache) SigVerificationDecorator {
+	svd.sigCache = cache
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -363,7 +388,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, simulate bool, tx
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
 	txData := adaptableTx.GetSigningTxData()
-	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	err := authsigning.VerifySignatureWithCache(ctx, svd.sigCache, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 	if err != nil {
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
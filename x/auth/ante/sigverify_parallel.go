@@ -0,0 +1,208 @@
+package ante
+
+import (
+	"runtime"
+	"sync"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+
+	storetypes "cosmossdk.io/store/types"
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SigPreVerifier verifies the signatures of the txs of a block concurrently,
+// before they are executed, and records the valid ones in a SignatureCache.
+// When the txs are then executed sequentially, the SigVerificationDecorator
+// sharing the cache skips the signatures already verified.
+//
+// Pre-verification is purely an optimization: the sign bytes of each signature
+// are derived from the state at the beginning of the block, assuming the
+// previous txs of a signer in the block succeed. Invalid signatures, or
+// signatures whose sign bytes differ at execution, are not cached and are
+// verified again by the decorator, which remains the only one deciding whether
+// a tx fails, so the outcome of the block is the same with or without it.
+type SigPreVerifier struct {
+	ak              AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	cache           *authsigning.SignatureCache
+	workers         int
+}
+
+// NewSigPreVerifier returns a SigPreVerifier recording the valid signatures in
+// cache, which must be shared with the SigVerificationDecorator. A number of
+// workers of zero or less uses one worker per CPU.
+func NewSigPreVerifier(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, cache *authsigning.SignatureCache, workers int) SigPreVerifier {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return SigPreVerifier{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		cache:           cache,
+		workers:         workers,
+	}
+}
+
+// sigJob is a single signer signature to verify.
+type sigJob struct {
+	pubKey    cryptotypes.PubKey
+	signBytes []byte
+	sig       []byte
+}
+
+// PreVerify verifies the signatures of txs. It can be used as the baseapp
+// TxsPreVerifier.
+func (v SigPreVerifier) PreVerify(ctx sdk.Context, txs []sdk.Tx) {
+	jobs := v.collectJobs(ctx, txs)
+
+	var batched, others []sigJob
+	for _, job := range jobs {
+		if _, ok := job.pubKey.(*ed25519.PubKey); ok {
+			batched = append(batched, job)
+		} else {
+			others = append(others, job)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	// ed25519 signatures are batch verified, the batch verifier reporting the
+	// validity of each signature.
+	if len(batched) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v.verifyBatch(batched)
+		}()
+	}
+
+	ch := make(chan sigJob)
+	for i := 0; i < v.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range ch {
+				if job.pubKey.VerifySignature(job.signBytes, job.sig) {
+					v.cache.Add(job.pubKey, job.signBytes, job.sig)
+				}
+			}
+		}()
+	}
+
+	for _, job := range others {
+		ch <- job
+	}
+	close(ch)
+
+	wg.Wait()
+}
+
+// collectJobs derives the sign bytes of the single signer signatures of txs.
+// It reads the state, so it runs sequentially.
+func (v SigPreVerifier) collectJobs(ctx sdk.Context, txs []sdk.Tx) []sigJob {
+	// reads must not be accounted to the block
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithResourceMeter(nil)
+
+	genesis := ctx.BlockHeight() == 0
+	sequences := make(map[string]uint64)
+
+	var jobs []sigJob
+	for _, tx := range txs {
+		sigTx, ok := tx.(authsigning.Tx)
+		if !ok {
+			continue
+		}
+
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			continue
+		}
+
+		signers, err := sigTx.GetSigners()
+		if err != nil || len(signers) != len(sigs) {
+			continue
+		}
+
+		for i, signer := range signers {
+			acc := v.ak.GetAccount(ctx, signer)
+			if acc == nil {
+				continue
+			}
+
+			// the sequence of the signer once its previous txs in the block
+			// are executed
+			key := string(signer)
+			seq, ok := sequences[key]
+			if !ok {
+				seq = acc.GetSequence()
+			}
+			sequences[key] = seq + 1
+
+			data, ok := sigs[i].Data.(*signing.SingleSignatureData)
+			if !ok || sigs[i].Sequence != seq {
+				continue
+			}
+
+			pubKey := acc.GetPubKey()
+			if pubKey == nil {
+				pubKey = sigs[i].PubKey
+			}
+			if pubKey == nil {
+				continue
+			}
+
+			var accNum uint64
+			if !genesis {
+				accNum = acc.GetAccountNumber()
+			}
+
+			signBytes, err := authsigning.GetSignBytesAdapter(ctx, v.signModeHandler, data.SignMode, authsigning.SignerData{
+				Address:       acc.GetAddress().String(),
+				ChainID:       ctx.ChainID(),
+				AccountNumber: accNum,
+				Sequence:      seq,
+				PubKey:        pubKey,
+			}, tx)
+			if err != nil {
+				continue
+			}
+
+			if v.cache.Has(pubKey, signBytes, data.Signature) {
+				continue
+			}
+
+			jobs = append(jobs, sigJob{pubKey: pubKey, signBytes: signBytes, sig: data.Signature})
+		}
+	}
+
+	return jobs
+}
+
+func (v SigPreVerifier) verifyBatch(jobs []sigJob) {
+	bv := cmted25519.NewBatchVerifier()
+	for _, job := range jobs {
+		if err := bv.Add(cmted25519.PubKey(job.pubKey.Bytes()), job.signBytes, job.sig); err != nil {
+			// the batch cannot be used, verify the signatures one by one
+			for _, job := range jobs {
+				if job.pubKey.VerifySignature(job.signBytes, job.sig) {
+					v.cache.Add(job.pubKey, job.signBytes, job.sig)
+				}
+			}
+			return
+		}
+	}
+
+	_, valid := bv.Verify()
+	for i, job := range jobs {
+		if valid[i] {
+			v.cache.Add(job.pubKey, job.signBytes, job.sig)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	xauthsigning "cosmossdk.io/x/auth/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSigPreVerifier(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	chainID := suite.ctx.ChainID()
+
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+	signMode, err := xauthsigning.APISignModeToInternal(signModeHandler.DefaultMode())
+	require.NoError(t, err)
+
+	accs := suite.CreateTestAccounts(2)
+
+	newTx := func(acc TestAccount, seq uint64) xauthsigning.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{acc.priv}, []uint64{acc.acc.GetAccountNumber()}, []uint64{seq}, chainID, signMode)
+		require.NoError(t, err)
+		return tx
+	}
+
+	isCached := func(cache *xauthsigning.SignatureCache, acc TestAccount, seq uint64, tx xauthsigning.Tx) bool {
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+
+		signBytes, err := xauthsigning.GetSignBytesAdapter(suite.ctx, signModeHandler, signMode, xauthsigning.SignerData{
+			Address:       acc.acc.GetAddress().String(),
+			ChainID:       chainID,
+			AccountNumber: acc.acc.GetAccountNumber(),
+			Sequence:      seq,
+			PubKey:        acc.priv.PubKey(),
+		}, tx)
+		require.NoError(t, err)
+
+		return cache.Has(acc.priv.PubKey(), signBytes, sigs[0].Data.(*signing.SingleSignatureData).Signature)
+	}
+
+	// two consecutive txs of the first account, and a tx of the second account
+	// with a wrong sequence
+	txs := []sdk.Tx{newTx(accs[0], 0), newTx(accs[0], 1), newTx(accs[1], 5)}
+
+	cache, err := xauthsigning.NewSignatureCache(100)
+	require.NoError(t, err)
+
+	gasConsumed := suite.ctx.GasMeter().GasConsumed()
+	ante.NewSigPreVerifier(suite.accountKeeper, signModeHandler, cache, 2).PreVerify(suite.ctx, txs)
+
+	require.True(t, isCached(cache, accs[0], 0, txs[0].(xauthsigning.Tx)))
+	require.True(t, isCached(cache, accs[0], 1, txs[1].(xauthsigning.Tx)))
+	require.False(t, isCached(cache, accs[1], 5, txs[2].(xauthsigning.Tx)))
+
+	// no gas is consumed from the block context
+	require.Equal(t, gasConsumed, suite.ctx.GasMeter().GasConsumed())
+}
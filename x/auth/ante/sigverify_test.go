@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -71,6 +72,44 @@ func TestSetPubKey(t *testing.T) {
 	}
 }
 
+func TestSetPubKey_ChangedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd)
+
+	// the account key was changed, so its public key doesn't match its address
+	_, pub1, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+	priv3, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(t, acc.SetPubKey(pub1))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(t, suite.accountKeeper.ChangePubKey(suite.ctx, addr1, pub2, 0))
+
+	newTx := func(priv cryptotypes.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the changed public key is accepted although it doesn't match the address
+	ctx, err := antehandler(suite.ctx, newTx(priv2), false)
+	require.NoError(t, err)
+	pk, err := suite.accountKeeper.GetPubKey(ctx, addr1)
+	require.NoError(t, err)
+	require.True(t, pub2.Equals(pk))
+
+	// other public keys not matching the address are still rejected
+	_, err = antehandler(suite.ctx, newTx(priv3), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
 func TestConsumeSignatureVerificationGas(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	params := types.DefaultParams()
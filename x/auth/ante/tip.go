@@ -0,0 +1,130 @@
+package ante
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var tipTypeURL = sdk.MsgTypeURL(&txtypes.Tip{}) //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+
+// TipExtensionOptionChecker is an ExtensionOptionChecker accepting tips. Chains
+// supporting tips must accept them with the ExtensionOptionChecker of their
+// AnteHandler, and pay them with the TipDecorator of the auth post handler.
+func TipExtensionOptionChecker(opt *codectypes.Any) bool {
+	return opt.TypeUrl == tipTypeURL
+}
+
+// GetTip returns the tip of the tx, or nil if the tx has none.
+//
+// A tip is a Tip extension option of the tx body, so the signatures of the
+// tipper and of the fee payer cover both the tip and the msgs of the tx: a
+// relayer cannot alter the msgs of the tipper, nor the tip it claims. Tips are
+// critical extension options so that chains without tip support reject them.
+func GetTip(tx sdk.Tx) (*txtypes.Tip, error) { //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+	extTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var tip *txtypes.Tip //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.TypeUrl != tipTypeURL {
+			continue
+		}
+
+		if tip != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx must contain at most one tip")
+		}
+
+		var ok bool
+		tip, ok = opt.GetCachedValue().(*txtypes.Tip) //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %s extension option, got %T", tipTypeURL, opt.GetCachedValue())
+		}
+	}
+
+	return tip, nil
+}
+
+// TipDecorator validates the tip of a meta-transaction, where a tipper signs
+// msgs along with a tip, usually with SIGN_MODE_DIRECT_AUX, and a relayer pays
+// the fees of the tx in exchange for the tip. The tip must be a valid amount,
+// the tipper must be a signer of the tx other than the fee payer, and the tip
+// coins must be sendable. The tip is paid by the TipDecorator of the auth post
+// handler once the msgs are executed.
+// CONTRACT: Tx must implement FeeTx and SigVerifiableTx.
+type TipDecorator struct {
+	ak AccountKeeper
+	bk types.BankKeeper
+}
+
+func NewTipDecorator(ak AccountKeeper, bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		ak: ak,
+		bk: bk,
+	}
+}
+
+func (td TipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	tip, err := GetTip(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if tip == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := tip.Amount.Validate(); err != nil || tip.Amount.IsZero() {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", tip.Amount)
+	}
+
+	tipper, err := td.ak.AddressCodec().StringToBytes(tip.Tipper)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address %s: %s", tip.Tipper, err)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if bytes.Equal(tipper, feeTx.FeePayer()) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tipper cannot be the fee payer")
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	isSigner := false
+	for _, signer := range signers {
+		if bytes.Equal(signer, tipper) {
+			isSigner = true
+			break
+		}
+	}
+	if !isSigner {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must be a signer of the tx", tip.Tipper)
+	}
+
+	if err := td.bk.IsSendEnabledCoins(ctx, tip.Amount...); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/tx"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestTipDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	accs := suite.CreateTestAccounts(3)
+	feePayer, tipper, other := accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), accs[2].acc.GetAddress()
+	tipAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	newTip := func(amount sdk.Coins, tipper sdk.AccAddress) *codectypes.Any {
+		tip, err := codectypes.NewAnyWithValue(&txtypes.Tip{Amount: amount, Tipper: tipper.String()}) //nolint:staticcheck // SA1019: Tip is reused as a tx extension option
+		require.NoError(t, err)
+		return tip
+	}
+
+	testCases := []struct {
+		name    string
+		tips    []*codectypes.Any
+		expErr  error
+		expSend bool
+	}{
+		{"no tip", nil, nil, false},
+		{"valid tip", []*codectypes.Any{newTip(tipAmount, tipper)}, nil, true},
+		{"zero tip", []*codectypes.Any{newTip(sdk.NewCoins(), tipper)}, sdkerrors.ErrInvalidCoins, false},
+		{"tipper is not a signer", []*codectypes.Any{newTip(tipAmount, other)}, sdkerrors.ErrUnauthorized, false},
+		{"tipper is the fee payer", []*codectypes.Any{newTip(tipAmount, feePayer)}, sdkerrors.ErrInvalidRequest, false},
+		{"multiple tips", []*codectypes.Any{newTip(tipAmount, tipper), newTip(tipAmount, tipper)}, sdkerrors.ErrInvalidRequest, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(feePayer, tipper)))
+			txBuilder.(tx.ExtensionOptionsTxBuilder).SetExtensionOptions(tc.tips...)
+
+			if tc.expSend {
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), tipAmount[0]).Return(nil)
+			}
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewTipDecorator(suite.accountKeeper, suite.bankKeeper))
+			_, err := antehandler(suite.ctx, txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			tip, err := ante.GetTip(txBuilder.GetTx())
+			require.NoError(t, err)
+			if tc.tips == nil {
+				require.Nil(t, tip)
+			} else {
+				require.Equal(t, tipper.String(), tip.Tipper)
+			}
+		})
+	}
+
+	require.True(t, ante.TipExtensionOptionChecker(newTip(tipAmount, tipper)))
+	testMsg, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	require.False(t, ante.TipExtensionOptionChecker(testMsg))
+}
@@ -74,6 +74,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "FeeSplit",
+					Use:       "fee-split",
+					Short:     "Query the weights by which the fees of txs are split between destinations",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +92,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "ChangePubKey",
+					Use:            "change-pub-key [address] [pub-key]",
+					Short:          "Rotate the public key of an account, optionally after a delay",
+					Example:        fmt.Sprintf(`%s tx auth change-pub-key cosmos1... '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}' --delay 24h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "pub_key"}},
+				},
+				{
+					RpcMethod:      "CancelPubKeyChange",
+					Use:            "cancel-pub-key-change [address]",
+					Short:          "Cancel the pending public key change of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SetFeeSplit",
+					Use:            "set-fee-split-proposal [weights]",
+					Short:          "Submit a proposal to replace the weights by which the fees of txs are split between destinations",
+					Example:        fmt.Sprintf(`%s tx auth set-fee-split-proposal '{"destination":"fee_collector","weight":3}' '{"destination":"burn","weight":1}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "weights", Varargs: true}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
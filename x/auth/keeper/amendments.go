@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RecordAccountAmendment stores the audit record of the amendment of the
+// account at addr decided by the proposal proposalID, from previous to amended.
+// An account is amended at most once per proposal.
+func (ak AccountKeeper) RecordAccountAmendment(ctx context.Context, proposalID uint64, previous, amended sdk.AccountI) error {
+	addr := previous.GetAddress()
+	if !addr.Equals(amended.GetAddress()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amended account %s is not account %s", amended.GetAddress(), addr)
+	}
+
+	key := collections.Join(addr, proposalID)
+	has, err := ak.AccountAmendments.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s was already amended by proposal %d", addr, proposalID)
+	}
+
+	amendment, err := types.NewAccountAmendment(proposalID, sdk.UnwrapSDKContext(ctx).BlockHeight(), previous, amended)
+	if err != nil {
+		return err
+	}
+
+	return ak.AccountAmendments.Set(ctx, key, amendment)
+}
+
+// GetAccountAmendments returns the audit records of the amendments of the
+// account at addr, by proposal id.
+func (ak AccountKeeper) GetAccountAmendments(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAmendment, error) {
+	iter, err := ak.AccountAmendments.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetFeeSplit replaces the weights by which the fees of txs are split between
+// destinations by the FeeSplitDecorator post handler. Destinations are module
+// accounts or types.FeeSplitBurn, which requires the fee collector to have the
+// burner permission. Empty weights leave all the fees to the fee collector.
+// Governance sets them with MsgSetFeeSplit.
+func (ak AccountKeeper) SetFeeSplit(ctx context.Context, weights []types.FeeSplitWeight) error {
+	if err := types.ValidateFeeSplit(weights); err != nil {
+		return err
+	}
+
+	for _, w := range weights {
+		if w.Destination == types.FeeSplitBurn {
+			if !ak.permAddrs[types.FeeCollectorName].HasPermission(types.Burner) {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "burning fees requires the %s module account to have the %s permission", types.FeeCollectorName, types.Burner)
+			}
+			continue
+		}
+
+		if _, ok := ak.permAddrs[w.Destination]; !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "fee split destination %s is not a module account", w.Destination)
+		}
+	}
+
+	if err := ak.FeeSplit.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, w := range weights {
+		if err := ak.FeeSplit.Set(ctx, w.Destination, w.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetFeeSplit returns the weights by which the fees of txs are split between
+// destinations, by destination.
+func (ak AccountKeeper) GetFeeSplit(ctx context.Context) ([]types.FeeSplitWeight, error) {
+	var weights []types.FeeSplitWeight
+	err := ak.FeeSplit.Walk(ctx, nil, func(destination string, weight uint64) (bool, error) {
+		weights = append(weights, types.FeeSplitWeight{Destination: destination, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return weights, nil
+}
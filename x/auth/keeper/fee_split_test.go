@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeSplit() {
+	authority := suite.accountKeeper.GetAuthority()
+	weights := []types.FeeSplitWeight{
+		{Destination: types.FeeCollectorName, Weight: 3},
+		{Destination: multiPerm, Weight: 1},
+	}
+
+	_, err := suite.msgServer.SetFeeSplit(suite.ctx, &types.MsgSetFeeSplit{
+		Authority: types.NewModuleAddress(holder).String(),
+		Weights:   weights,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// destinations must be module accounts, listed once with a positive weight
+	for _, invalid := range [][]types.FeeSplitWeight{
+		{{Destination: "unknown", Weight: 1}},
+		{{Destination: "", Weight: 1}},
+		{{Destination: multiPerm, Weight: 1}, {Destination: multiPerm, Weight: 2}},
+		{{Destination: multiPerm}},
+		// the fee collector can't burn
+		{{Destination: types.FeeSplitBurn, Weight: 1}},
+	} {
+		_, err := suite.msgServer.SetFeeSplit(suite.ctx, &types.MsgSetFeeSplit{Authority: authority, Weights: invalid})
+		suite.Require().Error(err)
+	}
+
+	_, err = suite.msgServer.SetFeeSplit(suite.ctx, &types.MsgSetFeeSplit{Authority: authority, Weights: weights})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.FeeSplit(suite.ctx, &types.QueryFeeSplitRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(weights, res.Weights)
+
+	// the weights are exported and imported in genesis
+	genState := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().ElementsMatch(weights, genState.FeeSplit)
+	suite.Require().NoError(types.ValidateFeeSplit(genState.FeeSplit))
+	suite.Require().NoError(suite.accountKeeper.FeeSplit.Clear(suite.ctx, nil))
+	suite.accountKeeper.InitGenesis(suite.ctx, *genState)
+	got, err := suite.accountKeeper.GetFeeSplit(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(weights, got)
+
+	// empty weights leave all the fees to the fee collector
+	_, err = suite.msgServer.SetFeeSplit(suite.ctx, &types.MsgSetFeeSplit{Authority: authority})
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.FeeSplit(suite.ctx, &types.QueryFeeSplitRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Weights)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,20 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, amendment := range data.AccountAmendments {
+		previous, err := amendment.GetPreviousAccount()
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.AccountAmendments.Set(ctx, collections.Join(previous.GetAddress(), amendment.ProposalId), amendment); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := ak.SetFeeSplit(ctx, data.FeeSplit); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -48,5 +63,19 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	err := ak.AccountAmendments.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], amendment types.AccountAmendment) (bool, error) {
+		genState.AccountAmendments = append(genState.AccountAmendments, amendment)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState.FeeSplit, err = ak.GetFeeSplit(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
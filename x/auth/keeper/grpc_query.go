@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -247,3 +248,50 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// AccountByPubKey returns the accounts whose public key is the public key of
+// the request.
+func (s queryServer) AccountByPubKey(ctx context.Context, req *types.QueryAccountByPubKeyRequest) (*types.QueryAccountByPubKeyResponse, error) {
+	if req == nil || req.PubKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := s.k.cdc.UnpackAny(req.PubKey, &pubKey); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key: %v", err)
+	}
+
+	accounts, err := s.k.GetAccountsByPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(accounts) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no account with public key %s", pubKey)
+	}
+
+	res := &types.QueryAccountByPubKeyResponse{Accounts: make([]*codectypes.Any, len(accounts))}
+	for i, acc := range accounts {
+		accAny, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Accounts[i] = accAny
+	}
+
+	return res, nil
+}
+
+// FeeSplit returns the weights by which the fees of txs are split between
+// destinations.
+func (s queryServer) FeeSplit(ctx context.Context, req *types.QueryFeeSplitRequest) (*types.QueryFeeSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	weights, err := s.k.GetFeeSplit(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeSplitResponse{Weights: weights}, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -66,17 +67,21 @@ func NewAccountIndexes(sb *collections.SchemaBuilder) AccountsIndexes {
 				return v.GetAccountNumber(), nil
 			},
 		),
+		PubKey: NewPubKeyIndex(sb),
 	}
 }
 
 type AccountsIndexes struct {
 	// Number is a unique index that indexes accounts by their account number.
 	Number *indexes.Unique[uint64, sdk.AccAddress, sdk.AccountI]
+	// PubKey is a multi index that indexes accounts by their public key.
+	PubKey *PubKeyIndex
 }
 
 func (a AccountsIndexes) IndexesList() []collections.Index[sdk.AccAddress, sdk.AccountI] {
 	return []collections.Index[sdk.AccAddress, sdk.AccountI]{
 		a.Number,
+		a.PubKey,
 	}
 }
 
@@ -90,6 +95,9 @@ type AccountKeeper struct {
 	permAddrs    map[string]types.PermissionsForAddress
 	bech32Prefix string
 
+	// derivations records the derivation paths of module and derived accounts.
+	derivations *sdkaddress.DerivationRegistry
+
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
@@ -103,6 +111,14 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// PendingPubKeys key: AccAddr | value: public key the account is changing to
+	PendingPubKeys collections.Map[sdk.AccAddress, cryptotypes.PubKey]
+	// PendingPubKeyTimes key: AccAddr | value: unix time at which the pending public key takes effect
+	PendingPubKeyTimes collections.Map[sdk.AccAddress, int64]
+	// AccountAmendments key: AccAddr+proposal id | value: audit record of the amendment
+	AccountAmendments collections.Map[collections.Pair[sdk.AccAddress, uint64], types.AccountAmendment]
+	// FeeSplit key: destination | value: weight of the share of the fees sent to the destination
+	FeeSplit collections.Map[string, uint64]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -118,8 +134,10 @@ func NewAccountKeeper(
 	maccPerms map[string][]string, ac address.Codec, bech32Prefix, authority string,
 ) AccountKeeper {
 	permAddrs := make(map[string]types.PermissionsForAddress)
+	derivations := sdkaddress.NewDerivationRegistry()
 	for name, perms := range maccPerms {
 		permAddrs[name] = types.NewPermissionsForAddress(name, perms)
+		derivations.MustDerive(name)
 	}
 
 	sb := collections.NewSchemaBuilder(storeService)
@@ -131,10 +149,18 @@ func NewAccountKeeper(
 		proto:         proto,
 		cdc:           cdc,
 		permAddrs:     permAddrs,
+		derivations:   derivations,
 		authority:     authority,
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+
+		PendingPubKeys:     collections.NewMap(sb, types.PendingPubKeyPrefix, "pending_pub_keys", sdk.AccAddressKey, codec.CollInterfaceValue[cryptotypes.PubKey](cdc)),
+		PendingPubKeyTimes: collections.NewMap(sb, types.PendingPubKeyTimePrefix, "pending_pub_key_times", sdk.AccAddressKey, collections.Int64Value),
+
+		AccountAmendments: collections.NewMap(sb, types.AccountAmendmentsPrefix, "account_amendments", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.AccountAmendment](cdc)),
+
+		FeeSplit: collections.NewMap(sb, types.FeeSplitPrefix, "fee_split", collections.StringKey, collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -218,6 +244,20 @@ func (ak AccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 	return permAddr.GetAddress()
 }
 
+// DeriveAddress returns the address of the sub-account of moduleName identified
+// by the given derivation keys, and records its derivation path so that it can
+// later be resolved with ResolveDerivedAddress. Modules should derive their
+// sub-account addresses through this method rather than hashing ad-hoc strings.
+func (ak AccountKeeper) DeriveAddress(moduleName string, keys ...[]byte) (sdk.AccAddress, error) {
+	return ak.derivations.Derive(moduleName, keys...)
+}
+
+// ResolveDerivedAddress returns the derivation path of a module account or of
+// an address previously derived with DeriveAddress.
+func (ak AccountKeeper) ResolveDerivedAddress(addr sdk.AccAddress) (sdkaddress.DerivationPath, bool) {
+	return ak.derivations.Resolve(addr)
+}
+
 // GetModuleAddressAndPermissions returns an address and permissions based on the module name
 func (ak AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string) {
 	permAddr, ok := ak.permAddrs[moduleName]
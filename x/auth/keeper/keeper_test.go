@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -92,6 +93,26 @@ func (suite *KeeperTestSuite) TestSupply_ValidatePermissions() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestDeriveAddress() {
+	// registered module accounts resolve to their module name
+	path, ok := suite.accountKeeper.ResolveDerivedAddress(types.NewModuleAddress(multiPerm))
+	suite.Require().True(ok)
+	suite.Require().Equal(multiPerm, path.Module)
+	suite.Require().Empty(path.Keys)
+
+	addr, err := suite.accountKeeper.DeriveAddress("dao", []byte("proposal-1"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.AccAddress(address.Module("dao", []byte("proposal-1"))), addr)
+
+	path, ok = suite.accountKeeper.ResolveDerivedAddress(addr)
+	suite.Require().True(ok)
+	suite.Require().Equal("dao", path.Module)
+	suite.Require().Equal([][]byte{[]byte("proposal-1")}, path.Keys)
+
+	_, ok = suite.accountKeeper.ResolveDerivedAddress(sdk.AccAddress("unknown"))
+	suite.Require().False(ok)
+}
+
 func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest() // reset
 
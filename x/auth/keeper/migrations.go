@@ -46,6 +46,12 @@ func (m Migrator) Migrate4To5(ctx sdk.Context) error {
 	return v5.Migrate(ctx, m.keeper.storeService, m.keeper.AccountNumber)
 }
 
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It indexes the existing accounts by their public key.
+func (m Migrator) Migrate5To6(ctx sdk.Context) error {
+	return m.keeper.BackfillPubKeyIndex(ctx)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -40,3 +43,59 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ChangePubKey rotates the public key of the signer account. The account being
+// the signer of the msg, its signature was verified against its current public
+// key by the ante handler.
+func (ms msgServer) ChangePubKey(ctx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.PubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "public key cannot be empty")
+	}
+
+	pubKey, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.ChangePubKey(ctx, addr, pubKey, msg.Delay); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
+
+// CancelPubKeyChange cancels the pending public key change of the signer
+// account.
+func (ms msgServer) CancelPubKeyChange(ctx context.Context, msg *types.MsgCancelPubKeyChange) (*types.MsgCancelPubKeyChangeResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if err := ms.ak.CancelPubKeyChange(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPubKeyChangeResponse{}, nil
+}
+
+// SetFeeSplit replaces the weights by which the fees of txs are split between
+// destinations.
+func (ms msgServer) SetFeeSplit(ctx context.Context, msg *types.MsgSetFeeSplit) (*types.MsgSetFeeSplitResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetFeeSplit(ctx, msg.Weights); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSplitResponse{}, nil
+}
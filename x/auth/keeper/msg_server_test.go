@@ -1,7 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +115,34 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgChangePubKey() {
+	oldKey, newKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldKey.Address())
+	addrStr, err := s.accountKeeper.AddressCodec().BytesToString(addr)
+	s.Require().NoError(err)
+
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(oldKey))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	msg, err := types.NewMsgChangePubKey(addrStr, newKey, time.Hour)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ChangePubKey(s.ctx, &types.MsgChangePubKey{Address: "foo", PubKey: msg.PubKey})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	_, err = s.msgServer.ChangePubKey(s.ctx, &types.MsgChangePubKey{Address: addrStr})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	_, err = s.msgServer.ChangePubKey(s.ctx, msg)
+	s.Require().NoError(err)
+	pending, _, found, err := s.accountKeeper.GetPendingPubKeyChange(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(newKey.Equals(pending))
+
+	_, err = s.msgServer.CancelPubKeyChange(s.ctx, &types.MsgCancelPubKeyChange{Address: addrStr})
+	s.Require().NoError(err)
+	_, err = s.msgServer.CancelPubKeyChange(s.ctx, &types.MsgCancelPubKeyChange{Address: addrStr})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
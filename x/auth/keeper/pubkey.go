@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ChangePubKey rotates the public key of the account at addr to pubKey, so
+// that an account whose key is compromised can be secured before it is
+// drained. The caller must have verified that the change is signed by the
+// current key of the account, e.g. by requiring addr to be a signer of the tx.
+//
+// With a zero delay, the new key is used right away to verify the signatures of
+// the account. Otherwise the change is pending until delay has elapsed, leaving
+// time to cancel it with CancelPubKeyChange if it was not requested by the
+// account owner, and takes effect with ApplyPubKeyChange.
+func (ak AccountKeeper) ChangePubKey(ctx context.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey, delay time.Duration) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot change the public key of module account %s", addr)
+	}
+
+	// only an account whose public key was set by a signed tx can prove that
+	// the current key signed the change
+	current := acc.GetPubKey()
+	if current == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key", addr)
+	}
+
+	if pubKey == nil || current.Equals(pubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key must be set and differ from the current one")
+	}
+
+	if delay < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative public key change delay %s", delay)
+	}
+
+	has, err := ak.PendingPubKeys.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already has a pending public key change", addr)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	effectiveTime := sdkCtx.BlockTime().Add(delay)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChangePubKey,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyPubKey, pubKey.String()),
+		sdk.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.Format(time.RFC3339)),
+	))
+
+	if delay == 0 {
+		return ak.setPubKey(ctx, acc, pubKey)
+	}
+
+	if err := ak.PendingPubKeys.Set(ctx, addr, pubKey); err != nil {
+		return err
+	}
+
+	return ak.PendingPubKeyTimes.Set(ctx, addr, effectiveTime.Unix())
+}
+
+// GetPendingPubKeyChange returns the pending public key change of the account
+// at addr, and the time it takes effect, or false if there is none.
+func (ak AccountKeeper) GetPendingPubKeyChange(ctx context.Context, addr sdk.AccAddress) (cryptotypes.PubKey, time.Time, bool, error) {
+	pubKey, err := ak.PendingPubKeys.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, time.Time{}, false, nil
+	} else if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	effectiveTime, err := ak.PendingPubKeyTimes.Get(ctx, addr)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	return pubKey, time.Unix(effectiveTime, 0).UTC(), true, nil
+}
+
+// CancelPubKeyChange cancels the pending public key change of the account at
+// addr. As for ChangePubKey, the caller must have verified that the
+// cancellation is signed by the current key of the account.
+func (ak AccountKeeper) CancelPubKeyChange(ctx context.Context, addr sdk.AccAddress) error {
+	has, err := ak.PendingPubKeys.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "account %s has no pending public key change", addr)
+	}
+
+	if err := ak.removePendingPubKeyChange(ctx, addr); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelPubKeyChange,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+	))
+
+	return nil
+}
+
+// ApplyPubKeyChange sets the public key of the account at addr to its pending
+// public key if the change delay has elapsed. It returns true if the public key
+// was changed. It is called by the ante handler before verifying the
+// signatures of an account, so a pending change takes effect with the first tx
+// signed by the account after its delay.
+func (ak AccountKeeper) ApplyPubKeyChange(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	pubKey, effectiveTime, found, err := ak.GetPendingPubKeyChange(ctx, addr)
+	if err != nil || !found {
+		return false, err
+	}
+
+	if sdk.UnwrapSDKContext(ctx).BlockTime().Before(effectiveTime) {
+		return false, nil
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return false, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	if err := ak.removePendingPubKeyChange(ctx, addr); err != nil {
+		return false, err
+	}
+
+	return true, ak.setPubKey(ctx, acc, pubKey)
+}
+
+func (ak AccountKeeper) setPubKey(ctx context.Context, acc sdk.AccountI, pubKey cryptotypes.PubKey) error {
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	ak.SetAccount(ctx, acc)
+	return nil
+}
+
+func (ak AccountKeeper) removePendingPubKeyChange(ctx context.Context, addr sdk.AccAddress) error {
+	if err := ak.PendingPubKeys.Remove(ctx, addr); err != nil {
+		return err
+	}
+
+	return ak.PendingPubKeyTimes.Remove(ctx, addr)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ collections.Index[sdk.AccAddress, sdk.AccountI] = (*PubKeyIndex)(nil)
+
+// PubKeyIndex indexes accounts by the address of their public key, which
+// differs from the account address for module accounts and accounts whose key
+// was changed. Accounts without public key are not indexed.
+type PubKeyIndex struct {
+	refKeys collections.KeySet[collections.Pair[[]byte, sdk.AccAddress]]
+}
+
+// NewPubKeyIndex returns the public key index of accounts.
+func NewPubKeyIndex(sb *collections.SchemaBuilder) *PubKeyIndex {
+	return &PubKeyIndex{
+		refKeys: collections.NewKeySet(sb, types.PubKeyIndexPrefix, "account_by_pub_key", collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey)),
+	}
+}
+
+// Reference implements collections.Index.
+func (i *PubKeyIndex) Reference(ctx context.Context, pk sdk.AccAddress, newValue sdk.AccountI, lazyOldValue func() (sdk.AccountI, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	return i.reference(ctx, pk, newValue)
+}
+
+// Unreference implements collections.Index.
+func (i *PubKeyIndex) Unreference(ctx context.Context, pk sdk.AccAddress, lazyOldValue func() (sdk.AccountI, error)) error {
+	oldValue, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+
+	return i.unreference(ctx, pk, oldValue)
+}
+
+// MatchExact returns the addresses of the accounts with pubKey.
+func (i *PubKeyIndex) MatchExact(ctx context.Context, pubKey cryptotypes.PubKey) ([]sdk.AccAddress, error) {
+	it, err := i.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, sdk.AccAddress](pubKey.Address()))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]sdk.AccAddress, len(keys))
+	for j, key := range keys {
+		addrs[j] = key.K2()
+	}
+	return addrs, nil
+}
+
+func (i *PubKeyIndex) reference(ctx context.Context, pk sdk.AccAddress, acc sdk.AccountI) error {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return nil
+	}
+
+	return i.refKeys.Set(ctx, collections.Join([]byte(pubKey.Address()), pk))
+}
+
+func (i *PubKeyIndex) unreference(ctx context.Context, pk sdk.AccAddress, acc sdk.AccountI) error {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return nil
+	}
+
+	return i.refKeys.Remove(ctx, collections.Join([]byte(pubKey.Address()), pk))
+}
+
+// GetAccountsByPubKey returns the accounts whose public key is pubKey.
+func (ak AccountKeeper) GetAccountsByPubKey(ctx context.Context, pubKey cryptotypes.PubKey) ([]sdk.AccountI, error) {
+	addrs, err := ak.Accounts.Indexes.PubKey.MatchExact(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]sdk.AccountI, 0, len(addrs))
+	for _, addr := range addrs {
+		acc, err := ak.Accounts.Get(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		// the index is keyed by the hash of the public key
+		if acc.GetPubKey().Equals(pubKey) {
+			accounts = append(accounts, acc)
+		}
+	}
+
+	return accounts, nil
+}
+
+// BackfillPubKeyIndex indexes the public keys of all existing accounts. It is
+// called by the migration of the module to consensus version 6.
+func (ak AccountKeeper) BackfillPubKeyIndex(ctx context.Context) error {
+	return ak.Accounts.Walk(ctx, nil, func(addr sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+		return false, ak.Accounts.Indexes.PubKey.reference(ctx, addr, acc)
+	})
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestPubKeyIndex() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ak := keeper.NewAccountKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(key), types.ProtoBaseAccount,
+		map[string][]string{}, authcodec.NewBech32Codec("cosmos"), "cosmos", types.NewModuleAddress("gov").String())
+
+	pubKey1, pubKey2 := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr1, addr2 := sdk.AccAddress(pubKey1.Address()), sdk.AccAddress(pubKey2.Address())
+
+	// accounts stored without the index, e.g. before the migration to
+	// consensus version 6, are indexed by the backfill
+	acc1 := ak.NewAccountWithAddress(ctx, addr1)
+	suite.Require().NoError(acc1.SetPubKey(pubKey1))
+	suite.Require().NoError(keeper.NewMigrator(ak, nil).V45SetAccount(ctx, acc1))
+	accounts, err := ak.GetAccountsByPubKey(ctx, pubKey1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(accounts)
+
+	suite.Require().NoError(keeper.NewMigrator(ak, nil).Migrate5To6(ctx))
+	accounts, err = ak.GetAccountsByPubKey(ctx, pubKey1)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 1)
+	suite.Require().Equal(addr1, accounts[0].GetAddress())
+
+	// accounts without public key are not indexed, and the index follows the
+	// public key of accounts
+	acc2 := ak.NewAccountWithAddress(ctx, addr2)
+	ak.SetAccount(ctx, acc2)
+	accounts, err = ak.GetAccountsByPubKey(ctx, pubKey2)
+	suite.Require().NoError(err)
+	suite.Require().Empty(accounts)
+
+	suite.Require().NoError(acc2.SetPubKey(pubKey2))
+	ak.SetAccount(ctx, acc2)
+	suite.Require().NoError(ak.ChangePubKey(ctx, addr1, pubKey2, 0))
+	accounts, err = ak.GetAccountsByPubKey(ctx, pubKey2)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 2)
+	accounts, err = ak.GetAccountsByPubKey(ctx, pubKey1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(accounts)
+
+	ak.RemoveAccount(ctx, ak.GetAccount(ctx, addr2))
+	accounts, err = ak.GetAccountsByPubKey(ctx, pubKey2)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 1)
+	suite.Require().Equal(addr1, accounts[0].GetAddress())
+
+	// the gRPC query returns the accounts with the public key of the request
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServer(ak))
+	queryClient := types.NewQueryClient(queryHelper)
+	query := func(ctx context.Context, pubKey *codectypes.Any) (*types.QueryAccountByPubKeyResponse, error) {
+		return queryClient.AccountByPubKey(ctx, &types.QueryAccountByPubKeyRequest{PubKey: pubKey})
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey2)
+	suite.Require().NoError(err)
+	res, err := query(ctx, pubKeyAny)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+
+	pubKeyAny, err = codectypes.NewAnyWithValue(pubKey1)
+	suite.Require().NoError(err)
+	_, err = query(ctx, pubKeyAny)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperTestSuite) TestChangePubKey() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000_000, 0).UTC()})
+	oldKey, newKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldKey.Address())
+
+	// accounts without public key can't change it
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().ErrorIs(suite.accountKeeper.ChangePubKey(ctx, addr, newKey, 0), sdkerrors.ErrInvalidPubKey)
+
+	suite.Require().NoError(acc.SetPubKey(oldKey))
+	suite.accountKeeper.SetAccount(ctx, acc)
+
+	suite.Require().ErrorIs(suite.accountKeeper.ChangePubKey(ctx, addr, oldKey, 0), sdkerrors.ErrInvalidPubKey)
+	suite.Require().ErrorIs(suite.accountKeeper.ChangePubKey(ctx, sdk.AccAddress("unknown"), newKey, 0), sdkerrors.ErrUnknownAddress)
+
+	// a time-locked change is pending until its delay elapsed
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, newKey, time.Hour))
+	pending, effectiveTime, found, err := suite.accountKeeper.GetPendingPubKeyChange(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().True(newKey.Equals(pending))
+	suite.Require().Equal(ctx.HeaderInfo().Time.Add(time.Hour), effectiveTime)
+	suite.Require().ErrorIs(suite.accountKeeper.ChangePubKey(ctx, addr, newKey, time.Hour), sdkerrors.ErrInvalidRequest)
+
+	applied, err := suite.accountKeeper.ApplyPubKeyChange(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(applied)
+
+	// a pending change can be cancelled
+	suite.Require().NoError(suite.accountKeeper.CancelPubKeyChange(ctx, addr))
+	suite.Require().ErrorIs(suite.accountKeeper.CancelPubKeyChange(ctx, addr), sdkerrors.ErrNotFound)
+
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, newKey, time.Hour))
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(time.Hour)})
+	applied, err = suite.accountKeeper.ApplyPubKeyChange(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(applied)
+
+	pubKey, err := suite.accountKeeper.GetPubKey(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(newKey.Equals(pubKey))
+	_, _, found, err = suite.accountKeeper.GetPendingPubKeyChange(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// changes without delay take effect right away
+	newerKey := secp256k1.GenPrivKey().PubKey()
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, newerKey, 0))
+	pubKey, err = suite.accountKeeper.GetPubKey(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(newerKey.Equals(pubKey))
+}
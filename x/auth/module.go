@@ -26,7 +26,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
@@ -126,6 +126,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5", types.ModuleName))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6", types.ModuleName))
+	}
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
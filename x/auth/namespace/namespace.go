@@ -0,0 +1,298 @@
+// Package namespace lets a parent module host sub-applications, each with its
+// own isolated store namespace and module account, administered by a delegated
+// admin.
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxNameLength is the maximum length of the name of a namespace.
+const MaxNameLength = 64
+
+var (
+	adminsPrefix = []byte{0x00}
+	frozenPrefix = []byte{0x01}
+	storesPrefix = []byte{0x02}
+)
+
+// AccountKeeper defines the account keeper used to create the module accounts
+// of the namespaces.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	NewAccount(ctx context.Context, acc sdk.AccountI) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+	RemoveAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// BankKeeper defines the bank keeper used to check that the module account of
+// a namespace is empty before it is deleted.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// Namespace is the state of a namespace.
+type Namespace struct {
+	Name   string
+	Admin  string
+	Frozen bool
+	// Address is the address of the module account of the namespace.
+	Address sdk.AccAddress
+}
+
+// Namespaces manages the namespaces of a parent module. Each namespace has a
+// store isolated from the other namespaces and from the parent module state,
+// and a module account named "<parent>/<name>". The parent module creates
+// namespaces; their admin can freeze, unfreeze and delete them, or hand them
+// over to another admin. The store of a frozen namespace is read-only.
+type Namespaces struct {
+	parent       string
+	prefix       []byte
+	storeService store.KVStoreService
+	ak           AccountKeeper
+	bk           BankKeeper
+
+	Admins collections.Map[string, string]
+	Frozen collections.KeySet[string]
+}
+
+// NewNamespaces returns the namespaces of the parent module, stored under
+// prefix in the store of the parent module.
+func NewNamespaces(sb *collections.SchemaBuilder, prefix collections.Prefix, storeService store.KVStoreService, parent string, ak AccountKeeper, bk BankKeeper) Namespaces {
+	p := prefix.Bytes()
+	return Namespaces{
+		parent:       parent,
+		prefix:       p,
+		storeService: storeService,
+		ak:           ak,
+		bk:           bk,
+		Admins:       collections.NewMap(sb, collections.NewPrefix(concat(p, adminsPrefix)), "namespace_admins", collections.StringKey, collections.StringValue),
+		Frozen:       collections.NewKeySet(sb, collections.NewPrefix(concat(p, frozenPrefix)), "namespace_frozen", collections.StringKey),
+	}
+}
+
+// Address returns the address of the module account of a namespace.
+func (n Namespaces) Address(name string) sdk.AccAddress {
+	return types.NewModuleAddress(n.accountName(name))
+}
+
+// Create creates a namespace administered by admin, along with its module
+// account.
+func (n Namespaces) Create(ctx context.Context, name, admin string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	if _, err := n.ak.AddressCodec().StringToBytes(admin); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid namespace admin: %s", err)
+	}
+
+	has, err := n.Admins.Has(ctx, name)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(sdkerrors.ErrConflict, "namespace %s already exists", name)
+	}
+
+	// anyone could have sent funds to the address of the module account,
+	// creating a base account which is then turned into the module account
+	macc := types.NewEmptyModuleAccount(n.accountName(name))
+	if acc := n.ak.GetAccount(ctx, macc.GetAddress()); acc != nil {
+		if _, ok := acc.(*types.BaseAccount); !ok || acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrConflict, "account %s of namespace %s already exists", macc.GetAddress(), name)
+		}
+		macc.AccountNumber = acc.GetAccountNumber()
+		n.ak.SetAccount(ctx, macc)
+	} else {
+		n.ak.SetAccount(ctx, n.ak.NewAccount(ctx, macc))
+	}
+
+	return n.Admins.Set(ctx, name, admin)
+}
+
+// Get returns a namespace.
+func (n Namespaces) Get(ctx context.Context, name string) (Namespace, error) {
+	admin, err := n.Admins.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return Namespace{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "namespace %s", name)
+		}
+		return Namespace{}, err
+	}
+
+	frozen, err := n.Frozen.Has(ctx, name)
+	if err != nil {
+		return Namespace{}, err
+	}
+
+	return Namespace{Name: name, Admin: admin, Frozen: frozen, Address: n.Address(name)}, nil
+}
+
+// Freeze makes the store of a namespace read-only. Only its admin can freeze
+// it.
+func (n Namespaces) Freeze(ctx context.Context, sender, name string) error {
+	if _, err := n.authorize(ctx, sender, name); err != nil {
+		return err
+	}
+
+	return n.Frozen.Set(ctx, name)
+}
+
+// Unfreeze makes the store of a frozen namespace writable again. Only its
+// admin can unfreeze it.
+func (n Namespaces) Unfreeze(ctx context.Context, sender, name string) error {
+	if _, err := n.authorize(ctx, sender, name); err != nil {
+		return err
+	}
+
+	return n.Frozen.Remove(ctx, name)
+}
+
+// SetAdmin hands a namespace over to a new admin. Only its current admin can
+// set it.
+func (n Namespaces) SetAdmin(ctx context.Context, sender, name, admin string) error {
+	if _, err := n.authorize(ctx, sender, name); err != nil {
+		return err
+	}
+
+	if _, err := n.ak.AddressCodec().StringToBytes(admin); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid namespace admin: %s", err)
+	}
+
+	return n.Admins.Set(ctx, name, admin)
+}
+
+// Delete deletes a namespace, its store and its module account, which must
+// not hold any funds. Only its admin can delete it.
+func (n Namespaces) Delete(ctx context.Context, sender, name string) error {
+	ns, err := n.authorize(ctx, sender, name)
+	if err != nil {
+		return err
+	}
+
+	if balances := n.bk.GetAllBalances(ctx, ns.Address); !balances.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account of namespace %s holds %s", name, balances)
+	}
+
+	if err := n.clearStore(ctx, name); err != nil {
+		return err
+	}
+
+	if acc := n.ak.GetAccount(ctx, ns.Address); acc != nil {
+		n.ak.RemoveAccount(ctx, acc)
+	}
+
+	if err := n.Frozen.Remove(ctx, name); err != nil {
+		return err
+	}
+
+	return n.Admins.Remove(ctx, name)
+}
+
+// StoreService returns the store service of a namespace, to be passed to the
+// keepers of the sub-application. Writes fail if the namespace is frozen or
+// does not exist.
+func (n Namespaces) StoreService(name string) store.KVStoreService {
+	return namespaceStoreService{namespaces: n, name: name}
+}
+
+// ValidateName returns an error if name is not a valid namespace name.
+func ValidateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "namespace name cannot be blank")
+	}
+	if len(name) > MaxNameLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "namespace name cannot be longer than %d characters", MaxNameLength)
+	}
+	if strings.Contains(name, "/") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "namespace name %s cannot contain '/'", name)
+	}
+
+	return nil
+}
+
+func (n Namespaces) authorize(ctx context.Context, sender, name string) (Namespace, error) {
+	ns, err := n.Get(ctx, name)
+	if err != nil {
+		return Namespace{}, err
+	}
+
+	if sender != ns.Admin {
+		return Namespace{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of namespace %s", sender, name)
+	}
+
+	return ns, nil
+}
+
+// checkWritable returns an error if the store of a namespace cannot be
+// written to.
+func (n Namespaces) checkWritable(ctx context.Context, name string) error {
+	ns, err := n.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if ns.Frozen {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "namespace %s is frozen", name)
+	}
+
+	return nil
+}
+
+func (n Namespaces) clearStore(ctx context.Context, name string) error {
+	kv := n.storeService.OpenKVStore(ctx)
+	prefix := n.storePrefix(name)
+
+	it, err := kv.Iterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := kv.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n Namespaces) accountName(name string) string {
+	return fmt.Sprintf("%s/%s", n.parent, name)
+}
+
+// storePrefix returns the prefix of the store of a namespace. The name is
+// length-prefixed so that no namespace store is a prefix of another.
+func (n Namespaces) storePrefix(name string) []byte {
+	return concat(n.prefix, storesPrefix, []byte{byte(len(name))}, []byte(name))
+}
+
+func concat(parts ...[]byte) []byte {
+	var b []byte
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+	return b
+}
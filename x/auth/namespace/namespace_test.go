@@ -0,0 +1,125 @@
+package namespace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/namespace"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type balances map[string]sdk.Coins
+
+func (b balances) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b[addr.String()]
+}
+
+func TestNamespaces(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	authKey := storetypes.NewKVStoreKey(types.StoreKey)
+	parentKey := storetypes.NewKVStoreKey("parent")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: authKey, "parent": parentKey},
+		map[string]*storetypes.TransientStoreKey{},
+		nil,
+	)
+
+	ak := keeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(authKey),
+		types.ProtoBaseAccount,
+		map[string][]string{},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		types.NewModuleAddress("gov").String(),
+	)
+	bk := balances{}
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(parentKey))
+	ns := namespace.NewNamespaces(sb, collections.NewPrefix(10), runtime.NewKVStoreService(parentKey), "parent", ak, bk)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	admin := sdk.AccAddress("admin").String()
+	other := sdk.AccAddress("other").String()
+
+	// funds sent to the address of a namespace before it is created
+	addr := ns.Address("app")
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+
+	require.ErrorIs(t, ns.Create(ctx, "a/b", admin), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, ns.Create(ctx, "app", admin))
+	require.NoError(t, ns.Create(ctx, "other", admin))
+	require.ErrorIs(t, ns.Create(ctx, "app", admin), sdkerrors.ErrConflict)
+
+	acc, ok := ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	require.True(t, ok)
+	require.Equal(t, "parent/app", acc.GetName())
+
+	// namespace stores are isolated from each other
+	appStore := ns.StoreService("app").OpenKVStore(ctx)
+	otherStore := ns.StoreService("other").OpenKVStore(ctx)
+	require.NoError(t, appStore.Set([]byte("k1"), []byte("v1")))
+	require.NoError(t, appStore.Set([]byte("k2"), []byte("v2")))
+	require.NoError(t, otherStore.Set([]byte("k1"), []byte("other")))
+
+	v, err := appStore.Get([]byte("k1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+
+	it, err := appStore.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"k1", "k2"}, keys)
+
+	// only the admin can freeze a namespace, whose store is then read-only
+	require.ErrorIs(t, ns.Freeze(ctx, other, "app"), sdkerrors.ErrUnauthorized)
+	require.NoError(t, ns.Freeze(ctx, admin, "app"))
+	require.ErrorIs(t, appStore.Set([]byte("k3"), []byte("v3")), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, appStore.Delete([]byte("k1")), sdkerrors.ErrUnauthorized)
+	has, err := appStore.Has([]byte("k1"))
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NoError(t, ns.Unfreeze(ctx, admin, "app"))
+	require.NoError(t, appStore.Set([]byte("k3"), []byte("v3")))
+
+	// the admin can hand the namespace over
+	require.NoError(t, ns.SetAdmin(ctx, admin, "app", other))
+	require.ErrorIs(t, ns.Delete(ctx, admin, "app"), sdkerrors.ErrUnauthorized)
+
+	// a namespace whose account holds funds cannot be deleted
+	bk[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	require.ErrorIs(t, ns.Delete(ctx, other, "app"), sdkerrors.ErrInvalidRequest)
+	delete(bk, addr.String())
+
+	require.NoError(t, ns.Delete(ctx, other, "app"))
+	_, err = ns.Get(ctx, "app")
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	require.Nil(t, ak.GetAccount(ctx, addr))
+	require.ErrorIs(t, appStore.Set([]byte("k1"), []byte("v1")), sdkerrors.ErrNotFound)
+
+	// deleting a namespace clears its store only
+	has, err = appStore.Has([]byte("k1"))
+	require.NoError(t, err)
+	require.False(t, has)
+	v, err = otherStore.Get([]byte("k1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("other"), v)
+}
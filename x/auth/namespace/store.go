@@ -0,0 +1,139 @@
+package namespace
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/core/store"
+)
+
+// namespaceStoreService opens the store of a namespace, which is a prefix of
+// the store of the parent module.
+type namespaceStoreService struct {
+	namespaces Namespaces
+	name       string
+}
+
+func (s namespaceStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return namespaceStore{
+		ctx:    ctx,
+		parent: s.namespaces.storeService.OpenKVStore(ctx),
+		prefix: s.namespaces.storePrefix(s.name),
+		ns:     s,
+	}
+}
+
+// namespaceStore is the store of a namespace. Writes are checked against the
+// state of the namespace, so that a frozen or deleted namespace cannot be
+// written to.
+type namespaceStore struct {
+	ctx    context.Context
+	parent store.KVStore
+	prefix []byte
+	ns     namespaceStoreService
+}
+
+func (s namespaceStore) key(key []byte) []byte {
+	return concat(s.prefix, key)
+}
+
+func (s namespaceStore) Get(key []byte) ([]byte, error) {
+	return s.parent.Get(s.key(key))
+}
+
+func (s namespaceStore) Has(key []byte) (bool, error) {
+	return s.parent.Has(s.key(key))
+}
+
+func (s namespaceStore) Set(key, value []byte) error {
+	if err := s.ns.namespaces.checkWritable(s.ctx, s.ns.name); err != nil {
+		return err
+	}
+
+	return s.parent.Set(s.key(key), value)
+}
+
+func (s namespaceStore) Delete(key []byte) error {
+	if err := s.ns.namespaces.checkWritable(s.ctx, s.ns.name); err != nil {
+		return err
+	}
+
+	return s.parent.Delete(s.key(key))
+}
+
+func (s namespaceStore) Iterator(start, end []byte) (store.Iterator, error) {
+	start, end = s.bounds(start, end)
+	it, err := s.parent.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return prefixIterator{Iterator: it, prefix: s.prefix}, nil
+}
+
+func (s namespaceStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	start, end = s.bounds(start, end)
+	it, err := s.parent.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return prefixIterator{Iterator: it, prefix: s.prefix}, nil
+}
+
+// bounds returns the bounds in the parent store of an iteration over the
+// namespace store.
+func (s namespaceStore) bounds(start, end []byte) ([]byte, []byte) {
+	if start == nil {
+		start = s.prefix
+	} else {
+		start = s.key(start)
+	}
+
+	if end == nil {
+		end = prefixEnd(s.prefix)
+	} else {
+		end = s.key(end)
+	}
+
+	return start, end
+}
+
+// prefixIterator strips the prefix of the namespace store from the keys of an
+// iterator over the parent store.
+type prefixIterator struct {
+	store.Iterator
+	prefix []byte
+}
+
+func (it prefixIterator) Key() []byte {
+	return it.Iterator.Key()[len(it.prefix):]
+}
+
+func (it prefixIterator) Domain() ([]byte, []byte) {
+	start, end := it.Iterator.Domain()
+	return it.strip(start), it.strip(end)
+}
+
+// strip strips the prefix from a bound of the iteration, which is nil if it
+// is a bound of the whole namespace store.
+func (it prefixIterator) strip(bound []byte) []byte {
+	if len(bound) <= len(it.prefix) || !bytes.HasPrefix(bound, it.prefix) {
+		return nil
+	}
+	return bound[len(it.prefix):]
+}
+
+// prefixEnd returns the end of the iteration over the keys starting with
+// prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
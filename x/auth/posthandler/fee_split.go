@@ -0,0 +1,90 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeSplitKeeper defines the expected interface needed to retrieve the fee
+// split weights, implemented by the x/auth keeper.
+type FeeSplitKeeper interface {
+	ante.AccountKeeper
+	GetFeeSplit(ctx context.Context) ([]types.FeeSplitWeight, error)
+}
+
+// FeeSplitBankKeeper defines the expected interface needed to move the fee
+// shares out of the fee collector.
+type FeeSplitBankKeeper interface {
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
+}
+
+// FeeSplitDecorator splits the fee of a tx, deducted into the fee collector by
+// the ante DeductFeeDecorator, between the destinations set by governance with
+// the x/auth keeper SetFeeSplit. The share of the fee collector stays in it.
+// The fee is split whether the msgs of the tx are executed successfully or
+// not, since it is paid either way.
+// CONTRACT: Tx must implement FeeTx.
+type FeeSplitDecorator struct {
+	fk FeeSplitKeeper
+	bk FeeSplitBankKeeper
+}
+
+func NewFeeSplitDecorator(fk FeeSplitKeeper, bk FeeSplitBankKeeper) FeeSplitDecorator {
+	return FeeSplitDecorator{
+		fk: fk,
+		bk: bk,
+	}
+}
+
+func (fsd FeeSplitDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	weights, err := fsd.fk.GetFeeSplit(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	for i, share := range types.SplitFee(fee, weights) {
+		if share.IsZero() {
+			continue
+		}
+
+		destination := weights[i].Destination
+		switch destination {
+		case types.FeeCollectorName:
+			// the share of the fee collector stays in it
+		case types.FeeSplitBurn:
+			err = fsd.bk.BurnCoins(ctx, fsd.fk.GetModuleAddress(types.FeeCollectorName), share)
+		default:
+			err = fsd.bk.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, destination, share)
+		}
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to split fee to %s", destination)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeSplit,
+				sdk.NewAttribute(types.AttributeKeyDestination, destination),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
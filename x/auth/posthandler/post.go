@@ -1,15 +1,37 @@
 package posthandler
 
 import (
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AccountKeeper and BankKeeper, when both set, enable the payment of tips to
+	// the fee payer. The AnteHandler must accept tips, see
+	// ante.TipExtensionOptionChecker.
+	AccountKeeper ante.AccountKeeper
+	BankKeeper    types.BankKeeper
+	// FeeSplitKeeper and FeeSplitBankKeeper, when both set, enable the split of
+	// the fees of txs between the destinations set by governance.
+	FeeSplitKeeper     FeeSplitKeeper
+	FeeSplitBankKeeper FeeSplitBankKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, paying tips and splitting fees
+// when enabled.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.AccountKeeper != nil && options.BankKeeper != nil {
+		postDecorators = append(postDecorators, NewTipDecorator(options.AccountKeeper, options.BankKeeper))
+	}
+
+	if options.FeeSplitKeeper != nil && options.FeeSplitBankKeeper != nil {
+		postDecorators = append(postDecorators, NewFeeSplitDecorator(options.FeeSplitKeeper, options.FeeSplitBankKeeper))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
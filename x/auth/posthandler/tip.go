@@ -0,0 +1,72 @@
+package posthandler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TipDecorator pays the tip of a meta-transaction, validated by the ante
+// TipDecorator, from the tipper to the fee payer. The tip is only paid when
+// the msgs of the tx are executed successfully; a tip that cannot be paid fails
+// the tx, reverting its msgs.
+// CONTRACT: Tx must implement FeeTx.
+type TipDecorator struct {
+	ak ante.AccountKeeper
+	bk types.BankKeeper
+}
+
+func NewTipDecorator(ak ante.AccountKeeper, bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		ak: ak,
+		bk: bk,
+	}
+}
+
+func (td TipDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	tip, err := ante.GetTip(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if tip == nil {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	tipper, err := td.ak.AddressCodec().StringToBytes(tip.Tipper)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address %s: %s", tip.Tipper, err)
+	}
+
+	feePayer, err := td.ak.AddressCodec().BytesToString(feeTx.FeePayer())
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := td.bk.SendCoins(ctx, tipper, feeTx.FeePayer(), tip.Amount); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to pay tip")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyTip, tip.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyTipper, tip.Tipper),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
@@ -2,8 +2,12 @@ package signing
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 
+	lru "github.com/hashicorp/golang-lru"
+
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -102,3 +106,84 @@ func VerifySignature(
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// SignatureCache caches successful signature verifications, so that a tx
+// verified in CheckTx is not verified again when it is delivered. Entries are
+// keyed by the public key, the sign bytes and the signature: as the sign bytes
+// commit to the chain ID, account number and sequence, a cached verification
+// remains valid regardless of state changes.
+type SignatureCache struct {
+	cache *lru.Cache
+}
+
+// NewSignatureCache returns a SignatureCache holding at most size entries.
+func NewSignatureCache(size int) (*SignatureCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureCache{cache: cache}, nil
+}
+
+// Has returns true if sig was successfully verified against signBytes and pubKey.
+func (c *SignatureCache) Has(pubKey cryptotypes.PubKey, signBytes, sig []byte) bool {
+	return c.cache.Contains(c.key(pubKey, signBytes, sig))
+}
+
+// Add records that sig was successfully verified against signBytes and pubKey.
+// It must only be called after a successful verification.
+func (c *SignatureCache) Add(pubKey cryptotypes.PubKey, signBytes, sig []byte) {
+	c.cache.Add(c.key(pubKey, signBytes, sig), struct{}{})
+}
+
+func (c *SignatureCache) key(pubKey cryptotypes.PubKey, signBytes, sig []byte) [sha256.Size]byte {
+	h := sha256.New()
+	for _, bz := range [][]byte{[]byte(pubKey.Type()), pubKey.Bytes(), signBytes, sig} {
+		var n [binary.MaxVarintLen64]byte
+		h.Write(n[:binary.PutUvarint(n[:], uint64(len(bz)))])
+		h.Write(bz)
+	}
+
+	var k [sha256.Size]byte
+	h.Sum(k[:0])
+	return k
+}
+
+// VerifySignatureWithCache is like VerifySignature, but skips the verification
+// of single signer signatures which were already verified successfully. A nil
+// cache disables caching.
+func VerifySignatureWithCache(
+	ctx context.Context,
+	cache *SignatureCache,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	signatureData signing.SignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	data, ok := signatureData.(*signing.SingleSignatureData)
+	if cache == nil || !ok {
+		return VerifySignature(ctx, pubKey, signerData, signatureData, handler, txData)
+	}
+
+	signMode, err := internalSignModeToAPI(data.SignMode)
+	if err != nil {
+		return err
+	}
+	signBytes, err := handler.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return err
+	}
+
+	if cache.Has(pubKey, signBytes, data.Signature) {
+		return nil
+	}
+
+	if !pubKey.VerifySignature(signBytes, data.Signature) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+
+	cache.Add(pubKey, signBytes, data.Signature)
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = AccountAmendment{}
+
+// NewAccountAmendment returns the audit record of the amendment of an account
+// decided by the proposal proposalID at height, from previous to amended.
+func NewAccountAmendment(proposalID uint64, height int64, previous, amended sdk.AccountI) (AccountAmendment, error) {
+	previousAny, err := codectypes.NewAnyWithValue(previous)
+	if err != nil {
+		return AccountAmendment{}, err
+	}
+	amendedAny, err := codectypes.NewAnyWithValue(amended)
+	if err != nil {
+		return AccountAmendment{}, err
+	}
+
+	return AccountAmendment{
+		ProposalId: proposalID,
+		Height:     height,
+		Previous:   previousAny,
+		Amended:    amendedAny,
+	}, nil
+}
+
+// GetPreviousAccount returns the account before the amendment.
+func (a AccountAmendment) GetPreviousAccount() (sdk.AccountI, error) {
+	return cachedAccount(a.Previous)
+}
+
+// GetAmendedAccount returns the account after the amendment.
+func (a AccountAmendment) GetAmendedAccount() (sdk.AccountI, error) {
+	return cachedAccount(a.Amended)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AccountAmendment) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var previous, amended sdk.AccountI
+	if err := unpacker.UnpackAny(a.Previous, &previous); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(a.Amended, &amended)
+}
+
+func cachedAccount(any *codectypes.Any) (sdk.AccountI, error) {
+	acc, ok := any.GetCachedValue().(sdk.AccountI)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (sdk.AccountI)(nil), any.GetCachedValue())
+	}
+	return acc, nil
+}
@@ -244,63 +244,203 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// AccountAmendment is the audit record of an amendment of an account decided
+// by governance, e.g. of the schedule of a vesting account.
+type AccountAmendment struct {
+	// proposal_id is the id of the proposal which decided the amendment.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the height at which the account was amended.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// previous is the account before the amendment.
+	Previous *types.Any `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// amended is the account after the amendment.
+	Amended *types.Any `protobuf:"bytes,4,opt,name=amended,proto3" json:"amended,omitempty"`
+}
+
+func (m *AccountAmendment) Reset()         { *m = AccountAmendment{} }
+func (m *AccountAmendment) String() string { return proto.CompactTextString(m) }
+func (*AccountAmendment) ProtoMessage()    {}
+func (*AccountAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *AccountAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAmendment.Merge(m, src)
+}
+func (m *AccountAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAmendment proto.InternalMessageInfo
+
+func (m *AccountAmendment) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *AccountAmendment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccountAmendment) GetPrevious() *types.Any {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *AccountAmendment) GetAmended() *types.Any {
+	if m != nil {
+		return m.Amended
+	}
+	return nil
+}
+
+// FeeSplitWeight is the weight of the share of the fees of txs sent to a
+// destination: the fee collector, another module account, or "burn".
+type FeeSplitWeight struct {
+	// destination is the name of the module account receiving the share, or
+	// "burn" to burn it.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// weight is the weight of the share of the destination.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *FeeSplitWeight) Reset()         { *m = FeeSplitWeight{} }
+func (m *FeeSplitWeight) String() string { return proto.CompactTextString(m) }
+func (*FeeSplitWeight) ProtoMessage()    {}
+func (*FeeSplitWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *FeeSplitWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplitWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplitWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplitWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplitWeight.Merge(m, src)
+}
+func (m *FeeSplitWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplitWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplitWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplitWeight proto.InternalMessageInfo
+
+func (m *FeeSplitWeight) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *FeeSplitWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*AccountAmendment)(nil), "cosmos.auth.v1beta1.AccountAmendment")
+	proto.RegisterType((*FeeSplitWeight)(nil), "cosmos.auth.v1beta1.FeeSplitWeight")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x67, 0x97, 0xa4, 0x99, 0x4d, 0x43, 0xe3, 0x2e, 0xc1, 0x8d, 0xd0, 0xae, 0xbb, 0x12,
+	0x74, 0x15, 0x11, 0x2f, 0xd9, 0x2a, 0x48, 0xe4, 0x96, 0x0d, 0x7f, 0x14, 0x4a, 0x4b, 0x34, 0x2b,
+	0x8a, 0xd4, 0x8b, 0x35, 0xb6, 0x5f, 0x9d, 0x51, 0x76, 0x3c, 0x66, 0x66, 0x1c, 0xd6, 0x3d, 0x73,
+	0xa8, 0x38, 0x21, 0x3e, 0x41, 0xe0, 0x13, 0xe4, 0xd0, 0x0f, 0x81, 0x38, 0x45, 0x5c, 0xe0, 0x14,
+	0xa1, 0xcd, 0x21, 0x15, 0xe2, 0xc2, 0x37, 0x40, 0x9e, 0xf1, 0x26, 0x9b, 0x6a, 0x05, 0x87, 0x5e,
+	0x2c, 0xbf, 0xdf, 0xfb, 0xcd, 0x7b, 0xbf, 0xf7, 0x9b, 0x67, 0xa3, 0x66, 0xc8, 0x25, 0xe3, 0xb2,
+	0x4b, 0x32, 0x75, 0xd0, 0x3d, 0xda, 0x0c, 0x40, 0x91, 0x4d, 0x1d, 0x78, 0xa9, 0xe0, 0x8a, 0xdb,
+	0xb7, 0x4d, 0xde, 0xd3, 0x50, 0x99, 0x5f, 0x5b, 0x21, 0x8c, 0x26, 0xbc, 0xab, 0x9f, 0x86, 0xb7,
+	0x76, 0xc7, 0xf0, 0x7c, 0x1d, 0x75, 0xcb, 0x43, 0x26, 0xd5, 0x88, 0x79, 0xcc, 0x0d, 0x5e, 0xbc,
+	0x4d, 0x0e, 0xc4, 0x9c, 0xc7, 0x43, 0xe8, 0xea, 0x28, 0xc8, 0x9e, 0x76, 0x49, 0x92, 0x9b, 0x54,
+	0xfb, 0xa7, 0x39, 0x54, 0xef, 0x13, 0x09, 0x3b, 0x61, 0xc8, 0xb3, 0x44, 0xd9, 0x3d, 0xb4, 0x40,
+	0xa2, 0x48, 0x80, 0x94, 0x8e, 0xe5, 0x5a, 0x9d, 0xc5, 0xbe, 0xf3, 0xdb, 0x8b, 0x8d, 0x46, 0xd9,
+	0x63, 0xc7, 0x64, 0x06, 0x4a, 0xd0, 0x24, 0xc6, 0x13, 0xa2, 0xfd, 0x18, 0x2d, 0xa4, 0x59, 0xe0,
+	0x1f, 0x42, 0xee, 0xcc, 0xb9, 0x56, 0xa7, 0xde, 0x6b, 0x78, 0xa6, 0xa1, 0x37, 0x69, 0xe8, 0xed,
+	0x24, 0x79, 0xff, 0xde, 0x5f, 0x67, 0xad, 0x46, 0x9a, 0x05, 0x43, 0x1a, 0x16, 0xdc, 0xf7, 0x39,
+	0xa3, 0x0a, 0x58, 0xaa, 0xf2, 0x9f, 0x2f, 0x4e, 0xd6, 0xd1, 0x55, 0x02, 0xcf, 0xa7, 0x59, 0xf0,
+	0x00, 0x72, 0xfb, 0x5d, 0xb4, 0x4c, 0x8c, 0x2c, 0x3f, 0xc9, 0x58, 0x00, 0xc2, 0xa9, 0xba, 0x56,
+	0xa7, 0x86, 0x6f, 0x96, 0xe8, 0x23, 0x0d, 0xda, 0x6b, 0xe8, 0x86, 0x84, 0x6f, 0x32, 0x48, 0x42,
+	0x70, 0x6a, 0x9a, 0x70, 0x19, 0x6f, 0xef, 0x3e, 0x3f, 0x6e, 0x55, 0x5e, 0x1e, 0xb7, 0x2a, 0xbf,
+	0xbe, 0xd8, 0x78, 0x67, 0x86, 0xbd, 0x5e, 0x39, 0xf7, 0xde, 0xf7, 0x17, 0x27, 0xeb, 0xab, 0x86,
+	0xb0, 0x21, 0xa3, 0xc3, 0xee, 0x94, 0x27, 0xed, 0xbf, 0x2d, 0x74, 0xf3, 0x21, 0x8f, 0xb2, 0xe1,
+	0xa5, 0x4b, 0x7b, 0x68, 0x29, 0x20, 0x12, 0xfc, 0x52, 0x88, 0xb6, 0xaa, 0xde, 0x73, 0xbd, 0x59,
+	0x1d, 0xa6, 0x2a, 0xf5, 0x6b, 0xa7, 0x67, 0x2d, 0x0b, 0xd7, 0x83, 0x29, 0xc3, 0x6d, 0x54, 0x4b,
+	0x08, 0x03, 0xed, 0xdc, 0x22, 0xd6, 0xef, 0xb6, 0x8b, 0xea, 0x29, 0x08, 0x46, 0xa5, 0xa4, 0x3c,
+	0x91, 0x4e, 0xd5, 0xad, 0x76, 0x16, 0xf1, 0x34, 0xb4, 0xfd, 0xe4, 0xb9, 0x99, 0xa9, 0x3d, 0xab,
+	0xe3, 0x35, 0xad, 0x7a, 0x32, 0x67, 0x6a, 0xb2, 0x6b, 0xd9, 0x1f, 0x2f, 0x4e, 0xd6, 0x97, 0x99,
+	0x46, 0x26, 0xc3, 0xb4, 0xbf, 0xb3, 0xd0, 0x2d, 0x43, 0xda, 0x15, 0x10, 0x41, 0xa2, 0x28, 0x19,
+	0xda, 0x2d, 0x54, 0x2f, 0x69, 0x5a, 0xad, 0xde, 0x0d, 0x8c, 0x0c, 0xf4, 0xa8, 0xd0, 0x7c, 0x0f,
+	0xbd, 0x19, 0x81, 0xa0, 0x47, 0x44, 0x51, 0x9e, 0x14, 0xd7, 0x28, 0x9d, 0x39, 0xb7, 0xda, 0x59,
+	0xc2, 0xcb, 0x57, 0xf0, 0x03, 0xc8, 0xe5, 0xf6, 0x7b, 0x85, 0xa0, 0xbb, 0x53, 0x82, 0x3e, 0x13,
+	0x3c, 0x4b, 0x4b, 0x3d, 0x57, 0x1d, 0xdb, 0xbf, 0xcf, 0xa1, 0xf9, 0x7d, 0x22, 0x08, 0x93, 0xb6,
+	0x87, 0x6e, 0x33, 0x32, 0xf2, 0x19, 0x30, 0xee, 0x87, 0x07, 0x44, 0x90, 0x50, 0x81, 0x30, 0x0b,
+	0x5a, 0xc3, 0x2b, 0x8c, 0x8c, 0x1e, 0x02, 0xe3, 0xbb, 0x97, 0x09, 0xdb, 0x45, 0x4b, 0x6a, 0xe4,
+	0x4b, 0x1a, 0xfb, 0x43, 0xca, 0xa8, 0xd2, 0xde, 0xd6, 0x30, 0x52, 0xa3, 0x01, 0x8d, 0xbf, 0x28,
+	0x10, 0xfb, 0x03, 0xf4, 0x96, 0x66, 0x3c, 0x03, 0x3f, 0xe4, 0x52, 0xf9, 0x29, 0x08, 0x3f, 0xc8,
+	0x15, 0x94, 0x1b, 0xb6, 0x52, 0x50, 0x9f, 0xc1, 0x2e, 0x97, 0x6a, 0x1f, 0x44, 0x3f, 0x57, 0x60,
+	0x7f, 0x89, 0xde, 0x2e, 0x0a, 0x1e, 0x81, 0xa0, 0x4f, 0x73, 0x73, 0x08, 0xa2, 0xde, 0xd6, 0xd6,
+	0xe6, 0x47, 0x66, 0xe9, 0xfa, 0xce, 0xf8, 0xac, 0xd5, 0x18, 0xd0, 0xf8, 0xb1, 0x66, 0x14, 0x47,
+	0x3f, 0xf9, 0x58, 0xe7, 0x71, 0x43, 0x5e, 0x43, 0xcd, 0x29, 0xfb, 0x2b, 0x74, 0xe7, 0xd5, 0x82,
+	0x12, 0xc2, 0xb4, 0xb7, 0xf5, 0xe1, 0xe1, 0xa6, 0xf3, 0x86, 0x2e, 0xb9, 0x36, 0x3e, 0x6b, 0xad,
+	0x5e, 0x2b, 0x39, 0x98, 0x30, 0xf0, 0xaa, 0x9c, 0x89, 0x6f, 0xdf, 0x7d, 0x79, 0xdc, 0xb2, 0x5e,
+	0xbd, 0xf3, 0x91, 0xf9, 0xe7, 0x18, 0x3b, 0xdb, 0xff, 0x58, 0xe8, 0x56, 0xe9, 0xf7, 0x0e, 0x83,
+	0x24, 0x62, 0x90, 0xa8, 0xe2, 0x82, 0x53, 0xc1, 0x53, 0x2e, 0xc9, 0xd0, 0xa7, 0x51, 0xe9, 0x2d,
+	0x9a, 0x40, 0x7b, 0x91, 0xbd, 0x8a, 0xe6, 0x0f, 0x80, 0xc6, 0x07, 0xc6, 0xce, 0x2a, 0x2e, 0x23,
+	0x1b, 0xa3, 0x1b, 0xa9, 0x80, 0x23, 0xca, 0x33, 0xe9, 0x54, 0xff, 0xe3, 0xf3, 0x77, 0xff, 0xef,
+	0x13, 0xc4, 0x97, 0x75, 0xec, 0x7d, 0xb4, 0x40, 0x0a, 0x65, 0x10, 0x39, 0xb5, 0xd7, 0x2a, 0x39,
+	0x29, 0xd3, 0xfe, 0x1c, 0x2d, 0x7f, 0x0a, 0x30, 0x48, 0x87, 0x54, 0x7d, 0x6d, 0x74, 0xbb, 0xa8,
+	0x1e, 0x81, 0x54, 0x34, 0xd1, 0xab, 0x59, 0x6e, 0xf4, 0x34, 0x54, 0x4c, 0xfc, 0xed, 0xd5, 0xc4,
+	0x35, 0x5c, 0x46, 0xfd, 0xfb, 0xbf, 0x8c, 0x9b, 0xd6, 0xe9, 0xb8, 0x69, 0xfd, 0x39, 0x6e, 0x5a,
+	0x3f, 0x9c, 0x37, 0x2b, 0xa7, 0xe7, 0xcd, 0xca, 0x1f, 0xe7, 0xcd, 0xca, 0x93, 0xf2, 0xcf, 0x2c,
+	0xa3, 0x43, 0x8f, 0xf2, 0x89, 0xeb, 0x2a, 0x4f, 0x41, 0x06, 0xf3, 0x5a, 0xf9, 0xfd, 0x7f, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xa4, 0xde, 0xc8, 0x13, 0x05, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -529,6 +669,98 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amended != nil {
+		{
+			size, err := m.Amended.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplitWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplitWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplitWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -629,6 +861,45 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *AccountAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovAuth(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Amended != nil {
+		l = m.Amended.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeSplitWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovAuth(uint64(m.Weight))
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1200,6 +1471,267 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &types.Any{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amended == nil {
+				m.Amended = &types.Any{}
+			}
+			if err := m.Amended.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplitWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplitWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplitWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
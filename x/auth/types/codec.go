@@ -22,6 +22,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgChangePubKey{}, "cosmos-sdk/x/auth/MsgChangePubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPubKeyChange{}, "cosmos-sdk/x/auth/MsgCancelPubKeyChange")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeeSplit{}, "cosmos-sdk/x/auth/MsgSetFeeSplit")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -58,5 +61,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgChangePubKey{},
+		&MsgCancelPubKeyChange{},
+		&MsgSetFeeSplit{},
 	)
 }
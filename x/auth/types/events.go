@@ -0,0 +1,13 @@
+package types
+
+// auth module event types and attributes
+const (
+	EventTypeChangePubKey       = "change_pub_key"
+	EventTypeCancelPubKeyChange = "cancel_pub_key_change"
+	EventTypeFeeSplit           = "fee_split"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyPubKey        = "pub_key"
+	AttributeKeyEffectiveTime = "effective_time"
+	AttributeKeyDestination   = "destination"
+)
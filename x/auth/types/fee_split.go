@@ -0,0 +1,75 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeSplitBurn is the fee split destination burning its share of the fees.
+const FeeSplitBurn = "burn"
+
+// ValidateFeeSplit validates fee split weights: each destination must be
+// listed once, with a positive weight.
+func ValidateFeeSplit(weights []FeeSplitWeight) error {
+	seen := make(map[string]bool, len(weights))
+	for _, w := range weights {
+		if w.Destination == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty fee split destination")
+		}
+		if seen[w.Destination] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate fee split destination %s", w.Destination)
+		}
+		seen[w.Destination] = true
+
+		if w.Weight == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee split destination %s has a zero weight", w.Destination)
+		}
+	}
+
+	return nil
+}
+
+// SplitFee splits fee between weights, in proportion to their weight, and
+// returns the share of each of them, in the same order. For each denom, the
+// remainder of the division goes to the destination with the largest weight,
+// the first one on ties, so that the shares always add up to fee.
+func SplitFee(fee sdk.Coins, weights []FeeSplitWeight) []sdk.Coins {
+	shares := make([]sdk.Coins, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+
+	total := math.ZeroInt()
+	largest := 0
+	for i, w := range weights {
+		total = total.Add(math.NewIntFromUint64(w.Weight))
+		if w.Weight > weights[largest].Weight {
+			largest = i
+		}
+	}
+	if total.IsZero() {
+		return shares
+	}
+
+	for _, coin := range fee {
+		remainder := coin.Amount
+		for i, w := range weights {
+			amount := coin.Amount.Mul(math.NewIntFromUint64(w.Weight)).Quo(total)
+			remainder = remainder.Sub(amount)
+			shares[i] = addFeeShare(shares[i], coin.Denom, amount)
+		}
+		shares[largest] = addFeeShare(shares[largest], coin.Denom, remainder)
+	}
+
+	return shares
+}
+
+func addFeeShare(share sdk.Coins, denom string, amount math.Int) sdk.Coins {
+	if amount.IsZero() {
+		return share
+	}
+	return share.Add(sdk.NewCoin(denom, amount))
+}
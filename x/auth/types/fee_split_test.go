@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSplitFee(t *testing.T) {
+	weights := []types.FeeSplitWeight{
+		{Destination: types.FeeSplitBurn, Weight: 1},
+		{Destination: types.FeeCollectorName, Weight: 3},
+		{Destination: "protocolpool", Weight: 3},
+	}
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 6))
+	shares := types.SplitFee(fee, weights)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 14)),
+		// the remainder goes to the first largest weight
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 44), sdk.NewInt64Coin("stake", 4)),
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 42), sdk.NewInt64Coin("stake", 2)),
+	}, shares)
+
+	var total sdk.Coins
+	for _, share := range shares {
+		total = total.Add(share...)
+	}
+	require.Equal(t, fee, total)
+
+	// zero weights don't split anything
+	require.Equal(t, []sdk.Coins{nil}, types.SplitFee(fee, []types.FeeSplitWeight{{Destination: types.FeeSplitBurn}}))
+}
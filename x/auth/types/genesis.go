@@ -40,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, amendment := range g.AccountAmendments {
+		if err := amendment.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -72,7 +77,42 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	if err := ValidateAccountAmendments(data.AccountAmendments); err != nil {
+		return err
+	}
+
+	return ValidateFeeSplit(data.FeeSplit)
+}
+
+// ValidateAccountAmendments validates the audit records of the accounts
+// amended by governance: an account is amended at most once per proposal.
+func ValidateAccountAmendments(amendments []AccountAmendment) error {
+	seen := make(map[string]bool, len(amendments))
+	for _, amendment := range amendments {
+		previous, err := amendment.GetPreviousAccount()
+		if err != nil {
+			return fmt.Errorf("invalid previous account of amendment by proposal %d: %w", amendment.ProposalId, err)
+		}
+		amended, err := amendment.GetAmendedAccount()
+		if err != nil {
+			return fmt.Errorf("invalid amended account of amendment by proposal %d: %w", amendment.ProposalId, err)
+		}
+		if !previous.GetAddress().Equals(amended.GetAddress()) {
+			return fmt.Errorf("amended account %s is not account %s", amended.GetAddress(), previous.GetAddress())
+		}
+
+		key := fmt.Sprintf("%s/%d", previous.GetAddress(), amendment.ProposalId)
+		if seen[key] {
+			return fmt.Errorf("account %s was already amended by proposal %d", previous.GetAddress(), amendment.ProposalId)
+		}
+		seen[key] = true
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
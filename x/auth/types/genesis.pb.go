@@ -31,6 +31,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// account_amendments are the audit records of the accounts amended by
+	// governance.
+	AccountAmendments []AccountAmendment `protobuf:"bytes,3,rep,name=account_amendments,json=accountAmendments,proto3" json:"account_amendments"`
+	// fee_split are the weights by which the fees of txs are split between
+	// destinations.
+	FeeSplit []FeeSplitWeight `protobuf:"bytes,4,rep,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +86,20 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetAccountAmendments() []AccountAmendment {
+	if m != nil {
+		return m.AccountAmendments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSplit() []FeeSplitWeight {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +107,28 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4f, 0x02, 0x31,
+	0x18, 0x86, 0xef, 0x80, 0x10, 0x28, 0x2e, 0x54, 0x06, 0xc0, 0xa4, 0xa2, 0xc6, 0x84, 0x38, 0xb4,
+	0x02, 0xbb, 0x09, 0x0c, 0xb8, 0x1a, 0x18, 0x4c, 0x58, 0x48, 0x81, 0x8f, 0x72, 0x91, 0x6b, 0x2f,
+	0xb4, 0x18, 0xf9, 0x17, 0xfe, 0x08, 0x07, 0x47, 0x7f, 0x06, 0x23, 0xa3, 0x93, 0x31, 0x30, 0xf8,
+	0x37, 0xcc, 0xb5, 0x87, 0x83, 0xb9, 0xe5, 0xf2, 0xe5, 0xbd, 0xe7, 0x7b, 0xbf, 0xf7, 0x2d, 0xba,
+	0x98, 0x2a, 0x1d, 0x2a, 0xcd, 0xf8, 0xda, 0x2c, 0xd8, 0x73, 0x6b, 0x02, 0x86, 0xb7, 0x98, 0x00,
+	0x09, 0x3a, 0xd0, 0x34, 0x5a, 0x29, 0xa3, 0xf0, 0xa9, 0x43, 0x68, 0x8c, 0xd0, 0x04, 0xa9, 0xd7,
+	0x84, 0x52, 0x62, 0x09, 0xcc, 0x22, 0x93, 0xf5, 0x9c, 0x71, 0xb9, 0x71, 0x7c, 0xbd, 0x22, 0x94,
+	0x50, 0x76, 0x64, 0xf1, 0x94, 0xa8, 0x24, 0xed, 0x90, 0xb5, 0x74, 0xff, 0xcb, 0x3c, 0x0c, 0xa4,
+	0x62, 0xf6, 0xeb, 0xa4, 0xcb, 0xb7, 0x0c, 0x3a, 0xb9, 0x77, 0x51, 0x86, 0x86, 0x1b, 0xc0, 0x77,
+	0x28, 0x1f, 0xf1, 0x15, 0x0f, 0x75, 0xd5, 0x6f, 0xf8, 0xcd, 0x52, 0xfb, 0x8c, 0xa6, 0x44, 0xa3,
+	0x0f, 0x16, 0xe9, 0x15, 0xb7, 0x5f, 0xe7, 0xde, 0xfb, 0xcf, 0xc7, 0x8d, 0x3f, 0x48, 0xb6, 0xf0,
+	0x2d, 0x2a, 0xf0, 0xe9, 0x54, 0xad, 0xa5, 0xd1, 0xd5, 0x4c, 0x23, 0xdb, 0x2c, 0xb5, 0x2b, 0xd4,
+	0xf5, 0xa0, 0xc7, 0x1e, 0xb4, 0x2b, 0x37, 0x83, 0x3f, 0x0a, 0x8f, 0x10, 0x4e, 0xe6, 0x31, 0x0f,
+	0x41, 0xce, 0x42, 0x88, 0x77, 0xb3, 0x76, 0xf7, 0x3a, 0xf5, 0x7a, 0xd7, 0xe1, 0xdd, 0x23, 0xdd,
+	0xcb, 0xc5, 0x39, 0x06, 0x65, 0xfe, 0x4f, 0xd7, 0xb8, 0x8f, 0x8a, 0x73, 0x80, 0xb1, 0x8e, 0x96,
+	0x81, 0xa9, 0xe6, 0xac, 0xe5, 0x55, 0xaa, 0x65, 0x1f, 0x60, 0x18, 0x43, 0x8f, 0x10, 0x88, 0xc5,
+	0xd1, 0xb0, 0x30, 0x4f, 0xd4, 0x5e, 0x67, 0xbb, 0x27, 0xfe, 0x6e, 0x4f, 0xfc, 0xef, 0x3d, 0xf1,
+	0x5f, 0x0f, 0xc4, 0xdb, 0x1d, 0x88, 0xf7, 0x79, 0x20, 0xde, 0xa8, 0xe6, 0xdc, 0xf4, 0xec, 0x89,
+	0x06, 0x8a, 0xbd, 0xb8, 0xb7, 0x37, 0x9b, 0x08, 0xf4, 0x24, 0x6f, 0x0b, 0x77, 0x7e, 0x03, 0x00,
+	0x00, 0xff, 0xff, 0xd6, 0x61, 0x22, 0x66, 0x00, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +151,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSplit) > 0 {
+		for iNdEx := len(m.FeeSplit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AccountAmendments) > 0 {
+		for iNdEx := len(m.AccountAmendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAmendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +231,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountAmendments) > 0 {
+		for _, e := range m.AccountAmendments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSplit) > 0 {
+		for _, e := range m.FeeSplit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +348,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAmendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAmendments = append(m.AccountAmendments, AccountAmendment{})
+			if err := m.AccountAmendments[len(m.AccountAmendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplit = append(m.FeeSplit, FeeSplitWeight{})
+			if err := m.FeeSplit[len(m.FeeSplit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
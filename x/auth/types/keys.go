@@ -28,4 +28,19 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// PendingPubKeyPrefix prefix for the time-locked public key changes of accounts
+	PendingPubKeyPrefix = collections.NewPrefix(3)
+
+	// PendingPubKeyTimePrefix prefix for the time at which pending public key changes take effect
+	PendingPubKeyTimePrefix = collections.NewPrefix(4)
+
+	// PubKeyIndexPrefix prefix for the optional account-by-public-key index
+	PubKeyIndexPrefix = collections.NewPrefix(5)
+
+	// AccountAmendmentsPrefix prefix for the audit records of the accounts amended by governance
+	AccountAmendmentsPrefix = collections.NewPrefix(6)
+
+	// FeeSplitPrefix prefix for the weights by which the fees of txs are split between destinations
+	FeeSplitPrefix = collections.NewPrefix(7)
 )
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &MsgChangePubKey{}
+
+// NewMsgChangePubKey returns a MsgChangePubKey rotating the public key of the
+// account at address to pubKey after delay.
+func NewMsgChangePubKey(address string, pubKey cryptotypes.PubKey, delay time.Duration) (*MsgChangePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgChangePubKey{
+		Address: address,
+		PubKey:  pkAny,
+		Delay:   delay,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,3 +12,22 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryAccountByPubKeyRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryAccountByPubKeyRequest{}
+
+func (m *QueryAccountByPubKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Accounts {
+		var account sdk.AccountI
+		if err := unpacker.UnpackAny(any, &account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryAccountByPubKeyResponse{}
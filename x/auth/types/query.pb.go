@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -962,6 +963,182 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAccountByPubKeyRequest is the Query/AccountByPubKey request type.
+type QueryAccountByPubKeyRequest struct {
+	// pub_key is the public key of the accounts to query.
+	PubKey *types.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryAccountByPubKeyRequest) Reset()         { *m = QueryAccountByPubKeyRequest{} }
+func (m *QueryAccountByPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountByPubKeyRequest) ProtoMessage()    {}
+func (*QueryAccountByPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountByPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountByPubKeyRequest.Merge(m, src)
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountByPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountByPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountByPubKeyRequest proto.InternalMessageInfo
+
+func (m *QueryAccountByPubKeyRequest) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// QueryAccountByPubKeyResponse is the Query/AccountByPubKey response type.
+type QueryAccountByPubKeyResponse struct {
+	// accounts are the accounts with the public key of the request.
+	Accounts []*types.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryAccountByPubKeyResponse) Reset()         { *m = QueryAccountByPubKeyResponse{} }
+func (m *QueryAccountByPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountByPubKeyResponse) ProtoMessage()    {}
+func (*QueryAccountByPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountByPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountByPubKeyResponse.Merge(m, src)
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountByPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountByPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountByPubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryAccountByPubKeyResponse) GetAccounts() []*types.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// QueryFeeSplitRequest is the Query/FeeSplit request type.
+type QueryFeeSplitRequest struct {
+}
+
+func (m *QueryFeeSplitRequest) Reset()         { *m = QueryFeeSplitRequest{} }
+func (m *QueryFeeSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitRequest) ProtoMessage()    {}
+func (*QueryFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryFeeSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitRequest proto.InternalMessageInfo
+
+// QueryFeeSplitResponse is the Query/FeeSplit response type.
+type QueryFeeSplitResponse struct {
+	// weights are the weights by which the fees of txs are split between
+	// destinations, by destination.
+	Weights []FeeSplitWeight `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights"`
+}
+
+func (m *QueryFeeSplitResponse) Reset()         { *m = QueryFeeSplitResponse{} }
+func (m *QueryFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitResponse) ProtoMessage()    {}
+func (*QueryFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitResponse) GetWeights() []FeeSplitWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,78 +1160,93 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAccountByPubKeyRequest)(nil), "cosmos.auth.v1beta1.QueryAccountByPubKeyRequest")
+	proto.RegisterType((*QueryAccountByPubKeyResponse)(nil), "cosmos.auth.v1beta1.QueryAccountByPubKeyResponse")
+	proto.RegisterType((*QueryFeeSplitRequest)(nil), "cosmos.auth.v1beta1.QueryFeeSplitRequest")
+	proto.RegisterType((*QueryFeeSplitResponse)(nil), "cosmos.auth.v1beta1.QueryFeeSplitResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x24, 0x2b, 0xf1, 0xe3, 0x54, 0xb3, 0x80, 0x7a, 0x58, 0x14, 0xdc,
-	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xda, 0x49, 0x2d, 0x36, 0x9e, 0x6c, 0xec, 0xc0, 0x86, 0x2a, 0x17,
-	0x24, 0xa4, 0x5e, 0x90, 0x90, 0xe0, 0x0f, 0xd8, 0x03, 0xe2, 0xbc, 0x48, 0xe5, 0xc6, 0x1f, 0xb0,
-	0xda, 0xd3, 0x0a, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xe0, 0xc6, 0xbf, 0x80, 0x32, 0xf3, 0xec, 0xd8,
-	0xed, 0x24, 0x71, 0xd9, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xb3, 0xbf, 0x2d, 0x14,
-	0x0e, 0xa9, 0xd7, 0xa1, 0x9e, 0x66, 0xf6, 0xfd, 0x23, 0xed, 0xf3, 0xdd, 0xa6, 0xed, 0x9b, 0xbb,
-	0xda, 0x83, 0xbe, 0xdd, 0x1b, 0xa8, 0xdd, 0x1e, 0xf5, 0x29, 0x59, 0xe5, 0x01, 0xea, 0x28, 0x40,
-	0xc5, 0x00, 0x79, 0x07, 0xb3, 0x9a, 0xa6, 0x67, 0xf3, 0xe8, 0x30, 0xb7, 0x6b, 0xb6, 0x1d, 0xd7,
-	0xf4, 0x1d, 0xea, 0x72, 0x01, 0x39, 0xd3, 0xa6, 0x6d, 0xca, 0x1e, 0xb5, 0xd1, 0x13, 0xae, 0xbe,
-	0xdc, 0xa6, 0xb4, 0x7d, 0xdf, 0xd6, 0xd8, 0xaf, 0x66, 0xbf, 0xa5, 0x99, 0x2e, 0x56, 0x94, 0xd7,
-	0x70, 0xcb, 0xec, 0x3a, 0x9a, 0xe9, 0xba, 0xd4, 0x67, 0x6a, 0x1e, 0xee, 0xe6, 0x45, 0xc0, 0x0c,
-	0x0e, 0x85, 0xf9, 0x7e, 0x83, 0x57, 0x44, 0x78, 0xbe, 0x95, 0xc3, 0xd4, 0x00, 0x38, 0x7a, 0x4e,
-	0xe5, 0x53, 0xc8, 0x7c, 0x38, 0xfa, 0xb9, 0x77, 0x78, 0x48, 0xfb, 0xae, 0xef, 0x19, 0xf6, 0x83,
-	0xbe, 0xed, 0xf9, 0xe4, 0x3d, 0x80, 0xf1, 0x91, 0xb2, 0x52, 0x51, 0x2a, 0x2d, 0x57, 0x36, 0x55,
-	0xd4, 0x1d, 0x9d, 0x5f, 0xe5, 0x2a, 0x88, 0xa2, 0xd6, 0xcd, 0xb6, 0x8d, 0xb9, 0x46, 0x24, 0x53,
-	0x39, 0x95, 0xe0, 0xe6, 0x85, 0x02, 0x5e, 0x97, 0xba, 0x9e, 0x4d, 0x0c, 0x58, 0x32, 0x71, 0x2d,
-	0x2b, 0x15, 0xaf, 0x95, 0x96, 0x2b, 0x19, 0x95, 0xb7, 0x40, 0x0d, 0xba, 0xa3, 0xee, 0xb9, 0x03,
-	0xbd, 0xf8, 0xf4, 0xb4, 0xbc, 0x26, 0xb8, 0x0d, 0x15, 0x15, 0xf7, 0x8d, 0x50, 0x87, 0xbc, 0x1f,
-	0xa3, 0x9e, 0x63, 0xd4, 0x5b, 0x33, 0xa9, 0x39, 0x50, 0x0c, 0xfb, 0x00, 0x56, 0xa3, 0xd4, 0x41,
-	0x57, 0x2a, 0xb0, 0x68, 0x5a, 0x56, 0xcf, 0xf6, 0x3c, 0xd6, 0x92, 0xeb, 0x7a, 0xf6, 0xd7, 0xd3,
-	0x72, 0x06, 0xf5, 0xf7, 0xf8, 0xce, 0x81, 0xdf, 0x73, 0xdc, 0xb6, 0x11, 0x04, 0xbe, 0xb5, 0x74,
-	0xf2, 0xa8, 0x90, 0xfa, 0xe7, 0x51, 0x21, 0xa5, 0x1c, 0xc5, 0x7b, 0x1d, 0x76, 0xa2, 0x0e, 0x8b,
-	0x78, 0x02, 0x6c, 0xf4, 0xff, 0x6d, 0x44, 0x20, 0xa3, 0x64, 0x80, 0xb0, 0x4a, 0x75, 0xb3, 0x67,
-	0x76, 0x82, 0x3b, 0x55, 0xea, 0x78, 0xa8, 0x60, 0x15, 0xcb, 0xbf, 0x09, 0x0b, 0x5d, 0xb6, 0x82,
-	0xd5, 0x73, 0xaa, 0xa8, 0x08, 0x4f, 0xd2, 0xe7, 0x9f, 0xfc, 0x51, 0x48, 0x19, 0x98, 0xa0, 0xac,
-	0x81, 0xcc, 0x14, 0xef, 0x52, 0xab, 0x7f, 0xdf, 0xbe, 0x30, 0x43, 0xca, 0x17, 0x90, 0x13, 0xee,
-	0x62, 0xdd, 0x8f, 0x13, 0x0e, 0xc0, 0xe6, 0xd3, 0xd3, 0xb2, 0x22, 0x42, 0x8a, 0xe9, 0x46, 0xc6,
-	0x40, 0xb9, 0x0d, 0x85, 0xcb, 0x85, 0xf5, 0xc1, 0x07, 0x66, 0x27, 0x98, 0x51, 0x42, 0x60, 0xde,
-	0x35, 0x3b, 0x36, 0xbf, 0x46, 0x83, 0x3d, 0x2b, 0x5f, 0x42, 0x71, 0x72, 0x1a, 0x42, 0x7f, 0x94,
-	0xec, 0xae, 0x92, 0x32, 0x87, 0x37, 0x76, 0x13, 0x56, 0x75, 0xfb, 0xf0, 0xa8, 0x5a, 0xa9, 0xf7,
-	0xec, 0x96, 0xf3, 0x30, 0x68, 0xe1, 0xdb, 0x90, 0x89, 0x2f, 0x23, 0xc6, 0x06, 0xdc, 0x68, 0xb2,
-	0xf5, 0x46, 0x97, 0x6d, 0xe0, 0x39, 0xd2, 0xcd, 0x48, 0xb0, 0xa2, 0x43, 0x0e, 0x67, 0x52, 0x1f,
-	0xf8, 0xb6, 0x77, 0x8f, 0xe2, 0x68, 0x62, 0x0b, 0x36, 0xe0, 0x06, 0xce, 0x68, 0xa3, 0x39, 0xda,
-	0x67, 0x1a, 0x69, 0x23, 0x6d, 0x46, 0x72, 0x94, 0x77, 0x61, 0x4d, 0xac, 0x81, 0x20, 0xaf, 0xc2,
-	0x4a, 0x20, 0xe2, 0xb1, 0x1d, 0x24, 0x09, 0xa4, 0x79, 0xb8, 0x72, 0x27, 0x44, 0xe1, 0x0b, 0xf7,
-	0x28, 0x93, 0x0b, 0x50, 0x12, 0xaa, 0xbc, 0x13, 0xc2, 0x5c, 0x50, 0x19, 0x77, 0x65, 0xf6, 0x89,
-	0x0e, 0x20, 0x1f, 0x7d, 0x0b, 0xc3, 0xd3, 0xed, 0xdf, 0x19, 0xcf, 0xc6, 0x9c, 0x63, 0xb1, 0xdc,
-	0x6b, 0xfa, 0x5c, 0x56, 0x32, 0xe6, 0x1c, 0x8b, 0xac, 0x03, 0xe0, 0x55, 0x35, 0x1c, 0x8b, 0x7d,
-	0x59, 0xe6, 0x8d, 0xeb, 0xb8, 0xb2, 0x6f, 0x29, 0x16, 0x4e, 0x9c, 0x48, 0x14, 0xe1, 0xf6, 0xe0,
-	0xc5, 0x40, 0x21, 0xe9, 0x37, 0x64, 0xc5, 0x8c, 0xc9, 0x29, 0x77, 0xe1, 0xa5, 0x68, 0x95, 0x7d,
-	0xb7, 0x45, 0x9f, 0xe3, 0xcb, 0xa4, 0xd4, 0x21, 0x7b, 0x59, 0x0e, 0x69, 0x6b, 0x30, 0xef, 0xb8,
-	0x2d, 0x8a, 0x43, 0x5e, 0x14, 0x7e, 0x12, 0x74, 0xd3, 0x0b, 0x26, 0xd9, 0x60, 0xd1, 0x95, 0x7f,
-	0xd3, 0xf0, 0x02, 0x93, 0x24, 0xdf, 0x48, 0xb0, 0x14, 0xbc, 0xf1, 0x64, 0x5b, 0x98, 0x2e, 0xf2,
-	0x1d, 0x79, 0x27, 0x49, 0x28, 0x67, 0x54, 0x76, 0x4e, 0xfe, 0x7e, 0xbc, 0x23, 0x7d, 0xf5, 0xdb,
-	0x5f, 0xdf, 0xcd, 0x15, 0xc8, 0xba, 0x26, 0x74, 0xc8, 0x00, 0xe1, 0x7b, 0x09, 0x16, 0x51, 0x80,
-	0x94, 0x66, 0xd6, 0x08, 0x68, 0xb6, 0x13, 0x44, 0x22, 0x4c, 0x6d, 0x0c, 0xb3, 0x4d, 0xb6, 0xa6,
-	0xc2, 0x68, 0xc7, 0x78, 0x03, 0x43, 0xf2, 0xb3, 0x04, 0xe4, 0xf2, 0xcc, 0x90, 0xea, 0xcc, 0xba,
-	0x97, 0xc7, 0x56, 0xae, 0x5d, 0x2d, 0xe9, 0x0a, 0xdc, 0xe1, 0x3b, 0xd5, 0x70, 0x2c, 0xed, 0xd8,
-	0xb1, 0x86, 0xe4, 0x6b, 0x09, 0x16, 0xb8, 0x23, 0x90, 0xad, 0xc9, 0x65, 0x63, 0xf6, 0x23, 0x97,
-	0x66, 0x07, 0x22, 0x53, 0x69, 0xcc, 0xb4, 0x4e, 0x72, 0x42, 0x26, 0x6e, 0x40, 0xe4, 0x47, 0x09,
-	0x56, 0xe2, 0xf6, 0x42, 0xb4, 0xc9, 0x65, 0x84, 0x36, 0x25, 0xbf, 0x9e, 0x3c, 0x01, 0xf9, 0x76,
-	0xc7, 0x7c, 0x9b, 0xe4, 0x15, 0x21, 0x5f, 0x87, 0x65, 0x36, 0xc2, 0xf9, 0xfb, 0x45, 0x82, 0x55,
-	0x81, 0xaf, 0x90, 0x5a, 0xc2, 0xe2, 0x31, 0xf7, 0x92, 0x6f, 0x5f, 0x31, 0x0b, 0xb9, 0xdf, 0x18,
-	0x73, 0x97, 0xc9, 0x6b, 0x49, 0xb8, 0xb5, 0xe3, 0x91, 0x33, 0x0e, 0xc9, 0x89, 0x04, 0xe9, 0xa8,
-	0x11, 0x4d, 0x78, 0x87, 0x04, 0x16, 0x36, 0xe1, 0x1d, 0x12, 0xb9, 0x9a, 0xb2, 0x31, 0xf5, 0xca,
-	0xb9, 0xb7, 0x91, 0xc7, 0x12, 0x64, 0x44, 0x96, 0x44, 0xc4, 0xf7, 0x38, 0xc5, 0x01, 0xe5, 0xdd,
-	0x2b, 0x64, 0x20, 0x62, 0x75, 0x6a, 0xf7, 0x38, 0x62, 0xf8, 0x7e, 0x73, 0x17, 0x1a, 0x92, 0x9f,
-	0xc6, 0xc8, 0x31, 0xe3, 0x9a, 0x8e, 0x2c, 0x72, 0xca, 0xe9, 0xc8, 0x42, 0x57, 0x54, 0x6a, 0x0c,
-	0x59, 0x25, 0xb7, 0x12, 0x21, 0x73, 0xff, 0x1d, 0x92, 0x1f, 0x24, 0x58, 0x8e, 0x18, 0x03, 0xb9,
-	0x35, 0xf3, 0xeb, 0x12, 0xb1, 0x23, 0xb9, 0x9c, 0x30, 0x3a, 0xf9, 0x60, 0x86, 0xee, 0xeb, 0xb6,
-	0xe8, 0xf8, 0x03, 0xaa, 0x57, 0x9f, 0x9c, 0xe5, 0xa5, 0x67, 0x67, 0x79, 0xe9, 0xcf, 0xb3, 0xbc,
-	0xf4, 0xed, 0x79, 0x3e, 0xf5, 0xec, 0x3c, 0x9f, 0xfa, 0xfd, 0x3c, 0x9f, 0xfa, 0x04, 0xff, 0x23,
-	0xf2, 0xac, 0xcf, 0x54, 0x87, 0x6a, 0x0f, 0xb9, 0x9a, 0x3f, 0xe8, 0xda, 0x5e, 0x73, 0x81, 0xfd,
-	0xad, 0x56, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xde, 0xbd, 0x53, 0x06, 0x0e, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc7, 0xbd, 0x69, 0x7e, 0x49, 0xfa, 0x92, 0x26, 0xfa, 0x4d, 0x1c, 0x30, 0x9b, 0xc4, 0xb6,
+	0x36, 0x90, 0x38, 0x6e, 0xb3, 0x5b, 0x27, 0xa9, 0x04, 0xe5, 0x14, 0x53, 0x5a, 0x22, 0x54, 0x64,
+	0x36, 0x15, 0x20, 0x0e, 0x58, 0xeb, 0x78, 0xec, 0xac, 0x1a, 0xef, 0xba, 0x5e, 0x9b, 0xd6, 0x44,
+	0xb9, 0x20, 0x21, 0xe5, 0x02, 0x42, 0x82, 0x3f, 0xa0, 0x07, 0x84, 0x38, 0x06, 0x11, 0x6e, 0xfc,
+	0x01, 0x55, 0x4e, 0x15, 0x5c, 0x38, 0x21, 0x94, 0x20, 0xc1, 0x9f, 0x81, 0x3c, 0xf3, 0x66, 0xbd,
+	0xeb, 0x4c, 0xec, 0x35, 0x88, 0x4b, 0xeb, 0xce, 0xbc, 0xf7, 0x7d, 0x9f, 0x7d, 0xf3, 0xe6, 0xbd,
+	0x29, 0xa4, 0x76, 0x5d, 0xaf, 0xe6, 0x7a, 0x86, 0xd5, 0x6a, 0xee, 0x19, 0x1f, 0xe7, 0x4a, 0xb4,
+	0x69, 0xe5, 0x8c, 0x47, 0x2d, 0xda, 0x68, 0xeb, 0xf5, 0x86, 0xdb, 0x74, 0xc9, 0x2c, 0x37, 0xd0,
+	0x3b, 0x06, 0x3a, 0x1a, 0xa8, 0x59, 0xf4, 0x2a, 0x59, 0x1e, 0xe5, 0xd6, 0xbe, 0x6f, 0xdd, 0xaa,
+	0xda, 0x8e, 0xd5, 0xb4, 0x5d, 0x87, 0x0b, 0xa8, 0xf1, 0xaa, 0x5b, 0x75, 0xd9, 0x4f, 0xa3, 0xf3,
+	0x0b, 0x57, 0x5f, 0xaa, 0xba, 0x6e, 0x75, 0x9f, 0x1a, 0xec, 0x5f, 0xa5, 0x56, 0xc5, 0xb0, 0x1c,
+	0x8c, 0xa8, 0x2e, 0xe0, 0x96, 0x55, 0xb7, 0x0d, 0xcb, 0x71, 0xdc, 0x26, 0x53, 0xf3, 0x70, 0x37,
+	0x29, 0x03, 0x66, 0x70, 0x28, 0xcc, 0xf7, 0x8b, 0x3c, 0x22, 0xc2, 0xf3, 0xad, 0x79, 0x74, 0x15,
+	0xc0, 0xc1, 0xef, 0x54, 0xff, 0x6f, 0xd5, 0x6c, 0xc7, 0x35, 0xd8, 0x9f, 0x7c, 0x49, 0xfb, 0x08,
+	0xe2, 0xef, 0x76, 0x2c, 0xb6, 0x76, 0x77, 0xdd, 0x96, 0xd3, 0xf4, 0x4c, 0xfa, 0xa8, 0x45, 0xbd,
+	0x26, 0xb9, 0x0b, 0xd0, 0xfd, 0xca, 0x84, 0x92, 0x56, 0x32, 0x93, 0xeb, 0xcb, 0x3a, 0x86, 0xea,
+	0xa4, 0x44, 0xe7, 0xc2, 0x48, 0xa7, 0x17, 0xac, 0x2a, 0x45, 0x5f, 0x33, 0xe0, 0xa9, 0x9d, 0x28,
+	0x30, 0xd7, 0x13, 0xc0, 0xab, 0xbb, 0x8e, 0x47, 0x89, 0x09, 0x13, 0x16, 0xae, 0x25, 0x94, 0xf4,
+	0x95, 0xcc, 0xe4, 0x7a, 0x5c, 0xe7, 0x59, 0xd1, 0x45, 0xc2, 0xf4, 0x2d, 0xa7, 0x9d, 0x4f, 0x9f,
+	0x9e, 0xac, 0x2d, 0x48, 0x0e, 0x48, 0x47, 0xc5, 0x6d, 0xd3, 0xd7, 0x21, 0xf7, 0x42, 0xd4, 0x23,
+	0x8c, 0x7a, 0x65, 0x20, 0x35, 0x07, 0x0a, 0x61, 0xef, 0xc0, 0x6c, 0x90, 0x5a, 0x64, 0x65, 0x1d,
+	0xc6, 0xad, 0x72, 0xb9, 0x41, 0x3d, 0x8f, 0xa5, 0xe4, 0x6a, 0x3e, 0xf1, 0xf3, 0xc9, 0x5a, 0x1c,
+	0xf5, 0xb7, 0xf8, 0xce, 0x4e, 0xb3, 0x61, 0x3b, 0x55, 0x53, 0x18, 0xde, 0x9e, 0x38, 0x7a, 0x9a,
+	0x8a, 0xfd, 0xf5, 0x34, 0x15, 0xd3, 0xf6, 0xc2, 0xb9, 0xf6, 0x33, 0x51, 0x80, 0x71, 0xfc, 0x02,
+	0x4c, 0xf4, 0x3f, 0x4d, 0x84, 0x90, 0xd1, 0xe2, 0x40, 0x58, 0xa4, 0x82, 0xd5, 0xb0, 0x6a, 0xe2,
+	0x4c, 0xb5, 0x02, 0xcc, 0x86, 0x56, 0x31, 0xfc, 0x6b, 0x30, 0x56, 0x67, 0x2b, 0x18, 0x7d, 0x5e,
+	0x97, 0x05, 0xe1, 0x4e, 0xf9, 0xd1, 0x67, 0xbf, 0xa5, 0x62, 0x26, 0x3a, 0x68, 0x0b, 0xa0, 0x32,
+	0xc5, 0xfb, 0x6e, 0xb9, 0xb5, 0x4f, 0x7b, 0x6a, 0x48, 0x7b, 0x0c, 0xf3, 0xd2, 0x5d, 0x8c, 0xfb,
+	0x41, 0xc4, 0x02, 0x58, 0x3e, 0x3d, 0x59, 0xd3, 0x64, 0x48, 0x21, 0xdd, 0x40, 0x19, 0x68, 0xb7,
+	0x20, 0x75, 0x31, 0x70, 0xbe, 0xfd, 0x8e, 0x55, 0x13, 0x35, 0x4a, 0x08, 0x8c, 0x3a, 0x56, 0x8d,
+	0xf2, 0x63, 0x34, 0xd9, 0x6f, 0xed, 0x13, 0x48, 0x5f, 0xee, 0x86, 0xd0, 0xef, 0x45, 0x3b, 0xab,
+	0xa8, 0xcc, 0xfe, 0x89, 0xcd, 0xc1, 0x6c, 0x9e, 0xee, 0xee, 0x6d, 0xac, 0x17, 0x1a, 0xb4, 0x62,
+	0x3f, 0x11, 0x29, 0x7c, 0x1d, 0xe2, 0xe1, 0x65, 0xc4, 0x58, 0x82, 0x6b, 0x25, 0xb6, 0x5e, 0xac,
+	0xb3, 0x0d, 0xfc, 0x8e, 0xa9, 0x52, 0xc0, 0x58, 0xcb, 0xc3, 0x3c, 0xd6, 0x64, 0xbe, 0xdd, 0xa4,
+	0xde, 0x03, 0x17, 0x4b, 0x13, 0x53, 0xb0, 0x04, 0xd7, 0xb0, 0x46, 0x8b, 0xa5, 0xce, 0x3e, 0xd3,
+	0x98, 0x32, 0xa7, 0xac, 0x80, 0x8f, 0xf6, 0x26, 0x2c, 0xc8, 0x35, 0x10, 0xe4, 0x15, 0x98, 0x16,
+	0x22, 0x1e, 0xdb, 0x41, 0x12, 0x21, 0xcd, 0xcd, 0xb5, 0x3b, 0x3e, 0x0a, 0x5f, 0x78, 0xe0, 0x32,
+	0x39, 0x81, 0x12, 0x51, 0xe5, 0x0d, 0x1f, 0xa6, 0x47, 0xa5, 0x9b, 0x95, 0xc1, 0x5f, 0xb4, 0x03,
+	0xc9, 0xe0, 0x2d, 0xf4, 0xbf, 0x6e, 0xfb, 0x4e, 0xb7, 0x36, 0x46, 0xec, 0x32, 0xf3, 0xbd, 0x92,
+	0x1f, 0x49, 0x28, 0xe6, 0x88, 0x5d, 0x26, 0x8b, 0x00, 0x78, 0x54, 0x45, 0xbb, 0xcc, 0x3a, 0xcb,
+	0xa8, 0x79, 0x15, 0x57, 0xb6, 0xcb, 0x5a, 0x19, 0x52, 0x97, 0x8a, 0x22, 0xdc, 0x16, 0xcc, 0x08,
+	0x85, 0xa8, 0x3d, 0x64, 0xda, 0x0a, 0xc9, 0x69, 0xf7, 0xe1, 0xc5, 0x60, 0x94, 0x6d, 0xa7, 0xe2,
+	0xfe, 0x8b, 0xce, 0xa4, 0x15, 0x20, 0x71, 0x51, 0x0e, 0x69, 0x37, 0x61, 0xd4, 0x76, 0x2a, 0x2e,
+	0x16, 0x79, 0x5a, 0xda, 0x12, 0xf2, 0x96, 0x27, 0x2a, 0xd9, 0x64, 0xd6, 0x5a, 0x05, 0x6f, 0xbc,
+	0x7f, 0x77, 0x0a, 0xad, 0xd2, 0xdb, 0xb4, 0x2d, 0x20, 0xef, 0xc1, 0x78, 0xbd, 0x55, 0x2a, 0x3e,
+	0xa4, 0xed, 0xbe, 0x97, 0x27, 0x71, 0xda, 0x45, 0xdf, 0x6d, 0xb4, 0xeb, 0x4d, 0x57, 0x47, 0x9d,
+	0xb1, 0x3a, 0xfb, 0x5b, 0x6b, 0xc0, 0x82, 0x3c, 0xce, 0x7f, 0x37, 0x5b, 0xb4, 0x17, 0xb0, 0x7b,
+	0xdf, 0xa5, 0x74, 0xa7, 0xbe, 0x6f, 0x8b, 0x99, 0xa0, 0x59, 0x30, 0xd7, 0xb3, 0x8e, 0x10, 0x6f,
+	0xc1, 0xf8, 0x63, 0x6a, 0x57, 0xf7, 0x7c, 0x86, 0x25, 0x69, 0x16, 0x85, 0xdf, 0xfb, 0xcc, 0x36,
+	0x7f, 0xb5, 0xd3, 0x60, 0xbf, 0xfb, 0xf3, 0x38, 0xab, 0x98, 0xc2, 0x7d, 0xfd, 0x87, 0x19, 0xf8,
+	0x1f, 0x8b, 0x41, 0x3e, 0x57, 0x60, 0x42, 0x34, 0x52, 0xb2, 0x2a, 0xd5, 0x93, 0x8d, 0x73, 0x35,
+	0x1b, 0xc5, 0x94, 0x73, 0x6b, 0xd9, 0xa3, 0x4e, 0xf4, 0x4f, 0x7f, 0xf9, 0xe3, 0xab, 0x91, 0x14,
+	0x59, 0x34, 0xa4, 0x6f, 0x11, 0x81, 0xf0, 0xb5, 0x02, 0xe3, 0x28, 0x40, 0x32, 0x03, 0x63, 0x08,
+	0x9a, 0xd5, 0x08, 0x96, 0x08, 0xb3, 0xd9, 0x85, 0x59, 0x25, 0x2b, 0x7d, 0x61, 0x8c, 0x03, 0x2c,
+	0xec, 0x43, 0xf2, 0xa3, 0x02, 0xe4, 0xe2, 0x55, 0x24, 0x1b, 0x03, 0xe3, 0x5e, 0xec, 0x06, 0xea,
+	0xe6, 0x70, 0x4e, 0x43, 0x70, 0xfb, 0xad, 0xaa, 0x68, 0x97, 0x8d, 0x03, 0xbb, 0x7c, 0x48, 0x3e,
+	0x53, 0x60, 0x8c, 0x0f, 0x5a, 0xb2, 0x72, 0x79, 0xd8, 0xd0, 0x54, 0x57, 0x33, 0x83, 0x0d, 0x91,
+	0x29, 0xd3, 0x65, 0x5a, 0x24, 0xf3, 0x52, 0x26, 0x3e, 0xd7, 0xc9, 0xb7, 0x0a, 0x4c, 0x87, 0xa7,
+	0x36, 0x31, 0x2e, 0x0f, 0x23, 0x9d, 0xfe, 0xea, 0xcd, 0xe8, 0x0e, 0xc8, 0x97, 0xeb, 0xf2, 0x2d,
+	0x93, 0x97, 0xa5, 0x7c, 0x35, 0xe6, 0x59, 0xf4, 0xeb, 0xef, 0x27, 0x05, 0x66, 0x25, 0xe3, 0x9a,
+	0x6c, 0x46, 0x0c, 0x1e, 0x7a, 0x14, 0xa8, 0xb7, 0x86, 0xf4, 0x42, 0xee, 0x57, 0xbb, 0xdc, 0x6b,
+	0xe4, 0x7a, 0x14, 0x6e, 0xe3, 0xa0, 0xf3, 0xe0, 0x38, 0x24, 0x47, 0x0a, 0x4c, 0x05, 0xe7, 0xfb,
+	0x25, 0x77, 0x48, 0xf2, 0x32, 0x50, 0x57, 0x23, 0x58, 0x22, 0xdf, 0x52, 0xdf, 0x23, 0xe7, 0x4f,
+	0x06, 0x72, 0xac, 0x40, 0x5c, 0x36, 0xe9, 0x89, 0xfc, 0x1c, 0xfb, 0x3c, 0x2c, 0xd4, 0xdc, 0x10,
+	0x1e, 0x88, 0xb8, 0xd1, 0x37, 0x7b, 0x1c, 0xd1, 0x38, 0x08, 0x0d, 0xf7, 0x43, 0xf2, 0x7d, 0x17,
+	0x39, 0xf4, 0x1e, 0xe8, 0x8f, 0x2c, 0x7b, 0x80, 0xa8, 0xb9, 0x21, 0x3c, 0xc4, 0x0d, 0x67, 0xc8,
+	0x3a, 0xb9, 0x11, 0x09, 0x99, 0x3f, 0x6b, 0x0e, 0xc9, 0x37, 0x0a, 0x4c, 0x06, 0xe6, 0x2d, 0xb9,
+	0x31, 0xb0, 0xbb, 0x04, 0xa6, 0xbc, 0xba, 0x16, 0xd1, 0x3a, 0x7a, 0x61, 0xfa, 0x8f, 0x1a, 0xa7,
+	0xe2, 0x06, 0x1a, 0xe8, 0xb1, 0x02, 0x33, 0x3d, 0xc3, 0x95, 0xdc, 0x1c, 0x18, 0xbc, 0x67, 0xde,
+	0xab, 0xb9, 0x21, 0x3c, 0x24, 0xc8, 0xb7, 0x95, 0xac, 0x96, 0xe9, 0xdb, 0xf2, 0x3b, 0xbd, 0x13,
+	0x5f, 0x14, 0xe4, 0x0b, 0x05, 0x26, 0xc4, 0x2c, 0xed, 0x37, 0x1a, 0x7b, 0xe6, 0xb7, 0x9a, 0x8d,
+	0x62, 0x8a, 0x74, 0xd7, 0xbb, 0x74, 0x69, 0x92, 0x94, 0xa2, 0x55, 0x28, 0x2d, 0x7a, 0x1d, 0xa7,
+	0xfc, 0xc6, 0xb3, 0xb3, 0xa4, 0xf2, 0xfc, 0x2c, 0xa9, 0xfc, 0x7e, 0x96, 0x54, 0xbe, 0x3c, 0x4f,
+	0xc6, 0x9e, 0x9f, 0x27, 0x63, 0xbf, 0x9e, 0x27, 0x63, 0x1f, 0xe2, 0xff, 0xdf, 0xbd, 0xf2, 0x43,
+	0xdd, 0x76, 0x8d, 0x27, 0x5c, 0xa0, 0xd9, 0xae, 0x53, 0xaf, 0x34, 0xc6, 0xde, 0x27, 0x1b, 0x7f,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0x00, 0x39, 0x50, 0xa5, 0xb4, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1106,6 +1298,13 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts whose public key is the given public
+	// key. Several accounts can share a public key, e.g. after a public key
+	// change.
+	AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error)
+	// FeeSplit returns the weights by which the fees of txs are split between
+	// destinations.
+	FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error)
 }
 
 type queryClient struct {
@@ -1206,6 +1405,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error) {
+	out := new(QueryAccountByPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountByPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error) {
+	out := new(QueryFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/FeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1245,6 +1462,13 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts whose public key is the given public
+	// key. Several accounts can share a public key, e.g. after a public key
+	// change.
+	AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error)
+	// FeeSplit returns the weights by which the fees of txs are split between
+	// destinations.
+	FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1281,6 +1505,12 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) AccountByPubKey(ctx context.Context, req *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByPubKey not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplit(ctx context.Context, req *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1466,6 +1696,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountByPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountByPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountByPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountByPubKey(ctx, req.(*QueryAccountByPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/FeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplit(ctx, req.(*QueryFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1510,6 +1776,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountByPubKey",
+			Handler:    _Query_AccountByPubKey_Handler,
+		},
+		{
+			MethodName: "FeeSplit",
+			Handler:    _Query_FeeSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2146,77 +2420,209 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAccountByPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAccountByPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountByPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountByPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountByPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountByPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeeSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2413,6 +2819,58 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountByPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountByPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4030,6 +4488,310 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountByPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountByPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &types.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, FeeSplitWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,149 @@
+package vesting
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AmendVestingSchedule amends the schedule of a vesting account on behalf of
+// governance, and records the account before and after the amendment in the
+// audit records of x/auth.
+func (s msgServer) AmendVestingSchedule(ctx context.Context, msg *types.MsgAmendVestingSchedule) (*types.MsgAmendVestingScheduleResponse, error) {
+	ak := s.AccountKeeper
+	if msg.Authority != ak.GetAuthority() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", ak.GetAuthority(), msg.Authority)
+	}
+
+	if (msg.ClawbackTo == "") == (msg.NewEndTime == 0) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amendment must either claw back or extend the vesting")
+	}
+
+	addr, err := ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	vacc, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a vesting account", msg.Address)
+	}
+	// the account is decoded again, so that amending vacc doesn't change it
+	previous := ak.GetAccount(ctx, addr)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := sdk.NewEvent(types.EventTypeAmendVestingSchedule,
+		sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(msg.ProposalId, 10)),
+	)
+
+	var amended sdk.AccountI
+	if msg.ClawbackTo != "" {
+		to, err := ak.AddressCodec().StringToBytes(msg.ClawbackTo)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid clawback address: %s", err)
+		}
+		if s.BankKeeper.BlockedAddr(to) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ClawbackTo)
+		}
+
+		// delegated vesting coins would stay locked in the delegations
+		if !vacc.GetDelegatedVesting().IsZero() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has delegated vesting coins, which must be undelegated first", msg.Address)
+		}
+
+		clawback := vacc.GetVestingCoins(sdkCtx.HeaderInfo().Time)
+		amended, err = baseAccount(vacc)
+		if err != nil {
+			return nil, err
+		}
+
+		// the coins are unlocked once the account is a base account
+		ak.SetAccount(ctx, amended)
+		if !clawback.IsZero() {
+			if err := s.BankKeeper.SendCoins(ctx, addr, to, clawback); err != nil {
+				return nil, err
+			}
+		}
+
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyClawback, clawback.String()),
+			sdk.NewAttribute(types.AttributeKeyClawbackTo, msg.ClawbackTo),
+		)
+	} else {
+		if err := extendVesting(vacc, sdkCtx.HeaderInfo().Time.Unix(), msg.NewEndTime); err != nil {
+			return nil, err
+		}
+		amended = vacc
+		ak.SetAccount(ctx, amended)
+
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyNewEndTime, strconv.FormatInt(msg.NewEndTime, 10)))
+	}
+
+	if err := ak.RecordAccountAmendment(ctx, msg.ProposalId, previous, amended); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(event)
+	return &types.MsgAmendVestingScheduleResponse{}, nil
+}
+
+// baseAccount returns the base account of vacc.
+func baseAccount(vacc exported.VestingAccount) (sdk.AccountI, error) {
+	switch acc := vacc.(type) {
+	case *types.ContinuousVestingAccount:
+		return acc.BaseAccount, nil
+	case *types.DelayedVestingAccount:
+		return acc.BaseAccount, nil
+	case *types.PeriodicVestingAccount:
+		return acc.BaseAccount, nil
+	case *types.PermanentLockedAccount:
+		return acc.BaseAccount, nil
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported vesting account type %T", vacc)
+	}
+}
+
+// extendVesting extends the vesting of the coins of vacc which are still
+// vesting at now until endTime, without changing the coins which vested.
+func extendVesting(vacc exported.VestingAccount, now, endTime int64) error {
+	if now >= vacc.GetEndTime() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vesting already ended")
+	}
+	if endTime <= vacc.GetEndTime() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "new end time %d must be after the end time %d", endTime, vacc.GetEndTime())
+	}
+
+	switch acc := vacc.(type) {
+	case *types.ContinuousVestingAccount:
+		// coins vest linearly from the vested coins at now to all coins at
+		// endTime, by moving the start time back; it is rounded down so that
+		// no vested coin is locked again
+		if now > acc.StartTime {
+			elapsed, remaining, extended := now-acc.StartTime, acc.EndTime-now, endTime-now
+			acc.StartTime = now - (elapsed*extended+remaining-1)/remaining
+		}
+		acc.EndTime = endTime
+	case *types.DelayedVestingAccount:
+		acc.EndTime = endTime
+	case *types.PeriodicVestingAccount:
+		// the last period is still vesting, as vesting didn't end
+		acc.VestingPeriods[len(acc.VestingPeriods)-1].Length += endTime - acc.EndTime
+		acc.EndTime = endTime
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting of %T can't be extended", vacc)
+	}
+
+	return vacc.(authtypes.GenesisAccount).Validate()
+}
@@ -0,0 +1,103 @@
+package vesting_test
+
+import (
+	"time"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *VestingTestSuite) TestAmendVestingSchedule() {
+	authority := authtypes.NewModuleAddress("gov").String()
+	now := s.ctx.HeaderInfo().Time.Unix()
+	ctx := s.ctx.WithBlockHeight(10)
+
+	newAccount := func(addr sdk.AccAddress) *vestingtypes.ContinuousVestingAccount {
+		baseAccount := s.accountKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+		acc, err := vestingtypes.NewContinuousVestingAccount(baseAccount, sdk.NewCoins(fooCoin), now-100, now+100)
+		s.Require().NoError(err)
+		s.accountKeeper.SetAccount(ctx, acc)
+		return acc
+	}
+	addrStr := func(addr sdk.AccAddress) string {
+		str, err := s.accountKeeper.AddressCodec().BytesToString(addr)
+		s.Require().NoError(err)
+		return str
+	}
+
+	// extending the vesting keeps the vested coins vested
+	acc := newAccount(to1Addr)
+	vested := acc.GetVestedCoins(ctx.HeaderInfo().Time)
+	_, err := s.msgServer.AmendVestingSchedule(ctx, &vestingtypes.MsgAmendVestingSchedule{
+		Authority:  authority,
+		ProposalId: 1,
+		Address:    addrStr(to1Addr),
+		NewEndTime: now + 300,
+	})
+	s.Require().NoError(err)
+	extended := s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().Equal(now+300, extended.EndTime)
+	s.Require().Equal(vested, extended.GetVestedCoins(ctx.HeaderInfo().Time))
+	s.Require().True(extended.GetVestedCoins(time.Unix(now+200, 0)).IsAllLT(sdk.NewCoins(fooCoin)))
+
+	// clawing back sends the vesting coins and ends the vesting
+	acc = newAccount(to2Addr)
+	clawback := acc.GetVestingCoins(ctx.HeaderInfo().Time)
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(ctx, to2Addr, fromAddr, clawback).Return(nil)
+	_, err = s.msgServer.AmendVestingSchedule(ctx, &vestingtypes.MsgAmendVestingSchedule{
+		Authority:  authority,
+		ProposalId: 1,
+		Address:    addrStr(to2Addr),
+		ClawbackTo: addrStr(fromAddr),
+	})
+	s.Require().NoError(err)
+	s.Require().IsType(&authtypes.BaseAccount{}, s.accountKeeper.GetAccount(ctx, to2Addr))
+
+	amendments, err := s.accountKeeper.GetAccountAmendments(ctx, to2Addr)
+	s.Require().NoError(err)
+	s.Require().Len(amendments, 1)
+	s.Require().Equal(uint64(1), amendments[0].ProposalId)
+	s.Require().Equal(int64(10), amendments[0].Height)
+	previous, err := amendments[0].GetPreviousAccount()
+	s.Require().NoError(err)
+	s.Require().Equal(acc.String(), previous.String())
+	amended, err := amendments[0].GetAmendedAccount()
+	s.Require().NoError(err)
+	s.Require().IsType(&authtypes.BaseAccount{}, amended)
+
+	// the audit records are exported in genesis
+	genState := s.accountKeeper.ExportGenesis(ctx)
+	s.Require().Len(genState.AccountAmendments, 2)
+	s.Require().NoError(authtypes.ValidateAccountAmendments(genState.AccountAmendments))
+	s.accountKeeper.InitGenesis(ctx, *genState)
+	s.Require().Equal(genState, s.accountKeeper.ExportGenesis(ctx))
+	genState.AccountAmendments = append(genState.AccountAmendments, genState.AccountAmendments[0])
+	s.Require().ErrorContains(authtypes.ValidateAccountAmendments(genState.AccountAmendments), "already amended")
+
+	// the account isn't a vesting account anymore
+	_, err = s.msgServer.AmendVestingSchedule(ctx, &vestingtypes.MsgAmendVestingSchedule{
+		Authority:  authority,
+		ProposalId: 2,
+		Address:    addrStr(to2Addr),
+		NewEndTime: now + 300,
+	})
+	s.Require().ErrorContains(err, "not a vesting account")
+
+	_, err = s.msgServer.AmendVestingSchedule(ctx, &vestingtypes.MsgAmendVestingSchedule{
+		Authority:  addrStr(fromAddr),
+		ProposalId: 2,
+		Address:    addrStr(to1Addr),
+		NewEndTime: now + 400,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.AmendVestingSchedule(ctx, &vestingtypes.MsgAmendVestingSchedule{
+		Authority:  authority,
+		ProposalId: 2,
+		Address:    addrStr(to1Addr),
+	})
+	s.Require().ErrorContains(err, "either claw back or extend")
+}
@@ -40,6 +40,10 @@ These tokens may be used for staking but are non-transferable. Staking rewards w
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "AmendVestingSchedule",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -0,0 +1,14 @@
+package types
+
+// vesting module event types
+const (
+	// EventTypeAmendVestingSchedule is emitted when governance amends the
+	// schedule of a vesting account.
+	EventTypeAmendVestingSchedule = "amend_vesting_schedule"
+
+	AttributeKeyAccount    = "account"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyClawback   = "clawback"
+	AttributeKeyClawbackTo = "clawback_to"
+	AttributeKeyNewEndTime = "new_end_time"
+)
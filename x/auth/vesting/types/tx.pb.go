@@ -380,6 +380,133 @@ func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
 
+// MsgAmendVestingSchedule defines a message that enables governance to amend
+// the schedule of a vesting account. Exactly one of clawback_to and
+// new_end_time is set.
+type MsgAmendVestingSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal_id is the id of the proposal which decided the amendment, kept in
+	// the audit record of the amendment.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the address of the vesting account.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// clawback_to, if set, is the address receiving the coins which are still
+	// vesting. The account then becomes a base account, keeping the coins which
+	// vested.
+	ClawbackTo string `protobuf:"bytes,4,opt,name=clawback_to,json=clawbackTo,proto3" json:"clawback_to,omitempty"`
+	// new_end_time, if set, extends the vesting of the coins which are still
+	// vesting until new_end_time, as unix time (in seconds). Coins which vested
+	// stay vested.
+	NewEndTime int64 `protobuf:"varint,5,opt,name=new_end_time,json=newEndTime,proto3" json:"new_end_time,omitempty"`
+}
+
+func (m *MsgAmendVestingSchedule) Reset()         { *m = MsgAmendVestingSchedule{} }
+func (m *MsgAmendVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendVestingSchedule) ProtoMessage()    {}
+func (*MsgAmendVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{6}
+}
+func (m *MsgAmendVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendVestingSchedule.Merge(m, src)
+}
+func (m *MsgAmendVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgAmendVestingSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAmendVestingSchedule) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgAmendVestingSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgAmendVestingSchedule) GetClawbackTo() string {
+	if m != nil {
+		return m.ClawbackTo
+	}
+	return ""
+}
+
+func (m *MsgAmendVestingSchedule) GetNewEndTime() int64 {
+	if m != nil {
+		return m.NewEndTime
+	}
+	return 0
+}
+
+// MsgAmendVestingScheduleResponse defines the Msg/AmendVestingSchedule
+// response type.
+type MsgAmendVestingScheduleResponse struct {
+}
+
+func (m *MsgAmendVestingScheduleResponse) Reset()         { *m = MsgAmendVestingScheduleResponse{} }
+func (m *MsgAmendVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgAmendVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{7}
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendVestingScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -387,55 +514,66 @@ func init() {
 	proto.RegisterType((*MsgCreatePermanentLockedAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePermanentLockedAccountResponse")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccount")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccountResponse")
+	proto.RegisterType((*MsgAmendVestingSchedule)(nil), "cosmos.vesting.v1beta1.MsgAmendVestingSchedule")
+	proto.RegisterType((*MsgAmendVestingScheduleResponse)(nil), "cosmos.vesting.v1beta1.MsgAmendVestingScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0xb7, 0x94, 0x5f, 0x3b, 0x10, 0x0d, 0x15, 0xa5, 0x6c, 0xa4, 0x5d, 0x1a, 0x8d, 0x2b,
-	0x09, 0x6d, 0x40, 0x13, 0x92, 0xc5, 0x84, 0xb0, 0x24, 0x9e, 0x24, 0x31, 0xd5, 0x78, 0xf0, 0xb2,
-	0x99, 0x6d, 0xc7, 0x32, 0x81, 0x76, 0x36, 0x9d, 0x81, 0xb0, 0x37, 0xe2, 0xd1, 0x93, 0x37, 0x8d,
-	0xf1, 0xe0, 0xd1, 0x78, 0xe2, 0xe0, 0xbf, 0x60, 0xc2, 0x4d, 0xe2, 0xc9, 0x13, 0x1a, 0x38, 0xe0,
-	0x99, 0xbf, 0xc0, 0x4c, 0x67, 0xba, 0xee, 0xae, 0x2d, 0x0b, 0x9e, 0xbc, 0x50, 0x76, 0xde, 0xf7,
-	0xfb, 0xde, 0xeb, 0xe7, 0xcd, 0x4c, 0x81, 0xe9, 0x11, 0x1a, 0x12, 0xea, 0xec, 0x20, 0xca, 0x70,
-	0x14, 0x38, 0x3b, 0x0b, 0x0d, 0xc4, 0xe0, 0x82, 0xc3, 0x76, 0xed, 0x66, 0x4c, 0x18, 0xd1, 0x6e,
-	0x08, 0x81, 0x2d, 0x05, 0xb6, 0x14, 0x94, 0x26, 0x03, 0x12, 0x90, 0x44, 0xe2, 0xf0, 0xff, 0x84,
-	0xba, 0x64, 0xc8, 0x74, 0x0d, 0x48, 0x51, 0x3b, 0x97, 0x47, 0x70, 0x24, 0xe3, 0xd3, 0x22, 0x5e,
-	0x17, 0x46, 0x99, 0x5a, 0x84, 0x6e, 0xe5, 0x74, 0x92, 0x16, 0x16, 0xaa, 0x29, 0xa9, 0x0a, 0x29,
-	0x57, 0xf0, 0x87, 0x0c, 0x4c, 0xc0, 0x10, 0x47, 0xc4, 0x49, 0xfe, 0x8a, 0x25, 0xeb, 0xbd, 0x0a,
-	0xa6, 0xd6, 0x69, 0xb0, 0x16, 0x23, 0xc8, 0xd0, 0x33, 0x91, 0x66, 0xd5, 0xf3, 0xc8, 0x76, 0xc4,
-	0xb4, 0x65, 0x30, 0xfe, 0x22, 0x26, 0x61, 0x1d, 0xfa, 0x7e, 0x8c, 0x28, 0xd5, 0x95, 0xb2, 0x52,
-	0x29, 0xd6, 0xf4, 0x6f, 0x9f, 0xe7, 0x27, 0x65, 0x57, 0xab, 0x22, 0xf2, 0x84, 0xc5, 0x38, 0x0a,
-	0xdc, 0x31, 0xae, 0x96, 0x4b, 0xda, 0x12, 0x00, 0x8c, 0xb4, 0xad, 0x03, 0x7d, 0xac, 0x45, 0x46,
-	0x52, 0x63, 0x0b, 0x0c, 0xc3, 0x90, 0xd7, 0xd7, 0xd5, 0xb2, 0x5a, 0x19, 0x5b, 0x9c, 0xb6, 0xa5,
-	0x83, 0xf3, 0x4a, 0xd1, 0xda, 0x6b, 0x04, 0x47, 0xb5, 0x87, 0x07, 0x47, 0x66, 0xe1, 0xd3, 0x0f,
-	0xb3, 0x12, 0x60, 0xb6, 0xb1, 0xdd, 0xb0, 0x3d, 0x12, 0x4a, 0x5e, 0xf2, 0x31, 0x4f, 0xfd, 0x4d,
-	0x87, 0xb5, 0x9a, 0x88, 0x26, 0x06, 0xfa, 0xee, 0x74, 0x7f, 0x6e, 0x7c, 0x0b, 0x05, 0xd0, 0x6b,
-	0xd5, 0x39, 0x71, 0xfa, 0xf1, 0x74, 0x7f, 0x4e, 0x71, 0x65, 0x41, 0x6d, 0x1a, 0x8c, 0xa2, 0xc8,
-	0xaf, 0x33, 0x1c, 0x22, 0x7d, 0xb0, 0xac, 0x54, 0x54, 0x77, 0x04, 0x45, 0xfe, 0x53, 0x1c, 0x22,
-	0x4d, 0x07, 0x23, 0x3e, 0xda, 0x82, 0x2d, 0xe4, 0xeb, 0x43, 0x65, 0xa5, 0x32, 0xea, 0xa6, 0x3f,
-	0xb5, 0x19, 0x00, 0x28, 0x83, 0x31, 0x13, 0xb6, 0xe1, 0xc4, 0x56, 0x4c, 0x56, 0xb8, 0xb1, 0xfa,
-	0xe0, 0xd7, 0x07, 0x53, 0x79, 0xc9, 0xeb, 0x76, 0xb2, 0x7c, 0x75, 0xba, 0x3f, 0x67, 0x75, 0xf4,
-	0x98, 0x33, 0x02, 0x6b, 0x16, 0x98, 0x39, 0x21, 0x17, 0xd1, 0x26, 0x89, 0x28, 0xb2, 0xbe, 0x0e,
-	0x74, 0x68, 0x1e, 0xa3, 0x38, 0x84, 0x11, 0x8a, 0xd8, 0x23, 0xe2, 0x6d, 0x22, 0x3f, 0x9d, 0x64,
-	0x35, 0x73, 0x92, 0x53, 0x67, 0x47, 0xe6, 0xb5, 0x16, 0x0c, 0xb7, 0xaa, 0x56, 0x67, 0xd4, 0xea,
-	0x1e, 0xe4, 0xfd, 0x8c, 0x41, 0x5e, 0x3f, 0x3b, 0x32, 0x27, 0x84, 0xf3, 0x4f, 0xcc, 0xfa, 0x3f,
-	0xa6, 0x58, 0x5d, 0xc9, 0x25, 0x7e, 0x3b, 0x8b, 0x38, 0x47, 0xd6, 0x45, 0xcb, 0xba, 0x0b, 0xee,
-	0xf4, 0x01, 0xda, 0x86, 0xff, 0xa6, 0x07, 0x3e, 0x26, 0x3e, 0xf6, 0x7a, 0x8e, 0xd1, 0x6c, 0x16,
-	0xfc, 0x6e, 0xc6, 0x33, 0x7f, 0x33, 0xee, 0x84, 0xd9, 0xbd, 0xc5, 0xd4, 0x9e, 0x2d, 0xa6, 0xb9,
-	0xe0, 0xaa, 0xbc, 0x00, 0xea, 0xcd, 0xa4, 0x05, 0xaa, 0x0f, 0x26, 0xd0, 0x0d, 0x3b, 0xfb, 0x62,
-	0xb2, 0x45, 0xa7, 0xb5, 0x22, 0x27, 0x2f, 0xe0, 0x5d, 0x91, 0x12, 0x11, 0xa1, 0x09, 0xc4, 0xc2,
-	0xa5, 0x20, 0x62, 0xe2, 0xf3, 0x17, 0xcf, 0x81, 0x98, 0x01, 0x26, 0x85, 0xb8, 0xf8, 0x45, 0x05,
-	0xea, 0x3a, 0x0d, 0xb4, 0x3d, 0x05, 0x4c, 0x66, 0x5e, 0x44, 0x4e, 0xde, 0x7b, 0xe4, 0x9c, 0x8d,
-	0xd2, 0xd2, 0x25, 0x0d, 0x69, 0x2b, 0xda, 0x5b, 0x05, 0xdc, 0x3c, 0xf7, 0x24, 0xf5, 0xcf, 0x9c,
-	0x6d, 0x2c, 0xad, 0xfc, 0xa3, 0x31, 0xbb, 0xb5, 0xac, 0x7d, 0x76, 0xa1, 0xd6, 0x32, 0x8c, 0x17,
-	0x6b, 0xed, 0x9c, 0x01, 0x96, 0x86, 0xf6, 0xf8, 0x1e, 0xaa, 0x2d, 0x1f, 0x1c, 0x1b, 0xca, 0xe1,
-	0xb1, 0xa1, 0xfc, 0x3c, 0x36, 0x94, 0xd7, 0x27, 0x46, 0xe1, 0xf0, 0xc4, 0x28, 0x7c, 0x3f, 0x31,
-	0x0a, 0xcf, 0x67, 0x45, 0x01, 0xea, 0x6f, 0xda, 0x98, 0x38, 0xbb, 0x0e, 0xdc, 0x66, 0x1b, 0xed,
-	0x8f, 0x58, 0x72, 0xb2, 0x1b, 0xc3, 0xc9, 0xf7, 0xe8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x59, 0xc3, 0x58, 0xdb, 0x6d, 0x07, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xbf, 0x4f, 0xdb, 0x4c,
+	0x18, 0xc7, 0x63, 0x12, 0x7e, 0xe4, 0x82, 0xde, 0x57, 0xf8, 0xe5, 0x2d, 0x26, 0x2a, 0x76, 0xb0,
+	0x5a, 0x35, 0x45, 0xc2, 0x16, 0xf4, 0x07, 0x6a, 0xa8, 0x84, 0x08, 0x6a, 0xa5, 0x4a, 0x45, 0xaa,
+	0x02, 0xea, 0xd0, 0xc5, 0x72, 0xec, 0xab, 0xb1, 0x12, 0xfb, 0x22, 0xdf, 0x05, 0xc8, 0x86, 0x3a,
+	0x76, 0xea, 0xd6, 0xaa, 0xea, 0xd0, 0xb1, 0xea, 0xc4, 0xd0, 0xa9, 0x7f, 0x01, 0x5b, 0x51, 0xa7,
+	0x4e, 0xb4, 0x82, 0x81, 0xce, 0xfc, 0x05, 0xd5, 0xf9, 0xce, 0x21, 0xa1, 0x17, 0x52, 0x98, 0xba,
+	0x60, 0x72, 0xcf, 0xf7, 0xfb, 0xdc, 0xe3, 0xcf, 0x73, 0xf7, 0xc8, 0x40, 0x73, 0x10, 0x0e, 0x10,
+	0x36, 0x37, 0x21, 0x26, 0x7e, 0xe8, 0x99, 0x9b, 0x73, 0x55, 0x48, 0xec, 0x39, 0x93, 0x6c, 0x1b,
+	0x8d, 0x08, 0x11, 0x24, 0x5f, 0x61, 0x02, 0x83, 0x0b, 0x0c, 0x2e, 0xc8, 0x8f, 0x7b, 0xc8, 0x43,
+	0xb1, 0xc4, 0xa4, 0xff, 0x31, 0x75, 0x5e, 0xe5, 0xe9, 0xaa, 0x36, 0x86, 0xed, 0x5c, 0x0e, 0xf2,
+	0x43, 0x1e, 0x9f, 0x64, 0x71, 0x8b, 0x19, 0x79, 0x6a, 0x16, 0xba, 0xd6, 0xa3, 0x92, 0x64, 0x63,
+	0xa6, 0x9a, 0xe0, 0xaa, 0x00, 0x53, 0x05, 0x7d, 0xf0, 0xc0, 0x98, 0x1d, 0xf8, 0x21, 0x32, 0xe3,
+	0xbf, 0x6c, 0x49, 0x7f, 0x97, 0x06, 0x13, 0xab, 0xd8, 0x5b, 0x89, 0xa0, 0x4d, 0xe0, 0x53, 0x96,
+	0x66, 0xd9, 0x71, 0x50, 0x33, 0x24, 0xf2, 0x22, 0x18, 0x7d, 0x1e, 0xa1, 0xc0, 0xb2, 0x5d, 0x37,
+	0x82, 0x18, 0x2b, 0x52, 0x41, 0x2a, 0x66, 0xcb, 0xca, 0xd7, 0x4f, 0xb3, 0xe3, 0xbc, 0xaa, 0x65,
+	0x16, 0x59, 0x23, 0x91, 0x1f, 0x7a, 0x95, 0x1c, 0x55, 0xf3, 0x25, 0x79, 0x01, 0x00, 0x82, 0xda,
+	0xd6, 0x81, 0x3e, 0xd6, 0x2c, 0x41, 0x89, 0xb1, 0x05, 0x86, 0xec, 0x80, 0xee, 0xaf, 0xa4, 0x0b,
+	0xe9, 0x62, 0x6e, 0x7e, 0xd2, 0xe0, 0x0e, 0xca, 0x2b, 0x41, 0x6b, 0xac, 0x20, 0x3f, 0x2c, 0x3f,
+	0xdc, 0x3b, 0xd0, 0x52, 0x1f, 0xbf, 0x6b, 0x45, 0xcf, 0x27, 0x1b, 0xcd, 0xaa, 0xe1, 0xa0, 0x80,
+	0xf3, 0xe2, 0x8f, 0x59, 0xec, 0xd6, 0x4c, 0xd2, 0x6a, 0x40, 0x1c, 0x1b, 0xf0, 0xdb, 0xe3, 0xdd,
+	0x99, 0xd1, 0x3a, 0xf4, 0x6c, 0xa7, 0x65, 0x51, 0xe2, 0xf8, 0xc3, 0xf1, 0xee, 0x8c, 0x54, 0xe1,
+	0x1b, 0xca, 0x93, 0x60, 0x04, 0x86, 0xae, 0x45, 0xfc, 0x00, 0x2a, 0x99, 0x82, 0x54, 0x4c, 0x57,
+	0x86, 0x61, 0xe8, 0xae, 0xfb, 0x01, 0x94, 0x15, 0x30, 0xec, 0xc2, 0xba, 0xdd, 0x82, 0xae, 0x32,
+	0x58, 0x90, 0x8a, 0x23, 0x95, 0xe4, 0xa7, 0x3c, 0x05, 0x00, 0x26, 0x76, 0x44, 0x98, 0x6d, 0x28,
+	0xb6, 0x65, 0xe3, 0x15, 0x6a, 0x2c, 0xdd, 0xff, 0xf9, 0x5e, 0x93, 0x5e, 0xd0, 0x7d, 0x3b, 0x59,
+	0xbe, 0x3c, 0xde, 0x9d, 0xd1, 0x3b, 0x6a, 0xec, 0xd1, 0x02, 0x7d, 0x1a, 0x68, 0x3d, 0x42, 0x15,
+	0x88, 0x1b, 0x28, 0xc4, 0x50, 0xff, 0x32, 0xd0, 0xa1, 0x79, 0x02, 0xa3, 0xc0, 0x0e, 0x61, 0x48,
+	0x1e, 0x23, 0xa7, 0x06, 0xdd, 0xa4, 0x93, 0x25, 0x61, 0x27, 0x27, 0x4e, 0x0e, 0xb4, 0xff, 0x5a,
+	0x76, 0x50, 0x2f, 0xe9, 0x9d, 0x51, 0xbd, 0xbb, 0x91, 0xb7, 0x05, 0x8d, 0xfc, 0xff, 0xe4, 0x40,
+	0x1b, 0x63, 0xce, 0xd3, 0x98, 0xfe, 0x77, 0x74, 0xb1, 0xb4, 0xd4, 0x93, 0xf8, 0x75, 0x11, 0x71,
+	0x8a, 0xac, 0x8b, 0x96, 0x7e, 0x13, 0xdc, 0xe8, 0x03, 0xb4, 0x0d, 0xff, 0xf5, 0x19, 0xf8, 0x3e,
+	0x72, 0x7d, 0xe7, 0xcc, 0x35, 0x9a, 0x16, 0xc1, 0xef, 0x66, 0x3c, 0xf5, 0x3b, 0xe3, 0x4e, 0x98,
+	0xdd, 0x47, 0x2c, 0x7d, 0xe6, 0x88, 0xc9, 0x15, 0xf0, 0x2f, 0x1f, 0x00, 0x56, 0x23, 0x2e, 0x01,
+	0x2b, 0x99, 0x18, 0xba, 0x6a, 0x88, 0x07, 0x93, 0xc1, 0x2a, 0x2d, 0x67, 0x29, 0x79, 0x06, 0xef,
+	0x1f, 0x2e, 0x61, 0x11, 0x1c, 0x43, 0x4c, 0x5d, 0x08, 0xa2, 0x8f, 0x5c, 0xfa, 0xe2, 0x3d, 0x20,
+	0x0a, 0xc0, 0xb4, 0x21, 0x7e, 0x1e, 0x88, 0x67, 0xd0, 0x72, 0x00, 0x43, 0x97, 0x4b, 0xd6, 0x9c,
+	0x0d, 0xe8, 0x36, 0xeb, 0x50, 0xbe, 0x0b, 0xb2, 0x76, 0x93, 0x6c, 0xa0, 0xc8, 0x27, 0xad, 0xbe,
+	0x03, 0xe8, 0x54, 0x2a, 0x6b, 0x20, 0xd7, 0x88, 0x50, 0x03, 0x61, 0xbb, 0x6e, 0xf9, 0x6e, 0x8c,
+	0x34, 0x53, 0x01, 0xc9, 0xd2, 0x23, 0x57, 0x9e, 0x07, 0xc3, 0x09, 0xef, 0x74, 0x9f, 0xb4, 0x89,
+	0x50, 0xbe, 0x07, 0x72, 0x4e, 0xdd, 0xde, 0xaa, 0xda, 0x4e, 0xcd, 0x22, 0x48, 0xc9, 0xf4, 0xf1,
+	0x81, 0x44, 0xbc, 0x8e, 0xe4, 0x02, 0x18, 0x0d, 0xe1, 0x96, 0xd5, 0x1e, 0x2f, 0x83, 0x71, 0x13,
+	0x41, 0x08, 0xb7, 0x1e, 0xb0, 0x09, 0x53, 0xba, 0x43, 0x69, 0x9f, 0xbe, 0x81, 0x60, 0x42, 0x88,
+	0x00, 0xf1, 0x09, 0x21, 0x0a, 0x25, 0x7c, 0xe7, 0xf7, 0x32, 0x20, 0xbd, 0x8a, 0x3d, 0x79, 0x47,
+	0x02, 0xe3, 0xc2, 0x41, 0x6f, 0xf6, 0x3a, 0x27, 0x3d, 0x66, 0x4f, 0x7e, 0xe1, 0x82, 0x86, 0xa4,
+	0x14, 0xf9, 0x8d, 0x04, 0xae, 0x9e, 0x3b, 0xa9, 0xfa, 0x67, 0x16, 0x1b, 0xf3, 0x4b, 0x97, 0x34,
+	0x8a, 0x4b, 0x13, 0xdd, 0xe3, 0x3f, 0x2a, 0x4d, 0x60, 0xcc, 0x2f, 0x5d, 0xd2, 0xd8, 0x2e, 0x8d,
+	0x36, 0x4e, 0x78, 0x3b, 0xce, 0x6b, 0x9c, 0xc8, 0x90, 0x5f, 0xb8, 0xa0, 0x21, 0x29, 0x21, 0x3f,
+	0xb8, 0x43, 0xc7, 0x44, 0x79, 0x71, 0xef, 0x50, 0x95, 0xf6, 0x0f, 0x55, 0xe9, 0xc7, 0xa1, 0x2a,
+	0xbd, 0x3a, 0x52, 0x53, 0xfb, 0x47, 0x6a, 0xea, 0xdb, 0x91, 0x9a, 0x7a, 0x36, 0xcd, 0x12, 0x63,
+	0xb7, 0x66, 0xf8, 0xc8, 0xdc, 0x36, 0xe9, 0x41, 0x6e, 0x7f, 0xa7, 0xc4, 0xc3, 0xbb, 0x3a, 0x14,
+	0x7f, 0x72, 0xdc, 0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xde, 0x76, 0x66, 0x50, 0x09, 0x00,
+	0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -543,6 +681,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// AmendVestingSchedule defines a governance operation amending the schedule
+	// of a vesting account, either clawing back its vesting coins or extending
+	// its vesting. The authority is defined in the x/auth keeper.
+	AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -580,6 +722,15 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
+func (c *msgClient) AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error) {
+	out := new(MsgAmendVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AmendVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount defines a method that enables creating a vesting
@@ -595,6 +746,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// AmendVestingSchedule defines a governance operation amending the schedule
+	// of a vesting account, either clawing back its vesting coins or extending
+	// its vesting. The authority is defined in the x/auth keeper.
+	AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -610,6 +765,9 @@ func (*UnimplementedMsgServer) CreatePermanentLockedAccount(ctx context.Context,
 func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) AmendVestingSchedule(ctx context.Context, req *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendVestingSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -669,6 +827,24 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AmendVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, req.(*MsgAmendVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.vesting.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -685,6 +861,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "AmendVestingSchedule",
+			Handler:    _Msg_AmendVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -937,6 +1117,83 @@ func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAmendVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewEndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewEndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ClawbackTo) > 0 {
+		i -= len(m.ClawbackTo)
+		copy(dAtA[i:], m.ClawbackTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClawbackTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1056,6 +1313,42 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAmendVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClawbackTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewEndTime != 0 {
+		n += 1 + sovTx(uint64(m.NewEndTime))
+	}
+	return n
+}
+
+func (m *MsgAmendVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1733,6 +2026,240 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAmendVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEndTime", wireType)
+			}
+			m.NewEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,4 +20,8 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrMaxExecDepth error if nested MsgExec exceed the maximum nesting depth
+	ErrMaxExecDepth = errors.Register(ModuleName, 13, "maximum MsgExec nesting depth exceeded")
+	// ErrExecCycle error if a grantee executes a MsgExec nested in its own MsgExec
+	ErrExecCycle = errors.Register(ModuleName, 14, "cycle in MsgExec authorization chain")
 )
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasAccountState reports whether addr granted authorizations. It implements
+// the bank AccountStateCheckFn, so that such accounts aren't reaped as dust.
+// Grants are keyed by granter, so only the grants given by addr are checked.
+func (k Keeper) HasAccountState(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, grantStoreKey(nil, addr, ""))
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
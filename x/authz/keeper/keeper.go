@@ -29,11 +29,20 @@ import (
 // https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(20)
 
+// DefaultMaxExecDepth is the default maximum nesting depth of MsgExec, a
+// MsgExec which isn't nested in another MsgExec having a depth of 1.
+const DefaultMaxExecDepth = 5
+
+// execChainKey is the context key of the grantees of the MsgExec being
+// executed, outermost first.
+type execChainKey struct{}
+
 type Keeper struct {
 	storeService corestoretypes.KVStoreService
 	cdc          codec.Codec
 	router       baseapp.MessageRouter
 	authKeeper   authz.AccountKeeper
+	maxExecDepth int
 }
 
 // NewKeeper constructs a message authorization Keeper
@@ -43,7 +52,19 @@ func NewKeeper(storeService corestoretypes.KVStoreService, cdc codec.Codec, rout
 		cdc:          cdc,
 		router:       router,
 		authKeeper:   ak,
+		maxExecDepth: DefaultMaxExecDepth,
+	}
+}
+
+// WithMaxExecDepth returns a copy of the keeper with an updated maximum nesting
+// depth of MsgExec. A depth of 1 disallows MsgExec nested in another MsgExec.
+func (k Keeper) WithMaxExecDepth(depth int) Keeper {
+	if depth < 1 {
+		panic("max exec depth must be positive")
 	}
+
+	k.maxExecDepth = depth
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -92,11 +113,30 @@ func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, upd
 
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
+//
+// The messages may include a MsgExec, executing messages on behalf of the
+// granters of another grantee. Such nesting is limited to the maximum exec
+// depth of the keeper, and a grantee cannot appear twice in a chain of nested
+// MsgExec.
 func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.HeaderInfo().Time
 
+	chain, _ := sdkCtx.Value(execChainKey{}).([]sdk.AccAddress)
+	if len(chain) >= k.maxExecDepth {
+		return nil, errorsmod.Wrapf(authz.ErrMaxExecDepth, "depth of MsgExec executed by %s exceeds %d", grantee, k.maxExecDepth)
+	}
+
+	for _, g := range chain {
+		if g.Equals(grantee) {
+			return nil, errorsmod.Wrapf(authz.ErrExecCycle, "grantee %s already executes an outer MsgExec", grantee)
+		}
+	}
+
+	// the chain is copied so that sibling messages don't share its backing array
+	execCtx := sdkCtx.WithValue(execChainKey{}, append(chain[:len(chain):len(chain)], grantee))
+
 	for i, msg := range msgs {
 		signers, _, err := k.cdc.GetMsgV1Signers(msg)
 		if err != nil {
@@ -157,7 +197,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
 		}
 
-		msgResp, err := handler(sdkCtx, msg)
+		msgResp, err := handler(execCtx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message %v", msg)
 		}
@@ -356,6 +356,42 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchNestedExec() {
+	require := s.Require()
+	granter, grantee, subGrantee, thirdGrantee, recipient := s.addrs[0], s.addrs[1], s.addrs[2], s.addrs[3], s.addrs[4]
+	exp := s.ctx.HeaderInfo().Time.AddDate(0, 0, 1)
+	execAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&authz.MsgExec{}))
+
+	// nested MsgExec are routed to a keeper allowing two levels of nesting
+	authzKeeper := s.authzKeeper.WithMaxExecDepth(2)
+	authz.RegisterMsgServer(s.baseApp.MsgServiceRouter(), authzKeeper)
+
+	require.NoError(authzKeeper.SaveGrant(s.ctx, grantee, granter, &banktypes.SendAuthorization{SpendLimit: coins100}, &exp))
+	require.NoError(authzKeeper.SaveGrant(s.ctx, subGrantee, grantee, execAuthz, &exp))
+	require.NoError(authzKeeper.SaveGrant(s.ctx, thirdGrantee, subGrantee, execAuthz, &exp))
+
+	send := &banktypes.MsgSend{FromAddress: granter.String(), ToAddress: recipient.String(), Amount: coins10}
+	exec := func(grantee sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+
+	s.T().Log("the grantee of a MsgExec grant re-delegates the authority of its granters")
+	_, err := authzKeeper.DispatchActions(s.ctx, subGrantee, []sdk.Msg{exec(grantee, send)})
+	require.NoError(err)
+
+	authorization, _ := authzKeeper.GetAuthorization(s.ctx, grantee, granter, bankSendAuthMsgType)
+	require.Equal(coins100.Sub(coins10...), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	s.T().Log("a grantee cannot appear twice in the authorization chain")
+	_, err = authzKeeper.DispatchActions(s.ctx, grantee, []sdk.Msg{exec(grantee, send)})
+	require.ErrorIs(err, authz.ErrExecCycle)
+
+	s.T().Log("nesting is limited to the maximum exec depth")
+	_, err = authzKeeper.DispatchActions(s.ctx, thirdGrantee, []sdk.Msg{exec(subGrantee, exec(grantee, send))})
+	require.ErrorIs(err, authz.ErrMaxExecDepth)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
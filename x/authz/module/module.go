@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/x/authz/client/cli"
 	"cosmossdk.io/x/authz/keeper"
 	"cosmossdk.io/x/authz/simulation"
+	banktypes "cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -167,14 +168,19 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	AuthzKeeper keeper.Keeper
-	Module      appmodule.AppModule
+	AuthzKeeper       keeper.Keeper
+	Module            appmodule.AppModule
+	AccountStateCheck banktypes.AccountStateCheckWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
-	return ModuleOutputs{AuthzKeeper: k, Module: m}
+	return ModuleOutputs{
+		AuthzKeeper:       k,
+		Module:            m,
+		AccountStateCheck: banktypes.AccountStateCheckWrapper{AccountStateCheckFn: k.HasAccountState},
+	}
 }
 
 // ____________________________________________________________________________
@@ -87,6 +87,18 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod:      "TotalSupplyAt",
+					Use:            "total-supply-at [height]",
+					Short:          "Query the total supply of coins after the execution of the block at a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "SupplyChanges",
+					Use:            "supply-changes [height]",
+					Short:          "Query the changes of the supply of coins from a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Deferred account creation is opt-in: when enabled in the params, an address
+// receiving only dust, as defined by the dust thresholds, holds balances
+// without an account record, so that airdropping dust to many addresses doesn't
+// grow the account state. The account is created once the address receives a non-dust amount,
+// or by the ante handler when the address signs or pays the fees of a tx.
+
+// accountCreationHooks houses the AccountCreationHookFn of the modules
+// accounting for new accounts.
+type accountCreationHooks struct {
+	fns []types.AccountCreationHookFn
+}
+
+// AppendAccountCreationHook adds a hook called when the bank module creates the
+// account of an address receiving coins, e.g. to implement state rent.
+func (k BaseSendKeeper) AppendAccountCreationHook(hook types.AccountCreationHookFn) {
+	k.accountCreationHooks.fns = append(k.accountCreationHooks.fns, hook)
+}
+
+// IsAccountCreationDeferred returns whether the account of addr, which doesn't
+// exist, isn't created because account creation is deferred and addr only
+// holds dust.
+func (k BaseSendKeeper) IsAccountCreationDeferred(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	params := k.GetParams(ctx)
+	if !params.DeferAccountCreation {
+		return false, nil
+	}
+
+	return params.IsDust(k.GetAllBalances(ctx, addr)), nil
+}
+
+// InstantiateDeferredAccount creates the account of addr if it doesn't exist
+// but addr holds balances, and returns whether it was created. The ante handler
+// calls it for the signers and fee payer of txs, so that an address which
+// received only dust can send txs.
+func (k BaseSendKeeper) InstantiateDeferredAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if k.ak.HasAccount(ctx, addr) || k.GetAllBalances(ctx, addr).IsZero() {
+		return false, nil
+	}
+
+	return true, k.createAccount(ctx, addr)
+}
+
+// createReceivingAccount creates the account of addr receiving coins if it
+// doesn't exist, unless its creation is deferred.
+func (k BaseSendKeeper) createReceivingAccount(ctx context.Context, addr sdk.AccAddress) error {
+	if k.ak.HasAccount(ctx, addr) {
+		return nil
+	}
+
+	deferred, err := k.IsAccountCreationDeferred(ctx, addr)
+	if err != nil || deferred {
+		return err
+	}
+
+	return k.createAccount(ctx, addr)
+}
+
+func (k BaseSendKeeper) createAccount(ctx context.Context, addr sdk.AccAddress) error {
+	defer telemetry.IncrCounter(1, "new", "account")
+	k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+
+	for _, hook := range k.accountCreationHooks.fns {
+		if err := hook(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
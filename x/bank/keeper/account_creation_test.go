@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) mockSendCoinsToNewAccount(sender sdk.AccountI, receiver sdk.AccAddress, created bool) {
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, sender.GetAddress()).Return(sender)
+	suite.authKeeper.EXPECT().HasAccount(suite.ctx, receiver).Return(false)
+	if created {
+		acc := authtypes.NewBaseAccountWithAddress(receiver)
+		suite.authKeeper.EXPECT().NewAccountWithAddress(suite.ctx, receiver).Return(acc)
+		suite.authKeeper.EXPECT().SetAccount(suite.ctx, acc)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeferAccountCreation() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	sender := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	var created []sdk.AccAddress
+	suite.bankKeeper.AppendAccountCreationHook(func(_ context.Context, addr sdk.AccAddress) error {
+		created = append(created, addr)
+		return nil
+	})
+
+	// creation isn't deferred until enabled
+	suite.mockSendCoinsToNewAccount(sender, accAddrs[1], true)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(5))))
+	require.Equal([]sdk.AccAddress{accAddrs[1]}, created)
+
+	params := banktypes.DefaultParams()
+	params.DustThresholds = []banktypes.DustThreshold{{Denom: fooDenom, Threshold: math.NewInt(10)}}
+	params.DeferAccountCreation = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// an address receiving dust holds balances without account
+	suite.mockSendCoinsToNewAccount(sender, accAddrs[2], false)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(5))))
+	require.Len(created, 1)
+	require.Equal(newFooCoin(5), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom))
+
+	deferred, err := suite.bankKeeper.IsAccountCreationDeferred(ctx, accAddrs[2])
+	require.NoError(err)
+	require.True(deferred)
+
+	// the account is created once it holds more than dust
+	suite.mockSendCoinsToNewAccount(sender, accAddrs[2], true)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+	require.Equal([]sdk.AccAddress{accAddrs[1], accAddrs[2]}, created)
+
+	// or when instantiated by the ante handler
+	suite.mockSendCoinsToNewAccount(sender, accAddrs[3], false)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(5))))
+
+	acc := authtypes.NewBaseAccountWithAddress(accAddrs[3])
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[3]).Return(false)
+	suite.authKeeper.EXPECT().NewAccountWithAddress(ctx, accAddrs[3]).Return(acc)
+	suite.authKeeper.EXPECT().SetAccount(ctx, acc)
+	ok, err := suite.bankKeeper.InstantiateDeferredAccount(ctx, accAddrs[3])
+	require.NoError(err)
+	require.True(ok)
+	require.Equal([]sdk.AccAddress{accAddrs[1], accAddrs[2], accAddrs[3]}, created)
+
+	// addresses without balances have nothing to instantiate
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[4]).Return(false)
+	ok, err = suite.bankKeeper.InstantiateDeferredAccount(ctx, accAddrs[4])
+	require.NoError(err)
+	require.False(ok)
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Dust reaping is opt-in: it is disabled as long as the dust_reap_limit param
+// is zero, and only accounts holding nothing but denoms with a dust threshold
+// are reaped. The end blocker of the module reaps dust accounts, provided that
+// the modules holding account state registered their AccountStateCheckFn.
+
+// accountStateChecks houses the AccountStateCheckFn of the modules holding
+// account state.
+type accountStateChecks struct {
+	fns []types.AccountStateCheckFn
+}
+
+// AppendAccountStateCheck adds a check reporting whether a module holds state
+// for an account. Accounts for which any check reports state are never reaped,
+// so every module keeping state keyed by account address, e.g. delegations or
+// grants, should register one. No account is reaped until a check is
+// registered.
+func (k BaseKeeper) AppendAccountStateCheck(check types.AccountStateCheckFn) {
+	k.accountStateChecks.fns = append(k.accountStateChecks.fns, check)
+}
+
+// IsDustAccount returns whether the account at addr can be reaped: it must be a
+// regular account which is neither blocked nor exempted, hold only balances
+// below the dust threshold of their denom, and have no state in other modules.
+func (k BaseKeeper) IsDustAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return false, nil
+	}
+
+	return k.isDustAccount(ctx, acc, k.GetAllBalances(ctx, addr))
+}
+
+func (k BaseKeeper) isDustAccount(ctx context.Context, acc sdk.AccountI, balances sdk.Coins) (bool, error) {
+	switch acc.(type) {
+	case sdk.ModuleAccountI, types.VestingAccount:
+		return false, nil
+	}
+
+	addr := acc.GetAddress()
+	if k.BlockedAddr(addr) {
+		return false, nil
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsDustExempt(addrStr) || !params.IsDust(balances) {
+		return false, nil
+	}
+
+	for _, check := range k.accountStateChecks.fns {
+		hasState, err := check(ctx, addr)
+		if err != nil || hasState {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// ReapDustAccounts examines up to dust_reap_limit accounts holding balances,
+// resuming from where the previous call stopped, and removes the dust accounts
+// among them along with their balances, which are burned. It returns the number
+// of accounts reaped. It reaps nothing while no AccountStateCheckFn is
+// registered, as accounts could then be reaped along with state in other
+// modules.
+func (k BaseKeeper) ReapDustAccounts(ctx context.Context) (int, error) {
+	limit := k.GetParams(ctx).DustReapLimit
+	if limit == 0 {
+		return 0, nil
+	}
+
+	if len(k.accountStateChecks.fns) == 0 {
+		k.logger.Error("dust reaping is enabled but no account state check is registered, skipping it")
+		return 0, nil
+	}
+
+	cursor, err := k.DustReapCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	var ranger collections.Ranger[collections.Pair[sdk.AccAddress, string]]
+	if cursor != nil {
+		ranger = new(collections.Range[collections.Pair[sdk.AccAddress, string]]).StartInclusive(collections.Join(sdk.AccAddress(cursor), ""))
+	}
+
+	// the addresses are collected first, as the store cannot be written to
+	// while iterating
+	var addrs []sdk.AccAddress
+	var next sdk.AccAddress
+	err = k.Balances.Walk(ctx, ranger, func(key collections.Pair[sdk.AccAddress, string], _ math.Int) (bool, error) {
+		addr := key.K1()
+		if len(addrs) > 0 && addrs[len(addrs)-1].Equals(addr) {
+			return false, nil
+		}
+
+		if uint64(len(addrs)) == limit {
+			next = addr
+			return true, nil
+		}
+
+		addrs = append(addrs, addr)
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var reaped int
+	for _, addr := range addrs {
+		acc := k.ak.GetAccount(ctx, addr)
+		if acc == nil {
+			continue
+		}
+
+		balances := k.GetAllBalances(ctx, addr)
+		dust, err := k.isDustAccount(ctx, acc, balances)
+		if err != nil {
+			return reaped, err
+		}
+		if !dust {
+			continue
+		}
+
+		if err := k.reapAccount(ctx, acc, balances); err != nil {
+			return reaped, err
+		}
+		reaped++
+	}
+
+	// the sweep starts over once every account was examined
+	if next == nil {
+		return reaped, k.DustReapCursor.Remove(ctx)
+	}
+
+	return reaped, k.DustReapCursor.Set(ctx, next)
+}
+
+// reapAccount burns the balances of acc and removes it.
+func (k BaseKeeper) reapAccount(ctx context.Context, acc sdk.AccountI, balances sdk.Coins) error {
+	addr := acc.GetAddress()
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	for _, balance := range balances {
+		if err := k.setBalance(ctx, addr, sdk.NewCoin(balance.Denom, math.ZeroInt())); err != nil {
+			return err
+		}
+
+		supply := k.GetSupply(ctx, balance.Denom).Sub(balance)
+		k.setSupply(ctx, supply)
+
+		if err := k.recordSupplyChange(ctx, addrStr, balance.Denom, balance.Amount.Neg(), supply.Amount); err != nil {
+			return err
+		}
+	}
+
+	k.ak.RemoveAccount(ctx, acc)
+
+	k.logger.Debug("reaped dust account", "account", addrStr, "amount", balances.String())
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !balances.IsZero() {
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			types.NewCoinSpentEvent(addrStr, balances),
+			types.NewCoinBurnEvent(addrStr, balances),
+		})
+		k.emitLedgerEntries(ctx, addrStr, types.LedgerSupply, balances)
+	}
+	sdkCtx.EventManager().EmitEvent(types.NewDustAccountReapedEvent(addrStr, balances))
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestReapDustAccounts() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	for i, amt := range []int64{5, 50, 5, 5} {
+		suite.mockFundAccount(accAddrs[i])
+		require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[i], sdk.NewCoins(newFooCoin(amt))))
+	}
+
+	// reaping is disabled until a limit is set
+	reaped, err := suite.bankKeeper.ReapDustAccounts(ctx)
+	require.NoError(err)
+	require.Zero(reaped)
+
+	params := banktypes.DefaultParams()
+	params.DustThresholds = []banktypes.DustThreshold{{Denom: fooDenom, Threshold: math.NewInt(10)}}
+	params.DustReapLimit = 2
+	params.DustExemptions = []string{accAddrs[2].String()}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// reaping is refused until a module registers an account state check
+	reaped, err = suite.bankKeeper.ReapDustAccounts(ctx)
+	require.NoError(err)
+	require.Zero(reaped)
+
+	suite.bankKeeper.AppendAccountStateCheck(func(_ context.Context, addr sdk.AccAddress) (bool, error) {
+		return addr.Equals(accAddrs[3]), nil
+	})
+
+	accs := make([]sdk.AccountI, 4)
+	for i := range accs {
+		accs[i] = authtypes.NewBaseAccountWithAddress(accAddrs[i])
+		suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[i]).Return(accs[i]).AnyTimes()
+	}
+	suite.authKeeper.EXPECT().RemoveAccount(gomock.Any(), accs[0]).Times(1)
+
+	supply := suite.bankKeeper.GetSupply(ctx, fooDenom)
+
+	// the first sweep examines the first two accounts, the second one being
+	// above the threshold
+	ctx = sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	reaped, err = suite.bankKeeper.ReapDustAccounts(ctx)
+	require.NoError(err)
+	require.Equal(1, reaped)
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]).IsZero())
+	require.Equal(supply.Sub(newFooCoin(5)), suite.bankKeeper.GetSupply(ctx, fooDenom))
+
+	var found bool
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		if event.Type == banktypes.EventTypeDustAccountReaped {
+			found = true
+		}
+	}
+	require.True(found)
+
+	cursor, err := suite.bankKeeper.DustReapCursor.Get(ctx)
+	require.NoError(err)
+	require.Equal([]byte(accAddrs[2]), cursor)
+
+	// the second sweep skips the exempted account and the one with state in
+	// another module, and wraps around
+	reaped, err = suite.bankKeeper.ReapDustAccounts(ctx)
+	require.NoError(err)
+	require.Zero(reaped)
+
+	has, err := suite.bankKeeper.DustReapCursor.Has(ctx)
+	require.NoError(err)
+	require.False(has)
+
+	for _, addr := range accAddrs[1:4] {
+		require.False(suite.bankKeeper.GetAllBalances(ctx, addr).IsZero())
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	v1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/bank/types"
@@ -334,3 +335,60 @@ func (k BaseKeeper) SendEnabled(ctx context.Context, req *types.QuerySendEnabled
 
 	return resp, nil
 }
+
+// TotalSupplyAt implements the Query/TotalSupplyAt gRPC method
+func (k BaseKeeper) TotalSupplyAt(ctx context.Context, req *types.QueryTotalSupplyAtRequest) (*types.QueryTotalSupplyAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	supply, err := k.GetTotalSupplyAt(ctx, req.Height)
+	if err != nil {
+		return nil, supplyHistoryStatus(err)
+	}
+
+	return &types.QueryTotalSupplyAtResponse{Supply: supply}, nil
+}
+
+// SupplyChanges implements the Query/SupplyChanges gRPC method
+func (k BaseKeeper) SupplyChanges(ctx context.Context, req *types.QuerySupplyChangesRequest) (*types.QuerySupplyChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	var (
+		res     types.QuerySupplyChangesResponse
+		heights uint64
+		last    int64
+	)
+	err := k.IterateSupplyChanges(ctx, req.Height, func(change types.SupplyChange) bool {
+		if heights == 0 || change.Height != last {
+			if heights == limit {
+				res.NextHeight = change.Height
+				return true
+			}
+			heights++
+			last = change.Height
+		}
+
+		res.Changes = append(res.Changes, change)
+		return false
+	})
+	if err != nil {
+		return nil, supplyHistoryStatus(err)
+	}
+
+	return &res, nil
+}
+
+func supplyHistoryStatus(err error) error {
+	if errorsmod.IsOf(err, types.ErrSupplyHistoryNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
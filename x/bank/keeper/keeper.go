@@ -37,6 +37,7 @@ type Keeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
 	GetAllDenomMetaData(ctx context.Context) []types.Metadata
 	IterateAllDenomMetaData(ctx context.Context, cb func(types.Metadata) bool)
+	ChainMetadataProvider() sdk.ChainMetadataProvider
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -49,6 +50,8 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	ReapDustAccounts(ctx context.Context) (int, error)
+
 	types.QueryServer
 }
 
@@ -60,6 +63,7 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	storeService           store.KVStoreService
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+	accountStateChecks     *accountStateChecks
 	logger                 log.Logger
 }
 
@@ -102,6 +106,7 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		storeService:           storeService,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
+		accountStateChecks:     &accountStateChecks{},
 		logger:                 logger,
 	}
 }
@@ -166,6 +171,12 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 		return err
 	}
 
+	moduleAddrStr, err := k.ak.AddressCodec().BytesToString(moduleAccAddr)
+	if err != nil {
+		return err
+	}
+	k.emitLedgerEntries(ctx, delAddrStr, moduleAddrStr, amt)
+
 	return nil
 }
 
@@ -198,6 +209,16 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return err
 	}
 
+	moduleAddrStr, err := k.ak.AddressCodec().BytesToString(moduleAccAddr)
+	if err != nil {
+		return err
+	}
+	delAddrStr, err := k.ak.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+	k.emitLedgerEntries(ctx, moduleAddrStr, delAddrStr, amt)
+
 	return nil
 }
 
@@ -257,6 +278,23 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// ChainMetadataProvider returns a provider filling in the denoms of the chain
+// metadata from the denominations metadata.
+func (k BaseKeeper) ChainMetadataProvider() sdk.ChainMetadataProvider {
+	return func(ctx sdk.Context, md *sdk.ChainMetadata) error {
+		return k.BaseViewKeeper.DenomMetadata.Walk(ctx, nil, func(_ string, metadata types.Metadata) (stop bool, err error) {
+			denom := sdk.DenomMetadata{Base: metadata.Base, Display: metadata.Display}
+			for _, unit := range metadata.DenomUnits {
+				if unit.Denom == metadata.Display {
+					denom.Exponent = unit.Exponent
+				}
+			}
+			md.Denoms = append(md.Denoms, denom)
+			return false, nil
+		})
+	}
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // An error is returned if the module account does not exist or if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -370,6 +408,10 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Add(amount)
 		k.setSupply(ctx, supply)
+
+		if err := k.recordSupplyChange(ctx, moduleName, amount.Denom, amount.Amount, supply.Amount); err != nil {
+			return err
+		}
 	}
 
 	k.logger.Debug("minted coins from module account", "amount", amounts.String(), "from", moduleName)
@@ -382,6 +424,7 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 	sdkCtx.EventManager().EmitEvent(
 		types.NewCoinMintEvent(addrStr, amounts),
 	)
+	k.emitLedgerEntries(ctx, types.LedgerSupply, addrStr, amounts)
 
 	return nil
 }
@@ -405,23 +448,35 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 		return err
 	}
 
+	addrStr, err := k.ak.AddressCodec().BytesToString(acc.GetAddress())
+	if err != nil {
+		return err
+	}
+
+	// burns are attributed to the module account burning the coins, if any
+	source := addrStr
+	if macc, ok := acc.(sdk.ModuleAccountI); ok {
+		source = macc.GetName()
+	}
+
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
 		k.setSupply(ctx, supply)
+
+		if err := k.recordSupplyChange(ctx, source, amount.Denom, amount.Amount.Neg(), supply.Amount); err != nil {
+			return err
+		}
 	}
 
 	k.logger.Debug("burned tokens from account", "amount", amounts.String(), "from", address)
 
-	addrStr, err := k.ak.AddressCodec().BytesToString(acc.GetAddress())
-	if err != nil {
-		return err
-	}
 	// emit burn event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		types.NewCoinBurnEvent(addrStr, amounts),
 	)
+	k.emitLedgerEntries(ctx, addrStr, types.LedgerSupply, amounts)
 
 	return nil
 }
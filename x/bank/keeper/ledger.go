@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ledger events are opt-in: when enabled, every balance movement emits a
+// ledger_entry event per denom, with both the account debited and the account
+// credited, so that auditors and accounting integrations can export the moves
+// as double-entry ledger rows, e.g. with the ledger package, instead of pairing
+// coin_spent and coin_received events. Minted coins are debited from, and
+// burned coins credited to, types.LedgerSupply. Events aren't part of the
+// consensus, so each node chooses whether to emit them.
+
+// ledger houses whether ledger events are emitted, shared by the copies of the
+// keeper.
+type ledger struct {
+	enabled bool
+}
+
+// EnableLedgerEvents makes the keeper emit a ledger_entry event for each denom
+// of each balance movement.
+func (k BaseSendKeeper) EnableLedgerEvents() {
+	k.ledger.enabled = true
+}
+
+// emitLedgerEntries emits the ledger entry events of the movement of amt from
+// from to to, if ledger events are enabled.
+func (k BaseSendKeeper) emitLedgerEntries(ctx context.Context, from, to string, amt sdk.Coins) {
+	if !k.ledger.enabled {
+		return
+	}
+
+	events := make(sdk.Events, 0, len(amt))
+	for _, coin := range amt {
+		events = append(events, types.NewLedgerEntryEvent(from, to, coin))
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestLedgerEvents() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithEventManager(sdk.NewEventManager())
+	k := suite.bankKeeper
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), multiPermAcc.Name).Return(multiPermAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), multiPermAcc.GetAddress()).Return(multiPermAcc).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[0]).Return(true).AnyTimes()
+
+	// no ledger events until enabled
+	require.NoError(k.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(100))))
+	entries, err := banktypes.LedgerEntriesFromEvents(1, ctx.EventManager().ABCIEvents())
+	require.NoError(err)
+	require.Empty(entries)
+
+	k.EnableLedgerEvents()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(k.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(10), newBarCoin(20))))
+	require.NoError(k.SendCoins(ctx, multiPermAcc.GetAddress(), accAddrs[0], sdk.NewCoins(newFooCoin(30))))
+	require.NoError(k.BurnCoins(ctx, multiPermAcc.GetAddress(), sdk.NewCoins(newBarCoin(5))))
+
+	module, account := multiPermAcc.GetAddress().String(), accAddrs[0].String()
+	entries, err = banktypes.LedgerEntriesFromEvents(1, ctx.EventManager().ABCIEvents())
+	require.NoError(err)
+	require.Equal([]banktypes.LedgerEntry{
+		{Height: 1, From: banktypes.LedgerSupply, To: module, Denom: barDenom, Amount: math.NewInt(20)},
+		{Height: 1, From: banktypes.LedgerSupply, To: module, Denom: fooDenom, Amount: math.NewInt(10)},
+		{Height: 1, From: module, To: account, Denom: fooDenom, Amount: math.NewInt(30)},
+		{Height: 1, From: module, To: banktypes.LedgerSupply, Denom: barDenom, Amount: math.NewInt(5)},
+	}, entries)
+}
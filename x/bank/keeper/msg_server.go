@@ -136,6 +136,17 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	for _, addr := range req.Params.DustExemptions {
+		if _, err := base.ak.AddressCodec().StringToBytes(addr); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid dust exemption address %s: %s", addr, err)
+		}
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -41,6 +42,28 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "use of send_enabled in params is no longer supported",
 		},
+		{
+			name: "non positive dust threshold",
+			input: &banktypes.MsgUpdateParams{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Params: banktypes.Params{
+					DustThresholds: []banktypes.DustThreshold{{Denom: "foo", Threshold: math.ZeroInt()}},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "dust threshold for foo must be positive",
+		},
+		{
+			name: "invalid dust exemption",
+			input: &banktypes.MsgUpdateParams{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Params: banktypes.Params{
+					DustExemptions: []string{"invalid"},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid dust exemption address",
+		},
 		{
 			name: "all good",
 			input: &banktypes.MsgUpdateParams{
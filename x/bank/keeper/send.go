@@ -12,7 +12,6 @@ import (
 	"cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -69,6 +68,10 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+
+	accountCreationHooks *accountCreationHooks
+
+	ledger *ledger
 }
 
 func NewBaseSendKeeper(
@@ -92,6 +95,10 @@ func NewBaseSendKeeper(
 		authority:       authority,
 		logger:          logger,
 		sendRestriction: newSendRestriction(),
+
+		accountCreationHooks: &accountCreationHooks{},
+
+		ledger: &ledger{},
 	}
 }
 
@@ -175,6 +182,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		outAddrString, err := k.ak.AddressCodec().BytesToString(outAddress)
+		if err != nil {
+			return err
+		}
+		k.emitLedgerEntries(ctx, input.Address, outAddrString, out.Coins)
+
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
@@ -187,10 +200,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		//
 		// NOTE: This should ultimately be removed in favor a more flexible approach
 		// such as delegated fee messages.
-		accExists := k.ak.HasAccount(ctx, outAddress)
-		if !accExists {
-			defer telemetry.IncrCounter(1, "new", "account")
-			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
+		if err := k.createReceivingAccount(ctx, outAddress); err != nil {
+			return err
 		}
 	}
 
@@ -220,10 +231,8 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 	//
 	// NOTE: This should ultimately be removed in favor a more flexible approach
 	// such as delegated fee messages.
-	accExists := k.ak.HasAccount(ctx, toAddr)
-	if !accExists {
-		defer telemetry.IncrCounter(1, "new", "account")
-		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
+	if err := k.createReceivingAccount(ctx, toAddr); err != nil {
+		return err
 	}
 
 	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
@@ -244,6 +253,7 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
 		),
 	)
+	k.emitLedgerEntries(ctx, fromAddrString, toAddrString, amt)
 
 	return nil
 }
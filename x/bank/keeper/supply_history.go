@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	stdmath "math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The supply history is opt-in: when tracked, the supply of a denom is recorded
+// after each height it changes at, along with the net amount minted and burned
+// by each source at that height, so that market data integrations can query the
+// supply at a past height or follow its changes without replaying blocks or
+// querying archive nodes.
+
+// SetTrackSupplyHistory enables or disables the tracking of the supply history.
+// Enabling it records the current supply, the history starting at the current
+// height. Disabling it keeps the recorded history, which can no longer be
+// queried until tracking is enabled again.
+func (k BaseKeeper) SetTrackSupplyHistory(ctx context.Context, enabled bool) error {
+	if !enabled {
+		return k.SupplyHistoryStart.Remove(ctx)
+	}
+
+	tracked, err := k.SupplyHistoryStart.Has(ctx)
+	if err != nil || tracked {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	err = k.Supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		return false, k.SupplyHistory.Set(ctx, collections.Join(denom, height), amount)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.SupplyHistoryStart.Set(ctx, height)
+}
+
+// recordSupplyChange records that source minted, or burned if delta is
+// negative, delta coins of denom, the supply of denom being now supply, if the
+// supply history is tracked.
+func (k BaseKeeper) recordSupplyChange(ctx context.Context, source, denom string, delta, supply math.Int) error {
+	tracked, err := k.SupplyHistoryStart.Has(ctx)
+	if err != nil || !tracked {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	if err := k.SupplyHistory.Set(ctx, collections.Join(denom, height), supply); err != nil {
+		return err
+	}
+
+	key := collections.Join3(height, denom, source)
+	net, err := k.NetSupplyChanges.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		net = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+
+	net = net.Add(delta)
+	if net.IsZero() {
+		return k.NetSupplyChanges.Remove(ctx, key)
+	}
+	return k.NetSupplyChanges.Set(ctx, key, net)
+}
+
+// GetSupplyAt returns the supply of denom after the execution of the block at
+// height. It returns an error if the supply history isn't tracked at height.
+func (k BaseViewKeeper) GetSupplyAt(ctx context.Context, denom string, height int64) (sdk.Coin, error) {
+	start, err := k.supplyHistoryStart(ctx, height)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, int64](denom).EndInclusive(height).Descending()
+	iter, err := k.SupplyHistory.Iterate(ctx, rng)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// the supply recorded before the history started is stale: the denom had no
+	// supply when it started, or else it would have been recorded at its start
+	if kv.Key.K2() < start {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+
+	return sdk.NewCoin(denom, kv.Value), nil
+}
+
+// GetTotalSupplyAt returns the supply of all the denoms after the execution of
+// the block at height. It returns an error if the supply history isn't tracked
+// at height.
+func (k BaseViewKeeper) GetTotalSupplyAt(ctx context.Context, height int64) (sdk.Coins, error) {
+	if _, err := k.supplyHistoryStart(ctx, height); err != nil {
+		return nil, err
+	}
+
+	supply := sdk.NewCoins()
+	rng := new(collections.Range[collections.Pair[string, int64]])
+	for {
+		denom, found, err := k.firstSupplyHistoryDenom(ctx, rng)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return supply, nil
+		}
+
+		coin, err := k.GetSupplyAt(ctx, denom, height)
+		if err != nil {
+			return nil, err
+		}
+		supply = supply.Add(coin)
+
+		// skip the remaining history of denom
+		rng = new(collections.Range[collections.Pair[string, int64]]).StartExclusive(collections.Join(denom, int64(stdmath.MaxInt64)))
+	}
+}
+
+// firstSupplyHistoryDenom returns the first denom of the supply history in rng.
+func (k BaseViewKeeper) firstSupplyHistoryDenom(ctx context.Context, rng collections.Ranger[collections.Pair[string, int64]]) (string, bool, error) {
+	iter, err := k.SupplyHistory.Iterate(ctx, rng)
+	if err != nil {
+		return "", false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return "", false, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return "", false, err
+	}
+	return key.K1(), true, nil
+}
+
+// IterateSupplyChanges iterates over the supply changes from fromHeight, by
+// height, denom and source, calling cb with each change. The iteration stops if
+// the callback returns true. It returns an error if the supply history isn't
+// tracked at fromHeight.
+func (k BaseViewKeeper) IterateSupplyChanges(ctx context.Context, fromHeight int64, cb func(types.SupplyChange) (stop bool)) error {
+	if _, err := k.supplyHistoryStart(ctx, fromHeight); err != nil {
+		return err
+	}
+
+	rng := new(collections.Range[collections.Triple[int64, string, string]]).
+		StartInclusive(collections.TriplePrefix[int64, string, string](fromHeight))
+	return k.NetSupplyChanges.Walk(ctx, rng, func(key collections.Triple[int64, string, string], amount math.Int) (bool, error) {
+		return cb(types.SupplyChange{
+			Height: key.K1(),
+			Denom:  key.K2(),
+			Source: key.K3(),
+			Amount: amount,
+		}), nil
+	})
+}
+
+// supplyHistoryStart returns the height the supply history is tracked from, or
+// an error if it isn't tracked at height.
+func (k BaseViewKeeper) supplyHistoryStart(ctx context.Context, height int64) (int64, error) {
+	start, err := k.SupplyHistoryStart.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, errorsmod.Wrap(types.ErrSupplyHistoryNotFound, "supply history is not tracked")
+	} else if err != nil {
+		return 0, err
+	}
+
+	if height < start {
+		return 0, errorsmod.Wrapf(types.ErrSupplyHistoryNotFound, "supply history starts at height %d", start)
+	}
+	return start, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestSupplyHistory() {
+	require := suite.Require()
+	k := suite.bankKeeper
+	ctxAt := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: height})
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), multiPermAcc.Name).Return(multiPermAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), multiPermAcc.GetAddress()).Return(multiPermAcc).AnyTimes()
+
+	require.NoError(k.MintCoins(ctxAt(1), multiPerm, sdk.NewCoins(newFooCoin(100))))
+	_, err := k.GetSupplyAt(ctxAt(1), fooDenom, 1)
+	require.ErrorIs(err, banktypes.ErrSupplyHistoryNotFound)
+
+	// the history starts with the supply at the height tracking is enabled at
+	require.NoError(k.SetTrackSupplyHistory(ctxAt(2), true))
+	require.NoError(k.MintCoins(ctxAt(3), multiPerm, sdk.NewCoins(newFooCoin(50), newBarCoin(10))))
+	require.NoError(k.BurnCoins(ctxAt(3), multiPermAcc.GetAddress(), sdk.NewCoins(newFooCoin(20))))
+	require.NoError(k.BurnCoins(ctxAt(5), multiPermAcc.GetAddress(), sdk.NewCoins(newBarCoin(10))))
+
+	_, err = k.GetTotalSupplyAt(ctxAt(5), 1)
+	require.ErrorIs(err, banktypes.ErrSupplyHistoryNotFound)
+	for height, expected := range map[int64]sdk.Coins{
+		2: sdk.NewCoins(newFooCoin(100)),
+		3: sdk.NewCoins(newFooCoin(130), newBarCoin(10)),
+		4: sdk.NewCoins(newFooCoin(130), newBarCoin(10)),
+		5: sdk.NewCoins(newFooCoin(130)),
+	} {
+		supply, err := k.GetTotalSupplyAt(ctxAt(5), height)
+		require.NoError(err)
+		require.Equal(expected, supply, height)
+	}
+
+	supply, err := k.GetSupplyAt(ctxAt(5), barDenom, 4)
+	require.NoError(err)
+	require.Equal(newBarCoin(10), supply)
+
+	// the changes are netted by height, denom and source
+	var changes []banktypes.SupplyChange
+	require.NoError(k.IterateSupplyChanges(ctxAt(5), 3, func(change banktypes.SupplyChange) bool {
+		changes = append(changes, change)
+		return false
+	}))
+	require.Equal([]banktypes.SupplyChange{
+		{Height: 3, Denom: barDenom, Source: multiPerm, Amount: math.NewInt(10)},
+		{Height: 3, Denom: fooDenom, Source: multiPerm, Amount: math.NewInt(30)},
+		{Height: 5, Denom: barDenom, Source: multiPerm, Amount: math.NewInt(-10)},
+	}, changes)
+
+	// the supply and its changes are queried by height
+	supplyRes, err := k.TotalSupplyAt(ctxAt(5), &banktypes.QueryTotalSupplyAtRequest{Height: 3})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(130), newBarCoin(10)), supplyRes.Supply)
+
+	res, err := k.SupplyChanges(ctxAt(5), &banktypes.QuerySupplyChangesRequest{Height: 2, Limit: 1})
+	require.NoError(err)
+	require.Equal(changes[:2], res.Changes)
+	require.Equal(int64(5), res.NextHeight)
+
+	res, err = k.SupplyChanges(ctxAt(5), &banktypes.QuerySupplyChangesRequest{Height: res.NextHeight})
+	require.NoError(err)
+	require.Equal(changes[2:], res.Changes)
+	require.Zero(res.NextHeight)
+
+	_, err = k.TotalSupplyAt(ctxAt(5), &banktypes.QueryTotalSupplyAtRequest{Height: 1})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// disabling tracking keeps the history, which can't be queried anymore
+	require.NoError(k.SetTrackSupplyHistory(ctxAt(6), false))
+	_, err = k.GetSupplyAt(ctxAt(6), fooDenom, 5)
+	require.ErrorIs(err, banktypes.ErrSupplyHistoryNotFound)
+}
@@ -67,6 +67,12 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+
+	DustReapCursor collections.Item[[]byte]
+
+	SupplyHistory      collections.Map[collections.Pair[string, int64], math.Int]
+	NetSupplyChanges   collections.Map[collections.Triple[int64, string, string], math.Int]
+	SupplyHistoryStart collections.Item[int64]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +88,12 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		DustReapCursor: collections.NewItem(sb, types.DustReapCursorKey, "dust_reap_cursor", collections.BytesValue),
+
+		SupplyHistory:      collections.NewMap(sb, types.SupplyHistoryPrefix, "supply_history", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), sdk.IntValue),
+		NetSupplyChanges:   collections.NewMap(sb, types.SupplyChangesPrefix, "supply_changes", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey), sdk.IntValue),
+		SupplyHistoryStart: collections.NewItem(sb, types.SupplyHistoryStartKey, "supply_history_start", collections.Int64Value),
 	}
 
 	schema, err := sb.Build()
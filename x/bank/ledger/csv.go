@@ -0,0 +1,124 @@
+// Package ledger exports the balance movements recorded by the bank module as
+// double-entry ledger rows, for auditors and accounting integrations.
+package ledger
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/types"
+)
+
+// CSVHeader is the header row of the CSV export.
+var CSVHeader = []string{"height", "msg_type", "from", "to", "denom", "amount"}
+
+var _ storetypes.ABCIListener = (*CSVExporter)(nil)
+
+// CSVExporter writes the ledger entries of the blocks committed by the node as
+// CSV rows, in the order the coins were moved. It is an ABCIListener, to be
+// registered with the streaming manager of the app, and requires the ledger
+// events of the bank keeper to be enabled.
+//
+// The rows of a block are written and flushed when the block is committed, so
+// that the rows of a block are never exported before it is final.
+type CSVExporter struct {
+	mtx sync.Mutex
+	w   *csv.Writer
+	// header is whether the header row was written
+	header bool
+	// pending holds the entries of the block being finalized, written at commit
+	pending []types.LedgerEntry
+}
+
+// NewCSVExporter returns a CSVExporter writing to w. The header row is written
+// before the first rows.
+func NewCSVExporter(w io.Writer) *CSVExporter {
+	return &CSVExporter{w: csv.NewWriter(w)}
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (e *CSVExporter) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	// the block events emitted in EndBlock happen after the txs
+	var beginEvents, endEvents []abci.Event
+	for _, event := range res.Events {
+		if isEndBlockEvent(event) {
+			endEvents = append(endEvents, event)
+		} else {
+			beginEvents = append(beginEvents, event)
+		}
+	}
+
+	entries, err := types.LedgerEntriesFromEvents(req.Height, beginEvents)
+	if err != nil {
+		return err
+	}
+	for _, txResult := range res.TxResults {
+		txEntries, err := types.LedgerEntriesFromEvents(req.Height, txResult.Events)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, txEntries...)
+	}
+	endEntries, err := types.LedgerEntriesFromEvents(req.Height, endEvents)
+	if err != nil {
+		return err
+	}
+	entries = append(entries, endEntries...)
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.pending = entries
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. The rows of the block are
+// written once it is committed.
+func (e *CSVExporter) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if !e.header {
+		if err := e.w.Write(CSVHeader); err != nil {
+			return err
+		}
+		e.header = true
+	}
+
+	for _, entry := range e.pending {
+		row := []string{
+			strconv.FormatInt(entry.Height, 10),
+			entry.MsgType,
+			entry.From,
+			entry.To,
+			entry.Denom,
+			entry.Amount.String(),
+		}
+		if err := e.w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+	if err := e.w.Error(); err != nil {
+		return err
+	}
+
+	e.pending = nil
+	return nil
+}
+
+func isEndBlockEvent(event abci.Event) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value == "EndBlock"
+		}
+	}
+	return false
+}
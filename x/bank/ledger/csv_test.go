@@ -0,0 +1,58 @@
+package ledger_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/ledger"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func ledgerEvent(from, to string, amount int64, attrs ...abci.EventAttribute) abci.Event {
+	event := abci.Event(types.NewLedgerEntryEvent(from, to, sdk.NewInt64Coin("stake", amount)))
+	event.Attributes = append(event.Attributes, attrs...)
+	return event
+}
+
+func TestCSVExporter(t *testing.T) {
+	var buf bytes.Buffer
+	exporter := ledger.NewCSVExporter(&buf)
+
+	msgIndex := abci.EventAttribute{Key: "msg_index", Value: "0"}
+	res := abci.ResponseFinalizeBlock{
+		Events: []abci.Event{
+			ledgerEvent("supply", "mint", 10, abci.EventAttribute{Key: "mode", Value: "BeginBlock"}),
+			ledgerEvent("pool", "supply", 3, abci.EventAttribute{Key: "mode", Value: "EndBlock"}),
+		},
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{
+				ledgerEvent("alice", "fee_collector", 1),
+				{Type: sdk.EventTypeMessage, Attributes: []abci.EventAttribute{{Key: sdk.AttributeKeyAction, Value: "/cosmos.bank.v1beta1.MsgSend"}, msgIndex}},
+				ledgerEvent("alice", "bob", 5, msgIndex),
+			}},
+		},
+	}
+
+	require.NoError(t, exporter.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 7}, res))
+	// rows are only written once the block is committed
+	require.Zero(t, buf.Len())
+	require.NoError(t, exporter.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+
+	require.Equal(t, `height,msg_type,from,to,denom,amount
+7,,supply,mint,stake,10
+7,,alice,fee_collector,stake,1
+7,/cosmos.bank.v1beta1.MsgSend,alice,bob,stake,5
+7,,pool,supply,stake,3
+`, buf.String())
+
+	// the header is written once
+	require.NoError(t, exporter.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 8}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, exporter.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	require.Equal(t, 5, bytes.Count(buf.Bytes(), []byte("\n")))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -39,7 +40,8 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasInvariants       = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -160,6 +162,12 @@ func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock reaps the dust accounts, if enabled by the dust_reap_limit param.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	_, err := am.keeper.ReapDustAccounts(ctx)
+	return err
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -189,6 +197,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeAppendAccountStateChecks),
 	)
 }
 
@@ -257,3 +266,22 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeAppendAccountStateChecks registers the account state checks of the
+// modules holding account state, which dust reaping requires.
+func InvokeAppendAccountStateChecks(keeper keeper.BaseKeeper, checks map[string]types.AccountStateCheckWrapper) {
+	// all arguments to invokers are optional
+	if len(checks) == 0 {
+		return
+	}
+
+	modNames := make([]string, 0, len(checks))
+	for modName := range checks {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	for _, modName := range modNames {
+		keeper.AppendAccountStateCheck(checks[modName].AccountStateCheckFn)
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountCreationHookFn is called when the bank module creates the account of
+// an address receiving coins, e.g. to account for the state rent of the new
+// account. An error aborts the transfer.
+type AccountCreationHookFn func(ctx context.Context, addr sdk.AccAddress) error
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,17 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// dust_thresholds are the balances under which amounts of their denom are
+	// dust. Accounts holding only dust can be reaped.
+	DustThresholds []DustThreshold `protobuf:"bytes,3,rep,name=dust_thresholds,json=dustThresholds,proto3" json:"dust_thresholds"`
+	// dust_reap_limit is the maximum number of accounts examined for dust at the
+	// end of a block. Zero disables dust reaping.
+	DustReapLimit uint64 `protobuf:"varint,4,opt,name=dust_reap_limit,json=dustReapLimit,proto3" json:"dust_reap_limit,omitempty"`
+	// dust_exemptions are the addresses never reaped.
+	DustExemptions []string `protobuf:"bytes,5,rep,name=dust_exemptions,json=dustExemptions,proto3" json:"dust_exemptions,omitempty"`
+	// defer_account_creation defers the creation of the accounts of addresses
+	// receiving only dust until they receive a non-dust amount or sign a tx.
+	DeferAccountCreation bool `protobuf:"varint,6,opt,name=defer_account_creation,json=deferAccountCreation,proto3" json:"defer_account_creation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +99,80 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDustThresholds() []DustThreshold {
+	if m != nil {
+		return m.DustThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetDustReapLimit() uint64 {
+	if m != nil {
+		return m.DustReapLimit
+	}
+	return 0
+}
+
+func (m *Params) GetDustExemptions() []string {
+	if m != nil {
+		return m.DustExemptions
+	}
+	return nil
+}
+
+func (m *Params) GetDeferAccountCreation() bool {
+	if m != nil {
+		return m.DeferAccountCreation
+	}
+	return false
+}
+
+// DustThreshold is the balance under which an amount of a denom is dust.
+type DustThreshold struct {
+	Denom     string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+}
+
+func (m *DustThreshold) Reset()         { *m = DustThreshold{} }
+func (m *DustThreshold) String() string { return proto.CompactTextString(m) }
+func (*DustThreshold) ProtoMessage()    {}
+func (*DustThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{1}
+}
+func (m *DustThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DustThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DustThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DustThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DustThreshold.Merge(m, src)
+}
+func (m *DustThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *DustThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_DustThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DustThreshold proto.InternalMessageInfo
+
+func (m *DustThreshold) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -98,7 +184,7 @@ func (m *SendEnabled) Reset()         { *m = SendEnabled{} }
 func (m *SendEnabled) String() string { return proto.CompactTextString(m) }
 func (*SendEnabled) ProtoMessage()    {}
 func (*SendEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{1}
+	return fileDescriptor_dd052eee12edf988, []int{2}
 }
 func (m *SendEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +237,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{3}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +276,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +318,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +366,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +451,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,65 +536,172 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// SupplyChange is the net change of the supply of a denom by the coins minted and burned by a source at a height.
+type SupplyChange struct {
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source is the name of the module account minting or burning the coins, or the address of the account burning
+	// them.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// amount is negative if more coins were burned than minted.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *SupplyChange) Reset()         { *m = SupplyChange{} }
+func (m *SupplyChange) String() string { return proto.CompactTextString(m) }
+func (*SupplyChange) ProtoMessage()    {}
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *SupplyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyChange.Merge(m, src)
+}
+func (m *SupplyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyChange proto.InternalMessageInfo
+
+func (m *SupplyChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SupplyChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SupplyChange) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
+	proto.RegisterType((*DustThreshold)(nil), "cosmos.bank.v1beta1.DustThreshold")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SupplyChange)(nil), "cosmos.bank.v1beta1.SupplyChange")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xda, 0xf1, 0x8f, 0xac, 0xf3, 0x8a, 0xb7, 0xcf, 0x7a, 0x6f, 0x93, 0x27, 0xce, 0x96,
-	0x0b, 0x64, 0x2c, 0xc5, 0x47, 0x92, 0xce, 0x0d, 0xc2, 0xe1, 0x97, 0x0b, 0x04, 0xba, 0x28, 0x42,
-	0xa2, 0xb1, 0xd6, 0xbe, 0xc5, 0x5e, 0xe5, 0x6e, 0xf7, 0x74, 0xbb, 0x17, 0xe2, 0x96, 0x0a, 0xa5,
-	0xa2, 0xa6, 0x8a, 0xa8, 0x10, 0xa2, 0x70, 0x91, 0x9e, 0x36, 0x4a, 0x15, 0x51, 0x51, 0x05, 0xe4,
-	0x14, 0xce, 0x9f, 0x81, 0x6e, 0xf7, 0xce, 0x71, 0xa4, 0xd0, 0x22, 0xd1, 0xd8, 0x33, 0xf3, 0x7d,
-	0x3b, 0xf3, 0xcd, 0xec, 0xdc, 0x42, 0x6b, 0x20, 0xa4, 0x2f, 0xa4, 0xdd, 0x27, 0x7c, 0xcf, 0xde,
-	0xdf, 0xe8, 0x53, 0x45, 0x36, 0xb4, 0xd3, 0x0a, 0x42, 0xa1, 0x04, 0xfa, 0xc7, 0xe0, 0x2d, 0x1d,
-	0x4a, 0xf0, 0xb5, 0xca, 0x50, 0x0c, 0x85, 0xc6, 0xed, 0xd8, 0x32, 0xd4, 0xb5, 0x55, 0x43, 0xed,
-	0x19, 0x20, 0x39, 0x67, 0xa0, 0xab, 0x2a, 0x92, 0xce, 0xab, 0x0c, 0x04, 0xe3, 0x09, 0xfe, 0x5f,
-	0x82, 0xfb, 0x72, 0x68, 0xef, 0x6f, 0xc4, 0x7f, 0x09, 0xf0, 0x37, 0xf1, 0x19, 0x17, 0xb6, 0xfe,
-	0x35, 0xa1, 0xfa, 0x07, 0x00, 0x0b, 0xcf, 0x49, 0x48, 0x7c, 0x89, 0x1e, 0xc3, 0x15, 0x49, 0xb9,
-	0xdb, 0xa3, 0x9c, 0xf4, 0x3d, 0xea, 0x62, 0x50, 0xcb, 0x35, 0xca, 0x9b, 0xb5, 0xd6, 0x0d, 0x9a,
-	0x5b, 0x3b, 0x94, 0xbb, 0x0f, 0x0d, 0xaf, 0x93, 0xc5, 0xc0, 0x29, 0xcb, 0xab, 0x00, 0xba, 0x0b,
-	0x2b, 0x2e, 0x7d, 0x45, 0x22, 0x4f, 0xf5, 0xae, 0x25, 0xcc, 0xd6, 0x40, 0xa3, 0xe4, 0xa0, 0x04,
-	0x5b, 0x48, 0xd1, 0xbe, 0x75, 0x38, 0x9b, 0x34, 0xb1, 0x29, 0xb4, 0x2e, 0xdd, 0x3d, 0xfb, 0xc0,
-	0x8c, 0xd0, 0x28, 0xab, 0x6f, 0xc3, 0xf2, 0x02, 0x1b, 0x55, 0x60, 0xde, 0xa5, 0x5c, 0xf8, 0x18,
-	0xd4, 0x40, 0x63, 0xd9, 0x31, 0x0e, 0xc2, 0xb0, 0x78, 0xbd, 0x50, 0xea, 0xb6, 0x97, 0x2e, 0x8f,
-	0xaa, 0xa0, 0x7e, 0x0a, 0x60, 0xbe, 0xcb, 0x83, 0x48, 0xa1, 0x4d, 0x58, 0x24, 0xae, 0x1b, 0x52,
-	0x29, 0x4d, 0x86, 0x0e, 0xfe, 0x7a, 0xbc, 0x5e, 0x49, 0xda, 0xbc, 0x6f, 0x90, 0x1d, 0x15, 0x32,
-	0x3e, 0x74, 0x52, 0x22, 0x7a, 0x0d, 0xf3, 0xf1, 0x84, 0x25, 0xce, 0xea, 0xa9, 0xac, 0x5e, 0x4d,
-	0x45, 0xd2, 0xf9, 0x54, 0xb6, 0x05, 0xe3, 0x9d, 0x47, 0x27, 0xe7, 0xd5, 0xcc, 0xa7, 0xef, 0xd5,
-	0xc6, 0x90, 0xa9, 0x51, 0xd4, 0x6f, 0x0d, 0x84, 0x9f, 0x5c, 0x9f, 0xbd, 0xd0, 0xa0, 0x1a, 0x07,
-	0x54, 0xea, 0x03, 0xf2, 0xfd, 0x6c, 0xd2, 0x5c, 0xf1, 0xe8, 0x90, 0x0c, 0xc6, 0x3d, 0x5d, 0xe3,
-	0xe3, 0x6c, 0xd2, 0x04, 0x8e, 0xa9, 0xd7, 0xae, 0xbc, 0x3d, 0xaa, 0x66, 0x2e, 0x8f, 0xaa, 0x99,
-	0x37, 0xb3, 0x49, 0x33, 0x95, 0x53, 0xff, 0x02, 0x60, 0xe1, 0x59, 0xa4, 0xfe, 0xb8, 0x6e, 0x4a,
-	0x69, 0x37, 0xf5, 0xcf, 0x00, 0x16, 0x76, 0xa2, 0x20, 0xf0, 0xc6, 0xb1, 0x1a, 0x25, 0x14, 0xf1,
-	0x92, 0x8d, 0xfb, 0x1d, 0x6a, 0x74, 0xbd, 0xf6, 0x9d, 0x44, 0x0d, 0x38, 0x3d, 0x5e, 0xff, 0xff,
-	0xc6, 0x35, 0xd7, 0x02, 0xbb, 0x18, 0xd4, 0x5f, 0xc0, 0xe5, 0x07, 0xf1, 0x9a, 0xed, 0x72, 0xa6,
-	0x7e, 0xb1, 0x80, 0x6b, 0xb0, 0x44, 0x0f, 0x02, 0xc1, 0x29, 0x57, 0x7a, 0x03, 0xff, 0x72, 0xe6,
-	0x7e, 0xbc, 0x9c, 0xc4, 0x63, 0x44, 0x52, 0x89, 0x73, 0xb5, 0x5c, 0x63, 0xd9, 0x49, 0xdd, 0xfa,
-	0x61, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x5c, 0xa2, 0x08, 0xaa, 0xc1, 0xb2, 0x4b, 0xe5, 0x20, 0x64,
-	0x81, 0x62, 0x82, 0x27, 0xe9, 0x17, 0x43, 0xe8, 0x5e, 0xcc, 0xe0, 0xc2, 0xef, 0x45, 0x9c, 0xa9,
-	0xf4, 0xfe, 0xac, 0x1b, 0xbf, 0xd1, 0xb9, 0x5e, 0x07, 0xba, 0xa9, 0x29, 0x11, 0x82, 0x4b, 0xf1,
-	0x5c, 0x71, 0x4e, 0xe7, 0xd6, 0x76, 0xac, 0xce, 0x65, 0x32, 0xf0, 0xc8, 0x18, 0x2f, 0xe9, 0x70,
-	0xea, 0xc6, 0x6c, 0x4e, 0x7c, 0x8a, 0xf3, 0x86, 0x1d, 0xdb, 0xe8, 0x5f, 0x58, 0x90, 0x63, 0xbf,
-	0x2f, 0x3c, 0x5c, 0xd0, 0xd1, 0xc4, 0x43, 0xab, 0x30, 0x17, 0x85, 0x0c, 0x17, 0xf5, 0x12, 0x16,
-	0xa7, 0xe7, 0xd5, 0xdc, 0xae, 0xd3, 0x75, 0xe2, 0x18, 0xba, 0x0d, 0x4b, 0x51, 0xc8, 0x7a, 0x23,
-	0x22, 0x47, 0xb8, 0xa4, 0xf1, 0xf2, 0xf4, 0xbc, 0x5a, 0xdc, 0x75, 0xba, 0x4f, 0x88, 0x1c, 0x39,
-	0xc5, 0x28, 0x64, 0xb1, 0xd1, 0xd9, 0x3a, 0x99, 0x5a, 0xe0, 0x6c, 0x6a, 0x81, 0x1f, 0x53, 0x0b,
-	0xbc, 0xbb, 0xb0, 0x32, 0x67, 0x17, 0x56, 0xe6, 0xdb, 0x85, 0x95, 0x79, 0x99, 0x3c, 0x87, 0xd2,
-	0xdd, 0x6b, 0x31, 0x91, 0x3e, 0x0f, 0xfa, 0xa2, 0xfb, 0x05, 0xfd, 0x92, 0x6d, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xee, 0xbd, 0x69, 0x7d, 0x05, 0x00, 0x00,
-}
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xc6, 0xbf, 0xc7, 0x09, 0x88, 0xc1, 0x1c, 0x93, 0x20, 0x6c, 0xcb, 0xc5, 0xc9, 0x04,
+	0xc5, 0x26, 0x77, 0x54, 0x69, 0x50, 0xec, 0x3b, 0xc0, 0x12, 0x08, 0xb4, 0x21, 0x20, 0xd1, 0xac,
+	0xc6, 0xbb, 0x73, 0xde, 0x51, 0x76, 0x67, 0x56, 0x3b, 0xb3, 0x21, 0x6e, 0xa9, 0xd0, 0x55, 0xb4,
+	0x40, 0x93, 0x12, 0x21, 0x8a, 0x14, 0xe9, 0x69, 0x4f, 0x57, 0x9d, 0xae, 0x42, 0x14, 0x01, 0x39,
+	0x45, 0xee, 0xcf, 0x40, 0xf3, 0x63, 0x6d, 0x47, 0x32, 0x48, 0x34, 0x48, 0xd7, 0x24, 0xf3, 0xde,
+	0xf7, 0xe6, 0x7d, 0xef, 0xbd, 0xf9, 0xf6, 0x19, 0xb4, 0x7c, 0x2e, 0x62, 0x2e, 0x06, 0x13, 0xcc,
+	0x4e, 0x06, 0xa7, 0xfb, 0x13, 0x22, 0xf1, 0xbe, 0x36, 0xfa, 0x49, 0xca, 0x25, 0x87, 0xaf, 0x1b,
+	0xbc, 0xaf, 0x5d, 0x16, 0xdf, 0x69, 0x4e, 0xf9, 0x94, 0x6b, 0x7c, 0xa0, 0x4e, 0x26, 0x74, 0x67,
+	0xdb, 0x84, 0x7a, 0x06, 0xb0, 0xf7, 0x0c, 0xb4, 0x64, 0x11, 0x64, 0xc1, 0xe2, 0x73, 0xca, 0x2c,
+	0xfe, 0xa6, 0xc5, 0x63, 0x31, 0x1d, 0x9c, 0xee, 0xab, 0x7f, 0x16, 0x78, 0x0d, 0xc7, 0x94, 0xf1,
+	0x81, 0xfe, 0x6b, 0x5c, 0xdd, 0x9f, 0x8a, 0xa0, 0xf2, 0x39, 0x4e, 0x71, 0x2c, 0xe0, 0x47, 0x60,
+	0x53, 0x10, 0x16, 0x78, 0x84, 0xe1, 0x49, 0x44, 0x02, 0xe4, 0x74, 0x8a, 0xbd, 0xc6, 0xbd, 0x4e,
+	0x7f, 0x4d, 0xcd, 0xfd, 0x23, 0xc2, 0x82, 0x87, 0x26, 0x6e, 0xb8, 0x81, 0x1c, 0xb7, 0x21, 0x96,
+	0x0e, 0xf8, 0x1e, 0x68, 0x06, 0xe4, 0x11, 0xce, 0x22, 0xe9, 0xdd, 0x4a, 0xb8, 0xd1, 0x71, 0x7a,
+	0x35, 0x17, 0x5a, 0x6c, 0x25, 0x05, 0xfc, 0x12, 0xbc, 0x1a, 0x64, 0x42, 0x7a, 0x32, 0x4c, 0x89,
+	0x08, 0x79, 0x14, 0x08, 0x54, 0xd4, 0xec, 0xdd, 0xb5, 0xec, 0x0f, 0x32, 0x21, 0xbf, 0xc8, 0x43,
+	0x87, 0xf5, 0x27, 0x57, 0xed, 0xc2, 0xcf, 0x37, 0x17, 0xbb, 0x8e, 0xfb, 0x4a, 0xb0, 0x8a, 0x08,
+	0x78, 0xd7, 0xe6, 0x4d, 0x09, 0x4e, 0xbc, 0x88, 0xc6, 0x54, 0xa2, 0x52, 0xc7, 0xe9, 0x95, 0xdc,
+	0x2d, 0xe5, 0x76, 0x09, 0x4e, 0x3e, 0x51, 0x4e, 0x78, 0x68, 0xe3, 0xc8, 0x19, 0x89, 0x13, 0x49,
+	0x39, 0x13, 0xa8, 0xdc, 0x29, 0xf6, 0xea, 0x43, 0xf4, 0xfc, 0x72, 0xaf, 0x69, 0x4b, 0x38, 0x0c,
+	0x82, 0x94, 0x08, 0x71, 0x24, 0x53, 0xca, 0xa6, 0x86, 0xea, 0xe1, 0x22, 0x1e, 0xbe, 0x0f, 0xee,
+	0x04, 0xe4, 0x11, 0x49, 0x3d, 0xec, 0xfb, 0x3c, 0x63, 0xd2, 0xf3, 0x53, 0x82, 0x15, 0x84, 0x2a,
+	0xba, 0xed, 0xa6, 0x46, 0x0f, 0x0d, 0x38, 0xb2, 0xd8, 0xc1, 0xdb, 0x8f, 0x6f, 0x2e, 0x76, 0x91,
+	0x21, 0xd8, 0x13, 0xc1, 0xc9, 0xe0, 0xcc, 0x68, 0xc7, 0x3c, 0x49, 0xf7, 0x14, 0x6c, 0xdd, 0xea,
+	0x15, 0x36, 0x41, 0x39, 0x20, 0x8c, 0xc7, 0xc8, 0xe9, 0x38, 0xbd, 0xba, 0x6b, 0x0c, 0x38, 0x06,
+	0xf5, 0xc5, 0xe4, 0xf4, 0x94, 0xeb, 0xc3, 0x77, 0xd5, 0x50, 0xfe, 0xb8, 0x6a, 0xbf, 0x61, 0x72,
+	0x8b, 0xe0, 0xa4, 0x4f, 0xf9, 0x20, 0xc6, 0x32, 0xec, 0x8f, 0x99, 0x7c, 0x7e, 0xb9, 0x07, 0x6c,
+	0x57, 0x63, 0x26, 0xdd, 0xe5, 0xed, 0x83, 0xd2, 0x8b, 0xf3, 0xb6, 0xd3, 0x1d, 0x81, 0xc6, 0xea,
+	0xf3, 0xac, 0x67, 0x45, 0xa0, 0x7a, 0xfb, 0x65, 0x73, 0xd3, 0x26, 0x79, 0xea, 0x80, 0xf2, 0x98,
+	0x25, 0x99, 0x84, 0xf7, 0x40, 0x15, 0x9b, 0xe1, 0x99, 0x0c, 0xff, 0x32, 0xd6, 0x3c, 0x10, 0x7e,
+	0x03, 0xca, 0x4a, 0xd2, 0x02, 0x6d, 0x68, 0x21, 0x6c, 0x2f, 0x85, 0x20, 0xc8, 0x42, 0x08, 0x23,
+	0x4e, 0xd9, 0xf0, 0x43, 0xd5, 0xea, 0x2f, 0x7f, 0xb6, 0x7b, 0x53, 0x2a, 0xc3, 0x6c, 0xd2, 0xf7,
+	0x79, 0x6c, 0xbf, 0x97, 0xc1, 0xca, 0x60, 0xe5, 0x2c, 0x21, 0x42, 0x5f, 0x10, 0x3f, 0xde, 0x5c,
+	0xec, 0x6e, 0x46, 0x64, 0x8a, 0xfd, 0x99, 0xa7, 0x39, 0x8c, 0x78, 0x0c, 0xdf, 0x41, 0xf3, 0xbb,
+	0xf3, 0x76, 0xe1, 0xc5, 0x79, 0xbb, 0xf0, 0xed, 0xcd, 0xc5, 0x6e, 0x5e, 0x4e, 0xf7, 0x37, 0x07,
+	0x54, 0x3e, 0xcb, 0xe4, 0x4b, 0xd7, 0x4d, 0x2d, 0xef, 0xa6, 0xfb, 0xab, 0x03, 0x2a, 0x47, 0x59,
+	0x92, 0x44, 0x33, 0x55, 0x8d, 0xe4, 0x12, 0x47, 0xc8, 0xf9, 0xdf, 0xaa, 0xd1, 0x7c, 0x07, 0xef,
+	0xd8, 0x6a, 0x9c, 0xa7, 0x97, 0x7b, 0x6f, 0xad, 0xdd, 0x2b, 0xba, 0xc0, 0x31, 0x72, 0xba, 0x5f,
+	0x81, 0xfa, 0x03, 0x25, 0xb3, 0x63, 0x46, 0xe5, 0x3f, 0x08, 0x70, 0x07, 0xd4, 0xc8, 0x59, 0xc2,
+	0x19, 0x61, 0x52, 0x2b, 0x70, 0xcb, 0x5d, 0xd8, 0x4a, 0x9c, 0x38, 0xa2, 0x58, 0x10, 0xb3, 0x49,
+	0xea, 0x6e, 0x6e, 0x76, 0x1f, 0x6f, 0x80, 0xda, 0xa7, 0x44, 0xe2, 0x00, 0x4b, 0x0c, 0x3b, 0xa0,
+	0x11, 0x10, 0xe1, 0xa7, 0x54, 0x7f, 0xc5, 0x36, 0xfd, 0xaa, 0x0b, 0x7e, 0xa0, 0x22, 0x18, 0x8f,
+	0xbd, 0x8c, 0x51, 0x99, 0xbf, 0x5f, 0x6b, 0xfd, 0x5a, 0xca, 0xeb, 0x75, 0x41, 0x90, 0x1f, 0x05,
+	0x84, 0xa0, 0xa4, 0xe6, 0x8a, 0x8a, 0x3a, 0xb7, 0x3e, 0xab, 0xea, 0x02, 0x2a, 0x92, 0x08, 0xcf,
+	0xf4, 0x3e, 0xaa, 0xbb, 0xb9, 0xa9, 0xa2, 0x19, 0x8e, 0x09, 0x2a, 0x9b, 0x68, 0x75, 0x86, 0x77,
+	0x40, 0x45, 0xcc, 0xe2, 0x09, 0x8f, 0xf4, 0x2a, 0xa9, 0xbb, 0xd6, 0x82, 0xdb, 0xa0, 0x98, 0xa5,
+	0x14, 0x55, 0xb5, 0x08, 0xab, 0xf3, 0xab, 0x76, 0xf1, 0xd8, 0x1d, 0xbb, 0xca, 0x07, 0xef, 0x82,
+	0x5a, 0x96, 0x52, 0x2f, 0xc4, 0x22, 0x44, 0x35, 0x8d, 0x37, 0xe6, 0x57, 0xed, 0xea, 0xb1, 0x3b,
+	0xfe, 0x18, 0x8b, 0xd0, 0xad, 0x66, 0x29, 0x55, 0x87, 0xee, 0x0f, 0x0e, 0xd8, 0x34, 0x33, 0x1f,
+	0x85, 0x98, 0x4d, 0x35, 0x57, 0x48, 0xe8, 0x34, 0x94, 0x7a, 0x16, 0x45, 0xd7, 0x5a, 0xcb, 0x17,
+	0xd8, 0x58, 0x7d, 0x01, 0x55, 0x19, 0xcf, 0x52, 0x3f, 0xef, 0xce, 0x5a, 0x70, 0x04, 0x2a, 0x38,
+	0x56, 0x8b, 0x0e, 0x95, 0xfe, 0xfb, 0x36, 0xb2, 0x57, 0x87, 0xf7, 0x9f, 0xcc, 0x5b, 0xce, 0xb3,
+	0x79, 0xcb, 0xf9, 0x6b, 0xde, 0x72, 0xbe, 0xbf, 0x6e, 0x15, 0x9e, 0x5d, 0xb7, 0x0a, 0xbf, 0x5f,
+	0xb7, 0x0a, 0x5f, 0x6f, 0xdf, 0x4a, 0x63, 0x57, 0xa6, 0x16, 0xe1, 0xa4, 0xa2, 0x7f, 0xd6, 0xee,
+	0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x15, 0xbb, 0x3d, 0xec, 0x8a, 0x07, 0x00, 0x00,
+}
+
+func (this *DustThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*DustThreshold)
+	if !ok {
+		that2, ok := that.(DustThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	return true
+}
 func (this *SendEnabled) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -585,6 +778,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeferAccountCreation {
+		i--
+		if m.DeferAccountCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DustExemptions) > 0 {
+		for iNdEx := len(m.DustExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DustExemptions[iNdEx])
+			copy(dAtA[i:], m.DustExemptions[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.DustExemptions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DustReapLimit != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.DustReapLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DustThresholds) > 0 {
+		for iNdEx := len(m.DustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -612,6 +843,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DustThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DustThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DustThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -907,6 +1178,58 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -933,6 +1256,39 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DustThresholds) > 0 {
+		for _, e := range m.DustThresholds {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DustReapLimit != 0 {
+		n += 1 + sovBank(uint64(m.DustReapLimit))
+	}
+	if len(m.DustExemptions) > 0 {
+		for _, s := range m.DustExemptions {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DeferAccountCreation {
+		n += 2
+	}
+	return n
+}
+
+func (m *DustThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovBank(uint64(l))
 	return n
 }
 
@@ -1070,6 +1426,28 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SupplyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1159,19 +1537,240 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBank
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
 			}
-			iNdEx += skippy
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThresholds = append(m.DustThresholds, DustThreshold{})
+			if err := m.DustThresholds[len(m.DustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustReapLimit", wireType)
+			}
+			m.DustReapLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustReapLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustExemptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustExemptions = append(m.DustExemptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferAccountCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeferAccountCreation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DustThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DustThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DustThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -2039,6 +2638,173 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
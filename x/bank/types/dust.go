@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountStateCheckFn reports whether a module holds state for an account, such
+// as delegations or grants, in which case the account is not reaped as dust.
+type AccountStateCheckFn func(ctx context.Context, addr sdk.AccAddress) (bool, error)
+
+// AccountStateCheckWrapper is a wrapper for modules to inject an
+// AccountStateCheckFn using depinject.
+type AccountStateCheckWrapper struct{ AccountStateCheckFn }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AccountStateCheckWrapper) IsOnePerModuleType() {}
@@ -17,10 +17,17 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	EventTypeDustAccountReaped = "dust_account_reaped"
+	EventTypeLedgerEntry       = "ledger_entry"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+	AttributeKeyAccount  = "account"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyFrom     = "from"
+	AttributeKeyTo       = "to"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -58,3 +65,23 @@ func NewCoinBurnEvent(burner string, amount sdk.Coins) sdk.Event {
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewDustAccountReapedEvent constructs a new dust account reaped sdk.Event
+func NewDustAccountReapedEvent(account string, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeDustAccountReaped,
+		sdk.NewAttribute(AttributeKeyAccount, account),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewLedgerEntryEvent constructs a new ledger entry sdk.Event
+func NewLedgerEntryEvent(from, to string, coin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeLedgerEntry,
+		sdk.NewAttribute(AttributeKeyFrom, from),
+		sdk.NewAttribute(AttributeKeyTo, to),
+		sdk.NewAttribute(AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+	)
+}
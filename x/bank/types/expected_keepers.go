@@ -21,6 +21,7 @@ type AccountKeeper interface {
 	GetAllAccounts(ctx context.Context) []sdk.AccountI
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 	SetAccount(ctx context.Context, acc sdk.AccountI)
+	RemoveAccount(ctx context.Context, acc sdk.AccountI)
 
 	IterateAccounts(ctx context.Context, process func(sdk.AccountI) bool)
 
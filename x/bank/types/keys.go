@@ -42,6 +42,15 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DustReapCursorKey is the key of the address the next sweep resumes from.
+	DustReapCursorKey = collections.NewPrefix(9)
+	// SupplyHistoryPrefix is the prefix for the supply of denoms after the heights it changed at.
+	SupplyHistoryPrefix = collections.NewPrefix(11)
+	// SupplyChangesPrefix is the prefix for the supply changes by height, denom and source.
+	SupplyChangesPrefix = collections.NewPrefix(12)
+	// SupplyHistoryStartKey is the key of the height the supply history is tracked from.
+	SupplyHistoryStartKey = collections.NewPrefix(13)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LedgerSupply is the counterparty of the ledger entries of minted and burned
+// coins: coins are minted from the supply and burned to the supply.
+const LedgerSupply = "supply"
+
+// msgIndexAttributeKey is the attribute BaseApp appends to the events of a
+// message with the index of the message in its tx.
+const msgIndexAttributeKey = "msg_index"
+
+// LedgerEntry is a balance movement recorded as a double-entry ledger row:
+// Amount coins of Denom are debited from From and credited to To.
+type LedgerEntry struct {
+	Height int64
+	// MsgType is the type URL of the message which moved the coins, or empty if
+	// they were moved outside of messages, e.g. to pay fees or in BeginBlock.
+	MsgType string
+	From    string
+	To      string
+	Denom   string
+	Amount  math.Int
+}
+
+// LedgerEntriesFromEvents returns the ledger entries of the ledger_entry events
+// of a tx or block at height, emitted by the bank module when ledger events are
+// enabled. The message type of an entry is the action of the message event with
+// the same msg_index.
+func LedgerEntriesFromEvents(height int64, events []abci.Event) ([]LedgerEntry, error) {
+	msgTypes := make(map[string]string)
+	for _, event := range events {
+		if event.Type == sdk.EventTypeMessage {
+			attrs := eventAttributes(event)
+			if action, ok := attrs[sdk.AttributeKeyAction]; ok {
+				msgTypes[attrs[msgIndexAttributeKey]] = action
+			}
+		}
+	}
+
+	var entries []LedgerEntry
+	for _, event := range events {
+		if event.Type != EventTypeLedgerEntry {
+			continue
+		}
+
+		attrs := eventAttributes(event)
+		amount, ok := math.NewIntFromString(attrs[sdk.AttributeKeyAmount])
+		if !ok {
+			return nil, fmt.Errorf("invalid %s amount %q", EventTypeLedgerEntry, attrs[sdk.AttributeKeyAmount])
+		}
+
+		var msgType string
+		if msgIndex, ok := attrs[msgIndexAttributeKey]; ok {
+			msgType = msgTypes[msgIndex]
+		}
+
+		entries = append(entries, LedgerEntry{
+			Height:  height,
+			MsgType: msgType,
+			From:    attrs[AttributeKeyFrom],
+			To:      attrs[AttributeKeyTo],
+			Denom:   attrs[AttributeKeyDenom],
+			Amount:  amount,
+		})
+	}
+
+	return entries, nil
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
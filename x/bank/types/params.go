@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -31,9 +33,55 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+
+	if err := validateDustThresholds(p.DustThresholds); err != nil {
+		return err
+	}
+
+	if err := validateDustExemptions(p.DustExemptions); err != nil {
+		return err
+	}
+
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
+// DustThreshold returns the dust threshold of denom, and false if denom has
+// none.
+func (p Params) DustThreshold(denom string) (math.Int, bool) {
+	for _, dt := range p.DustThresholds {
+		if dt.Denom == denom {
+			return dt.Threshold, true
+		}
+	}
+
+	return math.Int{}, false
+}
+
+// IsDust returns whether all balances are below the dust threshold of their
+// denom. Balances of denoms without dust threshold are never dust.
+func (p Params) IsDust(balances sdk.Coins) bool {
+	for _, balance := range balances {
+		threshold, ok := p.DustThreshold(balance.Denom)
+		if !ok || balance.Amount.GTE(threshold) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsDustExempt returns whether the address addr, encoded as a string, is
+// exempted from dust reaping.
+func (p Params) IsDustExempt(addr string) bool {
+	for _, exemption := range p.DustExemptions {
+		if exemption == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validate gets any errors with this SendEnabled entry.
 func (se SendEnabled) Validate() error {
 	return sdk.ValidateDenom(se.Denom)
@@ -48,6 +96,42 @@ func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
 	}
 }
 
+func validateDustThresholds(thresholds []DustThreshold) error {
+	seen := make(map[string]bool, len(thresholds))
+	for _, dt := range thresholds {
+		if err := sdk.ValidateDenom(dt.Denom); err != nil {
+			return fmt.Errorf("invalid dust threshold denom: %w", err)
+		}
+
+		if seen[dt.Denom] {
+			return fmt.Errorf("duplicate dust threshold for %s", dt.Denom)
+		}
+		seen[dt.Denom] = true
+
+		if dt.Threshold.IsNil() || !dt.Threshold.IsPositive() {
+			return fmt.Errorf("dust threshold for %s must be positive: %s", dt.Denom, dt.Threshold)
+		}
+	}
+
+	return nil
+}
+
+func validateDustExemptions(exemptions []string) error {
+	seen := make(map[string]bool, len(exemptions))
+	for _, addr := range exemptions {
+		if addr == "" {
+			return errors.New("empty dust exemption address")
+		}
+
+		if seen[addr] {
+			return fmt.Errorf("duplicate dust exemption for %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
 // validateIsBool is used by the x/params module to validate that a thing is a bool.
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
@@ -1272,6 +1272,208 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTotalSupplyAtRequest is the request type for the Query/TotalSupplyAt RPC method.
+type QueryTotalSupplyAtRequest struct {
+	// height is the height after which the supply is queried.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryTotalSupplyAtRequest) Reset()         { *m = QueryTotalSupplyAtRequest{} }
+func (m *QueryTotalSupplyAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyAtRequest) ProtoMessage()    {}
+func (*QueryTotalSupplyAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{25}
+}
+func (m *QueryTotalSupplyAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSupplyAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyAtRequest.Merge(m, src)
+}
+func (m *QueryTotalSupplyAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSupplyAtRequest proto.InternalMessageInfo
+
+func (m *QueryTotalSupplyAtRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryTotalSupplyAtResponse is the response type for the Query/TotalSupplyAt RPC method.
+type QueryTotalSupplyAtResponse struct {
+	// supply is the supply of the coins at the queried height.
+	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+}
+
+func (m *QueryTotalSupplyAtResponse) Reset()         { *m = QueryTotalSupplyAtResponse{} }
+func (m *QueryTotalSupplyAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyAtResponse) ProtoMessage()    {}
+func (*QueryTotalSupplyAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{26}
+}
+func (m *QueryTotalSupplyAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSupplyAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyAtResponse.Merge(m, src)
+}
+func (m *QueryTotalSupplyAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSupplyAtResponse proto.InternalMessageInfo
+
+func (m *QueryTotalSupplyAtResponse) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+// QuerySupplyChangesRequest is the request type for the Query/SupplyChanges RPC method.
+type QuerySupplyChangesRequest struct {
+	// height is the height from which the changes are queried.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// limit is the maximum number of heights whose changes are returned, 100 if zero.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QuerySupplyChangesRequest) Reset()         { *m = QuerySupplyChangesRequest{} }
+func (m *QuerySupplyChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyChangesRequest) ProtoMessage()    {}
+func (*QuerySupplyChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{27}
+}
+func (m *QuerySupplyChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyChangesRequest.Merge(m, src)
+}
+func (m *QuerySupplyChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyChangesRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyChangesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySupplyChangesRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QuerySupplyChangesResponse is the response type for the Query/SupplyChanges RPC method.
+type QuerySupplyChangesResponse struct {
+	// changes are the supply changes by height, denom and source.
+	Changes []SupplyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// next_height is the height to query the next changes from, zero if there are none.
+	NextHeight int64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *QuerySupplyChangesResponse) Reset()         { *m = QuerySupplyChangesResponse{} }
+func (m *QuerySupplyChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyChangesResponse) ProtoMessage()    {}
+func (*QuerySupplyChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{28}
+}
+func (m *QuerySupplyChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyChangesResponse.Merge(m, src)
+}
+func (m *QuerySupplyChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyChangesResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyChangesResponse) GetChanges() []SupplyChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QuerySupplyChangesResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1298,93 +1500,106 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryTotalSupplyAtRequest)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyAtRequest")
+	proto.RegisterType((*QueryTotalSupplyAtResponse)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyAtResponse")
+	proto.RegisterType((*QuerySupplyChangesRequest)(nil), "cosmos.bank.v1beta1.QuerySupplyChangesRequest")
+	proto.RegisterType((*QuerySupplyChangesResponse)(nil), "cosmos.bank.v1beta1.QuerySupplyChangesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb4, 0xaa, 0x9b, 0x3c, 0xa7, 0x88, 0x4e, 0x03, 0x4d, 0x36, 0xc4, 0x0e, 0x9b, 0x2a,
-	0x71, 0x42, 0xb2, 0xdb, 0x38, 0x55, 0x45, 0x4b, 0x88, 0x14, 0xb7, 0xa4, 0x07, 0x84, 0x5a, 0x1c,
-	0x7a, 0x81, 0x83, 0xb5, 0xf6, 0x0e, 0xc6, 0x8a, 0xbd, 0xe3, 0x7a, 0x36, 0x2d, 0x56, 0x15, 0x09,
-	0x21, 0x21, 0xf5, 0x06, 0x12, 0x3d, 0x55, 0x42, 0x8a, 0x90, 0x80, 0x0a, 0x24, 0xd4, 0x03, 0x47,
-	0x8e, 0x1c, 0x7a, 0xac, 0xe0, 0x00, 0xe2, 0x50, 0x50, 0x82, 0xd4, 0xfe, 0x0c, 0xe4, 0x99, 0x59,
-	0xef, 0xae, 0x3d, 0xde, 0x6c, 0x52, 0x83, 0x10, 0x97, 0xc4, 0x9e, 0x79, 0x6f, 0xde, 0xf7, 0xbe,
-	0xf7, 0xf6, 0xcd, 0xb7, 0x86, 0x4c, 0x99, 0xb2, 0x3a, 0x65, 0x66, 0xc9, 0x72, 0x36, 0xcd, 0x9b,
-	0x4b, 0x25, 0xe2, 0x5a, 0x4b, 0xe6, 0x8d, 0x2d, 0xd2, 0x6c, 0x19, 0x8d, 0x26, 0x75, 0x29, 0x3e,
-	0x25, 0x0c, 0x8c, 0xb6, 0x81, 0x21, 0x0d, 0xb4, 0xf9, 0x8e, 0x17, 0x23, 0xc2, 0xba, 0xe3, 0xdb,
-	0xb0, 0x2a, 0x55, 0xc7, 0x72, 0xab, 0xd4, 0x11, 0x07, 0x68, 0xa3, 0x15, 0x5a, 0xa1, 0xfc, 0xa3,
-	0xd9, 0xfe, 0x24, 0x57, 0x5f, 0xaa, 0x50, 0x5a, 0xa9, 0x11, 0xd3, 0x6a, 0x54, 0x4d, 0xcb, 0x71,
-	0xa8, 0xcb, 0x5d, 0x98, 0xdc, 0x4d, 0x07, 0xcf, 0xf7, 0x4e, 0x2e, 0xd3, 0xaa, 0xd3, 0xb3, 0x1f,
-	0x40, 0xcd, 0x11, 0x8a, 0xfd, 0x71, 0xb1, 0x5f, 0x14, 0x61, 0x65, 0x06, 0x62, 0x6b, 0x42, 0xba,
-	0x7a, 0xa8, 0x83, 0xc9, 0x6a, 0x27, 0xad, 0x7a, 0xd5, 0xa1, 0x26, 0xff, 0x2b, 0x96, 0xf4, 0x2a,
-	0x9c, 0x7a, 0xbb, 0x6d, 0x91, 0xb7, 0x6a, 0x96, 0x53, 0x26, 0x05, 0x72, 0x63, 0x8b, 0x30, 0x17,
-	0xe7, 0xe0, 0xb8, 0x65, 0xdb, 0x4d, 0xc2, 0xd8, 0x18, 0x9a, 0x42, 0xd9, 0xe1, 0xfc, 0xd8, 0xcf,
-	0x3f, 0x2c, 0x8e, 0xca, 0x48, 0x6b, 0x62, 0x67, 0xc3, 0x6d, 0x56, 0x9d, 0x4a, 0xc1, 0x33, 0xc4,
-	0xa3, 0x70, 0xcc, 0x26, 0x0e, 0xad, 0x8f, 0x1d, 0x69, 0x7b, 0x14, 0xc4, 0x97, 0x8b, 0x43, 0x77,
-	0x76, 0x32, 0x89, 0xa7, 0x3b, 0x99, 0x84, 0xfe, 0x26, 0x8c, 0x86, 0x43, 0xb1, 0x06, 0x75, 0x18,
-	0xc1, 0xcb, 0x70, 0xbc, 0x24, 0x96, 0x78, 0xac, 0x54, 0x6e, 0xdc, 0xe8, 0x14, 0x85, 0x11, 0xaf,
-	0x28, 0xc6, 0x25, 0x5a, 0x75, 0x0a, 0x9e, 0xa5, 0xfe, 0x13, 0x82, 0xd3, 0xfc, 0xb4, 0xb5, 0x5a,
-	0x4d, 0x1e, 0xc8, 0x9e, 0x05, 0xfc, 0x3a, 0x80, 0x5f, 0x5a, 0x9e, 0x41, 0x2a, 0x37, 0x13, 0xc2,
-	0x21, 0x88, 0xf4, 0xd0, 0x5c, 0xb3, 0x2a, 0x1e, 0x59, 0x85, 0x80, 0x27, 0x9e, 0x86, 0x13, 0x4d,
-	0xc2, 0x68, 0xed, 0x26, 0x29, 0x0a, 0x32, 0x8e, 0x4e, 0xa1, 0xec, 0x50, 0x61, 0x44, 0x2e, 0x5e,
-	0xee, 0xe2, 0x64, 0x17, 0xc1, 0x58, 0x6f, 0x1a, 0x92, 0x98, 0x6d, 0x18, 0x92, 0xe9, 0xb6, 0x13,
-	0x39, 0x1a, 0xc9, 0x4c, 0x7e, 0xfd, 0xe1, 0xe3, 0x4c, 0xe2, 0xdb, 0x3f, 0x32, 0xd9, 0x4a, 0xd5,
-	0xfd, 0x60, 0xab, 0x64, 0x94, 0x69, 0x5d, 0x76, 0x86, 0xfc, 0xb7, 0xc8, 0xec, 0x4d, 0xd3, 0x6d,
-	0x35, 0x08, 0xe3, 0x0e, 0xec, 0xde, 0x93, 0x07, 0xf3, 0x23, 0x35, 0x52, 0xb1, 0xca, 0xad, 0x62,
-	0xbb, 0xf7, 0xd8, 0xfd, 0x27, 0x0f, 0xe6, 0x51, 0xa1, 0x13, 0x12, 0x5f, 0x51, 0x50, 0x32, 0xbb,
-	0x2f, 0x25, 0x02, 0x7b, 0x90, 0x13, 0xfd, 0x2b, 0x04, 0x93, 0x3c, 0xc9, 0x8d, 0x06, 0x71, 0x6c,
-	0xab, 0x54, 0x23, 0xff, 0xa1, 0x8a, 0x05, 0x8a, 0xf1, 0x14, 0x41, 0xba, 0x1f, 0xce, 0xff, 0x59,
-	0x49, 0x5a, 0x30, 0xad, 0xcc, 0x34, 0xdf, 0xe2, 0x1d, 0xfa, 0x4f, 0x8e, 0x81, 0xf7, 0xe0, 0x4c,
-	0x74, 0xe8, 0x67, 0x19, 0x0b, 0x9b, 0x72, 0x2a, 0xbc, 0x43, 0x5d, 0xab, 0xb6, 0xb1, 0xd5, 0x68,
-	0xd4, 0x5a, 0x5e, 0x2e, 0xe1, 0x7e, 0x41, 0x03, 0xe8, 0x97, 0xc7, 0xde, 0xc3, 0x1b, 0x8a, 0x26,
-	0xe1, 0xb7, 0x20, 0xc9, 0xf8, 0xca, 0xbf, 0xd7, 0x27, 0x32, 0xe0, 0xe0, 0xba, 0x64, 0x41, 0x4e,
-	0x6c, 0x91, 0xda, 0xd5, 0xf7, 0x3d, 0x2a, 0x3b, 0x25, 0x46, 0x81, 0x12, 0xeb, 0xd7, 0xe1, 0x85,
-	0x2e, 0x6b, 0x49, 0xc5, 0x0a, 0x24, 0xad, 0x3a, 0xdd, 0x72, 0xdc, 0x7d, 0x0b, 0x99, 0x1f, 0x6e,
-	0x53, 0x21, 0xb3, 0x11, 0x3e, 0xfa, 0x28, 0x60, 0x7e, 0xec, 0x35, 0xab, 0x69, 0xd5, 0xbd, 0x89,
-	0xa1, 0x5f, 0x97, 0xf7, 0x96, 0xb7, 0x2a, 0x43, 0xad, 0x42, 0xb2, 0xc1, 0x57, 0x64, 0xa8, 0x09,
-	0x43, 0x71, 0xbf, 0x1b, 0xc2, 0x29, 0x14, 0x4c, 0x78, 0xe9, 0x36, 0x68, 0xfc, 0x58, 0xde, 0x8a,
-	0xec, 0x2d, 0xe2, 0x5a, 0xb6, 0xe5, 0x5a, 0x03, 0x6e, 0x21, 0xfd, 0x7b, 0x04, 0x13, 0xca, 0x30,
-	0x32, 0x8b, 0x75, 0x18, 0xae, 0xcb, 0x35, 0x6f, 0xcc, 0x4c, 0x2a, 0x13, 0xf1, 0x3c, 0x83, 0xa9,
-	0xf8, 0xae, 0x83, 0x6b, 0x84, 0x25, 0x18, 0xf7, 0xf1, 0x76, 0xb3, 0xa2, 0xee, 0x86, 0x52, 0x90,
-	0xc9, 0x9e, 0x0c, 0x2f, 0xc3, 0x90, 0x07, 0x53, 0xf2, 0x18, 0x3f, 0xc1, 0x8e, 0xa7, 0xbe, 0x0a,
-	0x33, 0xbd, 0x31, 0xf2, 0x2d, 0xd1, 0x85, 0x62, 0x2c, 0x45, 0x62, 0xa4, 0x30, 0xbb, 0xaf, 0xff,
-	0x40, 0x01, 0xdf, 0x92, 0xe3, 0x89, 0x07, 0xbc, 0x7a, 0xcb, 0x21, 0x4d, 0x16, 0x89, 0x70, 0x50,
-	0x97, 0x9c, 0xfe, 0x11, 0x02, 0xf0, 0x83, 0x1e, 0x6a, 0xae, 0xaf, 0xfa, 0xf3, 0xf8, 0xc8, 0x01,
-	0x1e, 0xe3, 0xce, 0x68, 0xfe, 0xc6, 0x9b, 0x96, 0xa1, 0xe4, 0x25, 0xbd, 0x79, 0x18, 0xe1, 0x09,
-	0x17, 0x29, 0x5f, 0x97, 0x4d, 0x9f, 0x51, 0x52, 0xec, 0xfb, 0x17, 0x52, 0xb6, 0x7f, 0xd6, 0x20,
-	0x2f, 0x47, 0x51, 0xa5, 0x0d, 0xe2, 0xd8, 0x6f, 0x38, 0xed, 0x2b, 0xca, 0xf6, 0xaa, 0xf4, 0x22,
-	0x24, 0x79, 0x48, 0x81, 0x70, 0xb8, 0x20, 0xbf, 0x75, 0xd5, 0xa9, 0x7c, 0xe8, 0x3a, 0xdd, 0xf7,
-	0x48, 0x0a, 0xc5, 0x96, 0x24, 0x5d, 0x82, 0x11, 0x46, 0x1c, 0xbb, 0x48, 0xc4, 0xba, 0x24, 0x69,
-	0x4a, 0x49, 0x52, 0xd0, 0x3f, 0xc5, 0xfc, 0x2f, 0x5d, 0x2c, 0x95, 0x0f, 0xcd, 0x52, 0xee, 0xd3,
-	0xe7, 0xe1, 0x18, 0x87, 0x8a, 0xbf, 0x40, 0x70, 0x5c, 0x5e, 0xe2, 0x38, 0xab, 0x44, 0xa3, 0x78,
-	0xc5, 0xd0, 0xe6, 0x62, 0x58, 0x8a, 0xb0, 0xfa, 0xeb, 0x77, 0xda, 0xad, 0xf4, 0xf1, 0x2f, 0x7f,
-	0x7d, 0x7e, 0x24, 0x87, 0xcf, 0x9a, 0xea, 0xb7, 0x23, 0x21, 0x91, 0xcc, 0xdb, 0xb2, 0x5f, 0xb7,
-	0xcd, 0x52, 0x4b, 0x48, 0x70, 0xbc, 0x83, 0x20, 0x15, 0xd0, 0xd7, 0x78, 0xa1, 0x7f, 0xe4, 0xde,
-	0xb7, 0x09, 0x6d, 0x31, 0xa6, 0xb5, 0xc4, 0x7a, 0xce, 0xc7, 0x3a, 0x87, 0x67, 0x63, 0x62, 0xc5,
-	0x3f, 0x22, 0x38, 0xd9, 0xa3, 0x3a, 0x71, 0xae, 0x7f, 0xe8, 0x7e, 0x52, 0x5a, 0x5b, 0x3e, 0x90,
-	0x8f, 0x04, 0xbd, 0xea, 0x83, 0x5e, 0xc6, 0x4b, 0x4a, 0xd0, 0xcc, 0x73, 0x2e, 0x2a, 0xe0, 0xff,
-	0x8a, 0xe0, 0x74, 0x1f, 0x3d, 0x87, 0x5f, 0x8d, 0x0f, 0x28, 0xac, 0x3e, 0xb5, 0x0b, 0x87, 0xf0,
-	0x94, 0x09, 0x5d, 0xf1, 0x13, 0x5a, 0xc1, 0x17, 0x0f, 0x9c, 0x90, 0xdf, 0x3b, 0x77, 0x11, 0xa4,
-	0x02, 0xf2, 0x2e, 0xaa, 0x77, 0x7a, 0x35, 0x67, 0x54, 0xef, 0x28, 0x34, 0xa3, 0x9e, 0xf5, 0x51,
-	0x4f, 0xe2, 0x09, 0x35, 0x6a, 0x01, 0xe3, 0x2e, 0x82, 0x21, 0x4f, 0x67, 0xe1, 0x88, 0x27, 0xa9,
-	0x4b, 0xb9, 0x69, 0xf3, 0x71, 0x4c, 0x25, 0x9a, 0x25, 0x1f, 0xcd, 0x0c, 0x3e, 0x13, 0x81, 0xc6,
-	0x67, 0xeb, 0x13, 0x04, 0x49, 0x21, 0xae, 0xf0, 0x6c, 0xff, 0x48, 0x21, 0x25, 0xa7, 0x65, 0xf7,
-	0x37, 0x8c, 0x4f, 0x8f, 0x90, 0x71, 0xf8, 0x3b, 0x04, 0x27, 0x42, 0x97, 0x3a, 0x36, 0xfa, 0x47,
-	0x51, 0x89, 0x1a, 0xcd, 0x8c, 0x6d, 0x2f, 0xc1, 0x5d, 0xf0, 0xc1, 0x19, 0x78, 0x41, 0x09, 0x4e,
-	0xdc, 0x15, 0x45, 0x4f, 0x0d, 0x98, 0xb7, 0xf9, 0xc2, 0x36, 0xfe, 0x1d, 0x81, 0xd6, 0x5f, 0x82,
-	0xe0, 0xd7, 0x62, 0x42, 0x51, 0x09, 0x1f, 0x6d, 0xe5, 0x70, 0xce, 0x32, 0xa9, 0x35, 0x3f, 0xa9,
-	0xf3, 0xf8, 0x5c, 0x9c, 0xa4, 0x8a, 0xa5, 0x56, 0x91, 0x5f, 0x20, 0x45, 0x26, 0xd0, 0x7f, 0x8d,
-	0xe0, 0xb9, 0xb0, 0xcc, 0xc5, 0xfb, 0x71, 0xdb, 0xad, 0xbb, 0xb5, 0xb3, 0xf1, 0x1d, 0xe2, 0xf7,
-	0x6e, 0x17, 0x70, 0xfc, 0x25, 0x82, 0x54, 0x40, 0x9a, 0x44, 0x3d, 0xe9, 0xbd, 0xf2, 0x2d, 0xea,
-	0x49, 0x57, 0xe8, 0x1d, 0xfd, 0xbc, 0x8f, 0xef, 0x15, 0x3c, 0xd7, 0x1f, 0x9f, 0xd4, 0x43, 0x9d,
-	0x56, 0xb9, 0x87, 0x20, 0x15, 0xb8, 0xda, 0xa3, 0x40, 0xf6, 0xaa, 0x97, 0x28, 0x90, 0x0a, 0xbd,
-	0xa1, 0x1b, 0x3e, 0xc8, 0x69, 0xfc, 0xb2, 0x7a, 0x00, 0x04, 0xf4, 0x48, 0x7e, 0xf9, 0xe1, 0x6e,
-	0x1a, 0x3d, 0xda, 0x4d, 0xa3, 0x3f, 0x77, 0xd3, 0xe8, 0xb3, 0xbd, 0x74, 0xe2, 0xd1, 0x5e, 0x3a,
-	0xf1, 0xdb, 0x5e, 0x3a, 0xf1, 0xae, 0xfc, 0xc1, 0x92, 0xd9, 0x9b, 0x46, 0x95, 0x9a, 0x1f, 0x8a,
-	0x33, 0xf8, 0x0b, 0x6d, 0x29, 0xc9, 0x7f, 0x87, 0x5c, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xcd,
-	0x75, 0xea, 0xc7, 0xaa, 0x15, 0x00, 0x00,
+	// 1436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdd, 0x6f, 0x14, 0x55,
+	0x14, 0xef, 0x2d, 0xd2, 0x8f, 0xb3, 0xc5, 0x84, 0x4b, 0x95, 0x76, 0x2a, 0xbb, 0x30, 0x10, 0x5a,
+	0x2a, 0xdd, 0xa1, 0x2d, 0x21, 0x82, 0xd8, 0xa4, 0x0b, 0x82, 0xc6, 0x18, 0x70, 0x2b, 0x2f, 0xfa,
+	0x30, 0x99, 0xdd, 0xb9, 0x6e, 0x27, 0xdd, 0x9d, 0x59, 0xf6, 0x4e, 0x81, 0x0d, 0x69, 0x62, 0x4c,
+	0x48, 0x78, 0x34, 0x91, 0xc4, 0x84, 0xc4, 0x84, 0x98, 0xa8, 0x44, 0x13, 0xc3, 0x83, 0x8f, 0x3e,
+	0xfa, 0xc0, 0x23, 0xd1, 0x07, 0x8d, 0x0f, 0x68, 0x5a, 0x13, 0x48, 0xfc, 0x27, 0xcc, 0xde, 0x7b,
+	0x66, 0x67, 0x66, 0xf7, 0xee, 0xee, 0xb4, 0xac, 0x1f, 0xf1, 0x05, 0x3a, 0xe7, 0x9e, 0x73, 0xcf,
+	0xef, 0x77, 0xce, 0xb9, 0xe7, 0x9e, 0xbb, 0x90, 0x29, 0x7a, 0xbc, 0xe2, 0x71, 0xa3, 0x60, 0xb9,
+	0x6b, 0xc6, 0xb5, 0xf9, 0x02, 0xf3, 0xad, 0x79, 0xe3, 0xea, 0x3a, 0xab, 0xd5, 0xb3, 0xd5, 0x9a,
+	0xe7, 0x7b, 0x74, 0x9f, 0x54, 0xc8, 0x36, 0x14, 0xb2, 0xa8, 0xa0, 0xcd, 0x36, 0xad, 0x38, 0x93,
+	0xda, 0x4d, 0xdb, 0xaa, 0x55, 0x72, 0x5c, 0xcb, 0x77, 0x3c, 0x57, 0x6e, 0xa0, 0x8d, 0x97, 0xbc,
+	0x92, 0x27, 0xfe, 0x34, 0x1a, 0x7f, 0xa1, 0xf4, 0xa5, 0x92, 0xe7, 0x95, 0xca, 0xcc, 0xb0, 0xaa,
+	0x8e, 0x61, 0xb9, 0xae, 0xe7, 0x0b, 0x13, 0x8e, 0xab, 0xe9, 0xe8, 0xfe, 0xc1, 0xce, 0x45, 0xcf,
+	0x71, 0xdb, 0xd6, 0x23, 0xa8, 0x05, 0x42, 0xb9, 0x3e, 0x29, 0xd7, 0x4d, 0xe9, 0x16, 0x19, 0xc8,
+	0xa5, 0x29, 0x34, 0x0d, 0x50, 0x47, 0xc9, 0x6a, 0x7b, 0xad, 0x8a, 0xe3, 0x7a, 0x86, 0xf8, 0x57,
+	0x8a, 0x74, 0x07, 0xf6, 0xbd, 0xd3, 0xd0, 0xc8, 0x59, 0x65, 0xcb, 0x2d, 0xb2, 0x3c, 0xbb, 0xba,
+	0xce, 0xb8, 0x4f, 0x17, 0x60, 0xd8, 0xb2, 0xed, 0x1a, 0xe3, 0x7c, 0x82, 0x1c, 0x24, 0x33, 0xa3,
+	0xb9, 0x89, 0x1f, 0xbf, 0x9b, 0x1b, 0x47, 0x4f, 0xcb, 0x72, 0x65, 0xc5, 0xaf, 0x39, 0x6e, 0x29,
+	0x1f, 0x28, 0xd2, 0x71, 0xd8, 0x6d, 0x33, 0xd7, 0xab, 0x4c, 0x0c, 0x36, 0x2c, 0xf2, 0xf2, 0xe3,
+	0xcc, 0xc8, 0xed, 0x7b, 0x99, 0x81, 0xa7, 0xf7, 0x32, 0x03, 0xfa, 0x5b, 0x30, 0x1e, 0x77, 0xc5,
+	0xab, 0x9e, 0xcb, 0x19, 0x5d, 0x84, 0xe1, 0x82, 0x14, 0x09, 0x5f, 0xa9, 0x85, 0xc9, 0x6c, 0x33,
+	0x29, 0x9c, 0x05, 0x49, 0xc9, 0x9e, 0xf3, 0x1c, 0x37, 0x1f, 0x68, 0xea, 0x3f, 0x10, 0xd8, 0x2f,
+	0x76, 0x5b, 0x2e, 0x97, 0x71, 0x43, 0xfe, 0x2c, 0xe0, 0x2f, 0x00, 0x84, 0xa9, 0x15, 0x0c, 0x52,
+	0x0b, 0x47, 0x63, 0x38, 0x64, 0x20, 0x03, 0x34, 0x97, 0xad, 0x52, 0x10, 0xac, 0x7c, 0xc4, 0x92,
+	0x1e, 0x86, 0x3d, 0x35, 0xc6, 0xbd, 0xf2, 0x35, 0x66, 0xca, 0x60, 0xec, 0x3a, 0x48, 0x66, 0x46,
+	0xf2, 0x63, 0x28, 0x3c, 0xdf, 0x12, 0x93, 0x4d, 0x02, 0x13, 0xed, 0x34, 0x30, 0x30, 0x1b, 0x30,
+	0x82, 0x74, 0x1b, 0x44, 0x76, 0x75, 0x8d, 0x4c, 0xee, 0xc2, 0xc3, 0xc7, 0x99, 0x81, 0xaf, 0x7f,
+	0xcb, 0xcc, 0x94, 0x1c, 0x7f, 0x75, 0xbd, 0x90, 0x2d, 0x7a, 0x15, 0xac, 0x0c, 0xfc, 0x6f, 0x8e,
+	0xdb, 0x6b, 0x86, 0x5f, 0xaf, 0x32, 0x2e, 0x0c, 0xf8, 0xdd, 0x27, 0x0f, 0x66, 0xc7, 0xca, 0xac,
+	0x64, 0x15, 0xeb, 0x66, 0xa3, 0xf6, 0xf8, 0xfd, 0x27, 0x0f, 0x66, 0x49, 0xbe, 0xe9, 0x92, 0x5e,
+	0x54, 0x84, 0x64, 0xba, 0x67, 0x48, 0x24, 0xf6, 0x68, 0x4c, 0xf4, 0x2f, 0x08, 0x1c, 0x10, 0x24,
+	0x57, 0xaa, 0xcc, 0xb5, 0xad, 0x42, 0x99, 0xfd, 0x87, 0x32, 0x16, 0x49, 0xc6, 0x53, 0x02, 0xe9,
+	0x4e, 0x38, 0xff, 0x67, 0x29, 0xa9, 0xc3, 0x61, 0x25, 0xd3, 0x5c, 0x5d, 0x54, 0xe8, 0xdf, 0xd9,
+	0x06, 0xde, 0x87, 0x23, 0xdd, 0x5d, 0x3f, 0x4b, 0x5b, 0x58, 0xc3, 0xae, 0xf0, 0xae, 0xe7, 0x5b,
+	0xe5, 0x95, 0xf5, 0x6a, 0xb5, 0x5c, 0x0f, 0xb8, 0xc4, 0xeb, 0x85, 0xf4, 0xa1, 0x5e, 0x1e, 0x07,
+	0x87, 0x37, 0xe6, 0x0d, 0xe1, 0xd7, 0x61, 0x88, 0x0b, 0xc9, 0x3f, 0x57, 0x27, 0xe8, 0xb0, 0x7f,
+	0x55, 0x72, 0x1c, 0x3b, 0xb6, 0xa4, 0x76, 0xe9, 0x83, 0x20, 0x94, 0xcd, 0x14, 0x93, 0x48, 0x8a,
+	0xf5, 0x2b, 0xf0, 0x42, 0x8b, 0x36, 0x86, 0xe2, 0x2c, 0x0c, 0x59, 0x15, 0x6f, 0xdd, 0xf5, 0x7b,
+	0x26, 0x32, 0x37, 0xda, 0x08, 0x05, 0xb2, 0x91, 0x36, 0xfa, 0x38, 0x50, 0xb1, 0xed, 0x65, 0xab,
+	0x66, 0x55, 0x82, 0x8e, 0xa1, 0x5f, 0x81, 0x7d, 0x31, 0x29, 0xba, 0x5a, 0x82, 0xa1, 0xaa, 0x90,
+	0xa0, 0xab, 0xa9, 0xac, 0xe2, 0x7e, 0xcf, 0x4a, 0xa3, 0x98, 0x33, 0x69, 0xa5, 0xdb, 0xa0, 0x89,
+	0x6d, 0x45, 0x29, 0xf2, 0xb7, 0x99, 0x6f, 0xd9, 0x96, 0x6f, 0xf5, 0xb9, 0x84, 0xf4, 0x6f, 0x09,
+	0x4c, 0x29, 0xdd, 0x20, 0x8b, 0x0b, 0x30, 0x5a, 0x41, 0x59, 0xd0, 0x66, 0x0e, 0x28, 0x89, 0x04,
+	0x96, 0x51, 0x2a, 0xa1, 0x69, 0xff, 0x0a, 0x61, 0x1e, 0x26, 0x43, 0xbc, 0xad, 0x51, 0x51, 0x57,
+	0x43, 0x01, 0x34, 0x95, 0x09, 0x32, 0x3c, 0x0f, 0x23, 0x01, 0x4c, 0x8c, 0x63, 0x72, 0x82, 0x4d,
+	0x4b, 0x7d, 0x09, 0x8e, 0xb6, 0xfb, 0xc8, 0xd5, 0x65, 0x15, 0xca, 0xb6, 0xd4, 0x15, 0xa3, 0x07,
+	0xd3, 0x3d, 0xed, 0xfb, 0x0a, 0xf8, 0x3a, 0xec, 0x0f, 0x1d, 0x5e, 0xba, 0xee, 0xb2, 0x1a, 0xef,
+	0x8a, 0xb0, 0x5f, 0x97, 0x9c, 0xfe, 0x21, 0x01, 0x08, 0x9d, 0xee, 0xa8, 0xaf, 0x2f, 0x85, 0xfd,
+	0x78, 0x70, 0x1b, 0xc7, 0xb8, 0xd9, 0x9a, 0xbf, 0x0a, 0xba, 0x65, 0x8c, 0x3c, 0x86, 0x37, 0x07,
+	0x63, 0x82, 0xb0, 0xe9, 0x09, 0x39, 0x16, 0x7d, 0x46, 0x19, 0xe2, 0xd0, 0x3e, 0x9f, 0xb2, 0xc3,
+	0xbd, 0xfa, 0x79, 0x39, 0xca, 0x2c, 0xad, 0x30, 0xd7, 0x7e, 0xdd, 0x6d, 0x5c, 0x51, 0x76, 0x90,
+	0xa5, 0x17, 0x61, 0x48, 0xb8, 0x94, 0x08, 0x47, 0xf3, 0xf8, 0xd5, 0x92, 0xa7, 0xe2, 0x8e, 0xf3,
+	0x74, 0x3f, 0x08, 0x52, 0xcc, 0x37, 0x06, 0xe9, 0x1c, 0x8c, 0x71, 0xe6, 0xda, 0x26, 0x93, 0x72,
+	0x0c, 0xd2, 0x41, 0x65, 0x90, 0xa2, 0xf6, 0x29, 0x1e, 0x7e, 0xd0, 0x8b, 0x0a, 0xa4, 0x3b, 0x8a,
+	0xd2, 0x22, 0x4c, 0xb6, 0x5e, 0x7e, 0xcb, 0x7e, 0x24, 0x4e, 0xab, 0xcc, 0x29, 0xad, 0xca, 0x96,
+	0xbf, 0x2b, 0x8f, 0x5f, 0xfa, 0xa7, 0x04, 0x34, 0x95, 0xd5, 0xbf, 0x7e, 0x69, 0xea, 0x6f, 0x22,
+	0x1d, 0x89, 0xe9, 0xdc, 0xaa, 0xe5, 0x96, 0x18, 0xef, 0x41, 0xa7, 0x71, 0x68, 0xcb, 0x4e, 0xc5,
+	0xf1, 0x45, 0xb5, 0x3d, 0x97, 0x97, 0x1f, 0xfa, 0xad, 0x80, 0x64, 0xcb, 0x5e, 0xcd, 0xee, 0x3e,
+	0x5c, 0x94, 0x22, 0x64, 0x79, 0x48, 0x9d, 0xc1, 0x88, 0x71, 0xec, 0x40, 0xa1, 0x31, 0xcd, 0x40,
+	0xca, 0x65, 0x37, 0x7c, 0x13, 0x91, 0x0d, 0x0a, 0x64, 0xd0, 0x10, 0xbd, 0x21, 0x24, 0x0b, 0x7f,
+	0x52, 0xd8, 0x2d, 0x70, 0xd0, 0xcf, 0x08, 0x0c, 0xe3, 0x98, 0x45, 0x67, 0x94, 0xde, 0x14, 0x8f,
+	0x40, 0xed, 0x58, 0x02, 0x4d, 0xc9, 0x49, 0x7f, 0xed, 0x76, 0x03, 0xdb, 0x47, 0x3f, 0xfd, 0xf1,
+	0xc9, 0xe0, 0x02, 0x3d, 0x61, 0xa8, 0xdf, 0xaf, 0xc2, 0x84, 0x1b, 0x37, 0xb1, 0xa3, 0x6c, 0x18,
+	0x85, 0xba, 0x7c, 0x24, 0xd1, 0x7b, 0x04, 0x52, 0x91, 0x17, 0x10, 0x3d, 0xde, 0xd9, 0x73, 0xfb,
+	0x7b, 0x4f, 0x9b, 0x4b, 0xa8, 0x8d, 0x58, 0x4f, 0x86, 0x58, 0x8f, 0xd1, 0xe9, 0x84, 0x58, 0xe9,
+	0xf7, 0x04, 0xf6, 0xb6, 0xbd, 0x0b, 0xe8, 0x42, 0x67, 0xd7, 0x9d, 0x1e, 0x3b, 0xda, 0xe2, 0xb6,
+	0x6c, 0x10, 0xf4, 0x52, 0x08, 0x7a, 0x91, 0xce, 0x2b, 0x41, 0xf3, 0xc0, 0xd8, 0x54, 0xc0, 0xff,
+	0x99, 0xc0, 0xfe, 0x0e, 0x13, 0x37, 0x7d, 0x25, 0x39, 0xa0, 0xf8, 0xfb, 0x40, 0x3b, 0xbd, 0x03,
+	0x4b, 0x24, 0x74, 0x31, 0x24, 0x74, 0x96, 0x9e, 0xd9, 0x36, 0xa1, 0xb0, 0x76, 0xee, 0x10, 0x48,
+	0x45, 0xba, 0x49, 0xb7, 0xda, 0x69, 0x7f, 0x15, 0x68, 0x73, 0x09, 0xb5, 0x11, 0xf5, 0x4c, 0x88,
+	0xfa, 0x00, 0x9d, 0x52, 0xa3, 0x96, 0x30, 0xee, 0x10, 0x18, 0x09, 0x26, 0x61, 0xda, 0xe5, 0x24,
+	0xb5, 0xcc, 0xd6, 0xda, 0x6c, 0x12, 0x55, 0x44, 0x33, 0x1f, 0xa2, 0x39, 0x4a, 0x8f, 0x74, 0x41,
+	0x13, 0x46, 0xeb, 0x16, 0x81, 0x21, 0x39, 0xfe, 0xd2, 0xe9, 0xce, 0x9e, 0x62, 0xb3, 0xb6, 0x36,
+	0xd3, 0x5b, 0x31, 0x79, 0x78, 0xe4, 0xa0, 0x4d, 0xbf, 0x21, 0xb0, 0x27, 0x36, 0x76, 0xd1, 0x6c,
+	0x67, 0x2f, 0xaa, 0xb1, 0x53, 0x33, 0x12, 0xeb, 0x23, 0xb8, 0xd3, 0x21, 0xb8, 0x2c, 0x3d, 0xae,
+	0x04, 0x27, 0x6f, 0x73, 0x33, 0x98, 0xd7, 0x8c, 0x9b, 0x42, 0xb0, 0x41, 0x7f, 0x25, 0xa0, 0x75,
+	0x1e, 0x12, 0xe9, 0xab, 0x09, 0xa1, 0xa8, 0x46, 0x53, 0xed, 0xec, 0xce, 0x8c, 0x91, 0xd4, 0x72,
+	0x48, 0xea, 0x14, 0x3d, 0x99, 0x84, 0x94, 0x59, 0xa8, 0x9b, 0xe2, 0x8a, 0x37, 0xb9, 0x44, 0xff,
+	0x25, 0x81, 0xe7, 0xe3, 0x0f, 0x11, 0xda, 0x2b, 0xb6, 0xad, 0x2f, 0x23, 0xed, 0x44, 0x72, 0x83,
+	0xe4, 0xb5, 0xdb, 0x02, 0x9c, 0x7e, 0x4e, 0x20, 0x15, 0x19, 0x1e, 0xbb, 0x9d, 0xf4, 0xf6, 0x01,
+	0x5b, 0x9b, 0x4b, 0xa8, 0x8d, 0xf8, 0x4e, 0x85, 0xf8, 0x5e, 0xa6, 0xc7, 0x3a, 0xe3, 0xc3, 0x89,
+	0xb5, 0x59, 0x2a, 0x77, 0x09, 0xa4, 0x22, 0xc3, 0x57, 0x37, 0x90, 0xed, 0xf3, 0xa5, 0x36, 0x97,
+	0x50, 0x1b, 0x41, 0x66, 0x43, 0x90, 0x87, 0xe9, 0x21, 0x75, 0x03, 0x88, 0x4c, 0x8c, 0xe2, 0xd4,
+	0xc5, 0x26, 0xaf, 0x6e, 0xa7, 0x4e, 0x35, 0xd8, 0x69, 0x46, 0x62, 0xfd, 0xe4, 0xa7, 0x4e, 0xf6,
+	0x28, 0x73, 0xd5, 0xe1, 0xbe, 0x57, 0xab, 0x1b, 0x37, 0xe5, 0x40, 0xb3, 0x21, 0xd0, 0xc6, 0x46,
+	0xa8, 0x6e, 0x68, 0x55, 0x73, 0x9b, 0x66, 0x24, 0xd6, 0xdf, 0x36, 0x5a, 0x9c, 0xc2, 0x9a, 0x68,
+	0x73, 0x8b, 0x0f, 0x37, 0xd3, 0xe4, 0xd1, 0x66, 0x9a, 0xfc, 0xbe, 0x99, 0x26, 0x1f, 0x6f, 0xa5,
+	0x07, 0x1e, 0x6d, 0xa5, 0x07, 0x7e, 0xd9, 0x4a, 0x0f, 0xbc, 0x87, 0x3f, 0xd7, 0x73, 0x7b, 0x2d,
+	0xeb, 0x78, 0xc6, 0x0d, 0xb9, 0x9d, 0x98, 0x4c, 0x0b, 0x43, 0xe2, 0x57, 0xf8, 0xc5, 0xbf, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0xdb, 0xaf, 0x56, 0xad, 0xa8, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1457,6 +1672,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// TotalSupplyAt queries the supply of all coins after the execution of the block at a height, if the supply
+	// history is tracked since then.
+	TotalSupplyAt(ctx context.Context, in *QueryTotalSupplyAtRequest, opts ...grpc.CallOption) (*QueryTotalSupplyAtResponse, error)
+	// SupplyChanges queries the changes of the supply of all coins from a height, if the supply history is tracked
+	// since then.
+	SupplyChanges(ctx context.Context, in *QuerySupplyChangesRequest, opts ...grpc.CallOption) (*QuerySupplyChangesResponse, error)
 }
 
 type queryClient struct {
@@ -1575,6 +1796,24 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) TotalSupplyAt(ctx context.Context, in *QueryTotalSupplyAtRequest, opts ...grpc.CallOption) (*QueryTotalSupplyAtResponse, error) {
+	out := new(QueryTotalSupplyAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/TotalSupplyAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyChanges(ctx context.Context, in *QuerySupplyChangesRequest, opts ...grpc.CallOption) (*QuerySupplyChangesResponse, error) {
+	out := new(QuerySupplyChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1635,6 +1874,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// TotalSupplyAt queries the supply of all coins after the execution of the block at a height, if the supply
+	// history is tracked since then.
+	TotalSupplyAt(context.Context, *QueryTotalSupplyAtRequest) (*QueryTotalSupplyAtResponse, error)
+	// SupplyChanges queries the changes of the supply of all coins from a height, if the supply history is tracked
+	// since then.
+	SupplyChanges(context.Context, *QuerySupplyChangesRequest) (*QuerySupplyChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1677,6 +1922,12 @@ func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) TotalSupplyAt(ctx context.Context, req *QueryTotalSupplyAtRequest) (*QueryTotalSupplyAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSupplyAt not implemented")
+}
+func (*UnimplementedQueryServer) SupplyChanges(ctx context.Context, req *QuerySupplyChangesRequest) (*QuerySupplyChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1898,6 +2149,42 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalSupplyAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSupplyAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSupplyAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/TotalSupplyAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSupplyAt(ctx, req.(*QueryTotalSupplyAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SupplyChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyChanges(ctx, req.(*QuerySupplyChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1950,6 +2237,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "TotalSupplyAt",
+			Handler:    _Query_TotalSupplyAt_Handler,
+		},
+		{
+			MethodName: "SupplyChanges",
+			Handler:    _Query_SupplyChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2932,84 +3227,224 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTotalSupplyAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryTotalSupplyAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalSupplyAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ResolveDenom {
-		n += 2
+func (m *QueryTotalSupplyAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalSupplyAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResolveDenom {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QuerySpendableBalancesRequest) Size() (n int) {
@@ -3323,6 +3758,66 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTotalSupplyAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupplyChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QuerySupplyChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5856,6 +6351,350 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTotalSupplyAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, SupplyChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
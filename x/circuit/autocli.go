@@ -30,6 +30,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod:      "MsgRouteHistory",
+					Use:            "msg-route-history [msg_type_url]",
+					Short:          "Query the changes of the availability of a message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url"}},
+				},
+				{
+					RpcMethod:      "MsgRouteStatus",
+					Use:            "msg-route-status [msg_type_url] [height]",
+					Short:          "Query the last change of the availability of a message type at a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url"}, {ProtoField: "height", Optional: true}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +79,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetGuardians",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "VotePause",
+					Use:       "vote-pause --from [guardian]",
+					Short:     "Vote as a guardian to pause the execution of all messages",
+				},
+				{
+					RpcMethod: "VoteUnpause",
+					Use:       "vote-unpause --from [guardian]",
+					Short:     "Vote as a guardian to unpause the execution of all messages",
+				},
 			},
 		},
 	}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// circuitMsgURLPrefix prefixes the URLs of the messages of the circuit module,
+// which stay allowed while the chain is paused so that it can be unpaused.
+const circuitMsgURLPrefix = "/cosmos.circuit."
+
+// SetGuardians replaces the guardian set, the accounts which pause the chain
+// once params.Threshold of them voted to, for at most params.PauseDuration.
+// Pending pause and unpause votes are discarded. It is meant to be called by
+// the module authority.
+func (k *Keeper) SetGuardians(ctx context.Context, guardians []string, params types.GuardianParams) error {
+	if err := params.Validate(len(guardians)); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addrs := make([][]byte, 0, len(guardians))
+	for _, guardian := range guardians {
+		addr, err := k.addressCodec.StringToBytes(guardian)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	for _, set := range []collections.KeySet[[]byte]{k.Guardians, k.PauseVotes, k.UnpauseVotes} {
+		if err := set.Clear(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range addrs {
+		if err := k.Guardians.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.GuardianThreshold.Set(ctx, params.Threshold); err != nil {
+		return err
+	}
+
+	return k.PauseDuration.Set(ctx, int64(params.PauseDuration))
+}
+
+// GetGuardianParams returns the guardian params.
+func (k *Keeper) GetGuardianParams(ctx context.Context) (types.GuardianParams, error) {
+	threshold, err := k.GuardianThreshold.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.GuardianParams{}, err
+	}
+
+	duration, err := k.PauseDuration.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.GuardianParams{}, err
+	}
+
+	return types.GuardianParams{Threshold: threshold, PauseDuration: time.Duration(duration)}, nil
+}
+
+// IsPaused returns whether the chain is paused by the guardians: the execution
+// of all messages but those of the circuit module is disabled, while queries
+// are still served.
+func (k *Keeper) IsPaused(ctx context.Context) (bool, error) {
+	until, err := k.PausedUntil.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo().Time.UnixNano() < until, nil
+}
+
+// VotePause records the vote of guardian to pause the chain, and pauses it
+// once the threshold of guardians voted. It returns whether the chain is
+// paused.
+func (k *Keeper) VotePause(ctx context.Context, guardian string) (bool, error) {
+	paused, err := k.IsPaused(ctx)
+	if err != nil || paused {
+		return paused, err
+	}
+
+	reached, err := k.vote(ctx, k.PauseVotes, guardian)
+	if err != nil || !reached {
+		return false, err
+	}
+
+	params, err := k.GetGuardianParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	until := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time.Add(params.PauseDuration)
+	if err := k.PausedUntil.Set(ctx, until.UnixNano()); err != nil {
+		return false, err
+	}
+
+	if err := k.PauseVotes.Clear(ctx, nil); err != nil {
+		return false, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"chain_paused",
+			sdk.NewAttribute("paused_until", until.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return true, nil
+}
+
+// VoteUnpause records the vote of guardian to unpause the chain, and unpauses
+// it once the threshold of guardians voted. It returns whether the chain was
+// unpaused.
+func (k *Keeper) VoteUnpause(ctx context.Context, guardian string) (bool, error) {
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !paused {
+		return false, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain is not paused")
+	}
+
+	reached, err := k.vote(ctx, k.UnpauseVotes, guardian)
+	if err != nil || !reached {
+		return false, err
+	}
+
+	if err := k.unpause(ctx); err != nil {
+		return false, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("chain_unpaused"))
+
+	return true, nil
+}
+
+// ExpirePause unpauses the chain once the pause expired. It is called at the
+// beginning of each block.
+func (k *Keeper) ExpirePause(ctx context.Context) error {
+	has, err := k.PausedUntil.Has(ctx)
+	if err != nil || !has {
+		return err
+	}
+
+	paused, err := k.IsPaused(ctx)
+	if err != nil || paused {
+		return err
+	}
+
+	if err := k.unpause(ctx); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("chain_pause_expired"))
+
+	return nil
+}
+
+// vote records the vote of guardian in votes and returns whether the threshold
+// of guardians voted.
+func (k *Keeper) vote(ctx context.Context, votes collections.KeySet[[]byte], guardian string) (bool, error) {
+	addr, err := k.addressCodec.StringToBytes(guardian)
+	if err != nil {
+		return false, err
+	}
+
+	isGuardian, err := k.Guardians.Has(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	if !isGuardian {
+		return false, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a guardian", guardian)
+	}
+
+	if err := votes.Set(ctx, addr); err != nil {
+		return false, err
+	}
+
+	params, err := k.GetGuardianParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var count uint64
+	err = votes.Walk(ctx, nil, func(_ []byte) (bool, error) {
+		count++
+		return count >= params.Threshold, nil
+	})
+
+	return count >= params.Threshold, err
+}
+
+func (k *Keeper) unpause(ctx context.Context) error {
+	if err := k.PausedUntil.Remove(ctx); err != nil {
+		return err
+	}
+
+	return k.UnpauseVotes.Clear(ctx, nil)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/circuit"
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestGuardianPause(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(circuit.AppModuleBasic{})
+	storeKey := storetypes.NewKVStoreKey("test")
+	ac := addresscodec.NewBech32Codec("cosmos")
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(storeKey), authtypes.NewModuleAddress("gov").String(), ac)
+
+	now := time.Unix(1700000000, 0)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithHeaderInfo(header.Info{Height: 1, Time: now})
+
+	var guardians []string
+	for _, name := range []string{"g1", "g2", "g3"} {
+		addr, err := ac.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		guardians = append(guardians, addr)
+	}
+	outsider, err := ac.BytesToString(sdk.AccAddress("outsider"))
+	require.NoError(t, err)
+
+	require.Error(t, k.SetGuardians(ctx, guardians, types.GuardianParams{Threshold: 4, PauseDuration: time.Hour}))
+	require.NoError(t, k.SetGuardians(ctx, guardians, types.GuardianParams{Threshold: 2, PauseDuration: time.Hour}))
+
+	// only guardians vote, and the threshold pauses the chain
+	_, err = k.VotePause(ctx, outsider)
+	require.Error(t, err)
+	paused, err := k.VotePause(ctx, guardians[0])
+	require.NoError(t, err)
+	require.False(t, paused)
+	paused, err = k.VotePause(ctx, guardians[1])
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	// all msgs but the circuit ones are disallowed
+	allowed, err := k.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsAllowed(ctx, "/cosmos.circuit.v1.MsgResetCircuitBreaker")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the guardians unpause the chain
+	_, err = k.VoteUnpause(ctx, guardians[2])
+	require.NoError(t, err)
+	unpaused, err := k.VoteUnpause(ctx, guardians[0])
+	require.NoError(t, err)
+	require.True(t, unpaused)
+	allowed, err = k.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// a pause expires after the pause duration
+	_, err = k.VotePause(ctx, guardians[0])
+	require.NoError(t, err)
+	_, err = k.VotePause(ctx, guardians[2])
+	require.NoError(t, err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: now.Add(59 * time.Minute)})
+	require.NoError(t, k.ExpirePause(ctx))
+	paused, err = k.IsPaused(ctx)
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: now.Add(time.Hour)})
+	paused, err = k.IsPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused)
+	require.NoError(t, k.ExpirePause(ctx))
+	has, err := k.PausedUntil.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	context "context"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -25,6 +26,28 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// MsgRoutes contains the message URLs routed by the application when last
+	// checked
+	MsgRoutes collections.KeySet[string]
+	// MsgRouteHistory contains the changes of the availability of each message
+	// URL by height
+	MsgRouteHistory collections.Map[collections.Pair[string, int64], uint64]
+	// Guardians contains the accounts which can pause the chain
+	Guardians collections.KeySet[[]byte]
+	// GuardianThreshold is the number of guardian votes pausing or unpausing
+	// the chain
+	GuardianThreshold collections.Item[uint64]
+	// PauseDuration is the duration in nanoseconds after which a pause expires
+	PauseDuration collections.Item[int64]
+	// PauseVotes contains the guardians which voted to pause the chain
+	PauseVotes collections.KeySet[[]byte]
+	// UnpauseVotes contains the guardians which voted to unpause the chain
+	UnpauseVotes collections.KeySet[[]byte]
+	// PausedUntil is the time in unix nanoseconds at which the current pause
+	// expires, if the chain is paused
+	PausedUntil collections.Item[int64]
+
+	msgRoutes *msgRoutes
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +77,56 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		MsgRoutes: collections.NewKeySet(
+			sb,
+			types.MsgRoutesPrefix,
+			"msg_routes",
+			collections.StringKey,
+		),
+		MsgRouteHistory: collections.NewMap(
+			sb,
+			types.MsgRouteHistoryPrefix,
+			"msg_route_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			collections.Uint64Value,
+		),
+		Guardians: collections.NewKeySet(
+			sb,
+			types.GuardiansPrefix,
+			"guardians",
+			collections.BytesKey,
+		),
+		GuardianThreshold: collections.NewItem(
+			sb,
+			types.GuardianThresholdPrefix,
+			"guardian_threshold",
+			collections.Uint64Value,
+		),
+		PauseDuration: collections.NewItem(
+			sb,
+			types.PauseDurationPrefix,
+			"pause_duration",
+			collections.Int64Value,
+		),
+		PauseVotes: collections.NewKeySet(
+			sb,
+			types.PauseVotesPrefix,
+			"pause_votes",
+			collections.BytesKey,
+		),
+		UnpauseVotes: collections.NewKeySet(
+			sb,
+			types.UnpauseVotesPrefix,
+			"unpause_votes",
+			collections.BytesKey,
+		),
+		PausedUntil: collections.NewItem(
+			sb,
+			types.PausedUntilPrefix,
+			"paused_until",
+			collections.Int64Value,
+		),
+		msgRoutes: &msgRoutes{},
 	}
 
 	schema, err := sb.Build()
@@ -70,7 +143,15 @@ func (k *Keeper) GetAuthority() []byte {
 }
 
 // IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// While the chain is paused by the guardians, only the messages of the circuit module are allowed.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
+	if !strings.HasPrefix(msgURL, circuitMsgURLPrefix) {
+		paused, err := k.IsPaused(ctx)
+		if err != nil || paused {
+			return false, err
+		}
+	}
+
 	has, err := k.DisableList.Has(ctx, msgURL)
 	return !has, err
 }
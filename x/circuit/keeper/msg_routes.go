@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgRoutes holds the lister of the message routes of the application, which
+// are compared to the recorded ones once per process, as they only change with
+// the application binary.
+type msgRoutes struct {
+	lister  types.MsgRouteLister
+	checked bool
+}
+
+// SetMsgRouteLister sets the lister of the message routes of the application,
+// whose additions and removals are recorded in the message route history at the
+// first block executed by the process.
+func (k *Keeper) SetMsgRouteLister(lister types.MsgRouteLister) {
+	k.msgRoutes.lister = lister
+}
+
+// RecordMsgRoutes records the message routes added or removed since they were
+// last checked, once per process. Message routes registered before the history
+// was tracked are recorded as added at the first check.
+func (k *Keeper) RecordMsgRoutes(ctx context.Context) error {
+	if k.msgRoutes.lister == nil || k.msgRoutes.checked {
+		return nil
+	}
+	k.msgRoutes.checked = true
+
+	current := make(map[string]bool)
+	for _, msgURL := range k.msgRoutes.lister.MsgTypeURLs() {
+		current[msgURL] = true
+	}
+
+	var removed []string
+	err := k.MsgRoutes.Walk(ctx, nil, func(msgURL string) (stop bool, err error) {
+		if current[msgURL] {
+			delete(current, msgURL)
+		} else {
+			removed = append(removed, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, msgURL := range removed {
+		if err := k.MsgRoutes.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+		if err := k.recordMsgRouteChange(ctx, msgURL, types.MsgRouteRemoved); err != nil {
+			return err
+		}
+	}
+
+	// iterate over the lister again for a deterministic order
+	for _, msgURL := range k.msgRoutes.lister.MsgTypeURLs() {
+		if !current[msgURL] {
+			continue
+		}
+		if err := k.MsgRoutes.Set(ctx, msgURL); err != nil {
+			return err
+		}
+		if err := k.recordMsgRouteChange(ctx, msgURL, types.MsgRouteAdded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordMsgRouteChange records the change of msgURL at the current height. Only
+// the last change of a height is kept.
+func (k *Keeper) recordMsgRouteChange(ctx context.Context, msgURL string, change types.MsgRouteChange) error {
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	return k.MsgRouteHistory.Set(ctx, collections.Join(msgURL, height), uint64(change))
+}
+
+// GetMsgRouteHistory returns the changes of the availability of msgURL, by
+// increasing height.
+func (k *Keeper) GetMsgRouteHistory(ctx context.Context, msgURL string) ([]types.MsgRouteRecord, error) {
+	var records []types.MsgRouteRecord
+	err := k.MsgRouteHistory.Walk(ctx, collections.NewPrefixedPairRange[string, int64](msgURL),
+		func(key collections.Pair[string, int64], change uint64) (stop bool, err error) {
+			records = append(records, types.MsgRouteRecord{
+				MsgTypeURL: msgURL,
+				Height:     key.K2(),
+				Change:     types.MsgRouteChange(change),
+			})
+			return false, nil
+		},
+	)
+
+	return records, err
+}
+
+// GetMsgRouteStatusAt returns the last change of the availability of msgURL at
+// or before height, explaining why messages of this type were accepted or not.
+// It returns false if no change was recorded.
+func (k *Keeper) GetMsgRouteStatusAt(ctx context.Context, msgURL string, height int64) (types.MsgRouteRecord, bool, error) {
+	rng := collections.NewPrefixedPairRange[string, int64](msgURL).
+		EndInclusive(height).
+		Descending()
+
+	iter, err := k.MsgRouteHistory.Iterate(ctx, rng)
+	if err != nil {
+		return types.MsgRouteRecord{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.MsgRouteRecord{}, false, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return types.MsgRouteRecord{}, false, err
+	}
+
+	return types.MsgRouteRecord{
+		MsgTypeURL: msgURL,
+		Height:     kv.Key.K2(),
+		Change:     types.MsgRouteChange(kv.Value),
+	}, true, nil
+}
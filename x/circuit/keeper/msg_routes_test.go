@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/circuit"
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type msgRouteLister []string
+
+func (l msgRouteLister) MsgTypeURLs() []string { return l }
+
+func TestRecordMsgRoutes(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(circuit.AppModuleBasic{})
+	storeKey := storetypes.NewKVStoreKey("test")
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	newKeeper := func(routes ...string) keeper.Keeper {
+		k := keeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress("gov").String(), addresscodec.NewBech32Codec("cosmos"))
+		k.SetMsgRouteLister(msgRouteLister(routes))
+		return k
+	}
+
+	k := newKeeper("/a", "/b")
+	require.NoError(t, k.RecordMsgRoutes(ctx.WithHeaderInfo(header.Info{Height: 1})))
+
+	// the routes are only checked once per process
+	require.NoError(t, k.RecordMsgRoutes(ctx.WithHeaderInfo(header.Info{Height: 2})))
+
+	// an upgraded binary removes a route and adds another one
+	k = newKeeper("/b", "/c")
+	require.NoError(t, k.RecordMsgRoutes(ctx.WithHeaderInfo(header.Info{Height: 10})))
+
+	history, err := k.GetMsgRouteHistory(ctx, "/a")
+	require.NoError(t, err)
+	require.Equal(t, []types.MsgRouteRecord{
+		{MsgTypeURL: "/a", Height: 1, Change: types.MsgRouteAdded},
+		{MsgTypeURL: "/a", Height: 10, Change: types.MsgRouteRemoved},
+	}, history)
+
+	history, err = k.GetMsgRouteHistory(ctx, "/b")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+
+	history, err = k.GetMsgRouteHistory(ctx, "/c")
+	require.NoError(t, err)
+	require.Equal(t, []types.MsgRouteRecord{{MsgTypeURL: "/c", Height: 10, Change: types.MsgRouteAdded}}, history)
+
+	// the circuit breaker disables a route
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err = msgServer.TripCircuitBreaker(ctx.WithHeaderInfo(header.Info{Height: 12}), &types.MsgTripCircuitBreaker{
+		Authority:   authtypes.NewModuleAddress("gov").String(),
+		MsgTypeUrls: []string{"/b"},
+	})
+	require.NoError(t, err)
+
+	record, found, err := k.GetMsgRouteStatusAt(ctx, "/b", 11)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.MsgRouteAdded, record.Change)
+
+	record, found, err = k.GetMsgRouteStatusAt(ctx, "/b", 20)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.MsgRouteRecord{MsgTypeURL: "/b", Height: 12, Change: types.MsgRouteDisabled}, record)
+
+	_, found, err = k.GetMsgRouteStatusAt(ctx, "/c", 9)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the history is exposed by the query server
+	qs := keeper.NewQueryServer(k)
+	historyRes, err := qs.MsgRouteHistory(ctx, &types.QueryMsgRouteHistoryRequest{MsgTypeUrl: "/b"})
+	require.NoError(t, err)
+	require.Equal(t, []*types.MsgRouteRecord{
+		{MsgTypeURL: "/b", Height: 1, Change: types.MsgRouteAdded},
+		{MsgTypeURL: "/b", Height: 12, Change: types.MsgRouteDisabled},
+	}, historyRes.Records)
+
+	_, err = qs.MsgRouteHistory(ctx, &types.QueryMsgRouteHistoryRequest{})
+	require.ErrorContains(t, err, "empty msg type url")
+
+	statusRes, err := qs.MsgRouteStatus(ctx, &types.QueryMsgRouteStatusRequest{MsgTypeUrl: "/b", Height: 11})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRouteRecord{MsgTypeURL: "/b", Height: 1, Change: types.MsgRouteAdded}, statusRes.Record)
+
+	// the current height is used if none is given
+	statusRes, err = qs.MsgRouteStatus(ctx.WithHeaderInfo(header.Info{Height: 13}), &types.QueryMsgRouteStatusRequest{MsgTypeUrl: "/b"})
+	require.NoError(t, err)
+	require.Equal(t, types.MsgRouteDisabled, statusRes.Record.Change)
+
+	statusRes, err = qs.MsgRouteStatus(ctx, &types.QueryMsgRouteStatusRequest{MsgTypeUrl: "/c", Height: 9})
+	require.NoError(t, err)
+	require.Nil(t, statusRes.Record)
+}
@@ -117,6 +117,10 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		if err = srv.recordMsgRouteChange(ctx, msgTypeURL, types.MsgRouteDisabled); err != nil {
+			return nil, err
+		}
+
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -176,6 +180,10 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.recordMsgRouteChange(ctx, msgTypeURL, types.MsgRouteEnabled); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -192,6 +200,55 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetGuardians replaces the guardians which can pause the chain. Only the
+// module authority can set them.
+func (srv msgServer) SetGuardians(ctx context.Context, msg *types.MsgSetGuardians) (*types.MsgSetGuardiansResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(address, srv.GetAuthority()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can set the guardians")
+	}
+
+	params := types.GuardianParams{Threshold: msg.Threshold, PauseDuration: msg.PauseDuration}
+	if err := srv.Keeper.SetGuardians(ctx, msg.Guardians, params); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_guardians",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("guardians", strings.Join(msg.Guardians, ",")),
+		),
+	})
+
+	return &types.MsgSetGuardiansResponse{}, nil
+}
+
+// VotePause records the vote of a guardian to pause the chain.
+func (srv msgServer) VotePause(ctx context.Context, msg *types.MsgVotePause) (*types.MsgVotePauseResponse, error) {
+	paused, err := srv.Keeper.VotePause(ctx, msg.Guardian)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVotePauseResponse{Paused: paused}, nil
+}
+
+// VoteUnpause records the vote of a guardian to unpause the chain.
+func (srv msgServer) VoteUnpause(ctx context.Context, msg *types.MsgVoteUnpause) (*types.MsgVoteUnpauseResponse, error) {
+	unpaused, err := srv.Keeper.VoteUnpause(ctx, msg.Guardian)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteUnpauseResponse{Unpaused: unpaused}, nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
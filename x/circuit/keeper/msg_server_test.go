@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "cosmos.bank.v1beta1.MsgSend"
@@ -373,3 +376,43 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestGuardianMsgs(t *testing.T) {
+	ft := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithHeaderInfo(header.Info{Height: 1, Time: time.Unix(1700000000, 0)})
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// only the module authority sets the guardians
+	msg := &types.MsgSetGuardians{Authority: addresses[0], Guardians: addresses[:3], Threshold: 2, PauseDuration: time.Hour}
+	_, err = srv.SetGuardians(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Authority = authority
+	_, err = srv.SetGuardians(ctx, msg)
+	require.NoError(t, err)
+
+	// the threshold of guardian votes pauses the chain
+	_, err = srv.VotePause(ctx, &types.MsgVotePause{Guardian: addresses[3]})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	res, err := srv.VotePause(ctx, &types.MsgVotePause{Guardian: addresses[0]})
+	require.NoError(t, err)
+	require.False(t, res.Paused)
+	res, err = srv.VotePause(ctx, &types.MsgVotePause{Guardian: addresses[1]})
+	require.NoError(t, err)
+	require.True(t, res.Paused)
+
+	// and unpauses it
+	unpauseRes, err := srv.VoteUnpause(ctx, &types.MsgVoteUnpause{Guardian: addresses[2]})
+	require.NoError(t, err)
+	require.False(t, unpauseRes.Unpaused)
+	unpauseRes, err = srv.VoteUnpause(ctx, &types.MsgVoteUnpause{Guardian: addresses[0]})
+	require.NoError(t, err)
+	require.True(t, unpauseRes.Unpaused)
+
+	paused, err := ft.keeper.IsPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused)
+}
@@ -3,8 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/x/circuit/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -73,3 +77,40 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// MsgRouteHistory returns the changes of the availability of a message type.
+func (qs QueryServer) MsgRouteHistory(ctx context.Context, req *types.QueryMsgRouteHistoryRequest) (*types.MsgRouteHistoryResponse, error) {
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	records, err := qs.keeper.GetMsgRouteHistory(ctx, req.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgRouteHistoryResponse{Records: make([]*types.MsgRouteRecord, len(records))}
+	for i := range records {
+		res.Records[i] = &records[i]
+	}
+	return res, nil
+}
+
+// MsgRouteStatus returns the last change of the availability of a message type
+// at or before a height, the current one if unset.
+func (qs QueryServer) MsgRouteStatus(ctx context.Context, req *types.QueryMsgRouteStatusRequest) (*types.MsgRouteStatusResponse, error) {
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	}
+
+	record, found, err := qs.keeper.GetMsgRouteStatusAt(ctx, req.MsgTypeUrl, height)
+	if err != nil || !found {
+		return &types.MsgRouteStatusResponse{}, err
+	}
+	return &types.MsgRouteStatusResponse{Record: &record}, nil
+}
@@ -34,7 +34,8 @@ var (
 	_ module.HasGenesis     = AppModule{}
 	_ module.HasServices    = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the circuit module.
@@ -125,6 +126,17 @@ func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json
 	return cdc.MustMarshalJSON(gs)
 }
 
+// BeginBlock records the message routes added or removed by the application
+// binary at the first block executed by the process, and lifts the guardians
+// pause once it expired.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	if err := am.keeper.RecordMsgRoutes(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.ExpirePause(ctx)
+}
+
 func init() {
 	appmodule.Register(
 		&modulev1.Module{},
@@ -167,6 +179,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	baseappOpt := func(app *baseapp.BaseApp) {
 		app.SetCircuitBreaker(&circuitkeeper)
+		circuitkeeper.SetMsgRouteLister(app.MsgServiceRouter())
 	}
 
 	return ModuleOutputs{CircuitKeeper: circuitkeeper, Module: m, BaseappOptions: baseappOpt}
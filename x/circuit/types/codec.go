@@ -12,6 +12,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgSetGuardians{},
+		&MsgVotePause{},
+		&MsgVoteUnpause{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// GuardianParams defines how the guardians pause the chain.
+type GuardianParams struct {
+	// Threshold is the number of guardians whose votes pause or unpause the
+	// chain.
+	Threshold uint64
+	// PauseDuration is the duration after which a pause expires, so that the
+	// guardians can never censor the chain indefinitely.
+	PauseDuration time.Duration
+}
+
+// Validate validates the guardian params against the number of guardians.
+func (p GuardianParams) Validate(guardians int) error {
+	if p.Threshold == 0 || p.Threshold > uint64(guardians) {
+		return fmt.Errorf("guardian threshold must be between 1 and the number of guardians %d, got %d", guardians, p.Threshold)
+	}
+
+	if p.PauseDuration <= 0 {
+		return fmt.Errorf("pause duration must be positive, got %s", p.PauseDuration)
+	}
+
+	return nil
+}
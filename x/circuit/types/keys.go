@@ -14,4 +14,12 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	MsgRoutesPrefix         = collections.NewPrefix(3)
+	MsgRouteHistoryPrefix   = collections.NewPrefix(4)
+	GuardiansPrefix         = collections.NewPrefix(5)
+	GuardianThresholdPrefix = collections.NewPrefix(6)
+	PauseDurationPrefix     = collections.NewPrefix(7)
+	PauseVotesPrefix        = collections.NewPrefix(8)
+	UnpauseVotesPrefix      = collections.NewPrefix(9)
+	PausedUntilPrefix       = collections.NewPrefix(10)
 )
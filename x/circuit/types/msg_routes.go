@@ -0,0 +1,7 @@
+package types
+
+// MsgRouteLister lists the type URLs of the messages routed by the application,
+// e.g. the baseapp MsgServiceRouter.
+type MsgRouteLister interface {
+	MsgTypeURLs() []string
+}
@@ -301,6 +301,196 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
+// QueryMsgRouteHistoryRequest is the request type for the Query/MsgRouteHistory RPC method.
+type QueryMsgRouteHistoryRequest struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryMsgRouteHistoryRequest) Reset()         { *m = QueryMsgRouteHistoryRequest{} }
+func (m *QueryMsgRouteHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRouteHistoryRequest) ProtoMessage()    {}
+func (*QueryMsgRouteHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryMsgRouteHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRouteHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRouteHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgRouteHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRouteHistoryRequest.Merge(m, src)
+}
+func (m *QueryMsgRouteHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRouteHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRouteHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgRouteHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMsgRouteHistoryRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgRouteHistoryResponse is the response type for the Query/MsgRouteHistory RPC method.
+type MsgRouteHistoryResponse struct {
+	Records []*MsgRouteRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *MsgRouteHistoryResponse) Reset()         { *m = MsgRouteHistoryResponse{} }
+func (m *MsgRouteHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRouteHistoryResponse) ProtoMessage()    {}
+func (*MsgRouteHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
+}
+func (m *MsgRouteHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRouteHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRouteHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRouteHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRouteHistoryResponse.Merge(m, src)
+}
+func (m *MsgRouteHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRouteHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRouteHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRouteHistoryResponse proto.InternalMessageInfo
+
+func (m *MsgRouteHistoryResponse) GetRecords() []*MsgRouteRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryMsgRouteStatusRequest is the request type for the Query/MsgRouteStatus RPC method.
+type QueryMsgRouteStatusRequest struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// height is the height at which the status is queried, the latest one if zero.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryMsgRouteStatusRequest) Reset()         { *m = QueryMsgRouteStatusRequest{} }
+func (m *QueryMsgRouteStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRouteStatusRequest) ProtoMessage()    {}
+func (*QueryMsgRouteStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{8}
+}
+func (m *QueryMsgRouteStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRouteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRouteStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgRouteStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRouteStatusRequest.Merge(m, src)
+}
+func (m *QueryMsgRouteStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRouteStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRouteStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgRouteStatusRequest proto.InternalMessageInfo
+
+func (m *QueryMsgRouteStatusRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryMsgRouteStatusRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgRouteStatusResponse is the response type for the Query/MsgRouteStatus RPC method.
+type MsgRouteStatusResponse struct {
+	// record is the last change at or before the queried height, nil if no change was recorded.
+	Record *MsgRouteRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (m *MsgRouteStatusResponse) Reset()         { *m = MsgRouteStatusResponse{} }
+func (m *MsgRouteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRouteStatusResponse) ProtoMessage()    {}
+func (*MsgRouteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{9}
+}
+func (m *MsgRouteStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRouteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRouteStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRouteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRouteStatusResponse.Merge(m, src)
+}
+func (m *MsgRouteStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRouteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRouteStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRouteStatusResponse proto.InternalMessageInfo
+
+func (m *MsgRouteStatusResponse) GetRecord() *MsgRouteRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
 	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
@@ -308,45 +498,60 @@ func init() {
 	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
 	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
 	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryMsgRouteHistoryRequest)(nil), "cosmos.circuit.v1.QueryMsgRouteHistoryRequest")
+	proto.RegisterType((*MsgRouteHistoryResponse)(nil), "cosmos.circuit.v1.MsgRouteHistoryResponse")
+	proto.RegisterType((*QueryMsgRouteStatusRequest)(nil), "cosmos.circuit.v1.QueryMsgRouteStatusRequest")
+	proto.RegisterType((*MsgRouteStatusResponse)(nil), "cosmos.circuit.v1.MsgRouteStatusResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
 
 var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0x33, 0x2d, 0xbf, 0x5f, 0xdb, 0xd7, 0x8a, 0x3a, 0xf6, 0x10, 0xb6, 0xed, 0x5a, 0xb7,
-	0xd8, 0x84, 0x5a, 0x76, 0x48, 0x04, 0x2f, 0x82, 0xa0, 0x88, 0xf5, 0xe0, 0xa1, 0xdd, 0xa3, 0x07,
-	0x65, 0xb2, 0x3b, 0x84, 0xc1, 0x74, 0x67, 0xbb, 0x6f, 0x13, 0x2c, 0xe2, 0xa5, 0x27, 0xbd, 0x89,
-	0xfe, 0x0d, 0x1e, 0x05, 0xff, 0x0c, 0x8f, 0x05, 0x2f, 0x1e, 0x25, 0x11, 0xfc, 0x37, 0xa4, 0xb3,
-	0x33, 0x9b, 0x8d, 0x9d, 0xda, 0xe3, 0xcc, 0xbc, 0xef, 0xcb, 0xe7, 0xfb, 0xbe, 0x2f, 0x0b, 0x1b,
-	0xb1, 0xc2, 0x43, 0x85, 0x2c, 0x96, 0x79, 0x3c, 0x94, 0x05, 0x1b, 0x75, 0xd8, 0xd1, 0x50, 0xe4,
-	0xc7, 0x61, 0x96, 0xab, 0x42, 0xd1, 0xeb, 0xe5, 0x73, 0x68, 0x9e, 0xc3, 0x51, 0xc7, 0xdb, 0x31,
-	0x8a, 0x1e, 0x47, 0x51, 0xd6, 0xb2, 0x51, 0xa7, 0x27, 0x0a, 0xde, 0x61, 0x19, 0xef, 0xcb, 0x94,
-	0x17, 0x52, 0xa5, 0xa5, 0xdc, 0x73, 0x74, 0x2f, 0x8e, 0x33, 0x81, 0xe6, 0x79, 0xbd, 0xaf, 0x54,
-	0x7f, 0x20, 0x18, 0xcf, 0x24, 0xe3, 0x69, 0xaa, 0x0a, 0xad, 0xb5, 0xaf, 0x6b, 0x46, 0x6c, 0x7f,
-	0xa3, 0x0e, 0x16, 0x30, 0xb8, 0x71, 0x70, 0x76, 0x7c, 0x18, 0xc7, 0x6a, 0x98, 0x16, 0x91, 0x38,
-	0x1a, 0x0a, 0x2c, 0x68, 0x13, 0x16, 0x78, 0x92, 0xe4, 0x02, 0xb1, 0x49, 0x36, 0x49, 0x7b, 0x29,
-	0xb2, 0xc7, 0xe0, 0x00, 0xae, 0x56, 0xb5, 0x98, 0xa9, 0x14, 0x05, 0x7d, 0x00, 0x90, 0x89, 0xfc,
-	0x50, 0x22, 0x4a, 0x95, 0xea, 0xfa, 0xe5, 0xae, 0x1f, 0x9e, 0x73, 0x1c, 0xee, 0x57, 0x45, 0x18,
-	0xd5, 0x14, 0xc1, 0x0b, 0x58, 0xad, 0x33, 0xa0, 0x85, 0x78, 0x02, 0x30, 0x9d, 0x84, 0xe9, 0xbb,
-	0x6d, 0xfb, 0x9e, 0x8d, 0x2d, 0x2c, 0x9d, 0x98, 0xb1, 0x85, 0xfb, 0xbc, 0x2f, 0x8c, 0x36, 0xaa,
-	0x29, 0x83, 0xcf, 0x04, 0xae, 0x4d, 0x7b, 0x1b, 0xe8, 0xa7, 0xb0, 0xc8, 0xcd, 0x5d, 0x93, 0x6c,
-	0xce, 0xb7, 0x97, 0xbb, 0xbb, 0x0e, 0xe4, 0x3d, 0x91, 0x0a, 0x94, 0x68, 0xd4, 0x75, 0x03, 0x95,
-	0x9a, 0xee, 0xcd, 0x60, 0xce, 0x69, 0xcc, 0xd6, 0xa5, 0x98, 0x25, 0xc6, 0x0c, 0xa7, 0x07, 0x4d,
-	0x3d, 0x87, 0xc7, 0x12, 0x79, 0x6f, 0x20, 0x92, 0x67, 0x12, 0x6d, 0x20, 0xc1, 0x7d, 0x58, 0x9d,
-	0xbd, 0x36, 0x36, 0xb6, 0xe0, 0x4a, 0x62, 0xee, 0x5f, 0x0e, 0x24, 0x16, 0xda, 0xcb, 0x52, 0xb4,
-	0x92, 0xd4, 0x8a, 0xbb, 0x5f, 0xe6, 0xe1, 0x3f, 0xdd, 0x99, 0xbe, 0x27, 0xb0, 0x60, 0xcc, 0xd0,
-	0x6d, 0x87, 0x5f, 0xc7, 0x2e, 0x78, 0x81, 0xa3, 0xee, 0xaf, 0x15, 0x08, 0xba, 0xef, 0x7e, 0x7f,
-	0xdd, 0x21, 0x27, 0xdf, 0x7f, 0x7d, 0x9a, 0x6b, 0xd1, 0xdb, 0xec, 0xfc, 0xba, 0xda, 0x69, 0xb1,
-	0x37, 0x66, 0x91, 0xde, 0xd2, 0x13, 0x02, 0x8b, 0x36, 0x16, 0xda, 0xba, 0x04, 0xc6, 0x2e, 0x85,
-	0xb7, 0x75, 0x31, 0x4d, 0x15, 0x6e, 0xd0, 0x9e, 0xe2, 0x6c, 0xd0, 0xb5, 0x7f, 0xe0, 0xd0, 0x8f,
-	0x04, 0x56, 0xea, 0x83, 0xa5, 0x77, 0x2e, 0x02, 0x71, 0xa4, 0xe2, 0xb9, 0xa8, 0x5d, 0x31, 0x05,
-	0xbb, 0x53, 0xa0, 0x5b, 0xf4, 0xa6, 0x03, 0xc8, 0xe4, 0xa5, 0x33, 0x7c, 0x74, 0xef, 0xdb, 0xd8,
-	0x27, 0xa7, 0x63, 0x9f, 0xfc, 0x1c, 0xfb, 0xe4, 0xc3, 0xc4, 0x6f, 0x9c, 0x4e, 0xfc, 0xc6, 0x8f,
-	0x89, 0xdf, 0x78, 0xbe, 0x5e, 0x2a, 0x31, 0x79, 0x15, 0x4a, 0xc5, 0x5e, 0x57, 0x1d, 0xf4, 0xd7,
-	0xa0, 0xf7, 0xbf, 0xfe, 0x4f, 0xdf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdd, 0xdb, 0x03,
-	0x8d, 0x04, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc6, 0x29, 0x84, 0x5f, 0x2f, 0x7c, 0xbf, 0xe8, 0x48, 0x70, 0x53, 0xa0, 0xc2, 0x20, 0x3f,
+	0x44, 0x6c, 0xdd, 0x35, 0x31, 0x31, 0x24, 0x1a, 0x8c, 0x11, 0x0e, 0x9a, 0x40, 0x51, 0x0e, 0x1e,
+	0xdc, 0x74, 0xb7, 0x93, 0x32, 0x71, 0xb7, 0x53, 0x3a, 0x53, 0xe2, 0xc6, 0x78, 0xe1, 0xa4, 0x37,
+	0xa3, 0x57, 0x0f, 0x5e, 0xbc, 0xfb, 0x67, 0x78, 0x24, 0xf1, 0xe2, 0xd1, 0x80, 0x89, 0x47, 0xff,
+	0x05, 0xb3, 0xed, 0xcc, 0x6e, 0xbb, 0x94, 0x5d, 0x8e, 0x9d, 0x79, 0x9f, 0xb7, 0x9f, 0xf7, 0xe9,
+	0xf3, 0xa6, 0x30, 0x5b, 0x65, 0xbc, 0xce, 0xb8, 0x55, 0xa5, 0x61, 0x35, 0xa2, 0xc2, 0x3a, 0x2c,
+	0x5a, 0x07, 0x11, 0x09, 0x1b, 0x66, 0x10, 0x32, 0xc1, 0xd0, 0xe5, 0xe4, 0xda, 0x94, 0xd7, 0xe6,
+	0x61, 0x51, 0x5f, 0x95, 0x8a, 0x8a, 0xc3, 0x49, 0x52, 0x6b, 0x1d, 0x16, 0x2b, 0x44, 0x38, 0x45,
+	0x2b, 0x70, 0x3c, 0xea, 0x3b, 0x82, 0x32, 0x3f, 0x91, 0xeb, 0x39, 0xdd, 0x45, 0x23, 0x20, 0x5c,
+	0x5e, 0xcf, 0x78, 0x8c, 0x79, 0x35, 0x62, 0x39, 0x01, 0xb5, 0x1c, 0xdf, 0x67, 0x22, 0xd6, 0xaa,
+	0xdb, 0x69, 0x29, 0x56, 0xef, 0x48, 0x83, 0x61, 0x0b, 0xae, 0xec, 0x34, 0x1f, 0x37, 0xaa, 0x55,
+	0x16, 0xf9, 0xc2, 0x26, 0x07, 0x11, 0xe1, 0x02, 0x15, 0x60, 0xd8, 0x71, 0xdd, 0x90, 0x70, 0x5e,
+	0xd0, 0xe6, 0xb4, 0x95, 0x51, 0x5b, 0x3d, 0xe2, 0x1d, 0x98, 0x68, 0xd5, 0xf2, 0x80, 0xf9, 0x9c,
+	0xa0, 0xfb, 0x00, 0x01, 0x09, 0xeb, 0x94, 0x73, 0xca, 0xfc, 0xb8, 0x7e, 0xac, 0x64, 0x98, 0x67,
+	0x26, 0x36, 0xb7, 0x5b, 0x45, 0xdc, 0x4e, 0x29, 0xf0, 0x4b, 0x98, 0x4c, 0x33, 0x70, 0x05, 0xf1,
+	0x18, 0xa0, 0xed, 0x84, 0xec, 0xbb, 0xa4, 0xfa, 0x36, 0x6d, 0x33, 0x93, 0x49, 0xa4, 0x6d, 0xe6,
+	0xb6, 0xe3, 0x11, 0xa9, 0xb5, 0x53, 0x4a, 0xfc, 0x55, 0x83, 0x4b, 0xed, 0xde, 0x12, 0x7a, 0x0b,
+	0x46, 0x1c, 0x79, 0x56, 0xd0, 0xe6, 0x06, 0x56, 0xc6, 0x4a, 0x6b, 0x39, 0xc8, 0x9b, 0xc4, 0x27,
+	0x9c, 0x72, 0xa9, 0x4e, 0x0f, 0xd0, 0x52, 0xa3, 0xcd, 0x0c, 0x66, 0x7f, 0x8c, 0xb9, 0xdc, 0x13,
+	0x33, 0xc1, 0xc8, 0x70, 0xea, 0x50, 0x88, 0x7d, 0x78, 0x44, 0xb9, 0x53, 0xa9, 0x11, 0xf7, 0x09,
+	0xe5, 0xea, 0x83, 0xe0, 0x75, 0x98, 0xcc, 0x1e, 0xcb, 0x31, 0x16, 0xe0, 0x3f, 0x57, 0x9e, 0x97,
+	0x6b, 0x94, 0x8b, 0x78, 0x96, 0x51, 0x7b, 0xdc, 0x4d, 0x15, 0xe3, 0x07, 0x30, 0x1d, 0x37, 0x7e,
+	0xca, 0x3d, 0x9b, 0x45, 0x82, 0x6c, 0x51, 0x2e, 0x58, 0xd8, 0x50, 0x3e, 0xcf, 0xc1, 0x78, 0x9d,
+	0x7b, 0xe5, 0x66, 0xa2, 0xca, 0x51, 0x58, 0x93, 0x5f, 0x1c, 0xea, 0xdc, 0x7b, 0xd6, 0x08, 0xc8,
+	0xf3, 0xb0, 0x86, 0xf7, 0xe0, 0xea, 0x19, 0xad, 0x04, 0x58, 0x87, 0xe1, 0x90, 0x54, 0x59, 0xe8,
+	0x2a, 0x1b, 0xe7, 0x73, 0x6c, 0x54, 0x62, 0x3b, 0xae, 0xb4, 0x95, 0x02, 0xef, 0x81, 0x9e, 0x01,
+	0xdb, 0x15, 0x8e, 0x88, 0xf8, 0x85, 0xb9, 0xd0, 0x14, 0x0c, 0xed, 0x13, 0xea, 0xed, 0x8b, 0xd8,
+	0xf6, 0x01, 0x5b, 0x3e, 0xe1, 0x5d, 0x98, 0xea, 0x6c, 0x29, 0x71, 0xef, 0xc1, 0x50, 0xf2, 0x72,
+	0x99, 0xa7, 0x0b, 0xd0, 0x4a, 0x41, 0xe9, 0xef, 0x20, 0x0c, 0xc6, 0xb4, 0xe8, 0xbd, 0x06, 0xc3,
+	0x32, 0x12, 0x68, 0x29, 0xa7, 0x41, 0xce, 0x46, 0xe9, 0x38, 0xa7, 0xae, 0x63, 0x91, 0x70, 0xe9,
+	0xdd, 0x9f, 0x6f, 0xab, 0xda, 0xd1, 0x8f, 0xdf, 0x9f, 0xfa, 0x97, 0xd1, 0xa2, 0x75, 0x76, 0xe9,
+	0x55, 0xe6, 0xac, 0x37, 0x72, 0x1d, 0xdf, 0xa2, 0x23, 0x0d, 0x46, 0x36, 0x54, 0x14, 0x97, 0x7b,
+	0xc0, 0x28, 0x6b, 0xf5, 0x85, 0xf3, 0x69, 0x5a, 0x5e, 0xe1, 0x95, 0x36, 0xce, 0x2c, 0x9a, 0xee,
+	0x82, 0x83, 0x3e, 0x6a, 0x30, 0x9e, 0x8e, 0x27, 0xba, 0x79, 0x1e, 0x48, 0x4e, 0xb6, 0xf5, 0x3c,
+	0xea, 0xbc, 0xb0, 0xe3, 0xb5, 0x36, 0xd0, 0x3c, 0xba, 0x96, 0x03, 0x24, 0x53, 0x1f, 0x6f, 0x02,
+	0xfa, 0xa2, 0xc1, 0x44, 0x47, 0x6a, 0x91, 0x79, 0x1e, 0x57, 0xfe, 0x6a, 0xe8, 0xab, 0x5d, 0xe2,
+	0xd1, 0xb1, 0x09, 0xb8, 0xd8, 0xa6, 0x5b, 0x42, 0xd7, 0x73, 0xe8, 0x9a, 0x61, 0x0e, 0x9b, 0xca,
+	0xf2, 0xbe, 0xc4, 0xf9, 0xac, 0xc1, 0xff, 0xd9, 0xa0, 0xa2, 0x5b, 0xbd, 0x08, 0x33, 0x3b, 0xa2,
+	0xdf, 0xe8, 0x02, 0x98, 0x8d, 0x3e, 0xbe, 0xdd, 0xe6, 0x5b, 0x44, 0x0b, 0x5d, 0xf9, 0x78, 0xac,
+	0x7c, 0x78, 0xf7, 0xfb, 0x89, 0xa1, 0x1d, 0x9f, 0x18, 0xda, 0xaf, 0x13, 0x43, 0xfb, 0x70, 0x6a,
+	0xf4, 0x1d, 0x9f, 0x1a, 0x7d, 0x3f, 0x4f, 0x8d, 0xbe, 0x17, 0x33, 0x89, 0x9a, 0xbb, 0xaf, 0x4c,
+	0xca, 0xac, 0xd7, 0xad, 0x2e, 0xf1, 0x5f, 0xa9, 0x32, 0x14, 0xff, 0x5b, 0xee, 0xfc, 0x0b, 0x00,
+	0x00, 0xff, 0xff, 0x9e, 0xcc, 0x74, 0xb3, 0x15, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +572,11 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// MsgRouteHistory returns the changes of the availability of a message type, by increasing height.
+	MsgRouteHistory(ctx context.Context, in *QueryMsgRouteHistoryRequest, opts ...grpc.CallOption) (*MsgRouteHistoryResponse, error)
+	// MsgRouteStatus returns the last change of the availability of a message type at or before a height, explaining
+	// why messages of this type were accepted or not.
+	MsgRouteStatus(ctx context.Context, in *QueryMsgRouteStatusRequest, opts ...grpc.CallOption) (*MsgRouteStatusResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +614,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) MsgRouteHistory(ctx context.Context, in *QueryMsgRouteHistoryRequest, opts ...grpc.CallOption) (*MsgRouteHistoryResponse, error) {
+	out := new(MsgRouteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/MsgRouteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgRouteStatus(ctx context.Context, in *QueryMsgRouteStatusRequest, opts ...grpc.CallOption) (*MsgRouteStatusResponse, error) {
+	out := new(MsgRouteStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/MsgRouteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account permissions.
@@ -412,6 +640,11 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// MsgRouteHistory returns the changes of the availability of a message type, by increasing height.
+	MsgRouteHistory(context.Context, *QueryMsgRouteHistoryRequest) (*MsgRouteHistoryResponse, error)
+	// MsgRouteStatus returns the last change of the availability of a message type at or before a height, explaining
+	// why messages of this type were accepted or not.
+	MsgRouteStatus(context.Context, *QueryMsgRouteStatusRequest) (*MsgRouteStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +660,12 @@ func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccount
 func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (*UnimplementedQueryServer) MsgRouteHistory(ctx context.Context, req *QueryMsgRouteHistoryRequest) (*MsgRouteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRouteHistory not implemented")
+}
+func (*UnimplementedQueryServer) MsgRouteStatus(ctx context.Context, req *QueryMsgRouteStatusRequest) (*MsgRouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRouteStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +725,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgRouteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRouteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRouteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/MsgRouteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRouteHistory(ctx, req.(*QueryMsgRouteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgRouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/MsgRouteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRouteStatus(ctx, req.(*QueryMsgRouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +777,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "MsgRouteHistory",
+			Handler:    _Query_MsgRouteHistory_Handler,
+		},
+		{
+			MethodName: "MsgRouteStatus",
+			Handler:    _Query_MsgRouteStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -711,73 +994,210 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMsgRouteHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryMsgRouteHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMsgRouteHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != nil {
-		l = m.Permission.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRouteHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRouteHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRouteHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMsgRouteStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgRouteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgRouteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRouteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRouteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRouteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != nil {
+		l = m.Permission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryDisabledListRequest) Size() (n int) {
@@ -804,6 +1224,63 @@ func (m *DisabledListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMsgRouteHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRouteHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgRouteStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgRouteStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1316,6 +1793,359 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMsgRouteHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRouteHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRouteHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRouteHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRouteHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRouteHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &MsgRouteRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgRouteStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRouteStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRouteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRouteStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRouteStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRouteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &MsgRouteRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
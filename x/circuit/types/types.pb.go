@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +23,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgRouteChange is a change of the availability of a message type.
+type MsgRouteChange int32
+
+const (
+	// MSG_ROUTE_CHANGE_UNSPECIFIED defines an invalid change.
+	MsgRouteChangeUnspecified MsgRouteChange = 0
+	// MSG_ROUTE_CHANGE_ADDED is recorded when the handler of a message type is registered by the application, e.g.
+	// after a software upgrade.
+	MsgRouteAdded MsgRouteChange = 1
+	// MSG_ROUTE_CHANGE_REMOVED is recorded when the handler of a message type isn't registered by the application
+	// anymore.
+	MsgRouteRemoved MsgRouteChange = 2
+	// MSG_ROUTE_CHANGE_DISABLED is recorded when the circuit breaker is tripped for a message type.
+	MsgRouteDisabled MsgRouteChange = 3
+	// MSG_ROUTE_CHANGE_ENABLED is recorded when the circuit breaker is reset for a message type.
+	MsgRouteEnabled MsgRouteChange = 4
+)
+
+var MsgRouteChange_name = map[int32]string{
+	0: "MSG_ROUTE_CHANGE_UNSPECIFIED",
+	1: "MSG_ROUTE_CHANGE_ADDED",
+	2: "MSG_ROUTE_CHANGE_REMOVED",
+	3: "MSG_ROUTE_CHANGE_DISABLED",
+	4: "MSG_ROUTE_CHANGE_ENABLED",
+}
+
+var MsgRouteChange_value = map[string]int32{
+	"MSG_ROUTE_CHANGE_UNSPECIFIED": 0,
+	"MSG_ROUTE_CHANGE_ADDED":       1,
+	"MSG_ROUTE_CHANGE_REMOVED":     2,
+	"MSG_ROUTE_CHANGE_DISABLED":    3,
+	"MSG_ROUTE_CHANGE_ENABLED":     4,
+}
+
+func (x MsgRouteChange) String() string {
+	return proto.EnumName(MsgRouteChange_name, int32(x))
+}
+
+func (MsgRouteChange) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{0}
+}
+
 // Level is the permission level.
 type Permissions_Level int32
 
@@ -228,42 +271,119 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+// MsgRouteRecord is an entry of the history of a message type.
+type MsgRouteRecord struct {
+	MsgTypeURL string         `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Height     int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Change     MsgRouteChange `protobuf:"varint,3,opt,name=change,proto3,enum=cosmos.circuit.v1.MsgRouteChange" json:"change,omitempty"`
+}
+
+func (m *MsgRouteRecord) Reset()         { *m = MsgRouteRecord{} }
+func (m *MsgRouteRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgRouteRecord) ProtoMessage()    {}
+func (*MsgRouteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
+}
+func (m *MsgRouteRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRouteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRouteRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRouteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRouteRecord.Merge(m, src)
+}
+func (m *MsgRouteRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRouteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRouteRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRouteRecord proto.InternalMessageInfo
+
+func (m *MsgRouteRecord) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *MsgRouteRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgRouteRecord) GetChange() MsgRouteChange {
+	if m != nil {
+		return m.Change
+	}
+	return MsgRouteChangeUnspecified
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.circuit.v1.MsgRouteChange", MsgRouteChange_name, MsgRouteChange_value)
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
+	proto.RegisterType((*MsgRouteRecord)(nil), "cosmos.circuit.v1.MsgRouteRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xa4, 0xed, 0x55, 0x4f, 0xda, 0xd4, 0x9d, 0xf6, 0x56, 0xa9, 0xd5, 0x1a, 0x13,
+	0x21, 0x14, 0xa1, 0xe2, 0xd0, 0x56, 0x42, 0x82, 0x0d, 0xb8, 0xf5, 0x10, 0x22, 0xd9, 0x49, 0x35,
+	0x6e, 0xba, 0x40, 0x42, 0x56, 0x6a, 0x0f, 0xae, 0x85, 0x93, 0x89, 0x3c, 0x4e, 0xa0, 0x6f, 0x80,
+	0xb2, 0x62, 0xc3, 0x0a, 0x65, 0x81, 0x78, 0x19, 0x96, 0x5d, 0x21, 0x56, 0x08, 0xa5, 0x2f, 0x82,
+	0x6a, 0x3b, 0x6d, 0x4a, 0x02, 0xbb, 0x99, 0x39, 0xdf, 0xef, 0xfc, 0xf9, 0x3c, 0x1e, 0xd8, 0x76,
+	0x18, 0x6f, 0x33, 0x5e, 0x71, 0xfc, 0xd0, 0xe9, 0xf9, 0x51, 0xa5, 0xbf, 0x5b, 0x89, 0xce, 0xbb,
+	0x94, 0xab, 0xdd, 0x90, 0x45, 0x0c, 0xad, 0x26, 0x61, 0x35, 0x0d, 0xab, 0xfd, 0x5d, 0x69, 0xdd,
+	0x63, 0x1e, 0x8b, 0xa3, 0x95, 0xab, 0x55, 0x22, 0x2c, 0x7d, 0x17, 0x20, 0x7f, 0x44, 0xc3, 0xb6,
+	0xcf, 0xb9, 0xcf, 0x3a, 0x1c, 0x3d, 0x85, 0xf9, 0x80, 0xf6, 0x69, 0x50, 0x14, 0x14, 0xa1, 0x5c,
+	0xd8, 0xbb, 0xa7, 0x4e, 0x25, 0x52, 0x27, 0xe4, 0xaa, 0x71, 0xa5, 0x25, 0x09, 0x82, 0xee, 0xc3,
+	0x4a, 0xe0, 0xb7, 0xfd, 0xc8, 0xbe, 0xea, 0xc4, 0xee, 0x85, 0x01, 0x2f, 0x66, 0x95, 0x5c, 0x79,
+	0x91, 0x2c, 0xc7, 0xc7, 0xc7, 0xe7, 0x5d, 0xda, 0x0c, 0x03, 0x5e, 0x72, 0x60, 0x3e, 0xe6, 0x90,
+	0x04, 0x1b, 0x06, 0x3e, 0xc1, 0x86, 0x5d, 0x6f, 0xd4, 0xb1, 0xdd, 0xac, 0x5b, 0x47, 0xf8, 0xb0,
+	0xf6, 0xa2, 0x86, 0x75, 0x31, 0x83, 0xd6, 0x60, 0x25, 0x89, 0x59, 0x0d, 0x13, 0xdb, 0xa6, 0x55,
+	0xb5, 0x44, 0x01, 0x21, 0x28, 0x24, 0x87, 0x9a, 0x61, 0x24, 0x67, 0x59, 0xf4, 0x3f, 0xac, 0xa6,
+	0xc2, 0xe6, 0x11, 0x26, 0xb6, 0xa6, 0x9b, 0xb5, 0xba, 0x98, 0x2b, 0xbd, 0x83, 0xcd, 0x2a, 0xed,
+	0x50, 0xee, 0x73, 0xcd, 0x71, 0x58, 0xaf, 0x13, 0x4d, 0x4e, 0x59, 0x84, 0xff, 0x5a, 0xae, 0x1b,
+	0x52, 0xce, 0xe3, 0x39, 0x17, 0xc9, 0x78, 0x8b, 0x9e, 0x43, 0xbe, 0x7b, 0x23, 0x2c, 0x66, 0x15,
+	0xa1, 0x9c, 0xdf, 0x93, 0xff, 0xed, 0x02, 0x99, 0x44, 0x4a, 0x9f, 0x05, 0x58, 0x4a, 0x2b, 0x5b,
+	0x51, 0x2b, 0xa2, 0xe8, 0x35, 0xac, 0xb5, 0x92, 0x16, 0xec, 0xc9, 0xd4, 0x82, 0x92, 0x2b, 0xe7,
+	0xf7, 0x76, 0x66, 0xa4, 0xfe, 0x6b, 0xdf, 0x04, 0xb5, 0xa6, 0x67, 0xd9, 0x01, 0xe4, 0xfa, 0xbc,
+	0x75, 0x1a, 0x50, 0x77, 0xca, 0x78, 0x71, 0x1c, 0xb9, 0xf6, 0xfe, 0x93, 0x00, 0x05, 0x93, 0x7b,
+	0x84, 0xf5, 0x22, 0x4a, 0xa8, 0xc3, 0x42, 0x17, 0x3d, 0x82, 0xa5, 0x36, 0xf7, 0xae, 0xd9, 0xc4,
+	0x91, 0x83, 0xc2, 0xe8, 0xe7, 0x1d, 0x30, 0xb9, 0x17, 0x93, 0xc4, 0x20, 0xd0, 0x4e, 0xd7, 0x61,
+	0x80, 0x36, 0x60, 0xe1, 0x8c, 0xfa, 0xde, 0x59, 0x14, 0xfb, 0x93, 0x23, 0xe9, 0x0e, 0x3d, 0x81,
+	0x05, 0xe7, 0xac, 0xd5, 0xf1, 0x68, 0x31, 0x17, 0xdf, 0x9e, 0xbb, 0x33, 0x86, 0x1b, 0x17, 0x3f,
+	0x8c, 0x85, 0x24, 0x05, 0x1e, 0x7c, 0xc9, 0x42, 0xe1, 0x76, 0x08, 0x3d, 0x83, 0x2d, 0xd3, 0xaa,
+	0xda, 0xa4, 0xd1, 0x3c, 0xc6, 0xf6, 0xe1, 0x4b, 0xad, 0x5e, 0xfd, 0xe3, 0x8e, 0x48, 0xdb, 0x83,
+	0xa1, 0xb2, 0x79, 0x9b, 0x6a, 0x76, 0x78, 0x97, 0x3a, 0xfe, 0x1b, 0x9f, 0xba, 0xe8, 0x21, 0x6c,
+	0x4c, 0x25, 0xd0, 0x74, 0x1d, 0xeb, 0xa2, 0x20, 0xad, 0x0e, 0x86, 0xca, 0xf2, 0x18, 0xd5, 0x5c,
+	0x97, 0xba, 0x68, 0x17, 0x8a, 0x53, 0x72, 0x82, 0xcd, 0xc6, 0x09, 0xd6, 0xc5, 0xac, 0xb4, 0x36,
+	0x18, 0x2a, 0x2b, 0x37, 0xce, 0xb5, 0x59, 0x9f, 0xba, 0x68, 0x1f, 0x36, 0xa7, 0x10, 0xbd, 0x66,
+	0x69, 0x07, 0x06, 0xd6, 0xc5, 0x9c, 0xb4, 0x3e, 0x18, 0x2a, 0xe2, 0x98, 0xd1, 0xd3, 0x4f, 0x31,
+	0xb3, 0x0e, 0xae, 0x27, 0xcc, 0xdc, 0xed, 0x3a, 0xb8, 0x13, 0x23, 0xd2, 0xdc, 0x87, 0xaf, 0x72,
+	0xe6, 0xe0, 0xf1, 0xb7, 0x91, 0x2c, 0x5c, 0x8c, 0x64, 0xe1, 0xd7, 0x48, 0x16, 0x3e, 0x5e, 0xca,
+	0x99, 0x8b, 0x4b, 0x39, 0xf3, 0xe3, 0x52, 0xce, 0xbc, 0xda, 0x4a, 0x7c, 0xe6, 0xee, 0x5b, 0xd5,
+	0x67, 0x95, 0xf7, 0xd7, 0xaf, 0x42, 0xfc, 0x24, 0x9c, 0x2e, 0xc4, 0xbf, 0xfa, 0xfe, 0xef, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x26, 0xd5, 0x4c, 0x2c, 0x34, 0x04, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -391,6 +511,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRouteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRouteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRouteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Change != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -458,6 +618,25 @@ func (m *GenesisState) Size() (n int) {
 	return n
 }
 
+func (m *MsgRouteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Change != 0 {
+		n += 1 + sovTypes(uint64(m.Change))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -799,6 +978,126 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRouteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRouteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRouteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= MsgRouteChange(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
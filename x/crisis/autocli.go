@@ -12,6 +12,24 @@ import (
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			// the Query service is resolved from the gogoproto registry, it is not
+			// part of the cosmossdk.io/api release this module depends on.
+			Service: "cosmos.crisis.v1beta1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Invariants",
+					Use:       "invariants [module-name] [invariant-route]",
+					Short:     "Run the invariants of a module, or a single one of them, and report their outcome",
+					Long:      "Run the invariants of a module, or a single one of them, and report their outcome. The queried node must be started with --x-crisis-enable-invariants-query.",
+					Example:   fmt.Sprintf(`$ %s query crisis invariants bank total-supply`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "module_name"},
+						{ProtoField: "route", Optional: true},
+					},
+				},
+			},
+		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: crisisv1beta1.Msg_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
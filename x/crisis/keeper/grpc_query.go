@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the crisis QueryServer.
+type Querier struct {
+	*Keeper
+
+	// invariantsEnabled is set by the node operator, running invariants on
+	// demand being too expensive to be allowed on every public node.
+	invariantsEnabled bool
+}
+
+// NewQuerier returns a QueryServer for the crisis module. The Invariants query
+// is rejected unless invariantsEnabled is set.
+func NewQuerier(keeper *Keeper, invariantsEnabled bool) Querier {
+	return Querier{Keeper: keeper, invariantsEnabled: invariantsEnabled}
+}
+
+// Invariants implements the Query/Invariants gRPC method.
+func (q Querier) Invariants(ctx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !q.invariantsEnabled {
+		return nil, status.Error(codes.PermissionDenied, "the invariants query is not enabled on this node")
+	}
+	if req.ModuleName == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	reports, err := q.Keeper.RunInvariants(ctx, req.ModuleName, req.Route)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryInvariantsResponse{Reports: reports}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -119,6 +120,52 @@ func (k *Keeper) AssertInvariants(ctx context.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", sdkCtx.BlockHeight())
 }
 
+// RunInvariants runs the invariants registered by moduleName, or only the one
+// registered under route if it is not empty, and returns a report for each of
+// them. Unlike AssertInvariants it never panics: broken invariants are only
+// reported, and an invariant which panics, as most invariants do on a corrupted
+// state, is reported as broken with the panic value as message. Each invariant
+// runs in its own branch of ctx which is discarded, so state is never modified.
+//
+// RunInvariants backs the Invariants query, which nodes must opt in to with the
+// x-crisis-enable-invariants-query flag since invariants can be expensive.
+func (k *Keeper) RunInvariants(ctx context.Context, moduleName, route string) ([]types.InvariantReport, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var reports []types.InvariantReport
+	for _, ir := range k.routes {
+		if ir.ModuleName != moduleName || (route != "" && ir.Route != route) {
+			continue
+		}
+
+		invCtx, _ := sdkCtx.CacheContext()
+		res, broken := runInvariant(invCtx, ir.Invar)
+		reports = append(reports, types.InvariantReport{
+			ModuleName: ir.ModuleName,
+			Route:      ir.Route,
+			Broken:     broken,
+			Message:    res,
+		})
+	}
+
+	if len(reports) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnknownInvariant, "module %q, route %q", moduleName, route)
+	}
+
+	return reports, nil
+}
+
+// runInvariant runs invar, reporting it as broken if it panics.
+func runInvariant(ctx sdk.Context, invar sdk.Invariant) (res string, broken bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			res, broken = fmt.Sprintf("invariant panicked: %v", r), true
+		}
+	}()
+
+	return invar(ctx)
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k *Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -66,3 +66,80 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestRunInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	keeper.RegisterRoute("testModule", "ok", func(sdk.Context) (string, bool) { return "all good", false })
+	keeper.RegisterRoute("testModule", "broken", func(ctx sdk.Context) (string, bool) {
+		// writes made by invariants must not leak into the caller's state
+		ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+		return "supply mismatch", true
+	})
+	keeper.RegisterRoute("testModule", "panics", func(sdk.Context) (string, bool) { panic("negative balance") })
+	keeper.RegisterRoute("otherModule", "ok", func(sdk.Context) (string, bool) { return "", false })
+
+	var reports []types.InvariantReport
+	require.NotPanics(t, func() {
+		var err error
+		reports, err = keeper.RunInvariants(testCtx.Ctx, "testModule", "")
+		require.NoError(t, err)
+	})
+	require.Equal(t, []types.InvariantReport{
+		{ModuleName: "testModule", Route: "ok", Broken: false, Message: "all good"},
+		{ModuleName: "testModule", Route: "broken", Broken: true, Message: "supply mismatch"},
+		{ModuleName: "testModule", Route: "panics", Broken: true, Message: "invariant panicked: negative balance"},
+	}, reports)
+	require.False(t, testCtx.Ctx.KVStore(key).Has([]byte("key")))
+
+	reports, err := keeper.RunInvariants(testCtx.Ctx, "otherModule", "ok")
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	_, err = keeper.RunInvariants(testCtx.Ctx, "testModule", "unknown")
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+}
+
+func TestQueryInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	k := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	k.RegisterRoute("testModule", "ok", func(sdk.Context) (string, bool) { return "all good", false })
+	k.RegisterRoute("testModule", "panics", func(sdk.Context) (string, bool) { panic("negative balance") })
+
+	req := &types.QueryInvariantsRequest{ModuleName: "testModule", Route: "panics"}
+
+	// the query is rejected unless the node enables it
+	_, err := keeper.NewQuerier(k, false).Invariants(testCtx.Ctx, req)
+	require.ErrorContains(t, err, "not enabled")
+
+	queryServer := keeper.NewQuerier(k, true)
+	res, err := queryServer.Invariants(testCtx.Ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []types.InvariantReport{
+		{ModuleName: "testModule", Route: "panics", Broken: true, Message: "invariant panicked: negative balance"},
+	}, res.Reports)
+
+	res, err = queryServer.Invariants(testCtx.Ctx, &types.QueryInvariantsRequest{ModuleName: "testModule"})
+	require.NoError(t, err)
+	require.Len(t, res.Reports, 2)
+
+	_, err = queryServer.Invariants(testCtx.Ctx, &types.QueryInvariantsRequest{})
+	require.ErrorContains(t, err, "module name cannot be empty")
+
+	_, err = queryServer.Invariants(testCtx.Ctx, &types.QueryInvariantsRequest{ModuleName: "unknown"})
+	require.ErrorContains(t, err, types.ErrUnknownInvariant.Error())
+}
@@ -43,6 +43,7 @@ var (
 // Module init related flags
 const (
 	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+	FlagEnableInvariantsQuery = "x-crisis-enable-invariants-query"
 )
 
 // AppModuleBasic defines the basic application module used by the crisis module.
@@ -75,7 +76,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the crisis module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // RegisterInterfaces registers interfaces and implementations of the crisis
 // module.
@@ -93,18 +98,21 @@ type AppModule struct {
 	keeper *keeper.Keeper
 
 	skipGenesisInvariants bool
+	enableInvariantsQuery bool
 }
 
 // NewAppModule creates a new AppModule object. If initChainAssertInvariants is set,
 // we will call keeper.AssertInvariants during InitGenesis (it may take a significant time)
 // - which doesn't impact the chain security unless 66+% of validators have a wrongly
-// modified genesis file.
-func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants bool) AppModule {
+// modified genesis file. If enableInvariantsQuery is set, the node serves the
+// Invariants query, running invariants on demand.
+func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants, enableInvariantsQuery bool) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 
 		skipGenesisInvariants: skipGenesisInvariants,
+		enableInvariantsQuery: enableInvariantsQuery,
 	}
 }
 
@@ -117,11 +125,13 @@ func (am AppModule) IsAppModule() {}
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Bool(FlagEnableInvariantsQuery, false, "Serve the x/crisis invariants query, running invariants on demand")
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper, am.enableInvariantsQuery))
 
 	m := keeper.NewMigrator(am.keeper)
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
@@ -215,12 +225,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 	)
 
-	var skipGenesisInvariants bool
+	var skipGenesisInvariants, enableInvariantsQuery bool
 	if in.AppOpts != nil {
 		skipGenesisInvariants = cast.ToBool(in.AppOpts.Get(FlagSkipGenesisInvariants))
+		enableInvariantsQuery = cast.ToBool(in.AppOpts.Get(FlagEnableInvariantsQuery))
 	}
 
-	m := NewAppModule(k, skipGenesisInvariants)
+	m := NewAppModule(k, skipGenesisInvariants, enableInvariantsQuery)
 
 	return ModuleOutputs{CrisisKeeper: k, Module: m}
 }
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasAccountState reports whether addr set a withdraw address. It implements
+// the bank AccountStateCheckFn, so that such accounts aren't reaped as dust.
+// The rewards of delegators are accounted by the staking check, which reports
+// their delegations.
+func (k Keeper) HasAccountState(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.DelegatorsWithdrawAddress.Has(ctx, addr)
+}
@@ -257,13 +257,7 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
+		if err := k.sendDelegationRewards(ctx, delAddr, valAddr, finalRewards); err != nil {
 			return nil, err
 		}
 	}
@@ -325,3 +319,25 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	return finalRewards, nil
 }
+
+// sendDelegationRewards sends the rewards of the delegation of delAddr to valAddr
+// to the withdraw address of delAddr, or to the module account delegating from
+// delAddr, which is notified of the rewards.
+func (k Keeper) sendDelegationRewards(ctx context.Context, delAddr, valAddr []byte, rewards sdk.Coins) error {
+	if sk, ok := k.stakingKeeper.(types.ModuleDelegationStakingKeeper); ok {
+		if moduleName, ok := sk.ModuleDelegatorName(delAddr); ok {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, rewards); err != nil {
+				return err
+			}
+
+			return sk.AfterModuleDelegationRewards(ctx, delAddr, valAddr, rewards)
+		}
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards)
+}
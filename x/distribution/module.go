@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/distribution/client/cli"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/simulation"
@@ -220,9 +221,10 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	DistrKeeper keeper.Keeper
-	Module      appmodule.AppModule
-	Hooks       staking.StakingHooksWrapper
+	DistrKeeper       keeper.Keeper
+	Module            appmodule.AppModule
+	Hooks             staking.StakingHooksWrapper
+	AccountStateCheck banktypes.AccountStateCheckWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -251,8 +253,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.PoolKeeper)
 
 	return ModuleOutputs{
-		DistrKeeper: k,
-		Module:      m,
-		Hooks:       staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		DistrKeeper:       k,
+		Module:            m,
+		Hooks:             staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		AccountStateCheck: banktypes.AccountStateCheckWrapper{AccountStateCheckFn: k.HasAccountState},
 	}
 }
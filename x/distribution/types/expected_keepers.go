@@ -65,6 +65,15 @@ type StakingKeeper interface {
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
 }
 
+// ModuleDelegationStakingKeeper is implemented by staking keepers supporting
+// delegations by module accounts. The rewards of such delegations are withdrawn
+// to the module account, whatever its withdraw address, and the module notified
+// so that it can forward them.
+type ModuleDelegationStakingKeeper interface {
+	ModuleDelegatorName(delAddr sdk.AccAddress) (string, bool)
+	AfterModuleDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error // Must be called when a validator is created
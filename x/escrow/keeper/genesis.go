@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/escrow/types"
+)
+
+// InitGenesis initializes the escrows and next escrow IDs of the escrow module
+// from genesis. The coins of the escrows must be held by the escrow module
+// account in the bank genesis.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, n := range gs.NextIDs {
+		if err := k.NextID.Set(ctx, n.Owner, n.NextID); err != nil {
+			return err
+		}
+	}
+
+	for _, escrow := range gs.Escrows {
+		for _, addr := range []string{escrow.Depositor, escrow.Beneficiary} {
+			if _, err := k.addressCodec.StringToBytes(addr); err != nil {
+				return err
+			}
+		}
+
+		if err := k.Escrows.Set(ctx, collections.Join(escrow.Owner, escrow.ID), escrow); err != nil {
+			return err
+		}
+
+		if !escrow.Timeout.IsZero() {
+			if err := k.Timeouts.Set(ctx, collections.Join3(escrow.Timeout.UnixNano(), escrow.Owner, escrow.ID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the escrows and next escrow IDs of the escrow module.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	gs := types.DefaultGenesisState()
+
+	err := k.NextID.Walk(ctx, nil, func(owner string, id uint64) (bool, error) {
+		gs.NextIDs = append(gs.NextIDs, types.OwnerNextID{Owner: owner, NextID: id})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Escrows.Walk(ctx, nil, func(_ collections.Pair[string, uint64], escrow types.Escrow) (bool, error) {
+		gs.Escrows = append(gs.Escrows, escrow)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
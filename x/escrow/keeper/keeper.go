@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/escrow/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper locks coins in escrow on behalf of other modules, such as gov
+// deposits, protocol pool budgets or marketplace orders, and releases or
+// refunds them on instruction of the owner module or on timeout. The escrowed
+// coins are held by the escrow module account.
+//
+// The keeper doesn't authenticate its callers: a module must only be given
+// access to the keeper through a wrapper fixing its owner name, or be trusted
+// not to act on the escrows of other modules.
+type Keeper struct {
+	addressCodec address.Codec
+	bankKeeper   types.BankKeeper
+
+	// maxTimeoutsPerBlock bounds the number of escrows settled by EndBlocker.
+	maxTimeoutsPerBlock uint32
+
+	Schema collections.Schema
+	// Escrows key: owner module, escrow ID, value: escrow
+	Escrows collections.Map[collections.Pair[string, uint64], types.Escrow]
+	// Timeouts key: timeout (unix nanoseconds), owner module, escrow ID
+	Timeouts collections.KeySet[collections.Triple[int64, string, uint64]]
+	// NextID key: owner module, value: ID of its next escrow
+	NextID collections.Map[string, uint64]
+}
+
+// NewKeeper returns an escrow keeper settling at most maxTimeoutsPerBlock timed
+// out escrows per block, or types.DefaultMaxTimeoutsPerBlock if zero.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	addressCodec address.Codec,
+	bk types.BankKeeper,
+	maxTimeoutsPerBlock uint32,
+) Keeper {
+	if maxTimeoutsPerBlock == 0 {
+		maxTimeoutsPerBlock = types.DefaultMaxTimeoutsPerBlock
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		addressCodec:        addressCodec,
+		bankKeeper:          bk,
+		maxTimeoutsPerBlock: maxTimeoutsPerBlock,
+		Escrows: collections.NewMap(
+			sb, types.EscrowsKey, "escrows",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.Escrow](cdc),
+		),
+		Timeouts: collections.NewKeySet(
+			sb, types.TimeoutsKey, "timeouts",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key),
+		),
+		NextID: collections.NewMap(sb, types.NextIDKey, "next_id", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Lock moves amount from depositor to escrow on behalf of the owner module,
+// for beneficiary. Unless timeout is the zero time, the escrow is settled with
+// action at the end of the first block at or after timeout. It returns the ID
+// of the escrow, sequential per owner.
+func (k Keeper) Lock(
+	ctx context.Context,
+	owner string,
+	depositor, beneficiary sdk.AccAddress,
+	amount sdk.Coins,
+	timeout time.Time,
+	action types.TimeoutAction,
+) (uint64, error) {
+	id, err := k.NextID.Get(ctx, owner)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	depositorStr, err := k.addressCodec.BytesToString(depositor)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEscrow, "invalid depositor: %s", err)
+	}
+
+	beneficiaryStr, err := k.addressCodec.BytesToString(beneficiary)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEscrow, "invalid beneficiary: %s", err)
+	}
+
+	escrow := types.Escrow{
+		Owner:         owner,
+		ID:            id,
+		Depositor:     depositorStr,
+		Beneficiary:   beneficiaryStr,
+		Amount:        amount,
+		Timeout:       timeout,
+		TimeoutAction: action,
+	}
+	if err := escrow.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidEscrow, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return 0, err
+	}
+
+	if err := k.NextID.Set(ctx, owner, id+1); err != nil {
+		return 0, err
+	}
+
+	if err := k.Escrows.Set(ctx, collections.Join(owner, id), escrow); err != nil {
+		return 0, err
+	}
+
+	if !timeout.IsZero() {
+		if err := k.Timeouts.Set(ctx, collections.Join3(timeout.UnixNano(), owner, id)); err != nil {
+			return 0, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockEscrow,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeout, timeout.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return id, nil
+}
+
+// GetEscrow returns the escrow of owner with ID id.
+func (k Keeper) GetEscrow(ctx context.Context, owner string, id uint64) (types.Escrow, error) {
+	escrow, err := k.Escrows.Get(ctx, collections.Join(owner, id))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Escrow{}, errorsmod.Wrapf(types.ErrEscrowNotFound, "%s/%d", owner, id)
+	}
+
+	return escrow, err
+}
+
+// Release settles the escrow of owner with ID id, sending its coins to the
+// beneficiary.
+func (k Keeper) Release(ctx context.Context, owner string, id uint64) error {
+	escrow, err := k.GetEscrow(ctx, owner, id)
+	if err != nil {
+		return err
+	}
+
+	return k.settle(ctx, escrow, types.EventTypeReleaseEscrow, escrow.Beneficiary)
+}
+
+// Refund settles the escrow of owner with ID id, sending its coins back to the
+// depositor.
+func (k Keeper) Refund(ctx context.Context, owner string, id uint64) error {
+	escrow, err := k.GetEscrow(ctx, owner, id)
+	if err != nil {
+		return err
+	}
+
+	return k.settle(ctx, escrow, types.EventTypeRefundEscrow, escrow.Depositor)
+}
+
+// EndBlocker settles the escrows which timed out, according to their timeout
+// action, at most maxTimeoutsPerBlock of them per block: the next ones are
+// settled in the following blocks. An escrow failing to settle, e.g. because
+// its recipient is blocked from receiving coins, doesn't fail the block: it
+// stays locked until its owner settles it and a settle_escrow_failure event is
+// emitted.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.HeaderInfo().Time.UnixNano()
+
+	// settling escrows removes their timeouts, so they are collected first
+	var expired []collections.Triple[int64, string, uint64]
+	err := k.Timeouts.Walk(ctx, nil, func(key collections.Triple[int64, string, uint64]) (bool, error) {
+		if key.K1() > now {
+			return true, nil
+		}
+
+		expired = append(expired, key)
+		return uint32(len(expired)) >= k.maxTimeoutsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		cacheCtx, write := sdkCtx.CacheContext()
+		err := k.settleTimeout(cacheCtx, key.K2(), key.K3())
+		if err == nil {
+			write()
+			continue
+		}
+
+		// the timeout is dropped so that the escrow isn't retried at every block
+		if err := k.Timeouts.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleEscrowFailure,
+				sdk.NewAttribute(types.AttributeKeyOwner, key.K2()),
+				sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(key.K3(), 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// settleTimeout settles the timed out escrow of owner with ID id according to
+// its timeout action.
+func (k Keeper) settleTimeout(ctx context.Context, owner string, id uint64) error {
+	escrow, err := k.GetEscrow(ctx, owner, id)
+	if err != nil {
+		return err
+	}
+
+	if escrow.TimeoutAction == types.TimeoutRelease {
+		return k.settle(ctx, escrow, types.EventTypeReleaseEscrow, escrow.Beneficiary)
+	}
+
+	return k.settle(ctx, escrow, types.EventTypeRefundEscrow, escrow.Depositor)
+}
+
+// settle sends the coins of escrow to recipient and removes the escrow.
+func (k Keeper) settle(ctx context.Context, escrow types.Escrow, eventType, recipient string) error {
+	recipientAddr, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Escrows.Remove(ctx, collections.Join(escrow.Owner, escrow.ID)); err != nil {
+		return err
+	}
+
+	if !escrow.Timeout.IsZero() {
+		if err := k.Timeouts.Remove(ctx, collections.Join3(escrow.Timeout.UnixNano(), escrow.Owner, escrow.ID)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, escrow.Amount); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOwner, escrow.Owner),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/escrow/keeper"
+	"cosmossdk.io/x/escrow/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// bankKeeper records the balances of accounts and modules, refusing to send
+// coins to blocked accounts.
+type bankKeeper struct {
+	balances map[string]sdk.Coins
+	blocked  map[string]bool
+}
+
+func (b bankKeeper) SendCoinsFromAccountToModule(_ context.Context, sender sdk.AccAddress, module string, amt sdk.Coins) error {
+	b.balances[sender.String()] = b.balances[sender.String()].Sub(amt...)
+	b.balances[module] = b.balances[module].Add(amt...)
+	return nil
+}
+
+func (b bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	if b.blocked[recipient.String()] {
+		return errors.New("blocked recipient")
+	}
+	b.balances[module] = b.balances[module].Sub(amt...)
+	b.balances[recipient.String()] = b.balances[recipient.String()].Add(amt...)
+	return nil
+}
+
+func TestEscrow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	bk := bankKeeper{balances: map[string]sdk.Coins{alice.String(): amount.MulInt(math.NewInt(4))}}
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, runtime.NewKVStoreService(key), addresscodec.NewBech32Codec("cosmos"), bk, 0)
+
+	_, err := k.Lock(ctx, "gov", alice, bob, sdk.NewCoins(), time.Time{}, types.TimeoutRefund)
+	require.ErrorIs(t, err, types.ErrInvalidEscrow)
+
+	// escrow IDs are sequential per owner
+	released, err := k.Lock(ctx, "gov", alice, bob, amount, time.Time{}, types.TimeoutRefund)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), released)
+	refunded, err := k.Lock(ctx, "gov", alice, bob, amount, time.Time{}, types.TimeoutRefund)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), refunded)
+	timedOutRefund, err := k.Lock(ctx, "marketplace", alice, bob, amount, now.Add(time.Hour), types.TimeoutRefund)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), timedOutRefund)
+	timedOutRelease, err := k.Lock(ctx, "marketplace", alice, bob, amount, now.Add(2*time.Hour), types.TimeoutRelease)
+	require.NoError(t, err)
+	require.Equal(t, amount.MulInt(math.NewInt(4)), bk.balances[types.ModuleName])
+
+	require.NoError(t, k.Release(ctx, "gov", released))
+	require.Equal(t, amount, bk.balances[bob.String()])
+	require.NoError(t, k.Refund(ctx, "gov", refunded))
+	require.Equal(t, amount, bk.balances[alice.String()])
+	require.ErrorIs(t, k.Release(ctx, "gov", released), types.ErrEscrowNotFound)
+	require.ErrorIs(t, k.Refund(ctx, "marketplace", 42), types.ErrEscrowNotFound)
+
+	// escrows are settled at the first end block at or after their timeout
+	require.NoError(t, k.EndBlocker(ctx))
+	_, err = k.GetEscrow(ctx, "marketplace", timedOutRefund)
+	require.NoError(t, err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	_, err = k.GetEscrow(ctx, "marketplace", timedOutRefund)
+	require.ErrorIs(t, err, types.ErrEscrowNotFound)
+	require.Equal(t, amount.MulInt(math.NewInt(2)), bk.balances[alice.String()])
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(3 * time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	_, err = k.GetEscrow(ctx, "marketplace", timedOutRelease)
+	require.ErrorIs(t, err, types.ErrEscrowNotFound)
+	require.Equal(t, amount.MulInt(math.NewInt(2)), bk.balances[bob.String()])
+	require.True(t, bk.balances[types.ModuleName].IsZero())
+}
+
+func TestEscrowEndBlocker(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	alice, bob, carol := sdk.AccAddress("alice"), sdk.AccAddress("bob"), sdk.AccAddress("carol")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	bk := bankKeeper{
+		balances: map[string]sdk.Coins{alice.String(): amount.MulInt(math.NewInt(3))},
+		blocked:  map[string]bool{carol.String(): true},
+	}
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, runtime.NewKVStoreService(key), addresscodec.NewBech32Codec("cosmos"), bk, 2)
+
+	// the first escrow to time out can't be released to its blocked beneficiary
+	failing, err := k.Lock(ctx, "marketplace", alice, carol, amount, now, types.TimeoutRelease)
+	require.NoError(t, err)
+	first, err := k.Lock(ctx, "marketplace", alice, bob, amount, now, types.TimeoutRelease)
+	require.NoError(t, err)
+	second, err := k.Lock(ctx, "marketplace", alice, bob, amount, now, types.TimeoutRelease)
+	require.NoError(t, err)
+
+	// at most 2 escrows are settled per block, a failure not failing the block
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, amount, bk.balances[bob.String()])
+	_, err = k.GetEscrow(ctx, "marketplace", first)
+	require.ErrorIs(t, err, types.ErrEscrowNotFound)
+	_, err = k.GetEscrow(ctx, "marketplace", second)
+	require.NoError(t, err)
+
+	var failures int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettleEscrowFailure {
+			failures++
+		}
+	}
+	require.Equal(t, 1, failures)
+
+	// the failed escrow stays locked without being retried, until its owner
+	// settles it
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, amount.MulInt(math.NewInt(2)), bk.balances[bob.String()])
+	_, err = k.GetEscrow(ctx, "marketplace", failing)
+	require.NoError(t, err)
+	require.NoError(t, k.Refund(ctx, "marketplace", failing))
+	require.Equal(t, amount, bk.balances[alice.String()])
+	require.True(t, bk.balances[types.ModuleName].IsZero())
+}
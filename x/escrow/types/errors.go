@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/escrow module sentinel errors
+var (
+	ErrInvalidEscrow  = errors.Register(ModuleName, 2, "invalid escrow")
+	ErrEscrowNotFound = errors.Register(ModuleName, 3, "escrow not found")
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate validates the escrow.
+func (e Escrow) Validate() error {
+	if e.Owner == "" {
+		return fmt.Errorf("empty owner")
+	}
+
+	if e.Depositor == "" {
+		return fmt.Errorf("empty depositor")
+	}
+
+	if e.Beneficiary == "" {
+		return fmt.Errorf("empty beneficiary")
+	}
+
+	if !e.Amount.IsValid() || e.Amount.IsZero() {
+		return fmt.Errorf("invalid amount: %s", e.Amount)
+	}
+
+	if _, ok := TimeoutAction_name[int32(e.TimeoutAction)]; !ok {
+		return fmt.Errorf("unknown timeout action: %s", e.TimeoutAction)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package types
+
+// x/escrow module event types and attributes
+const (
+	EventTypeLockEscrow          = "lock_escrow"
+	EventTypeReleaseEscrow       = "release_escrow"
+	EventTypeRefundEscrow        = "refund_escrow"
+	EventTypeSettleEscrowFailure = "settle_escrow_failure"
+	AttributeKeyOwner            = "owner"
+	AttributeKeyEscrowID         = "escrow_id"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyTimeout          = "timeout"
+	AttributeKeyError            = "error"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState returns the default genesis state of the escrow module,
+// without escrows.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate validates the genesis state: escrows must be valid and have an ID
+// below the next ID of their owner.
+func (gs GenesisState) Validate() error {
+	nextIDs := make(map[string]uint64, len(gs.NextIDs))
+	for _, n := range gs.NextIDs {
+		if n.Owner == "" {
+			return fmt.Errorf("empty owner")
+		}
+		if _, ok := nextIDs[n.Owner]; ok {
+			return fmt.Errorf("duplicate next ID of owner %s", n.Owner)
+		}
+		nextIDs[n.Owner] = n.NextID
+	}
+
+	seen := make(map[string]bool, len(gs.Escrows))
+	for _, e := range gs.Escrows {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow %s/%d: %w", e.Owner, e.ID, err)
+		}
+
+		key := fmt.Sprintf("%s/%d", e.Owner, e.ID)
+		if seen[key] {
+			return fmt.Errorf("duplicate escrow %s", key)
+		}
+		seen[key] = true
+
+		if e.ID >= nextIDs[e.Owner] {
+			return fmt.Errorf("escrow %s has an ID not below the next ID of its owner", key)
+		}
+	}
+
+	return nil
+}
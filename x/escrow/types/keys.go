@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/escrow module, which is also the
+	// name of the module account holding the escrowed coins.
+	ModuleName = "escrow"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+
+	// DefaultMaxTimeoutsPerBlock is the default maximum number of timed out
+	// escrows settled at the end of a block.
+	DefaultMaxTimeoutsPerBlock = 100
+)
+
+var (
+	EscrowsKey  = collections.NewPrefix(0)
+	TimeoutsKey = collections.NewPrefix(1)
+	NextIDKey   = collections.NewPrefix(2)
+)
@@ -121,21 +121,31 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
-	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	// to slash unbonding and rebonding delegations. It decays with the age of the
+	// infraction, and old enough infractions are not slashed at all.
+	slashFractionDoubleSign, err := k.slashingKeeper.DecayedSlashFractionDoubleSign(ctx, ageDuration)
 	if err != nil {
 		return err
 	}
 
-	err = k.slashingKeeper.SlashWithInfractionReason(
-		ctx,
-		consAddr,
-		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
-		st.Infraction_INFRACTION_DOUBLE_SIGN,
-	)
-	if err != nil {
-		return err
+	if slashFractionDoubleSign.IsPositive() {
+		err = k.slashingKeeper.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			slashFractionDoubleSign,
+			evidence.GetValidatorPower(), distributionHeight,
+			st.Infraction_INFRACTION_DOUBLE_SIGN,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		logger.Info(
+			"equivocation not slashed; infraction too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
 	}
 
 	// Jail the validator if not already jailed. This will begin unbonding the
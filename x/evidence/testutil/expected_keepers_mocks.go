@@ -92,6 +92,21 @@ func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
 	return m.recorder
 }
 
+// DecayedSlashFractionDoubleSign mocks base method.
+func (m *MockSlashingKeeper) DecayedSlashFractionDoubleSign(arg0 context.Context, arg1 time.Duration) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecayedSlashFractionDoubleSign", arg0, arg1)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecayedSlashFractionDoubleSign indicates an expected call of DecayedSlashFractionDoubleSign.
+func (mr *MockSlashingKeeperMockRecorder) DecayedSlashFractionDoubleSign(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecayedSlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).DecayedSlashFractionDoubleSign), arg0, arg1)
+}
+
 // GetPubkey mocks base method.
 func (m *MockSlashingKeeper) GetPubkey(arg0 context.Context, arg1 types.Address) (types.PubKey, error) {
 	m.ctrl.T.Helper()
@@ -177,21 +192,6 @@ func (mr *MockSlashingKeeperMockRecorder) Slash(arg0, arg1, arg2, arg3, arg4 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SlashFractionDoubleSign mocks base method.
-func (m *MockSlashingKeeper) SlashFractionDoubleSign(arg0 context.Context) (math.LegacyDec, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", arg0)
-	ret0, _ := ret[0].(math.LegacyDec)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
-func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), arg0)
-}
-
 // SlashWithInfractionReason mocks base method.
 func (m *MockSlashingKeeper) SlashWithInfractionReason(arg0 context.Context, arg1 types0.ConsAddress, arg2 math.LegacyDec, arg3, arg4 int64, arg5 stakingv1beta1.Infraction) error {
 	m.ctrl.T.Helper()
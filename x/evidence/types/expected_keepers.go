@@ -29,7 +29,7 @@ type (
 		Tombstone(context.Context, sdk.ConsAddress) error
 		Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
 		SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
-		SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
+		DecayedSlashFractionDoubleSign(context.Context, time.Duration) (math.LegacyDec, error)
 		Jail(context.Context, sdk.ConsAddress) error
 		JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 	}
@@ -23,6 +23,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagGroupPolicy = "group-policy"
+	FlagMsgType     = "msg-type"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -43,7 +45,8 @@ func GetTxCmd() *cobra.Command {
 	return feegrantTxCmd
 }
 
-// NewCmdFeeGrant returns a CLI command handler to create a MsgGrantAllowance transaction.
+// NewCmdFeeGrant returns a CLI command handler to create a MsgGrantAllowance transaction,
+// or a MsgGrantGroupAllowance or MsgGrantMsgTypeAllowance one with the --group-policy or --msg-type flags.
 // This command is more powerful than AutoCLI generated command as it allows a better input validation.
 func NewCmdFeeGrant() *cobra.Command {
 	cmd := &cobra.Command{
@@ -59,8 +62,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... [group_policy] --group-policy --spend-limit 100stake or
+%s tx %s grant cosmos1skjw... /cosmos.gov.v1beta1.MsgVote --msg-type --spend-limit 100stake
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -74,10 +80,24 @@ Examples:
 				return err
 			}
 
-			_, err = clientCtx.AddressCodec.StringToBytes(args[1])
+			groupPolicy, err := cmd.Flags().GetBool(FlagGroupPolicy)
 			if err != nil {
 				return err
 			}
+			msgType, err := cmd.Flags().GetBool(FlagMsgType)
+			if err != nil {
+				return err
+			}
+			if groupPolicy && msgType {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", FlagGroupPolicy, FlagMsgType)
+			}
+
+			if !msgType {
+				_, err = clientCtx.AddressCodec.StringToBytes(args[1])
+				if err != nil {
+					return err
+				}
+			}
 
 			granter := clientCtx.GetFromAddress()
 			granterStr, err := clientCtx.AddressCodec.BytesToString(granter)
@@ -176,6 +196,21 @@ Examples:
 				return err
 			}
 
+			switch {
+			case groupPolicy:
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &feegrant.MsgGrantGroupAllowance{
+					Granter:     granterStr,
+					GroupPolicy: args[1],
+					Allowance:   msg.Allowance,
+				})
+			case msgType:
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &feegrant.MsgGrantMsgTypeAllowance{
+					Granter:    granterStr,
+					MsgTypeUrl: args[1],
+					Allowance:  msg.Allowance,
+				})
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -186,6 +221,8 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().Bool(FlagGroupPolicy, false, "Grant the allowance to the members of the group of the [grantee] group policy")
+	cmd.Flags().Bool(FlagMsgType, false, "Grant the allowance to any account executing messages of the [grantee] type URL")
 
 	return cmd
 }
@@ -13,6 +13,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantGroupAllowance{}, "cosmos-sdk/MsgGrantGroupAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgSyncGroupAllowance{}, "cosmos-sdk/MsgSyncGroupAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantMsgTypeAllowance{}, "cosmos-sdk/MsgGrantMsgTypeAllowance")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgGrantGroupAllowance{},
+		&MsgSyncGroupAllowance{},
+		&MsgGrantMsgTypeAllowance{},
 	)
 
 	registry.RegisterInterface(
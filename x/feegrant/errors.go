@@ -20,4 +20,6 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrGroupsNotEnabled error if allowances are granted to group policies without a group keeper
+	ErrGroupsNotEnabled = errors.Register(DefaultCodespace, 8, "group allowances are not enabled")
 )
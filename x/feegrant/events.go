@@ -11,4 +11,9 @@ const (
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+
+	// AttributeKeyAllowanceGrantee is the grantee of the allowance used to pay
+	// the fees of the grantee, when it was granted to a group policy or a
+	// message type rather than to the grantee.
+	AttributeKeyAllowanceGrantee = "allowance_grantee"
 )
@@ -25,3 +25,10 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// GroupKeeper defines the expected group keeper, resolving the members of the
+// group of a group policy an allowance is granted to.
+type GroupKeeper interface {
+	GroupPolicyMembers(ctx context.Context, groupPolicy sdk.AccAddress) ([]sdk.AccAddress, error)
+	IsGroupPolicyMember(ctx context.Context, groupPolicy, member sdk.AccAddress) (bool, error)
+}
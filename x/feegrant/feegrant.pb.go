@@ -283,11 +283,135 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// GroupGrant records an allowance granted to the members of the group of a
+// group policy, used by the members it was granted to.
+type GroupGrant struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// group_policy is the address of the group policy the allowance is granted to.
+	GroupPolicy string `protobuf:"bytes,2,opt,name=group_policy,json=groupPolicy,proto3" json:"group_policy,omitempty"`
+	// members are the addresses of the members of the group the allowance was
+	// granted to, at the time of the grant or of its last sync.
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *GroupGrant) Reset()         { *m = GroupGrant{} }
+func (m *GroupGrant) String() string { return proto.CompactTextString(m) }
+func (*GroupGrant) ProtoMessage()    {}
+func (*GroupGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *GroupGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupGrant.Merge(m, src)
+}
+func (m *GroupGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupGrant proto.InternalMessageInfo
+
+func (m *GroupGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *GroupGrant) GetGroupPolicy() string {
+	if m != nil {
+		return m.GroupPolicy
+	}
+	return ""
+}
+
+func (m *GroupGrant) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// MsgTypeGrant records an allowance granted to any account executing messages
+// of a type.
+type MsgTypeGrant struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// msg_type_url is the type URL of the messages the allowance pays the fees of.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgTypeGrant) Reset()         { *m = MsgTypeGrant{} }
+func (m *MsgTypeGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeGrant) ProtoMessage()    {}
+func (*MsgTypeGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *MsgTypeGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeGrant.Merge(m, src)
+}
+func (m *MsgTypeGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeGrant proto.InternalMessageInfo
+
+func (m *MsgTypeGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgTypeGrant) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*GroupGrant)(nil), "cosmos.feegrant.v1beta1.GroupGrant")
+	proto.RegisterType((*MsgTypeGrant)(nil), "cosmos.feegrant.v1beta1.MsgTypeGrant")
 }
 
 func init() {
@@ -295,49 +419,53 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x18, 0xdf, 0xd9, 0x05, 0xde, 0xec, 0xec, 0xbe, 0xbc, 0xd0, 0x97, 0xe4, 0xed, 0x92, 0x37, 0xdd,
+	0xcd, 0x26, 0xea, 0x42, 0x42, 0x1b, 0xf0, 0x86, 0x17, 0x28, 0x06, 0xd4, 0x40, 0x42, 0x16, 0xbc,
+	0x98, 0x98, 0x66, 0xda, 0x0e, 0x75, 0x42, 0xdb, 0x69, 0x3a, 0x5d, 0x65, 0xaf, 0x9e, 0x8c, 0x1e,
+	0xe4, 0x68, 0x3c, 0x71, 0x34, 0x9e, 0x30, 0xe1, 0x43, 0x10, 0x0f, 0x86, 0x78, 0xd2, 0x8b, 0x18,
+	0x38, 0x70, 0xf6, 0x1b, 0x98, 0xce, 0x4c, 0x77, 0x17, 0x10, 0xf9, 0x13, 0xc3, 0x65, 0xb7, 0xf3,
+	0xcc, 0xf3, 0xfc, 0xfe, 0x3c, 0xcf, 0xd3, 0x14, 0xde, 0x74, 0x28, 0x0b, 0x28, 0x33, 0xd6, 0x30,
+	0xf6, 0x62, 0x14, 0x26, 0xc6, 0xd3, 0x49, 0x1b, 0x27, 0x68, 0xb2, 0x13, 0xd0, 0xa3, 0x98, 0x26,
+	0x54, 0xf9, 0x4f, 0xe4, 0xe9, 0x9d, 0xb0, 0xcc, 0x1b, 0x1d, 0xf1, 0xa8, 0x47, 0x79, 0x8e, 0x91,
+	0x3e, 0x89, 0xf4, 0xd1, 0x8a, 0x47, 0xa9, 0xe7, 0x63, 0x83, 0x9f, 0xec, 0xd6, 0x9a, 0x81, 0xc2,
+	0x76, 0x76, 0x25, 0x90, 0x2c, 0x51, 0x23, 0x61, 0xc5, 0x95, 0x26, 0xc5, 0xd8, 0x88, 0xe1, 0x8e,
+	0x10, 0x87, 0x92, 0x50, 0xde, 0x0f, 0xa3, 0x80, 0x84, 0xd4, 0xe0, 0xbf, 0x32, 0x54, 0x3d, 0x49,
+	0x94, 0x90, 0x00, 0xb3, 0x04, 0x05, 0x51, 0x86, 0x79, 0x32, 0xc1, 0x6d, 0xc5, 0x28, 0x21, 0x54,
+	0x62, 0xd6, 0xb7, 0xf2, 0x70, 0xd0, 0x44, 0x8c, 0x38, 0xb3, 0xbe, 0x4f, 0x9f, 0xa1, 0xd0, 0xc1,
+	0xca, 0x73, 0x00, 0x4b, 0x2c, 0xc2, 0xa1, 0x6b, 0xf9, 0x24, 0x20, 0x89, 0x0a, 0x6a, 0x85, 0x46,
+	0x69, 0xaa, 0xa2, 0x4b, 0xad, 0xa9, 0xba, 0xcc, 0xbe, 0x3e, 0x47, 0x49, 0x68, 0xce, 0xef, 0x7e,
+	0xab, 0xe6, 0xde, 0xef, 0x57, 0x1b, 0x1e, 0x49, 0x9e, 0xb4, 0x6c, 0xdd, 0xa1, 0x81, 0x34, 0x26,
+	0xff, 0x26, 0x98, 0xbb, 0x6e, 0x24, 0xed, 0x08, 0x33, 0x5e, 0xc0, 0xde, 0x1e, 0x6d, 0x8f, 0x97,
+	0x7d, 0xec, 0x21, 0xa7, 0x6d, 0xa5, 0xfe, 0xd8, 0xbb, 0xa3, 0xed, 0x71, 0xd0, 0x84, 0x9c, 0x75,
+	0x31, 0x25, 0x55, 0x66, 0x20, 0xc4, 0x1b, 0x11, 0x11, 0x5a, 0xd5, 0x7c, 0x0d, 0x34, 0x4a, 0x53,
+	0xa3, 0xba, 0x30, 0xa3, 0x67, 0x66, 0xf4, 0xd5, 0xcc, 0xad, 0xd9, 0xb7, 0xb9, 0x5f, 0x05, 0xcd,
+	0x9e, 0x9a, 0xe9, 0x85, 0x8f, 0x3b, 0x13, 0x37, 0xce, 0x18, 0x9b, 0x3e, 0x8f, 0x71, 0xc7, 0xf0,
+	0xfd, 0x97, 0x47, 0xdb, 0xe3, 0x95, 0x1e, 0xa5, 0xc7, 0xfb, 0x51, 0xff, 0xda, 0x07, 0x87, 0x97,
+	0x71, 0x4c, 0xa8, 0xdb, 0xdb, 0xa5, 0x7b, 0xb0, 0xdf, 0x4e, 0xf3, 0x54, 0xc0, 0xb5, 0xdd, 0xd2,
+	0xcf, 0xa2, 0x3a, 0x8e, 0x66, 0x16, 0xd3, 0x66, 0x09, 0xbf, 0x02, 0x40, 0x99, 0x81, 0x03, 0x11,
+	0x87, 0x97, 0x36, 0x2b, 0xa7, 0x6c, 0xde, 0x95, 0x33, 0x33, 0xff, 0x4e, 0x8b, 0xdf, 0xec, 0x57,
+	0x81, 0x00, 0x90, 0x75, 0xca, 0x6b, 0x00, 0x15, 0xf1, 0x68, 0xf5, 0x0e, 0xae, 0x70, 0x5d, 0x83,
+	0x1b, 0x12, 0xe4, 0x2b, 0xdd, 0xf1, 0xbd, 0x02, 0x50, 0x06, 0x2d, 0x07, 0x85, 0x42, 0x95, 0xda,
+	0x77, 0x5d, 0x7a, 0x06, 0x05, 0xf5, 0x1c, 0x0a, 0xb9, 0x24, 0x65, 0x11, 0x96, 0xa5, 0x98, 0x18,
+	0x33, 0x9c, 0xa8, 0xfd, 0xe7, 0xae, 0x13, 0x6f, 0xf4, 0x66, 0xa7, 0xd1, 0x25, 0x51, 0xde, 0x4c,
+	0xab, 0xa7, 0x1f, 0x5c, 0x6a, 0xb1, 0xfe, 0xef, 0x51, 0x7e, 0x6a, 0x8b, 0xea, 0x3f, 0x00, 0xfc,
+	0x97, 0x9f, 0xb0, 0xbb, 0xc4, 0xbc, 0xee, 0x76, 0x3d, 0x86, 0x45, 0x94, 0x1d, 0xe4, 0x86, 0x8d,
+	0x9c, 0x92, 0x3b, 0x1b, 0xb6, 0xcd, 0xb1, 0x0b, 0x8b, 0x69, 0x76, 0x11, 0x95, 0x31, 0x38, 0x84,
+	0x04, 0xab, 0x15, 0x60, 0xc6, 0x90, 0x87, 0x99, 0x9a, 0xaf, 0x15, 0x1a, 0xc5, 0xe6, 0x3f, 0x32,
+	0xbe, 0x24, 0xc3, 0xd3, 0xcb, 0x2f, 0xb6, 0xaa, 0xb9, 0x4b, 0x39, 0xd6, 0x7a, 0x1c, 0xff, 0xc2,
+	0x5b, 0xfd, 0x13, 0x80, 0xfd, 0x0b, 0x29, 0x84, 0x32, 0x05, 0xff, 0xe2, 0x58, 0x38, 0xe6, 0x1e,
+	0x8b, 0xa6, 0xfa, 0x79, 0x67, 0x62, 0x44, 0x12, 0xcd, 0xba, 0x6e, 0x8c, 0x19, 0x5b, 0x49, 0x62,
+	0x12, 0x7a, 0xcd, 0x2c, 0xb1, 0x5b, 0x83, 0xd5, 0xfc, 0xc5, 0x6a, 0x4e, 0x74, 0xb3, 0xf0, 0xa7,
+	0xbb, 0x59, 0xff, 0x00, 0x20, 0x5c, 0x88, 0x69, 0x2b, 0xba, 0xba, 0xab, 0x3b, 0xb0, 0xec, 0xa5,
+	0x08, 0x56, 0x44, 0x7d, 0xe2, 0xb4, 0xcf, 0xb5, 0x56, 0xe2, 0xd9, 0xcb, 0x3c, 0x39, 0x25, 0x0c,
+	0x70, 0x60, 0xe3, 0x98, 0xf1, 0x57, 0xfe, 0xb7, 0x84, 0x32, 0xb1, 0xee, 0xc2, 0xf2, 0x12, 0xf3,
+	0x56, 0xdb, 0x11, 0xbe, 0xba, 0xe8, 0x1a, 0x2c, 0x07, 0xcc, 0xb3, 0xd2, 0xf7, 0xd1, 0x6a, 0xc5,
+	0xbe, 0x10, 0xdd, 0x84, 0x81, 0xc0, 0x7d, 0x18, 0xfb, 0xe6, 0xe4, 0xee, 0x81, 0x06, 0xf6, 0x0e,
+	0x34, 0xf0, 0xfd, 0x40, 0x03, 0x9b, 0x87, 0x5a, 0x6e, 0xef, 0x50, 0xcb, 0x7d, 0x39, 0xd4, 0x72,
+	0x8f, 0xe4, 0x07, 0x95, 0xb9, 0xeb, 0x3a, 0xa1, 0xc6, 0x46, 0xe7, 0x7b, 0x6b, 0x0f, 0xf0, 0x81,
+	0xdc, 0xfe, 0x19, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x73, 0xd7, 0x54, 0x9a, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -557,6 +685,89 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroupGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GroupPolicy) > 0 {
+		i -= len(m.GroupPolicy)
+		copy(dAtA[i:], m.GroupPolicy)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.GroupPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -654,6 +865,46 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *GroupGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.GroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTypeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1265,6 +1516,266 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroupGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,12 @@
 package feegrant
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +30,46 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	allowances := make(map[[2]string]bool, len(data.Allowances))
+	for _, f := range data.Allowances {
+		allowances[[2]string{f.Granter, f.Grantee}] = true
+	}
+
+	groupGrants := make(map[[2]string]bool, len(data.GroupGrants))
+	for _, g := range data.GroupGrants {
+		key := [2]string{g.Granter, g.GroupPolicy}
+		if !allowances[key] {
+			return errorsmod.Wrapf(ErrNoAllowance, "no allowance of %s to group policy %s", g.Granter, g.GroupPolicy)
+		}
+		if groupGrants[key] {
+			return fmt.Errorf("duplicate group grant of %s to group policy %s", g.Granter, g.GroupPolicy)
+		}
+		groupGrants[key] = true
+
+		for _, member := range g.Members {
+			if member == "" {
+				return fmt.Errorf("empty member of the group grant of %s to group policy %s", g.Granter, g.GroupPolicy)
+			}
+		}
+	}
+
+	msgTypeGrants := make(map[[2]string]bool, len(data.MsgTypeGrants))
+	for _, g := range data.MsgTypeGrants {
+		if g.Granter == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "empty granter of message type grant")
+		}
+		if g.MsgTypeUrl == "" {
+			return errorsmod.Wrap(ErrNoMessages, "message type URL cannot be empty")
+		}
+
+		key := [2]string{g.Granter, g.MsgTypeUrl}
+		if msgTypeGrants[key] {
+			return fmt.Errorf("duplicate message type grant of %s to %s", g.Granter, g.MsgTypeUrl)
+		}
+		msgTypeGrants[key] = true
+	}
+
 	return nil
 }
 
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasAccountState reports whether addr was granted a fee allowance. It
+// implements the bank AccountStateCheckFn, so that such grantees aren't reaped
+// as dust. Allowances are keyed by grantee first, so the lookup is a prefix scan.
+func (k Keeper) HasAccountState(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	iter, err := k.FeeAllowance.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](addr))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
@@ -92,6 +92,53 @@ func TestImportExportGenesis(t *testing.T) {
 	assert.DeepEqual(t, genesis, newGenesis)
 }
 
+func TestImportExportGroupGrants(t *testing.T) {
+	f := initFixture(t)
+
+	groupPolicyAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgTypeURL := sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})
+	msgTypeGranteeAddr := feegrant.MsgTypeGrantee(msgTypeURL)
+	for _, addr := range []sdk.AccAddress{groupPolicyAddr, msgTypeGranteeAddr} {
+		f.accountKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+
+	ac := f.accountKeeper.AddressCodec()
+	granter, err := ac.BytesToString(granterAddr)
+	assert.NilError(t, err)
+	member, err := ac.BytesToString(granteeAddr)
+	assert.NilError(t, err)
+	groupPolicy, err := ac.BytesToString(groupPolicyAddr)
+	assert.NilError(t, err)
+	msgTypeGrantee, err := ac.BytesToString(msgTypeGranteeAddr)
+	assert.NilError(t, err)
+
+	allowance, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000)))})
+	assert.NilError(t, err)
+	genesis := &feegrant.GenesisState{
+		Allowances: []feegrant.Grant{
+			{Granter: granter, Grantee: groupPolicy, Allowance: allowance},
+			{Granter: granter, Grantee: msgTypeGrantee, Allowance: allowance},
+		},
+		GroupGrants:   []feegrant.GroupGrant{{Granter: granter, GroupPolicy: groupPolicy, Members: []string{member}}},
+		MsgTypeGrants: []feegrant.MsgTypeGrant{{Granter: granter, MsgTypeUrl: msgTypeURL}},
+	}
+	assert.NilError(t, feegrant.ValidateGenesis(*genesis))
+
+	assert.NilError(t, f.feegrantKeeper.InitGenesis(f.ctx, genesis))
+	members, err := f.feegrantKeeper.GetGroupGrantMembers(f.ctx, granterAddr, groupPolicyAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []sdk.AccAddress{granteeAddr}, members)
+
+	newGenesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis.GroupGrants, newGenesis.GroupGrants)
+	assert.DeepEqual(t, genesis.MsgTypeGrants, newGenesis.MsgTypeGrants)
+
+	// a group grant without its allowance is invalid
+	genesis.Allowances = genesis.Allowances[1:]
+	assert.ErrorIs(t, feegrant.ValidateGenesis(*genesis), feegrant.ErrNoAllowance)
+}
+
 func TestInitGenesis(t *testing.T) {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	assert.NilError(t, err)
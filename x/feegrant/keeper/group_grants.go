@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantGroupAllowance grants feeAllowance to the members of the group of the
+// group policy at groupPolicy, so that sponsors don't grant an allowance to
+// each member. The members share the allowance, which can be revoked as the
+// allowance of groupPolicy. Members joining the group after the grant use the
+// allowance once it is synced with SyncGroupAllowance.
+func (k Keeper) GrantGroupAllowance(ctx context.Context, granter, groupPolicy sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if k.groupKeeper == nil {
+		return feegrant.ErrGroupsNotEnabled
+	}
+
+	// fails if groupPolicy is not a group policy
+	members, err := k.groupKeeper.GroupPolicyMembers(ctx, groupPolicy)
+	if err != nil {
+		return errorsmod.Wrapf(err, "group policy %s", groupPolicy)
+	}
+
+	if err := k.GrantAllowance(ctx, granter, groupPolicy, feeAllowance); err != nil {
+		return err
+	}
+
+	if err := k.GroupGrants.Set(ctx, collections.Join(granter, groupPolicy)); err != nil {
+		return err
+	}
+
+	return k.setGroupGrantMembers(ctx, granter, groupPolicy, members)
+}
+
+// SyncGroupAllowance updates the members using the allowance of granter to
+// groupPolicy with the current members of its group.
+func (k Keeper) SyncGroupAllowance(ctx context.Context, granter, groupPolicy sdk.AccAddress) error {
+	if k.groupKeeper == nil {
+		return feegrant.ErrGroupsNotEnabled
+	}
+
+	has, err := k.GroupGrants.Has(ctx, collections.Join(granter, groupPolicy))
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(feegrant.ErrNoAllowance, "no group allowance granted to %s", groupPolicy)
+	}
+
+	members, err := k.groupKeeper.GroupPolicyMembers(ctx, groupPolicy)
+	if err != nil {
+		return errorsmod.Wrapf(err, "group policy %s", groupPolicy)
+	}
+
+	if err := k.removeGroupGrantMembers(ctx, granter, groupPolicy); err != nil {
+		return err
+	}
+
+	return k.setGroupGrantMembers(ctx, granter, groupPolicy, members)
+}
+
+// GetGroupGrantMembers returns the members using the allowance of granter to
+// groupPolicy.
+func (k Keeper) GetGroupGrantMembers(ctx context.Context, granter, groupPolicy sdk.AccAddress) ([]sdk.AccAddress, error) {
+	it, err := k.GroupGrantMembers.Indexes.Policy.MatchExact(ctx, collections.Join(granter, groupPolicy))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := it.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]sdk.AccAddress, len(keys))
+	for i, key := range keys {
+		members[i] = key.K2()
+	}
+
+	return members, nil
+}
+
+// setGroupGrantMembers sets the members using the allowance of granter to
+// groupPolicy. A member of several groups whose policies granter granted an
+// allowance to uses the allowance of the last one set.
+func (k Keeper) setGroupGrantMembers(ctx context.Context, granter, groupPolicy sdk.AccAddress, members []sdk.AccAddress) error {
+	for _, member := range members {
+		if err := k.GroupGrantMembers.Set(ctx, collections.Join(granter, member), groupPolicy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeGroupGrantMembers removes the members using the allowance of granter
+// to groupPolicy.
+func (k Keeper) removeGroupGrantMembers(ctx context.Context, granter, groupPolicy sdk.AccAddress) error {
+	members, err := k.GetGroupGrantMembers(ctx, granter, groupPolicy)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if err := k.GroupGrantMembers.Remove(ctx, collections.Join(granter, member)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GrantMsgTypeAllowance grants feeAllowance to any account executing messages
+// of type msgTypeURL: the allowance pays the fees of txs whose messages are all
+// of that type. The accounts share the allowance, which can be revoked as the
+// allowance of feegrant.MsgTypeGrantee(msgTypeURL).
+func (k Keeper) GrantMsgTypeAllowance(ctx context.Context, granter sdk.AccAddress, msgTypeURL string, feeAllowance feegrant.FeeAllowanceI) error {
+	if msgTypeURL == "" {
+		return errorsmod.Wrap(feegrant.ErrNoMessages, "message type URL cannot be empty")
+	}
+
+	grantee := feegrant.MsgTypeGrantee(msgTypeURL)
+	if err := k.GrantAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
+
+	return k.MsgTypeGrants.Set(ctx, collections.Join(granter, grantee), msgTypeURL)
+}
+
+// resolveGranteeGroup returns the grantee of an allowance of granter covering
+// grantee without being granted to it: the allowance granted to the accounts
+// executing msgs if they are all of the same type, or else the allowance
+// granted to the group policy grantee uses the allowance of, if it is still a
+// member of its group.
+func (k Keeper) resolveGranteeGroup(ctx context.Context, granter, grantee sdk.AccAddress, msgs []sdk.Msg) (sdk.AccAddress, bool, error) {
+	if msgTypeURL, ok := singleMsgType(msgs); ok {
+		msgTypeGrantee := feegrant.MsgTypeGrantee(msgTypeURL)
+		has, err := k.MsgTypeGrants.Has(ctx, collections.Join(granter, msgTypeGrantee))
+		if err != nil || has {
+			return msgTypeGrantee, has, err
+		}
+	}
+
+	if k.groupKeeper == nil {
+		return nil, false, nil
+	}
+
+	groupPolicy, err := k.GroupGrantMembers.Get(ctx, collections.Join(granter, grantee))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	isMember, err := k.groupKeeper.IsGroupPolicyMember(ctx, groupPolicy, grantee)
+	if err != nil {
+		// the group policy may have been removed
+		return nil, false, nil
+	}
+
+	return groupPolicy, isMember, nil
+}
+
+// removeGranteeGroup removes the group policy or message type the allowance of
+// granter to grantee was granted to, if any.
+func (k Keeper) removeGranteeGroup(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	has, err := k.GroupGrants.Has(ctx, collections.Join(granter, grantee))
+	if err != nil {
+		return err
+	}
+	if has {
+		if err := k.removeGroupGrantMembers(ctx, granter, grantee); err != nil {
+			return err
+		}
+		if err := k.GroupGrants.Remove(ctx, collections.Join(granter, grantee)); err != nil {
+			return err
+		}
+	}
+
+	return k.MsgTypeGrants.Remove(ctx, collections.Join(granter, grantee))
+}
+
+// singleMsgType returns the type URL of msgs if they are all of the same type.
+func singleMsgType(msgs []sdk.Msg) (string, bool) {
+	if len(msgs) == 0 {
+		return "", false
+	}
+
+	typeURL := sdk.MsgTypeURL(msgs[0])
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != typeURL {
+			return "", false
+		}
+	}
+
+	return typeURL, true
+}
+
+// groupGrant returns the record of the allowance of granter to groupPolicy.
+func (k Keeper) groupGrant(ctx context.Context, granter, groupPolicy sdk.AccAddress) (*feegrant.GroupGrant, error) {
+	granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return nil, err
+	}
+	groupPolicyStr, err := k.authKeeper.AddressCodec().BytesToString(groupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := k.GetGroupGrantMembers(ctx, granter, groupPolicy)
+	if err != nil {
+		return nil, err
+	}
+	memberStrs := make([]string, len(members))
+	for i, member := range members {
+		memberStrs[i], err = k.authKeeper.AddressCodec().BytesToString(member)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &feegrant.GroupGrant{Granter: granterStr, GroupPolicy: groupPolicyStr, Members: memberStrs}, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/keeper"
+	feegranttestutil "cosmossdk.io/x/feegrant/testutil"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestGroupAllowance() {
+	granter, groupPolicy, member, newMember, outsider := suite.addrs[0], suite.addrs[10], suite.addrs[11], suite.addrs[12], suite.addrs[13]
+	k := suite.feegrantKeeper
+	allowance, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	grantMsg := &feegrant.MsgGrantGroupAllowance{
+		Granter:     suite.encodedAddrs[0],
+		GroupPolicy: suite.encodedAddrs[10],
+		Allowance:   allowance,
+	}
+
+	_, err = suite.msgSrvr.GrantGroupAllowance(suite.ctx, grantMsg)
+	suite.Require().ErrorIs(err, feegrant.ErrGroupsNotEnabled)
+
+	members := []sdk.AccAddress{member}
+	groupKeeper := feegranttestutil.NewMockGroupKeeper(gomock.NewController(suite.T()))
+	groupKeeper.EXPECT().GroupPolicyMembers(gomock.Any(), groupPolicy).DoAndReturn(func(_ context.Context, _ sdk.AccAddress) ([]sdk.AccAddress, error) {
+		return members, nil
+	}).AnyTimes()
+	groupKeeper.EXPECT().GroupPolicyMembers(gomock.Any(), gomock.Any()).Return(nil, errors.New("not a group policy")).AnyTimes()
+	groupKeeper.EXPECT().IsGroupPolicyMember(gomock.Any(), groupPolicy, gomock.Any()).DoAndReturn(func(_ context.Context, _, addr sdk.AccAddress) (bool, error) {
+		for _, m := range members {
+			if m.Equals(addr) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}).AnyTimes()
+	k.SetGroupKeeper(groupKeeper)
+	msgSrvr := keeper.NewMsgServerImpl(k)
+
+	_, err = msgSrvr.GrantGroupAllowance(suite.ctx, &feegrant.MsgGrantGroupAllowance{
+		Granter:     suite.encodedAddrs[0],
+		GroupPolicy: suite.encodedAddrs[13],
+		Allowance:   allowance,
+	})
+	suite.Require().Error(err)
+	_, err = msgSrvr.GrantGroupAllowance(suite.ctx, grantMsg)
+	suite.Require().NoError(err)
+
+	// members use the allowance of the group policy, other accounts can't
+	msgs := []sdk.Msg{testdata.NewTestMsg(member)}
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, member, fee, msgs))
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, outsider, fee, msgs))
+
+	remaining, err := k.GetAllowance(suite.ctx, granter, groupPolicy)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(fee...), remaining.(*feegrant.BasicAllowance).SpendLimit)
+
+	// members joining the group use the allowance once it is synced, members
+	// leaving it no longer do
+	members = []sdk.AccAddress{newMember}
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, newMember, fee, msgs))
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, member, fee, msgs))
+	_, err = msgSrvr.SyncGroupAllowance(suite.ctx, &feegrant.MsgSyncGroupAllowance{
+		Granter:     suite.encodedAddrs[0],
+		GroupPolicy: suite.encodedAddrs[10],
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, newMember, fee, msgs))
+
+	res, err := k.GroupAllowances(suite.ctx, &feegrant.QueryGroupAllowancesRequest{Granter: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*feegrant.GroupGrant{{
+		Granter:     suite.encodedAddrs[0],
+		GroupPolicy: suite.encodedAddrs[10],
+		Members:     []string{suite.encodedAddrs[12]},
+	}}, res.GroupGrants)
+
+	// revoking the allowance of the group policy revokes it for its members
+	_, err = msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[10],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, newMember, fee, msgs))
+	has, err := k.GroupGrants.Has(suite.ctx, collections.Join(granter, groupPolicy))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = k.GroupGrantMembers.Has(suite.ctx, collections.Join(granter, newMember))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	_, err = msgSrvr.SyncGroupAllowance(suite.ctx, &feegrant.MsgSyncGroupAllowance{
+		Granter:     suite.encodedAddrs[0],
+		GroupPolicy: suite.encodedAddrs[10],
+	})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+}
+
+func (suite *KeeperTestSuite) TestMsgTypeAllowance() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	k := suite.feegrantKeeper
+	allowance := &feegrant.BasicAllowance{SpendLimit: suite.atom}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+
+	msg := testdata.NewTestMsg(grantee)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	msgTypeGrantee := feegrant.MsgTypeGrantee(msgTypeURL)
+	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), msgTypeGrantee).Return(authtypes.NewBaseAccountWithAddress(msgTypeGrantee)).AnyTimes()
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantMsgTypeAllowance(suite.ctx, &feegrant.MsgGrantMsgTypeAllowance{
+		Granter:   suite.encodedAddrs[0],
+		Allowance: allowanceAny,
+	})
+	suite.Require().Error(err)
+	_, err = suite.msgSrvr.GrantMsgTypeAllowance(suite.ctx, &feegrant.MsgGrantMsgTypeAllowance{
+		Granter:    suite.encodedAddrs[0],
+		MsgTypeUrl: msgTypeURL,
+		Allowance:  allowanceAny,
+	})
+	suite.Require().NoError(err)
+
+	res, err := k.MsgTypeAllowances(suite.ctx, &feegrant.QueryMsgTypeAllowancesRequest{Granter: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*feegrant.MsgTypeGrant{{Granter: suite.encodedAddrs[0], MsgTypeUrl: msgTypeURL}}, res.MsgTypeGrants)
+
+	// any account executing messages of the type uses the allowance
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, fee, []sdk.Msg{msg, msg}))
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, grantee, fee, []sdk.Msg{msg, &feegrant.MsgPruneAllowances{}}))
+
+	remaining, err := k.GetAllowance(suite.ctx, granter, msgTypeGrantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(fee...), remaining.(*feegrant.BasicAllowance).SpendLimit)
+
+	msgTypeGranteeStr, err := suite.accountKeeper.AddressCodec().BytesToString(msgTypeGrantee)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+		Granter: suite.encodedAddrs[0],
+		Grantee: msgTypeGranteeStr,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Error(k.UseGrantedFees(suite.ctx, granter, grantee, fee, []sdk.Msg{msg}))
+	has, err := k.MsgTypeGrants.Has(suite.ctx, collections.Join(granter, msgTypeGrantee))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
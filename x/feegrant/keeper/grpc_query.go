@@ -116,3 +116,51 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// GroupAllowances queries the allowances granted by the given granter to the
+// members of group policies.
+func (q Keeper) GroupAllowances(c context.Context, req *feegrant.QueryGroupAllowancesRequest) (*feegrant.QueryGroupAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(c, q.GroupGrants, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*feegrant.GroupGrant, error) {
+			return q.groupGrant(c, key.K1(), key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](granterAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryGroupAllowancesResponse{GroupGrants: grants, Pagination: pageRes}, nil
+}
+
+// MsgTypeAllowances queries the allowances granted by the given granter to the
+// accounts executing a message type.
+func (q Keeper) MsgTypeAllowances(c context.Context, req *feegrant.QueryMsgTypeAllowancesRequest) (*feegrant.QueryMsgTypeAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(c, q.MsgTypeGrants, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], msgTypeURL string) (*feegrant.MsgTypeGrant, error) {
+			return &feegrant.MsgTypeGrant{Granter: req.Granter, MsgTypeUrl: msgTypeURL}, nil
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](granterAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryMsgTypeAllowancesResponse{MsgTypeGrants: grants, Pagination: pageRes}, nil
+}
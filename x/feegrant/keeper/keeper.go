@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -25,11 +27,29 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
 	authKeeper   feegrant.AccountKeeper
+	groupKeeper  feegrant.GroupKeeper
 	Schema       collections.Schema
 	// FeeAllowance key: grantee+granter | value: Grant
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// GroupGrants key: granter+group policy | value: none
+	GroupGrants collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// MsgTypeGrants key: granter+msg type grantee | value: msg type URL
+	MsgTypeGrants collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], string]
+	// GroupGrantMembers key: granter+member | value: group policy
+	GroupGrantMembers *collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], sdk.AccAddress, GroupGrantMemberIndexes]
+}
+
+// GroupGrantMemberIndexes indexes the members of the group grants by granter
+// and group policy.
+type GroupGrantMemberIndexes struct {
+	// Policy key: granter+group policy | primary key: granter+member
+	Policy *indexes.Multi[collections.Pair[sdk.AccAddress, sdk.AccAddress], collections.Pair[sdk.AccAddress, sdk.AccAddress], sdk.AccAddress]
+}
+
+func (i GroupGrantMemberIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], sdk.AccAddress] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], sdk.AccAddress]{i.Policy}
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,9 +76,48 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feeg
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		GroupGrants: collections.NewKeySet(
+			sb,
+			feegrant.GroupGrantKeyPrefix,
+			"group_grants",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		MsgTypeGrants: collections.NewMap(
+			sb,
+			feegrant.MsgTypeGrantKeyPrefix,
+			"msg_type_grants",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			collections.StringValue,
+		),
+		GroupGrantMembers: collections.NewIndexedMap(
+			sb,
+			feegrant.GroupGrantMemberKeyPrefix,
+			"group_grant_members",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+			GroupGrantMemberIndexes{
+				Policy: indexes.NewMulti(
+					sb,
+					feegrant.GroupGrantMemberByPolicyKeyPrefix,
+					"group_grant_members_by_policy",
+					collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+					collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+					func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], groupPolicy sdk.AccAddress) (collections.Pair[sdk.AccAddress, sdk.AccAddress], error) {
+						return collections.Join(key.K1(), groupPolicy), nil
+					},
+				),
+			},
+		),
 	}
 }
 
+// SetGroupKeeper sets the group keeper resolving the members of the group
+// policies allowances are granted to. It must be called before the keeper is
+// passed to other modules.
+func (k *Keeper) SetGroupKeeper(gk feegrant.GroupKeeper) {
+	k.groupKeeper = gk
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
@@ -184,6 +243,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		}
 	}
 
+	if err := k.removeGranteeGroup(ctx, granter, grantee); err != nil {
+		return err
+	}
+
 	granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
 	if err != nil {
 		return err
@@ -233,9 +296,28 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the granter didn't grant an allowance to the grantee, the allowances it granted to the
+// accounts executing the messages of the tx, or to the group policies the grantee is a member of,
+// are used.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
-	grant, err := k.GetAllowance(ctx, granter, grantee)
+	allowanceGrantee := grantee
+	has, err := k.FeeAllowance.Has(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		groupGrantee, found, err := k.resolveGranteeGroup(ctx, granter, grantee, msgs)
+		if err != nil {
+			return err
+		}
+		if found {
+			allowanceGrantee = groupGrantee
+		}
+	}
+
+	grant, err := k.GetAllowance(ctx, granter, allowanceGrantee)
 	if err != nil {
 		return err
 	}
@@ -248,32 +330,38 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	if err != nil {
 		return err
 	}
+	allowanceGranteeStr, err := k.authKeeper.AddressCodec().BytesToString(allowanceGrantee)
+	if err != nil {
+		return err
+	}
 
 	remove, err := grant.Accept(ctx, fee, msgs)
 	if remove && err == nil {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
-		_ = k.revokeAllowance(ctx, granter, grantee)
-		emitUseGrantEvent(ctx, granterStr, granteeStr)
+		_ = k.revokeAllowance(ctx, granter, allowanceGrantee)
+		emitUseGrantEvent(ctx, granterStr, granteeStr, allowanceGranteeStr)
 
 		return nil
 	}
 	if err != nil {
 		return err
 	}
-	emitUseGrantEvent(ctx, granterStr, granteeStr)
+	emitUseGrantEvent(ctx, granterStr, granteeStr, allowanceGranteeStr)
 
 	// if fee allowance is accepted, store the updated state of the allowance
-	return k.UpdateAllowance(ctx, granter, grantee, grant)
+	return k.UpdateAllowance(ctx, granter, allowanceGrantee, grant)
 }
 
-func emitUseGrantEvent(ctx context.Context, granter, grantee string) {
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
-		sdk.NewEvent(
-			feegrant.EventTypeUseFeeGrant,
-			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter),
-			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
-		),
-	)
+func emitUseGrantEvent(ctx context.Context, granter, grantee, allowanceGrantee string) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(feegrant.AttributeKeyGranter, granter),
+		sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
+	}
+	if allowanceGrantee != grantee {
+		attrs = append(attrs, sdk.NewAttribute(feegrant.AttributeKeyAllowanceGrantee, allowanceGrantee))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(feegrant.EventTypeUseFeeGrant, attrs...))
 }
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
@@ -298,6 +386,43 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, g := range data.GroupGrants {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(g.Granter)
+		if err != nil {
+			return err
+		}
+		groupPolicy, err := k.authKeeper.AddressCodec().StringToBytes(g.GroupPolicy)
+		if err != nil {
+			return err
+		}
+		members := make([]sdk.AccAddress, len(g.Members))
+		for i, member := range g.Members {
+			members[i], err = k.authKeeper.AddressCodec().StringToBytes(member)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := k.GroupGrants.Set(ctx, collections.Join[sdk.AccAddress, sdk.AccAddress](granter, groupPolicy)); err != nil {
+			return err
+		}
+		if err := k.setGroupGrantMembers(ctx, granter, groupPolicy, members); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range data.MsgTypeGrants {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(g.Granter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.MsgTypeGrants.Set(ctx, collections.Join[sdk.AccAddress](granter, feegrant.MsgTypeGrantee(g.MsgTypeUrl)), g.MsgTypeUrl); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -309,10 +434,41 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groupGrants []feegrant.GroupGrant
+	err = k.GroupGrants.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		groupGrant, err := k.groupGrant(ctx, key.K1(), key.K2())
+		if err != nil {
+			return true, err
+		}
+		groupGrants = append(groupGrants, *groupGrant)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var msgTypeGrants []feegrant.MsgTypeGrant
+	err = k.MsgTypeGrants.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], msgTypeURL string) (bool, error) {
+		granter, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		msgTypeGrants = append(msgTypeGrants, feegrant.MsgTypeGrant{Granter: granter, MsgTypeUrl: msgTypeURL})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+		Allowances:    grants,
+		GroupGrants:   groupGrants,
+		MsgTypeGrants: msgTypeGrants,
+	}, nil
 }
 
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
@@ -332,6 +488,10 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 			return true, err
 		}
 
+		if err := k.removeGranteeGroup(ctx, granter, grantee); err != nil {
+			return true, err
+		}
+
 		// limit the amount of iterations to avoid taking too much time
 		count++
 		if count == limit {
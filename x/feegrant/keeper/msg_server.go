@@ -104,3 +104,80 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// GrantGroupAllowance grants an allowance from the granter's funds to be used by
+// the members of the group of a group policy.
+func (k msgServer) GrantGroupAllowance(ctx context.Context, msg *feegrant.MsgGrantGroupAllowance) (*feegrant.MsgGrantGroupAllowanceResponse, error) {
+	if strings.EqualFold(msg.GroupPolicy, msg.Granter) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	groupPolicy, err := k.authKeeper.AddressCodec().StringToBytes(msg.GroupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantGroupAllowance(ctx, granter, groupPolicy, allowance); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantGroupAllowanceResponse{}, nil
+}
+
+// SyncGroupAllowance updates the members using the allowance granted to a group
+// policy with the current members of its group.
+func (k msgServer) SyncGroupAllowance(ctx context.Context, msg *feegrant.MsgSyncGroupAllowance) (*feegrant.MsgSyncGroupAllowanceResponse, error) {
+	groupPolicy, err := k.authKeeper.AddressCodec().StringToBytes(msg.GroupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SyncGroupAllowance(ctx, granter, groupPolicy); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgSyncGroupAllowanceResponse{}, nil
+}
+
+// GrantMsgTypeAllowance grants an allowance from the granter's funds to be used
+// by any account executing messages of a type.
+func (k msgServer) GrantMsgTypeAllowance(ctx context.Context, msg *feegrant.MsgGrantMsgTypeAllowance) (*feegrant.MsgGrantMsgTypeAllowanceResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantMsgTypeAllowance(ctx, granter, msg.MsgTypeUrl, allowance); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantMsgTypeAllowanceResponse{}, nil
+}
@@ -2,6 +2,9 @@ package feegrant
 
 import (
 	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -26,4 +29,30 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// GroupGrantKeyPrefix is the set of the kvstore for the grants to the
+	// members of group policies
+	// - 0x02<granter><group_policy>: <empty value>
+	GroupGrantKeyPrefix = collections.NewPrefix(2)
+
+	// MsgTypeGrantKeyPrefix is the set of the kvstore for the grants to the
+	// accounts executing a message type
+	// - 0x03<granter><msg_type_grantee>: <msg_type_url>
+	MsgTypeGrantKeyPrefix = collections.NewPrefix(3)
+
+	// GroupGrantMemberKeyPrefix is the set of the kvstore for the group
+	// policies the members of their group use the grants to
+	// - 0x04<granter><member>: <group_policy>
+	GroupGrantMemberKeyPrefix = collections.NewPrefix(4)
+
+	// GroupGrantMemberByPolicyKeyPrefix is the set of the kvstore indexing the
+	// members of GroupGrantMemberKeyPrefix by group policy
+	// - 0x05<granter><group_policy><granter><member>: <empty value>
+	GroupGrantMemberByPolicyKeyPrefix = collections.NewPrefix(5)
 )
+
+// MsgTypeGrantee returns the grantee of the allowances granted to any account
+// executing messages of type msgTypeURL.
+func MsgTypeGrantee(msgTypeURL string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(msgTypeURL))
+}
@@ -44,6 +44,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "GroupAllowances",
+					Use:       "group-grants-by-granter [granter]",
+					Short:     "Query all grants by a granter to the members of group policies",
+					Example:   fmt.Sprintf(`$ %s query feegrant group-grants-by-granter [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "MsgTypeAllowances",
+					Use:       "msg-type-grants-by-granter [granter]",
+					Short:     "Query all grants by a granter to the accounts executing a message type",
+					Example:   fmt.Sprintf(`$ %s query feegrant msg-type-grants-by-granter [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +85,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired allowances in order to reduce the size of the store when the number of expired allowances is large.",
 					Example:   fmt.Sprintf(`$ %s tx feegrant prune --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod: "SyncGroupAllowance",
+					Use:       "sync-group-grant [granter] [group_policy]",
+					Short:     "Sync the members of a fee grant to a group policy",
+					Long:      "Update the members using the fee grant from a granter to a group policy with the current members of its group. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant sync-group-grant [granter] [group_policy]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "group_policy"},
+					},
+				},
+				{
+					RpcMethod: "GrantGroupAllowance",
+					Skip:      true, // skipped because the grant command covers it
+				},
+				{
+					RpcMethod: "GrantMsgTypeAllowance",
+					Skip:      true, // skipped because the grant command covers it
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
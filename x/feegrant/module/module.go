@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/errors"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/client/cli"
 	"cosmossdk.io/x/feegrant/keeper"
@@ -178,10 +179,22 @@ type FeegrantInputs struct {
 	Registry      cdctypes.InterfaceRegistry
 }
 
-func ProvideModule(in FeegrantInputs) (keeper.Keeper, appmodule.AppModule) {
+type FeegrantOutputs struct {
+	depinject.Out
+
+	FeegrantKeeper    keeper.Keeper
+	Module            appmodule.AppModule
+	AccountStateCheck banktypes.AccountStateCheckWrapper
+}
+
+func ProvideModule(in FeegrantInputs) FeegrantOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.AccountKeeper)
 	m := NewAppModule(in.Cdc, in.AccountKeeper, in.BankKeeper, k, in.Registry)
-	return k, m
+	return FeegrantOutputs{
+		FeegrantKeeper:    k,
+		Module:            m,
+		AccountStateCheck: banktypes.AccountStateCheckWrapper{AccountStateCheckFn: k.HasAccountState},
+	}
 }
 
 // AppModuleSimulation functions
@@ -11,8 +11,9 @@ import (
 )
 
 var (
-	_, _ sdk.Msg                       = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_    types.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_, _    sdk.Msg                       = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _, _ sdk.Msg                       = &MsgGrantGroupAllowance{}, &MsgSyncGroupAllowance{}, &MsgGrantMsgTypeAllowance{}
+	_, _, _ types.UnpackInterfacesMessage = &MsgGrantAllowance{}, &MsgGrantGroupAllowance{}, &MsgGrantMsgTypeAllowance{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -54,3 +55,35 @@ func (msg MsgGrantAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error
 func NewMsgRevokeAllowance(granter, grantee string) MsgRevokeAllowance {
 	return MsgRevokeAllowance{Granter: granter, Grantee: grantee}
 }
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantGroupAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantGroupAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantMsgTypeAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantMsgTypeAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
@@ -353,6 +353,226 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGroupAllowancesRequest is the request type for the Query/GroupAllowances RPC method.
+type QueryGroupAllowancesRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupAllowancesRequest) Reset()         { *m = QueryGroupAllowancesRequest{} }
+func (m *QueryGroupAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowancesRequest) ProtoMessage()    {}
+func (*QueryGroupAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryGroupAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowancesRequest.Merge(m, src)
+}
+func (m *QueryGroupAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryGroupAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupAllowancesResponse is the response type for the Query/GroupAllowances RPC method.
+type QueryGroupAllowancesResponse struct {
+	// group_grants are the allowances granted by the granter to the members of
+	// group policies.
+	GroupGrants []*GroupGrant `protobuf:"bytes,1,rep,name=group_grants,json=groupGrants,proto3" json:"group_grants,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupAllowancesResponse) Reset()         { *m = QueryGroupAllowancesResponse{} }
+func (m *QueryGroupAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowancesResponse) ProtoMessage()    {}
+func (*QueryGroupAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryGroupAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowancesResponse.Merge(m, src)
+}
+func (m *QueryGroupAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAllowancesResponse) GetGroupGrants() []*GroupGrant {
+	if m != nil {
+		return m.GroupGrants
+	}
+	return nil
+}
+
+func (m *QueryGroupAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgTypeAllowancesRequest is the request type for the Query/MsgTypeAllowances RPC method.
+type QueryMsgTypeAllowancesRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeAllowancesRequest) Reset()         { *m = QueryMsgTypeAllowancesRequest{} }
+func (m *QueryMsgTypeAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeAllowancesRequest) ProtoMessage()    {}
+func (*QueryMsgTypeAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QueryMsgTypeAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeAllowancesRequest.Merge(m, src)
+}
+func (m *QueryMsgTypeAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgTypeAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryMsgTypeAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgTypeAllowancesResponse is the response type for the Query/MsgTypeAllowances RPC method.
+type QueryMsgTypeAllowancesResponse struct {
+	// msg_type_grants are the allowances granted by the granter to the accounts
+	// executing a message type.
+	MsgTypeGrants []*MsgTypeGrant `protobuf:"bytes,1,rep,name=msg_type_grants,json=msgTypeGrants,proto3" json:"msg_type_grants,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeAllowancesResponse) Reset()         { *m = QueryMsgTypeAllowancesResponse{} }
+func (m *QueryMsgTypeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeAllowancesResponse) ProtoMessage()    {}
+func (*QueryMsgTypeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
+}
+func (m *QueryMsgTypeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeAllowancesResponse.Merge(m, src)
+}
+func (m *QueryMsgTypeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgTypeAllowancesResponse) GetMsgTypeGrants() []*MsgTypeGrant {
+	if m != nil {
+		return m.MsgTypeGrants
+	}
+	return nil
+}
+
+func (m *QueryMsgTypeAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -360,6 +580,10 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryGroupAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryGroupAllowancesRequest")
+	proto.RegisterType((*QueryGroupAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryGroupAllowancesResponse")
+	proto.RegisterType((*QueryMsgTypeAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryMsgTypeAllowancesRequest")
+	proto.RegisterType((*QueryMsgTypeAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryMsgTypeAllowancesResponse")
 }
 
 func init() {
@@ -367,40 +591,50 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbd, 0x8b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xe7, 0x17, 0x79, 0xaf, 0x1b, 0x3f, 0x2e, 0x2e, 0xb2, 0x86, 0x15, 0xce, 0x2f,
-	0x6e, 0xc6, 0x44, 0x94, 0x13, 0xe4, 0xe0, 0x52, 0x98, 0x56, 0x23, 0x58, 0xd8, 0xc8, 0xe4, 0xf2,
-	0xba, 0x2c, 0xc6, 0x99, 0xdc, 0xce, 0x46, 0x3d, 0xe4, 0x10, 0xfc, 0x0b, 0x04, 0x6d, 0x45, 0xb0,
-	0xb0, 0xd1, 0xd2, 0xd6, 0xde, 0xf2, 0xd0, 0xc6, 0x52, 0x12, 0xff, 0x10, 0xc9, 0xec, 0xcc, 0x6e,
-	0xcc, 0x65, 0xc9, 0xa2, 0x16, 0xd7, 0x65, 0xb2, 0xcf, 0xf3, 0xee, 0xef, 0x79, 0xdf, 0x99, 0x59,
-	0x38, 0xb7, 0xa5, 0xf4, 0x63, 0xa5, 0xf9, 0x43, 0xc4, 0x30, 0x16, 0x32, 0xe1, 0x4f, 0x1a, 0x5d,
-	0x4c, 0x44, 0x83, 0x6f, 0x0f, 0x31, 0xde, 0x61, 0x83, 0x58, 0x25, 0x8a, 0xae, 0xa4, 0x22, 0xe6,
-	0x44, 0xcc, 0x8a, 0xbc, 0xd5, 0x22, 0x77, 0xa6, 0x34, 0x05, 0xbc, 0x4b, 0x56, 0xd7, 0x15, 0x1a,
-	0xd3, 0xca, 0x99, 0x72, 0x20, 0xc2, 0x48, 0x8a, 0x24, 0x52, 0xd2, 0x6a, 0xcf, 0x84, 0x4a, 0x85,
-	0x7d, 0xe4, 0x62, 0x10, 0x71, 0x21, 0xa5, 0x4a, 0xcc, 0x43, 0x6d, 0x9f, 0x9e, 0x4e, 0x2b, 0x3d,
-	0x30, 0x2b, 0x6e, 0xb9, 0xcc, 0x22, 0x78, 0x01, 0x27, 0xef, 0x4c, 0x4a, 0x6f, 0xf6, 0xfb, 0xea,
-	0xa9, 0x90, 0x5b, 0xd8, 0xc1, 0xed, 0x21, 0xea, 0x84, 0x36, 0xe1, 0x98, 0x81, 0xc1, 0xb8, 0x46,
-	0xea, 0xe4, 0x42, 0xb5, 0x55, 0xfb, 0xf6, 0x79, 0xed, 0x84, 0xf5, 0x6e, 0xf6, 0x7a, 0x31, 0x6a,
-	0x7d, 0x37, 0x89, 0x23, 0x19, 0x76, 0x9c, 0x30, 0xf7, 0x60, 0x6d, 0xa9, 0x9c, 0x07, 0x83, 0x7b,
-	0x70, 0x6a, 0x16, 0x40, 0x0f, 0x94, 0xd4, 0x48, 0x6f, 0x42, 0x55, 0xb8, 0x3f, 0x0d, 0xc3, 0x72,
-	0xd3, 0x67, 0x05, 0x4d, 0x65, 0xed, 0xc9, 0xaa, 0x93, 0x1b, 0x82, 0x37, 0x64, 0xb6, 0xb0, 0xde,
-	0x17, 0x0d, 0xcb, 0x46, 0x43, 0x7a, 0x0b, 0x20, 0x6f, 0xba, 0x49, 0xb7, 0xdc, 0x5c, 0x75, 0x34,
-	0x93, 0x09, 0xb1, 0x74, 0xf6, 0x8e, 0xe7, 0xb6, 0x08, 0x5d, 0x2b, 0x3b, 0x53, 0xce, 0xe0, 0x3d,
-	0x81, 0x95, 0x7d, 0x58, 0x36, 0xf0, 0x06, 0x40, 0xc6, 0xaf, 0x6b, 0xa4, 0x7e, 0xa8, 0x44, 0xe2,
-	0x29, 0x07, 0x6d, 0xcf, 0x61, 0x3c, 0xbf, 0x90, 0x31, 0x7d, 0xf9, 0x1f, 0x90, 0x6f, 0x09, 0x9c,
-	0x9d, 0x81, 0x6c, 0xed, 0xb4, 0xd3, 0x21, 0xff, 0xcb, 0xfe, 0xf8, 0x5f, 0x4d, 0xfc, 0x48, 0xa0,
-	0x5e, 0xcc, 0x77, 0xc0, 0xba, 0xd9, 0x7c, 0x77, 0x18, 0x8e, 0x18, 0x5a, 0xfa, 0x89, 0x40, 0x35,
-	0x43, 0xa6, 0xac, 0x10, 0x66, 0xee, 0x89, 0xf4, 0x78, 0x69, 0x7d, 0x0a, 0x11, 0x6c, 0xbc, 0xfc,
-	0xfe, 0xeb, 0xf5, 0xd2, 0x3a, 0xbd, 0xce, 0x8b, 0x6e, 0x9c, 0x2c, 0x2e, 0x7f, 0x6e, 0x67, 0xb4,
-	0xeb, 0x7e, 0xe1, 0x2e, 0xfd, 0x40, 0x00, 0xf2, 0x0e, 0xd3, 0xb2, 0xef, 0x77, 0xe7, 0xcc, 0xbb,
-	0x52, 0xde, 0x60, 0x89, 0xaf, 0x19, 0x62, 0x4e, 0xd7, 0x16, 0x13, 0xeb, 0x29, 0xd0, 0x2f, 0x04,
-	0x8e, 0xcf, 0xd9, 0x0a, 0x74, 0xbd, 0x2c, 0xc0, 0xec, 0xee, 0xf6, 0x6e, 0xfc, 0x85, 0xd3, 0x66,
-	0x68, 0x98, 0x0c, 0x97, 0xe9, 0xc5, 0xc2, 0x0c, 0x91, 0xd6, 0x43, 0xec, 0xe5, 0x2d, 0x6f, 0x35,
-	0xbe, 0x8e, 0x7c, 0xb2, 0x37, 0xf2, 0xc9, 0xcf, 0x91, 0x4f, 0x5e, 0x8d, 0xfd, 0xca, 0xde, 0xd8,
-	0xaf, 0xfc, 0x18, 0xfb, 0x95, 0xfb, 0xf6, 0x23, 0xa2, 0x7b, 0x8f, 0x58, 0xa4, 0xf8, 0xb3, 0xac,
-	0x56, 0xf7, 0xa8, 0xb9, 0xbe, 0xaf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x3a, 0x28, 0x67,
-	0x8b, 0x06, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfd, 0xf1, 0xb3, 0xe4, 0x89, 0x52, 0x1c, 0xff, 0x34, 0xae, 0x75, 0x0d, 0x29,
+	0xd6, 0x7f, 0x74, 0xc7, 0xa4, 0xa6, 0x56, 0x94, 0x42, 0x73, 0x68, 0x4e, 0x05, 0x8d, 0xe2, 0xc1,
+	0x4b, 0xd8, 0x34, 0xe3, 0xb2, 0x98, 0xec, 0x6c, 0x77, 0x36, 0x6a, 0x90, 0x20, 0xf8, 0x0a, 0x04,
+	0xbd, 0x88, 0x78, 0x11, 0xf1, 0xa2, 0xe0, 0xc5, 0x83, 0x20, 0xde, 0x3c, 0x78, 0x2c, 0x7a, 0xf1,
+	0x28, 0x89, 0x2f, 0x44, 0x32, 0x3b, 0xbb, 0x49, 0x93, 0xdd, 0x74, 0xab, 0x01, 0x7b, 0xdb, 0xd9,
+	0x79, 0xbe, 0xcf, 0x7c, 0x9e, 0xef, 0xcc, 0x3e, 0x3b, 0x30, 0xb7, 0xc1, 0x78, 0x9d, 0x71, 0x72,
+	0x87, 0x52, 0xc3, 0xd1, 0x2d, 0x97, 0xdc, 0xcb, 0x56, 0xa8, 0xab, 0x67, 0xc9, 0x66, 0x83, 0x3a,
+	0x4d, 0xcd, 0x76, 0x98, 0xcb, 0xf0, 0x8c, 0x17, 0xa4, 0xf9, 0x41, 0x9a, 0x0c, 0x52, 0xe6, 0xa3,
+	0xd4, 0x41, 0xa4, 0x48, 0xa0, 0x9c, 0x93, 0x71, 0x15, 0x9d, 0x53, 0x2f, 0x73, 0x10, 0x69, 0xeb,
+	0x86, 0x69, 0xe9, 0xae, 0xc9, 0x2c, 0x19, 0x3b, 0x6b, 0x30, 0x66, 0xd4, 0x28, 0xd1, 0x6d, 0x93,
+	0xe8, 0x96, 0xc5, 0x5c, 0x31, 0xc9, 0xe5, 0xec, 0x31, 0x2f, 0x53, 0x59, 0x8c, 0x88, 0xe4, 0x12,
+	0x83, 0xcc, 0x23, 0x38, 0x72, 0xbd, 0x9b, 0x7a, 0xb5, 0x56, 0x63, 0xf7, 0x75, 0x6b, 0x83, 0x96,
+	0xe8, 0x66, 0x83, 0x72, 0x17, 0xe7, 0x60, 0x4a, 0xc0, 0x50, 0x27, 0x85, 0xd2, 0xe8, 0x4c, 0xa2,
+	0x90, 0xfa, 0xf6, 0x61, 0xe1, 0xb0, 0xd4, 0xae, 0x56, 0xab, 0x0e, 0xe5, 0xfc, 0x86, 0xeb, 0x98,
+	0x96, 0x51, 0xf2, 0x03, 0x7b, 0x1a, 0x9a, 0x9a, 0x8c, 0xa7, 0xa1, 0x99, 0x5b, 0x70, 0x74, 0x10,
+	0x80, 0xdb, 0xcc, 0xe2, 0x14, 0x5f, 0x85, 0x84, 0xee, 0xbf, 0x14, 0x0c, 0xc9, 0x9c, 0xaa, 0x45,
+	0x98, 0xaa, 0x15, 0xbb, 0xa3, 0x52, 0x4f, 0x90, 0x79, 0x86, 0x06, 0x13, 0xf3, 0xa1, 0xd2, 0x68,
+	0xdc, 0xd2, 0x28, 0x5e, 0x03, 0xe8, 0x99, 0x2e, 0xaa, 0x4b, 0xe6, 0xe6, 0x7d, 0x9a, 0xee, 0x0e,
+	0x69, 0xde, 0xde, 0xfb, 0x3c, 0xd7, 0x74, 0xc3, 0xb7, 0xb2, 0xd4, 0xa7, 0xcc, 0xbc, 0x42, 0x30,
+	0x33, 0x84, 0x25, 0x0b, 0x5e, 0x01, 0x08, 0xf8, 0x79, 0x0a, 0xa5, 0xff, 0x8b, 0x51, 0x71, 0x9f,
+	0x02, 0x17, 0x43, 0x18, 0x4f, 0xef, 0xc8, 0xe8, 0x2d, 0xbe, 0x0d, 0xf2, 0x25, 0x82, 0x93, 0x03,
+	0x90, 0x85, 0x66, 0xd1, 0xdb, 0xe4, 0xbf, 0x39, 0x1f, 0xe3, 0x32, 0xf1, 0x2d, 0x82, 0x74, 0x34,
+	0xdf, 0x5e, 0x73, 0xf3, 0x39, 0x82, 0xe3, 0x82, 0xb6, 0xe8, 0xb0, 0x86, 0x3d, 0xe2, 0x38, 0xfe,
+	0x03, 0x27, 0xdf, 0x23, 0x98, 0x0d, 0x67, 0x93, 0x2e, 0xae, 0xc1, 0x7e, 0xa3, 0x3b, 0x55, 0x16,
+	0x2b, 0xfb, 0x3e, 0xce, 0x8d, 0xf0, 0x91, 0x35, 0x6c, 0xcf, 0xcc, 0xa4, 0x11, 0x3c, 0x8f, 0xd1,
+	0xcd, 0x17, 0x08, 0x4e, 0x08, 0xe2, 0x75, 0x6e, 0xdc, 0x6c, 0xda, 0x74, 0x6f, 0xf9, 0xf9, 0x11,
+	0x81, 0x1a, 0x45, 0x27, 0x1d, 0x5d, 0x87, 0xe9, 0x3a, 0x37, 0xca, 0x6e, 0xd3, 0xa6, 0xdb, 0x4d,
+	0x3d, 0x15, 0x69, 0xaa, 0x4c, 0xe6, 0xd9, 0x7a, 0xa0, 0xde, 0x37, 0x1a, 0x9f, 0xb1, 0xb9, 0xd7,
+	0x53, 0xf0, 0xbf, 0x40, 0xc7, 0xef, 0x10, 0x24, 0x02, 0x70, 0xac, 0x45, 0x62, 0x85, 0xfe, 0x38,
+	0x14, 0x12, 0x3b, 0xde, 0x83, 0xc8, 0xac, 0x3c, 0xfe, 0xfe, 0xeb, 0xe9, 0xe4, 0x32, 0x5e, 0x22,
+	0x51, 0x3f, 0xc6, 0xe0, 0xab, 0x24, 0x0f, 0xe5, 0x86, 0xb5, 0xfc, 0x27, 0xda, 0xc2, 0x6f, 0x10,
+	0x40, 0xcf, 0x67, 0x1c, 0x77, 0x7d, 0xff, 0xbc, 0x28, 0x17, 0xe2, 0x0b, 0x24, 0x71, 0x5e, 0x10,
+	0x13, 0xbc, 0xb0, 0x33, 0x31, 0xef, 0x03, 0xfd, 0x8c, 0xe0, 0x50, 0x48, 0xc7, 0xc2, 0xcb, 0x71,
+	0x01, 0x06, 0x9b, 0xb0, 0x72, 0xf9, 0x0f, 0x94, 0xb2, 0x86, 0xac, 0xa8, 0xe1, 0x3c, 0x3e, 0x1b,
+	0x59, 0x83, 0xc9, 0x79, 0x83, 0x56, 0x7b, 0x96, 0xe3, 0x4f, 0x08, 0xa6, 0x07, 0xfa, 0x04, 0xbe,
+	0x38, 0x9a, 0x20, 0xbc, 0xe5, 0x29, 0xf9, 0x5d, 0xaa, 0x24, 0xf3, 0x15, 0xc1, 0x9c, 0xc7, 0x8b,
+	0x91, 0xcc, 0x5e, 0xaf, 0x1a, 0x76, 0xdf, 0x69, 0xe1, 0x2f, 0x08, 0x0e, 0x0e, 0x7d, 0x95, 0x78,
+	0x69, 0x34, 0x49, 0x54, 0x93, 0x51, 0x2e, 0xed, 0x5a, 0x17, 0xfb, 0xb4, 0x07, 0xdd, 0x21, 0xac,
+	0x8c, 0x42, 0xf6, 0x6b, 0x5b, 0x45, 0x5b, 0x6d, 0x15, 0xfd, 0x6c, 0xab, 0xe8, 0x49, 0x47, 0x9d,
+	0xd8, 0xea, 0xa8, 0x13, 0x3f, 0x3a, 0xea, 0xc4, 0x6d, 0x79, 0xe1, 0xe4, 0xd5, 0xbb, 0x9a, 0xc9,
+	0xc8, 0x83, 0x20, 0x71, 0x65, 0x9f, 0xb8, 0xea, 0x2d, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50,
+	0x50, 0xba, 0xf8, 0xb7, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +657,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GroupAllowances returns the allowances given by an address to the members
+	// of group policies.
+	GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error)
+	// MsgTypeAllowances returns the allowances given by an address to the
+	// accounts executing a message type.
+	MsgTypeAllowances(ctx context.Context, in *QueryMsgTypeAllowancesRequest, opts ...grpc.CallOption) (*QueryMsgTypeAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -460,6 +700,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) GroupAllowances(ctx context.Context, in *QueryGroupAllowancesRequest, opts ...grpc.CallOption) (*QueryGroupAllowancesResponse, error) {
+	out := new(QueryGroupAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/GroupAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgTypeAllowances(ctx context.Context, in *QueryMsgTypeAllowancesRequest, opts ...grpc.CallOption) (*QueryMsgTypeAllowancesResponse, error) {
+	out := new(QueryMsgTypeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/MsgTypeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allwance to the grantee by the granter.
@@ -470,6 +728,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GroupAllowances returns the allowances given by an address to the members
+	// of group policies.
+	GroupAllowances(context.Context, *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error)
+	// MsgTypeAllowances returns the allowances given by an address to the
+	// accounts executing a message type.
+	MsgTypeAllowances(context.Context, *QueryMsgTypeAllowancesRequest) (*QueryMsgTypeAllowancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -485,6 +749,12 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) GroupAllowances(ctx context.Context, req *QueryGroupAllowancesRequest) (*QueryGroupAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowances not implemented")
+}
+func (*UnimplementedQueryServer) MsgTypeAllowances(ctx context.Context, req *QueryMsgTypeAllowancesRequest) (*QueryMsgTypeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeAllowances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -544,6 +814,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/GroupAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAllowances(ctx, req.(*QueryGroupAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTypeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/MsgTypeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeAllowances(ctx, req.(*QueryMsgTypeAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -560,6 +866,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "GroupAllowances",
+			Handler:    _Query_GroupAllowances_Handler,
+		},
+		{
+			MethodName: "MsgTypeAllowances",
+			Handler:    _Query_MsgTypeAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -819,52 +1133,234 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGroupAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGroupAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGroupAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGroupAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupGrants) > 0 {
+		for iNdEx := len(m.GroupGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeGrants) > 0 {
+		for iNdEx := len(m.MsgTypeGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Grantee)
 	if l > 0 {
@@ -889,56 +1385,566 @@ func (m *QueryAllowancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupGrants) > 0 {
+		for _, e := range m.GroupGrants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTypeAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTypeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeGrants) > 0 {
+		for _, e := range m.MsgTypeGrants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllowancesByGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,10 +1967,10 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1001,9 +2007,9 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1013,23 +2019,27 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1052,7 +2062,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,15 +2085,15 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1110,10 +2120,44 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1138,7 +2182,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1161,15 +2205,15 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1197,7 +2241,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1256,7 +2300,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1279,15 +2323,15 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupGrants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1314,8 +2358,8 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroupGrants = append(m.GroupGrants, &GroupGrant{})
+			if err := m.GroupGrants[len(m.GroupGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1376,7 +2420,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgTypeAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,10 +2443,10 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgTypeAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgTypeAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1494,7 +2538,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgTypeAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,15 +2561,15 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgTypeAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgTypeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeGrants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1552,8 +2596,8 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgTypeGrants = append(m.MsgTypeGrants, &MsgTypeGrant{})
+			if err := m.MsgTypeGrants[len(m.MsgTypeGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
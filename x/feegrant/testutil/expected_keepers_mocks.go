@@ -168,3 +168,56 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
+
+// MockGroupKeeper is a mock of GroupKeeper interface.
+type MockGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupKeeperMockRecorder
+}
+
+// MockGroupKeeperMockRecorder is the mock recorder for MockGroupKeeper.
+type MockGroupKeeperMockRecorder struct {
+	mock *MockGroupKeeper
+}
+
+// NewMockGroupKeeper creates a new mock instance.
+func NewMockGroupKeeper(ctrl *gomock.Controller) *MockGroupKeeper {
+	mock := &MockGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupKeeper) EXPECT() *MockGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GroupPolicyMembers mocks base method.
+func (m *MockGroupKeeper) GroupPolicyMembers(ctx context.Context, groupPolicy types.AccAddress) ([]types.AccAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPolicyMembers", ctx, groupPolicy)
+	ret0, _ := ret[0].([]types.AccAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupPolicyMembers indicates an expected call of GroupPolicyMembers.
+func (mr *MockGroupKeeperMockRecorder) GroupPolicyMembers(ctx, groupPolicy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPolicyMembers", reflect.TypeOf((*MockGroupKeeper)(nil).GroupPolicyMembers), ctx, groupPolicy)
+}
+
+// IsGroupPolicyMember mocks base method.
+func (m *MockGroupKeeper) IsGroupPolicyMember(ctx context.Context, groupPolicy, member types.AccAddress) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsGroupPolicyMember", ctx, groupPolicy, member)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsGroupPolicyMember indicates an expected call of IsGroupPolicyMember.
+func (mr *MockGroupKeeperMockRecorder) IsGroupPolicyMember(ctx, groupPolicy, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsGroupPolicyMember", reflect.TypeOf((*MockGroupKeeper)(nil).IsGroupPolicyMember), ctx, groupPolicy, member)
+}
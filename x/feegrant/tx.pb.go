@@ -312,6 +312,305 @@ func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
 
+// MsgGrantGroupAllowance adds permission for the members of the group of
+// GroupPolicy to spend up to Allowance of fees from the account of Granter.
+type MsgGrantGroupAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// group_policy is the address of the group policy whose group members are
+	// granted the allowance.
+	GroupPolicy string `protobuf:"bytes,2,opt,name=group_policy,json=groupPolicy,proto3" json:"group_policy,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *types.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (m *MsgGrantGroupAllowance) Reset()         { *m = MsgGrantGroupAllowance{} }
+func (m *MsgGrantGroupAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantGroupAllowance) ProtoMessage()    {}
+func (*MsgGrantGroupAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgGrantGroupAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantGroupAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantGroupAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantGroupAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantGroupAllowance.Merge(m, src)
+}
+func (m *MsgGrantGroupAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantGroupAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantGroupAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantGroupAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantGroupAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgGrantGroupAllowance) GetGroupPolicy() string {
+	if m != nil {
+		return m.GroupPolicy
+	}
+	return ""
+}
+
+func (m *MsgGrantGroupAllowance) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+// MsgGrantGroupAllowanceResponse defines the Msg/GrantGroupAllowance response type.
+type MsgGrantGroupAllowanceResponse struct {
+}
+
+func (m *MsgGrantGroupAllowanceResponse) Reset()         { *m = MsgGrantGroupAllowanceResponse{} }
+func (m *MsgGrantGroupAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantGroupAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantGroupAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgGrantGroupAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantGroupAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantGroupAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantGroupAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantGroupAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantGroupAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantGroupAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantGroupAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantGroupAllowanceResponse proto.InternalMessageInfo
+
+// MsgSyncGroupAllowance updates the members of the group the allowance of
+// Granter to GroupPolicy is granted to.
+type MsgSyncGroupAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// group_policy is the address of the group policy the allowance is granted to.
+	GroupPolicy string `protobuf:"bytes,2,opt,name=group_policy,json=groupPolicy,proto3" json:"group_policy,omitempty"`
+}
+
+func (m *MsgSyncGroupAllowance) Reset()         { *m = MsgSyncGroupAllowance{} }
+func (m *MsgSyncGroupAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncGroupAllowance) ProtoMessage()    {}
+func (*MsgSyncGroupAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{8}
+}
+func (m *MsgSyncGroupAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncGroupAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncGroupAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncGroupAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncGroupAllowance.Merge(m, src)
+}
+func (m *MsgSyncGroupAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncGroupAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncGroupAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncGroupAllowance proto.InternalMessageInfo
+
+func (m *MsgSyncGroupAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgSyncGroupAllowance) GetGroupPolicy() string {
+	if m != nil {
+		return m.GroupPolicy
+	}
+	return ""
+}
+
+// MsgSyncGroupAllowanceResponse defines the Msg/SyncGroupAllowance response type.
+type MsgSyncGroupAllowanceResponse struct {
+}
+
+func (m *MsgSyncGroupAllowanceResponse) Reset()         { *m = MsgSyncGroupAllowanceResponse{} }
+func (m *MsgSyncGroupAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncGroupAllowanceResponse) ProtoMessage()    {}
+func (*MsgSyncGroupAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{9}
+}
+func (m *MsgSyncGroupAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncGroupAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncGroupAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncGroupAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncGroupAllowanceResponse.Merge(m, src)
+}
+func (m *MsgSyncGroupAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncGroupAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncGroupAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncGroupAllowanceResponse proto.InternalMessageInfo
+
+// MsgGrantMsgTypeAllowance adds permission for any account executing messages
+// of type MsgTypeUrl to spend up to Allowance of fees from the account of
+// Granter.
+type MsgGrantMsgTypeAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// msg_type_url is the type URL of the messages the allowance pays the fees of.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *types.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (m *MsgGrantMsgTypeAllowance) Reset()         { *m = MsgGrantMsgTypeAllowance{} }
+func (m *MsgGrantMsgTypeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantMsgTypeAllowance) ProtoMessage()    {}
+func (*MsgGrantMsgTypeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{10}
+}
+func (m *MsgGrantMsgTypeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantMsgTypeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantMsgTypeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantMsgTypeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantMsgTypeAllowance.Merge(m, src)
+}
+func (m *MsgGrantMsgTypeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantMsgTypeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantMsgTypeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantMsgTypeAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantMsgTypeAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgGrantMsgTypeAllowance) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGrantMsgTypeAllowance) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+// MsgGrantMsgTypeAllowanceResponse defines the Msg/GrantMsgTypeAllowance response type.
+type MsgGrantMsgTypeAllowanceResponse struct {
+}
+
+func (m *MsgGrantMsgTypeAllowanceResponse) Reset()         { *m = MsgGrantMsgTypeAllowanceResponse{} }
+func (m *MsgGrantMsgTypeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantMsgTypeAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantMsgTypeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{11}
+}
+func (m *MsgGrantMsgTypeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantMsgTypeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantMsgTypeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantMsgTypeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantMsgTypeAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantMsgTypeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantMsgTypeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantMsgTypeAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantMsgTypeAllowanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
@@ -319,41 +618,59 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
 	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
 	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+	proto.RegisterType((*MsgGrantGroupAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantGroupAllowance")
+	proto.RegisterType((*MsgGrantGroupAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantGroupAllowanceResponse")
+	proto.RegisterType((*MsgSyncGroupAllowance)(nil), "cosmos.feegrant.v1beta1.MsgSyncGroupAllowance")
+	proto.RegisterType((*MsgSyncGroupAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgSyncGroupAllowanceResponse")
+	proto.RegisterType((*MsgGrantMsgTypeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantMsgTypeAllowance")
+	proto.RegisterType((*MsgGrantMsgTypeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantMsgTypeAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xee, 0x99, 0x60, 0xb0, 0x5f, 0x02, 0x8a, 0x4a, 0xf3, 0x10, 0x92, 0xc5,
-	0x42, 0x06, 0x5c, 0x6c, 0x05, 0x20, 0x21, 0xc2, 0x5e, 0x81, 0xaa, 0xb3, 0xe2, 0x06, 0xb9, 0x0a,
-	0xca, 0x81, 0x5a, 0x89, 0x66, 0x28, 0xcc, 0x4a, 0xa3, 0x17, 0x4c, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9,
-	0x42, 0x05, 0x5c, 0x7c, 0x68, 0x89, 0x4d, 0x4b, 0x0f, 0x57, 0xc4, 0x62, 0xc4, 0x86, 0x94, 0x11,
-	0xf1, 0x6a, 0x61, 0x36, 0x0b, 0x15, 0x73, 0xf1, 0xa3, 0xc7, 0x9a, 0x36, 0x3e, 0x63, 0xd0, 0x14,
-	0x4b, 0x19, 0x93, 0xa0, 0x18, 0xd9, 0x52, 0xf4, 0xe0, 0xc5, 0x6b, 0x29, 0x9a, 0x62, 0xfc, 0x96,
-	0xe2, 0x08, 0x63, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x0c, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a, 0x5a, 0x32, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb,
-	0x57, 0xc0, 0x8b, 0xa4, 0x24, 0x36, 0x70, 0x6e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8d,
-	0x04, 0xdd, 0xf4, 0xac, 0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xef, 0xa6, 0xb4, 0xd2, 0x69, 0x51, 0xba, 0xb6, 0x9a, 0xac, 0xed, 0xba, 0xae, 0x08, 0x35,
+	0x92, 0x1d, 0x37, 0x11, 0xc5, 0x78, 0x4a, 0x0e, 0x16, 0x0f, 0x81, 0x92, 0xe8, 0x45, 0x90, 0xb0,
+	0x49, 0xa6, 0x43, 0xc8, 0x66, 0x67, 0xd9, 0xd9, 0xc4, 0x2e, 0x08, 0x8a, 0xd0, 0x83, 0x9e, 0x7c,
+	0x00, 0x1f, 0xc1, 0x43, 0x0e, 0xbd, 0xf9, 0x02, 0xe2, 0xa9, 0x78, 0xf2, 0x28, 0xc9, 0x21, 0x47,
+	0x5f, 0x41, 0xb2, 0xff, 0x62, 0x66, 0x37, 0x49, 0x43, 0xa9, 0x5e, 0x12, 0x76, 0xbe, 0xdf, 0xf7,
+	0xfd, 0xfe, 0xec, 0xcc, 0xb0, 0x40, 0xaa, 0x13, 0xda, 0x26, 0x14, 0x1e, 0x22, 0x84, 0x2d, 0xcd,
+	0xb0, 0x61, 0x57, 0xad, 0x21, 0x5b, 0x53, 0xa1, 0x7d, 0xa4, 0x98, 0x16, 0xb1, 0x09, 0x7f, 0xdd,
+	0x43, 0x28, 0x01, 0x42, 0xf1, 0x11, 0x42, 0x0a, 0x13, 0x82, 0x75, 0x04, 0x5d, 0x58, 0xad, 0x73,
+	0x08, 0x35, 0xc3, 0xf1, 0x7a, 0x84, 0x94, 0xd7, 0x53, 0x75, 0x9f, 0xa0, 0x3f, 0xc0, 0x2b, 0xf9,
+	0xe3, 0x60, 0x9b, 0x62, 0xd8, 0x55, 0x47, 0x7f, 0x7e, 0x61, 0x53, 0x6b, 0x37, 0x0d, 0x02, 0xdd,
+	0x5f, 0x6f, 0x49, 0xfe, 0x90, 0x00, 0x9b, 0x25, 0x8a, 0xf7, 0x47, 0xb4, 0x05, 0x5d, 0x27, 0xaf,
+	0x35, 0xa3, 0x8e, 0xf8, 0x2c, 0xb8, 0xe4, 0x0a, 0x41, 0x56, 0x92, 0x93, 0xb8, 0xbd, 0xb5, 0x62,
+	0xf2, 0xc7, 0x49, 0x66, 0xcb, 0x27, 0x29, 0x34, 0x1a, 0x16, 0xa2, 0xb4, 0x62, 0x5b, 0x4d, 0x03,
+	0x97, 0x03, 0xe0, 0xb8, 0x07, 0x25, 0x13, 0x67, 0xeb, 0x41, 0xfc, 0x2b, 0xb0, 0xa6, 0x05, 0xa4,
+	0xc9, 0x65, 0x89, 0xdb, 0x5b, 0xcf, 0x6e, 0x29, 0x9e, 0x67, 0x25, 0xf0, 0xac, 0x14, 0x0c, 0xa7,
+	0x78, 0xf7, 0xfb, 0x49, 0xe6, 0xce, 0x94, 0x94, 0x94, 0xa7, 0x08, 0x85, 0xd2, 0x9f, 0x95, 0xc7,
+	0x13, 0xf3, 0x99, 0xf7, 0xc3, 0x5e, 0x3a, 0x10, 0xf8, 0x71, 0xd8, 0x4b, 0xef, 0x78, 0x23, 0x32,
+	0xb4, 0xd1, 0x82, 0x11, 0xd7, 0xf2, 0x0d, 0x90, 0x8a, 0x2c, 0x96, 0x11, 0x35, 0x89, 0x41, 0x91,
+	0xfc, 0x85, 0x03, 0x7c, 0x89, 0xe2, 0x32, 0xea, 0x92, 0x16, 0xfa, 0xe7, 0x49, 0xe5, 0x15, 0xd6,
+	0xca, 0xee, 0xa4, 0x15, 0x46, 0x97, 0xbc, 0x03, 0x84, 0xe8, 0x6a, 0x68, 0xa6, 0xe2, 0x7a, 0x39,
+	0xb0, 0x3a, 0xc6, 0xb8, 0x48, 0xf9, 0xfb, 0x60, 0xd5, 0x1c, 0x2d, 0xcd, 0xb7, 0xe2, 0xe3, 0xf2,
+	0xeb, 0x23, 0x55, 0xfe, 0x83, 0x4f, 0xc9, 0x0c, 0x0d, 0x29, 0x3f, 0x27, 0xc0, 0xb5, 0x20, 0xdd,
+	0x7d, 0x8b, 0x74, 0xcc, 0xf3, 0x65, 0xf8, 0x04, 0x6c, 0xe0, 0xd1, 0x94, 0xaa, 0x49, 0xf4, 0x66,
+	0xdd, 0x99, 0x1b, 0xe4, 0xba, 0x8b, 0x3e, 0x70, 0xc1, 0x17, 0xbd, 0xed, 0xb2, 0xec, 0xbb, 0xba,
+	0x15, 0xb3, 0xed, 0x26, 0x33, 0x90, 0x25, 0x20, 0xc6, 0x57, 0xc2, 0x00, 0xbf, 0x72, 0x60, 0xbb,
+	0x44, 0x71, 0xc5, 0x31, 0xea, 0xff, 0x39, 0xbf, 0xbc, 0xca, 0x1a, 0x94, 0x26, 0x0d, 0x46, 0x35,
+	0xca, 0x37, 0xc1, 0x6e, 0x6c, 0x21, 0xb4, 0x77, 0x9c, 0x00, 0xc9, 0x20, 0x81, 0x12, 0xc5, 0xcf,
+	0x1d, 0xf3, 0x9c, 0xa7, 0x4c, 0x02, 0x1b, 0x6d, 0x8a, 0xab, 0xb6, 0x63, 0xa2, 0x6a, 0xc7, 0xd2,
+	0x3d, 0x87, 0x65, 0xd0, 0xf6, 0x66, 0xbf, 0xb0, 0xf4, 0x8b, 0xde, 0x06, 0x0f, 0xd8, 0x94, 0x6e,
+	0xc7, 0x6c, 0x03, 0xd6, 0xaa, 0x2c, 0x03, 0x69, 0x5a, 0x2d, 0xc8, 0x2a, 0xfb, 0x7b, 0x05, 0x2c,
+	0x97, 0x28, 0xe6, 0x4d, 0x70, 0x99, 0xb9, 0xb8, 0xd3, 0xca, 0x34, 0x99, 0x91, 0x9b, 0x4d, 0xc8,
+	0x9e, 0x1d, 0x1b, 0x30, 0xf3, 0x14, 0x5c, 0x61, 0x6f, 0xc0, 0x7b, 0xb3, 0xc6, 0x30, 0x60, 0x21,
+	0xb7, 0x00, 0xf8, 0x6f, 0x52, 0xf6, 0xaa, 0x9a, 0x49, 0xca, 0x80, 0x85, 0xdc, 0x02, 0xe0, 0x90,
+	0xf4, 0x2d, 0xb8, 0x1a, 0x77, 0x57, 0xc1, 0xb9, 0xa1, 0x4d, 0x36, 0x08, 0x8f, 0x16, 0x6c, 0x08,
+	0x05, 0xbc, 0x01, 0x7c, 0xcc, 0x59, 0x57, 0x66, 0x8d, 0x8b, 0xe2, 0x85, 0x87, 0x8b, 0xe1, 0x43,
+	0xf6, 0x63, 0x0e, 0x6c, 0xc7, 0x9f, 0x45, 0x75, 0xae, 0x21, 0xb6, 0x45, 0x78, 0xbc, 0x70, 0x4b,
+	0xa0, 0x43, 0x58, 0x79, 0x37, 0xec, 0xa5, 0xb9, 0xa2, 0xfa, 0xad, 0x2f, 0x72, 0xa7, 0x7d, 0x91,
+	0xfb, 0xd5, 0x17, 0xb9, 0x4f, 0x03, 0x71, 0xe9, 0x74, 0x20, 0x2e, 0xfd, 0x1c, 0x88, 0x4b, 0x2f,
+	0xfd, 0x8f, 0x1d, 0xda, 0x68, 0x29, 0x4d, 0x02, 0x8f, 0xc2, 0xaf, 0xac, 0xda, 0xaa, 0x7b, 0x84,
+	0x73, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x5c, 0x16, 0x8d, 0x7f, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +695,15 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantGroupAllowance grants fee allowance to the members of the group of a
+	// group policy on the granter's account.
+	GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error)
+	// SyncGroupAllowance updates the members of the group an allowance is
+	// granted to with the current members of the group.
+	SyncGroupAllowance(ctx context.Context, in *MsgSyncGroupAllowance, opts ...grpc.CallOption) (*MsgSyncGroupAllowanceResponse, error)
+	// GrantMsgTypeAllowance grants fee allowance to any account executing
+	// messages of a type on the granter's account.
+	GrantMsgTypeAllowance(ctx context.Context, in *MsgGrantMsgTypeAllowance, opts ...grpc.CallOption) (*MsgGrantMsgTypeAllowanceResponse, error)
 }
 
 type msgClient struct {
@@ -415,6 +741,33 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error) {
+	out := new(MsgGrantGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SyncGroupAllowance(ctx context.Context, in *MsgSyncGroupAllowance, opts ...grpc.CallOption) (*MsgSyncGroupAllowanceResponse, error) {
+	out := new(MsgSyncGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/SyncGroupAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantMsgTypeAllowance(ctx context.Context, in *MsgGrantMsgTypeAllowance, opts ...grpc.CallOption) (*MsgGrantMsgTypeAllowanceResponse, error) {
+	out := new(MsgGrantMsgTypeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantMsgTypeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -427,6 +780,15 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantGroupAllowance grants fee allowance to the members of the group of a
+	// group policy on the granter's account.
+	GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error)
+	// SyncGroupAllowance updates the members of the group an allowance is
+	// granted to with the current members of the group.
+	SyncGroupAllowance(context.Context, *MsgSyncGroupAllowance) (*MsgSyncGroupAllowanceResponse, error)
+	// GrantMsgTypeAllowance grants fee allowance to any account executing
+	// messages of a type on the granter's account.
+	GrantMsgTypeAllowance(context.Context, *MsgGrantMsgTypeAllowance) (*MsgGrantMsgTypeAllowanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -442,6 +804,15 @@ func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevo
 func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (*UnimplementedMsgServer) GrantGroupAllowance(ctx context.Context, req *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGroupAllowance not implemented")
+}
+func (*UnimplementedMsgServer) SyncGroupAllowance(ctx context.Context, req *MsgSyncGroupAllowance) (*MsgSyncGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGroupAllowance not implemented")
+}
+func (*UnimplementedMsgServer) GrantMsgTypeAllowance(ctx context.Context, req *MsgGrantMsgTypeAllowance) (*MsgGrantMsgTypeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMsgTypeAllowance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -501,6 +872,60 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, req.(*MsgGrantGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/SyncGroupAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncGroupAllowance(ctx, req.(*MsgSyncGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantMsgTypeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantMsgTypeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantMsgTypeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantMsgTypeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantMsgTypeAllowance(ctx, req.(*MsgGrantMsgTypeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -517,6 +942,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantGroupAllowance",
+			Handler:    _Msg_GrantGroupAllowance_Handler,
+		},
+		{
+			MethodName: "SyncGroupAllowance",
+			Handler:    _Msg_SyncGroupAllowance_Handler,
+		},
+		{
+			MethodName: "GrantMsgTypeAllowance",
+			Handler:    _Msg_GrantMsgTypeAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -707,15 +1144,219 @@ func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *MsgGrantGroupAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantGroupAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantGroupAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPolicy) > 0 {
+		i -= len(m.GroupPolicy)
+		copy(dAtA[i:], m.GroupPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantGroupAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantGroupAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantGroupAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncGroupAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncGroupAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncGroupAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupPolicy) > 0 {
+		i -= len(m.GroupPolicy)
+		copy(dAtA[i:], m.GroupPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncGroupAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncGroupAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncGroupAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantMsgTypeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantMsgTypeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantMsgTypeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantMsgTypeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantMsgTypeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantMsgTypeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgGrantAllowance) Size() (n int) {
@@ -762,47 +1403,629 @@ func (m *MsgRevokeAllowance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pruner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantGroupAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantGroupAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSyncGroupAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSyncGroupAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantMsgTypeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantMsgTypeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgPruneAllowances) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pruner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgPruneAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantGroupAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,10 +2048,10 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantGroupAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantGroupAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -865,7 +2088,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,7 +2116,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -952,7 +2175,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantGroupAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -975,10 +2198,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantGroupAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantGroupAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1002,7 +2225,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncGroupAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,10 +2248,10 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncGroupAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncGroupAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1065,7 +2288,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1093,7 +2316,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1116,7 +2339,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncGroupAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1139,10 +2362,10 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncGroupAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncGroupAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1166,7 +2389,7 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantMsgTypeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,15 +2412,15 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAllowances: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantMsgTypeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantMsgTypeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pruner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1225,7 +2448,75 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pruner = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1248,7 +2539,7 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantMsgTypeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1271,10 +2562,10 @@ func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantMsgTypeAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantMsgTypeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagGenesis = "genesis"
+	flagOutput  = "output"
+)
+
+// PatchGenesisCmd returns a command applying JSON merge patches and JSON
+// patches to a genesis file, validating the genesis after each patch.
+func PatchGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch [patch-file]...",
+		Short: "Apply JSON merge patches or JSON patches to the genesis file",
+		Long: `Apply patches to the genesis file at the default location or at the location
+passed with --genesis, in order. A patch file holding a JSON object is applied as
+a JSON merge patch (RFC 7386), and a patch file holding a JSON array as a JSON
+patch (RFC 6902). Patches apply to the whole genesis file, module states being
+under /app_state/<module>.
+
+The genesis is validated after each patch, including the module genesis checks,
+and the patched genesis is only written if every patch applies successfully.`,
+		Example: fmt.Sprintf(`$ %[1]s genesis patch launch-params.json accounts.patch.json
+$ %[1]s genesis patch staking.patch.json --genesis ./genesis.json --output ./genesis.patched.json`, "<appd>"),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genFile, _ := cmd.Flags().GetString(flagGenesis)
+			if genFile == "" {
+				genFile = serverCtx.Config.GenesisFile()
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = genFile
+			}
+
+			appGenesis, err := types.AppGenesisFromFile(genFile)
+			if err != nil {
+				return err
+			}
+
+			patches := make([]genutil.GenesisPatch, 0, len(args))
+			for _, file := range args {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+
+				patches = append(patches, genutil.GenesisPatch{Name: file, Doc: bz})
+			}
+
+			appGenesis, err = genutil.PatchAppGenesis(appGenesis, patches, func(appGenesis *types.AppGenesis) error {
+				var genState map[string]json.RawMessage
+				if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+					return fmt.Errorf("error unmarshalling app state: %w", err)
+				}
+
+				if err := mbm.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, genState); err != nil {
+					return err
+				}
+
+				return mbm.ValidateGenesisChecks(clientCtx.Codec, genState)
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := genutil.ExportGenesisFile(appGenesis, output); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Applied %d patches, genesis written to %s\n", len(patches), output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesis, "", "Genesis file to patch (default is the node genesis file)")
+	cmd.Flags().String(flagOutput, "", "File to write the patched genesis to (default is the patched genesis file)")
+
+	return cmd
+}
@@ -53,6 +53,10 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
 			}
 
+			if err = mbm.ValidateGenesisChecks(cdc, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+			}
+
 			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
 			return nil
 		},
@@ -0,0 +1,430 @@
+package genutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// PatchKind is the format of a genesis patch.
+type PatchKind string
+
+const (
+	// PatchKindMerge is a JSON merge patch (RFC 7386): an object whose fields
+	// replace the fields of the patched document, null removing them.
+	PatchKindMerge PatchKind = "merge"
+	// PatchKindJSONPatch is a JSON patch (RFC 6902): an array of add, remove,
+	// replace, move, copy and test operations.
+	PatchKindJSONPatch PatchKind = "json-patch"
+)
+
+// GenesisPatch is a structured patch of a genesis file. Patches apply to the
+// whole genesis file, so module states are patched under /app_state/<module>.
+type GenesisPatch struct {
+	// Name identifies the patch in errors, e.g. its file name.
+	Name string
+	// Kind is the format of Doc. When empty, it is detected from Doc: arrays are
+	// JSON patches and objects are merge patches.
+	Kind PatchKind
+	Doc  json.RawMessage
+}
+
+// PatchAppGenesis applies patches to the genesis, in order, and returns the
+// patched genesis. After each patch, the genesis is completed and validated,
+// and validate is called when not nil, so an invalid patch is reported as soon
+// as it is applied.
+//
+// Patched documents are re-encoded, so the fields of objects are sorted.
+func PatchAppGenesis(appGenesis *types.AppGenesis, patches []GenesisPatch, validate func(*types.AppGenesis) error) (*types.AppGenesis, error) {
+	doc, err := json.Marshal(appGenesis)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, patch := range patches {
+		name := patch.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+
+		kind := patch.Kind
+		if kind == "" {
+			kind, err = detectPatchKind(patch.Doc)
+			if err != nil {
+				return nil, fmt.Errorf("patch %s: %w", name, err)
+			}
+		}
+
+		switch kind {
+		case PatchKindMerge:
+			doc, err = ApplyMergePatch(doc, patch.Doc)
+		case PatchKindJSONPatch:
+			doc, err = ApplyJSONPatch(doc, patch.Doc)
+		default:
+			err = fmt.Errorf("unknown patch kind %q", kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("patch %s: %w", name, err)
+		}
+
+		appGenesis, err = types.AppGenesisFromReader(bytes.NewReader(doc))
+		if err != nil {
+			return nil, fmt.Errorf("patch %s: %w", name, err)
+		}
+
+		if err := appGenesis.ValidateAndComplete(); err != nil {
+			return nil, fmt.Errorf("patch %s: invalid genesis: %w", name, err)
+		}
+
+		if validate != nil {
+			if err := validate(appGenesis); err != nil {
+				return nil, fmt.Errorf("patch %s: invalid genesis: %w", name, err)
+			}
+		}
+	}
+
+	return appGenesis, nil
+}
+
+func detectPatchKind(doc json.RawMessage) (PatchKind, error) {
+	switch trimmed := bytes.TrimSpace(doc); {
+	case len(trimmed) > 0 && trimmed[0] == '[':
+		return PatchKindJSONPatch, nil
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		return PatchKindMerge, nil
+	default:
+		return "", errors.New("patch must be a JSON object (merge patch) or array (JSON patch)")
+	}
+}
+
+// ApplyMergePatch applies the JSON merge patch (RFC 7386) patch to doc.
+func ApplyMergePatch(doc, patch []byte) ([]byte, error) {
+	target, err := decodeJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+
+	p, err := decodeJSON(patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+
+	return json.Marshal(mergePatch(target, p))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+
+	return targetObj
+}
+
+// jsonPatchOp is a single operation of a JSON patch.
+type jsonPatchOp struct {
+	Op    string           `json:"op"`
+	Path  string           `json:"path"`
+	From  string           `json:"from"`
+	Value *json.RawMessage `json:"value"`
+}
+
+// ApplyJSONPatch applies the JSON patch (RFC 6902) patch to doc. The patch is
+// atomic: if an operation fails, an error is returned and doc is unchanged.
+func ApplyJSONPatch(doc, patch []byte) ([]byte, error) {
+	root, err := decodeJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+
+	var ops []jsonPatchOp
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+
+	for i, op := range ops {
+		root, err = applyJSONPatchOp(root, op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func applyJSONPatchOp(root interface{}, op jsonPatchOp) (interface{}, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	value := func() (interface{}, error) {
+		if op.Value == nil {
+			return nil, errors.New("missing value")
+		}
+		return decodeJSON(*op.Value)
+	}
+
+	switch op.Op {
+	case "add":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		return addValue(root, path, v)
+
+	case "remove":
+		root, _, err = removeValue(root, path)
+		return root, err
+
+	case "replace":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		if root, _, err = removeValue(root, path); err != nil {
+			return nil, err
+		}
+		return addValue(root, path, v)
+
+	case "move", "copy":
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		var v interface{}
+		if op.Op == "move" {
+			if len(from) < len(path) && reflect.DeepEqual(from, path[:len(from)]) {
+				return nil, errors.New("cannot move a value into itself")
+			}
+			root, v, err = removeValue(root, from)
+		} else {
+			v, err = getValue(root, from)
+			if err == nil {
+				v, err = deepCopy(v)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return addValue(root, path, v)
+
+	case "test":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := getValue(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(actual, v) {
+			return nil, errors.New("test failed")
+		}
+		return root, nil
+
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
+
+// parsePointer parses a JSON pointer (RFC 6901) into its reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+func arrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if allowEnd && token == "-" {
+		return length, nil
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	limit := length - 1
+	if allowEnd {
+		limit = length
+	}
+	if i > limit {
+		return 0, fmt.Errorf("array index %d out of bounds", i)
+	}
+
+	return i, nil
+}
+
+func getValue(node interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			v, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found", token)
+			}
+			node = v
+		case []interface{}:
+			i, err := arrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("cannot traverse %q: not an object or array", token)
+		}
+	}
+
+	return node, nil
+}
+
+// addValue adds value at path and returns the new root.
+func addValue(root interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parent, err := getValue(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[last] = value
+		return root, nil
+	case []interface{}:
+		i, err := arrayIndex(last, len(p), true)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, nil)
+		copy(p[i+1:], p[i:])
+		p[i] = value
+		return setValue(root, path[:len(path)-1], p)
+	default:
+		return nil, fmt.Errorf("cannot add %q: parent is not an object or array", last)
+	}
+}
+
+// removeValue removes the value at path and returns the new root along with
+// the removed value.
+func removeValue(root interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, root, nil
+	}
+
+	parent, err := getValue(root, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		v, ok := p[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("key %q not found", last)
+		}
+		delete(p, last)
+		return root, v, nil
+	case []interface{}:
+		i, err := arrayIndex(last, len(p), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		v := p[i]
+		p = append(p[:i:i], p[i+1:]...)
+		root, err = setValue(root, path[:len(path)-1], p)
+		return root, v, err
+	default:
+		return nil, nil, fmt.Errorf("cannot remove %q: parent is not an object or array", last)
+	}
+}
+
+// setValue replaces the value at an existing path, used to store arrays whose
+// length changed.
+func setValue(root interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parent, err := getValue(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[last] = value
+	case []interface{}:
+		i, err := arrayIndex(last, len(p), false)
+		if err != nil {
+			return nil, err
+		}
+		p[i] = value
+	}
+
+	return root, nil
+}
+
+// decodeJSON decodes bz keeping numbers as json.Number, so that large integers
+// such as token amounts are not rounded.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+
+	return v, nil
+}
+
+func deepCopy(v interface{}) (interface{}, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSON(bz)
+}
@@ -0,0 +1,103 @@
+package genutil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	doc := `{"a":{"b":1,"c":[1,2]},"d":"x"}`
+
+	out, err := ApplyMergePatch([]byte(doc), []byte(`{"a":{"b":null,"c":[3],"e":100000000000000000000},"f":true}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":{"c":[3],"e":100000000000000000000},"d":"x","f":true}`, string(out))
+	require.Contains(t, string(out), "100000000000000000000")
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	doc := `{"a":{"b":1},"list":[1,2,3],"s~/":"x"}`
+
+	testCases := []struct {
+		name   string
+		patch  string
+		expOut string
+		expErr string
+	}{
+		{"add to object", `[{"op":"add","path":"/a/c","value":2}]`, `{"a":{"b":1,"c":2},"list":[1,2,3],"s~/":"x"}`, ""},
+		{"insert in array", `[{"op":"add","path":"/list/1","value":9}]`, `{"a":{"b":1},"list":[1,9,2,3],"s~/":"x"}`, ""},
+		{"append to array", `[{"op":"add","path":"/list/-","value":4}]`, `{"a":{"b":1},"list":[1,2,3,4],"s~/":"x"}`, ""},
+		{"remove from array", `[{"op":"remove","path":"/list/0"}]`, `{"a":{"b":1},"list":[2,3],"s~/":"x"}`, ""},
+		{"replace escaped key", `[{"op":"replace","path":"/s~0~1","value":"y"}]`, `{"a":{"b":1},"list":[1,2,3],"s~/":"y"}`, ""},
+		{"move", `[{"op":"move","from":"/a/b","path":"/b"}]`, `{"a":{},"b":1,"list":[1,2,3],"s~/":"x"}`, ""},
+		{"copy", `[{"op":"copy","from":"/list","path":"/a/list"}]`, `{"a":{"b":1,"list":[1,2,3]},"list":[1,2,3],"s~/":"x"}`, ""},
+		{"test", `[{"op":"test","path":"/a/b","value":1},{"op":"remove","path":"/a"}]`, `{"list":[1,2,3],"s~/":"x"}`, ""},
+		{"failed test", `[{"op":"test","path":"/a/b","value":2}]`, "", "test failed"},
+		{"missing key", `[{"op":"remove","path":"/a/c"}]`, "", `key "c" not found`},
+		{"index out of bounds", `[{"op":"replace","path":"/list/3","value":0}]`, "", "out of bounds"},
+		{"move into itself", `[{"op":"move","from":"/a","path":"/a/b"}]`, "", "into itself"},
+		{"unknown op", `[{"op":"merge","path":"/a"}]`, "", "unknown operation"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ApplyJSONPatch([]byte(doc), []byte(tc.patch))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expOut, string(out))
+		})
+	}
+}
+
+func TestPatchAppGenesis(t *testing.T) {
+	appGenesis, err := types.AppGenesisFromFile("types/testdata/app_genesis.json")
+	require.NoError(t, err)
+
+	patches := []GenesisPatch{
+		{Name: "chain-id", Doc: json.RawMessage(`{"chain_id":"launch-1"}`)},
+		{Name: "bank", Doc: json.RawMessage(`[{"op":"replace","path":"/app_state/bank/params/default_send_enabled","value":false}]`)},
+	}
+
+	var validated int
+	patched, err := PatchAppGenesis(appGenesis, patches, func(*types.AppGenesis) error {
+		validated++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, validated)
+	require.Equal(t, "launch-1", patched.ChainID)
+
+	var appState map[string]map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(patched.AppState, &appState))
+	require.JSONEq(t, `false`, string(field(t, appState["bank"]["params"], "default_send_enabled")))
+
+	// the genesis is validated after each patch
+	_, err = PatchAppGenesis(appGenesis, patches, func(ag *types.AppGenesis) error {
+		if ag.ChainID == "launch-1" {
+			return errors.New("chain-id rejected")
+		}
+		return nil
+	})
+	require.ErrorContains(t, err, "patch chain-id: invalid genesis: chain-id rejected")
+
+	_, err = PatchAppGenesis(appGenesis, []GenesisPatch{{Name: "empty", Doc: json.RawMessage(`{"chain_id":""}`)}}, nil)
+	require.ErrorContains(t, err, "patch empty: invalid genesis")
+}
+
+func field(t *testing.T, obj json.RawMessage, key string) json.RawMessage {
+	t.Helper()
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(obj, &fields))
+	return fields[key]
+}
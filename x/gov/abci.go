@@ -157,6 +157,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			// the handlers fails, no state mutation is written and the error
 			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
+			cacheCtx = sdk.WithProposalID(cacheCtx, proposal.Id)
 			messages, err := proposal.GetMsgs()
 			if err != nil {
 				proposal.Status = v1.StatusFailed
@@ -85,6 +85,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ParamChanges",
+					Use:       "param-changes [module]",
+					Short:     "Query the history of the param changes of a module",
+					Example:   fmt.Sprintf("%s query gov param-changes cosmos.bank.v1beta1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "module"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -116,6 +125,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "DelegateVote",
+					Use:       "delegate-vote [delegate]",
+					Short:     "Delegate your governance voting power to another account",
+					Example:   fmt.Sprintf(`$ %s tx gov delegate-vote cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateVote",
+					Use:       "undelegate-vote",
+					Short:     "Remove the delegation of your governance voting power",
+					Example:   fmt.Sprintf(`$ %s tx gov undelegate-vote --from mykey`, version.AppName),
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [option]",
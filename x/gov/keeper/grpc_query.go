@@ -236,6 +236,24 @@ func (q queryServer) Deposits(ctx context.Context, req *v1.QueryDepositsRequest)
 	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
+// ParamChanges implements the Query/ParamChanges gRPC method
+func (q queryServer) ParamChanges(ctx context.Context, req *v1.QueryParamChangesRequest) (*v1.QueryParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Module == "" {
+		return nil, status.Error(codes.InvalidArgument, "module can not be empty")
+	}
+
+	changes, pageRes, err := q.k.GetParamChanges(ctx, req.Module, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryParamChangesResponse{ParamChanges: changes, Pagination: pageRes}, nil
+}
+
 // TallyResult queries the tally of a proposal vote
 func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
 	if req == nil {
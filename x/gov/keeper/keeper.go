@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/gov/types"
@@ -64,6 +65,13 @@ type Keeper struct {
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// VotingPeriodProposals key: proposalID | value: proposalStatus (votingPeriod or not)
 	VotingPeriodProposals collections.Map[uint64, []byte] // TODO(tip): this could be a keyset or index.
+	// VoteDelegations key: delegatorAddr | value: delegateAddr
+	VoteDelegations collections.Map[sdk.AccAddress, sdk.AccAddress]
+	// VoteDelegators key: delegateAddr+delegatorAddr, indexing VoteDelegations by delegate
+	VoteDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// ParamChanges key: module+sequence | value: param change
+	ParamChanges   collections.Map[collections.Pair[string, uint64], v1.ParamChange]
+	ParamChangeSeq collections.Sequence
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -105,6 +113,10 @@ func NewKeeper(
 	if config.MaxSummaryLen == 0 {
 		config.MaxSummaryLen = defaultConfig.MaxSummaryLen
 	}
+	// If MaxParamChanges not set by app developer, set to default value.
+	if config.MaxParamChanges == 0 {
+		config.MaxParamChanges = defaultConfig.MaxParamChanges
+	}
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
@@ -126,6 +138,10 @@ func NewKeeper(
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:  collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		VoteDelegations:        collections.NewMap(sb, types.VoteDelegationsKeyPrefix, "vote_delegations", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		VoteDelegators:         collections.NewKeySet(sb, types.VoteDelegatorsKeyPrefix, "vote_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		ParamChanges:           collections.NewMap(sb, types.ParamChangesKeyPrefix, "param_changes", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[v1.ParamChange](cdc)),
+		ParamChangeSeq:         collections.NewSequence(sb, types.ParamChangeSeqKey, "param_change_seq"),
 	}
 	schema, err := sb.Build()
 	if err != nil {
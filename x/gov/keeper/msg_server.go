@@ -145,6 +145,39 @@ func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelPropos
 	}, nil
 }
 
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateVote(ctx, delegator, delegate); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements the MsgServer.UndelegateVote method.
+func (k msgServer) UndelegateVote(ctx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateVote(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
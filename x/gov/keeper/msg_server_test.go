@@ -393,6 +393,80 @@ func (suite *KeeperTestSuite) TestMsgCancelProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	suite.reset()
+	delegator, delegate, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	cases := map[string]struct {
+		msg       *v1.MsgDelegateVote
+		expErr    bool
+		expErrMsg string
+		expDel    sdk.AccAddress
+	}{
+		"invalid delegator": {
+			msg:       &v1.MsgDelegateVote{Delegator: "", Delegate: delegate.String()},
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		"invalid delegate": {
+			msg:       &v1.MsgDelegateVote{Delegator: delegator.String(), Delegate: abc},
+			expErr:    true,
+			expErrMsg: "invalid delegate address",
+		},
+		"delegate to self": {
+			msg:       &v1.MsgDelegateVote{Delegator: delegator.String(), Delegate: delegator.String()},
+			expErr:    true,
+			expErrMsg: "cannot delegate vote to self",
+		},
+		"all good": {
+			msg:    &v1.MsgDelegateVote{Delegator: delegator.String(), Delegate: delegate.String()},
+			expDel: delegate,
+		},
+		"redelegate": {
+			msg:    &v1.MsgDelegateVote{Delegator: delegator.String(), Delegate: other.String()},
+			expDel: other,
+		},
+	}
+
+	for _, name := range []string{"invalid delegator", "invalid delegate", "delegate to self", "all good", "redelegate"} {
+		tc := cases[name]
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			del, err := suite.govKeeper.GetVoteDelegate(suite.ctx, delegator)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expDel, del)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUndelegateVote() {
+	suite.reset()
+	delegator, delegate := suite.addrs[0], suite.addrs[1]
+
+	_, err := suite.msgSrvr.UndelegateVote(suite.ctx, &v1.MsgUndelegateVote{Delegator: ""})
+	suite.Require().ErrorContains(err, "invalid delegator address")
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, &v1.MsgUndelegateVote{Delegator: delegator.String()})
+	suite.Require().ErrorContains(err, "has no vote delegation")
+
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, &v1.MsgDelegateVote{Delegator: delegator.String(), Delegate: delegate.String()})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, &v1.MsgUndelegateVote{Delegator: delegator.String()})
+	suite.Require().NoError(err)
+
+	del, err := suite.govKeeper.GetVoteDelegate(suite.ctx, delegator)
+	suite.Require().NoError(err)
+	suite.Require().Nil(del)
+}
+
 func (suite *KeeperTestSuite) TestMsgVote() {
 	suite.reset()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
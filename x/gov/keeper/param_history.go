@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ baseapp.ParamChangeRecorder = Keeper{}
+
+// RecordParamChange adds a change of the params of a module to its history,
+// which keeps the last config.MaxParamChanges changes of each module. It is
+// set as the param change recorder of the app to record the changes applied by
+// MsgUpdateParams, whether executed by a proposal or by another authority.
+func (keeper Keeper) RecordParamChange(ctx context.Context, change sdk.ParamChange) error {
+	seq, err := keeper.ParamChangeSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record := v1.ParamChange{
+		Module:     change.Module,
+		Height:     change.Height,
+		Authority:  change.Authority,
+		ProposalId: change.ProposalID,
+		OldParams:  string(change.Old),
+		NewParams:  string(change.New),
+	}
+	if err := keeper.ParamChanges.Set(ctx, collections.Join(change.Module, seq), record); err != nil {
+		return err
+	}
+
+	return keeper.pruneParamChanges(ctx, change.Module)
+}
+
+// GetParamChanges returns a page of the history of the param changes of
+// module, oldest first.
+func (keeper Keeper) GetParamChanges(ctx context.Context, module string, pageReq *query.PageRequest) ([]*v1.ParamChange, *query.PageResponse, error) {
+	return query.CollectionPaginate(ctx, keeper.ParamChanges, pageReq, func(_ collections.Pair[string, uint64], change v1.ParamChange) (*v1.ParamChange, error) {
+		return &change, nil
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](module))
+}
+
+// pruneParamChanges removes the oldest param changes of module beyond
+// config.MaxParamChanges.
+func (keeper Keeper) pruneParamChanges(ctx context.Context, module string) error {
+	iter, err := keeper.ParamChanges.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](module).Descending())
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for i := keeper.config.MaxParamChanges; i < uint64(len(keys)); i++ {
+		if err := keeper.ParamChanges.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
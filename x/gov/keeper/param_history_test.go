@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestParamChanges(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	maxChanges := int(types.DefaultConfig().MaxParamChanges)
+
+	change := func(module string, i int) sdk.ParamChange {
+		return sdk.ParamChange{
+			Module:     module,
+			Height:     int64(i),
+			Authority:  govKeeper.GetAuthority(),
+			ProposalID: uint64(i),
+			Old:        json.RawMessage(fmt.Sprintf(`{"n":%d}`, i)),
+			New:        json.RawMessage(fmt.Sprintf(`{"n":%d}`, i+1)),
+		}
+	}
+
+	record := func(module string, i int) *v1.ParamChange {
+		return &v1.ParamChange{
+			Module:     module,
+			Height:     int64(i),
+			Authority:  govKeeper.GetAuthority(),
+			ProposalId: uint64(i),
+			OldParams:  fmt.Sprintf(`{"n":%d}`, i),
+			NewParams:  fmt.Sprintf(`{"n":%d}`, i+1),
+		}
+	}
+
+	queryServer := keeper.NewQueryServer(govKeeper)
+	res, err := queryServer.ParamChanges(ctx, &v1.QueryParamChangesRequest{Module: "cosmos.bank.v1beta1"})
+	require.NoError(t, err)
+	require.Empty(t, res.ParamChanges)
+
+	for i := 0; i <= maxChanges; i++ {
+		require.NoError(t, govKeeper.RecordParamChange(ctx, change("cosmos.bank.v1beta1", i)))
+	}
+	require.NoError(t, govKeeper.RecordParamChange(ctx, change("cosmos.staking.v1beta1", 7)))
+
+	// the oldest bank change is pruned, the staking one is kept
+	res, err = queryServer.ParamChanges(ctx, &v1.QueryParamChangesRequest{Module: "cosmos.bank.v1beta1", Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxChanges), res.Pagination.Total)
+	require.Equal(t, record("cosmos.bank.v1beta1", 1), res.ParamChanges[0])
+
+	res, err = queryServer.ParamChanges(ctx, &v1.QueryParamChangesRequest{Module: "cosmos.bank.v1beta1", Pagination: &query.PageRequest{Offset: uint64(maxChanges - 1)}})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ParamChange{record("cosmos.bank.v1beta1", maxChanges)}, res.ParamChanges)
+
+	res, err = queryServer.ParamChanges(ctx, &v1.QueryParamChangesRequest{Module: "cosmos.staking.v1beta1"})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ParamChange{record("cosmos.staking.v1beta1", 7)}, res.ParamChanges)
+
+	_, err = queryServer.ParamChanges(ctx, &v1.QueryParamChangesRequest{})
+	require.ErrorContains(t, err, "module can not be empty")
+}
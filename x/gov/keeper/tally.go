@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -17,7 +18,7 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false, false, v1.TallyResult{}, err
 	}
 
-	totalVoterPower, results, err := keeper.calculateVoteResultsAndVotingPower(ctx, proposal.Id, validators)
+	totalBonded, err := keeper.sk.TotalBondedTokens(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -26,14 +27,16 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
-	tallyResults = v1.NewTallyResultFromMap(results)
 
-	// If there is no staked coins, the proposal fails
-	totalBonded, err := keeper.sk.TotalBondedTokens(ctx)
+	method := params.TallyMethodOf(proposal.ProposalType)
+	votingStake, totalVoterPower, results, err := keeper.calculateVoteResultsAndVotingPower(ctx, proposal.Id, validators, method, totalBonded)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	// If there is no staked coins, the proposal fails
 	if totalBonded.IsZero() {
 		return false, false, tallyResults, nil
 	}
@@ -49,20 +52,20 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
 		return keeper.tallyOptimistic(totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		return keeper.tallyExpedited(totalVoterPower, totalBonded, results, params)
+		return keeper.tallyExpedited(votingStake, totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-		return keeper.tallyMultipleChoice(totalVoterPower, totalBonded, results, params) // TODO(@julienrbrt): implement in follow up
+		return keeper.tallyMultipleChoice(votingStake, totalBonded, results, params) // TODO(@julienrbrt): implement in follow up
 	default:
-		return keeper.tallyStandard(totalVoterPower, totalBonded, results, params)
+		return keeper.tallyStandard(votingStake, totalVoterPower, totalBonded, results, params)
 	}
 }
 
 // tallyStandard tallies the votes of a standard proposal
-func (keeper Keeper) tallyStandard(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (keeper Keeper) tallyStandard(votingStake, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := votingStake.Quo(math.LegacyNewDecFromInt(totalBonded))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
@@ -91,11 +94,11 @@ func (keeper Keeper) tallyStandard(totalVoterPower math.LegacyDec, totalBonded m
 }
 
 // tallyExpedited tallies the votes of an expedited proposal
-func (keeper Keeper) tallyExpedited(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (keeper Keeper) tallyExpedited(votingStake, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := votingStake.Quo(math.LegacyNewDecFromInt(totalBonded))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
@@ -142,11 +145,11 @@ func (keeper Keeper) tallyOptimistic(totalVoterPower math.LegacyDec, totalBonded
 }
 
 // tallyMultipleChoice tallies the votes of a multiple choice proposal
-func (keeper Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (keeper Keeper) tallyMultipleChoice(votingStake math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := votingStake.Quo(math.LegacyNewDecFromInt(totalBonded))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
@@ -180,14 +183,36 @@ func (keeper Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Va
 }
 
 // calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// and returns the stake which voted, the voting power of the voters weighted with method, and the votes
+// results from voters
 func (keeper Keeper) calculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
-) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
-	totalVP := math.LegacyZeroDec()
-	results := createEmptyResults()
+	method v1.ProposalTallyMethod,
+	totalBonded math.Int,
+) (votingStake, totalVP math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error) {
+	votingStake = math.LegacyZeroDec()
+	totalVP = math.LegacyZeroDec()
+	results = createEmptyResults()
+
+	// addVote tallies the stake of an account, weighted to votingPower, with options
+	addVote := func(stake, votingPower math.LegacyDec, options v1.WeightedVoteOptions) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+
+		votingStake = votingStake.Add(stake)
+		totalVP = totalVP.Add(votingPower)
+	}
+
+	// direct voters, in iteration order, whose vote overrides their vote delegation
+	var voters []sdk.AccAddress
+	votes := make(map[string]v1.WeightedVoteOptions)
+	// accounts whose delegations have been tallied, and deducted from their validators
+	tallied := make(map[string]bool)
 
 	// iterate over all votes, tally up the voting power of each validator
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
@@ -208,37 +233,56 @@ func (keeper Keeper) calculateVoteResultsAndVotingPower(
 			validators[valAddrStr] = val
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
+		voters = append(voters, voter)
+		votes[string(voter)] = vote.Options
 
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
+		stake, err := keeper.tallyDelegations(ctx, voter, validators)
+		if err != nil {
+			return false, err
+		}
+		tallied[string(voter)] = true
+		addVote(stake, method.VotingPower(stake, totalBonded), vote.Options)
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
+	}); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, nil, err
+	}
 
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
+	// tally the voting power of the accounts which delegated their vote to a
+	// voter and didn't vote themselves with the options of the voter
+	for _, voter := range voters {
+		options := votes[string(voter)]
+		if err := keeper.IterateVoteDelegators(ctx, voter, func(delegator sdk.AccAddress) (bool, error) {
+			if _, voted := votes[string(delegator)]; voted {
+				return false, nil
+			}
 
-				totalVP = totalVP.Add(votingPower)
+			stake, err := keeper.tallyDelegations(ctx, delegator, validators)
+			if err != nil {
+				return false, err
 			}
+			tallied[string(delegator)] = true
+			addVote(stake, method.VotingPower(stake, totalBonded), options)
 
-			return false
-		})
-		if err != nil {
-			return false, err
+			return false, nil
+		}); err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, nil, err
 		}
+	}
 
-		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
-	}); err != nil {
-		return math.LegacyDec{}, nil, err
+	if method.Method != v1.TallyMethodStandard {
+		// alternative methods weight the stake the delegators which didn't vote
+		// let their validators vote with per delegator, not per validator
+		if err := keeper.tallyInheritedVotes(ctx, validators, tallied, func(stake math.LegacyDec, votes []inheritedVote) {
+			votingPower := method.VotingPower(stake, totalBonded)
+			for _, vote := range votes {
+				addVote(vote.stake, votingPower.Mul(vote.stake).Quo(stake), vote.options)
+			}
+		}); err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, nil, err
+		}
+
+		return votingStake, totalVP, results, nil
 	}
 
 	// iterate over the validators again to tally their voting power
@@ -249,16 +293,112 @@ func (keeper Keeper) calculateVoteResultsAndVotingPower(
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		addVote(votingPower, votingPower, val.Vote)
+	}
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+	return votingStake, totalVP, results, nil
+}
+
+// inheritedVote is the vote a delegator inherits from a validator with the
+// stake it delegated to the validator.
+type inheritedVote struct {
+	stake   math.LegacyDec
+	options v1.WeightedVoteOptions
+}
+
+// tallyInheritedVotes calls addVotes, for each delegator which didn't vote and
+// whose delegations haven't been tallied, with its stake delegated to the
+// validators which voted and the votes it inherits from them.
+func (keeper Keeper) tallyInheritedVotes(
+	ctx context.Context,
+	validators map[string]v1.ValidatorGovInfo,
+	tallied map[string]bool,
+	addVotes func(stake math.LegacyDec, votes []inheritedVote),
+) error {
+	valAddrs := make([]string, 0, len(validators))
+	for valAddr, val := range validators {
+		if len(val.Vote) != 0 {
+			valAddrs = append(valAddrs, valAddr)
+		}
+	}
+	sort.Strings(valAddrs)
+
+	// the delegators, in iteration order, and the votes they inherit
+	var delegators []string
+	inherited := make(map[string][]inheritedVote)
+	for _, valAddr := range valAddrs {
+		val := validators[valAddr]
+		var err error
+		if iterErr := keeper.sk.IterateValidatorDelegations(ctx, val.Address, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+			var delegator []byte
+			delegator, err = keeper.authKeeper.AddressCodec().StringToBytes(delegation.GetDelegatorAddr())
+			if err != nil {
+				return true
+			}
+
+			if tallied[string(delegator)] {
+				return false
+			}
+
+			if _, ok := inherited[string(delegator)]; !ok {
+				delegators = append(delegators, string(delegator))
+			}
+			inherited[string(delegator)] = append(inherited[string(delegator)], inheritedVote{
+				// delegation shares * bonded / total shares
+				stake:   delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares),
+				options: val.Vote,
+			})
+
+			return false
+		}); iterErr != nil {
+			return iterErr
+		}
+		if err != nil {
+			return err
 		}
-		totalVP = totalVP.Add(votingPower)
 	}
 
-	return totalVP, results, nil
+	for _, delegator := range delegators {
+		stake := math.LegacyZeroDec()
+		for _, vote := range inherited[delegator] {
+			stake = stake.Add(vote.stake)
+		}
+
+		if stake.IsPositive() {
+			addVotes(stake, inherited[delegator])
+		}
+	}
+
+	return nil
+}
+
+// tallyDelegations deducts the delegations of voter from the bonded validators
+// they are delegated to, and returns the stake they represent.
+func (keeper Keeper) tallyDelegations(
+	ctx context.Context,
+	voter sdk.AccAddress,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, error) {
+	stake := math.LegacyZeroDec()
+
+	// iterate over all delegations from voter, deduct from any delegated-to validators
+	err := keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			// delegation shares * bonded / total shares
+			stake = stake.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false
+	})
+
+	return stake, err
 }
 
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
@@ -52,6 +52,19 @@ var (
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	voteDelegation = func(s tallyFixture, delegator, delegate sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		err := s.keeper.DelegateVote(s.ctx, delegator, delegate)
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -352,6 +365,80 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:       "6000000",
 			},
 		},
+		{
+			name: "delegate votes yes, vote delegator's voting power follows: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[1].String(),
+					ValidatorAddress: s.valAddrs[0].String(),
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				voteDelegation(s, s.delAddrs[1], s.delAddrs[0], delegations)
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:        "42",
+				AbstainCount:    "0",
+				NoCount:         "0",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name: "delegate votes yes, vote delegator votes no itself: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[1].String(),
+					ValidatorAddress: s.valAddrs[0].String(),
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				require.NoError(s.t, s.keeper.DelegateVote(s.ctx, s.delAddrs[1], s.delAddrs[0]))
+				delegatorVote(s, s.delAddrs[1], delegations, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:        "0",
+				AbstainCount:    "0",
+				NoCount:         "42",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name: "delegate votes yes, one of two vote delegators votes no itself: its stake is only counted once, as no",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				voteDelegation(s, s.delAddrs[1], s.delAddrs[0], []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[1].String(),
+					ValidatorAddress: s.valAddrs[0].String(),
+					Shares:           sdkmath.LegacyNewDec(42),
+				}})
+				// the delegations of the direct voter are iterated exactly
+				// once, when its own vote is tallied
+				require.NoError(s.t, s.keeper.DelegateVote(s.ctx, s.delAddrs[2], s.delAddrs[0]))
+				delegatorVote(s, s.delAddrs[2], []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[2].String(),
+					ValidatorAddress: s.valAddrs[1].String(),
+					Shares:           sdkmath.LegacyNewDec(7),
+				}}, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:        "42",
+				AbstainCount:    "0",
+				NoCount:         "7",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -939,3 +1026,235 @@ func TestTally_Optimistic(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_TallyMethods(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        v1.ProposalTallyMethod
+		quorum        string
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name:         "standard: whale passes the prop",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodStandard},
+			quorum:       "0.0001",
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "1000000",
+				AbstainCount:    "0",
+				NoCount:         "360000",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name:         "quadratic: small voters reject the prop",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodQuadratic},
+			quorum:       "0.0001",
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:        "1000",
+				AbstainCount:    "0",
+				NoCount:         "1200",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name:         "quadratic: quorum is reached on the stake which voted, small voters reject the prop",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodQuadratic},
+			quorum:       "0.136", // 1360000 of 10000000 bonded tokens voted, far more than the weighted power
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:        "1000",
+				AbstainCount:    "0",
+				NoCount:         "1200",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name:         "quadratic: quorum is not reached on the stake which voted: prop fails/burn deposit",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodQuadratic},
+			quorum:       "0.137",
+			expectedPass: false,
+			expectedBurn: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "1000",
+				AbstainCount:    "0",
+				NoCount:         "1200",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name:         "capped: whale is capped, prop is rejected",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodCapped, MaxVotingPowerRatio: "0.01"},
+			quorum:       "0.0001",
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:        "100000",
+				AbstainCount:    "0",
+				NoCount:         "360000",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+		{
+			name:         "capped: quorum is reached, whale below the cap passes the prop",
+			method:       v1.ProposalTallyMethod{Method: v1.TallyMethodCapped, MaxVotingPowerRatio: "0.5"},
+			quorum:       "0.136",
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "1000000",
+				AbstainCount:    "0",
+				NoCount:         "360000",
+				NoWithVetoCount: "0",
+				SpamCount:       "0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.Quorum = tt.quorum
+			params.BurnVoteQuorum = true
+			tt.method.ProposalType = v1.ProposalType_PROPOSAL_TYPE_STANDARD
+			params.TallyMethods = []v1.ProposalTallyMethod{tt.method}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			addrs := simtestutil.CreateRandomAccounts(6)
+			valAddr := sdk.ValAddress(addrs[0])
+			delAddrs := addrs[1:]
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						fn(0, stakingtypes.Validator{
+							OperatorAddress: valAddr.String(),
+							Status:          stakingtypes.Bonded,
+							Tokens:          sdkmath.NewInt(10000000),
+							DelegatorShares: sdkmath.LegacyNewDec(10000000),
+						})
+						return nil
+					})
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			s := tallyFixture{t: t, proposal: proposal, ctx: ctx, keeper: govKeeper, mocks: mocks}
+			setTotalBonded(s, 10000000)
+
+			// a whale votes yes, four small delegators vote no
+			delegatorVote(s, delAddrs[0], []stakingtypes.Delegation{{
+				DelegatorAddress: delAddrs[0].String(),
+				ValidatorAddress: valAddr.String(),
+				Shares:           sdkmath.LegacyNewDec(1000000),
+			}}, v1.VoteOption_VOTE_OPTION_ONE)
+			for _, addr := range delAddrs[1:] {
+				delegatorVote(s, addr, []stakingtypes.Delegation{{
+					DelegatorAddress: addr.String(),
+					ValidatorAddress: valAddr.String(),
+					Shares:           sdkmath.LegacyNewDec(90000),
+				}}, v1.VoteOption_VOTE_OPTION_THREE)
+			}
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+		})
+	}
+}
+
+func TestTally_TallyMethodsInheritedVotes(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	params := v1.DefaultParams()
+	params.Quorum = "0.0001"
+	params.TallyMethods = []v1.ProposalTallyMethod{{
+		ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+		Method:       v1.TallyMethodQuadratic,
+	}}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	addrs := simtestutil.CreateRandomAccounts(8)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+	delAddrs := addrs[2:]
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				for i, valAddr := range valAddrs {
+					fn(int64(i), stakingtypes.Validator{
+						OperatorAddress: valAddr.String(),
+						Status:          stakingtypes.Bonded,
+						Tokens:          sdkmath.NewInt(1000000),
+						DelegatorShares: sdkmath.LegacyNewDec(1000000),
+					})
+				}
+				return nil
+			})
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	s := tallyFixture{t: t, proposal: proposal, ctx: ctx, keeper: govKeeper, mocks: mocks}
+	setTotalBonded(s, 2000000)
+
+	delegation := func(delegator sdk.AccAddress, valAddr sdk.ValAddress, shares int64) stakingtypes.Delegation {
+		return stakingtypes.Delegation{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: valAddr.String(),
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}
+	}
+	// the first validator votes yes with the stake of four delegators which
+	// don't vote, one of them also letting the second validator vote no with
+	// their stake, and the last delegator votes no itself
+	inheriting := []stakingtypes.Delegation{
+		delegation(delAddrs[0], valAddrs[0], 90000),
+		delegation(delAddrs[1], valAddrs[0], 90000),
+		delegation(delAddrs[2], valAddrs[0], 90000),
+		delegation(delAddrs[3], valAddrs[0], 40000),
+		delegation(delAddrs[5], valAddrs[0], 10000),
+	}
+	validatorVote(s, valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+	validatorVote(s, valAddrs[1], v1.VoteOption_VOTE_OPTION_THREE)
+	delegatorVote(s, delAddrs[5], []stakingtypes.Delegation{delegation(delAddrs[5], valAddrs[0], 10000)}, v1.VoteOption_VOTE_OPTION_THREE)
+	for i, valAddr := range valAddrs {
+		delegations := inheriting
+		if i == 1 {
+			delegations = []stakingtypes.Delegation{delegation(delAddrs[3], valAddrs[1], 50000)}
+		}
+		mocks.stakingKeeper.EXPECT().
+			IterateValidatorDelegations(ctx, valAddr, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, valAddr sdk.ValAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+
+	_, _, tally, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	// each delegator inheriting the vote of its validators is weighted on its
+	// own: sqrt(90000) for the first three, and sqrt(40000+50000) split 4:5
+	// between yes and no for the fourth, rather than sqrt(310000) for the
+	// stake of the first validator
+	assert.Equal(t, v1.TallyResult{
+		YesCount:        "1033",
+		AbstainCount:    "0",
+		NoCount:         "266",
+		NoWithVetoCount: "0",
+		SpamCount:       "0",
+	}, tally)
+}
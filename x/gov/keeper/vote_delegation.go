@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Vote delegation lets an account delegate its governance voting power to
+// another account, the delegate, independently of its stake delegations. When
+// the delegate votes on a proposal, the voting power of its delegators is
+// tallied with the options of the delegate, unless they vote themselves: a
+// direct vote always overrides the vote delegation. Vote delegation isn't
+// transitive, the delegators of an account which delegated its vote and didn't
+// vote don't follow its delegate.
+
+// DelegateVote delegates the governance voting power of delegator to delegate,
+// replacing its previous vote delegation if any.
+func (keeper Keeper) DelegateVote(ctx context.Context, delegator, delegate sdk.AccAddress) error {
+	if delegator.Equals(delegate) {
+		return errorsmod.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate vote to self")
+	}
+
+	if err := keeper.removeVoteDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	if err := keeper.VoteDelegations.Set(ctx, delegator, delegate); err != nil {
+		return err
+	}
+
+	if err := keeper.VoteDelegators.Set(ctx, collections.Join(delegate, delegator)); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegate.String()),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateVote removes the vote delegation of delegator.
+func (keeper Keeper) UndelegateVote(ctx context.Context, delegator sdk.AccAddress) error {
+	has, err := keeper.VoteDelegations.Has(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errorsmod.Wrapf(types.ErrInvalidVoteDelegation, "%s has no vote delegation", delegator)
+	}
+
+	if err := keeper.removeVoteDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, delegator.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetVoteDelegate returns the delegate of delegator, or nil if it didn't
+// delegate its vote.
+func (keeper Keeper) GetVoteDelegate(ctx context.Context, delegator sdk.AccAddress) (sdk.AccAddress, error) {
+	delegate, err := keeper.VoteDelegations.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+
+	return delegate, err
+}
+
+// IterateVoteDelegators iterates over the accounts which delegated their vote
+// to delegate.
+func (keeper Keeper) IterateVoteDelegators(ctx context.Context, delegate sdk.AccAddress, cb func(delegator sdk.AccAddress) (stop bool, err error)) error {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegate)
+	return keeper.VoteDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		return cb(key.K2())
+	})
+}
+
+// removeVoteDelegation removes the vote delegation of delegator, if any.
+func (keeper Keeper) removeVoteDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegate, err := keeper.GetVoteDelegate(ctx, delegator)
+	if err != nil || delegate == nil {
+		return err
+	}
+
+	if err := keeper.VoteDelegators.Remove(ctx, collections.Join(delegate, delegator)); err != nil {
+		return err
+	}
+
+	return keeper.VoteDelegations.Remove(ctx, delegator)
+}
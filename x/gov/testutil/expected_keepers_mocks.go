@@ -213,6 +213,20 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, address, amt interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, address, amt)
 }
 
+// ChainMetadataProvider mocks base method.
+func (m *MockBankKeeper) ChainMetadataProvider() types0.ChainMetadataProvider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainMetadataProvider")
+	ret0, _ := ret[0].(types0.ChainMetadataProvider)
+	return ret0
+}
+
+// ChainMetadataProvider indicates an expected call of ChainMetadataProvider.
+func (mr *MockBankKeeperMockRecorder) ChainMetadataProvider() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainMetadataProvider", reflect.TypeOf((*MockBankKeeper)(nil).ChainMetadataProvider))
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -746,6 +760,21 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// ReapDustAccounts mocks base method.
+func (m *MockBankKeeper) ReapDustAccounts(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReapDustAccounts", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReapDustAccounts indicates an expected call of ReapDustAccounts.
+func (mr *MockBankKeeperMockRecorder) ReapDustAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapDustAccounts", reflect.TypeOf((*MockBankKeeper)(nil).ReapDustAccounts), ctx)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -925,6 +954,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SupplyChanges mocks base method.
+func (m *MockBankKeeper) SupplyChanges(arg0 context.Context, arg1 *types.QuerySupplyChangesRequest) (*types.QuerySupplyChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupplyChanges", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySupplyChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SupplyChanges indicates an expected call of SupplyChanges.
+func (mr *MockBankKeeperMockRecorder) SupplyChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyChanges", reflect.TypeOf((*MockBankKeeper)(nil).SupplyChanges), arg0, arg1)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
@@ -955,6 +999,21 @@ func (mr *MockBankKeeperMockRecorder) TotalSupply(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).TotalSupply), arg0, arg1)
 }
 
+// TotalSupplyAt mocks base method.
+func (m *MockBankKeeper) TotalSupplyAt(arg0 context.Context, arg1 *types.QueryTotalSupplyAtRequest) (*types.QueryTotalSupplyAtResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalSupplyAt", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryTotalSupplyAtResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalSupplyAt indicates an expected call of TotalSupplyAt.
+func (mr *MockBankKeeperMockRecorder) TotalSupplyAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSupplyAt", reflect.TypeOf((*MockBankKeeper)(nil).TotalSupplyAt), arg0, arg1)
+}
+
 // UndelegateCoins mocks base method.
 func (m *MockBankKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -1114,6 +1173,20 @@ func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
 }
 
+// IterateValidatorDelegations mocks base method.
+func (m *MockStakingKeeper) IterateValidatorDelegations(ctx context.Context, valAddr types0.ValAddress, fn func(int64, types0.DelegationI) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateValidatorDelegations", ctx, valAddr, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateValidatorDelegations indicates an expected call of IterateValidatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) IterateValidatorDelegations(ctx, valAddr, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateValidatorDelegations), ctx, valAddr, fn)
+}
+
 // TokensFromConsensusPower mocks base method.
 func (m *MockStakingKeeper) TokensFromConsensusPower(ctx context.Context, power int64) math.Int {
 	m.ctrl.T.Helper()
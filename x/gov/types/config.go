@@ -8,13 +8,16 @@ type Config struct {
 	MaxMetadataLen uint64
 	// MaxSummaryLen defines the amount of characters that can be used for proposal summary
 	MaxSummaryLen uint64
+	// MaxParamChanges defines the number of param changes kept in the history of each module
+	MaxParamChanges uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxTitleLen:    255,
-		MaxMetadataLen: 255,
-		MaxSummaryLen:  10200,
+		MaxTitleLen:     255,
+		MaxMetadataLen:  255,
+		MaxSummaryLen:   10200,
+		MaxParamChanges: 100,
 	}
 }
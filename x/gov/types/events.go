@@ -8,9 +8,12 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDelegateVote     = "delegate_vote"
+	EventTypeUndelegateVote   = "undelegate_vote"
 
 	AttributeKeyProposalResult               = "proposal_result"
 	AttributeKeyVoter                        = "voter"
+	AttributeKeyDelegate                     = "delegate"
 	AttributeKeyOption                       = "option"
 	AttributeKeyProposalID                   = "proposal_id"
 	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
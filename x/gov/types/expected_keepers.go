@@ -22,6 +22,10 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	IterateValidatorDelegations(
+		ctx context.Context, valAddr sdk.ValAddress,
+		fn func(index int64, delegation sdk.DelegationI) (stop bool),
+	) error
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	VoteDelegationsKeyPrefix      = collections.NewPrefix(50) // VoteDelegationsKeyPrefix stores the governance vote delegations.
+	VoteDelegatorsKeyPrefix       = collections.NewPrefix(51) // VoteDelegatorsKeyPrefix indexes the vote delegations by delegate.
+	ParamChangesKeyPrefix         = collections.NewPrefix(53) // ParamChangesKeyPrefix stores the history of the param changes of the modules.
+	ParamChangeSeqKey             = collections.NewPrefix(54) // ParamChangeSeqKey stores the sequence numbering the param changes.
 )
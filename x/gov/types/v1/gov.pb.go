@@ -73,6 +73,40 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// TallyMethod enumerates the ways the voting power of an account is weighted when tallying the votes of a
+// proposal.
+type TallyMethod int32
+
+const (
+	// TALLY_METHOD_STANDARD weights voting power linearly with stake.
+	TallyMethod_TALLY_METHOD_STANDARD TallyMethod = 0
+	// TALLY_METHOD_QUADRATIC weights voting power with the integer square root of stake.
+	TallyMethod_TALLY_METHOD_QUADRATIC TallyMethod = 1
+	// TALLY_METHOD_CAPPED weights voting power linearly with stake, up to a cap proportional to the total bonded
+	// tokens.
+	TallyMethod_TALLY_METHOD_CAPPED TallyMethod = 2
+)
+
+var TallyMethod_name = map[int32]string{
+	0: "TALLY_METHOD_STANDARD",
+	1: "TALLY_METHOD_QUADRATIC",
+	2: "TALLY_METHOD_CAPPED",
+}
+
+var TallyMethod_value = map[string]int32{
+	"TALLY_METHOD_STANDARD":  0,
+	"TALLY_METHOD_QUADRATIC": 1,
+	"TALLY_METHOD_CAPPED":    2,
+}
+
+func (x TallyMethod) String() string {
+	return proto.EnumName(TallyMethod_name, int32(x))
+}
+
+func (TallyMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -130,7 +164,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -179,7 +213,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -894,6 +928,10 @@ type Params struct {
 	//
 	// Since: x/gov v1.0.0
 	OptimisticRejectedThreshold string `protobuf:"bytes,18,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	// tally_methods defines the tally method of the proposal types which are not tallied with the standard method.
+	//
+	// Since: x/gov v1.0.0
+	TallyMethods []ProposalTallyMethod `protobuf:"bytes,19,rep,name=tally_methods,json=tallyMethods,proto3" json:"tally_methods"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1055,8 +1093,181 @@ func (m *Params) GetOptimisticRejectedThreshold() string {
 	return ""
 }
 
+func (m *Params) GetTallyMethods() []ProposalTallyMethod {
+	if m != nil {
+		return m.TallyMethods
+	}
+	return nil
+}
+
+// ProposalTallyMethod defines the tally method of a proposal type.
+//
+// With alternative methods, the voting power of an account is the sum of the voting power of its delegations,
+// including the stake it lets its validators vote with. Quorum is always computed on the stake which voted,
+// regardless of the method.
+//
+// Since: x/gov v1.0.0
+type ProposalTallyMethod struct {
+	// proposal_type is the type of the proposals tallied with method.
+	ProposalType ProposalType `protobuf:"varint,1,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// method is the tally method of the proposals.
+	Method TallyMethod `protobuf:"varint,2,opt,name=method,proto3,enum=cosmos.gov.v1.TallyMethod" json:"method,omitempty"`
+	// max_voting_power_ratio is the maximum voting power of an account, as a ratio of the total bonded tokens, with
+	// TALLY_METHOD_CAPPED.
+	MaxVotingPowerRatio string `protobuf:"bytes,3,opt,name=max_voting_power_ratio,json=maxVotingPowerRatio,proto3" json:"max_voting_power_ratio,omitempty"`
+}
+
+func (m *ProposalTallyMethod) Reset()         { *m = ProposalTallyMethod{} }
+func (m *ProposalTallyMethod) String() string { return proto.CompactTextString(m) }
+func (*ProposalTallyMethod) ProtoMessage()    {}
+func (*ProposalTallyMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *ProposalTallyMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTallyMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTallyMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTallyMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTallyMethod.Merge(m, src)
+}
+func (m *ProposalTallyMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTallyMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTallyMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTallyMethod proto.InternalMessageInfo
+
+func (m *ProposalTallyMethod) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *ProposalTallyMethod) GetMethod() TallyMethod {
+	if m != nil {
+		return m.Method
+	}
+	return TallyMethod_TALLY_METHOD_STANDARD
+}
+
+func (m *ProposalTallyMethod) GetMaxVotingPowerRatio() string {
+	if m != nil {
+		return m.MaxVotingPowerRatio
+	}
+	return ""
+}
+
+// ParamChange is the record of a change of the params of a module, applied by a MsgUpdateParams.
+//
+// Since: x/gov v1.0.0
+type ParamChange struct {
+	// module is the protobuf package of the MsgUpdateParams, e.g. cosmos.bank.v1beta1.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// height is the height at which the change was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// authority is the authority of the MsgUpdateParams.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal_id is the id of the governance proposal which executed the MsgUpdateParams, if any.
+	ProposalId uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// old_params are the JSON encoded params before the change, empty if the module does not serve its params with a
+	// Query/Params.
+	OldParams string `protobuf:"bytes,5,opt,name=old_params,json=oldParams,proto3" json:"old_params,omitempty"`
+	// new_params are the JSON encoded params after the change.
+	NewParams string `protobuf:"bytes,6,opt,name=new_params,json=newParams,proto3" json:"new_params,omitempty"`
+}
+
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ParamChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *ParamChange) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ParamChange) GetOldParams() string {
+	if m != nil {
+		return m.OldParams
+	}
+	return ""
+}
+
+func (m *ParamChange) GetNewParams() string {
+	if m != nil {
+		return m.NewParams
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.TallyMethod", TallyMethod_name, TallyMethod_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
@@ -1068,115 +1279,130 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposalTallyMethod)(nil), "cosmos.gov.v1.ProposalTallyMethod")
+	proto.RegisterType((*ParamChange)(nil), "cosmos.gov.v1.ParamChange")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0xe3, 0xc6,
-	0x19, 0x36, 0xf5, 0x65, 0xe9, 0xd5, 0x87, 0xe9, 0x59, 0x6f, 0x4c, 0xdb, 0xb1, 0xe4, 0xa8, 0x41,
-	0xe0, 0xba, 0x59, 0xa9, 0x4e, 0xba, 0x3d, 0x24, 0x05, 0x5a, 0x7d, 0x70, 0x6b, 0x1a, 0xb6, 0xa5,
-	0x52, 0xb4, 0xbd, 0xdb, 0x0b, 0x4b, 0x8b, 0xb3, 0x32, 0x5b, 0x91, 0xa3, 0x92, 0x23, 0xc5, 0xea,
-	0x0f, 0xe8, 0x39, 0xc7, 0x9e, 0x8a, 0xde, 0xda, 0x63, 0x0f, 0x41, 0xff, 0x41, 0x81, 0xa0, 0x87,
-	0x22, 0xc8, 0xa9, 0x97, 0x6e, 0x8b, 0xdd, 0x43, 0x81, 0xfc, 0x84, 0x9e, 0x02, 0x0e, 0x87, 0x22,
-	0x25, 0x2b, 0xb1, 0x9d, 0x8b, 0x4d, 0xbe, 0xef, 0xf3, 0x3c, 0xf3, 0xce, 0xfb, 0x31, 0x23, 0x09,
-	0x36, 0xfb, 0xc4, 0xb3, 0x89, 0x57, 0x1f, 0x90, 0x49, 0x7d, 0x72, 0xe8, 0xff, 0xab, 0x8d, 0x5c,
-	0x42, 0x09, 0x2a, 0x06, 0x8e, 0x9a, 0x6f, 0x99, 0x1c, 0x6e, 0x97, 0x39, 0xee, 0xca, 0xf0, 0x70,
-	0x7d, 0x72, 0x78, 0x85, 0xa9, 0x71, 0x58, 0xef, 0x13, 0xcb, 0x09, 0xe0, 0xdb, 0x1b, 0x03, 0x32,
-	0x20, 0xec, 0xb1, 0xee, 0x3f, 0x71, 0x6b, 0x65, 0x40, 0xc8, 0x60, 0x88, 0xeb, 0xec, 0xed, 0x6a,
-	0xfc, 0xb2, 0x4e, 0x2d, 0x1b, 0x7b, 0xd4, 0xb0, 0x47, 0x1c, 0xb0, 0xb5, 0x08, 0x30, 0x9c, 0x29,
-	0x77, 0x95, 0x17, 0x5d, 0xe6, 0xd8, 0x35, 0xa8, 0x45, 0xc2, 0x15, 0xb7, 0x82, 0x88, 0xf4, 0x60,
-	0x51, 0x1e, 0x6d, 0xe0, 0x5a, 0x37, 0x6c, 0xcb, 0x21, 0x75, 0xf6, 0x37, 0x30, 0x55, 0x09, 0xa0,
-	0x4b, 0x6c, 0x0d, 0xae, 0x29, 0x36, 0x2f, 0x08, 0xc5, 0x9d, 0x91, 0xaf, 0x84, 0x0e, 0x21, 0x43,
-	0xd8, 0x93, 0x24, 0xec, 0x09, 0xfb, 0xa5, 0x0f, 0xb6, 0x6a, 0x73, 0xbb, 0xae, 0x45, 0x50, 0x95,
-	0x03, 0xd1, 0x7b, 0x90, 0xf9, 0x84, 0x09, 0x49, 0x89, 0x3d, 0x61, 0x3f, 0xd7, 0x2c, 0x7d, 0xf9,
-	0xd9, 0x13, 0xe0, 0xac, 0x36, 0xee, 0xab, 0xdc, 0x5b, 0xfd, 0x93, 0x00, 0xab, 0x6d, 0x3c, 0x22,
-	0x9e, 0x45, 0x51, 0x05, 0xf2, 0x23, 0x97, 0x8c, 0x88, 0x67, 0x0c, 0x75, 0xcb, 0x64, 0x6b, 0xa5,
-	0x54, 0x08, 0x4d, 0x8a, 0x89, 0x7e, 0x0c, 0x39, 0x33, 0xc0, 0x12, 0x97, 0xeb, 0x4a, 0x5f, 0x7e,
-	0xf6, 0x64, 0x83, 0xeb, 0x36, 0x4c, 0xd3, 0xc5, 0x9e, 0xd7, 0xa3, 0xae, 0xe5, 0x0c, 0xd4, 0x08,
-	0x8a, 0x7e, 0x02, 0x19, 0xc3, 0x26, 0x63, 0x87, 0x4a, 0xc9, 0xbd, 0xe4, 0x7e, 0x3e, 0x8a, 0xdf,
-	0x2f, 0x53, 0x8d, 0x97, 0xa9, 0xd6, 0x22, 0x96, 0xd3, 0xcc, 0x7d, 0xfe, 0xaa, 0xb2, 0xf2, 0x97,
-	0xff, 0xfd, 0xf5, 0x40, 0x50, 0x39, 0xa7, 0xfa, 0x8f, 0x0c, 0x64, 0xbb, 0x3c, 0x08, 0x54, 0x82,
-	0xc4, 0x2c, 0xb4, 0x84, 0x65, 0xa2, 0x1f, 0x42, 0xd6, 0xc6, 0x9e, 0x67, 0x0c, 0xb0, 0x27, 0x25,
-	0x98, 0xf8, 0x46, 0x2d, 0xa8, 0x48, 0x2d, 0xac, 0x48, 0xad, 0xe1, 0x4c, 0xd5, 0x19, 0x0a, 0x3d,
-	0x85, 0x8c, 0x47, 0x0d, 0x3a, 0xf6, 0xa4, 0x24, 0x4b, 0xe6, 0xee, 0x42, 0x32, 0xc3, 0xa5, 0x7a,
-	0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x02, 0xf4, 0xd2, 0x72, 0x8c, 0xa1, 0x4e, 0x8d, 0xe1, 0x70, 0xaa,
-	0xbb, 0xd8, 0x1b, 0x0f, 0xa9, 0x94, 0xda, 0x13, 0xf6, 0xf3, 0x1f, 0x6c, 0x2f, 0x48, 0x68, 0x3e,
-	0x44, 0x65, 0x08, 0x55, 0x64, 0xac, 0x98, 0x05, 0x35, 0x20, 0xef, 0x8d, 0xaf, 0x6c, 0x8b, 0xea,
-	0x7e, 0x9b, 0x49, 0x69, 0x2e, 0xb1, 0x18, 0xb5, 0x16, 0xf6, 0x60, 0x33, 0xf5, 0xe9, 0x7f, 0x2a,
-	0x82, 0x0a, 0x01, 0xc9, 0x37, 0xa3, 0x63, 0x10, 0x79, 0x76, 0x75, 0xec, 0x98, 0x81, 0x4e, 0xe6,
-	0x9e, 0x3a, 0x25, 0xce, 0x94, 0x1d, 0x93, 0x69, 0x29, 0x50, 0xa4, 0x84, 0x1a, 0x43, 0x9d, 0xdb,
-	0xa5, 0xd5, 0x07, 0xd4, 0xa8, 0xc0, 0xa8, 0x61, 0x03, 0x9d, 0xc0, 0xfa, 0x84, 0x50, 0xcb, 0x19,
-	0xe8, 0x1e, 0x35, 0x5c, 0xbe, 0xbf, 0xec, 0x3d, 0xe3, 0x5a, 0x0b, 0xa8, 0x3d, 0x9f, 0xc9, 0x02,
-	0x3b, 0x02, 0x6e, 0x8a, 0xf6, 0x98, 0xbb, 0xa7, 0x56, 0x31, 0x20, 0x86, 0x5b, 0xdc, 0xf6, 0x9b,
-	0x84, 0x1a, 0xa6, 0x41, 0x0d, 0x09, 0xfc, 0xb6, 0x55, 0x67, 0xef, 0x68, 0x03, 0xd2, 0xd4, 0xa2,
-	0x43, 0x2c, 0xe5, 0x99, 0x23, 0x78, 0x41, 0x12, 0xac, 0x7a, 0x63, 0xdb, 0x36, 0xdc, 0xa9, 0x54,
-	0x60, 0xf6, 0xf0, 0x15, 0xfd, 0x08, 0xb2, 0xc1, 0x44, 0x60, 0x57, 0x2a, 0xde, 0x31, 0x02, 0x33,
-	0x24, 0xda, 0x83, 0x1c, 0xbe, 0x19, 0x61, 0xd3, 0xa2, 0xd8, 0x94, 0x4a, 0x7b, 0xc2, 0x7e, 0xb6,
-	0x99, 0x90, 0x04, 0x35, 0x32, 0xa2, 0xef, 0x41, 0xf1, 0xa5, 0x61, 0x0d, 0xb1, 0xa9, 0xbb, 0xd8,
-	0xf0, 0x88, 0x23, 0xad, 0xb1, 0x75, 0x0b, 0x81, 0x51, 0x65, 0x36, 0xf4, 0x33, 0x28, 0xce, 0x26,
-	0x94, 0x4e, 0x47, 0x58, 0x12, 0x59, 0x0b, 0xef, 0x7c, 0x43, 0x0b, 0x6b, 0xd3, 0x11, 0x56, 0x0b,
-	0xa3, 0xd8, 0x5b, 0xf5, 0xf7, 0x09, 0xc8, 0xc7, 0x9b, 0xf1, 0x07, 0x90, 0x9b, 0x62, 0x4f, 0xef,
-	0xb3, 0xe9, 0x14, 0x6e, 0x1d, 0x15, 0x8a, 0x43, 0xd5, 0xec, 0x14, 0x7b, 0x2d, 0xdf, 0x8f, 0x3e,
-	0x84, 0xa2, 0x71, 0xe5, 0x51, 0xc3, 0x72, 0x38, 0x21, 0xb1, 0x94, 0x50, 0xe0, 0xa0, 0x80, 0xf4,
-	0x7d, 0xc8, 0x3a, 0x84, 0xe3, 0x93, 0x4b, 0xf1, 0xab, 0x0e, 0x09, 0xa0, 0x1f, 0x03, 0x72, 0x88,
-	0xfe, 0x89, 0x45, 0xaf, 0xf5, 0x09, 0xa6, 0x21, 0x29, 0xb5, 0x94, 0xb4, 0xe6, 0x90, 0x4b, 0x8b,
-	0x5e, 0x5f, 0x60, 0xca, 0xc9, 0x4f, 0x00, 0xbc, 0x91, 0x61, 0x73, 0x52, 0x7a, 0x29, 0x29, 0xe7,
-	0x23, 0x18, 0xbc, 0xfa, 0x37, 0x01, 0x52, 0xfe, 0xb9, 0x79, 0xf7, 0xa9, 0x57, 0x83, 0xf4, 0x84,
-	0x50, 0x7c, 0xf7, 0x89, 0x17, 0xc0, 0xd0, 0xc7, 0xb0, 0x1a, 0x1c, 0xc2, 0x9e, 0x94, 0x62, 0xa3,
-	0xf4, 0xce, 0x42, 0x79, 0x6e, 0x9f, 0xf0, 0x6a, 0xc8, 0x98, 0x6b, 0xd5, 0xf4, 0x7c, 0xab, 0x1e,
-	0xa7, 0xb2, 0x49, 0x31, 0x55, 0xfd, 0xb7, 0x00, 0x45, 0x3e, 0x70, 0x5d, 0xc3, 0x35, 0x6c, 0x0f,
-	0xbd, 0x80, 0xbc, 0x6d, 0x39, 0xb3, 0xf9, 0x15, 0xee, 0x9a, 0xdf, 0x5d, 0x7f, 0x7e, 0xbf, 0x7a,
-	0x55, 0x79, 0x1c, 0x63, 0xbd, 0x4f, 0x6c, 0x8b, 0x62, 0x7b, 0x44, 0xa7, 0x2a, 0xd8, 0x96, 0x13,
-	0x4e, 0xb4, 0x0d, 0xc8, 0x36, 0x6e, 0x42, 0x90, 0x3e, 0xc2, 0xae, 0x45, 0x4c, 0x96, 0x08, 0x7f,
-	0x85, 0xc5, 0x31, 0x6c, 0xf3, 0xab, 0xaf, 0xf9, 0xee, 0x57, 0xaf, 0x2a, 0x6f, 0xdf, 0x26, 0x46,
-	0x8b, 0xfc, 0xc1, 0x9f, 0x52, 0xd1, 0x36, 0x6e, 0xc2, 0x9d, 0x30, 0xff, 0x47, 0x09, 0x49, 0xa8,
-	0x3e, 0x87, 0xc2, 0x05, 0x9b, 0x5e, 0xbe, 0xbb, 0x36, 0xf0, 0x69, 0x0e, 0x57, 0x17, 0xee, 0x5a,
-	0x3d, 0xc5, 0xd4, 0x0b, 0x01, 0x2b, 0xa6, 0xfc, 0x47, 0x81, 0xf7, 0x3e, 0x57, 0x7e, 0x0f, 0x32,
-	0xbf, 0x1d, 0x13, 0x77, 0x6c, 0x2f, 0x69, 0x7c, 0x76, 0x47, 0x06, 0x5e, 0xf4, 0x3e, 0xe4, 0xe8,
-	0xb5, 0x8b, 0xbd, 0x6b, 0x32, 0x34, 0xbf, 0xe1, 0x3a, 0x8d, 0x00, 0xe8, 0x29, 0x94, 0x58, 0xf3,
-	0x46, 0x94, 0xe4, 0x52, 0x4a, 0xd1, 0x47, 0x69, 0x21, 0x88, 0x05, 0xf8, 0xf7, 0x1c, 0x64, 0x78,
-	0x6c, 0xf2, 0x03, 0x6b, 0x1a, 0x3b, 0x93, 0xe3, 0xf5, 0x3b, 0xfd, 0x6e, 0xf5, 0x4b, 0x2d, 0xaf,
-	0xcf, 0xed, 0x5a, 0x24, 0xbf, 0x43, 0x2d, 0x62, 0x79, 0x4f, 0xdd, 0x3f, 0xef, 0xe9, 0x87, 0xe7,
-	0x3d, 0x73, 0x8f, 0xbc, 0x23, 0x05, 0xb6, 0xfc, 0x44, 0x5b, 0x8e, 0x45, 0xad, 0xe8, 0x12, 0xd4,
-	0x59, 0xf8, 0xd2, 0xea, 0x52, 0x85, 0xb7, 0x6c, 0xcb, 0x51, 0x02, 0x3c, 0x4f, 0x8f, 0xea, 0xa3,
-	0x51, 0x13, 0x1e, 0xcf, 0x4e, 0x92, 0xbe, 0xe1, 0xf4, 0xf1, 0x90, 0xcb, 0x64, 0x97, 0xca, 0x3c,
-	0x0a, 0xc1, 0x2d, 0x86, 0x0d, 0x34, 0x8e, 0x61, 0x63, 0x51, 0xc3, 0xc4, 0x1e, 0x65, 0x37, 0xdf,
-	0xb7, 0x9d, 0x3d, 0x68, 0x5e, 0xac, 0x8d, 0x3d, 0x8a, 0x2e, 0x61, 0x73, 0x76, 0xbf, 0xe8, 0xf3,
-	0x75, 0x83, 0xfb, 0xd5, 0xed, 0xf1, 0x8c, 0x7f, 0x11, 0x2f, 0xe0, 0x4f, 0xe1, 0x51, 0x24, 0x1c,
-	0xe5, 0x3b, 0xbf, 0x74, 0x9b, 0x68, 0x06, 0x8d, 0x92, 0xfe, 0x1c, 0x22, 0x65, 0x3d, 0xde, 0xe7,
-	0x85, 0x07, 0xf4, 0x79, 0x14, 0xc3, 0x69, 0xd4, 0xf0, 0xfb, 0x20, 0x5e, 0x8d, 0x5d, 0xc7, 0xdf,
-	0x2e, 0xd6, 0x79, 0x97, 0xf9, 0xd7, 0x74, 0x56, 0x2d, 0xf9, 0x76, 0xff, 0xc8, 0xfd, 0x45, 0xd0,
-	0x5d, 0x0d, 0xd8, 0x65, 0xc8, 0x59, 0xba, 0x67, 0x43, 0xe2, 0x62, 0x9f, 0x1d, 0x5c, 0xd3, 0xea,
-	0xb6, 0x0f, 0x0a, 0x2f, 0xd4, 0x70, 0x1a, 0x02, 0x04, 0x7a, 0x17, 0x4a, 0xd1, 0x62, 0x7e, 0x5b,
-	0xb1, 0x4b, 0x3b, 0xab, 0x16, 0xc2, 0xa5, 0xfc, 0xdb, 0x09, 0x7d, 0x04, 0xeb, 0xb1, 0x2d, 0xf2,
-	0x96, 0x10, 0x97, 0xe6, 0x6a, 0x2d, 0x1a, 0xdd, 0xa0, 0x1d, 0x7e, 0x05, 0x15, 0xff, 0x66, 0xb0,
-	0x2d, 0x8f, 0x5a, 0x7d, 0xdd, 0x18, 0xd3, 0x6b, 0xe2, 0x5a, 0xbf, 0xc3, 0xa6, 0x6e, 0x04, 0xd5,
-	0xc7, 0x9e, 0xb4, 0xbe, 0x97, 0xfc, 0xd6, 0xce, 0xd8, 0x8d, 0x04, 0x1a, 0x33, 0x7e, 0x23, 0xa4,
-	0x23, 0x15, 0x62, 0x00, 0xdd, 0xc5, 0xbf, 0xc6, 0xfd, 0xf9, 0xaa, 0xa2, 0xa5, 0x91, 0xee, 0x44,
-	0x24, 0x95, 0x73, 0x66, 0xe5, 0x3d, 0xf8, 0xb3, 0x00, 0x85, 0xf8, 0x67, 0x10, 0xb4, 0x0b, 0x5b,
-	0x5d, 0xb5, 0xd3, 0xed, 0xf4, 0x1a, 0x27, 0xba, 0xf6, 0xa2, 0x2b, 0xeb, 0xe7, 0x67, 0xbd, 0xae,
-	0xdc, 0x52, 0x9e, 0x29, 0x72, 0x5b, 0x5c, 0x41, 0xdb, 0xf0, 0xd6, 0xbc, 0xbb, 0xa7, 0x35, 0xce,
-	0xda, 0x0d, 0xb5, 0x2d, 0x0a, 0xe8, 0x1d, 0xd8, 0x9d, 0xf7, 0x9d, 0x9e, 0x9f, 0x68, 0x4a, 0xf7,
-	0x44, 0xd6, 0x5b, 0x47, 0x1d, 0xa5, 0x25, 0x8b, 0x09, 0xf4, 0x36, 0x48, 0xf3, 0x90, 0x4e, 0x57,
-	0x53, 0x4e, 0x95, 0x9e, 0xa6, 0xb4, 0xc4, 0x24, 0xda, 0x81, 0xcd, 0x79, 0xaf, 0xfc, 0xbc, 0x2b,
-	0xb7, 0x15, 0x4d, 0x6e, 0x8b, 0xa9, 0x83, 0xff, 0x0b, 0x00, 0xb1, 0x2f, 0x5a, 0x3b, 0xb0, 0x79,
-	0xd1, 0xd1, 0x02, 0x81, 0xce, 0xd9, 0x42, 0x94, 0x8f, 0x60, 0x2d, 0xee, 0xec, 0x9c, 0xc9, 0xa2,
-	0xb0, 0x68, 0x7c, 0x21, 0xf7, 0x6e, 0x1b, 0xb5, 0xcb, 0x8e, 0x98, 0x40, 0x9b, 0xf0, 0x28, 0x6e,
-	0x6c, 0x34, 0x7b, 0x5a, 0x43, 0x39, 0x13, 0x13, 0xe8, 0x31, 0xac, 0xcf, 0xa1, 0x8f, 0x54, 0x59,
-	0x16, 0x93, 0x08, 0x41, 0x29, 0x6e, 0x3e, 0xeb, 0x88, 0x49, 0xb4, 0x01, 0x62, 0xdc, 0xf6, 0xac,
-	0x73, 0xae, 0x8a, 0x29, 0x7f, 0xff, 0xf3, 0x48, 0xfd, 0x52, 0xd1, 0x8e, 0xf4, 0x0b, 0x59, 0xeb,
-	0x88, 0xa9, 0x45, 0x4e, 0xaf, 0xdb, 0x38, 0x15, 0xd3, 0xdb, 0x09, 0x51, 0x38, 0xf8, 0xa7, 0x00,
-	0xa5, 0xf9, 0x6f, 0x3b, 0xa8, 0x02, 0x3b, 0xb3, 0x64, 0xf5, 0xb4, 0x86, 0x76, 0xde, 0x5b, 0x48,
-	0x42, 0x15, 0xca, 0x8b, 0x80, 0xb6, 0xdc, 0xed, 0xf4, 0x14, 0x4d, 0xef, 0xca, 0xaa, 0xd2, 0x59,
-	0x2c, 0x19, 0xc7, 0x5c, 0x74, 0x34, 0xe5, 0xec, 0xe7, 0x21, 0x24, 0x31, 0x57, 0x71, 0x0e, 0xe9,
-	0x36, 0x7a, 0x3d, 0xb9, 0x2d, 0x26, 0xe7, 0xca, 0xc9, 0x7d, 0xaa, 0x7c, 0x2c, 0xb7, 0x58, 0xc5,
-	0x96, 0x31, 0x9f, 0x35, 0x94, 0x13, 0xb9, 0x2d, 0xa6, 0x9b, 0x4f, 0x3f, 0x7f, 0x5d, 0x16, 0xbe,
-	0x78, 0x5d, 0x16, 0xfe, 0xfb, 0xba, 0x2c, 0x7c, 0xfa, 0xa6, 0xbc, 0xf2, 0xc5, 0x9b, 0xf2, 0xca,
-	0xbf, 0xde, 0x94, 0x57, 0x7e, 0xb9, 0x13, 0xb4, 0xae, 0x67, 0xfe, 0xa6, 0x66, 0x91, 0xfa, 0x0d,
-	0xfb, 0x1d, 0xc1, 0xff, 0x00, 0xed, 0xd5, 0x27, 0x87, 0x57, 0x19, 0x76, 0xfc, 0x7d, 0xf8, 0x75,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x02, 0x8a, 0x3f, 0x65, 0x10, 0x00, 0x00,
+	// 1851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x25, 0x59, 0x96, 0x9e, 0xfe, 0x84, 0x19, 0xdb, 0x31, 0x6d, 0xaf, 0xff, 0xac, 0xba,
+	0x58, 0xb8, 0xee, 0x46, 0xae, 0xb3, 0x4d, 0x0f, 0xbb, 0x05, 0x5a, 0x5a, 0x62, 0x6a, 0x06, 0xb6,
+	0xa5, 0xa5, 0x68, 0x3b, 0x29, 0x50, 0xb0, 0xb4, 0x39, 0x91, 0xd9, 0x8a, 0x1c, 0x95, 0x1c, 0xd9,
+	0x56, 0x3f, 0x40, 0xcf, 0x7b, 0x6c, 0x2f, 0x45, 0x6f, 0xed, 0xb1, 0x87, 0x45, 0x3f, 0xc3, 0xa2,
+	0x87, 0x22, 0x58, 0xf4, 0xd0, 0x4b, 0xd3, 0x22, 0x39, 0x14, 0xd8, 0x8f, 0xd0, 0x53, 0x31, 0xc3,
+	0xa1, 0x48, 0xc9, 0xca, 0xda, 0xde, 0x4b, 0xa2, 0x79, 0xef, 0xf7, 0x7b, 0xf3, 0xe6, 0xfd, 0x9b,
+	0xa1, 0x61, 0xf1, 0x8c, 0x84, 0x1e, 0x09, 0xb7, 0xbb, 0xe4, 0x62, 0xfb, 0x62, 0x87, 0xfd, 0x57,
+	0xef, 0x07, 0x84, 0x12, 0x54, 0x89, 0x14, 0x75, 0x26, 0xb9, 0xd8, 0x59, 0x5e, 0x13, 0xb8, 0x53,
+	0x3b, 0xc4, 0xdb, 0x17, 0x3b, 0xa7, 0x98, 0xda, 0x3b, 0xdb, 0x67, 0xc4, 0xf5, 0x23, 0xf8, 0xf2,
+	0x7c, 0x97, 0x74, 0x09, 0xff, 0xb9, 0xcd, 0x7e, 0x09, 0xe9, 0x7a, 0x97, 0x90, 0x6e, 0x0f, 0x6f,
+	0xf3, 0xd5, 0xe9, 0xe0, 0xe5, 0x36, 0x75, 0x3d, 0x1c, 0x52, 0xdb, 0xeb, 0x0b, 0xc0, 0xd2, 0x24,
+	0xc0, 0xf6, 0x87, 0x42, 0xb5, 0x36, 0xa9, 0x72, 0x06, 0x81, 0x4d, 0x5d, 0x12, 0xef, 0xb8, 0x14,
+	0x79, 0x64, 0x45, 0x9b, 0x0a, 0x6f, 0x23, 0xd5, 0x03, 0xdb, 0x73, 0x7d, 0xb2, 0xcd, 0xff, 0x8d,
+	0x44, 0x35, 0x02, 0xe8, 0x04, 0xbb, 0xdd, 0x73, 0x8a, 0x9d, 0x63, 0x42, 0x71, 0xab, 0xcf, 0x2c,
+	0xa1, 0x1d, 0xc8, 0x13, 0xfe, 0x4b, 0x91, 0x36, 0xa4, 0xcd, 0xea, 0xe3, 0xa5, 0xfa, 0xd8, 0xa9,
+	0xeb, 0x09, 0xd4, 0x10, 0x40, 0xf4, 0x21, 0xe4, 0x2f, 0xb9, 0x21, 0x25, 0xb3, 0x21, 0x6d, 0x16,
+	0x77, 0xab, 0x5f, 0x7d, 0xf1, 0x08, 0x04, 0xab, 0x89, 0xcf, 0x0c, 0xa1, 0xad, 0xfd, 0x51, 0x82,
+	0xd9, 0x26, 0xee, 0x93, 0xd0, 0xa5, 0x68, 0x1d, 0x4a, 0xfd, 0x80, 0xf4, 0x49, 0x68, 0xf7, 0x2c,
+	0xd7, 0xe1, 0x7b, 0xe5, 0x0c, 0x88, 0x45, 0xba, 0x83, 0x7e, 0x08, 0x45, 0x27, 0xc2, 0x92, 0x40,
+	0xd8, 0x55, 0xbe, 0xfa, 0xe2, 0xd1, 0xbc, 0xb0, 0xab, 0x3a, 0x4e, 0x80, 0xc3, 0xb0, 0x43, 0x03,
+	0xd7, 0xef, 0x1a, 0x09, 0x14, 0xfd, 0x08, 0xf2, 0xb6, 0x47, 0x06, 0x3e, 0x55, 0xb2, 0x1b, 0xd9,
+	0xcd, 0x52, 0xe2, 0x3f, 0x4b, 0x53, 0x5d, 0xa4, 0xa9, 0xde, 0x20, 0xae, 0xbf, 0x5b, 0xfc, 0xf2,
+	0xf5, 0xfa, 0xbd, 0x3f, 0xff, 0xf7, 0x2f, 0x5b, 0x92, 0x21, 0x38, 0xb5, 0xbf, 0xe5, 0xa1, 0xd0,
+	0x16, 0x4e, 0xa0, 0x2a, 0x64, 0x46, 0xae, 0x65, 0x5c, 0x07, 0x7d, 0x1f, 0x0a, 0x1e, 0x0e, 0x43,
+	0xbb, 0x8b, 0x43, 0x25, 0xc3, 0x8d, 0xcf, 0xd7, 0xa3, 0x8c, 0xd4, 0xe3, 0x8c, 0xd4, 0x55, 0x7f,
+	0x68, 0x8c, 0x50, 0xe8, 0x09, 0xe4, 0x43, 0x6a, 0xd3, 0x41, 0xa8, 0x64, 0x79, 0x30, 0x57, 0x27,
+	0x82, 0x19, 0x6f, 0xd5, 0xe1, 0x20, 0x43, 0x80, 0xd1, 0x1e, 0xa0, 0x97, 0xae, 0x6f, 0xf7, 0x2c,
+	0x6a, 0xf7, 0x7a, 0x43, 0x2b, 0xc0, 0xe1, 0xa0, 0x47, 0x95, 0xdc, 0x86, 0xb4, 0x59, 0x7a, 0xbc,
+	0x3c, 0x61, 0xc2, 0x64, 0x10, 0x83, 0x23, 0x0c, 0x99, 0xb3, 0x52, 0x12, 0xa4, 0x42, 0x29, 0x1c,
+	0x9c, 0x7a, 0x2e, 0xb5, 0x58, 0x99, 0x29, 0x33, 0xc2, 0xc4, 0xa4, 0xd7, 0x66, 0x5c, 0x83, 0xbb,
+	0xb9, 0xcf, 0xff, 0xbd, 0x2e, 0x19, 0x10, 0x91, 0x98, 0x18, 0x3d, 0x03, 0x59, 0x44, 0xd7, 0xc2,
+	0xbe, 0x13, 0xd9, 0xc9, 0xdf, 0xd2, 0x4e, 0x55, 0x30, 0x35, 0xdf, 0xe1, 0xb6, 0x74, 0xa8, 0x50,
+	0x42, 0xed, 0x9e, 0x25, 0xe4, 0xca, 0xec, 0x1d, 0x72, 0x54, 0xe6, 0xd4, 0xb8, 0x80, 0xf6, 0xe1,
+	0xc1, 0x05, 0xa1, 0xae, 0xdf, 0xb5, 0x42, 0x6a, 0x07, 0xe2, 0x7c, 0x85, 0x5b, 0xfa, 0x75, 0x3f,
+	0xa2, 0x76, 0x18, 0x93, 0x3b, 0xb6, 0x07, 0x42, 0x94, 0x9c, 0xb1, 0x78, 0x4b, 0x5b, 0x95, 0x88,
+	0x18, 0x1f, 0x71, 0x99, 0x15, 0x09, 0xb5, 0x1d, 0x9b, 0xda, 0x0a, 0xb0, 0xb2, 0x35, 0x46, 0x6b,
+	0x34, 0x0f, 0x33, 0xd4, 0xa5, 0x3d, 0xac, 0x94, 0xb8, 0x22, 0x5a, 0x20, 0x05, 0x66, 0xc3, 0x81,
+	0xe7, 0xd9, 0xc1, 0x50, 0x29, 0x73, 0x79, 0xbc, 0x44, 0x3f, 0x80, 0x42, 0xd4, 0x11, 0x38, 0x50,
+	0x2a, 0x37, 0xb4, 0xc0, 0x08, 0x89, 0x36, 0xa0, 0x88, 0xaf, 0xfa, 0xd8, 0x71, 0x29, 0x76, 0x94,
+	0xea, 0x86, 0xb4, 0x59, 0xd8, 0xcd, 0x28, 0x92, 0x91, 0x08, 0xd1, 0x77, 0xa0, 0xf2, 0xd2, 0x76,
+	0x7b, 0xd8, 0xb1, 0x02, 0x6c, 0x87, 0xc4, 0x57, 0xee, 0xf3, 0x7d, 0xcb, 0x91, 0xd0, 0xe0, 0x32,
+	0xf4, 0x13, 0xa8, 0x8c, 0x3a, 0x94, 0x0e, 0xfb, 0x58, 0x91, 0x79, 0x09, 0xaf, 0xbc, 0xa3, 0x84,
+	0xcd, 0x61, 0x1f, 0x1b, 0xe5, 0x7e, 0x6a, 0x55, 0xfb, 0x6d, 0x06, 0x4a, 0xe9, 0x62, 0xfc, 0x1e,
+	0x14, 0x87, 0x38, 0xb4, 0xce, 0x78, 0x77, 0x4a, 0xd7, 0x46, 0x85, 0xee, 0x53, 0xa3, 0x30, 0xc4,
+	0x61, 0x83, 0xe9, 0xd1, 0xc7, 0x50, 0xb1, 0x4f, 0x43, 0x6a, 0xbb, 0xbe, 0x20, 0x64, 0xa6, 0x12,
+	0xca, 0x02, 0x14, 0x91, 0xbe, 0x0b, 0x05, 0x9f, 0x08, 0x7c, 0x76, 0x2a, 0x7e, 0xd6, 0x27, 0x11,
+	0xf4, 0x53, 0x40, 0x3e, 0xb1, 0x2e, 0x5d, 0x7a, 0x6e, 0x5d, 0x60, 0x1a, 0x93, 0x72, 0x53, 0x49,
+	0xf7, 0x7d, 0x72, 0xe2, 0xd2, 0xf3, 0x63, 0x4c, 0x05, 0xf9, 0x11, 0x40, 0xd8, 0xb7, 0x3d, 0x41,
+	0x9a, 0x99, 0x4a, 0x2a, 0x32, 0x04, 0x87, 0xd7, 0xfe, 0x2a, 0x41, 0x8e, 0xcd, 0xcd, 0x9b, 0xa7,
+	0x5e, 0x1d, 0x66, 0x2e, 0x08, 0xc5, 0x37, 0x4f, 0xbc, 0x08, 0x86, 0x3e, 0x85, 0xd9, 0x68, 0x08,
+	0x87, 0x4a, 0x8e, 0xb7, 0xd2, 0xfb, 0x13, 0xe9, 0xb9, 0x3e, 0xe1, 0x8d, 0x98, 0x31, 0x56, 0xaa,
+	0x33, 0xe3, 0xa5, 0xfa, 0x2c, 0x57, 0xc8, 0xca, 0xb9, 0xda, 0xbf, 0x24, 0xa8, 0x88, 0x86, 0x6b,
+	0xdb, 0x81, 0xed, 0x85, 0xe8, 0x05, 0x94, 0x3c, 0xd7, 0x1f, 0xf5, 0xaf, 0x74, 0x53, 0xff, 0xae,
+	0xb2, 0xfe, 0xfd, 0xfa, 0xf5, 0xfa, 0x42, 0x8a, 0xf5, 0x11, 0xf1, 0x5c, 0x8a, 0xbd, 0x3e, 0x1d,
+	0x1a, 0xe0, 0xb9, 0x7e, 0xdc, 0xd1, 0x1e, 0x20, 0xcf, 0xbe, 0x8a, 0x41, 0x56, 0x1f, 0x07, 0x2e,
+	0x71, 0x78, 0x20, 0xd8, 0x0e, 0x93, 0x6d, 0xd8, 0x14, 0x57, 0xdf, 0xee, 0x07, 0x5f, 0xbf, 0x5e,
+	0x7f, 0xef, 0x3a, 0x31, 0xd9, 0xe4, 0x77, 0xac, 0x4b, 0x65, 0xcf, 0xbe, 0x8a, 0x4f, 0xc2, 0xf5,
+	0x9f, 0x64, 0x14, 0xa9, 0xf6, 0x1c, 0xca, 0xc7, 0xbc, 0x7b, 0xc5, 0xe9, 0x9a, 0x20, 0xba, 0x39,
+	0xde, 0x5d, 0xba, 0x69, 0xf7, 0x1c, 0xb7, 0x5e, 0x8e, 0x58, 0x29, 0xcb, 0x7f, 0x90, 0x44, 0xed,
+	0x0b, 0xcb, 0x1f, 0x42, 0xfe, 0xd7, 0x03, 0x12, 0x0c, 0x3c, 0x45, 0x9a, 0x7e, 0x47, 0x46, 0x5a,
+	0xf4, 0x11, 0x14, 0xe9, 0x79, 0x80, 0xc3, 0x73, 0xd2, 0x73, 0xde, 0x71, 0x9d, 0x26, 0x00, 0xf4,
+	0x04, 0xaa, 0xbc, 0x78, 0x13, 0x4a, 0x76, 0x2a, 0xa5, 0xc2, 0x50, 0x66, 0x0c, 0xe2, 0x0e, 0xfe,
+	0x1e, 0x20, 0x2f, 0x7c, 0xd3, 0xee, 0x98, 0xd3, 0xd4, 0x4c, 0x4e, 0xe7, 0xef, 0xe0, 0xdb, 0xe5,
+	0x2f, 0x37, 0x3d, 0x3f, 0xd7, 0x73, 0x91, 0xfd, 0x16, 0xb9, 0x48, 0xc5, 0x3d, 0x77, 0xfb, 0xb8,
+	0xcf, 0xdc, 0x3d, 0xee, 0xf9, 0x5b, 0xc4, 0x1d, 0xe9, 0xb0, 0xc4, 0x02, 0xed, 0xfa, 0x2e, 0x75,
+	0x93, 0x4b, 0xd0, 0xe2, 0xee, 0x2b, 0xb3, 0x53, 0x2d, 0x3c, 0xf4, 0x5c, 0x5f, 0x8f, 0xf0, 0x22,
+	0x3c, 0x06, 0x43, 0xa3, 0x5d, 0x58, 0x18, 0x4d, 0x92, 0x33, 0xdb, 0x3f, 0xc3, 0x3d, 0x61, 0xa6,
+	0x30, 0xd5, 0xcc, 0x5c, 0x0c, 0x6e, 0x70, 0x6c, 0x64, 0xe3, 0x19, 0xcc, 0x4f, 0xda, 0x70, 0x70,
+	0x48, 0x95, 0xe2, 0x0d, 0xb3, 0x07, 0x8d, 0x1b, 0x6b, 0xe2, 0x90, 0xa2, 0x13, 0x58, 0x1c, 0xdd,
+	0x2f, 0xd6, 0x78, 0xde, 0xe0, 0x76, 0x79, 0x5b, 0x18, 0xf1, 0x8f, 0xd3, 0x09, 0xfc, 0x31, 0xcc,
+	0x25, 0x86, 0x93, 0x78, 0x97, 0xa6, 0x1e, 0x13, 0x8d, 0xa0, 0x49, 0xd0, 0x9f, 0x43, 0x62, 0xd9,
+	0x4a, 0xd7, 0x79, 0xf9, 0x0e, 0x75, 0x9e, 0xf8, 0x70, 0x90, 0x14, 0xfc, 0x26, 0xc8, 0xa7, 0x83,
+	0xc0, 0x67, 0xc7, 0xc5, 0x96, 0xa8, 0x32, 0x76, 0x4d, 0x17, 0x8c, 0x2a, 0x93, 0xb3, 0x91, 0xfb,
+	0x59, 0x54, 0x5d, 0x2a, 0xac, 0x72, 0xe4, 0x28, 0xdc, 0xa3, 0x26, 0x09, 0x30, 0x63, 0x47, 0xd7,
+	0xb4, 0xb1, 0xcc, 0x40, 0xf1, 0x85, 0x1a, 0x77, 0x43, 0x84, 0x40, 0x1f, 0x40, 0x35, 0xd9, 0x8c,
+	0x95, 0x15, 0xbf, 0xb4, 0x0b, 0x46, 0x39, 0xde, 0x8a, 0xdd, 0x4e, 0xe8, 0x13, 0x78, 0x90, 0x3a,
+	0xa2, 0x28, 0x09, 0x79, 0x6a, 0xac, 0xee, 0x27, 0xad, 0x1b, 0x95, 0xc3, 0x2f, 0x60, 0x9d, 0xdd,
+	0x0c, 0x9e, 0x1b, 0x52, 0xf7, 0xcc, 0xb2, 0x07, 0xf4, 0x9c, 0x04, 0xee, 0x6f, 0xb0, 0x63, 0xd9,
+	0x51, 0xf6, 0x71, 0xa8, 0x3c, 0xd8, 0xc8, 0x7e, 0x63, 0x65, 0xac, 0x26, 0x06, 0xd4, 0x11, 0x5f,
+	0x8d, 0xe9, 0xc8, 0x80, 0x14, 0xc0, 0x0a, 0xf0, 0x2f, 0xf1, 0xd9, 0x78, 0x56, 0xd1, 0x54, 0x4f,
+	0x57, 0x12, 0x92, 0x21, 0x38, 0x49, 0x7a, 0x0d, 0xa8, 0x44, 0xaf, 0x64, 0x0f, 0xd3, 0x73, 0xe2,
+	0x84, 0xca, 0x1c, 0x4f, 0x6b, 0xed, 0x5d, 0xcf, 0x14, 0x86, 0x3d, 0xe0, 0xd0, 0xf1, 0xb7, 0x65,
+	0x22, 0x0f, 0x6b, 0xaf, 0x24, 0x98, 0x9b, 0x42, 0xb8, 0xfe, 0x24, 0x92, 0xee, 0xf8, 0x24, 0x42,
+	0x8f, 0x21, 0x1f, 0xf9, 0xc9, 0xe7, 0x62, 0x75, 0xfa, 0x6b, 0x3e, 0xda, 0xcd, 0x10, 0x48, 0xd4,
+	0x80, 0x87, 0x6c, 0xae, 0xc6, 0x4d, 0x45, 0x2e, 0x71, 0x20, 0x12, 0x3b, 0x7d, 0xd8, 0xcf, 0x79,
+	0xf6, 0x95, 0x68, 0x21, 0x86, 0xe5, 0xc9, 0xad, 0xfd, 0x43, 0x82, 0x12, 0x1f, 0xf7, 0x8d, 0x73,
+	0xdb, 0xef, 0x62, 0xf4, 0x10, 0xf2, 0x1e, 0x71, 0x06, 0xbd, 0xe8, 0x0c, 0x45, 0x43, 0xac, 0x98,
+	0xfc, 0x3c, 0xf9, 0x96, 0xcb, 0x1a, 0x62, 0xc5, 0x3e, 0xc7, 0x44, 0x45, 0xd0, 0xa1, 0xd8, 0xf7,
+	0x1b, 0x3e, 0xc7, 0x46, 0xd0, 0xc9, 0x17, 0x4f, 0xee, 0xda, 0x8b, 0x67, 0x15, 0x80, 0xf4, 0x1c,
+	0xab, 0xcf, 0xaf, 0x22, 0xf1, 0x0c, 0x29, 0x92, 0x9e, 0x23, 0xee, 0xa6, 0x55, 0x00, 0x1f, 0x5f,
+	0xc6, 0xea, 0x7c, 0xa4, 0xf6, 0xf1, 0x65, 0xa4, 0xde, 0xfa, 0x93, 0x04, 0xe5, 0x74, 0xb8, 0xd1,
+	0x2a, 0x2c, 0xb5, 0x8d, 0x56, 0xbb, 0xd5, 0x51, 0xf7, 0x2d, 0xf3, 0x45, 0x5b, 0xb3, 0x8e, 0x0e,
+	0x3b, 0x6d, 0xad, 0xa1, 0x3f, 0xd5, 0xb5, 0xa6, 0x7c, 0x0f, 0x2d, 0xc3, 0xc3, 0x71, 0x75, 0xc7,
+	0x54, 0x0f, 0x9b, 0xaa, 0xd1, 0x94, 0x25, 0xf4, 0x3e, 0xac, 0x8e, 0xeb, 0x0e, 0x8e, 0xf6, 0x4d,
+	0xbd, 0xbd, 0xaf, 0x59, 0x8d, 0xbd, 0x96, 0xde, 0xd0, 0xe4, 0x0c, 0x7a, 0x0f, 0x94, 0x71, 0x48,
+	0xab, 0x6d, 0xea, 0x07, 0x7a, 0xc7, 0xd4, 0x1b, 0x72, 0x16, 0xad, 0xc0, 0xe2, 0xb8, 0x56, 0x7b,
+	0xde, 0xd6, 0x9a, 0xba, 0xa9, 0x35, 0xe5, 0xdc, 0xd6, 0xcf, 0xa1, 0x94, 0x4a, 0x2e, 0x5a, 0x82,
+	0x05, 0x53, 0xdd, 0xdf, 0x7f, 0x61, 0x1d, 0x68, 0xe6, 0x5e, 0xab, 0x99, 0xf8, 0xc1, 0x7d, 0x1c,
+	0x53, 0x7d, 0x76, 0xa4, 0x36, 0x0d, 0x95, 0x6d, 0x21, 0xa1, 0x45, 0x98, 0x1b, 0xd3, 0x35, 0xd4,
+	0x76, 0x5b, 0x6b, 0xca, 0x99, 0xad, 0xff, 0x49, 0x00, 0xa9, 0xaf, 0xf8, 0x15, 0x58, 0x3c, 0x6e,
+	0x99, 0x91, 0x7f, 0xad, 0xc3, 0x89, 0x20, 0xcc, 0xc1, 0xfd, 0xb4, 0xb2, 0x75, 0xa8, 0xc9, 0xd2,
+	0xa4, 0xf0, 0x85, 0xd6, 0xb9, 0x2e, 0x34, 0x4f, 0x5a, 0x72, 0x86, 0xf9, 0x90, 0x16, 0xaa, 0xbb,
+	0x1d, 0x53, 0xd5, 0x0f, 0xe5, 0x0c, 0x5a, 0x80, 0x07, 0x63, 0xe8, 0x3d, 0x43, 0xd3, 0xe4, 0x2c,
+	0x42, 0x50, 0x4d, 0x8b, 0x0f, 0x5b, 0x72, 0x16, 0xcd, 0x83, 0x9c, 0x96, 0x3d, 0x6d, 0x1d, 0x19,
+	0x72, 0x8e, 0x85, 0x77, 0x1c, 0x69, 0x9d, 0xe8, 0xe6, 0x9e, 0x75, 0xac, 0x99, 0x2d, 0x39, 0x37,
+	0xc9, 0xe9, 0xb4, 0xd5, 0x03, 0x79, 0x66, 0x39, 0x23, 0x4b, 0x5b, 0x7f, 0x97, 0xa0, 0x3a, 0xfe,
+	0x29, 0x8d, 0xd6, 0x61, 0x65, 0x94, 0x8b, 0x8e, 0xa9, 0x9a, 0x47, 0x9d, 0x89, 0x20, 0xd4, 0x60,
+	0x6d, 0x12, 0xd0, 0xd4, 0xda, 0xad, 0x8e, 0x6e, 0x5a, 0x6d, 0xcd, 0xd0, 0x5b, 0x93, 0x15, 0x21,
+	0x30, 0xc7, 0x2d, 0x53, 0x3f, 0xfc, 0x69, 0x0c, 0xc9, 0x8c, 0x15, 0x94, 0x80, 0xb4, 0xd5, 0x4e,
+	0x47, 0x6b, 0xca, 0xd9, 0xb1, 0x6a, 0x11, 0x3a, 0x43, 0x7b, 0xa6, 0x35, 0x78, 0x41, 0x4c, 0x63,
+	0x3e, 0x55, 0xf5, 0x7d, 0xad, 0x29, 0xcf, 0xec, 0x3e, 0xf9, 0xf2, 0xcd, 0x9a, 0xf4, 0xea, 0xcd,
+	0x9a, 0xf4, 0x9f, 0x37, 0x6b, 0xd2, 0xe7, 0x6f, 0xd7, 0xee, 0xbd, 0x7a, 0xbb, 0x76, 0xef, 0x9f,
+	0x6f, 0xd7, 0xee, 0xfd, 0x6c, 0x25, 0x6a, 0xb8, 0xd0, 0xf9, 0x55, 0xdd, 0x25, 0xdb, 0x57, 0xfc,
+	0x8f, 0x54, 0x6c, 0x14, 0x85, 0xec, 0x2f, 0x50, 0x79, 0x7e, 0xb7, 0x7e, 0xfc, 0xff, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x99, 0x16, 0x92, 0xe9, 0xc2, 0x12, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1686,6 +1912,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TallyMethods) > 0 {
+		for iNdEx := len(m.TallyMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if len(m.OptimisticRejectedThreshold) > 0 {
 		i -= len(m.OptimisticRejectedThreshold)
 		copy(dAtA[i:], m.OptimisticRejectedThreshold)
@@ -1855,6 +2097,107 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTallyMethod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTallyMethod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTallyMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxVotingPowerRatio) > 0 {
+		i -= len(m.MaxVotingPowerRatio)
+		copy(dAtA[i:], m.MaxVotingPowerRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MaxVotingPowerRatio)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Method != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewParams) > 0 {
+		i -= len(m.NewParams)
+		copy(dAtA[i:], m.NewParams)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NewParams)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OldParams) > 0 {
+		i -= len(m.OldParams)
+		copy(dAtA[i:], m.OldParams)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OldParams)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -2166,6 +2509,62 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.TallyMethods) > 0 {
+		for _, e := range m.TallyMethods {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalTallyMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	if m.Method != 0 {
+		n += 1 + sovGov(uint64(m.Method))
+	}
+	l = len(m.MaxVotingPowerRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.OldParams)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NewParams)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -4260,6 +4659,376 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMethods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyMethods = append(m.TallyMethods, ProposalTallyMethod{})
+			if err := m.TallyMethods[len(m.TallyMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTallyMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTallyMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTallyMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= TallyMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxVotingPowerRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
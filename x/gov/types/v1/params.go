@@ -245,5 +245,17 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		}
 	}
 
+	proposalTypes := make(map[ProposalType]bool, len(p.TallyMethods))
+	for _, method := range p.TallyMethods {
+		if err := method.Validate(); err != nil {
+			return fmt.Errorf("invalid tally method of %s proposals: %w", method.ProposalType, err)
+		}
+
+		if proposalTypes[method.ProposalType] {
+			return fmt.Errorf("duplicate tally method of %s proposals", method.ProposalType)
+		}
+		proposalTypes[method.ProposalType] = true
+	}
+
 	return nil
 }
@@ -975,6 +975,120 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryParamChangesRequest is the request type for the Query/ParamChanges RPC method.
+//
+// Since: x/gov v1.0.0
+type QueryParamChangesRequest struct {
+	// module is the protobuf package of the module, e.g. cosmos.bank.v1beta1.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangesRequest) Reset()         { *m = QueryParamChangesRequest{} }
+func (m *QueryParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangesRequest) ProtoMessage()    {}
+func (*QueryParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangesRequest.Merge(m, src)
+}
+func (m *QueryParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryParamChangesRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryParamChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamChangesResponse is the response type for the Query/ParamChanges RPC method.
+//
+// Since: x/gov v1.0.0
+type QueryParamChangesResponse struct {
+	// param_changes defines the requested param changes.
+	ParamChanges []*ParamChange `protobuf:"bytes,1,rep,name=param_changes,json=paramChanges,proto3" json:"param_changes,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangesResponse) Reset()         { *m = QueryParamChangesResponse{} }
+func (m *QueryParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangesResponse) ProtoMessage()    {}
+func (*QueryParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangesResponse.Merge(m, src)
+}
+func (m *QueryParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryParamChangesResponse) GetParamChanges() []*ParamChange {
+	if m != nil {
+		return m.ParamChanges
+	}
+	return nil
+}
+
+func (m *QueryParamChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -994,76 +1108,84 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryParamChangesRequest)(nil), "cosmos.gov.v1.QueryParamChangesRequest")
+	proto.RegisterType((*QueryParamChangesResponse)(nil), "cosmos.gov.v1.QueryParamChangesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
-	0x14, 0x8e, 0x27, 0x8f, 0x66, 0x4e, 0x1e, 0xc0, 0xe9, 0x23, 0xae, 0xd3, 0x4e, 0x83, 0x43, 0x93,
-	0xf0, 0xa8, 0xcd, 0xa4, 0x4d, 0x2b, 0x41, 0x11, 0x6a, 0x5a, 0xa5, 0x20, 0xb1, 0x08, 0x6e, 0xc5,
-	0x82, 0x4d, 0xe4, 0x66, 0x2c, 0xcb, 0x62, 0xea, 0xeb, 0xce, 0xbd, 0x33, 0x22, 0xa4, 0x11, 0x52,
-	0x25, 0x1e, 0x2b, 0x40, 0xa2, 0x02, 0x7e, 0x08, 0x3f, 0x82, 0x65, 0x05, 0x1b, 0x96, 0x28, 0xe1,
-	0x87, 0x20, 0xdf, 0x7b, 0xec, 0xb1, 0x1d, 0x8f, 0x67, 0x52, 0x45, 0x2c, 0xe7, 0xde, 0xef, 0x7c,
-	0xe7, 0x3b, 0x8f, 0x7b, 0x8e, 0x07, 0x2e, 0xee, 0x32, 0xfe, 0x98, 0x71, 0xdb, 0x67, 0x3d, 0xbb,
-	0xd7, 0xb4, 0x9f, 0x74, 0xbd, 0xce, 0x9e, 0x15, 0x75, 0x98, 0x60, 0x38, 0xa7, 0xae, 0x2c, 0x9f,
-	0xf5, 0xac, 0x5e, 0xd3, 0x78, 0x8b, 0x90, 0x8f, 0x5c, 0xee, 0x29, 0x9c, 0xdd, 0x6b, 0x3e, 0xf2,
-	0x84, 0xdb, 0xb4, 0x23, 0xd7, 0x0f, 0x42, 0x57, 0x04, 0x2c, 0x54, 0xa6, 0xc6, 0x25, 0x9f, 0x31,
-	0xbf, 0xed, 0xd9, 0x6e, 0x14, 0xd8, 0x6e, 0x18, 0x32, 0x21, 0x2f, 0x39, 0xdd, 0x2e, 0xe4, 0x7d,
-	0xc6, 0xfc, 0xea, 0x82, 0xc4, 0xec, 0xc8, 0x5f, 0x36, 0xb9, 0x97, 0x3f, 0x4c, 0x03, 0xf4, 0x4f,
-	0x63, 0x9f, 0x77, 0x59, 0xc8, 0x45, 0x20, 0xba, 0x31, 0x9f, 0xe3, 0x3d, 0xe9, 0x7a, 0x5c, 0x98,
-	0x1f, 0xc2, 0xc5, 0x92, 0x3b, 0x1e, 0xb1, 0x90, 0x7b, 0x68, 0xc2, 0xec, 0x6e, 0xe6, 0x5c, 0xd7,
-	0x96, 0xb4, 0xb5, 0xba, 0x93, 0x3b, 0x33, 0x6f, 0xc1, 0x39, 0x49, 0xb0, 0xdd, 0x61, 0x11, 0xe3,
-	0x6e, 0x9b, 0x88, 0xf1, 0x0a, 0xcc, 0x44, 0x74, 0xb4, 0x13, 0xb4, 0xa4, 0xe9, 0x84, 0x03, 0xc9,
-	0xd1, 0xc7, 0x2d, 0xf3, 0x13, 0x38, 0x5f, 0x30, 0x24, 0xaf, 0xd7, 0x61, 0x3a, 0x81, 0x49, 0xb3,
-	0x99, 0xf5, 0x05, 0x2b, 0x97, 0x4e, 0x2b, 0x35, 0x49, 0x81, 0xe6, 0x8f, 0xb5, 0x02, 0x1d, 0x4f,
-	0x84, 0x6c, 0xc1, 0x2b, 0xa9, 0x10, 0x2e, 0x5c, 0xd1, 0xe5, 0x92, 0x75, 0x7e, 0xfd, 0xf2, 0x00,
-	0xd6, 0x07, 0x12, 0xe4, 0xcc, 0x47, 0xb9, 0xdf, 0x68, 0xc1, 0x64, 0x8f, 0x09, 0xaf, 0xa3, 0xd7,
-	0xe2, 0x2c, 0x6c, 0xea, 0x7f, 0xfe, 0x7e, 0xed, 0x1c, 0x11, 0xdc, 0x69, 0xb5, 0x3a, 0x1e, 0xe7,
-	0x0f, 0x44, 0x27, 0x08, 0x7d, 0x47, 0xc1, 0xf0, 0x26, 0xd4, 0x5b, 0x5e, 0xc4, 0x78, 0x20, 0x58,
-	0x47, 0x1f, 0x1f, 0x62, 0xd3, 0x87, 0xe2, 0x16, 0x40, 0xbf, 0x27, 0xf4, 0x09, 0x99, 0x80, 0x95,
-	0x44, 0x6a, 0xdc, 0x40, 0x96, 0x6a, 0x34, 0x6a, 0x20, 0x6b, 0xdb, 0xf5, 0x3d, 0x8a, 0xd5, 0xc9,
-	0x58, 0x9a, 0xbf, 0x69, 0x70, 0xa1, 0x98, 0x11, 0xca, 0xf0, 0x06, 0xd4, 0x93, 0xe0, 0xe2, 0x64,
-	0x8c, 0x57, 0xa5, 0xb8, 0x8f, 0xc4, 0xfb, 0x39, 0x65, 0x35, 0xa9, 0x6c, 0x75, 0xa8, 0x32, 0xe5,
-	0x33, 0x27, 0x6d, 0x17, 0x5e, 0x95, 0xca, 0x3e, 0x63, 0xc2, 0x1b, 0xb5, 0x5f, 0x4e, 0x9a, 0x7f,
-	0xf3, 0x36, 0xbc, 0x96, 0x71, 0x42, 0x91, 0xaf, 0xc2, 0x44, 0x7c, 0x4b, 0x7d, 0x75, 0xb6, 0x10,
-	0xb4, 0x84, 0x4a, 0x80, 0xf9, 0x34, 0x63, 0xcd, 0x47, 0xd6, 0xb8, 0x55, 0x92, 0xa1, 0x97, 0xa9,
-	0xdd, 0xf7, 0x1a, 0x60, 0xd6, 0x3d, 0xa9, 0x7f, 0x53, 0xa5, 0x20, 0xa9, 0x59, 0xa9, 0x7c, 0x85,
-	0x38, 0xbd, 0x5a, 0x6d, 0x90, 0x92, 0x6d, 0xb7, 0xe3, 0x3e, 0xce, 0x65, 0x42, 0x1e, 0xec, 0x88,
-	0xbd, 0xc8, 0xa3, 0xc1, 0x00, 0xea, 0xe8, 0xe1, 0x5e, 0xe4, 0x99, 0xbf, 0xd4, 0xe0, 0x6c, 0xce,
-	0x8e, 0x42, 0xb8, 0x07, 0x73, 0x3d, 0x26, 0x82, 0xd0, 0xdf, 0x51, 0x60, 0xaa, 0xc4, 0xe2, 0xf1,
-	0x50, 0x82, 0xd0, 0x57, 0xb6, 0x9b, 0x35, 0x5d, 0x73, 0x66, 0x7b, 0x99, 0x13, 0xbc, 0x0f, 0xf3,
-	0xf4, 0x60, 0x12, 0x1a, 0x15, 0xe1, 0xa5, 0x02, 0xcd, 0x3d, 0x05, 0xca, 0xf0, 0xcc, 0xb5, 0xb2,
-	0x47, 0x78, 0x07, 0x66, 0x85, 0xdb, 0x6e, 0xef, 0x25, 0x34, 0xe3, 0x92, 0xc6, 0x28, 0xd0, 0x3c,
-	0x8c, 0x21, 0x19, 0x92, 0x19, 0xd1, 0x3f, 0xc0, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xad, 0x9e, 0x2f,
-	0xbe, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x8d, 0xdc, 0x5a, 0xb9, 0x71, 0x52,
-	0x1b, 0x79, 0x9c, 0x98, 0x1f, 0xd1, 0x7c, 0x4e, 0xfd, 0x51, 0x21, 0xde, 0x85, 0x33, 0x04, 0xa2,
-	0x12, 0x5c, 0x28, 0xcf, 0x9d, 0x93, 0xc0, 0xcc, 0xaf, 0xf3, 0x4c, 0xff, 0xff, 0xab, 0x78, 0xae,
-	0xd1, 0x8c, 0xef, 0x2b, 0xa0, 0x60, 0xd6, 0x61, 0x9a, 0x54, 0x26, 0x6f, 0x63, 0x50, 0x34, 0x29,
-	0xee, 0xf4, 0x5e, 0xc8, 0x7b, 0xb0, 0x20, 0x55, 0xc9, 0x2e, 0x71, 0x3c, 0xde, 0x6d, 0x8b, 0x13,
-	0x2c, 0x41, 0xfd, 0xb8, 0x6d, 0x5a, 0xa1, 0x49, 0xd9, 0x67, 0x54, 0x9f, 0xd2, 0xa6, 0x24, 0x13,
-	0x05, 0x5c, 0x3f, 0xaa, 0xc3, 0xa4, 0xa4, 0xc3, 0x6f, 0x35, 0x98, 0xcd, 0xae, 0x74, 0x5c, 0x2d,
-	0x58, 0x0f, 0xfa, 0x20, 0x30, 0xd6, 0x86, 0x03, 0x95, 0x3e, 0x73, 0xf9, 0xd9, 0x5f, 0xff, 0xfe,
-	0x5c, 0xbb, 0x8c, 0x8b, 0x76, 0xfe, 0x9b, 0x24, 0xfb, 0x79, 0x80, 0xdf, 0x68, 0x30, 0x9d, 0xec,
-	0x12, 0x5c, 0x2e, 0xe3, 0x2e, 0x7c, 0x38, 0x18, 0x6f, 0x54, 0x83, 0xc8, 0xb9, 0x25, 0x9d, 0xaf,
-	0xe1, 0x4a, 0xc1, 0x79, 0xba, 0xad, 0xec, 0xfd, 0x4c, 0xe6, 0x0f, 0xf0, 0x2b, 0xa8, 0xa7, 0x7b,
-	0x10, 0x2b, 0x5d, 0x24, 0x7d, 0x6d, 0x5c, 0x1d, 0x82, 0x22, 0x25, 0x4b, 0x52, 0x89, 0x81, 0xfa,
-	0x20, 0x25, 0xf8, 0x9d, 0x06, 0x13, 0xf1, 0x6c, 0xc6, 0x2b, 0x65, 0x8c, 0x99, 0x25, 0x68, 0x2c,
-	0x0d, 0x06, 0x90, 0xb7, 0xdb, 0xd2, 0xdb, 0x4d, 0xbc, 0x31, 0x5a, 0xdc, 0xb6, 0xdc, 0x06, 0xf6,
-	0xbe, 0x5c, 0x89, 0x07, 0xf8, 0x4c, 0x83, 0x49, 0xb9, 0x52, 0x70, 0xa0, 0xa7, 0x34, 0xfc, 0xd7,
-	0x2b, 0x10, 0x24, 0xe6, 0x86, 0x14, 0x63, 0xe1, 0x3b, 0x27, 0x11, 0x83, 0x4f, 0x61, 0x8a, 0x46,
-	0x67, 0xa9, 0x8b, 0xdc, 0xa2, 0x31, 0xcc, 0x2a, 0x08, 0xc9, 0x78, 0x5b, 0xca, 0xb8, 0x8a, 0xcb,
-	0x45, 0x19, 0x12, 0x66, 0xef, 0x67, 0x36, 0xd5, 0x01, 0xfe, 0xaa, 0xc1, 0x19, 0x1a, 0x06, 0x58,
-	0x4a, 0x9e, 0x1f, 0xcc, 0xc6, 0x72, 0x25, 0x86, 0x14, 0xdc, 0x95, 0x0a, 0x3e, 0xc0, 0xf7, 0x47,
-	0x4c, 0x44, 0x32, 0x84, 0xec, 0xfd, 0x74, 0x50, 0x1f, 0xe0, 0x0f, 0x1a, 0x4c, 0x27, 0x93, 0x0d,
-	0xab, 0xdc, 0xf2, 0xca, 0xa7, 0x52, 0x1c, 0x8e, 0xe6, 0x2d, 0x29, 0xae, 0x89, 0xf6, 0x09, 0xc5,
-	0xe1, 0x73, 0x0d, 0x66, 0x32, 0x53, 0x06, 0x57, 0xca, 0xdc, 0x1d, 0x9f, 0x7a, 0xc6, 0xea, 0x50,
-	0xdc, 0x4b, 0xf6, 0x8f, 0x9c, 0x72, 0x9b, 0x1b, 0x7f, 0x1c, 0x36, 0xb4, 0x17, 0x87, 0x0d, 0xed,
-	0x9f, 0xc3, 0x86, 0xf6, 0xd3, 0x51, 0x63, 0xec, 0xc5, 0x51, 0x63, 0xec, 0xef, 0xa3, 0xc6, 0xd8,
-	0xe7, 0x8b, 0x8a, 0x86, 0xb7, 0xbe, 0xb0, 0x02, 0x66, 0x7f, 0x29, 0xe9, 0xe2, 0xaa, 0xf3, 0xf8,
-	0xdf, 0xd6, 0x94, 0xfc, 0x33, 0x74, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0x70, 0x46,
-	0xfc, 0xb6, 0x0d, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x54,
+	0x10, 0xae, 0x37, 0x97, 0x26, 0x93, 0x4d, 0x80, 0x69, 0x2e, 0x8e, 0xd3, 0x6e, 0x83, 0x43, 0x2e,
+	0x5c, 0x62, 0xb3, 0x69, 0xd3, 0x4a, 0x50, 0x54, 0x35, 0xa9, 0x52, 0x90, 0x78, 0x08, 0x6e, 0xc5,
+	0x03, 0x2f, 0x2b, 0x37, 0x6b, 0x19, 0x8b, 0x8d, 0x8f, 0xbb, 0xc7, 0xbb, 0x22, 0xdd, 0xae, 0x90,
+	0x2a, 0x71, 0x79, 0x82, 0x4a, 0x54, 0xc0, 0x1f, 0xe0, 0x1f, 0xf0, 0x23, 0x78, 0xac, 0xe0, 0x85,
+	0x47, 0x94, 0xf0, 0x1b, 0x78, 0x46, 0x3e, 0x67, 0xbc, 0x6b, 0x3b, 0xde, 0x4b, 0xaa, 0x88, 0xa7,
+	0x95, 0xcf, 0xf9, 0xe6, 0x9b, 0x6f, 0xe6, 0xcc, 0x99, 0x39, 0x0b, 0x8b, 0x07, 0x8c, 0x1f, 0x32,
+	0x6e, 0xba, 0xac, 0x69, 0x36, 0xcb, 0xe6, 0xa3, 0x86, 0x53, 0x3f, 0x32, 0x82, 0x3a, 0x0b, 0x19,
+	0x4e, 0xcb, 0x2d, 0xc3, 0x65, 0x4d, 0xa3, 0x59, 0xd6, 0xde, 0x22, 0xe4, 0x43, 0x9b, 0x3b, 0x12,
+	0x67, 0x36, 0xcb, 0x0f, 0x9d, 0xd0, 0x2e, 0x9b, 0x81, 0xed, 0x7a, 0xbe, 0x1d, 0x7a, 0xcc, 0x97,
+	0xa6, 0xda, 0x65, 0x97, 0x31, 0xb7, 0xe6, 0x98, 0x76, 0xe0, 0x99, 0xb6, 0xef, 0xb3, 0x50, 0x6c,
+	0x72, 0xda, 0x5d, 0x48, 0xfb, 0x8c, 0xf8, 0xe5, 0x06, 0x89, 0xa9, 0x88, 0x2f, 0x93, 0xdc, 0x8b,
+	0x0f, 0x5d, 0x03, 0xf5, 0x93, 0xc8, 0xe7, 0x2e, 0xf3, 0x79, 0xe8, 0x85, 0x8d, 0x88, 0xcf, 0x72,
+	0x1e, 0x35, 0x1c, 0x1e, 0xea, 0xb7, 0x61, 0x31, 0x67, 0x8f, 0x07, 0xcc, 0xe7, 0x0e, 0xea, 0x50,
+	0x3c, 0x48, 0xac, 0xab, 0xca, 0xb2, 0xb2, 0x31, 0x69, 0xa5, 0xd6, 0xf4, 0x9b, 0x30, 0x2b, 0x08,
+	0xf6, 0xeb, 0x2c, 0x60, 0xdc, 0xae, 0x11, 0x31, 0x5e, 0x85, 0xa9, 0x80, 0x96, 0x2a, 0x5e, 0x55,
+	0x98, 0x8e, 0x5a, 0x10, 0x2f, 0x7d, 0x54, 0xd5, 0x3f, 0x86, 0xb9, 0x8c, 0x21, 0x79, 0xbd, 0x06,
+	0x13, 0x31, 0x4c, 0x98, 0x4d, 0x6d, 0x2d, 0x18, 0xa9, 0x74, 0x1a, 0x1d, 0x93, 0x0e, 0x50, 0xff,
+	0xa1, 0x90, 0xa1, 0xe3, 0xb1, 0x90, 0x3d, 0x78, 0xa5, 0x23, 0x84, 0x87, 0x76, 0xd8, 0xe0, 0x82,
+	0x75, 0x66, 0xeb, 0x4a, 0x0f, 0xd6, 0xfb, 0x02, 0x64, 0xcd, 0x04, 0xa9, 0x6f, 0x34, 0x60, 0xac,
+	0xc9, 0x42, 0xa7, 0xae, 0x16, 0xa2, 0x2c, 0xec, 0xa8, 0x7f, 0xfc, 0xb6, 0x39, 0x4b, 0x04, 0x77,
+	0xaa, 0xd5, 0xba, 0xc3, 0xf9, 0xfd, 0xb0, 0xee, 0xf9, 0xae, 0x25, 0x61, 0x78, 0x03, 0x26, 0xab,
+	0x4e, 0xc0, 0xb8, 0x17, 0xb2, 0xba, 0x3a, 0x32, 0xc0, 0xa6, 0x0b, 0xc5, 0x3d, 0x80, 0x6e, 0x4d,
+	0xa8, 0xa3, 0x22, 0x01, 0x6b, 0xb1, 0xd4, 0xa8, 0x80, 0x0c, 0x59, 0x68, 0x54, 0x40, 0xc6, 0xbe,
+	0xed, 0x3a, 0x14, 0xab, 0x95, 0xb0, 0xd4, 0x7f, 0x51, 0x60, 0x3e, 0x9b, 0x11, 0xca, 0xf0, 0x36,
+	0x4c, 0xc6, 0xc1, 0x45, 0xc9, 0x18, 0xe9, 0x97, 0xe2, 0x2e, 0x12, 0xef, 0xa5, 0x94, 0x15, 0x84,
+	0xb2, 0xf5, 0x81, 0xca, 0xa4, 0xcf, 0x94, 0xb4, 0x03, 0x78, 0x55, 0x28, 0xfb, 0x94, 0x85, 0xce,
+	0xb0, 0xf5, 0x72, 0xd6, 0xfc, 0xeb, 0xb7, 0xe0, 0xb5, 0x84, 0x13, 0x8a, 0x7c, 0x1d, 0x46, 0xa3,
+	0x5d, 0xaa, 0xab, 0x4b, 0x99, 0xa0, 0x05, 0x54, 0x00, 0xf4, 0x27, 0x09, 0x6b, 0x3e, 0xb4, 0xc6,
+	0xbd, 0x9c, 0x0c, 0xbd, 0xcc, 0xd9, 0x7d, 0xa7, 0x00, 0x26, 0xdd, 0x93, 0xfa, 0x37, 0x65, 0x0a,
+	0xe2, 0x33, 0xcb, 0x95, 0x2f, 0x11, 0xe7, 0x77, 0x56, 0xdb, 0xa4, 0x64, 0xdf, 0xae, 0xdb, 0x87,
+	0xa9, 0x4c, 0x88, 0x85, 0x4a, 0x78, 0x14, 0x38, 0xd4, 0x18, 0x40, 0x2e, 0x3d, 0x38, 0x0a, 0x1c,
+	0xfd, 0xa7, 0x02, 0x5c, 0x4a, 0xd9, 0x51, 0x08, 0x77, 0x61, 0xba, 0xc9, 0x42, 0xcf, 0x77, 0x2b,
+	0x12, 0x4c, 0x27, 0xb1, 0x74, 0x3a, 0x14, 0xcf, 0x77, 0xa5, 0xed, 0x4e, 0x41, 0x55, 0xac, 0x62,
+	0x33, 0xb1, 0x82, 0xf7, 0x60, 0x86, 0x2e, 0x4c, 0x4c, 0x23, 0x23, 0xbc, 0x9c, 0xa1, 0xb9, 0x2b,
+	0x41, 0x09, 0x9e, 0xe9, 0x6a, 0x72, 0x09, 0xef, 0x40, 0x31, 0xb4, 0x6b, 0xb5, 0xa3, 0x98, 0x66,
+	0x44, 0xd0, 0x68, 0x19, 0x9a, 0x07, 0x11, 0x24, 0x41, 0x32, 0x15, 0x76, 0x17, 0x70, 0x13, 0xc6,
+	0xc9, 0x58, 0xde, 0xd5, 0xb9, 0xec, 0x4d, 0x92, 0x09, 0x20, 0x90, 0xee, 0x53, 0x5e, 0x48, 0xda,
+	0xd0, 0xa5, 0x95, 0x6a, 0x27, 0x85, 0xa1, 0xdb, 0x89, 0xfe, 0x21, 0xcc, 0xa6, 0xfd, 0xd1, 0x41,
+	0xbc, 0x0b, 0x17, 0x09, 0x44, 0x47, 0x30, 0x9f, 0x9f, 0x3b, 0x2b, 0x86, 0xe9, 0x5f, 0xa5, 0x99,
+	0xfe, 0xff, 0x5b, 0xf1, 0x5c, 0x81, 0xb9, 0x8c, 0x02, 0x0a, 0x66, 0x0b, 0x26, 0x48, 0x65, 0x7c,
+	0x37, 0x7a, 0x45, 0xd3, 0xc1, 0x9d, 0xdf, 0x0d, 0x79, 0x0f, 0x16, 0x84, 0x2a, 0x51, 0x25, 0x96,
+	0xc3, 0x1b, 0xb5, 0xf0, 0x0c, 0x43, 0x50, 0x3d, 0x6d, 0xdb, 0x39, 0xa1, 0x31, 0x51, 0x67, 0xaa,
+	0xd2, 0xbb, 0x28, 0xc9, 0x44, 0x02, 0xf5, 0xc7, 0xc4, 0x26, 0x4a, 0x6e, 0xf7, 0x73, 0xdb, 0x77,
+	0xbb, 0xbd, 0x6b, 0x1e, 0xc6, 0x0f, 0x59, 0xb5, 0x51, 0x8b, 0x2f, 0x2b, 0x7d, 0x9d, 0xdb, 0xe1,
+	0xfc, 0xaa, 0xc0, 0x62, 0x8e, 0x73, 0x8a, 0xe5, 0x36, 0x4c, 0x8b, 0xfa, 0xaf, 0x1c, 0xc8, 0x0d,
+	0x3a, 0x25, 0x2d, 0xef, 0xae, 0x48, 0x5b, 0xab, 0x18, 0x74, 0x3f, 0xce, 0xef, 0xb4, 0xb6, 0xfe,
+	0x05, 0x18, 0x13, 0x3a, 0xf1, 0x1b, 0x05, 0x8a, 0xc9, 0x67, 0x0f, 0xae, 0x67, 0xd4, 0xf4, 0x7a,
+	0x34, 0x69, 0x1b, 0x83, 0x81, 0xd2, 0xb3, 0xbe, 0xf2, 0xf4, 0xcf, 0x7f, 0x7e, 0x2c, 0x5c, 0xc1,
+	0x25, 0x33, 0xfd, 0x6e, 0x4b, 0x3e, 0xa1, 0xf0, 0x6b, 0x05, 0x26, 0xe2, 0x79, 0x8b, 0x2b, 0x79,
+	0xdc, 0x99, 0xc7, 0x95, 0xf6, 0x46, 0x7f, 0x10, 0x39, 0x37, 0x84, 0xf3, 0x0d, 0x5c, 0xcb, 0x38,
+	0xef, 0x4c, 0x74, 0xb3, 0x95, 0xa8, 0xce, 0x36, 0x3e, 0x86, 0xc9, 0x98, 0x83, 0x63, 0x5f, 0x17,
+	0x71, 0x55, 0x69, 0xab, 0x03, 0x50, 0xa4, 0x64, 0x59, 0x28, 0xd1, 0x50, 0xed, 0xa5, 0x04, 0xbf,
+	0x55, 0x60, 0x34, 0x9a, 0x5f, 0x78, 0x35, 0x8f, 0x31, 0xf1, 0x50, 0xd0, 0x96, 0x7b, 0x03, 0xc8,
+	0xdb, 0x2d, 0xe1, 0xed, 0x06, 0x5e, 0x1f, 0x2e, 0x6e, 0x53, 0x4c, 0x4c, 0xb3, 0x15, 0xfd, 0xd4,
+	0xdb, 0xf8, 0x54, 0x81, 0xb1, 0x88, 0x8e, 0x63, 0x4f, 0x4f, 0x9d, 0xf0, 0x5f, 0xef, 0x83, 0x20,
+	0x31, 0xd7, 0x85, 0x18, 0x03, 0xdf, 0x39, 0x8b, 0x18, 0x7c, 0x02, 0xe3, 0x34, 0x5e, 0x72, 0x5d,
+	0xa4, 0x86, 0xb1, 0xa6, 0xf7, 0x83, 0x90, 0x8c, 0xb7, 0x85, 0x8c, 0x55, 0x5c, 0xc9, 0xca, 0x10,
+	0x30, 0xb3, 0x95, 0x98, 0xe6, 0x6d, 0xfc, 0x59, 0x81, 0x8b, 0xd4, 0x30, 0x31, 0x97, 0x3c, 0x3d,
+	0xbc, 0xb4, 0x95, 0xbe, 0x18, 0x52, 0xb0, 0x2b, 0x14, 0x7c, 0x80, 0xef, 0x0f, 0x99, 0x88, 0xb8,
+	0x51, 0x9b, 0xad, 0xce, 0x30, 0x6b, 0xe3, 0xf7, 0x0a, 0x4c, 0x10, 0x31, 0xc7, 0x7e, 0x6e, 0x79,
+	0xdf, 0xab, 0x92, 0x1d, 0x20, 0xfa, 0x4d, 0x21, 0xae, 0x8c, 0xe6, 0x19, 0xc5, 0xe1, 0x73, 0x05,
+	0xa6, 0x12, 0x9d, 0x18, 0xd7, 0xf2, 0xdc, 0x9d, 0x9e, 0x0c, 0xda, 0xfa, 0x40, 0xdc, 0x4b, 0xd6,
+	0x8f, 0x98, 0x04, 0xf8, 0x4c, 0x81, 0x62, 0xb2, 0x11, 0xe7, 0xf7, 0xb6, 0x9c, 0x39, 0xa1, 0x6d,
+	0x0c, 0x06, 0x92, 0xb2, 0x4d, 0xa1, 0x6c, 0x1d, 0x57, 0xf3, 0x4a, 0x2a, 0x6e, 0xf4, 0x66, 0x4b,
+	0xce, 0x99, 0xf6, 0xce, 0xf6, 0xef, 0xc7, 0x25, 0xe5, 0xc5, 0x71, 0x49, 0xf9, 0xfb, 0xb8, 0xa4,
+	0x3c, 0x3b, 0x29, 0x5d, 0x78, 0x71, 0x52, 0xba, 0xf0, 0xd7, 0x49, 0xe9, 0xc2, 0x67, 0x4b, 0xd2,
+	0x9e, 0x57, 0xbf, 0x30, 0x3c, 0x66, 0x7e, 0x29, 0x78, 0xa2, 0x42, 0xe4, 0xd1, 0x9f, 0xe4, 0x71,
+	0xf1, 0x1f, 0xf6, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x80, 0xbd, 0xfa, 0x6d, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1218,10 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ParamChanges queries the history of the param changes of a module, oldest first.
+	//
+	// Since: x/gov v1.0.0
+	ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -1187,6 +1313,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error) {
+	out := new(QueryParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1207,6 +1342,10 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ParamChanges queries the history of the param changes of a module, oldest first.
+	//
+	// Since: x/gov v1.0.0
+	ParamChanges(context.Context, *QueryParamChangesRequest) (*QueryParamChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1240,6 +1379,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ParamChanges(ctx context.Context, req *QueryParamChangesRequest) (*QueryParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1407,6 +1549,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamChanges(ctx, req.(*QueryParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1447,6 +1607,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ParamChanges",
+			Handler:    _Query_ParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2153,6 +2317,97 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParamChanges) > 0 {
+		for iNdEx := len(m.ParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2445,6 +2700,42 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamChanges) > 0 {
+		for _, e := range m.ParamChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4282,6 +4573,244 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamChanges = append(m.ParamChanges, &ParamChange{})
+			if err := m.ParamChanges[len(m.ParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
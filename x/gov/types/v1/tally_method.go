@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// TallyMethodStandard weights voting power linearly with stake.
+	TallyMethodStandard = TallyMethod_TALLY_METHOD_STANDARD
+	// TallyMethodQuadratic weights voting power with the integer square root
+	// of stake.
+	TallyMethodQuadratic = TallyMethod_TALLY_METHOD_QUADRATIC
+	// TallyMethodCapped weights voting power linearly with stake, up to a cap
+	// proportional to the total bonded tokens.
+	TallyMethodCapped = TallyMethod_TALLY_METHOD_CAPPED
+)
+
+// Validate validates the tally method of a proposal type.
+func (m ProposalTallyMethod) Validate() error {
+	if _, ok := ProposalType_name[int32(m.ProposalType)]; !ok || m.ProposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid proposal type: %s", m.ProposalType)
+	}
+
+	switch m.Method {
+	case TallyMethodStandard, TallyMethodQuadratic:
+		return nil
+	case TallyMethodCapped:
+		ratio, err := math.LegacyNewDecFromStr(m.MaxVotingPowerRatio)
+		if err != nil {
+			return fmt.Errorf("invalid max voting power ratio string: %w", err)
+		}
+
+		if !ratio.IsPositive() || ratio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("max voting power ratio must be in (0, 1]: %s", ratio)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown tally method: %s", m.Method)
+	}
+}
+
+// VotingPower returns the weighted voting power of an account with stake
+// power, totalBonded being the total bonded tokens. Alternative methods work
+// on the integer part of power, so that weighting is exact.
+func (m ProposalTallyMethod) VotingPower(power math.LegacyDec, totalBonded math.Int) math.LegacyDec {
+	switch m.Method {
+	case TallyMethodQuadratic:
+		return math.LegacyNewDecFromInt(isqrt(power.TruncateInt()))
+
+	case TallyMethodCapped:
+		ratio, _ := math.LegacyNewDecFromStr(m.MaxVotingPowerRatio)
+		maxPower := ratio.MulInt(totalBonded).TruncateInt()
+		return math.LegacyNewDecFromInt(math.MinInt(power.TruncateInt(), maxPower))
+
+	default:
+		return power
+	}
+}
+
+// TallyMethodOf returns the tally method of the proposals of proposalType, the
+// standard method if none is set. Proposals with an unspecified type are
+// standard proposals.
+func (p Params) TallyMethodOf(proposalType ProposalType) ProposalTallyMethod {
+	if proposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		proposalType = ProposalType_PROPOSAL_TYPE_STANDARD
+	}
+
+	for _, method := range p.TallyMethods {
+		if method.ProposalType == proposalType {
+			return method
+		}
+	}
+
+	return ProposalTallyMethod{ProposalType: proposalType, Method: TallyMethodStandard}
+}
+
+// isqrt returns the integer square root of a non negative integer.
+func isqrt(x math.Int) math.Int {
+	if !x.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	return math.NewIntFromBigInt(x.BigInt().Sqrt(x.BigInt()))
+}
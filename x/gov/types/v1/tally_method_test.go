@@ -0,0 +1,98 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+func genPower(t *rapid.T, label string) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(rapid.Int64Range(0, 1<<62).Draw(t, label), rapid.Int64Range(0, 6).Draw(t, label+"_prec"))
+}
+
+func TestTallyMethodStandardProperties(t *testing.T) {
+	method := v1.ProposalTallyMethod{Method: v1.TallyMethodStandard}
+	rapid.Check(t, func(t *rapid.T) {
+		power := genPower(t, "power")
+		require.True(t, power.Equal(method.VotingPower(power, math.NewInt(1))))
+	})
+}
+
+func TestTallyMethodQuadraticProperties(t *testing.T) {
+	method := v1.ProposalTallyMethod{Method: v1.TallyMethodQuadratic}
+	rapid.Check(t, func(t *rapid.T) {
+		power := genPower(t, "power")
+		stake := power.TruncateInt()
+		weighted := method.VotingPower(power, math.NewInt(1))
+
+		// the weighted power is the exact integer square root of the stake
+		require.True(t, weighted.IsInteger())
+		root := weighted.TruncateInt()
+		require.True(t, root.Mul(root).LTE(stake))
+		next := root.AddRaw(1)
+		require.True(t, next.Mul(next).GT(stake))
+
+		// weighting is monotonic
+		other := genPower(t, "other")
+		if other.GTE(power) {
+			require.True(t, method.VotingPower(other, math.NewInt(1)).GTE(weighted))
+		}
+	})
+}
+
+func TestTallyMethodCappedProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		ratio := math.LegacyNewDecWithPrec(rapid.Int64Range(1, 100).Draw(t, "ratio"), 2)
+		method := v1.ProposalTallyMethod{
+			ProposalType:        v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			Method:              v1.TallyMethodCapped,
+			MaxVotingPowerRatio: ratio.String(),
+		}
+		require.NoError(t, method.Validate())
+
+		totalBonded := math.NewInt(rapid.Int64Range(1, 1<<62).Draw(t, "bonded"))
+		maxPower := ratio.MulInt(totalBonded).TruncateInt()
+
+		power := genPower(t, "power")
+		weighted := method.VotingPower(power, totalBonded)
+
+		// the weighted power is the stake, capped
+		require.True(t, weighted.IsInteger())
+		require.True(t, weighted.TruncateInt().LTE(maxPower))
+		if power.TruncateInt().LTE(maxPower) {
+			require.True(t, weighted.TruncateInt().Equal(power.TruncateInt()))
+		} else {
+			require.True(t, weighted.TruncateInt().Equal(maxPower))
+		}
+	})
+}
+
+func TestProposalTallyMethodValidate(t *testing.T) {
+	standard := v1.ProposalType_PROPOSAL_TYPE_STANDARD
+	require.NoError(t, v1.ProposalTallyMethod{ProposalType: standard, Method: v1.TallyMethodQuadratic}.Validate())
+	require.NoError(t, v1.ProposalTallyMethod{ProposalType: standard, Method: v1.TallyMethodCapped, MaxVotingPowerRatio: "0.05"}.Validate())
+	require.Error(t, v1.ProposalTallyMethod{Method: v1.TallyMethodQuadratic}.Validate())
+	require.Error(t, v1.ProposalTallyMethod{ProposalType: standard, Method: v1.TallyMethodCapped}.Validate())
+	require.Error(t, v1.ProposalTallyMethod{ProposalType: standard, Method: v1.TallyMethodCapped, MaxVotingPowerRatio: "2"}.Validate())
+	require.Error(t, v1.ProposalTallyMethod{ProposalType: standard, Method: 42}.Validate())
+}
+
+func TestParamsTallyMethods(t *testing.T) {
+	params := v1.DefaultParams()
+	require.Equal(t, v1.TallyMethodStandard, params.TallyMethodOf(v1.ProposalType_PROPOSAL_TYPE_EXPEDITED).Method)
+
+	quadratic := v1.ProposalTallyMethod{ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD, Method: v1.TallyMethodQuadratic}
+	params.TallyMethods = []v1.ProposalTallyMethod{quadratic}
+	require.NoError(t, params.ValidateBasic(address.NewBech32Codec("cosmos")))
+	require.Equal(t, quadratic, params.TallyMethodOf(v1.ProposalType_PROPOSAL_TYPE_STANDARD))
+	require.Equal(t, quadratic, params.TallyMethodOf(v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED))
+
+	params.TallyMethods = append(params.TallyMethods, quadratic)
+	require.Error(t, params.ValidateBasic(address.NewBech32Codec("cosmos")))
+}
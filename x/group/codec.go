@@ -30,6 +30,8 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMemberUpdate{}, "cosmos-sdk/MsgScheduleMemberUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledMemberUpdate{}, "cosmos-sdk/MsgCancelMemberUpdate")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -49,6 +51,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgScheduleMemberUpdate{},
+		&MsgCancelScheduledMemberUpdate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
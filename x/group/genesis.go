@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -79,6 +80,22 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, u := range s.ScheduledMemberUpdates {
+
+		// check that group with scheduled member update's GroupId exists
+		if _, exists := groups[u.GroupId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("scheduled member update with GroupId %d doesn't exist", u.GroupId))
+		}
+
+		if err := u.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "ScheduledMemberUpdate validation failed")
+		}
+
+		if u.Id > s.ScheduledMemberUpdateSeq {
+			return errorsmod.Wrap(errors.ErrInvalid, fmt.Sprintf("scheduled member update id %d is above the sequence %d", u.Id, s.ScheduledMemberUpdateSeq))
+		}
+	}
 	return nil
 }
 
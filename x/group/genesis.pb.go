@@ -43,6 +43,11 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// scheduled_member_update_seq is the scheduled member update table orm.Sequence,
+	// it is used to get the next scheduled member update ID.
+	ScheduledMemberUpdateSeq uint64 `protobuf:"varint,9,opt,name=scheduled_member_update_seq,json=scheduledMemberUpdateSeq,proto3" json:"scheduled_member_update_seq,omitempty"`
+	// scheduled_member_updates is the list of scheduled member updates.
+	ScheduledMemberUpdates []*ScheduledMemberUpdate `protobuf:"bytes,10,rep,name=scheduled_member_updates,json=scheduledMemberUpdates,proto3" json:"scheduled_member_updates,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +139,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledMemberUpdateSeq() uint64 {
+	if m != nil {
+		return m.ScheduledMemberUpdateSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledMemberUpdates() []*ScheduledMemberUpdate {
+	if m != nil {
+		return m.ScheduledMemberUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,28 +160,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x7a, 0x41,
-	0x14, 0xc7, 0xbd, 0x3f, 0xff, 0xfc, 0x74, 0xfc, 0x53, 0x0c, 0x05, 0x37, 0xad, 0xc1, 0x5a, 0x09,
-	0xc1, 0x5c, 0xb4, 0x45, 0xeb, 0xda, 0x48, 0x8b, 0x40, 0xae, 0xd0, 0xa2, 0x4d, 0xa8, 0x9d, 0xe4,
-	0x92, 0x7a, 0xc6, 0x7b, 0x26, 0xc9, 0xb7, 0xe8, 0x09, 0x7a, 0x9e, 0x96, 0x2e, 0x5b, 0x86, 0xbe,
-	0x48, 0x78, 0x46, 0x31, 0xd4, 0xdd, 0xcc, 0x99, 0xcf, 0xf7, 0x7c, 0xbe, 0x30, 0xe2, 0xac, 0x87,
-	0x34, 0x44, 0x0a, 0xfa, 0x31, 0xbe, 0x99, 0x60, 0x52, 0x0f, 0xfa, 0x30, 0x02, 0x8a, 0x48, 0x9b,
-	0x18, 0x2d, 0xca, 0x03, 0xf7, 0xac, 0xf9, 0x59, 0x4f, 0xea, 0xe5, 0xca, 0x36, 0x6f, 0xa7, 0x06,
-	0x56, 0xf4, 0xc5, 0x67, 0x52, 0x14, 0x9a, 0x2e, 0xdf, 0xb6, 0x1d, 0x0b, 0xb2, 0x22, 0x72, 0x0c,
-	0x3e, 0x11, 0x8c, 0x7d, 0xaf, 0xea, 0xd5, 0x52, 0x61, 0x96, 0x07, 0x6d, 0x18, 0xcb, 0x86, 0xc8,
-	0xf0, 0x99, 0xfc, 0x7f, 0xd5, 0x64, 0x2d, 0xdf, 0x28, 0xeb, 0x2d, 0x99, 0x6e, 0x2e, 0x0f, 0x77,
-	0xa3, 0x17, 0x0c, 0x57, 0xa4, 0xbc, 0x11, 0x45, 0xb7, 0x70, 0x08, 0xc3, 0x2e, 0xc4, 0xe4, 0x27,
-	0x39, 0x7a, 0xba, 0x3f, 0x7a, 0xcf, 0x50, 0x58, 0xe8, 0x6f, 0x2e, 0x24, 0x6b, 0xe2, 0xd0, 0xad,
-	0x30, 0x38, 0x88, 0x7a, 0x53, 0xae, 0x96, 0xe2, 0x6a, 0x25, 0x9e, 0xb7, 0x78, 0xbc, 0x2c, 0xd8,
-	0x14, 0xa5, 0x3f, 0x64, 0x04, 0xe4, 0xa7, 0xd9, 0x56, 0xdd, 0x6f, 0x73, 0x41, 0xae, 0x5b, 0xdc,
-	0x6c, 0x8a, 0x80, 0xe4, 0xb9, 0x28, 0x98, 0x18, 0x0d, 0x52, 0x67, 0xc0, 0xba, 0x0c, 0xeb, 0xf2,
-	0xeb, 0xd9, 0xd2, 0x75, 0x2d, 0x72, 0xeb, 0x2b, 0xf9, 0xff, 0x59, 0x73, 0xb2, 0xa3, 0x69, 0xad,
-	0x88, 0x70, 0xc3, 0xca, 0x4b, 0x91, 0x9e, 0xa0, 0x05, 0xf2, 0xb3, 0x1c, 0x3a, 0xde, 0x09, 0x3d,
-	0xa0, 0x85, 0xd0, 0x31, 0xb7, 0xfa, 0x6b, 0xae, 0xbc, 0xd9, 0x5c, 0x79, 0x3f, 0x73, 0xe5, 0x7d,
-	0x2c, 0x54, 0x62, 0xb6, 0x50, 0x89, 0xef, 0x85, 0x4a, 0x3c, 0x1e, 0xb9, 0x18, 0x3d, 0xbf, 0xea,
-	0x08, 0x83, 0x77, 0xf7, 0xbf, 0xdd, 0x0c, 0xff, 0xeb, 0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0xb4, 0xee, 0x7e, 0x26, 0x02, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3d, 0x4f, 0xc2, 0x50,
+	0x14, 0x86, 0xa9, 0x7c, 0x08, 0x97, 0x0f, 0xcd, 0x8d, 0x9a, 0x2b, 0x68, 0x83, 0x0e, 0x86, 0xc4,
+	0xa4, 0x0d, 0x38, 0x38, 0x39, 0xe8, 0x42, 0x1c, 0x4c, 0x48, 0x89, 0x0e, 0x2e, 0x08, 0xf4, 0x88,
+	0x8d, 0xc0, 0x2d, 0x3d, 0x85, 0xc8, 0xbf, 0xf0, 0x1f, 0xb9, 0x3a, 0x32, 0x3a, 0x1a, 0xf8, 0x23,
+	0xa6, 0xe7, 0x16, 0x31, 0x50, 0xb7, 0xf6, 0xdc, 0xe7, 0x3d, 0xcf, 0x9b, 0x9b, 0xcb, 0x8e, 0xbb,
+	0x12, 0x07, 0x12, 0xcd, 0x9e, 0x27, 0xc7, 0xae, 0x39, 0xa9, 0x9a, 0x3d, 0x18, 0x02, 0x3a, 0x68,
+	0xb8, 0x9e, 0xf4, 0x25, 0xdf, 0x51, 0xc7, 0x06, 0x1d, 0x1b, 0x93, 0x6a, 0xb1, 0xb4, 0xce, 0xfb,
+	0x53, 0x17, 0x42, 0xfa, 0xf4, 0x23, 0xc1, 0x72, 0x75, 0x95, 0x6f, 0xfa, 0x6d, 0x1f, 0x78, 0x89,
+	0x65, 0x08, 0x6c, 0x21, 0x8c, 0x84, 0x56, 0xd6, 0x2a, 0x09, 0x2b, 0x4d, 0x83, 0x26, 0x8c, 0x78,
+	0x8d, 0xa5, 0xe8, 0x1b, 0xc5, 0x56, 0x39, 0x5e, 0xc9, 0xd6, 0x8a, 0xc6, 0x9a, 0xcc, 0xa8, 0x07,
+	0x1f, 0xb7, 0xc3, 0x67, 0x69, 0x85, 0x24, 0xbf, 0x66, 0x79, 0xb5, 0x70, 0x00, 0x83, 0x0e, 0x78,
+	0x28, 0xe2, 0x14, 0x3d, 0x8a, 0x8e, 0xde, 0x11, 0x64, 0xe5, 0x7a, 0xab, 0x1f, 0xe4, 0x15, 0xb6,
+	0xab, 0x56, 0xb8, 0xb2, 0xef, 0x74, 0xa7, 0x54, 0x2d, 0x41, 0xd5, 0x0a, 0x34, 0x6f, 0xd0, 0x38,
+	0x28, 0x58, 0x67, 0x85, 0x3f, 0xa4, 0x03, 0x28, 0x92, 0x64, 0x2b, 0x47, 0xdb, 0x54, 0x90, 0xea,
+	0xe6, 0x57, 0x9b, 0x1c, 0x40, 0x7e, 0xc2, 0x72, 0xae, 0x27, 0x5d, 0x89, 0xed, 0x3e, 0xe9, 0x52,
+	0xa4, 0xcb, 0x2e, 0x67, 0x81, 0xeb, 0x92, 0x65, 0x96, 0xbf, 0x28, 0xb6, 0x49, 0x73, 0xb8, 0xa1,
+	0x69, 0x84, 0x84, 0xb5, 0x62, 0xf9, 0x39, 0x4b, 0x4e, 0xa4, 0x0f, 0x28, 0xd2, 0x14, 0xda, 0xdf,
+	0x08, 0x3d, 0x48, 0x1f, 0x2c, 0xc5, 0xf0, 0x2b, 0x56, 0xc2, 0xee, 0x0b, 0xd8, 0xe3, 0x3e, 0xd8,
+	0xe1, 0x15, 0xb6, 0xc6, 0xae, 0xdd, 0xf6, 0x81, 0x7a, 0x65, 0xa8, 0x97, 0xf8, 0x45, 0xd4, 0x95,
+	0xdd, 0x13, 0x10, 0x94, 0x7c, 0x62, 0xe2, 0x9f, 0x38, 0x0a, 0x46, 0xfa, 0xb3, 0x0d, 0x7d, 0x33,
+	0x6a, 0x99, 0x75, 0x10, 0xe9, 0xc0, 0x1b, 0xe3, 0x73, 0xae, 0x6b, 0xb3, 0xb9, 0xae, 0x7d, 0xcf,
+	0x75, 0xed, 0x7d, 0xa1, 0xc7, 0x66, 0x0b, 0x3d, 0xf6, 0xb5, 0xd0, 0x63, 0x8f, 0x7b, 0x6a, 0x31,
+	0xda, 0xaf, 0x86, 0x23, 0xcd, 0x37, 0xf5, 0x00, 0x3b, 0x29, 0x7a, 0x78, 0x17, 0x3f, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xd2, 0x54, 0x65, 0x36, 0xc7, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +207,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledMemberUpdates) > 0 {
+		for iNdEx := len(m.ScheduledMemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ScheduledMemberUpdateSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduledMemberUpdateSeq))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +370,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ScheduledMemberUpdateSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduledMemberUpdateSeq))
+	}
+	if len(m.ScheduledMemberUpdates) > 0 {
+		for _, e := range m.ScheduledMemberUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -594,6 +644,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMemberUpdateSeq", wireType)
+			}
+			m.ScheduledMemberUpdateSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledMemberUpdateSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMemberUpdates = append(m.ScheduledMemberUpdates, &ScheduledMemberUpdate{})
+			if err := m.ScheduledMemberUpdates[len(m.ScheduledMemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
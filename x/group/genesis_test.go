@@ -97,6 +97,9 @@ func TestGenesisStateValidate(t *testing.T) {
 				ProposalSeq:    1,
 				Proposals:      []*Proposal{proposal},
 				Votes:          []*Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: VOTE_OPTION_YES}},
+
+				ScheduledMemberUpdateSeq: 1,
+				ScheduledMemberUpdates:   []*ScheduledMemberUpdate{{Id: 1, GroupId: 1, Member: MemberRequest{Address: memberAddr.String(), Weight: "0"}, Time: submittedAt}},
 			},
 			false,
 		},
@@ -734,6 +737,23 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"scheduled member update of a non-existent group",
+			GenesisState{
+				ScheduledMemberUpdateSeq: 1,
+				ScheduledMemberUpdates:   []*ScheduledMemberUpdate{{Id: 1, GroupId: 1, Member: MemberRequest{Address: memberAddr.String(), Weight: "1"}, Time: submittedAt}},
+			},
+			true,
+		},
+		{
+			"scheduled member update id above the sequence",
+			GenesisState{
+				Groups:                   []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				ScheduledMemberUpdateSeq: 1,
+				ScheduledMemberUpdates:   []*ScheduledMemberUpdate{{Id: 2, GroupId: 1, Member: MemberRequest{Address: memberAddr.String(), Weight: "1"}, Time: submittedAt}},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
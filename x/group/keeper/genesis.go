@@ -44,6 +44,10 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.scheduledMemberUpdateTable.Import(sdkCtx.KVStore(k.key), genesisState.ScheduledMemberUpdates, genesisState.ScheduledMemberUpdateSeq); err != nil {
+		panic(errors.Wrap(err, "scheduled member updates"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -92,5 +96,13 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) *group.Gen
 	}
 	genesisState.Votes = votes
 
+	var scheduledMemberUpdates []*group.ScheduledMemberUpdate
+	scheduledMemberUpdateSeq, err := k.scheduledMemberUpdateTable.Export(sdkCtx.KVStore(k.key), &scheduledMemberUpdates)
+	if err != nil {
+		panic(errors.Wrap(err, "scheduled member updates"))
+	}
+	genesisState.ScheduledMemberUpdates = scheduledMemberUpdates
+	genesisState.ScheduledMemberUpdateSeq = scheduledMemberUpdateSeq
+
 	return genesisState
 }
@@ -133,6 +133,9 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		ProposalSeq:    1,
 		Proposals:      []*group.Proposal{proposal},
 		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+
+		ScheduledMemberUpdateSeq: 2,
+		ScheduledMemberUpdates:   []*group.ScheduledMemberUpdate{{Id: 2, GroupId: 1, Member: group.MemberRequest{Address: memberAddr.String(), Weight: "0"}, Time: timeout}},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -166,6 +169,10 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertGroupPoliciesEqual(g, res.Info)
 	}
 
+	scheduledRes, err := s.keeper.ScheduledMemberUpdates(ctx, &group.QueryScheduledMemberUpdatesRequest{GroupId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.ScheduledMemberUpdates, scheduledRes.Updates)
+
 	for _, g := range genesisState.Proposals {
 		res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{
 			ProposalId: g.Id,
@@ -206,10 +213,12 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.ScheduledMemberUpdates, exportedGenesisState.ScheduledMemberUpdates)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
 	s.Require().Equal(genesisState.ProposalSeq, exportedGenesisState.ProposalSeq)
+	s.Require().Equal(genesisState.ScheduledMemberUpdateSeq, exportedGenesisState.ScheduledMemberUpdateSeq)
 }
 
 func (s *GenesisTestSuite) assertGroupPoliciesEqual(g, other *group.GroupPolicyInfo) {
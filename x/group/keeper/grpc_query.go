@@ -386,3 +386,23 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// ScheduledMemberUpdates queries the upcoming member updates of a group.
+func (k Keeper) ScheduledMemberUpdates(goCtx context.Context, request *group.QueryScheduledMemberUpdatesRequest) (*group.QueryScheduledMemberUpdatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	it, err := k.scheduledMemberUpdateByGroupIndex.GetPaginated(ctx.KVStore(k.key), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []*group.ScheduledMemberUpdate
+	pageRes, err := orm.Paginate(it, request.Pagination, &updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryScheduledMemberUpdatesResponse{
+		Updates:    updates,
+		Pagination: pageRes,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -43,6 +44,12 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Scheduled Member Update Table
+	ScheduledMemberUpdateTablePrefix        byte = 0x50
+	ScheduledMemberUpdateTableSeqPrefix     byte = 0x51
+	ScheduledMemberUpdatesByTimePrefix      byte = 0x52
+	ScheduledMemberUpdateByGroupIndexPrefix byte = 0x53
 )
 
 type Keeper struct {
@@ -75,6 +82,11 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Scheduled Member Update Table
+	scheduledMemberUpdateTable        orm.AutoUInt64Table
+	scheduledMemberUpdatesByTime      orm.Index
+	scheduledMemberUpdateByGroupIndex orm.Index
+
 	router baseapp.MessageRouter
 
 	config group.Config
@@ -232,6 +244,25 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	}
 	k.voteTable = *voteTable
 
+	// Scheduled Member Update Table
+	scheduledMemberUpdateTable, err := orm.NewAutoUInt64Table([2]byte{ScheduledMemberUpdateTablePrefix}, ScheduledMemberUpdateTableSeqPrefix, &group.ScheduledMemberUpdate{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledMemberUpdatesByTime, err = orm.NewIndex(scheduledMemberUpdateTable, ScheduledMemberUpdatesByTimePrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{sdk.FormatTimeBytes(value.(*group.ScheduledMemberUpdate).Time)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledMemberUpdateByGroupIndex, err = orm.NewIndex(scheduledMemberUpdateTable, ScheduledMemberUpdateByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.ScheduledMemberUpdate).GroupId}, nil
+	}, group.ScheduledMemberUpdate{}.GroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledMemberUpdateTable = *scheduledMemberUpdateTable
+
 	return k
 }
 
@@ -250,6 +281,67 @@ func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
 	return k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
 }
 
+// IsGroupPolicyMember returns whether member is a member of the group of the
+// group policy at groupPolicy. It returns an error if there is no such group
+// policy.
+func (k Keeper) IsGroupPolicyMember(ctx context.Context, groupPolicy, member sdk.AccAddress) (bool, error) {
+	policyAddr, err := k.accKeeper.AddressCodec().BytesToString(groupPolicy)
+	if err != nil {
+		return false, err
+	}
+	memberAddr, err := k.accKeeper.AddressCodec().BytesToString(member)
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policyInfo, err := k.getGroupPolicyInfo(sdkCtx, policyAddr)
+	if err != nil {
+		return false, err
+	}
+
+	return k.groupMemberTable.Has(sdkCtx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+		GroupId: policyInfo.GroupId,
+		Member:  &group.Member{Address: memberAddr},
+	})), nil
+}
+
+// GroupPolicyMembers returns the addresses of the members of the group of the
+// group policy at groupPolicy.
+func (k Keeper) GroupPolicyMembers(ctx context.Context, groupPolicy sdk.AccAddress) ([]sdk.AccAddress, error) {
+	policyAddr, err := k.accKeeper.AddressCodec().BytesToString(groupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policyInfo, err := k.getGroupPolicyInfo(sdkCtx, policyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.groupMemberByGroupIndex.Get(sdkCtx.KVStore(k.key), policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var groupMembers []*group.GroupMember
+	if _, err := orm.ReadAll(it, &groupMembers); err != nil {
+		return nil, err
+	}
+
+	members := make([]sdk.AccAddress, len(groupMembers))
+	for i, groupMember := range groupMembers {
+		members[i], err = k.accKeeper.AddressCodec().StringToBytes(groupMember.Member.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return members, nil
+}
+
 // proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
 	timeBytes := sdk.FormatTimeBytes(endTime)
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// scheduleMemberUpdate schedules an update of a member of the group groupID,
+// applied at the end of the first block at or after at. A zero weight removes
+// the member. It returns the id of the scheduled update.
+func (k Keeper) scheduleMemberUpdate(ctx sdk.Context, groupID uint64, member group.MemberRequest, at time.Time) (uint64, error) {
+	if at.IsZero() {
+		return 0, errorsmod.Wrap(errors.ErrEmpty, "time")
+	}
+
+	store := ctx.KVStore(k.key)
+	update := group.ScheduledMemberUpdate{
+		Id:      k.scheduledMemberUpdateTable.Sequence().PeekNextVal(store),
+		GroupId: groupID,
+		Member:  member,
+		Time:    at.UTC(),
+	}
+	if _, err := k.scheduledMemberUpdateTable.Create(store, &update); err != nil {
+		return 0, errorsmod.Wrap(err, "could not schedule member update")
+	}
+
+	ctx.EventManager().EmitEvent(scheduledMemberUpdateEvent(group.EventTypeMemberUpdateScheduled, update))
+
+	return update.Id, nil
+}
+
+// ApplyScheduledMemberUpdates applies the member updates scheduled at or
+// before the block time, in time then scheduling order. An update which fails,
+// e.g. the removal of a member who already left the group, is dropped with a
+// scheduled_member_update_failed event.
+func (k Keeper) ApplyScheduledMemberUpdates(ctx sdk.Context) error {
+	store := ctx.KVStore(k.key)
+	it, err := k.scheduledMemberUpdatesByTime.PrefixScan(store, nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.HeaderInfo().Time)))
+	if err != nil {
+		return err
+	}
+
+	var updates []group.ScheduledMemberUpdate
+	for {
+		var update group.ScheduledMemberUpdate
+		_, err := it.LoadNext(&update)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Close()
+			return err
+		}
+		updates = append(updates, update)
+	}
+	it.Close()
+
+	for _, update := range updates {
+		if err := k.scheduledMemberUpdateTable.Delete(store, update.Id); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applyScheduledMemberUpdate(cacheCtx, update); err != nil {
+			ctx.EventManager().EmitEvent(scheduledMemberUpdateEvent(group.EventTypeMemberUpdateFailed, update).
+				AppendAttributes(sdk.NewAttribute(group.AttributeKeyError, err.Error())))
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(scheduledMemberUpdateEvent(group.EventTypeMemberUpdateApplied, update))
+	}
+
+	return nil
+}
+
+// applyScheduledMemberUpdate applies update to its group.
+func (k Keeper) applyScheduledMemberUpdate(ctx sdk.Context, update group.ScheduledMemberUpdate) error {
+	groupInfo, err := k.getGroupInfo(ctx, update.GroupId)
+	if err != nil {
+		return err
+	}
+	if err := k.applyMemberUpdates(ctx, &groupInfo, []group.MemberRequest{update.Member}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{GroupId: update.GroupId})
+}
+
+// assertGroupAdmin returns an error if admin is not the admin of the group
+// groupID.
+func (k Keeper) assertGroupAdmin(ctx sdk.Context, groupID uint64, admin string) error {
+	groupInfo, err := k.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return errorsmod.Wrap(err, "group")
+	}
+	if !strings.EqualFold(groupInfo.Admin, admin) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not group admin; got %s, expected %s", admin, groupInfo.Admin)
+	}
+
+	return nil
+}
+
+func (k Keeper) getScheduledMemberUpdate(ctx sdk.Context, id uint64) (group.ScheduledMemberUpdate, error) {
+	var update group.ScheduledMemberUpdate
+	if _, err := k.scheduledMemberUpdateTable.GetOne(ctx.KVStore(k.key), id, &update); err != nil {
+		return group.ScheduledMemberUpdate{}, errorsmod.Wrap(err, "scheduled member update")
+	}
+	return update, nil
+}
+
+func scheduledMemberUpdateEvent(eventType string, update group.ScheduledMemberUpdate) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(group.AttributeKeyUpdateID, strconv.FormatUint(update.Id, 10)),
+		sdk.NewAttribute(group.AttributeKeyGroupID, strconv.FormatUint(update.GroupId, 10)),
+		sdk.NewAttribute(group.AttributeKeyMember, update.Member.Address),
+		sdk.NewAttribute(group.AttributeKeyTime, update.Time.Format(time.RFC3339Nano)),
+	)
+}
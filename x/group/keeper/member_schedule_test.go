@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestScheduledMemberUpdates() {
+	admin, member := s.addrs[0].String(), s.addrs[5].String()
+	at := func(d time.Duration) time.Time { return s.blockTime.Add(d) }
+	schedule := func(admin string, member group.MemberRequest, activation time.Time, expiry *time.Time) ([]uint64, error) {
+		res, err := s.groupKeeper.ScheduleMemberUpdate(s.ctx, &group.MsgScheduleMemberUpdate{
+			Admin:      admin,
+			GroupId:    s.groupID,
+			Member:     member,
+			Activation: activation,
+			Expiry:     expiry,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.UpdateIds, nil
+	}
+	cancel := func(admin string, id uint64) error {
+		_, err := s.groupKeeper.CancelScheduledMemberUpdate(s.ctx, &group.MsgCancelScheduledMemberUpdate{Admin: admin, UpdateId: id})
+		return err
+	}
+	scheduled := func() []*group.ScheduledMemberUpdate {
+		res, err := s.groupKeeper.ScheduledMemberUpdates(s.ctx, &group.QueryScheduledMemberUpdatesRequest{GroupId: s.groupID})
+		s.Require().NoError(err)
+		return res.Updates
+	}
+	expiry := at(2 * time.Hour)
+
+	_, err := schedule(s.addrs[1].String(), group.MemberRequest{Address: member, Weight: "3"}, at(time.Hour), &expiry)
+	s.Require().ErrorContains(err, "not group admin")
+	_, err = schedule(admin, group.MemberRequest{Address: member, Weight: "3"}, at(2*time.Hour), &expiry)
+	s.Require().ErrorContains(err, "expiry")
+	_, err = schedule(admin, group.MemberRequest{Address: member, Weight: "0"}, at(time.Hour), &expiry)
+	s.Require().ErrorContains(err, "member weight")
+
+	// member joins for an hour, addrs[1] gets a new weight and the removal of
+	// addrs[3], who is not a member, fails when applied
+	ids, err := schedule(admin, group.MemberRequest{Address: member, Weight: "3"}, at(time.Hour), &expiry)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2}, ids)
+	_, err = schedule(admin, group.MemberRequest{Address: s.addrs[1].String(), Weight: "5"}, at(30*time.Minute), nil)
+	s.Require().NoError(err)
+	ids, err = schedule(admin, group.MemberRequest{Address: s.addrs[4].String(), Weight: "0"}, at(45*time.Minute), nil)
+	s.Require().NoError(err)
+	canceled := ids[0]
+	_, err = schedule(admin, group.MemberRequest{Address: s.addrs[3].String(), Weight: "0"}, at(90*time.Minute), nil)
+	s.Require().NoError(err)
+
+	s.Require().ErrorContains(cancel(s.addrs[1].String(), canceled), "not group admin")
+	s.Require().NoError(cancel(admin, canceled))
+	s.Require().ErrorContains(cancel(admin, canceled), "not found")
+
+	// the updates are returned in scheduling order, and exported in genesis
+	updates := scheduled()
+	s.Require().Len(updates, 4)
+	for i, d := range []time.Duration{time.Hour, 2 * time.Hour, 30 * time.Minute, 90 * time.Minute} {
+		s.Require().Equal(at(d), updates[i].Time)
+	}
+
+	genesis := s.groupKeeper.ExportGenesis(s.ctx, nil)
+	s.Require().Len(genesis.ScheduledMemberUpdates, 4)
+	s.Require().Equal(uint64(5), genesis.ScheduledMemberUpdateSeq)
+	s.Require().NoError(genesis.Validate())
+
+	applyAt := func(d time.Duration) sdk.Context {
+		ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: at(d)}).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.groupKeeper.ApplyScheduledMemberUpdates(ctx))
+		return ctx
+	}
+	totalWeight := func() string {
+		res, err := s.groupKeeper.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: s.groupID})
+		s.Require().NoError(err)
+		return res.Info.TotalWeight
+	}
+
+	applyAt(0)
+	s.Require().Equal("3", totalWeight())
+
+	applyAt(time.Hour)
+	s.Require().Equal("9", totalWeight())
+
+	ctx := applyAt(2 * time.Hour)
+	s.Require().Equal("6", totalWeight())
+	var failed int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == group.EventTypeMemberUpdateFailed {
+			failed++
+		}
+	}
+	s.Require().Equal(1, failed)
+
+	res, err := s.groupKeeper.GroupMembers(s.ctx, &group.QueryGroupMembersRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 2)
+
+	s.Require().Empty(scheduled())
+}
@@ -113,109 +113,115 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	action := func(g *group.GroupInfo) error {
-		totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+		return k.applyMemberUpdates(ctx, g, msg.MemberUpdates)
+	}
+
+	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "members updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// applyMemberUpdates adds, updates or removes (with a zero weight) the members
+// of the group g, bumping its version.
+func (k Keeper) applyMemberUpdates(ctx sdk.Context, g *group.GroupInfo, updates []group.MemberRequest) error {
+	totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return errorsmod.Wrap(err, "group total weight")
+	}
+
+	for _, member := range updates {
+		if err := k.assertMetadataLength(member.Metadata, "group member metadata"); err != nil {
+			return err
+		}
+		groupMember := group.GroupMember{
+			GroupId: g.Id,
+			Member: &group.Member{
+				Address:  member.Address,
+				Weight:   member.Weight,
+				Metadata: member.Metadata,
+			},
+		}
+
+		// Checking if the group member is already part of the group
+		var found bool
+		var prevGroupMember group.GroupMember
+		switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&groupMember), &prevGroupMember); {
+		case err == nil:
+			found = true
+		case sdkerrors.ErrNotFound.Is(err):
+			found = false
+		default:
+			return errorsmod.Wrap(err, "get group member")
+		}
+
+		newMemberWeight, err := math.NewNonNegativeDecFromString(groupMember.Member.Weight)
 		if err != nil {
-			return errorsmod.Wrap(err, "group total weight")
+			return err
 		}
 
-		for _, member := range msg.MemberUpdates {
-			if err := k.assertMetadataLength(member.Metadata, "group member metadata"); err != nil {
-				return err
-			}
-			groupMember := group.GroupMember{
-				GroupId: msg.GroupId,
-				Member: &group.Member{
-					Address:  member.Address,
-					Weight:   member.Weight,
-					Metadata: member.Metadata,
-				},
+		// Handle delete for members with zero weight.
+		if newMemberWeight.IsZero() {
+			// We can't delete a group member that doesn't already exist.
+			if !found {
+				return errorsmod.Wrap(sdkerrors.ErrNotFound, "unknown member")
 			}
 
-			// Checking if the group member is already part of the group
-			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&groupMember), &prevGroupMember); {
-			case err == nil:
-				found = true
-			case sdkerrors.ErrNotFound.Is(err):
-				found = false
-			default:
-				return errorsmod.Wrap(err, "get group member")
+			previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
+			if err != nil {
+				return err
 			}
 
-			newMemberWeight, err := math.NewNonNegativeDecFromString(groupMember.Member.Weight)
+			// Subtract the weight of the group member to delete from the group total weight.
+			totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
 			if err != nil {
 				return err
 			}
 
-			// Handle delete for members with zero weight.
-			if newMemberWeight.IsZero() {
-				// We can't delete a group member that doesn't already exist.
-				if !found {
-					return errorsmod.Wrap(sdkerrors.ErrNotFound, "unknown member")
-				}
-
-				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
-				if err != nil {
-					return err
-				}
-
-				// Subtract the weight of the group member to delete from the group total weight.
-				totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
-				if err != nil {
-					return err
-				}
-
-				// Delete group member in the groupMemberTable.
-				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
-					return errorsmod.Wrap(err, "delete member")
-				}
-				continue
+			// Delete group member in the groupMemberTable.
+			if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
+				return errorsmod.Wrap(err, "delete member")
 			}
-			// If group member already exists, handle update
-			if found {
-				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
-				if err != nil {
-					return err
-				}
-				// Subtract previous weight from the group total weight.
-				totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
-				if err != nil {
-					return err
-				}
-				// Save updated group member in the groupMemberTable.
-				groupMember.Member.AddedAt = prevGroupMember.Member.AddedAt
-				if err := k.groupMemberTable.Update(ctx.KVStore(k.key), &groupMember); err != nil {
-					return errorsmod.Wrap(err, "add member")
-				}
-			} else { // else handle create.
-				groupMember.Member.AddedAt = ctx.HeaderInfo().Time
-				if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
-					return errorsmod.Wrap(err, "add member")
-				}
+			continue
+		}
+		// If group member already exists, handle update
+		if found {
+			previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
+			if err != nil {
+				return err
 			}
-			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
-			totalWeight, err = totalWeight.Add(newMemberWeight)
+			// Subtract previous weight from the group total weight.
+			totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
 			if err != nil {
 				return err
 			}
+			// Save updated group member in the groupMemberTable.
+			groupMember.Member.AddedAt = prevGroupMember.Member.AddedAt
+			if err := k.groupMemberTable.Update(ctx.KVStore(k.key), &groupMember); err != nil {
+				return errorsmod.Wrap(err, "add member")
+			}
+		} else { // else handle create.
+			groupMember.Member.AddedAt = ctx.HeaderInfo().Time
+			if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
+				return errorsmod.Wrap(err, "add member")
+			}
 		}
-		// Update group in the groupTable.
-		g.TotalWeight = totalWeight.String()
-		g.Version++
-
-		if err := k.validateDecisionPolicies(ctx, *g); err != nil {
+		// In both cases (handle + update), we need to add the new member's weight to the group total weight.
+		totalWeight, err = totalWeight.Add(newMemberWeight)
+		if err != nil {
 			return err
 		}
-
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
 	}
+	// Update group in the groupTable.
+	g.TotalWeight = totalWeight.String()
+	g.Version++
 
-	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "members updated"); err != nil {
-		return nil, err
+	if err := k.validateDecisionPolicies(ctx, *g); err != nil {
+		return err
 	}
 
-	return &group.MsgUpdateGroupMembersResponse{}, nil
+	return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
 }
 
 func (k Keeper) UpdateGroupAdmin(goCtx context.Context, msg *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error) {
@@ -976,6 +982,77 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// ScheduleMemberUpdate implements the MsgServer/ScheduleMemberUpdate method.
+func (k Keeper) ScheduleMemberUpdate(goCtx context.Context, msg *group.MsgScheduleMemberUpdate) (*group.MsgScheduleMemberUpdateResponse, error) {
+	if msg.GroupId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "group id")
+	}
+
+	if err := k.validateMembers([]group.MemberRequest{msg.Member}); err != nil {
+		return nil, errorsmod.Wrap(err, "member")
+	}
+
+	if err := k.assertMetadataLength(msg.Member.Metadata, "group member metadata"); err != nil {
+		return nil, err
+	}
+
+	// a membership with a term limit joins the group with a positive weight
+	if msg.Expiry != nil {
+		if _, err := math.NewPositiveDecFromString(msg.Member.Weight); err != nil {
+			return nil, errorsmod.Wrap(err, "member weight")
+		}
+		if !msg.Expiry.After(msg.Activation) {
+			return nil, errorsmod.Wrapf(errors.ErrInvalid, "membership expiry %s is not after its activation %s", msg.Expiry, msg.Activation)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertGroupAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	id, err := k.scheduleMemberUpdate(ctx, msg.GroupId, msg.Member, msg.Activation)
+	if err != nil {
+		return nil, err
+	}
+	ids := []uint64{id}
+
+	if msg.Expiry != nil {
+		leave := group.MemberRequest{Address: msg.Member.Address, Weight: "0"}
+		if id, err = k.scheduleMemberUpdate(ctx, msg.GroupId, leave, *msg.Expiry); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return &group.MsgScheduleMemberUpdateResponse{UpdateIds: ids}, nil
+}
+
+// CancelScheduledMemberUpdate implements the MsgServer/CancelScheduledMemberUpdate method.
+func (k Keeper) CancelScheduledMemberUpdate(goCtx context.Context, msg *group.MsgCancelScheduledMemberUpdate) (*group.MsgCancelScheduledMemberUpdateResponse, error) {
+	if msg.UpdateId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "update id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	update, err := k.getScheduledMemberUpdate(ctx, msg.UpdateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.assertGroupAdmin(ctx, update.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := k.scheduledMemberUpdateTable.Delete(ctx.KVStore(k.key), update.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(scheduledMemberUpdateEvent(group.EventTypeMemberUpdateCanceled, update))
+
+	return &group.MsgCancelScheduledMemberUpdateResponse{}, nil
+}
+
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	var groupMember group.GroupMember
 	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
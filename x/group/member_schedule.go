@@ -0,0 +1,15 @@
+package group
+
+// Scheduled member update events and attributes.
+const (
+	EventTypeMemberUpdateScheduled = "scheduled_member_update"
+	EventTypeMemberUpdateApplied   = "scheduled_member_update_applied"
+	EventTypeMemberUpdateFailed    = "scheduled_member_update_failed"
+	EventTypeMemberUpdateCanceled  = "scheduled_member_update_canceled"
+
+	AttributeKeyUpdateID = "update_id"
+	AttributeKeyGroupID  = "group_id"
+	AttributeKeyMember   = "member"
+	AttributeKeyTime     = "time"
+	AttributeKeyError    = "error"
+)
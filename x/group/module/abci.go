@@ -6,12 +6,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// applies the scheduled member updates and prunes expired proposals.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		return err
 	}
 
+	if err := k.ApplyScheduledMemberUpdates(ctx); err != nil {
+		return err
+	}
+
 	return k.PruneProposals(ctx)
 }
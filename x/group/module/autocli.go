@@ -121,6 +121,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "groups",
 					Short:     "Query for all groups on chain",
 				},
+				{
+					RpcMethod: "ScheduledMemberUpdates",
+					Use:       "scheduled-member-updates [group-id]",
+					Short:     "Query for the upcoming member updates of a group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "group_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -205,6 +213,23 @@ Parameters:
 						{ProtoField: "address"}, {ProtoField: "group_id"},
 					},
 				},
+				{
+					RpcMethod: "ScheduleMemberUpdate",
+					Use:       "schedule-member-update [admin] [group-id] [member-json] [activation]",
+					Short:     "Schedule an update of a group member, or a membership with a term limit with --expiry",
+					Example:   `schedule-member-update cosmos1... 1 '{"address":"cosmos1...","weight":"1"}' 2024-01-01T00:00:00Z --expiry 2025-01-01T00:00:00Z`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_id"}, {ProtoField: "member"}, {ProtoField: "activation"},
+					},
+				},
+				{
+					RpcMethod: "CancelScheduledMemberUpdate",
+					Use:       "cancel-scheduled-member-update [admin] [update-id]",
+					Short:     "Cancel a scheduled member update",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "update_id"},
+					},
+				},
 			},
 		},
 	}
@@ -21,6 +21,8 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
 	_ sdk.Msg = &MsgLeaveGroup{}
+	_ sdk.Msg = &MsgScheduleMemberUpdate{}
+	_ sdk.Msg = &MsgCancelScheduledMemberUpdate{}
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
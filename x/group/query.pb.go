@@ -1486,6 +1486,116 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryScheduledMemberUpdatesRequest is the Query/ScheduledMemberUpdates request type.
+type QueryScheduledMemberUpdatesRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledMemberUpdatesRequest) Reset()         { *m = QueryScheduledMemberUpdatesRequest{} }
+func (m *QueryScheduledMemberUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMemberUpdatesRequest) ProtoMessage()    {}
+func (*QueryScheduledMemberUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryScheduledMemberUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMemberUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMemberUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledMemberUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMemberUpdatesRequest.Merge(m, src)
+}
+func (m *QueryScheduledMemberUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMemberUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMemberUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMemberUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledMemberUpdatesRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryScheduledMemberUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledMemberUpdatesResponse is the Query/ScheduledMemberUpdates response type.
+type QueryScheduledMemberUpdatesResponse struct {
+	// updates are the scheduled member updates of the group, in scheduling order.
+	Updates []*ScheduledMemberUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) Reset()         { *m = QueryScheduledMemberUpdatesResponse{} }
+func (m *QueryScheduledMemberUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMemberUpdatesResponse) ProtoMessage()    {}
+func (*QueryScheduledMemberUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryScheduledMemberUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMemberUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMemberUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledMemberUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMemberUpdatesResponse.Merge(m, src)
+}
+func (m *QueryScheduledMemberUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMemberUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMemberUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMemberUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledMemberUpdatesResponse) GetUpdates() []*ScheduledMemberUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1625,100 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryScheduledMemberUpdatesRequest)(nil), "cosmos.group.v1.QueryScheduledMemberUpdatesRequest")
+	proto.RegisterType((*QueryScheduledMemberUpdatesResponse)(nil), "cosmos.group.v1.QueryScheduledMemberUpdatesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xa5, 0xf9, 0xf5, 0xd2, 0x36, 0xea, 0x34, 0x6d, 0x93, 0x6d, 0xe4, 0x84, 0x2d,
-	0xe4, 0x77, 0x76, 0x63, 0x27, 0x4d, 0x11, 0x50, 0xa1, 0x5a, 0x82, 0x90, 0x43, 0x51, 0x6a, 0x2a,
-	0x24, 0x10, 0x52, 0xb4, 0xae, 0x37, 0xd6, 0x0a, 0x7b, 0xc7, 0xf5, 0x6e, 0x22, 0xac, 0xc8, 0x17,
-	0x24, 0x38, 0x20, 0x0e, 0xd0, 0x22, 0x54, 0x22, 0x0e, 0x3d, 0x20, 0xd1, 0x23, 0x07, 0x10, 0x12,
-	0xb7, 0xde, 0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x3f, 0x82, 0x0b, 0xda, 0x99, 0xb7,
-	0xf6, 0xfe, 0xf6, 0x5a, 0x58, 0xe0, 0x4b, 0x55, 0xef, 0xbc, 0x99, 0xf7, 0x99, 0xef, 0x7b, 0x3b,
-	0xfb, 0x9d, 0xc0, 0x95, 0xbb, 0xcc, 0xaa, 0x32, 0x4b, 0x2d, 0xd7, 0xd9, 0x7e, 0x4d, 0x3d, 0xc8,
-	0xaa, 0xf7, 0xf6, 0xf5, 0x7a, 0x43, 0xa9, 0xd5, 0x99, 0xcd, 0xe8, 0xb8, 0x18, 0x54, 0xf8, 0xa0,
-	0x72, 0x90, 0x95, 0x26, 0xca, 0xac, 0xcc, 0xf8, 0x98, 0xea, 0xfc, 0x4f, 0x84, 0x49, 0xd3, 0x65,
-	0xc6, 0xca, 0x15, 0x5d, 0xd5, 0x6a, 0x86, 0xaa, 0x99, 0x26, 0xb3, 0x35, 0xdb, 0x60, 0xa6, 0x85,
-	0xa3, 0xa1, 0x0c, 0x76, 0xa3, 0xa6, 0xbb, 0x83, 0x4b, 0x38, 0x58, 0xd4, 0x2c, 0x5d, 0xa4, 0x56,
-	0x0f, 0xb2, 0x45, 0xdd, 0xd6, 0xb2, 0x6a, 0x4d, 0x2b, 0x1b, 0x26, 0x5f, 0x09, 0x63, 0xa7, 0x44,
-	0xec, 0xae, 0xc8, 0x8f, 0x68, 0x62, 0xe8, 0xbc, 0x56, 0x35, 0x4c, 0xa6, 0xf2, 0x7f, 0xc5, 0x23,
-	0x39, 0x07, 0x17, 0x6f, 0x3b, 0xeb, 0x6d, 0x39, 0x69, 0xb7, 0xcd, 0x3d, 0x56, 0xd0, 0xef, 0xed,
-	0xeb, 0x96, 0x4d, 0xa7, 0x60, 0x84, 0xa3, 0xec, 0x1a, 0xa5, 0x49, 0x32, 0x4b, 0x16, 0x4e, 0x17,
-	0x86, 0xf9, 0xef, 0xed, 0x92, 0xfc, 0x26, 0x5c, 0x0a, 0xce, 0xb1, 0x6a, 0xcc, 0xb4, 0x74, 0xaa,
-	0xc0, 0x69, 0xc3, 0xdc, 0x63, 0x7c, 0xc2, 0x58, 0x4e, 0x52, 0x02, 0xc2, 0x28, 0xed, 0x19, 0x3c,
-	0x4e, 0xbe, 0x0d, 0x57, 0xda, 0x2b, 0xed, 0xb0, 0x8a, 0x71, 0xb7, 0xe1, 0x65, 0xc8, 0xc1, 0xb0,
-	0x56, 0x2a, 0xd5, 0x75, 0xcb, 0xe2, 0x2b, 0x8e, 0xe6, 0x27, 0x7f, 0xf9, 0x61, 0x75, 0x02, 0x17,
-	0xbd, 0x29, 0x46, 0xde, 0xb6, 0xeb, 0x86, 0x59, 0x2e, 0xb8, 0x81, 0xf2, 0x1d, 0x98, 0x8e, 0x5e,
-	0x12, 0x11, 0x37, 0x7c, 0x88, 0xb3, 0xd1, 0x88, 0x9e, 0x79, 0x02, 0xb4, 0x09, 0x93, 0xed, 0x55,
-	0x6f, 0xe9, 0xd5, 0xa2, 0x5e, 0xb7, 0x3a, 0x2b, 0x45, 0xdf, 0x00, 0x68, 0xd7, 0x67, 0xf2, 0x14,
-	0x4f, 0x39, 0xe7, 0xa6, 0x74, 0x8a, 0xa9, 0x88, 0x3e, 0xc2, 0x62, 0x2a, 0x3b, 0x5a, 0x59, 0xc7,
-	0x65, 0x0b, 0x9e, 0x99, 0xf2, 0x37, 0x04, 0xa6, 0x22, 0xf2, 0xe3, 0x96, 0x36, 0x61, 0xb8, 0x2a,
-	0x1e, 0x4d, 0x92, 0xd9, 0xe7, 0x16, 0xc6, 0x72, 0xd3, 0xd1, 0xbb, 0x12, 0xf3, 0x0a, 0x6e, 0x30,
-	0xdd, 0x8a, 0xa0, 0x9b, 0xef, 0x48, 0x27, 0x92, 0xfa, 0xf0, 0x1e, 0xf8, 0xf0, 0xac, 0x7c, 0xe3,
-	0x66, 0xa9, 0x6a, 0x98, 0xae, 0x3e, 0x0a, 0x0c, 0x6a, 0xce, 0xef, 0x8e, 0x35, 0x14, 0x61, 0x3d,
-	0x13, 0xed, 0x6b, 0x02, 0x52, 0x14, 0x15, 0xaa, 0x96, 0x83, 0x21, 0x2e, 0x8f, 0x2b, 0x5a, 0x52,
-	0xb7, 0x62, 0x64, 0xef, 0x14, 0xfb, 0x98, 0xc0, 0x6c, 0xa0, 0x4d, 0x0d, 0xdd, 0xca, 0x8b, 0x9f,
-	0xff, 0x61, 0x63, 0xfd, 0x48, 0xe0, 0xf9, 0x04, 0x0e, 0x94, 0x6a, 0x0b, 0xce, 0x09, 0x90, 0x1a,
-	0x06, 0xa0, 0x64, 0x9d, 0xdf, 0x9e, 0xb3, 0x65, 0xef, 0xba, 0xbd, 0xd3, 0xef, 0x28, 0x46, 0xbf,
-	0xbe, 0x68, 0xbc, 0x38, 0x51, 0xfd, 0xfd, 0xd7, 0x7f, 0xa2, 0x5e, 0x87, 0x09, 0x8e, 0xbd, 0x53,
-	0x67, 0x35, 0x66, 0x69, 0x15, 0x57, 0xc7, 0x19, 0x18, 0xab, 0xe1, 0xa3, 0x76, 0x2b, 0x82, 0xfb,
-	0x68, 0xbb, 0x24, 0xbf, 0x85, 0x1f, 0x91, 0xf6, 0x44, 0xdc, 0xe3, 0x35, 0x18, 0x71, 0xc3, 0xf0,
-	0xc0, 0x9d, 0x0a, 0xed, 0xae, 0x35, 0xa9, 0x15, 0x2a, 0x3f, 0x22, 0x20, 0xfb, 0x16, 0x74, 0x3b,
-	0x52, 0x88, 0xf0, 0x2f, 0x3e, 0x0f, 0x3d, 0xab, 0xf1, 0x77, 0x04, 0xae, 0x26, 0x22, 0xa2, 0x02,
-	0xd7, 0x61, 0xd4, 0xdd, 0x96, 0x5b, 0xe0, 0x04, 0x09, 0xda, 0xb1, 0xbd, 0xab, 0x6a, 0x1d, 0x66,
-	0x38, 0xe8, 0x3b, 0xcc, 0xd6, 0xf3, 0x2d, 0x5c, 0xe7, 0x57, 0x3d, 0x6d, 0x81, 0x9d, 0x37, 0xe9,
-	0xc0, 0x99, 0xc0, 0x39, 0x12, 0xdf, 0x24, 0x1e, 0x26, 0xdf, 0xc2, 0xb7, 0x33, 0x32, 0x27, 0x2a,
-	0xb3, 0x08, 0xa7, 0x9d, 0x60, 0xec, 0x8b, 0x8b, 0x21, 0x51, 0x9c, 0xe8, 0x02, 0x0f, 0x91, 0x3f,
-	0x21, 0xe8, 0x13, 0x9c, 0x67, 0x56, 0xbe, 0xeb, 0x06, 0xed, 0x59, 0xd5, 0xbf, 0x24, 0xe8, 0x2e,
-	0x42, 0x20, 0xb8, 0xa9, 0x65, 0x21, 0x94, 0x5b, 0xea, 0x98, 0x5d, 0x89, 0x98, 0xde, 0x95, 0xf8,
-	0x3e, 0x41, 0x7b, 0x82, 0x58, 0xbe, 0xe2, 0xb6, 0x6a, 0x47, 0x52, 0xd5, 0xae, 0x67, 0x5a, 0x7d,
-	0xe1, 0x9a, 0x02, 0x3f, 0xd4, 0xff, 0x2a, 0xd4, 0xc3, 0xa0, 0x25, 0x40, 0x4b, 0xd4, 0x07, 0x07,
-	0xca, 0x11, 0xf1, 0x7a, 0x61, 0x0f, 0x5a, 0x3f, 0xd8, 0x95, 0x97, 0xe1, 0x32, 0x67, 0xbb, 0xa3,
-	0x55, 0x2a, 0xce, 0xd9, 0xb6, 0x5f, 0xb1, 0x53, 0x7f, 0x1c, 0xde, 0xc5, 0xde, 0xf4, 0xcd, 0xc5,
-	0x4d, 0xdd, 0x80, 0x41, 0xdb, 0x79, 0x8c, 0x87, 0x40, 0xd8, 0xb7, 0x7a, 0x26, 0xe5, 0x47, 0x9f,
-	0xfe, 0x3e, 0x33, 0xf0, 0xf8, 0xaf, 0xef, 0x97, 0x48, 0x41, 0xcc, 0x92, 0xdf, 0x07, 0xea, 0x91,
-	0xcc, 0x25, 0xea, 0x55, 0x45, 0xee, 0x13, 0xb8, 0xe0, 0x5b, 0xbe, 0x0f, 0x2a, 0x91, 0xfb, 0xfb,
-	0x3c, 0x0c, 0x72, 0x28, 0xfa, 0x19, 0x81, 0xd1, 0x56, 0x22, 0x3a, 0x17, 0x82, 0x88, 0xbc, 0xd6,
-	0x49, 0xf3, 0x1d, 0xe3, 0x44, 0x52, 0x59, 0xf9, 0xe8, 0xd7, 0x3f, 0x1f, 0x9c, 0x5a, 0xa0, 0x73,
-	0x6a, 0xf0, 0x62, 0x8a, 0x9e, 0xd4, 0xdc, 0x63, 0xea, 0xa1, 0xeb, 0x4f, 0x9b, 0xf4, 0x5b, 0x02,
-	0xe3, 0x01, 0xa3, 0x42, 0x57, 0x12, 0x92, 0x85, 0x6e, 0x7b, 0xd2, 0x6a, 0xca, 0x68, 0x04, 0xdc,
-	0xe0, 0x80, 0x0a, 0x5d, 0x89, 0x01, 0xe4, 0xb6, 0xaa, 0x81, 0x9c, 0xf8, 0xb6, 0x36, 0xe9, 0x43,
-	0x02, 0x67, 0xbc, 0x97, 0x28, 0xba, 0x98, 0x90, 0xd5, 0x7f, 0xd1, 0x93, 0x96, 0xd2, 0x84, 0x22,
-	0x5d, 0x96, 0xd3, 0x2d, 0xd3, 0xc5, 0x18, 0x3a, 0xbc, 0x83, 0x79, 0x15, 0x3c, 0x22, 0x70, 0xd6,
-	0x77, 0x55, 0xa1, 0x49, 0x09, 0x03, 0x66, 0x57, 0x5a, 0x4e, 0x15, 0x8b, 0x74, 0x6b, 0x9c, 0x6e,
-	0x89, 0x2e, 0x44, 0xd3, 0x59, 0xbb, 0xc5, 0xc6, 0x2e, 0xf7, 0xc4, 0x8e, 0x72, 0x55, 0xc3, 0x6c,
-	0xd2, 0x9f, 0x09, 0x4c, 0x44, 0xdd, 0x11, 0x68, 0xb6, 0x53, 0xd5, 0x42, 0xf7, 0x1a, 0x29, 0xd7,
-	0xcd, 0x14, 0x24, 0x7e, 0x85, 0x13, 0x5f, 0xa3, 0xeb, 0x49, 0xd5, 0x36, 0x74, 0x4e, 0x2e, 0x86,
-	0x3c, 0xca, 0xfe, 0x14, 0x86, 0x17, 0x02, 0xa7, 0x83, 0xf7, 0xe9, 0x9c, 0xeb, 0x66, 0x0a, 0xc2,
-	0xbf, 0xc4, 0xe1, 0x73, 0x74, 0x2d, 0x05, 0xbc, 0x5f, 0xf6, 0x4f, 0x09, 0x8c, 0xb8, 0x26, 0x83,
-	0xbe, 0x18, 0x9d, 0x3a, 0xe0, 0x86, 0xa4, 0xb9, 0x4e, 0x61, 0x48, 0xa5, 0x72, 0xaa, 0x45, 0x3a,
-	0x1f, 0xa2, 0x72, 0x4f, 0x6f, 0xf5, 0xd0, 0x73, 0xb4, 0x37, 0xe9, 0x13, 0x02, 0x97, 0xa2, 0xed,
-	0x2e, 0x5d, 0x4f, 0xce, 0x19, 0xe9, 0xdf, 0xa5, 0x8d, 0xee, 0x26, 0x21, 0xf6, 0xab, 0x1c, 0x7b,
-	0x93, 0x6e, 0xc4, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x84, 0xc0, 0x85, 0x08,
-	0x57, 0x4a, 0xd7, 0xa2, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0xed, 0x62, 0x06, 0xa2, 0xbf, 0xce, 0xd1,
-	0x5f, 0xa3, 0x37, 0x42, 0xe8, 0x8e, 0xcf, 0x71, 0xa8, 0x5b, 0x7a, 0x73, 0x2f, 0xe6, 0xd7, 0x5f,
-	0x3d, 0xe4, 0x0f, 0x9b, 0xf4, 0x31, 0x81, 0xf1, 0x80, 0x01, 0x8d, 0x3b, 0x6a, 0xa3, 0x0d, 0x73,
-	0xdc, 0x51, 0x1b, 0xe3, 0x6a, 0x13, 0xfa, 0x97, 0xfb, 0x33, 0x2f, 0x78, 0xa0, 0x65, 0xbe, 0x22,
-	0x70, 0xc6, 0xeb, 0xff, 0xe2, 0x8e, 0xdb, 0x08, 0xe3, 0x1a, 0x77, 0xdc, 0x46, 0xd9, 0xc9, 0x84,
-	0x5e, 0x6e, 0x11, 0xa2, 0xa2, 0xa8, 0xe1, 0x23, 0x02, 0xe7, 0xfc, 0x4e, 0x8b, 0x76, 0x38, 0x41,
-	0x7d, 0x56, 0x51, 0x5a, 0x49, 0x17, 0x8c, 0x78, 0xeb, 0x1c, 0x6f, 0x95, 0x2e, 0x27, 0x9c, 0xb7,
-	0xe2, 0x8b, 0xe0, 0x69, 0xd5, 0x23, 0x02, 0x63, 0x1e, 0xff, 0x43, 0x17, 0xa2, 0x53, 0x86, 0x3d,
-	0x99, 0xb4, 0x98, 0x22, 0x12, 0xc9, 0x36, 0x39, 0xd9, 0x1a, 0x55, 0xe2, 0xdf, 0xa6, 0x40, 0x17,
-	0x72, 0xeb, 0x45, 0x6d, 0x18, 0x12, 0x7b, 0xa5, 0x57, 0x93, 0x94, 0x70, 0x89, 0x5e, 0x48, 0x0e,
-	0x42, 0x98, 0x19, 0x0e, 0x33, 0x45, 0x2f, 0xc7, 0xc8, 0x94, 0x57, 0x9e, 0x1e, 0x67, 0xc8, 0xb3,
-	0xe3, 0x0c, 0xf9, 0xe3, 0x38, 0x43, 0x3e, 0x3f, 0xc9, 0x0c, 0x3c, 0x3b, 0xc9, 0x0c, 0xfc, 0x76,
-	0x92, 0x19, 0x78, 0x0f, 0x5d, 0xba, 0x55, 0xfa, 0x40, 0x31, 0x98, 0xfa, 0xa1, 0x98, 0x50, 0x1c,
-	0xe2, 0x7f, 0xe4, 0x5e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x52, 0x63, 0x6a, 0x95, 0xbf, 0x17,
-	0x00, 0x00,
+	// 1373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa5, 0x6d, 0x9a, 0x97, 0xb6, 0x11, 0x93, 0xa4, 0x75, 0x36, 0x91, 0x13, 0xb6,
+	0x90, 0xdf, 0xd9, 0x8d, 0x9d, 0x34, 0x45, 0x40, 0x05, 0xb5, 0x04, 0x21, 0x87, 0xa2, 0xd4, 0x2d,
+	0x48, 0x20, 0xa4, 0x68, 0x13, 0x6f, 0xcc, 0x0a, 0x7b, 0xd7, 0xf5, 0xae, 0x23, 0xac, 0xc8, 0x17,
+	0x24, 0x40, 0x42, 0x1c, 0xa0, 0x45, 0xa8, 0x44, 0x1c, 0x7a, 0x40, 0xa2, 0xc7, 0x1e, 0x40, 0x48,
+	0x5c, 0x50, 0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0x82, 0xc4, 0xbf, 0x81, 0x76, 0xe6, 0xad,
+	0xbd, 0x3f, 0x66, 0xd7, 0xb6, 0xb0, 0x4a, 0x2e, 0x55, 0x3d, 0xf3, 0xde, 0xcc, 0x67, 0xbe, 0xef,
+	0xed, 0xcc, 0x7b, 0x0a, 0x8c, 0xef, 0x58, 0x76, 0xd9, 0xb2, 0xd5, 0x62, 0xd5, 0xaa, 0x55, 0xd4,
+	0xbd, 0x8c, 0x7a, 0xbb, 0xa6, 0x57, 0xeb, 0x4a, 0xa5, 0x6a, 0x39, 0x16, 0x1d, 0xe2, 0x93, 0x0a,
+	0x9b, 0x54, 0xf6, 0x32, 0xd2, 0x48, 0xd1, 0x2a, 0x5a, 0x6c, 0x4e, 0x75, 0xff, 0xc7, 0xcd, 0xa4,
+	0x89, 0xa2, 0x65, 0x15, 0x4b, 0xba, 0xaa, 0x55, 0x0c, 0x55, 0x33, 0x4d, 0xcb, 0xd1, 0x1c, 0xc3,
+	0x32, 0x6d, 0x9c, 0x8d, 0xec, 0xe0, 0xd4, 0x2b, 0xba, 0x37, 0x39, 0x8f, 0x93, 0xdb, 0x9a, 0xad,
+	0xf3, 0xad, 0xd5, 0xbd, 0xcc, 0xb6, 0xee, 0x68, 0x19, 0xb5, 0xa2, 0x15, 0x0d, 0x93, 0xad, 0x84,
+	0xb6, 0x63, 0xdc, 0x76, 0x8b, 0xef, 0xcf, 0x7f, 0xe0, 0xd4, 0xb3, 0x5a, 0xd9, 0x30, 0x2d, 0x95,
+	0xfd, 0xcb, 0x87, 0xe4, 0x2c, 0x8c, 0xde, 0x70, 0xd7, 0x5b, 0x77, 0xb7, 0xdd, 0x30, 0x77, 0xad,
+	0xbc, 0x7e, 0xbb, 0xa6, 0xdb, 0x0e, 0x1d, 0x83, 0x33, 0x0c, 0x65, 0xcb, 0x28, 0xa4, 0xc8, 0x14,
+	0x99, 0x3d, 0x99, 0xef, 0x67, 0xbf, 0x37, 0x0a, 0xf2, 0x9b, 0x70, 0x21, 0xec, 0x63, 0x57, 0x2c,
+	0xd3, 0xd6, 0xa9, 0x02, 0x27, 0x0d, 0x73, 0xd7, 0x62, 0x0e, 0x83, 0x59, 0x49, 0x09, 0x09, 0xa3,
+	0xb4, 0x3c, 0x98, 0x9d, 0x7c, 0x03, 0xc6, 0x5b, 0x2b, 0x6d, 0x5a, 0x25, 0x63, 0xa7, 0xee, 0x67,
+	0xc8, 0x42, 0xbf, 0x56, 0x28, 0x54, 0x75, 0xdb, 0x66, 0x2b, 0x0e, 0xe4, 0x52, 0xbf, 0xfd, 0xb8,
+	0x34, 0x82, 0x8b, 0x5e, 0xe3, 0x33, 0x37, 0x9d, 0xaa, 0x61, 0x16, 0xf3, 0x9e, 0xa1, 0x7c, 0x0b,
+	0x26, 0xc4, 0x4b, 0x22, 0xe2, 0x6a, 0x00, 0x71, 0x4a, 0x8c, 0xe8, 0xf3, 0xe3, 0xa0, 0x0d, 0x48,
+	0xb5, 0x56, 0xbd, 0xae, 0x97, 0xb7, 0xf5, 0xaa, 0xdd, 0x5e, 0x29, 0xfa, 0x06, 0x40, 0x2b, 0x3e,
+	0xa9, 0x13, 0x6c, 0xcb, 0x69, 0x6f, 0x4b, 0x37, 0x98, 0x0a, 0xcf, 0x23, 0x0c, 0xa6, 0xb2, 0xa9,
+	0x15, 0x75, 0x5c, 0x36, 0xef, 0xf3, 0x94, 0xbf, 0x23, 0x30, 0x26, 0xd8, 0x1f, 0x8f, 0xb4, 0x06,
+	0xfd, 0x65, 0x3e, 0x94, 0x22, 0x53, 0xcf, 0xcc, 0x0e, 0x66, 0x27, 0xc4, 0xa7, 0xe2, 0x7e, 0x79,
+	0xcf, 0x98, 0xae, 0x0b, 0xe8, 0x66, 0xda, 0xd2, 0xf1, 0x4d, 0x03, 0x78, 0x77, 0x03, 0x78, 0x76,
+	0xae, 0x7e, 0xad, 0x50, 0x36, 0x4c, 0x4f, 0x1f, 0x05, 0x4e, 0x69, 0xee, 0xef, 0xb6, 0x31, 0xe4,
+	0x66, 0x3d, 0x13, 0xed, 0x5b, 0x02, 0x92, 0x88, 0x0a, 0x55, 0xcb, 0xc2, 0x69, 0x26, 0x8f, 0x27,
+	0x5a, 0x52, 0xb6, 0xa2, 0x65, 0xef, 0x14, 0xfb, 0x84, 0xc0, 0x54, 0x28, 0x4d, 0x0d, 0xdd, 0xce,
+	0xf1, 0x9f, 0x4f, 0x31, 0xb1, 0x7e, 0x22, 0xf0, 0x5c, 0x02, 0x07, 0x4a, 0xb5, 0x0e, 0xe7, 0x39,
+	0x48, 0x05, 0x0d, 0x50, 0xb2, 0xf6, 0x5f, 0xcf, 0xb9, 0xa2, 0x7f, 0xdd, 0xde, 0xe9, 0x77, 0x10,
+	0xa3, 0xdf, 0xb1, 0x48, 0xbc, 0x38, 0x51, 0x83, 0xf9, 0x77, 0xfc, 0x44, 0xbd, 0x02, 0x23, 0x0c,
+	0x7b, 0xb3, 0x6a, 0x55, 0x2c, 0x5b, 0x2b, 0x79, 0x3a, 0x4e, 0xc2, 0x60, 0x05, 0x87, 0x5a, 0xa9,
+	0x08, 0xde, 0xd0, 0x46, 0x41, 0x7e, 0x0b, 0x46, 0x43, 0x8e, 0x78, 0xc6, 0xcb, 0x70, 0xc6, 0x33,
+	0xc3, 0x0b, 0x77, 0x2c, 0x72, 0xba, 0xa6, 0x53, 0xd3, 0x54, 0xbe, 0x4f, 0x40, 0x0e, 0x2c, 0xe8,
+	0x65, 0x24, 0x17, 0xe1, 0x3f, 0x3c, 0x0f, 0x3d, 0x8b, 0xf1, 0x0f, 0x04, 0x2e, 0x25, 0x22, 0xa2,
+	0x02, 0x57, 0x60, 0xc0, 0x3b, 0x96, 0x17, 0xe0, 0x04, 0x09, 0x5a, 0xb6, 0xbd, 0x8b, 0x6a, 0x15,
+	0x26, 0x19, 0xe8, 0x3b, 0x96, 0xa3, 0xe7, 0x9a, 0xb8, 0xee, 0xaf, 0x6a, 0xa7, 0x01, 0x76, 0xbf,
+	0xa4, 0x3d, 0xd7, 0x21, 0x75, 0xa2, 0x8d, 0xce, 0xdc, 0x4c, 0xbe, 0x8e, 0x5f, 0xa7, 0x70, 0x4f,
+	0x54, 0x66, 0x0e, 0x4e, 0xba, 0xc6, 0x98, 0x17, 0xa3, 0x11, 0x51, 0x5c, 0xeb, 0x3c, 0x33, 0x91,
+	0x3f, 0x25, 0x58, 0x27, 0xb8, 0x63, 0x76, 0xae, 0xeb, 0x04, 0xed, 0x59, 0xd4, 0xbf, 0x26, 0x30,
+	0x21, 0x06, 0xc1, 0x43, 0x2d, 0x70, 0xa1, 0xbc, 0x50, 0xc7, 0x9c, 0x8a, 0xdb, 0xf4, 0x2e, 0xc4,
+	0x77, 0x08, 0x96, 0x27, 0x88, 0x15, 0x08, 0x6e, 0x33, 0x76, 0xa4, 0xa3, 0xd8, 0xf5, 0x4c, 0xab,
+	0xaf, 0xbc, 0xa2, 0x20, 0x08, 0xf5, 0xbf, 0x0a, 0x75, 0x2f, 0x5c, 0x12, 0x60, 0x49, 0x74, 0x0c,
+	0x2e, 0x94, 0x03, 0x02, 0xe3, 0x42, 0xb4, 0xe3, 0x50, 0xae, 0xbc, 0x04, 0x17, 0x19, 0xdb, 0x2d,
+	0xad, 0x54, 0x72, 0xef, 0xb6, 0x5a, 0xc9, 0xe9, 0xf8, 0x71, 0x78, 0x17, 0x52, 0x51, 0x5f, 0x3c,
+	0xd4, 0x55, 0x38, 0xe5, 0xb8, 0xc3, 0x78, 0x09, 0x44, 0xeb, 0x56, 0x9f, 0x53, 0x6e, 0xe0, 0xf1,
+	0x9f, 0x93, 0x7d, 0x0f, 0xfe, 0x79, 0x38, 0x4f, 0xf2, 0xdc, 0x4b, 0x7e, 0x1f, 0xa8, 0x4f, 0x32,
+	0x8f, 0xa8, 0x57, 0x11, 0xb9, 0x43, 0x60, 0x38, 0xb0, 0xfc, 0x71, 0x88, 0xc4, 0x67, 0xde, 0xd3,
+	0x78, 0x73, 0xe7, 0x03, 0xbd, 0x50, 0x2b, 0xe9, 0x05, 0x9e, 0x27, 0x6f, 0x57, 0x0a, 0x9a, 0xa3,
+	0x3f, 0xcd, 0x9e, 0xe4, 0xa1, 0xf7, 0x02, 0xc6, 0x91, 0xa0, 0x5c, 0xaf, 0x41, 0x7f, 0x8d, 0x0f,
+	0xa1, 0x5e, 0xd3, 0x11, 0xbd, 0x84, 0x2b, 0xe4, 0x3d, 0xb7, 0x9e, 0x89, 0x97, 0xfd, 0x75, 0x18,
+	0x4e, 0x31, 0x64, 0xfa, 0x05, 0x81, 0x81, 0x66, 0x94, 0x68, 0x94, 0x48, 0xd8, 0x13, 0x4b, 0x33,
+	0x6d, 0xed, 0xf8, 0xa6, 0xb2, 0xf2, 0xf1, 0xef, 0x7f, 0xdf, 0x3d, 0x31, 0x4b, 0xa7, 0xd5, 0x70,
+	0x57, 0x8f, 0x51, 0x31, 0x77, 0x2d, 0x75, 0xdf, 0x8b, 0x50, 0x83, 0x7e, 0x4f, 0x60, 0x28, 0x54,
+	0xe5, 0xd1, 0xc5, 0x84, 0xcd, 0x22, 0xad, 0xb2, 0xb4, 0xd4, 0xa1, 0x35, 0x02, 0xae, 0x32, 0x40,
+	0x85, 0x2e, 0xc6, 0x00, 0xb2, 0x9a, 0xb4, 0x8e, 0x9c, 0x78, 0xd5, 0x35, 0xe8, 0x3d, 0x02, 0x67,
+	0xfd, 0x1d, 0x28, 0x9d, 0x4b, 0xd8, 0x35, 0xd8, 0x25, 0x4b, 0xf3, 0x9d, 0x98, 0x22, 0x5d, 0x86,
+	0xd1, 0x2d, 0xd0, 0xb9, 0x18, 0x3a, 0x6c, 0x60, 0xfd, 0x0a, 0x1e, 0x10, 0x38, 0x17, 0xe8, 0xf3,
+	0x68, 0xd2, 0x86, 0xa1, 0x4e, 0x41, 0x5a, 0xe8, 0xc8, 0x16, 0xe9, 0x96, 0x19, 0xdd, 0x3c, 0x9d,
+	0x15, 0xd3, 0xd9, 0x5b, 0xdb, 0xf5, 0x2d, 0xd6, 0x50, 0xb8, 0xca, 0x95, 0x0d, 0xb3, 0x41, 0x7f,
+	0x21, 0x30, 0x22, 0x6a, 0xb0, 0x68, 0xa6, 0x5d, 0xd4, 0x22, 0x4d, 0xa1, 0x94, 0xed, 0xc6, 0x05,
+	0x89, 0x5f, 0x66, 0xc4, 0x97, 0xe9, 0x4a, 0x52, 0xb4, 0x0d, 0x9d, 0x91, 0xf3, 0x29, 0x9f, 0xb2,
+	0x3f, 0x47, 0xe1, 0xb9, 0xc0, 0x9d, 0xc1, 0x07, 0x74, 0xce, 0x76, 0xe3, 0x82, 0xf0, 0x2f, 0x32,
+	0xf8, 0x2c, 0x5d, 0xee, 0x00, 0x3e, 0x28, 0xfb, 0xe7, 0x04, 0xce, 0x78, 0x15, 0x1a, 0x7d, 0x41,
+	0xbc, 0x75, 0xa8, 0x94, 0x94, 0xa6, 0xdb, 0x99, 0x21, 0x95, 0xca, 0xa8, 0xe6, 0xe8, 0x4c, 0x84,
+	0xca, 0x7b, 0xfa, 0xd4, 0x7d, 0xdf, 0xbb, 0xd8, 0xa0, 0x8f, 0x08, 0x5c, 0x10, 0xf7, 0x0a, 0x74,
+	0x25, 0x79, 0x4f, 0x61, 0xf3, 0x23, 0xad, 0x76, 0xe7, 0x84, 0xd8, 0xaf, 0x30, 0xec, 0x35, 0xba,
+	0x1a, 0x8b, 0xdd, 0x4a, 0x02, 0xbc, 0x04, 0x7c, 0xdf, 0xff, 0x23, 0x02, 0xc3, 0x82, 0x92, 0x9e,
+	0x2e, 0x8b, 0x59, 0xe2, 0x3b, 0x0e, 0x29, 0xd3, 0x85, 0x07, 0xa2, 0xbf, 0xce, 0xd0, 0x5f, 0xa5,
+	0x57, 0x23, 0xe8, 0x6e, 0x91, 0xe8, 0x52, 0x37, 0xf5, 0x76, 0x07, 0xaa, 0x41, 0xfd, 0xd5, 0x7d,
+	0x36, 0xd8, 0xa0, 0x0f, 0x08, 0x0c, 0x85, 0xaa, 0xf7, 0xb8, 0xab, 0x56, 0xdc, 0x6d, 0x48, 0x4b,
+	0x1d, 0x5a, 0xb7, 0xcd, 0x5f, 0x97, 0xc8, 0xf6, 0x83, 0x87, 0x52, 0xe6, 0x1b, 0x02, 0x67, 0xfd,
+	0xc5, 0x73, 0xdc, 0x75, 0x2b, 0xa8, 0xfa, 0xe3, 0xae, 0x5b, 0x51, 0x2d, 0x9e, 0x90, 0xcb, 0x4d,
+	0x42, 0x54, 0x14, 0x35, 0xbc, 0x4f, 0xe0, 0x7c, 0xb0, 0x4c, 0xa5, 0x6d, 0x6e, 0xd0, 0x40, 0x9d,
+	0x2d, 0x2d, 0x76, 0x66, 0x8c, 0x78, 0x2b, 0x0c, 0x6f, 0x89, 0x2e, 0x24, 0xdc, 0xb7, 0xfc, 0x45,
+	0xf0, 0xa5, 0xea, 0x01, 0x81, 0x41, 0x5f, 0xf1, 0x48, 0x67, 0xc5, 0x5b, 0x46, 0x0b, 0x5a, 0x69,
+	0xae, 0x03, 0x4b, 0x24, 0x5b, 0x63, 0x64, 0xcb, 0x54, 0x89, 0xff, 0x9a, 0x42, 0x59, 0xc8, 0xea,
+	0x56, 0xea, 0xc0, 0x69, 0x7e, 0x56, 0x7a, 0x29, 0x49, 0x09, 0x8f, 0xe8, 0xf9, 0x64, 0x23, 0x84,
+	0x99, 0x64, 0x30, 0x63, 0xf4, 0x62, 0x8c, 0x4c, 0xec, 0x06, 0x12, 0xd7, 0x6a, 0x71, 0x37, 0x50,
+	0x62, 0x8d, 0x29, 0xad, 0x76, 0xe7, 0xd4, 0xf6, 0x06, 0xb2, 0x3d, 0x47, 0x8c, 0xe6, 0x16, 0xd6,
+	0x7f, 0xbe, 0xc7, 0x28, 0xa7, 0x3c, 0x3e, 0x4c, 0x93, 0x27, 0x87, 0x69, 0xf2, 0xd7, 0x61, 0x9a,
+	0x7c, 0x79, 0x94, 0xee, 0x7b, 0x72, 0x94, 0xee, 0xfb, 0xe3, 0x28, 0xdd, 0xf7, 0x1e, 0xb6, 0x69,
+	0x76, 0xe1, 0x43, 0xc5, 0xb0, 0xd4, 0x8f, 0xf8, 0xb2, 0xdb, 0xa7, 0xd9, 0x5f, 0x39, 0x56, 0xfe,
+	0x0d, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xff, 0x71, 0xa6, 0xc0, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1767,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ScheduledMemberUpdates queries the upcoming member updates of a group.
+	ScheduledMemberUpdates(ctx context.Context, in *QueryScheduledMemberUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledMemberUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +1905,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ScheduledMemberUpdates(ctx context.Context, in *QueryScheduledMemberUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledMemberUpdatesResponse, error) {
+	out := new(QueryScheduledMemberUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ScheduledMemberUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +1950,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ScheduledMemberUpdates queries the upcoming member updates of a group.
+	ScheduledMemberUpdates(context.Context, *QueryScheduledMemberUpdatesRequest) (*QueryScheduledMemberUpdatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2000,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ScheduledMemberUpdates(ctx context.Context, req *QueryScheduledMemberUpdatesRequest) (*QueryScheduledMemberUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMemberUpdates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2260,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledMemberUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMemberUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMemberUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ScheduledMemberUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMemberUpdates(ctx, req.(*QueryScheduledMemberUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2338,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ScheduledMemberUpdates",
+			Handler:    _Query_ScheduledMemberUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,6 +3475,95 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScheduledMemberUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMemberUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMemberUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3777,6 +4020,41 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduledMemberUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMemberUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +6949,231 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduledMemberUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMemberUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMemberUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMemberUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMemberUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMemberUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &ScheduledMemberUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
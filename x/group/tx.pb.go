@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1469,6 +1473,229 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgScheduleMemberUpdate is the Msg/ScheduleMemberUpdate request type.
+type MsgScheduleMemberUpdate struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// member is the member update to apply, which removes the member from the group if its weight is zero.
+	Member MemberRequest `protobuf:"bytes,3,opt,name=member,proto3" json:"member"`
+	// activation is the time at which the member update is applied.
+	Activation time.Time `protobuf:"bytes,4,opt,name=activation,proto3,stdtime" json:"activation"`
+	// expiry is the optional time at which the member leaves the group again, so that memberships can have term limits.
+	// The member weight must then be positive.
+	Expiry *time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *MsgScheduleMemberUpdate) Reset()         { *m = MsgScheduleMemberUpdate{} }
+func (m *MsgScheduleMemberUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMemberUpdate) ProtoMessage()    {}
+func (*MsgScheduleMemberUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgScheduleMemberUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMemberUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMemberUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMemberUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMemberUpdate.Merge(m, src)
+}
+func (m *MsgScheduleMemberUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMemberUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMemberUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMemberUpdate proto.InternalMessageInfo
+
+func (m *MsgScheduleMemberUpdate) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgScheduleMemberUpdate) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgScheduleMemberUpdate) GetMember() MemberRequest {
+	if m != nil {
+		return m.Member
+	}
+	return MemberRequest{}
+}
+
+func (m *MsgScheduleMemberUpdate) GetActivation() time.Time {
+	if m != nil {
+		return m.Activation
+	}
+	return time.Time{}
+}
+
+func (m *MsgScheduleMemberUpdate) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+// MsgScheduleMemberUpdateResponse is the Msg/ScheduleMemberUpdate response type.
+type MsgScheduleMemberUpdateResponse struct {
+	// update_ids are the IDs of the scheduled updates, the one at expiry coming second.
+	UpdateIds []uint64 `protobuf:"varint,1,rep,packed,name=update_ids,json=updateIds,proto3" json:"update_ids,omitempty"`
+}
+
+func (m *MsgScheduleMemberUpdateResponse) Reset()         { *m = MsgScheduleMemberUpdateResponse{} }
+func (m *MsgScheduleMemberUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMemberUpdateResponse) ProtoMessage()    {}
+func (*MsgScheduleMemberUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgScheduleMemberUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMemberUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMemberUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMemberUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMemberUpdateResponse.Merge(m, src)
+}
+func (m *MsgScheduleMemberUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMemberUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMemberUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMemberUpdateResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMemberUpdateResponse) GetUpdateIds() []uint64 {
+	if m != nil {
+		return m.UpdateIds
+	}
+	return nil
+}
+
+// MsgCancelScheduledMemberUpdate is the Msg/CancelScheduledMemberUpdate request type.
+type MsgCancelScheduledMemberUpdate struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// update_id is the unique ID of the scheduled member update.
+	UpdateId uint64 `protobuf:"varint,2,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+}
+
+func (m *MsgCancelScheduledMemberUpdate) Reset()         { *m = MsgCancelScheduledMemberUpdate{} }
+func (m *MsgCancelScheduledMemberUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMemberUpdate) ProtoMessage()    {}
+func (*MsgCancelScheduledMemberUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgCancelScheduledMemberUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMemberUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMemberUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMemberUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMemberUpdate.Merge(m, src)
+}
+func (m *MsgCancelScheduledMemberUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMemberUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMemberUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMemberUpdate proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledMemberUpdate) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledMemberUpdate) GetUpdateId() uint64 {
+	if m != nil {
+		return m.UpdateId
+	}
+	return 0
+}
+
+// MsgCancelScheduledMemberUpdateResponse is the Msg/CancelScheduledMemberUpdate response type.
+type MsgCancelScheduledMemberUpdateResponse struct {
+}
+
+func (m *MsgCancelScheduledMemberUpdateResponse) Reset() {
+	*m = MsgCancelScheduledMemberUpdateResponse{}
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMemberUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMemberUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMemberUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMemberUpdateResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMemberUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMemberUpdateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1499,102 +1726,119 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgScheduleMemberUpdate)(nil), "cosmos.group.v1.MsgScheduleMemberUpdate")
+	proto.RegisterType((*MsgScheduleMemberUpdateResponse)(nil), "cosmos.group.v1.MsgScheduleMemberUpdateResponse")
+	proto.RegisterType((*MsgCancelScheduledMemberUpdate)(nil), "cosmos.group.v1.MsgCancelScheduledMemberUpdate")
+	proto.RegisterType((*MsgCancelScheduledMemberUpdateResponse)(nil), "cosmos.group.v1.MsgCancelScheduledMemberUpdateResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xce, 0xd7, 0x0b, 0x75, 0x93, 0x6d, 0xd2, 0x6e, 0xb6, 0xad, 0xed, 0x4e, 0x3f,
-	0x92, 0x5a, 0x8d, 0xdd, 0x38, 0xb4, 0x12, 0x06, 0x09, 0x35, 0xa9, 0x41, 0x41, 0x18, 0xa2, 0x6d,
-	0x4b, 0x81, 0x8b, 0xd9, 0x64, 0xb7, 0xcb, 0xaa, 0xb1, 0xd7, 0x78, 0xd6, 0x69, 0x72, 0xe3, 0xe3,
-	0x02, 0x08, 0x09, 0x24, 0xf8, 0x03, 0xe0, 0xc6, 0xb1, 0x48, 0x3d, 0x70, 0xe3, 0x86, 0xaa, 0x72,
-	0xa9, 0x38, 0x71, 0x42, 0xd0, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd, 0xf1, 0x8e, 0x77,
-	0xd7, 0xbb, 0xb1, 0x2c, 0xb8, 0x44, 0xde, 0x79, 0xbf, 0xf7, 0xf5, 0x7b, 0x6f, 0xde, 0xcc, 0x04,
-	0xa4, 0x1d, 0x0b, 0x37, 0x2d, 0x5c, 0x36, 0x3a, 0x56, 0xb7, 0x5d, 0xde, 0x5b, 0x2d, 0xdb, 0xfb,
-	0xa5, 0x76, 0xc7, 0xb2, 0x2d, 0xf1, 0x28, 0x95, 0x94, 0x88, 0xa4, 0xb4, 0xb7, 0x2a, 0xcf, 0x1b,
-	0x96, 0x61, 0x11, 0x59, 0xd9, 0xf9, 0x45, 0x61, 0xf2, 0x22, 0x85, 0x35, 0xa8, 0xc0, 0xd5, 0x71,
-	0x45, 0x86, 0x65, 0x19, 0xbb, 0x7a, 0x99, 0x7c, 0x6d, 0x77, 0xef, 0x94, 0xd5, 0xd6, 0x81, 0x2b,
-	0x3a, 0x19, 0x70, 0x7b, 0xd0, 0xd6, 0x3d, 0xbd, 0x13, 0xae, 0xb0, 0x89, 0x0d, 0x47, 0xd4, 0xc4,
-	0x86, 0x2b, 0x98, 0x53, 0x9b, 0x66, 0xcb, 0x2a, 0x93, 0xbf, 0x74, 0x09, 0xfd, 0x22, 0x40, 0xb6,
-	0x8e, 0x8d, 0x8d, 0x8e, 0xae, 0xda, 0xfa, 0xab, 0x8e, 0x35, 0xb1, 0x04, 0xe3, 0xaa, 0xd6, 0x34,
-	0x5b, 0x92, 0x50, 0x10, 0x96, 0xa7, 0xd7, 0xa5, 0x5f, 0x1f, 0xac, 0xcc, 0xbb, 0x71, 0x5d, 0xd3,
-	0xb4, 0x8e, 0x8e, 0xf1, 0x0d, 0xbb, 0x63, 0xb6, 0x0c, 0x85, 0xc2, 0xc4, 0x0d, 0x98, 0x6c, 0xea,
-	0xcd, 0x6d, 0xbd, 0x83, 0xa5, 0x54, 0x21, 0xbd, 0x3c, 0x53, 0xc9, 0x95, 0xfa, 0x52, 0x2f, 0xd5,
-	0x89, 0x5c, 0xd1, 0x3f, 0xe8, 0xea, 0xd8, 0x5e, 0x9f, 0x7e, 0xf8, 0x7b, 0x7e, 0xec, 0xfb, 0x67,
-	0xf7, 0x8b, 0x82, 0xe2, 0x69, 0x8a, 0x32, 0x4c, 0x35, 0x75, 0x5b, 0xd5, 0x54, 0x5b, 0x95, 0xd2,
-	0x8e, 0x5f, 0x85, 0x7d, 0x57, 0x97, 0x3f, 0x7e, 0x76, 0xbf, 0x48, 0x9d, 0x7d, 0xfe, 0xec, 0x7e,
-	0xd1, 0x65, 0x6c, 0x05, 0x6b, 0x77, 0xcb, 0x7c, 0xe8, 0x68, 0x0d, 0x8e, 0xf3, 0x2b, 0x8a, 0x8e,
-	0xdb, 0x56, 0x0b, 0xeb, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0xa6, 0x46, 0xf2, 0xca, 0x28, 0x93,
-	0xe4, 0x7b, 0x53, 0x43, 0x7f, 0x09, 0xb0, 0x50, 0xc7, 0xc6, 0xad, 0xb6, 0xe6, 0x69, 0xd5, 0xdd,
-	0xa0, 0x0e, 0xcb, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x41, 0x96, 0xa6, 0xda, 0xe8, 0x12,
-	0x3f, 0x58, 0x4a, 0x1f, 0x96, 0xab, 0x23, 0xd4, 0x00, 0x8d, 0x13, 0x57, 0xcb, 0x3c, 0x2b, 0x05,
-	0x9e, 0x95, 0x60, 0x36, 0x28, 0x0f, 0xa7, 0x43, 0x05, 0x1e, 0x47, 0xe8, 0x67, 0x01, 0x8e, 0xf1,
-	0x88, 0x6b, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x05, 0xa6, 0x5b, 0xfa, 0xbd, 0x06, 0x35, 0x97, 0x8e,
-	0x31, 0x37, 0xd5, 0xd2, 0xef, 0x91, 0x08, 0xaa, 0x2b, 0x7c, 0xae, 0xb9, 0xc8, 0x5c, 0x09, 0x1c,
-	0x9d, 0x86, 0x93, 0x21, 0xcb, 0x2c, 0xcf, 0x1f, 0x04, 0xd2, 0x26, 0x1c, 0x13, 0xb4, 0xd5, 0x46,
-	0x99, 0xea, 0xa0, 0x8e, 0xbe, 0xcc, 0xe7, 0x73, 0x66, 0x40, 0xed, 0xa8, 0x06, 0x2a, 0x40, 0x2e,
-	0x5c, 0xc2, 0xb2, 0xfa, 0x26, 0x05, 0xf3, 0x7c, 0xf3, 0x6f, 0x59, 0xbb, 0xe6, 0xce, 0xc1, 0x7f,
-	0x94, 0x93, 0xa8, 0xc2, 0x51, 0x4d, 0xdf, 0x31, 0xb1, 0x69, 0xb5, 0x1a, 0x6d, 0xe2, 0x59, 0xca,
-	0x14, 0x84, 0xe5, 0x99, 0xca, 0x7c, 0x89, 0xce, 0xb1, 0x92, 0x37, 0xc7, 0x4a, 0xd7, 0x5a, 0x07,
-	0xeb, 0xe8, 0xd1, 0x83, 0x95, 0x5c, 0x7f, 0xef, 0x5f, 0x77, 0x0d, 0xd0, 0xc8, 0x95, 0xac, 0xc6,
-	0x7d, 0x57, 0x2b, 0x9f, 0x7e, 0x9b, 0x1f, 0xe3, 0xa9, 0xcb, 0x47, 0x0e, 0x03, 0xaa, 0x83, 0x14,
-	0x38, 0x15, 0xb6, 0xce, 0x06, 0x43, 0x05, 0x26, 0x55, 0xca, 0x42, 0x2c, 0x3f, 0x1e, 0x10, 0x7d,
-	0x92, 0x82, 0x45, 0xbe, 0x1a, 0xd4, 0xe8, 0x70, 0xdb, 0xe5, 0x35, 0x98, 0xa7, 0x7c, 0x53, 0xd6,
-	0x1a, 0x5e, 0x38, 0xa9, 0x18, 0x75, 0xd1, 0xf0, 0x7b, 0x26, 0x92, 0x61, 0xf7, 0xd7, 0x1a, 0x4f,
-	0xea, 0xb9, 0xc8, 0x7e, 0xf4, 0xe5, 0x89, 0xce, 0xc2, 0x99, 0x48, 0x21, 0xeb, 0xca, 0x1f, 0xd3,
-	0x20, 0xf1, 0xfc, 0xdf, 0x36, 0xed, 0xf7, 0x87, 0xec, 0xcc, 0x91, 0x9c, 0x34, 0xe7, 0x21, 0x4b,
-	0xe9, 0xee, 0xeb, 0xe4, 0x23, 0x06, 0x37, 0x09, 0x2a, 0xb0, 0xc0, 0x55, 0x85, 0xa1, 0x33, 0x04,
-	0x7d, 0xcc, 0x47, 0x3e, 0xd3, 0x59, 0xed, 0xd3, 0x51, 0xb1, 0x5b, 0x89, 0xf1, 0x82, 0xb0, 0x3c,
-	0xc5, 0x17, 0x0c, 0xd3, 0x66, 0x09, 0xd9, 0x35, 0x13, 0x23, 0xde, 0x35, 0x57, 0x83, 0xbb, 0xe6,
-	0x6c, 0xe4, 0xae, 0xe9, 0x55, 0x07, 0x7d, 0x26, 0x40, 0x21, 0x4a, 0x98, 0xe0, 0x5c, 0x1d, 0x65,
-	0x5f, 0xa3, 0x9f, 0x52, 0x80, 0xc2, 0x9a, 0x8d, 0x4f, 0xfd, 0x7f, 0xdd, 0x7a, 0x21, 0x95, 0x4c,
-	0x8f, 0xb8, 0x92, 0xd5, 0x60, 0x25, 0x97, 0x22, 0xb7, 0x2a, 0x6f, 0x0b, 0x5d, 0x82, 0x62, 0x3c,
-	0x81, 0x6c, 0xdb, 0xfe, 0x2d, 0x90, 0xb1, 0x19, 0x80, 0x0f, 0x7d, 0x50, 0x8e, 0x92, 0xe9, 0x41,
-	0x27, 0xeb, 0xd5, 0xa4, 0xf4, 0xf0, 0xf9, 0xa0, 0x0b, 0x70, 0x6e, 0x90, 0x9c, 0x11, 0xf3, 0x67,
-	0x0a, 0xe6, 0xea, 0xd8, 0xb8, 0xd1, 0xdd, 0x6e, 0x9a, 0xf6, 0x56, 0xc7, 0x6a, 0x5b, 0x58, 0xdd,
-	0x8d, 0xcc, 0x4e, 0x18, 0x22, 0xbb, 0x53, 0x30, 0xdd, 0x26, 0x76, 0xbd, 0x31, 0x37, 0xad, 0xf4,
-	0x16, 0x06, 0x9e, 0xc0, 0x97, 0x1d, 0x19, 0xc6, 0xaa, 0xa1, 0x63, 0x29, 0x43, 0xe6, 0x63, 0x68,
-	0xeb, 0x29, 0x0c, 0x25, 0x5e, 0x84, 0x8c, 0xbe, 0xaf, 0xef, 0x90, 0xf9, 0x94, 0xad, 0x2c, 0x04,
-	0xa6, 0x69, 0x6d, 0x5f, 0xdf, 0x51, 0x08, 0x44, 0x9c, 0x87, 0x71, 0xdb, 0xb4, 0x77, 0x75, 0x32,
-	0x9e, 0xa6, 0x15, 0xfa, 0x21, 0x4a, 0x30, 0x89, 0xbb, 0xcd, 0xa6, 0xda, 0x39, 0x90, 0x26, 0xc9,
-	0xba, 0xf7, 0x59, 0x7d, 0xc1, 0xeb, 0xd5, 0x5e, 0xf0, 0x4e, 0x41, 0x90, 0xaf, 0x20, 0xf4, 0xf1,
-	0x12, 0x60, 0x13, 0xbd, 0x44, 0x4e, 0x57, 0x7e, 0x91, 0x0d, 0x9c, 0x3c, 0xcc, 0xb4, 0xdd, 0xb5,
-	0xde, 0xcc, 0x01, 0x6f, 0x69, 0x53, 0x43, 0xdf, 0xd1, 0x5b, 0xac, 0x33, 0xab, 0xb4, 0x8e, 0x7a,
-	0x8f, 0xd5, 0x28, 0x4e, 0xd1, 0x7f, 0x13, 0x48, 0x25, 0xbc, 0x09, 0x54, 0xaf, 0x38, 0x19, 0x7a,
-	0x5f, 0xfd, 0x47, 0x27, 0xcb, 0xaf, 0x3f, 0x16, 0xf7, 0x82, 0xda, 0xbf, 0xcc, 0x9a, 0xec, 0x1f,
-	0x01, 0x26, 0xeb, 0xd8, 0x78, 0xcb, 0xb2, 0xe3, 0xf3, 0x75, 0x76, 0xe2, 0x9e, 0x65, 0xeb, 0x9d,
-	0xd8, 0xa0, 0x29, 0x4c, 0x5c, 0x83, 0x09, 0xab, 0x6d, 0x9b, 0x16, 0xbd, 0x1f, 0x64, 0x2b, 0x27,
-	0x03, 0x55, 0x77, 0xfc, 0xbe, 0x49, 0x20, 0x8a, 0x0b, 0xe5, 0xda, 0x2e, 0xd3, 0xd7, 0x76, 0xc9,
-	0x9b, 0xa8, 0xba, 0x44, 0x76, 0x27, 0x89, 0xc3, 0x21, 0x4b, 0x0a, 0x23, 0xcb, 0xf1, 0x8e, 0xe6,
-	0xe0, 0xa8, 0xfb, 0x93, 0x91, 0xf2, 0x05, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xf3, 0x30, 0xe5,
-	0x38, 0xec, 0xda, 0x56, 0x3c, 0x2f, 0x0c, 0x59, 0x2d, 0x3a, 0xe1, 0xb1, 0xcf, 0xc8, 0x08, 0x9d,
-	0x10, 0x90, 0x42, 0x22, 0x24, 0xb9, 0x79, 0xad, 0xf9, 0x32, 0x4c, 0x74, 0x74, 0xdc, 0xdd, 0xb5,
-	0x89, 0xcb, 0x6c, 0x65, 0x29, 0x40, 0x85, 0x57, 0xe9, 0x9a, 0xeb, 0x42, 0x21, 0x70, 0xc5, 0x55,
-	0x43, 0x5f, 0x0a, 0x70, 0xa4, 0x8e, 0x8d, 0xd7, 0x75, 0x75, 0xcf, 0x7d, 0x8b, 0x0f, 0x71, 0x3b,
-	0x1d, 0x70, 0x7f, 0xa7, 0x6f, 0x46, 0x7f, 0xbb, 0xe6, 0xc2, 0xf2, 0xeb, 0xf9, 0x47, 0x27, 0xc8,
-	0xd3, 0xb8, 0xb7, 0xe0, 0xe5, 0x5a, 0x2c, 0x42, 0xa6, 0x46, 0xc7, 0xc2, 0x6c, 0xed, 0xed, 0xda,
-	0x46, 0xe3, 0xd6, 0x1b, 0x37, 0xb6, 0x6a, 0x1b, 0x9b, 0xaf, 0x6c, 0xd6, 0xae, 0xcf, 0x8e, 0x89,
-	0xcf, 0xc1, 0x14, 0x59, 0xbd, 0xa9, 0xbc, 0x33, 0x2b, 0x54, 0x1e, 0xcd, 0x40, 0xba, 0x8e, 0x0d,
-	0xf1, 0x36, 0xcc, 0xf8, 0xff, 0xcf, 0x90, 0x0f, 0x5e, 0xde, 0xb8, 0xdb, 0x86, 0xbc, 0x14, 0x03,
-	0x60, 0xc4, 0xef, 0x82, 0x18, 0xf2, 0x7a, 0xbf, 0x10, 0xa6, 0x1e, 0xc4, 0xc9, 0xa5, 0x64, 0x38,
-	0xe6, 0xed, 0x0e, 0xcc, 0x06, 0x9e, 0xc8, 0xe7, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x29, 0x09, 0x8a,
-	0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x29, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09, 0x81, 0xcc,
-	0xa1, 0x09, 0x73, 0xc1, 0xd7, 0xe3, 0xf9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x92, 0x08, 0xc6, 0x5c,
-	0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x31, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x26, 0x86, 0x32, 0xb7,
-	0xfb, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1, 0xcc, 0xf3,
-	0xd7, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0x6b, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0x8b, 0x43, 0x28, 0xb1,
-	0xa8, 0x3e, 0x12, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x92, 0xc8, 0x34, 0xeb, 0xb7, 0x2b, 0x87, 0x82,
-	0xb3, 0x18, 0xde, 0x83, 0x6c, 0xdf, 0x6d, 0x0a, 0x85, 0x19, 0xe2, 0x31, 0x72, 0x31, 0x1e, 0xe3,
-	0xdf, 0xb0, 0x81, 0xdb, 0x40, 0xe8, 0x86, 0xed, 0x47, 0x85, 0x6f, 0xd8, 0xa8, 0x63, 0x5b, 0x5c,
-	0x87, 0x0c, 0x39, 0xb2, 0xa5, 0x30, 0x2d, 0x47, 0x22, 0x17, 0xa2, 0x24, 0x7e, 0x1b, 0x64, 0xae,
-	0x86, 0xda, 0x70, 0x24, 0xe1, 0x36, 0xb8, 0x73, 0xe8, 0x26, 0x80, 0xef, 0x08, 0xc9, 0x85, 0xe1,
-	0x7b, 0x72, 0xf9, 0xc2, 0x60, 0xb9, 0x67, 0x55, 0x1e, 0xff, 0xd0, 0x79, 0x47, 0xaf, 0x97, 0x1e,
-	0x3e, 0xc9, 0x09, 0x8f, 0x9f, 0xe4, 0x84, 0x3f, 0x9e, 0xe4, 0x84, 0xaf, 0x9e, 0xe6, 0xc6, 0x1e,
-	0x3f, 0xcd, 0x8d, 0xfd, 0xf6, 0x34, 0x37, 0xf6, 0xae, 0x7b, 0x2c, 0x61, 0xed, 0x6e, 0xc9, 0xb4,
-	0xca, 0xfb, 0xf4, 0x3c, 0xd9, 0x9e, 0x20, 0x57, 0xcf, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0x49, 0x9b, 0xdc, 0x29, 0x17, 0x00, 0x00,
+	// 1634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xd8, 0xce, 0x87, 0x4f, 0x5e, 0x9c, 0x64, 0x9a, 0xb4, 0xce, 0xa4, 0xb5, 0xdd, 0x69,
+	0x9b, 0xb8, 0x56, 0x63, 0x37, 0xce, 0x6b, 0xdf, 0x7b, 0x7e, 0x48, 0x90, 0xa4, 0x01, 0x05, 0x61,
+	0x88, 0xa6, 0x2d, 0x05, 0x36, 0x66, 0xe2, 0xb9, 0x9d, 0x8e, 0x6a, 0x7b, 0x8c, 0xef, 0x38, 0x4d,
+	0x76, 0x7c, 0x2c, 0xf8, 0x10, 0x12, 0x95, 0x60, 0x8d, 0x60, 0xc7, 0xb2, 0x48, 0x5d, 0xb0, 0x63,
+	0x87, 0x2a, 0xd8, 0x54, 0xac, 0x58, 0x51, 0x68, 0x85, 0xba, 0xe3, 0x5f, 0x00, 0xcd, 0xbd, 0x33,
+	0x37, 0xbe, 0x9e, 0x19, 0x8f, 0x63, 0x2c, 0xd8, 0x58, 0x9e, 0x7b, 0x7e, 0xe7, 0xfb, 0xdc, 0x73,
+	0xcf, 0xbd, 0x90, 0xac, 0x9a, 0xb8, 0x6e, 0xe2, 0x82, 0xde, 0x32, 0xdb, 0xcd, 0xc2, 0xde, 0x6a,
+	0xc1, 0xda, 0xcf, 0x37, 0x5b, 0xa6, 0x65, 0x8a, 0xd3, 0x94, 0x92, 0x27, 0x94, 0xfc, 0xde, 0xaa,
+	0x34, 0xa7, 0x9b, 0xba, 0x49, 0x68, 0x05, 0xfb, 0x1f, 0x85, 0x49, 0x0b, 0x14, 0x56, 0xa1, 0x04,
+	0x87, 0xc7, 0x21, 0xe9, 0xa6, 0xa9, 0xd7, 0x50, 0x81, 0x7c, 0xed, 0xb6, 0x6f, 0x16, 0xd4, 0xc6,
+	0x81, 0x43, 0x4a, 0x77, 0x93, 0x2c, 0xa3, 0x8e, 0xb0, 0xa5, 0xd6, 0x9b, 0x0e, 0x60, 0xd1, 0x63,
+	0xd7, 0x41, 0x13, 0xb9, 0x82, 0x4f, 0x38, 0xc4, 0x3a, 0xd6, 0x6d, 0x52, 0x1d, 0xeb, 0x0e, 0x61,
+	0x56, 0xad, 0x1b, 0x0d, 0xb3, 0x40, 0x7e, 0xe9, 0x92, 0xfc, 0x83, 0x00, 0x89, 0x32, 0xd6, 0x37,
+	0x5b, 0x48, 0xb5, 0xd0, 0x0b, 0xb6, 0x34, 0x31, 0x0f, 0xa3, 0xaa, 0x56, 0x37, 0x1a, 0x49, 0x21,
+	0x23, 0x64, 0xe3, 0x1b, 0xc9, 0x1f, 0xef, 0xaf, 0xcc, 0x39, 0x86, 0xaf, 0x6b, 0x5a, 0x0b, 0x61,
+	0x7c, 0xd5, 0x6a, 0x19, 0x0d, 0x5d, 0xa1, 0x30, 0x71, 0x13, 0xc6, 0xeb, 0xa8, 0xbe, 0x8b, 0x5a,
+	0x38, 0x19, 0xc9, 0x44, 0xb3, 0x93, 0xc5, 0x54, 0xbe, 0x2b, 0x36, 0xf9, 0x32, 0xa1, 0x2b, 0xe8,
+	0xad, 0x36, 0xc2, 0xd6, 0x46, 0xfc, 0xc1, 0xcf, 0xe9, 0x91, 0xaf, 0x9e, 0xde, 0xcb, 0x09, 0x8a,
+	0xcb, 0x29, 0x4a, 0x30, 0x51, 0x47, 0x96, 0xaa, 0xa9, 0x96, 0x9a, 0x8c, 0xda, 0x7a, 0x15, 0xf6,
+	0x5d, 0xca, 0xbe, 0xfb, 0xf4, 0x5e, 0x8e, 0x2a, 0xfb, 0xe8, 0xe9, 0xbd, 0x9c, 0x13, 0xd2, 0x15,
+	0xac, 0xdd, 0x2e, 0xf0, 0xa6, 0xcb, 0x6b, 0x70, 0x9c, 0x5f, 0x51, 0x10, 0x6e, 0x9a, 0x0d, 0x8c,
+	0xc4, 0x05, 0x98, 0x20, 0xd6, 0x54, 0x0c, 0x8d, 0xf8, 0x15, 0x53, 0xc6, 0xc9, 0xf7, 0xb6, 0x26,
+	0xff, 0x26, 0xc0, 0x7c, 0x19, 0xeb, 0xd7, 0x9b, 0x9a, 0xcb, 0x55, 0x76, 0x8c, 0x3a, 0x6a, 0x24,
+	0x3a, 0x95, 0x44, 0x38, 0x25, 0xe2, 0x0e, 0x24, 0xa8, 0xab, 0x95, 0x36, 0xd1, 0x83, 0x93, 0xd1,
+	0xa3, 0xc6, 0x6a, 0x8a, 0x0a, 0xa0, 0x76, 0xe2, 0x52, 0x81, 0x8f, 0x4a, 0x86, 0x8f, 0x8a, 0xd7,
+	0x1b, 0x39, 0x0d, 0xa7, 0x7c, 0x09, 0x6e, 0x8c, 0xe4, 0xef, 0x04, 0x38, 0xc6, 0x23, 0xd6, 0x89,
+	0x5b, 0x43, 0x0c, 0xc3, 0x25, 0x88, 0x37, 0xd0, 0x9d, 0x0a, 0x15, 0x17, 0x0d, 0x11, 0x37, 0xd1,
+	0x40, 0x77, 0x88, 0x05, 0xa5, 0x15, 0xde, 0xd7, 0x54, 0xa0, 0xaf, 0x04, 0x2e, 0x9f, 0x82, 0x45,
+	0x9f, 0x65, 0xe6, 0xe7, 0xd7, 0x02, 0x1c, 0xe7, 0xe9, 0x65, 0xa7, 0xd4, 0x86, 0xe9, 0x6a, 0xaf,
+	0x8a, 0xbe, 0xc8, 0xfb, 0x73, 0xba, 0x47, 0xee, 0x28, 0x87, 0x9c, 0x81, 0x94, 0x3f, 0x85, 0x79,
+	0xf5, 0x59, 0x04, 0xe6, 0xf8, 0xe2, 0xdf, 0x31, 0x6b, 0x46, 0xf5, 0xe0, 0x6f, 0xf2, 0x49, 0x54,
+	0x61, 0x5a, 0x43, 0x55, 0x03, 0x1b, 0x66, 0xa3, 0xd2, 0x24, 0x9a, 0x93, 0xb1, 0x8c, 0x90, 0x9d,
+	0x2c, 0xce, 0xe5, 0x69, 0x37, 0xcb, 0xbb, 0xdd, 0x2c, 0xbf, 0xde, 0x38, 0xd8, 0x90, 0xbf, 0xbf,
+	0xbf, 0x92, 0xea, 0xae, 0xfd, 0x2b, 0x8e, 0x00, 0x6a, 0xb9, 0x92, 0xd0, 0xb8, 0xef, 0x52, 0xf1,
+	0x83, 0x2f, 0xd2, 0x23, 0x7c, 0xe8, 0xd2, 0x81, 0xcd, 0x80, 0xf2, 0xc8, 0x0a, 0x9c, 0xf4, 0x5b,
+	0x67, 0x8d, 0xa1, 0x08, 0xe3, 0x2a, 0x8d, 0x42, 0x68, 0x7c, 0x5c, 0xa0, 0xfc, 0x5e, 0x04, 0x16,
+	0xf8, 0x6c, 0x50, 0xa1, 0x83, 0x6d, 0x97, 0x17, 0x61, 0x8e, 0xc6, 0x9b, 0x46, 0xad, 0xe2, 0x9a,
+	0x13, 0x09, 0x61, 0x17, 0xf5, 0x4e, 0xcd, 0x84, 0x32, 0xe8, 0xfe, 0x5a, 0xe3, 0x83, 0x7a, 0x36,
+	0xb0, 0x1e, 0x3b, 0xfc, 0x94, 0xcf, 0xc0, 0xe9, 0x40, 0x22, 0xab, 0xca, 0x6f, 0xa2, 0x90, 0xe4,
+	0xe3, 0x7f, 0xc3, 0xb0, 0x6e, 0x0d, 0x58, 0x99, 0x43, 0x39, 0x69, 0xce, 0x41, 0x82, 0x86, 0xbb,
+	0xab, 0x92, 0xa7, 0x74, 0xae, 0x13, 0x14, 0x61, 0x9e, 0xcb, 0x0a, 0x43, 0xc7, 0x08, 0xfa, 0x58,
+	0x47, 0xf0, 0x19, 0xcf, 0x6a, 0x17, 0x8f, 0x8a, 0x9d, 0x4c, 0x8c, 0x66, 0x84, 0xec, 0x04, 0x9f,
+	0x30, 0x4c, 0x8b, 0xc5, 0x67, 0xd7, 0x8c, 0x0d, 0x79, 0xd7, 0x5c, 0xf6, 0xee, 0x9a, 0x33, 0x81,
+	0xbb, 0xe6, 0x30, 0x3b, 0xf2, 0x87, 0x02, 0x64, 0x82, 0x88, 0x7d, 0x9c, 0xab, 0xc3, 0xac, 0x6b,
+	0xf9, 0xdb, 0x08, 0xc8, 0x7e, 0xc5, 0xc6, 0xbb, 0xfe, 0x8f, 0x6e, 0x3d, 0x9f, 0x4c, 0x46, 0x87,
+	0x9c, 0xc9, 0x92, 0x37, 0x93, 0xcb, 0x81, 0x5b, 0x95, 0x97, 0x25, 0x5f, 0x80, 0x5c, 0x78, 0x00,
+	0xd9, 0xb6, 0xfd, 0x5d, 0x80, 0x93, 0x7e, 0xf0, 0x81, 0x0f, 0xca, 0x61, 0x46, 0xba, 0xd7, 0xc9,
+	0x7a, 0xb9, 0xdf, 0xf0, 0xf0, 0xfe, 0xc8, 0x4b, 0x70, 0xb6, 0x17, 0x9d, 0x05, 0xe6, 0xd7, 0x08,
+	0xcc, 0x96, 0xb1, 0x7e, 0xb5, 0xbd, 0x5b, 0x37, 0xac, 0x9d, 0x96, 0xd9, 0x34, 0xb1, 0x5a, 0x0b,
+	0xf4, 0x4e, 0x18, 0xc0, 0xbb, 0x93, 0x10, 0x6f, 0x12, 0xb9, 0x6e, 0x9b, 0x8b, 0x2b, 0x87, 0x0b,
+	0x3d, 0x4f, 0xe0, 0x8b, 0x36, 0x0d, 0x63, 0x55, 0x47, 0x38, 0x19, 0xcb, 0x44, 0x83, 0x4a, 0x4f,
+	0x61, 0x28, 0xf1, 0x3c, 0xc4, 0xd0, 0x3e, 0xaa, 0x92, 0xfe, 0x94, 0x28, 0xce, 0x7b, 0xba, 0xe9,
+	0xd6, 0x3e, 0xaa, 0x2a, 0x04, 0x22, 0xce, 0xc1, 0xa8, 0x65, 0x58, 0x35, 0x44, 0xda, 0x53, 0x5c,
+	0xa1, 0x1f, 0x62, 0x12, 0xc6, 0x71, 0xbb, 0x5e, 0x57, 0x5b, 0x07, 0xc9, 0x71, 0xb2, 0xee, 0x7e,
+	0x96, 0xfe, 0xe7, 0xd6, 0xea, 0xa1, 0xf1, 0x76, 0x42, 0xe4, 0x8e, 0x84, 0xd0, 0xcb, 0x8b, 0x27,
+	0x9a, 0xf2, 0x33, 0xb0, 0xe0, 0x59, 0x64, 0x0d, 0x27, 0x0d, 0x93, 0x4d, 0x67, 0xed, 0xb0, 0xe7,
+	0x80, 0xbb, 0xb4, 0xad, 0xc9, 0x5f, 0xd2, 0x29, 0xd6, 0xee, 0x55, 0x5a, 0x4b, 0xbd, 0xc3, 0x72,
+	0x14, 0xc6, 0xd8, 0x39, 0x09, 0x44, 0xfa, 0x9c, 0x04, 0x4a, 0x97, 0x6c, 0x0f, 0xdd, 0xaf, 0xee,
+	0xa3, 0x93, 0xf9, 0xd7, 0x6d, 0x8b, 0x33, 0xa0, 0x76, 0x2f, 0xb3, 0x22, 0xfb, 0x43, 0x80, 0xf1,
+	0x32, 0xd6, 0x5f, 0x35, 0xad, 0x70, 0x7f, 0xed, 0x9d, 0xb8, 0x67, 0x5a, 0xa8, 0x15, 0x6a, 0x34,
+	0x85, 0x89, 0x6b, 0x30, 0x66, 0x36, 0x2d, 0xc3, 0xa4, 0xf3, 0x41, 0xa2, 0xb8, 0xe8, 0xc9, 0xba,
+	0xad, 0xf7, 0x15, 0x02, 0x51, 0x1c, 0x28, 0x57, 0x76, 0xb1, 0xae, 0xb2, 0xeb, 0xbf, 0x88, 0x4a,
+	0xcb, 0x64, 0x77, 0x12, 0x3b, 0xec, 0x60, 0x25, 0xfd, 0x82, 0x65, 0x6b, 0x97, 0x67, 0x61, 0xda,
+	0xf9, 0xcb, 0x82, 0xf2, 0x31, 0x0d, 0x8a, 0x2d, 0x2d, 0x3c, 0x28, 0xff, 0x86, 0x09, 0x5b, 0x61,
+	0xdb, 0x32, 0xc3, 0xe3, 0xc2, 0x90, 0xa5, 0x9c, 0x6d, 0x1e, 0xfb, 0x0c, 0xb4, 0xd0, 0x36, 0x41,
+	0x56, 0x60, 0xda, 0xf9, 0xcb, 0x4a, 0xf3, 0x59, 0x18, 0x6b, 0x21, 0xdc, 0xae, 0x59, 0x44, 0x65,
+	0xa2, 0xb8, 0xec, 0x09, 0x85, 0x9b, 0xe9, 0x2d, 0x47, 0x85, 0x42, 0xe0, 0x8a, 0xc3, 0x26, 0x7f,
+	0x22, 0xc0, 0x54, 0x19, 0xeb, 0x2f, 0x21, 0x75, 0xcf, 0xb9, 0x8b, 0x0f, 0x30, 0x9d, 0xf6, 0x98,
+	0xdf, 0xe9, 0x9d, 0xb1, 0xb3, 0x5c, 0x53, 0x7e, 0xfe, 0x1d, 0xea, 0x97, 0x4f, 0xc0, 0x3c, 0xb7,
+	0xc0, 0xb2, 0xf1, 0x28, 0x02, 0x27, 0xec, 0x4d, 0x5a, 0xbd, 0x85, 0xb4, 0x76, 0x0d, 0x95, 0x3b,
+	0xae, 0xa6, 0xc3, 0xbc, 0x70, 0xac, 0xc3, 0x18, 0x9d, 0xdb, 0x9c, 0xb3, 0xf4, 0x08, 0x03, 0x9f,
+	0xc3, 0x28, 0x6e, 0x03, 0xa8, 0x55, 0xcb, 0xd8, 0x53, 0x49, 0xcd, 0xd3, 0x2b, 0x89, 0xe4, 0xe9,
+	0x8b, 0xd7, 0xdc, 0x07, 0x96, 0x8d, 0x29, 0x5b, 0xc4, 0xdd, 0x47, 0x69, 0x81, 0x8a, 0xe9, 0x60,
+	0x16, 0xff, 0x0b, 0x63, 0x68, 0xbf, 0x69, 0xb4, 0x0e, 0x92, 0xa3, 0xa1, 0x62, 0x62, 0xb6, 0x08,
+	0xc5, 0xc1, 0x97, 0x56, 0xf9, 0x63, 0x49, 0xe6, 0x8f, 0x25, 0xbf, 0x28, 0xca, 0xcf, 0x41, 0x3a,
+	0x80, 0xc4, 0x0a, 0xee, 0x14, 0x00, 0x7d, 0x4d, 0xa8, 0x18, 0x9a, 0x5d, 0x20, 0xd1, 0x6c, 0x4c,
+	0x89, 0xd3, 0x95, 0x6d, 0x0d, 0xcb, 0x9f, 0x0b, 0xe4, 0xd2, 0xb8, 0xa9, 0x36, 0xaa, 0xa8, 0xe6,
+	0x0a, 0xd2, 0xfe, 0x52, 0xaa, 0x16, 0x21, 0xce, 0x34, 0x3a, 0xb9, 0x9a, 0x70, 0x15, 0x86, 0xbc,
+	0x48, 0x50, 0x33, 0x38, 0x17, 0xb3, 0xb0, 0xd4, 0xdb, 0x3e, 0xd7, 0xd3, 0x5c, 0x0e, 0x62, 0x5b,
+	0xf4, 0x14, 0x9a, 0xd9, 0x7a, 0x6d, 0x6b, 0xb3, 0x72, 0xfd, 0xe5, 0xab, 0x3b, 0x5b, 0x9b, 0xdb,
+	0xcf, 0x6f, 0x6f, 0x5d, 0x99, 0x19, 0x11, 0xff, 0x05, 0x13, 0x64, 0xf5, 0x9a, 0xf2, 0xfa, 0x8c,
+	0x50, 0x7c, 0x30, 0x05, 0xd1, 0x32, 0xd6, 0xc5, 0x1b, 0x30, 0xd9, 0xf9, 0xac, 0x95, 0xf6, 0x96,
+	0x0e, 0x37, 0xdc, 0x4a, 0xcb, 0x21, 0x00, 0x16, 0xf6, 0x1a, 0x88, 0x3e, 0x8f, 0x45, 0x4b, 0x7e,
+	0xec, 0x5e, 0x9c, 0x94, 0xef, 0x0f, 0xc7, 0xb4, 0xdd, 0x84, 0x19, 0xcf, 0x8b, 0xcc, 0xd9, 0x10,
+	0x19, 0x04, 0x25, 0x5d, 0xe8, 0x07, 0xc5, 0xf4, 0x98, 0x70, 0xcc, 0xef, 0x45, 0x64, 0x39, 0xd4,
+	0x5c, 0x0a, 0x94, 0x0a, 0x7d, 0x02, 0x99, 0x42, 0x03, 0x66, 0xbd, 0x8f, 0x15, 0xe7, 0x42, 0x92,
+	0x40, 0x61, 0xd2, 0x4a, 0x5f, 0x30, 0xa6, 0xaa, 0x0d, 0xf3, 0xfe, 0x37, 0xd0, 0xf3, 0x21, 0x72,
+	0x0e, 0xa1, 0xd2, 0x6a, 0xdf, 0x50, 0xa6, 0x76, 0x1f, 0x8e, 0x07, 0xbc, 0x11, 0xe4, 0x42, 0x82,
+	0xd5, 0x81, 0x95, 0x8a, 0xfd, 0x63, 0x99, 0xe6, 0x4f, 0x05, 0x48, 0x87, 0x5d, 0x96, 0xd6, 0xfa,
+	0x92, 0xcb, 0x33, 0x49, 0xff, 0x1f, 0x80, 0x89, 0x59, 0xf5, 0x8e, 0x00, 0x0b, 0xc1, 0x57, 0x8a,
+	0x95, 0xbe, 0x44, 0xb3, 0x7a, 0xbb, 0x74, 0x24, 0x38, 0xb3, 0xe1, 0x4d, 0x48, 0x74, 0x0d, 0xef,
+	0xb2, 0x9f, 0x20, 0x1e, 0x23, 0xe5, 0xc2, 0x31, 0x9d, 0x1b, 0xd6, 0x33, 0x7c, 0xfa, 0x6e, 0xd8,
+	0x6e, 0x94, 0x74, 0xa1, 0x1f, 0x14, 0xd3, 0xb3, 0x01, 0x31, 0x32, 0x21, 0x26, 0xfd, 0xb8, 0x6c,
+	0x8a, 0x94, 0x09, 0xa2, 0x74, 0xca, 0x20, 0x7d, 0xd5, 0x57, 0x86, 0x4d, 0x91, 0x32, 0x41, 0x14,
+	0x26, 0xe3, 0x1a, 0x40, 0xc7, 0xc4, 0x92, 0xf2, 0xc3, 0x1f, 0xd2, 0xa5, 0xa5, 0xde, 0x74, 0x26,
+	0xb5, 0x05, 0x73, 0xbe, 0xc3, 0x45, 0xd6, 0x37, 0x13, 0x3e, 0x48, 0xe9, 0x62, 0xbf, 0x48, 0xa6,
+	0xf3, 0x7d, 0x01, 0x16, 0x7b, 0x9d, 0x96, 0xbe, 0x2d, 0xae, 0x07, 0x83, 0xf4, 0x9f, 0x23, 0x32,
+	0xb8, 0x96, 0x48, 0xa3, 0x6f, 0xdb, 0xc3, 0xc7, 0x46, 0xfe, 0xc1, 0xe3, 0x94, 0xf0, 0xf0, 0x71,
+	0x4a, 0xf8, 0xe5, 0x71, 0x4a, 0xb8, 0xfb, 0x24, 0x35, 0xf2, 0xf0, 0x49, 0x6a, 0xe4, 0xa7, 0x27,
+	0xa9, 0x91, 0x37, 0x9c, 0x53, 0x1a, 0x6b, 0xb7, 0xf3, 0x86, 0x59, 0xd8, 0xa7, 0xc3, 0xdb, 0xee,
+	0x18, 0x19, 0x44, 0xd6, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xed, 0xf9, 0xf8, 0xb7, 0x1a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1637,6 +1881,10 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// ScheduleMemberUpdate schedules an update of a group member, or a membership with a term limit.
+	ScheduleMemberUpdate(ctx context.Context, in *MsgScheduleMemberUpdate, opts ...grpc.CallOption) (*MsgScheduleMemberUpdateResponse, error)
+	// CancelScheduledMemberUpdate cancels a scheduled member update.
+	CancelScheduledMemberUpdate(ctx context.Context, in *MsgCancelScheduledMemberUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledMemberUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -1773,6 +2021,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMemberUpdate(ctx context.Context, in *MsgScheduleMemberUpdate, opts ...grpc.CallOption) (*MsgScheduleMemberUpdateResponse, error) {
+	out := new(MsgScheduleMemberUpdateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/ScheduleMemberUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMemberUpdate(ctx context.Context, in *MsgCancelScheduledMemberUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledMemberUpdateResponse, error) {
+	out := new(MsgCancelScheduledMemberUpdateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CancelScheduledMemberUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1803,6 +2069,10 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// ScheduleMemberUpdate schedules an update of a group member, or a membership with a term limit.
+	ScheduleMemberUpdate(context.Context, *MsgScheduleMemberUpdate) (*MsgScheduleMemberUpdateResponse, error)
+	// CancelScheduledMemberUpdate cancels a scheduled member update.
+	CancelScheduledMemberUpdate(context.Context, *MsgCancelScheduledMemberUpdate) (*MsgCancelScheduledMemberUpdateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1851,6 +2121,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleMemberUpdate(ctx context.Context, req *MsgScheduleMemberUpdate) (*MsgScheduleMemberUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMemberUpdate not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMemberUpdate(ctx context.Context, req *MsgCancelScheduledMemberUpdate) (*MsgCancelScheduledMemberUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMemberUpdate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2108,6 +2384,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMemberUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMemberUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMemberUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/ScheduleMemberUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMemberUpdate(ctx, req.(*MsgScheduleMemberUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMemberUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMemberUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMemberUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/CancelScheduledMemberUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMemberUpdate(ctx, req.(*MsgCancelScheduledMemberUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2168,6 +2480,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "ScheduleMemberUpdate",
+			Handler:    _Msg_ScheduleMemberUpdate_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMemberUpdate",
+			Handler:    _Msg_CancelScheduledMemberUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3220,61 +3540,223 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleMemberUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleMemberUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMemberUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Expiry != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Activation, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Activation):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
+func (m *MsgScheduleMemberUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleMemberUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMemberUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UpdateIds) > 0 {
+		dAtA8 := make([]byte, len(m.UpdateIds)*10)
+		var j7 int
+		for _, num := range m.UpdateIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMemberUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMemberUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMemberUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMemberUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMemberUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMemberUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GroupId != 0 {
 		n += 1 + sovTx(uint64(m.GroupId))
@@ -3697,6 +4179,71 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleMemberUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = m.Member.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Activation)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleMemberUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpdateIds) > 0 {
+		l = 0
+		for _, e := range m.UpdateIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMemberUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateId != 0 {
+		n += 1 + sovTx(uint64(m.UpdateId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMemberUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6676,6 +7223,486 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleMemberUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMemberUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMemberUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Activation, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMemberUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMemberUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UpdateIds = append(m.UpdateIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UpdateIds) == 0 {
+					m.UpdateIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UpdateIds = append(m.UpdateIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMemberUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMemberUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMemberUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
+			}
+			m.UpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMemberUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMemberUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -447,6 +447,30 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (u ScheduledMemberUpdate) PrimaryKeyFields() []interface{} {
+	return []interface{}{u.Id}
+}
+
+// ValidateBasic does basic validation on scheduled member update.
+func (u ScheduledMemberUpdate) ValidateBasic() error {
+	if u.Id == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "scheduled member update id")
+	}
+	if u.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "scheduled member update's group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Member.Address); err != nil {
+		return errorsmod.Wrap(err, "scheduled member update's member address")
+	}
+	if _, err := math.NewNonNegativeDecFromString(u.Member.Weight); err != nil {
+		return errorsmod.Wrap(err, "weight must be non negative")
+	}
+	if u.Time.IsZero() {
+		return errorsmod.Wrap(errors.ErrEmpty, "scheduled member update time")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
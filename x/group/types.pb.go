@@ -901,6 +901,81 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// ScheduledMemberUpdate is an update of a group member scheduled by the group admin. It is applied at the end of the
+// first block at or after its time, as if by a MsgUpdateGroupMembers: the member joins the group or its weight changes
+// if its weight is positive, and it leaves the group if its weight is zero.
+type ScheduledMemberUpdate struct {
+	// id is the unique ID of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// member is the member update to apply.
+	Member MemberRequest `protobuf:"bytes,3,opt,name=member,proto3" json:"member"`
+	// time is the time at which the update is applied.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ScheduledMemberUpdate) Reset()         { *m = ScheduledMemberUpdate{} }
+func (m *ScheduledMemberUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMemberUpdate) ProtoMessage()    {}
+func (*ScheduledMemberUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
+}
+func (m *ScheduledMemberUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMemberUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMemberUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMemberUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMemberUpdate.Merge(m, src)
+}
+func (m *ScheduledMemberUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMemberUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMemberUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMemberUpdate proto.InternalMessageInfo
+
+func (m *ScheduledMemberUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledMemberUpdate) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *ScheduledMemberUpdate) GetMember() MemberRequest {
+	if m != nil {
+		return m.Member
+	}
+	return MemberRequest{}
+}
+
+func (m *ScheduledMemberUpdate) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -916,97 +991,102 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*ScheduledMemberUpdate)(nil), "cosmos.group.v1.ScheduledMemberUpdate")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1358 bytes of a gzipped FileDescriptorProto
+	// 1410 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x0a, 0xaa, 0xdd, 0xa6, 0x12, 0x48, 0x3d, 0x61, 0x3b, 0x5b, 0xea, 0xa8, 0xb5, 0xad,
-	0xf5, 0x3a, 0xa1, 0xbd, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xaa, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd,
-	0x1f, 0x54, 0x5c, 0xe8, 0x91, 0x0b, 0x52, 0x25, 0x2e, 0x1c, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0xc4, 0x09, 0x50, 0x73, 0x28, 0x27, 0x4e, 0x5c, 0x41, 0x68, 0x67, 0x66,
-	0x1d, 0x7f, 0x24, 0x2e, 0xa9, 0x2a, 0x2e, 0x51, 0x66, 0x9e, 0xe7, 0x7d, 0xe7, 0x7d, 0xde, 0xaf,
-	0x4d, 0x60, 0xb5, 0x45, 0xdc, 0x2e, 0x71, 0x0b, 0x6d, 0x87, 0xf4, 0x7b, 0x85, 0x83, 0x6b, 0x05,
-	0x3a, 0xe8, 0x61, 0x37, 0xdf, 0x73, 0x08, 0x25, 0x28, 0xc5, 0xc1, 0x3c, 0x03, 0xf3, 0x07, 0xd7,
-	0x56, 0x16, 0xdb, 0xa4, 0x4d, 0x18, 0x56, 0xf0, 0x7e, 0xe3, 0xb4, 0x95, 0x4c, 0x9b, 0x90, 0x76,
-	0x07, 0x17, 0xd8, 0x69, 0xaf, 0x7f, 0xbf, 0x60, 0xf6, 0x1d, 0x83, 0x5a, 0xc4, 0x16, 0x78, 0x76,
-	0x12, 0xa7, 0x56, 0x17, 0xbb, 0xd4, 0xe8, 0xf6, 0x04, 0x61, 0x99, 0xbf, 0xa3, 0x73, 0xcf, 0xe2,
-	0x51, 0x01, 0x4d, 0xda, 0x1a, 0xf6, 0x40, 0x40, 0xe7, 0x8c, 0xae, 0x65, 0x93, 0x02, 0xfb, 0xc9,
-	0xaf, 0x72, 0xdf, 0x48, 0x10, 0xb9, 0x83, 0xbb, 0x7b, 0xd8, 0x41, 0x9b, 0x10, 0x35, 0x4c, 0xd3,
-	0xc1, 0xae, 0x2b, 0x4b, 0x6b, 0xd2, 0x7a, 0xbc, 0x24, 0xff, 0xf4, 0xec, 0xca, 0xa2, 0xf0, 0x5d,
-	0xe4, 0x48, 0x83, 0x3a, 0x96, 0xdd, 0x56, 0x7d, 0x22, 0xba, 0x00, 0x91, 0x43, 0x6c, 0xb5, 0xf7,
-	0xa9, 0x1c, 0xf4, 0x4c, 0x54, 0x71, 0x42, 0x2b, 0x10, 0xeb, 0x62, 0x6a, 0x98, 0x06, 0x35, 0xe4,
-	0x10, 0x43, 0x86, 0x67, 0xb4, 0x05, 0x31, 0xc3, 0x34, 0xb1, 0xa9, 0x1b, 0x54, 0x0e, 0xaf, 0x49,
-	0xeb, 0x89, 0xcd, 0x95, 0x3c, 0x8f, 0x39, 0xef, 0xc7, 0x9c, 0xd7, 0x7c, 0xbd, 0xa5, 0x85, 0xe7,
-	0xbf, 0x64, 0x03, 0x8f, 0x7f, 0xcd, 0x4a, 0x5f, 0xbf, 0x7a, 0xba, 0x21, 0xb1, 0x97, 0xb1, 0x59,
-	0xa4, 0xb9, 0x43, 0x58, 0xe0, 0x71, 0xab, 0xf8, 0xd3, 0x3e, 0x76, 0xe9, 0x7f, 0x15, 0x7e, 0xee,
-	0x7b, 0x09, 0x96, 0xb4, 0x7d, 0x07, 0xbb, 0xfb, 0xa4, 0x63, 0x6e, 0xe1, 0x96, 0xe5, 0x5a, 0xc4,
-	0xae, 0x93, 0x8e, 0xd5, 0x1a, 0xa0, 0x8b, 0x10, 0xa7, 0x3e, 0xc4, 0xa3, 0x50, 0x8f, 0x2f, 0xd0,
-	0x47, 0x10, 0x3d, 0xb4, 0x6c, 0x93, 0x1c, 0xba, 0xec, 0xb9, 0xc4, 0xe6, 0xbb, 0xf9, 0x89, 0x76,
-	0xc9, 0x8f, 0xfb, 0xdb, 0xe5, 0x6c, 0xd5, 0x37, 0xbb, 0x51, 0xf9, 0xe1, 0xd9, 0x95, 0xcc, 0x6c,
-	0x9b, 0xcf, 0x5e, 0x3d, 0xdd, 0xc8, 0x71, 0xca, 0x15, 0xd7, 0x7c, 0x50, 0x38, 0x25, 0xd4, 0xdc,
-	0x73, 0x09, 0xe4, 0x3a, 0x76, 0x5a, 0xd8, 0xa6, 0x46, 0x1b, 0x4f, 0xe8, 0xc8, 0x00, 0xf4, 0x86,
-	0x98, 0x10, 0x32, 0x72, 0xf3, 0x16, 0x94, 0x6c, 0xff, 0x3b, 0x25, 0x97, 0x46, 0x94, 0x9c, 0x16,
-	0x6d, 0xee, 0x3b, 0x09, 0xfe, 0x77, 0xe2, 0x73, 0xe8, 0x0e, 0x2c, 0x1c, 0x10, 0x6a, 0xd9, 0x6d,
-	0xbd, 0x87, 0x1d, 0x8b, 0xf0, 0x9a, 0x24, 0x36, 0x97, 0xa7, 0xfa, 0x6d, 0x4b, 0xcc, 0x1f, 0x6f,
-	0xb7, 0x2f, 0x86, 0xed, 0x36, 0xcf, 0xcd, 0xeb, 0xcc, 0x1a, 0xdd, 0x83, 0xc5, 0xae, 0x65, 0xeb,
-	0xf8, 0x21, 0x6e, 0xf5, 0x3d, 0xb6, 0xef, 0x35, 0x78, 0x46, 0xaf, 0xa8, 0x6b, 0xd9, 0x8a, 0xef,
-	0x84, 0xfb, 0xce, 0xfd, 0x21, 0x41, 0xfc, 0x63, 0x2f, 0x11, 0x15, 0xfb, 0x3e, 0x41, 0x49, 0x08,
-	0x5a, 0x3c, 0xda, 0xb0, 0x1a, 0xb4, 0x4c, 0x94, 0x87, 0x39, 0xc3, 0xec, 0x5a, 0x36, 0xef, 0xd3,
-	0x19, 0xad, 0xcd, 0x69, 0x33, 0xe7, 0x4f, 0x86, 0xe8, 0x01, 0x76, 0xbc, 0x64, 0xb1, 0xf1, 0x0b,
-	0xab, 0xfe, 0x11, 0xbd, 0x03, 0xf3, 0x94, 0x50, 0xa3, 0xa3, 0x8b, 0xa1, 0x98, 0x63, 0x96, 0x09,
-	0x76, 0xb7, 0xcb, 0x27, 0xe3, 0x16, 0x40, 0xcb, 0xc1, 0x06, 0xe5, 0xe3, 0x1b, 0x39, 0xeb, 0xf8,
-	0xc6, 0x85, 0x71, 0x91, 0xe6, 0xee, 0x42, 0x82, 0xe9, 0x15, 0xdb, 0x67, 0x19, 0x62, 0xac, 0x0f,
-	0xf4, 0xa1, 0xee, 0x28, 0x3b, 0x57, 0x4c, 0x54, 0x80, 0x48, 0x97, 0x91, 0x44, 0xa2, 0x97, 0xa6,
-	0x9a, 0x4d, 0x6c, 0x02, 0x41, 0xcb, 0xfd, 0x15, 0x84, 0x14, 0xf3, 0xcd, 0xbb, 0x81, 0x65, 0xf4,
-	0x4d, 0xd6, 0xc3, 0x68, 0x4c, 0xc1, 0xf1, 0x98, 0x86, 0x05, 0x09, 0x9d, 0xbd, 0x20, 0xe1, 0xd3,
-	0x0b, 0x32, 0x37, 0x5e, 0x10, 0x03, 0x52, 0xa6, 0x68, 0x6c, 0xbd, 0xc7, 0xb4, 0x88, 0x94, 0x2f,
-	0x4e, 0xa5, 0xbc, 0x68, 0x0f, 0x4a, 0xb9, 0xd7, 0x0f, 0x95, 0x9a, 0x34, 0xc7, 0x47, 0x7d, 0xbc,
-	0xa0, 0xd1, 0x37, 0x2f, 0xe8, 0x8d, 0xd8, 0xa3, 0x27, 0xd9, 0xc0, 0xef, 0x4f, 0xb2, 0x52, 0xee,
-	0xef, 0x39, 0x88, 0xd5, 0x1d, 0xd2, 0x23, 0xae, 0xd1, 0x99, 0x6a, 0xe5, 0x6d, 0x58, 0xe4, 0x49,
-	0xe5, 0x82, 0x74, 0xbf, 0x2a, 0xaf, 0xeb, 0x6c, 0xd4, 0x3e, 0xae, 0xa8, 0x40, 0x66, 0xb6, 0xf9,
-	0x07, 0x10, 0xef, 0xb1, 0x18, 0xb0, 0xe3, 0xca, 0xe1, 0xb5, 0xd0, 0x4c, 0xe7, 0xc7, 0x54, 0xb4,
-	0x0d, 0x09, 0xb7, 0xbf, 0xd7, 0xb5, 0xa8, 0xee, 0x7d, 0x74, 0x59, 0x45, 0xce, 0x94, 0x11, 0xe0,
-	0xd6, 0x1e, 0x8e, 0x2e, 0xc1, 0x02, 0xd7, 0xea, 0xd7, 0x37, 0xc2, 0xd2, 0x30, 0xcf, 0x2e, 0x77,
-	0x44, 0x91, 0xaf, 0x4e, 0x24, 0xc4, 0xe7, 0x46, 0x19, 0x77, 0x54, 0xb6, 0x6f, 0xf1, 0x21, 0x44,
-	0x5c, 0x6a, 0xd0, 0xbe, 0x2b, 0xc7, 0xd6, 0xa4, 0xf5, 0xe4, 0x66, 0x76, 0x6a, 0x20, 0xfc, 0xec,
-	0x37, 0x18, 0x4d, 0x15, 0x74, 0xd4, 0x04, 0x74, 0xdf, 0xb2, 0x8d, 0x8e, 0x4e, 0x8d, 0x4e, 0x67,
-	0xa0, 0x3b, 0xd8, 0xed, 0x77, 0xa8, 0x1c, 0x67, 0x12, 0x2f, 0x4e, 0x39, 0xd1, 0x3c, 0x92, 0xca,
-	0x38, 0xa5, 0xb8, 0x27, 0x92, 0x0b, 0x4c, 0x33, 0x17, 0x23, 0x20, 0x6a, 0xc2, 0xb9, 0xb1, 0x35,
-	0xab, 0x63, 0xdb, 0x94, 0xe1, 0xac, 0x89, 0x4b, 0x8d, 0xee, 0x5a, 0xc5, 0x36, 0x51, 0x1d, 0x52,
-	0x7c, 0xd5, 0x12, 0xc7, 0x0f, 0x35, 0xc1, 0xf4, 0xbe, 0x77, 0xaa, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x12, 0x8f, 0x9d, 0xd1, 0x55, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x63, 0x57, 0x9e, 0x5f, 0x0b,
-	0x9d, 0x36, 0x48, 0xea, 0x90, 0x85, 0x16, 0x61, 0x8e, 0x5a, 0xb4, 0x83, 0xe5, 0x05, 0xd6, 0x5e,
-	0xfc, 0xe0, 0x4d, 0xac, 0xdb, 0xef, 0x76, 0x0d, 0x67, 0x20, 0x27, 0xd9, 0xbd, 0x7f, 0xbc, 0x11,
-	0xf6, 0x86, 0x20, 0xf7, 0xa5, 0x04, 0x89, 0xd1, 0x04, 0xad, 0x42, 0x7c, 0x80, 0x5d, 0xbd, 0x45,
-	0xfa, 0x36, 0x15, 0x9f, 0xd3, 0xd8, 0x00, 0xbb, 0x65, 0xef, 0xec, 0x35, 0x89, 0xb1, 0xe7, 0x52,
-	0xc3, 0xb2, 0x05, 0x81, 0xff, 0x2d, 0x32, 0x2f, 0x2e, 0x39, 0x69, 0x19, 0x62, 0x36, 0x11, 0x38,
-	0xef, 0xf4, 0xa8, 0x4d, 0x38, 0xf4, 0x3e, 0x20, 0x9b, 0xe8, 0x87, 0x16, 0xdd, 0xd7, 0x0f, 0x30,
-	0xf5, 0x49, 0x7c, 0xc9, 0xa4, 0x6c, 0xb2, 0x6b, 0xd1, 0xfd, 0x1d, 0x4c, 0x39, 0x59, 0xc4, 0xf7,
-	0xa7, 0x04, 0xe1, 0x1d, 0x42, 0x31, 0xca, 0x42, 0xa2, 0x27, 0x52, 0x77, 0xbc, 0x78, 0xc1, 0xbf,
-	0xe2, 0x7b, 0xee, 0x80, 0x50, 0xb1, 0x7a, 0x67, 0xee, 0x39, 0x46, 0x43, 0xd7, 0x21, 0x42, 0x7a,
-	0xde, 0x67, 0x8d, 0x45, 0x99, 0xdc, 0x5c, 0x9d, 0x2a, 0x95, 0xf7, 0x6e, 0x8d, 0x51, 0x54, 0x41,
-	0x9d, 0xb9, 0x1c, 0xdf, 0xe2, 0x38, 0x6e, 0x7c, 0x2e, 0x01, 0x1c, 0x3f, 0x8f, 0x56, 0x61, 0x69,
-	0xa7, 0xa6, 0x29, 0x7a, 0xad, 0xae, 0x55, 0x6a, 0x55, 0xbd, 0x59, 0x6d, 0xd4, 0x95, 0x72, 0xe5,
-	0x66, 0x45, 0xd9, 0x4a, 0x07, 0xd0, 0x79, 0x48, 0x8d, 0x82, 0x77, 0x95, 0x46, 0x5a, 0x42, 0x4b,
-	0x70, 0x7e, 0xf4, 0xb2, 0x58, 0x6a, 0x68, 0xc5, 0x4a, 0x35, 0x1d, 0x44, 0x08, 0x92, 0xa3, 0x40,
-	0xb5, 0x96, 0x0e, 0xa1, 0x8b, 0x20, 0x8f, 0xdf, 0xe9, 0xbb, 0x15, 0xed, 0x96, 0xbe, 0xa3, 0x68,
-	0xb5, 0x74, 0x78, 0x25, 0xfc, 0xe8, 0xab, 0x4c, 0x60, 0xe3, 0x47, 0x09, 0x92, 0xe3, 0xb3, 0x8a,
-	0xb2, 0xb0, 0x5a, 0x57, 0x6b, 0xf5, 0x5a, 0xa3, 0x78, 0x5b, 0x6f, 0x68, 0x45, 0xad, 0xd9, 0x98,
-	0x88, 0xec, 0xff, 0xb0, 0x3c, 0x49, 0x68, 0x34, 0x4b, 0x77, 0x2a, 0x9a, 0xa6, 0x6c, 0xa5, 0x25,
-	0xef, 0xd9, 0x49, 0xb8, 0x58, 0x2e, 0x2b, 0x75, 0x0f, 0x0d, 0x9e, 0x84, 0xaa, 0xca, 0xb6, 0x52,
-	0xf6, 0xd0, 0x90, 0x97, 0x91, 0x29, 0xdb, 0x52, 0x4d, 0xf5, 0xc0, 0xf0, 0x49, 0xef, 0x7a, 0x82,
-	0xb6, 0xd4, 0xe2, 0x6e, 0x35, 0x3d, 0x27, 0x04, 0x7d, 0x2b, 0xc1, 0x85, 0x93, 0x87, 0x11, 0xad,
-	0xc3, 0xe5, 0xa1, 0xbd, 0xf2, 0x89, 0x52, 0x6e, 0x6a, 0x35, 0x55, 0x57, 0x95, 0x46, 0xf3, 0xb6,
-	0x36, 0xa1, 0xf0, 0x32, 0xac, 0x9d, 0xca, 0xac, 0xd6, 0x34, 0x5d, 0x6d, 0x56, 0xd3, 0xd2, 0x4c,
-	0x56, 0xa3, 0x59, 0x2e, 0x2b, 0x8d, 0x46, 0x3a, 0x38, 0x93, 0x75, 0xb3, 0x58, 0xb9, 0xdd, 0x54,
-	0x95, 0x74, 0x88, 0x07, 0x5f, 0xca, 0x3f, 0x7f, 0x99, 0x91, 0x5e, 0xbc, 0xcc, 0x48, 0xbf, 0xbd,
-	0xcc, 0x48, 0x8f, 0x8f, 0x32, 0x81, 0x17, 0x47, 0x99, 0xc0, 0xcf, 0x47, 0x99, 0xc0, 0x3d, 0xd1,
-	0xf3, 0xae, 0xf9, 0x20, 0x6f, 0x91, 0xc2, 0x43, 0xfe, 0xcf, 0xdf, 0x5e, 0x84, 0xb5, 0xdf, 0xf5,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x5f, 0xf0, 0x46, 0x13, 0x0e, 0x00, 0x00,
+	0x1b, 0xce, 0xda, 0x8e, 0x63, 0xbf, 0x4e, 0x1c, 0x77, 0x9a, 0x36, 0x9b, 0xa4, 0x3f, 0x3b, 0x3f,
+	0xb7, 0x82, 0x28, 0xa8, 0x76, 0x9b, 0x4a, 0x20, 0x55, 0x42, 0xc2, 0x76, 0xb6, 0xd4, 0x51, 0x6b,
+	0x5b, 0xbb, 0xeb, 0x84, 0xf6, 0xb2, 0xda, 0x78, 0xa7, 0xce, 0xaa, 0xf6, 0x8e, 0xd9, 0x1d, 0x27,
+	0xf5, 0x7f, 0x50, 0x71, 0xa1, 0x47, 0x2e, 0x48, 0x95, 0xb8, 0x70, 0xec, 0xa1, 0xe2, 0xc0, 0x11,
+	0x71, 0xa8, 0x38, 0xa0, 0x8a, 0x13, 0x27, 0x40, 0xed, 0xa1, 0x9c, 0x38, 0x71, 0x05, 0xa1, 0x9d,
+	0x99, 0x75, 0xfc, 0x11, 0xbb, 0xa4, 0xaa, 0xb8, 0x44, 0x99, 0x79, 0x9e, 0x77, 0xe6, 0x7d, 0xde,
+	0xaf, 0x59, 0xc3, 0x5a, 0x83, 0x78, 0x6d, 0xe2, 0xe5, 0x9b, 0x2e, 0xe9, 0x76, 0xf2, 0x87, 0x57,
+	0xf3, 0xb4, 0xd7, 0xc1, 0x5e, 0xae, 0xe3, 0x12, 0x4a, 0xd0, 0x22, 0x07, 0x73, 0x0c, 0xcc, 0x1d,
+	0x5e, 0x5d, 0x5d, 0x6a, 0x92, 0x26, 0x61, 0x58, 0xde, 0xff, 0x8f, 0xd3, 0x56, 0xd3, 0x4d, 0x42,
+	0x9a, 0x2d, 0x9c, 0x67, 0xab, 0xfd, 0xee, 0xbd, 0xbc, 0xd5, 0x75, 0x4d, 0x6a, 0x13, 0x47, 0xe0,
+	0x99, 0x51, 0x9c, 0xda, 0x6d, 0xec, 0x51, 0xb3, 0xdd, 0x11, 0x84, 0x15, 0x7e, 0x8f, 0xc1, 0x4f,
+	0x16, 0x97, 0x0a, 0x68, 0xd4, 0xd6, 0x74, 0x7a, 0x02, 0x3a, 0x63, 0xb6, 0x6d, 0x87, 0xe4, 0xd9,
+	0x5f, 0xbe, 0x95, 0xfd, 0x46, 0x82, 0xe8, 0x6d, 0xdc, 0xde, 0xc7, 0x2e, 0xda, 0x82, 0x39, 0xd3,
+	0xb2, 0x5c, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0xc4, 0x8b, 0xf2, 0x4f, 0x4f, 0x2f, 0x2f, 0x89,
+	0xb3, 0x0b, 0x1c, 0xd1, 0xa8, 0x6b, 0x3b, 0x4d, 0x35, 0x20, 0xa2, 0xf3, 0x10, 0x3d, 0xc2, 0x76,
+	0xf3, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x2b, 0xb4, 0x0a, 0xb1, 0x36, 0xa6, 0xa6, 0x65, 0x52,
+	0x53, 0x0e, 0x33, 0xa4, 0xbf, 0x46, 0xdb, 0x10, 0x33, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xc8,
+	0xba, 0xb4, 0x91, 0xd8, 0x5a, 0xcd, 0x71, 0x9f, 0x73, 0x81, 0xcf, 0x39, 0x3d, 0xd0, 0x5b, 0x5c,
+	0x78, 0xf6, 0x4b, 0x66, 0xe6, 0xd1, 0xaf, 0x19, 0xe9, 0xeb, 0x57, 0x4f, 0x36, 0x25, 0x76, 0x33,
+	0xb6, 0x0a, 0x34, 0x7b, 0x04, 0x0b, 0xdc, 0x6f, 0x15, 0x7f, 0xda, 0xc5, 0x1e, 0xfd, 0xaf, 0xdc,
+	0xcf, 0x7e, 0x2f, 0xc1, 0xb2, 0x7e, 0xe0, 0x62, 0xef, 0x80, 0xb4, 0xac, 0x6d, 0xdc, 0xb0, 0x3d,
+	0x9b, 0x38, 0x35, 0xd2, 0xb2, 0x1b, 0x3d, 0x74, 0x01, 0xe2, 0x34, 0x80, 0xb8, 0x17, 0xea, 0xf1,
+	0x06, 0xfa, 0x08, 0xe6, 0x8e, 0x6c, 0xc7, 0x22, 0x47, 0x1e, 0xbb, 0x2e, 0xb1, 0xf5, 0x4e, 0x6e,
+	0xa4, 0x5c, 0x72, 0xc3, 0xe7, 0xed, 0x71, 0xb6, 0x1a, 0x98, 0x5d, 0x2f, 0xff, 0xf0, 0xf4, 0x72,
+	0x7a, 0xba, 0xcd, 0x67, 0xaf, 0x9e, 0x6c, 0x66, 0x39, 0xe5, 0xb2, 0x67, 0xdd, 0xcf, 0x4f, 0x70,
+	0x35, 0xfb, 0x4c, 0x02, 0xb9, 0x86, 0xdd, 0x06, 0x76, 0xa8, 0xd9, 0xc4, 0x23, 0x3a, 0xd2, 0x00,
+	0x9d, 0x3e, 0x26, 0x84, 0x0c, 0xec, 0xbc, 0x05, 0x25, 0x3b, 0xff, 0x4e, 0xc9, 0xc5, 0x01, 0x25,
+	0x93, 0xbc, 0xcd, 0x7e, 0x27, 0xc1, 0xb9, 0x13, 0xaf, 0x43, 0xb7, 0x61, 0xe1, 0x90, 0x50, 0xdb,
+	0x69, 0x1a, 0x1d, 0xec, 0xda, 0x84, 0xe7, 0x24, 0xb1, 0xb5, 0x32, 0x56, 0x6f, 0xdb, 0xa2, 0xff,
+	0x78, 0xb9, 0x7d, 0xd1, 0x2f, 0xb7, 0x79, 0x6e, 0x5e, 0x63, 0xd6, 0xe8, 0x2e, 0x2c, 0xb5, 0x6d,
+	0xc7, 0xc0, 0x0f, 0x70, 0xa3, 0xeb, 0xb3, 0x83, 0x53, 0x43, 0xa7, 0x3c, 0x15, 0xb5, 0x6d, 0x47,
+	0x09, 0x0e, 0xe1, 0x67, 0x67, 0xff, 0x90, 0x20, 0xfe, 0xb1, 0x1f, 0x88, 0xb2, 0x73, 0x8f, 0xa0,
+	0x24, 0x84, 0x6c, 0xee, 0x6d, 0x44, 0x0d, 0xd9, 0x16, 0xca, 0xc1, 0xac, 0x69, 0xb5, 0x6d, 0x47,
+	0x0e, 0xbd, 0xa6, 0xb4, 0x39, 0x6d, 0x6a, 0xff, 0xc9, 0x30, 0x77, 0x88, 0x5d, 0x3f, 0x58, 0xac,
+	0xfd, 0x22, 0x6a, 0xb0, 0x44, 0xff, 0x87, 0x79, 0x4a, 0xa8, 0xd9, 0x32, 0x44, 0x53, 0xcc, 0x32,
+	0xcb, 0x04, 0xdb, 0xdb, 0xe3, 0x9d, 0x71, 0x13, 0xa0, 0xe1, 0x62, 0x93, 0xf2, 0xf6, 0x8d, 0x9e,
+	0xb6, 0x7d, 0xe3, 0xc2, 0xb8, 0x40, 0xb3, 0x77, 0x20, 0xc1, 0xf4, 0x8a, 0xe9, 0xb3, 0x02, 0x31,
+	0x56, 0x07, 0x46, 0x5f, 0xf7, 0x1c, 0x5b, 0x97, 0x2d, 0x94, 0x87, 0x68, 0x9b, 0x91, 0x44, 0xa0,
+	0x97, 0xc7, 0x8a, 0x4d, 0x4c, 0x02, 0x41, 0xcb, 0xfe, 0x15, 0x82, 0x45, 0x76, 0x36, 0xaf, 0x06,
+	0x16, 0xd1, 0x37, 0x19, 0x0f, 0x83, 0x3e, 0x85, 0x86, 0x7d, 0xea, 0x27, 0x24, 0x7c, 0xfa, 0x84,
+	0x44, 0x26, 0x27, 0x64, 0x76, 0x38, 0x21, 0x26, 0x2c, 0x5a, 0xa2, 0xb0, 0x8d, 0x0e, 0xd3, 0x22,
+	0x42, 0xbe, 0x34, 0x16, 0xf2, 0x82, 0xd3, 0x2b, 0x66, 0x5f, 0xdf, 0x54, 0x6a, 0xd2, 0x1a, 0x6e,
+	0xf5, 0xe1, 0x84, 0xce, 0xbd, 0x79, 0x42, 0xaf, 0xc7, 0x1e, 0x3e, 0xce, 0xcc, 0xfc, 0xfe, 0x38,
+	0x23, 0x65, 0xff, 0x9e, 0x85, 0x58, 0xcd, 0x25, 0x1d, 0xe2, 0x99, 0xad, 0xb1, 0x52, 0xde, 0x81,
+	0x25, 0x1e, 0x54, 0x2e, 0xc8, 0x08, 0xb2, 0xf2, 0xba, 0xca, 0x46, 0xcd, 0xe3, 0x8c, 0x0a, 0x64,
+	0x6a, 0x99, 0xbf, 0x0f, 0xf1, 0x0e, 0xf3, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0x4f, 0x3d, 0xfc,
+	0x98, 0x8a, 0x76, 0x20, 0xe1, 0x75, 0xf7, 0xdb, 0x36, 0x35, 0xfc, 0x47, 0x57, 0x9e, 0x3d, 0x6d,
+	0x44, 0x80, 0x5b, 0xfb, 0x38, 0xba, 0x08, 0x0b, 0x5c, 0x6b, 0x90, 0xdf, 0x28, 0x0b, 0xc3, 0x3c,
+	0xdb, 0xdc, 0x15, 0x49, 0xbe, 0x32, 0x12, 0x90, 0x80, 0x3b, 0xc7, 0xb8, 0x83, 0xb2, 0x03, 0x8b,
+	0x0f, 0x20, 0xea, 0x51, 0x93, 0x76, 0x3d, 0x39, 0xb6, 0x2e, 0x6d, 0x24, 0xb7, 0x32, 0x63, 0x0d,
+	0x11, 0x44, 0x5f, 0x63, 0x34, 0x55, 0xd0, 0x51, 0x1d, 0xd0, 0x3d, 0xdb, 0x31, 0x5b, 0x06, 0x35,
+	0x5b, 0xad, 0x9e, 0xe1, 0x62, 0xaf, 0xdb, 0xa2, 0x72, 0x9c, 0x49, 0xbc, 0x30, 0x76, 0x88, 0xee,
+	0x93, 0x54, 0xc6, 0x29, 0xc6, 0x7d, 0x91, 0x5c, 0x60, 0x8a, 0x1d, 0x31, 0x00, 0xa2, 0x3a, 0x9c,
+	0x19, 0x1a, 0xb3, 0x06, 0x76, 0x2c, 0x19, 0x4e, 0x1b, 0xb8, 0xc5, 0xc1, 0x59, 0xab, 0x38, 0x16,
+	0xaa, 0xc1, 0x22, 0x1f, 0xb5, 0xc4, 0x0d, 0x5c, 0x4d, 0x30, 0xbd, 0xef, 0x4e, 0xd4, 0xab, 0x08,
+	0x3e, 0x77, 0x4c, 0x4d, 0xe2, 0xa1, 0x35, 0xba, 0xe2, 0xd7, 0x8b, 0xe7, 0x99, 0x4d, 0xec, 0xc9,
+	0xf3, 0xeb, 0xe1, 0x49, 0x8d, 0xa4, 0xf6, 0x59, 0x68, 0x09, 0x66, 0xa9, 0x4d, 0x5b, 0x58, 0x5e,
+	0x60, 0xe5, 0xc5, 0x17, 0x7e, 0xc7, 0x7a, 0xdd, 0x76, 0xdb, 0x74, 0x7b, 0x72, 0x92, 0xed, 0x07,
+	0xcb, 0xeb, 0x11, 0xbf, 0x09, 0xb2, 0x5f, 0x4a, 0x90, 0x18, 0x0c, 0xd0, 0x1a, 0xc4, 0x7b, 0xd8,
+	0x33, 0x1a, 0xa4, 0xeb, 0x50, 0xf1, 0x9c, 0xc6, 0x7a, 0xd8, 0x2b, 0xf9, 0x6b, 0xbf, 0x48, 0xcc,
+	0x7d, 0x8f, 0x9a, 0xb6, 0x23, 0x08, 0xfc, 0x5b, 0x64, 0x5e, 0x6c, 0x72, 0xd2, 0x0a, 0xc4, 0x1c,
+	0x22, 0x70, 0x5e, 0xe9, 0x73, 0x0e, 0xe1, 0xd0, 0x7b, 0x80, 0x1c, 0x62, 0x1c, 0xd9, 0xf4, 0xc0,
+	0x38, 0xc4, 0x34, 0x20, 0xf1, 0x21, 0xb3, 0xe8, 0x90, 0x3d, 0x9b, 0x1e, 0xec, 0x62, 0xca, 0xc9,
+	0xc2, 0xbf, 0x3f, 0x25, 0x88, 0xec, 0x12, 0x8a, 0x51, 0x06, 0x12, 0x1d, 0x11, 0xba, 0xe3, 0xc1,
+	0x0b, 0xc1, 0x16, 0x9f, 0x73, 0x87, 0x84, 0x8a, 0xd1, 0x3b, 0x75, 0xce, 0x31, 0x1a, 0xba, 0x06,
+	0x51, 0xd2, 0xf1, 0x9f, 0x35, 0xe6, 0x65, 0x72, 0x6b, 0x6d, 0x2c, 0x55, 0xfe, 0xbd, 0x55, 0x46,
+	0x51, 0x05, 0x75, 0xea, 0x70, 0x7c, 0x8b, 0xed, 0xe8, 0x7f, 0xba, 0x9d, 0xd3, 0x1a, 0x07, 0xd8,
+	0xea, 0xb6, 0xb0, 0xc5, 0xdf, 0x8c, 0x7a, 0xc7, 0x32, 0x29, 0x1e, 0x1b, 0x52, 0x53, 0x26, 0x7f,
+	0xa1, 0xff, 0x1a, 0x85, 0x99, 0x2f, 0xe9, 0x49, 0xaf, 0x11, 0xff, 0x2e, 0x1d, 0xec, 0x1c, 0x61,
+	0x88, 0x3e, 0x84, 0x08, 0x13, 0x73, 0xea, 0xaf, 0x5f, 0x66, 0xb6, 0xf9, 0xb9, 0x04, 0x70, 0x1c,
+	0x45, 0xb4, 0x06, 0xcb, 0xbb, 0x55, 0x5d, 0x31, 0xaa, 0x35, 0xbd, 0x5c, 0xad, 0x18, 0xf5, 0x8a,
+	0x56, 0x53, 0x4a, 0xe5, 0x1b, 0x65, 0x65, 0x3b, 0x35, 0x83, 0xce, 0xc2, 0xe2, 0x20, 0x78, 0x47,
+	0xd1, 0x52, 0x12, 0x5a, 0x86, 0xb3, 0x83, 0x9b, 0x85, 0xa2, 0xa6, 0x17, 0xca, 0x95, 0x54, 0x08,
+	0x21, 0x48, 0x0e, 0x02, 0x95, 0x6a, 0x2a, 0x8c, 0x2e, 0x80, 0x3c, 0xbc, 0x67, 0xec, 0x95, 0xf5,
+	0x9b, 0xc6, 0xae, 0xa2, 0x57, 0x53, 0x91, 0xd5, 0xc8, 0xc3, 0xaf, 0xd2, 0x33, 0x9b, 0x3f, 0x4a,
+	0x90, 0x1c, 0x1e, 0x39, 0x28, 0x03, 0x6b, 0x35, 0xb5, 0x5a, 0xab, 0x6a, 0x85, 0x5b, 0x86, 0xa6,
+	0x17, 0xf4, 0xba, 0x36, 0xe2, 0xd9, 0xff, 0x60, 0x65, 0x94, 0xa0, 0xd5, 0x8b, 0xb7, 0xcb, 0xba,
+	0xae, 0x6c, 0xa7, 0x24, 0xff, 0xda, 0x51, 0xb8, 0x50, 0x2a, 0x29, 0x35, 0x1f, 0x0d, 0x9d, 0x84,
+	0xaa, 0xca, 0x8e, 0x52, 0xf2, 0xd1, 0xb0, 0x1f, 0x91, 0x31, 0xdb, 0x62, 0x55, 0xf5, 0xc1, 0xc8,
+	0x49, 0xf7, 0xfa, 0x82, 0xb6, 0xd5, 0xc2, 0x5e, 0x25, 0x35, 0x2b, 0x04, 0x7d, 0x2b, 0xc1, 0xf9,
+	0x93, 0x67, 0x0a, 0xda, 0x80, 0x4b, 0x7d, 0x7b, 0xe5, 0x13, 0xa5, 0x54, 0xd7, 0xab, 0xaa, 0xa1,
+	0x2a, 0x5a, 0xfd, 0x96, 0x3e, 0xa2, 0xf0, 0x12, 0xac, 0x4f, 0x64, 0x56, 0xaa, 0xba, 0xa1, 0xd6,
+	0x2b, 0x29, 0x69, 0x2a, 0x4b, 0xab, 0x97, 0x4a, 0x8a, 0xa6, 0xa5, 0x42, 0x53, 0x59, 0x37, 0x0a,
+	0xe5, 0x5b, 0x75, 0x55, 0x49, 0x85, 0xb9, 0xf3, 0xc5, 0xdc, 0xb3, 0x17, 0x69, 0xe9, 0xf9, 0x8b,
+	0xb4, 0xf4, 0xdb, 0x8b, 0xb4, 0xf4, 0xe8, 0x65, 0x7a, 0xe6, 0xf9, 0xcb, 0xf4, 0xcc, 0xcf, 0x2f,
+	0xd3, 0x33, 0x77, 0x45, 0xeb, 0x7a, 0xd6, 0xfd, 0x9c, 0x4d, 0xf2, 0x0f, 0xf8, 0x6f, 0xd8, 0xfd,
+	0x28, 0x2b, 0xbc, 0x6b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x80, 0x62, 0xba, 0x32, 0xda, 0x0e,
+	0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1677,6 +1757,57 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledMemberUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMemberUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMemberUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1965,6 +2096,25 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledMemberUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = m.Member.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4024,6 +4174,160 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledMemberUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMemberUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMemberUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,73 @@
+package nameservice
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	nameservicev1 "cosmossdk.io/api/cosmos/nameservice/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: nameservicev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current nameservice parameters",
+				},
+				{
+					RpcMethod:      "Record",
+					Use:            "record [name]",
+					Short:          "Query the registration of a name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "Resolve",
+					Use:            "resolve [name]",
+					Short:          "Query the address a name resolves to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "ReverseLookup",
+					Use:            "reverse-lookup [address]",
+					Short:          "Query the primary name of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: nameservicev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterName",
+					Use:            "register [name] --from [owner]",
+					Short:          "Register a name, paying the registration fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "RenewName",
+					Use:            "renew [name] --from [owner]",
+					Short:          "Renew a name for one registration period, paying the registration fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "TransferName",
+					Use:            "transfer [name] [new-owner] --from [owner]",
+					Short:          "Transfer a name to another owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "SetPrimaryName",
+					Use:            "set-primary-name [name] --from [owner]",
+					Short:          "Set the name returned by the reverse lookups of the owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nameservice/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the nameservice module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, record := range data.Records {
+		owner, err := k.addressCodec.StringToBytes(record.Owner)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Owner.Set(ctx, record.Name, owner); err != nil {
+			return err
+		}
+
+		if err := k.Expiry.Set(ctx, record.Name, record.Expiry.Unix()); err != nil {
+			return err
+		}
+	}
+
+	for _, primary := range data.PrimaryNames {
+		addr, err := k.addressCodec.StringToBytes(primary.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PrimaryName.Set(ctx, addr, primary.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the nameservice module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.Record
+	err = k.Owner.Walk(ctx, nil, func(name string, _ []byte) (bool, error) {
+		record, err := k.GetRecord(ctx, name)
+		if err != nil {
+			return true, err
+		}
+
+		records = append(records, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var primaryNames []types.PrimaryName
+	err = k.PrimaryName.Walk(ctx, nil, func(addr sdk.AccAddress, name string) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		primaryNames = append(primaryNames, types.PrimaryName{Address: addrStr, Name: name})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:       params,
+		Records:      records,
+		PrimaryNames: primaryNames,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/nameservice/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns an implementation of the nameservice QueryServer
+// interface for the provided Keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Params queries the nameservice params.
+func (q queryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Record queries the registration of a name.
+func (q queryServer) Record(ctx context.Context, req *types.QueryRecordRequest) (*types.QueryRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.k.GetRecord(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecordResponse{Record: record}, nil
+}
+
+// Resolve queries the address a name resolves to.
+func (q queryServer) Resolve(ctx context.Context, req *types.QueryResolveRequest) (*types.QueryResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.Resolve(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	addrStr, err := q.k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResolveResponse{Address: addrStr}, nil
+}
+
+// ReverseLookup queries the primary name of an address.
+func (q queryServer) ReverseLookup(ctx context.Context, req *types.QueryReverseLookupRequest) (*types.QueryReverseLookupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	name, err := q.k.ReverseLookup(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReverseLookupResponse{Name: name}, nil
+}
@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nameservice/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper manages a registry of human-readable names resolving to addresses.
+// Names are registered for a period against a fee, can be renewed and
+// transferred by their owner, and can be set as the primary name of their
+// owner for reverse lookups.
+type Keeper struct {
+	addressCodec address.Codec
+	bankKeeper   types.BankKeeper
+
+	// feeCollector is the module account receiving registration fees
+	feeCollector string
+	authority    string
+
+	Schema collections.Schema
+	// Owner key: name, value: owner address
+	Owner collections.Map[string, []byte]
+	// Expiry key: name, value: expiry time in unix seconds
+	Expiry collections.Map[string, int64]
+	// PrimaryName key: owner address, value: name used for reverse lookups
+	PrimaryName collections.Map[sdk.AccAddress, string]
+	// Params contains the registration fee and period
+	Params collections.Item[types.Params]
+}
+
+// NewKeeper returns a name service keeper charging registration fees to
+// feeCollector, whose params are updated by authority.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, addressCodec address.Codec, bk types.BankKeeper, feeCollector, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		addressCodec: addressCodec,
+		bankKeeper:   bk,
+		feeCollector: feeCollector,
+		authority:    authority,
+		Owner:        collections.NewMap(sb, types.OwnerKey, "owner", collections.StringKey, collections.BytesValue),
+		Expiry:       collections.NewMap(sb, types.ExpiryKey, "expiry", collections.StringKey, collections.Int64Value),
+		PrimaryName:  collections.NewMap(sb, types.PrimaryNameKey, "primary_name", sdk.AccAddressKey, collections.StringValue),
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/nameservice module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the nameservice params, or the default params if they
+// weren't set.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return params, err
+}
+
+// GetRecord returns the registration of name, expired or not.
+func (k Keeper) GetRecord(ctx context.Context, name string) (types.Record, error) {
+	owner, expiry, err := k.getRegistration(ctx, name)
+	if err != nil {
+		return types.Record{}, err
+	}
+
+	ownerStr, err := k.addressCodec.BytesToString(owner)
+	if err != nil {
+		return types.Record{}, err
+	}
+
+	return types.Record{Name: name, Owner: ownerStr, Expiry: expiry}, nil
+}
+
+// Resolve returns the address name resolves to. Expired names don't resolve.
+func (k Keeper) Resolve(ctx context.Context, name string) (sdk.AccAddress, error) {
+	owner, expiry, err := k.getRegistration(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if isExpired(ctx, expiry) {
+		return nil, errorsmod.Wrap(types.ErrNameExpired, name)
+	}
+
+	return owner, nil
+}
+
+// ReverseLookup returns the primary name of addr. It fails if addr has no
+// primary name, or if its primary name expired or no longer resolves to addr.
+func (k Keeper) ReverseLookup(ctx context.Context, addr sdk.AccAddress) (string, error) {
+	name, err := k.PrimaryName.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", errorsmod.Wrapf(types.ErrNameNotFound, "no primary name for %s", addr)
+	} else if err != nil {
+		return "", err
+	}
+
+	owner, err := k.Resolve(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	if !owner.Equals(addr) {
+		return "", errorsmod.Wrapf(types.ErrNameNotFound, "no primary name for %s", addr)
+	}
+
+	return name, nil
+}
+
+// Register registers name to owner for one registration period, charging the
+// registration fee. Names never registered or expired can be registered by
+// anyone.
+func (k Keeper) Register(ctx context.Context, owner sdk.AccAddress, name string) error {
+	if err := types.ValidateName(name); err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	prevOwner, expiry, err := k.getRegistration(ctx, name)
+	switch {
+	case err == nil:
+		if !isExpired(ctx, expiry) {
+			return errorsmod.Wrap(types.ErrNameTaken, name)
+		}
+
+		if err := k.clearPrimaryName(ctx, prevOwner, name); err != nil {
+			return err
+		}
+	case !errors.Is(err, types.ErrNameNotFound):
+		return err
+	}
+
+	fee, expiry, err := k.chargeRegistration(ctx, owner, now)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Owner.Set(ctx, name, owner); err != nil {
+		return err
+	}
+
+	if err := k.Expiry.Set(ctx, name, expiry.Unix()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterName,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.Format(time.RFC3339)),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+
+	return nil
+}
+
+// Renew extends the registration of name by one registration period, charging
+// the registration fee. Only the owner of the name can renew it, including
+// after it expired as long as nobody else registered it.
+func (k Keeper) Renew(ctx context.Context, owner sdk.AccAddress, name string) error {
+	expiry, err := k.getOwnedRegistration(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	// renewing before expiry extends the current registration
+	start := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if expiry.After(start) {
+		start = expiry
+	}
+
+	fee, expiry, err := k.chargeRegistration(ctx, owner, start)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Expiry.Set(ctx, name, expiry.Unix()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRenewName,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiry, expiry.Format(time.RFC3339)),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+
+	return nil
+}
+
+// Transfer transfers name from its owner to newOwner, keeping its expiry. If
+// name was the primary name of owner, owner no longer has a primary name.
+func (k Keeper) Transfer(ctx context.Context, owner sdk.AccAddress, name string, newOwner sdk.AccAddress) error {
+	expiry, err := k.getOwnedRegistration(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if isExpired(ctx, expiry) {
+		return errorsmod.Wrap(types.ErrNameExpired, name)
+	}
+
+	if err := k.clearPrimaryName(ctx, owner, name); err != nil {
+		return err
+	}
+
+	if err := k.Owner.Set(ctx, name, newOwner); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferName,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyPreviousOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyOwner, newOwner.String()),
+	))
+
+	return nil
+}
+
+// SetPrimaryName sets name as the name returned by reverse lookups of owner,
+// or removes the primary name of owner if name is empty. Owner must own name.
+func (k Keeper) SetPrimaryName(ctx context.Context, owner sdk.AccAddress, name string) error {
+	if name == "" {
+		return k.PrimaryName.Remove(ctx, owner)
+	}
+
+	expiry, err := k.getOwnedRegistration(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if isExpired(ctx, expiry) {
+		return errorsmod.Wrap(types.ErrNameExpired, name)
+	}
+
+	if err := k.PrimaryName.Set(ctx, owner, name); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetPrimaryName,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+	))
+
+	return nil
+}
+
+// getRegistration returns the owner and expiry of name, expired or not.
+func (k Keeper) getRegistration(ctx context.Context, name string) (sdk.AccAddress, time.Time, error) {
+	owner, err := k.Owner.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, time.Time{}, errorsmod.Wrap(types.ErrNameNotFound, name)
+	} else if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	expiry, err := k.Expiry.Get(ctx, name)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return owner, time.Unix(expiry, 0).UTC(), nil
+}
+
+// getOwnedRegistration returns the expiry of name, which must be owned by
+// owner.
+func (k Keeper) getOwnedRegistration(ctx context.Context, owner sdk.AccAddress, name string) (time.Time, error) {
+	registered, expiry, err := k.getRegistration(ctx, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !registered.Equals(owner) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrNotNameOwner, "%s does not own %s", owner, name)
+	}
+
+	return expiry, nil
+}
+
+// chargeRegistration charges the registration fee to payer and returns it,
+// along with the expiry of a registration period starting at start.
+func (k Keeper) chargeRegistration(ctx context.Context, payer sdk.AccAddress, start time.Time) (sdk.Coins, time.Time, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	fee := params.RegistrationFee
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, k.feeCollector, fee); err != nil {
+			return nil, time.Time{}, errorsmod.Wrap(err, "failed to pay registration fee")
+		}
+	}
+
+	return fee, start.Add(params.RegistrationPeriod), nil
+}
+
+func (k Keeper) clearPrimaryName(ctx context.Context, owner sdk.AccAddress, name string) error {
+	primary, err := k.PrimaryName.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if primary != name {
+		return nil
+	}
+
+	return k.PrimaryName.Remove(ctx, owner)
+}
+
+// isExpired returns true if a registration expiring at expiry is expired at the
+// block time.
+func isExpired(ctx context.Context, expiry time.Time) bool {
+	return !sdk.UnwrapSDKContext(ctx).BlockTime().Before(expiry)
+}
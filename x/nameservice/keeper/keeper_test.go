@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nameservice"
+	"cosmossdk.io/x/nameservice/keeper"
+	"cosmossdk.io/x/nameservice/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// bankKeeper records the registration fees paid.
+type bankKeeper struct {
+	paid map[string]sdk.Coins
+}
+
+func (b bankKeeper) SendCoinsFromAccountToModule(_ context.Context, sender sdk.AccAddress, _ string, amt sdk.Coins) error {
+	b.paid[sender.String()] = b.paid[sender.String()].Add(amt...)
+	return nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, bankKeeper) {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(nameservice.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000_000, 0)})
+
+	bk := bankKeeper{paid: map[string]sdk.Coins{}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), addresscodec.NewBech32Codec("cosmos"), bk, "fee_collector", "authority")
+
+	return ctx, k, bk
+}
+
+func TestNameService(t *testing.T) {
+	ctx, k, bk := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	params := types.Params{RegistrationFee: fee, RegistrationPeriod: time.Hour}
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "other", Params: params})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "authority", Params: types.Params{RegistrationFee: fee}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "authority", Params: params})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, k.Register(ctx, alice, "Alice"), types.ErrInvalidName)
+	require.NoError(t, k.Register(ctx, alice, "alice"))
+	require.Equal(t, fee, bk.paid[alice.String()])
+	require.ErrorIs(t, k.Register(ctx, bob, "alice"), types.ErrNameTaken)
+
+	addr, err := k.Resolve(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, alice, addr)
+
+	_, err = k.ReverseLookup(ctx, alice)
+	require.ErrorIs(t, err, types.ErrNameNotFound)
+	require.ErrorIs(t, k.SetPrimaryName(ctx, bob, "alice"), types.ErrNotNameOwner)
+	require.NoError(t, k.SetPrimaryName(ctx, alice, "alice"))
+	name, err := k.ReverseLookup(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, "alice", name)
+
+	// renewing before expiry extends the registration
+	require.NoError(t, k.Renew(ctx, alice, "alice"))
+	record, err := k.GetRecord(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour).UTC(), record.Expiry)
+
+	// transferring the name removes the primary name of the previous owner
+	require.NoError(t, k.Transfer(ctx, alice, "alice", bob))
+	_, err = k.ReverseLookup(ctx, alice)
+	require.ErrorIs(t, err, types.ErrNameNotFound)
+	addr, err = k.Resolve(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, bob, addr)
+
+	// expired names don't resolve and can be registered by anyone
+	ctx = ctx.WithHeaderInfo(header.Info{Time: record.Expiry})
+	_, err = k.Resolve(ctx, "alice")
+	require.ErrorIs(t, err, types.ErrNameExpired)
+	require.ErrorIs(t, k.Transfer(ctx, bob, "alice", alice), types.ErrNameExpired)
+	require.NoError(t, k.Register(ctx, alice, "alice"))
+	require.Equal(t, fee.Add(fee...).Add(fee...), bk.paid[alice.String()])
+
+	addr, err = k.Resolve(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, alice, addr)
+}
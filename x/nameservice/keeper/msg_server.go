@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nameservice/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nameservice MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterName registers a name to its signer.
+func (k msgServer) RegisterName(ctx context.Context, msg *types.MsgRegisterName) (*types.MsgRegisterNameResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := k.Register(ctx, owner, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterNameResponse{}, nil
+}
+
+// RenewName renews a name owned by its signer.
+func (k msgServer) RenewName(ctx context.Context, msg *types.MsgRenewName) (*types.MsgRenewNameResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := k.Renew(ctx, owner, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewNameResponse{}, nil
+}
+
+// TransferName transfers a name owned by its signer.
+func (k msgServer) TransferName(ctx context.Context, msg *types.MsgTransferName) (*types.MsgTransferNameResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	newOwner, err := k.addressCodec.StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if err := k.Transfer(ctx, owner, msg.Name, newOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNameResponse{}, nil
+}
+
+// SetPrimaryName sets the primary name of the signer.
+func (k msgServer) SetPrimaryName(ctx context.Context, msg *types.MsgSetPrimaryName) (*types.MsgSetPrimaryNameResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := k.Keeper.SetPrimaryName(ctx, owner, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPrimaryNameResponse{}, nil
+}
+
+// UpdateParams updates the nameservice params. Only the module authority can
+// update them.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/nameservice/keeper"
+	"cosmossdk.io/x/nameservice/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgServerAndQueries(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+
+	alice, bob := sdk.AccAddress("alice").String(), sdk.AccAddress("bob").String()
+
+	_, err := srv.RegisterName(ctx, &types.MsgRegisterName{Owner: "invalid", Name: "alice"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.RegisterName(ctx, &types.MsgRegisterName{Owner: alice, Name: "alice"})
+	require.NoError(t, err)
+	_, err = srv.SetPrimaryName(ctx, &types.MsgSetPrimaryName{Owner: alice, Name: "alice"})
+	require.NoError(t, err)
+
+	resolved, err := qs.Resolve(ctx, &types.QueryResolveRequest{Name: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, alice, resolved.Address)
+	lookup, err := qs.ReverseLookup(ctx, &types.QueryReverseLookupRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, "alice", lookup.Name)
+
+	_, err = srv.TransferName(ctx, &types.MsgTransferName{Owner: bob, Name: "alice", NewOwner: bob})
+	require.ErrorIs(t, err, types.ErrNotNameOwner)
+	_, err = srv.TransferName(ctx, &types.MsgTransferName{Owner: alice, Name: "alice", NewOwner: bob})
+	require.NoError(t, err)
+
+	record, err := qs.Record(ctx, &types.QueryRecordRequest{Name: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, bob, record.Record.Owner)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultRegistrationPeriod).UTC(), record.Record.Expiry)
+
+	// the registrations are exported and imported
+	_, err = srv.SetPrimaryName(ctx, &types.MsgSetPrimaryName{Owner: bob, Name: "alice"})
+	require.NoError(t, err)
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, gs.Validate())
+	require.Equal(t, []types.Record{record.Record}, gs.Records)
+	require.Equal(t, []types.PrimaryName{{Address: bob, Name: "alice"}}, gs.PrimaryNames)
+
+	ctx2, k2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, gs))
+	gs2, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, gs, gs2)
+}
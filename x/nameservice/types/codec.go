@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/nameservice interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterName{}, "cosmos-sdk/MsgRegisterName")
+	legacy.RegisterAminoMsg(cdc, &MsgRenewName{}, "cosmos-sdk/MsgRenewName")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferName{}, "cosmos-sdk/MsgTransferName")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPrimaryName{}, "cosmos-sdk/MsgSetPrimaryName")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/nameservice/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterName{},
+		&MsgRenewName{},
+		&MsgTransferName{},
+		&MsgSetPrimaryName{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/nameservice module sentinel errors
+var (
+	ErrInvalidName  = errors.Register(ModuleName, 2, "invalid name")
+	ErrNameTaken    = errors.Register(ModuleName, 3, "name already registered")
+	ErrNameNotFound = errors.Register(ModuleName, 4, "name not found")
+	ErrNameExpired  = errors.Register(ModuleName, 5, "name registration expired")
+	ErrNotNameOwner = errors.Register(ModuleName, 6, "not the owner of the name")
+)
@@ -0,0 +1,14 @@
+package types
+
+// x/nameservice module event types and attributes
+const (
+	EventTypeRegisterName     = "register_name"
+	EventTypeRenewName        = "renew_name"
+	EventTypeTransferName     = "transfer_name"
+	EventTypeSetPrimaryName   = "set_primary_name"
+	AttributeKeyName          = "name"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyPreviousOwner = "previous_owner"
+	AttributeKeyExpiry        = "expiry"
+	AttributeKeyFee           = "fee"
+)
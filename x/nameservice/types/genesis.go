@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState returns the default genesis state of the nameservice
+// module, without any registered name.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation: names must be valid and
+// registered once, and primary names must be registered to their address.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	owners := make(map[string]string, len(gs.Records))
+	for _, record := range gs.Records {
+		if err := ValidateName(record.Name); err != nil {
+			return err
+		}
+
+		if _, ok := owners[record.Name]; ok {
+			return fmt.Errorf("duplicate record for name %s", record.Name)
+		}
+
+		if record.Owner == "" {
+			return fmt.Errorf("record for name %s has no owner", record.Name)
+		}
+
+		owners[record.Name] = record.Owner
+	}
+
+	addresses := make(map[string]struct{}, len(gs.PrimaryNames))
+	for _, primary := range gs.PrimaryNames {
+		if _, ok := addresses[primary.Address]; ok {
+			return fmt.Errorf("duplicate primary name for address %s", primary.Address)
+		}
+
+		if owners[primary.Name] != primary.Address {
+			return fmt.Errorf("primary name %s of address %s is not registered to it", primary.Name, primary.Address)
+		}
+
+		addresses[primary.Address] = struct{}{}
+	}
+
+	return nil
+}
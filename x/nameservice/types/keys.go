@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/nameservice module.
+	ModuleName = "nameservice"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	OwnerKey       = collections.NewPrefix(0)
+	ExpiryKey      = collections.NewPrefix(1)
+	PrimaryNameKey = collections.NewPrefix(2)
+	ParamsKey      = collections.NewPrefix(3)
+)
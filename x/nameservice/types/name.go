@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MinNameLength and MaxNameLength bound the length of a name.
+	MinNameLength = 3
+	MaxNameLength = 63
+
+	// DefaultRegistrationPeriod is the default duration of a registration or
+	// renewal.
+	DefaultRegistrationPeriod = 365 * 24 * time.Hour
+)
+
+// IsExpired returns true if the registration of the name is expired at t.
+func (r Record) IsExpired(t time.Time) bool {
+	return !t.Before(r.Expiry)
+}
+
+// ValidateName checks that name can be registered: it must be between
+// MinNameLength and MaxNameLength characters long, made of lowercase letters,
+// digits and hyphens, and cannot start or end with a hyphen. Names are
+// case-sensitive, restricting them to lowercase avoids look-alike names.
+func ValidateName(name string) error {
+	if len(name) < MinNameLength || len(name) > MaxNameLength {
+		return ErrInvalidName.Wrapf("name must be between %d and %d characters long, got %d", MinNameLength, MaxNameLength, len(name))
+	}
+
+	if name[0] == '-' || name[len(name)-1] == '-' {
+		return ErrInvalidName.Wrapf("name %q cannot start or end with a hyphen", name)
+	}
+
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return ErrInvalidName.Wrapf("name %q contains invalid character %q", name, c)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRegistrationPeriod checks that period can be used as registration period.
+func ValidateRegistrationPeriod(period time.Duration) error {
+	if period < time.Second {
+		return fmt.Errorf("registration period must be at least one second, got %s", period)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+// DefaultParams returns the default nameservice params: registrations are free
+// and last DefaultRegistrationPeriod.
+func DefaultParams() Params {
+	return Params{
+		RegistrationPeriod: DefaultRegistrationPeriod,
+	}
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := p.RegistrationFee.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateRegistrationPeriod(p.RegistrationPeriod)
+}
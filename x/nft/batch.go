@@ -0,0 +1,28 @@
+package nft
+
+const (
+	// DefaultMaxBatchSize is the default maximum number of nfts of a batch.
+	DefaultMaxBatchSize = 100
+
+	// EventTypeBatchMint is the type of the event coalescing the mints of a
+	// batch.
+	EventTypeBatchMint = "nft_batch_mint"
+	// EventTypeBatchBurn is the type of the event coalescing the burns of a
+	// batch.
+	EventTypeBatchBurn = "nft_batch_burn"
+	// EventTypeBatchSend is the type of the event coalescing the transfers of a
+	// batch.
+	EventTypeBatchSend = "nft_batch_send"
+
+	AttributeKeyOwner     = "owner"
+	AttributeKeySender    = "sender"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeyNFTs      = "nfts"
+	AttributeKeySucceeded = "succeeded"
+	AttributeKeyFailed    = "failed"
+)
+
+// Succeeded reports whether the operation succeeded for the nft.
+func (r *BatchResult) Succeeded() bool {
+	return r.Error == ""
+}
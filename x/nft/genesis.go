@@ -11,6 +11,11 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			return ErrEmptyClassID
 		}
 	}
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return err
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
@@ -26,5 +31,6 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 
 // DefaultGenesisState - Returns a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	params := DefaultParams()
+	return &GenesisState{Params: &params}
 }
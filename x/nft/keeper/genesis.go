@@ -10,6 +10,11 @@ import (
 // InitGenesis initializes the nft module's genesis state from a given
 // genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) {
+	if data.Params != nil {
+		if err := k.SetParams(ctx, *data.Params); err != nil {
+			panic(err)
+		}
+	}
 	for _, class := range data.Classes {
 		if err := k.SaveClass(ctx, *class); err != nil {
 			panic(err)
@@ -62,8 +67,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) *nft.GenesisState {
 			Nfts:  nftMap[owner],
 		})
 	}
+	params := k.GetParams(ctx)
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Params:  &params,
 	}
 }
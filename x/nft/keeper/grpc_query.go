@@ -265,3 +265,13 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// Params return the parameters of the nft module
+func (k Keeper) Params(goCtx context.Context, r *nft.QueryParamsRequest) (*nft.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	params := k.GetParams(goCtx)
+	return &nft.QueryParamsResponse{Params: &params}, nil
+}
@@ -14,11 +14,15 @@ type Keeper struct {
 	storeService store.KVStoreService
 	bk           nft.BankKeeper
 	ac           address.Codec
+
+	// the address capable of executing a MsgUpdateParams or MsgBatchMint
+	// message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(storeService store.KVStoreService,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
@@ -30,5 +34,11 @@ func NewKeeper(storeService store.KVStoreService,
 		storeService: storeService,
 		bk:           bk,
 		ac:           ak.AddressCodec(),
+		authority:    authority,
 	}
 }
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/nft/module"
@@ -43,6 +44,7 @@ type TestSuite struct {
 	encodedAddrs  []string
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
+	msgServer     nft.MsgServer
 	accountKeeper *nfttestutil.MockAccountKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
@@ -73,11 +75,12 @@ func (s *TestSuite) SetupTest() {
 
 	s.accountKeeper = accountKeeper
 
-	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper, authtypes.NewModuleAddress("gov").String())
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -352,12 +355,14 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	params := nft.DefaultParams()
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Params: &params,
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -377,14 +382,17 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expParams := nft.Params{MaxBatchSize: 10}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Params: &expParams,
 	}
 	s.nftKeeper.InitGenesis(s.ctx, expGenesis)
+	s.Require().Equal(expParams, s.nftKeeper.GetParams(s.ctx))
 
 	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
 	s.Require().True(has)
@@ -11,10 +11,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -54,3 +64,127 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	}
 	return &nft.MsgSendResponse{}, nil
 }
+
+// BatchMint implements BatchMint method of the types.MsgServer.
+func (k msgServer) BatchMint(goCtx context.Context, msg *nft.MsgBatchMint) (*nft.MsgBatchMintResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	tokens := make([]nft.NFT, len(msg.Nfts))
+	for i, token := range msg.Nfts {
+		if token == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nft %d is empty", i)
+		}
+		tokens[i] = *token
+	}
+
+	results, err := k.BatchMintWithResults(goCtx, tokens, receiver)
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgBatchMintResponse{Results: results}, nil
+}
+
+// BatchBurn implements BatchBurn method of the types.MsgServer. The nfts which
+// are not owned by the owner of the message are reported as failed.
+func (k msgServer) BatchBurn(goCtx context.Context, msg *nft.MsgBatchBurn) (*nft.MsgBatchBurnResponse, error) {
+	owner, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	results, err := k.runBatch(goCtx, msg.Items, func(ctx context.Context, i int) error {
+		item := msg.Items[i]
+		if err := k.checkBatchItem(ctx, item, owner, msg.Owner); err != nil {
+			return err
+		}
+
+		return k.Burn(ctx, item.ClassId, item.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(batchEvent(
+		nft.EventTypeBatchBurn, results,
+		sdk.NewAttribute(nft.AttributeKeyOwner, msg.Owner),
+	))
+	return &nft.MsgBatchBurnResponse{Results: results}, nil
+}
+
+// BatchSend implements BatchSend method of the types.MsgServer. Each nft is
+// checked like the nft of a MsgSend, and the nfts failing the checks are
+// reported as failed.
+func (k msgServer) BatchSend(goCtx context.Context, msg *nft.MsgBatchSend) (*nft.MsgBatchSendResponse, error) {
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	results, err := k.runBatch(goCtx, msg.Items, func(ctx context.Context, i int) error {
+		item := msg.Items[i]
+		if err := k.checkBatchItem(ctx, item, sender, msg.Sender); err != nil {
+			return err
+		}
+
+		return k.Transfer(ctx, item.ClassId, item.Id, receiver)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(batchEvent(
+		nft.EventTypeBatchSend, results,
+		sdk.NewAttribute(nft.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(nft.AttributeKeyReceiver, msg.Receiver),
+	))
+	return &nft.MsgBatchSendResponse{Results: results}, nil
+}
+
+// UpdateParams implements UpdateParams method of the types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *nft.MsgUpdateParams) (*nft.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.Params == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "params cannot be nil")
+	}
+
+	if err := k.SetParams(goCtx, *msg.Params); err != nil {
+		return nil, err
+	}
+	return &nft.MsgUpdateParamsResponse{}, nil
+}
+
+// checkBatchItem checks that the nft of a batch item is identified and owned
+// by owner.
+func (k msgServer) checkBatchItem(ctx context.Context, item *nft.BatchItem, owner sdk.AccAddress, ownerStr string) error {
+	if item == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty batch item")
+	}
+
+	if len(item.ClassId) == 0 {
+		return nft.ErrEmptyClassID
+	}
+
+	if len(item.Id) == 0 {
+		return nft.ErrEmptyNFTID
+	}
+
+	if !bytes.Equal(k.GetOwner(ctx, item.ClassId, item.Id), owner) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", ownerStr, item.Id)
+	}
+	return nil
+}
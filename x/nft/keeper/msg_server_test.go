@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"cosmossdk.io/x/nft"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -34,12 +36,14 @@ func (s *TestSuite) TestSend() {
 	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	params := nft.DefaultParams()
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&ExpClass},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&ExpNFT},
 		}},
+		Params: &params,
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -120,7 +124,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -130,3 +134,97 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestBatchMsgs() {
+	ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.nftKeeper.SaveClass(ctx, nft.Class{Id: "classID1"}))
+	authority := s.nftKeeper.GetAuthority()
+
+	// only the authority mints
+	_, err := s.msgServer.BatchMint(ctx, &nft.MsgBatchMint{
+		Authority: s.encodedAddrs[0],
+		Receiver:  s.encodedAddrs[0],
+		Nfts:      []*nft.NFT{{ClassId: "classID1", Id: "nftID1"}},
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	mintRes, err := s.msgServer.BatchMint(ctx, &nft.MsgBatchMint{
+		Authority: authority,
+		Receiver:  s.encodedAddrs[0],
+		Nfts: []*nft.NFT{
+			{ClassId: "classID1", Id: "nftID1"},
+			{ClassId: "classID1", Id: "nftID2"},
+			{ClassId: "classID1", Id: "nftID3"},
+			{ClassId: "classID2", Id: "nftID1"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(mintRes.Results, 4)
+	s.Require().True(mintRes.Results[2].Succeeded())
+	s.Require().Contains(mintRes.Results[3].Error, nft.ErrClassNotExists.Error())
+	s.Require().NoError(s.nftKeeper.Mint(ctx, nft.NFT{ClassId: "classID1", Id: "nftID4"}, s.addrs[1]))
+
+	// the batches are limited by the params
+	_, err = s.msgServer.UpdateParams(ctx, &nft.MsgUpdateParams{Authority: authority, Params: &nft.Params{MaxBatchSize: 2}})
+	s.Require().NoError(err)
+	_, err = s.msgServer.BatchSend(ctx, &nft.MsgBatchSend{
+		Sender:   s.encodedAddrs[0],
+		Receiver: s.encodedAddrs[2],
+		Items: []*nft.BatchItem{
+			{ClassId: "classID1", Id: "nftID1"},
+			{ClassId: "classID1", Id: "nftID2"},
+			{ClassId: "classID1", Id: "nftID3"},
+		},
+	})
+	s.Require().ErrorIs(err, nft.ErrBatchTooLarge)
+	_, err = s.msgServer.UpdateParams(ctx, &nft.MsgUpdateParams{Authority: authority, Params: &nft.Params{MaxBatchSize: 3}})
+	s.Require().NoError(err)
+
+	// only the nfts owned by the sender are sent
+	sendRes, err := s.msgServer.BatchSend(ctx, &nft.MsgBatchSend{
+		Sender:   s.encodedAddrs[0],
+		Receiver: s.encodedAddrs[2],
+		Items: []*nft.BatchItem{
+			{ClassId: "classID1", Id: "nftID1"},
+			{ClassId: "classID1", Id: "nftID4"},
+			{ClassId: "classID1", Id: ""},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().True(sendRes.Results[0].Succeeded())
+	s.Require().Contains(sendRes.Results[1].Error, "is not the owner")
+	s.Require().Contains(sendRes.Results[2].Error, nft.ErrEmptyNFTID.Error())
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(ctx, "classID1", "nftID1"))
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(ctx, "classID1", "nftID4"))
+
+	// only the nfts owned by the owner are burnt
+	burnRes, err := s.msgServer.BatchBurn(ctx, &nft.MsgBatchBurn{
+		Owner: s.encodedAddrs[0],
+		Items: []*nft.BatchItem{
+			{ClassId: "classID1", Id: "nftID2"},
+			{ClassId: "classID1", Id: "nftID4"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().True(burnRes.Results[0].Succeeded())
+	s.Require().Contains(burnRes.Results[1].Error, "is not the owner")
+	s.Require().False(s.nftKeeper.HasNFT(ctx, "classID1", "nftID2"))
+	s.Require().True(s.nftKeeper.HasNFT(ctx, "classID1", "nftID4"))
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	params := nft.Params{MaxBatchSize: 10}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.encodedAddrs[0], Params: &params})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.nftKeeper.GetAuthority(), Params: &nft.Params{}})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.nftKeeper.GetAuthority(), Params: &params})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &nft.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, *res.Params)
+}
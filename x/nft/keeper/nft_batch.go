@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
@@ -87,3 +89,95 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	}
 	return nil
 }
+
+// BatchMintWithResults mints a batch of nfts to receiver. Unlike BatchMint, the
+// nfts which cannot be minted are skipped and reported in the results, in the
+// order of tokens, and the mints are coalesced into a single event.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) BatchMintWithResults(ctx context.Context, tokens []nft.NFT, receiver sdk.AccAddress) ([]*nft.BatchResult, error) {
+	recStr, err := k.ac.BytesToString(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*nft.BatchItem, len(tokens))
+	for i, token := range tokens {
+		items[i] = &nft.BatchItem{ClassId: token.ClassId, Id: token.Id}
+	}
+
+	results, err := k.runBatch(ctx, items, func(ctx context.Context, i int) error {
+		return k.Mint(ctx, tokens[i], receiver)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		batchEvent(nft.EventTypeBatchMint, results, sdk.NewAttribute(nft.AttributeKeyReceiver, recStr)),
+	)
+	return results, nil
+}
+
+// BatchBurnWithResults burns a batch of nfts. Unlike BatchBurn, the nfts which
+// cannot be burnt are skipped and reported in the results, in the order of
+// items, and the burns are coalesced into a single event.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) BatchBurnWithResults(ctx context.Context, items []*nft.BatchItem) ([]*nft.BatchResult, error) {
+	results, err := k.runBatch(ctx, items, func(ctx context.Context, i int) error {
+		return k.Burn(ctx, items[i].GetClassId(), items[i].GetId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(batchEvent(nft.EventTypeBatchBurn, results))
+	return results, nil
+}
+
+// runBatch runs fn for each item of a batch of at most MaxBatchSize items, and
+// returns the result for each of them. Each item is processed in its own branch
+// of the state, written only if fn succeeds, so that a failed item leaves no
+// partial writes. The events emitted by fn are dropped in favor of the event
+// of the batch.
+func (k Keeper) runBatch(ctx context.Context, items []*nft.BatchItem, fn func(ctx context.Context, i int) error) ([]*nft.BatchResult, error) {
+	if len(items) == 0 {
+		return nil, nft.ErrEmptyBatch
+	}
+
+	if maxBatchSize := k.GetParams(ctx).MaxBatchSize; len(items) > int(maxBatchSize) {
+		return nil, errors.Wrapf(nft.ErrBatchTooLarge, "%d nfts, at most %d", len(items), maxBatchSize)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([]*nft.BatchResult, len(items))
+	for i, item := range items {
+		results[i] = &nft.BatchResult{ClassId: item.GetClassId(), Id: item.GetId()}
+
+		cms := sdkCtx.MultiStore().CacheMultiStore()
+		if err := fn(sdkCtx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager()), i); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		cms.Write()
+	}
+
+	return results, nil
+}
+
+// batchEvent returns the event coalescing the operations of a batch, listing
+// the nfts for which they succeeded.
+func batchEvent(eventType string, results []*nft.BatchResult, attrs ...sdk.Attribute) sdk.Event {
+	nfts := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Succeeded() {
+			nfts = append(nfts, result.ClassId+"/"+result.Id)
+		}
+	}
+
+	attrs = append(attrs,
+		sdk.NewAttribute(nft.AttributeKeyNFTs, strings.Join(nfts, ",")),
+		sdk.NewAttribute(nft.AttributeKeySucceeded, strconv.Itoa(len(nfts))),
+		sdk.NewAttribute(nft.AttributeKeyFailed, strconv.Itoa(len(results)-len(nfts))),
+	)
+	return sdk.NewEvent(eventType, attrs...)
+}
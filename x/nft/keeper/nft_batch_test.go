@@ -348,6 +348,55 @@ func groupByClassID(tokens []nft.NFT) map[string][]nft.NFT {
 	return classMap
 }
 
+func (s *TestSuite) TestBatchWithResults() {
+	ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.nftKeeper.SaveClass(ctx, nft.Class{Id: "classID1"}))
+
+	_, err := s.nftKeeper.BatchMintWithResults(ctx, nil, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrEmptyBatch)
+
+	// the size of batches is limited by the params
+	s.Require().NoError(s.nftKeeper.SetParams(ctx, nft.Params{MaxBatchSize: 1}))
+	_, err = s.nftKeeper.BatchBurnWithResults(ctx, []*nft.BatchItem{
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+	})
+	s.Require().ErrorIs(err, nft.ErrBatchTooLarge)
+	s.Require().NoError(s.nftKeeper.SetParams(ctx, nft.DefaultParams()))
+
+	// failed mints are reported without failing the batch
+	results, err := s.nftKeeper.BatchMintWithResults(ctx, []nft.NFT{
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID2", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+	}, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().Len(results, 4)
+	s.Require().True(results[0].Succeeded())
+	s.Require().Contains(results[1].Error, nft.ErrNFTExists.Error())
+	s.Require().Contains(results[2].Error, nft.ErrClassNotExists.Error())
+	s.Require().True(results[3].Succeeded())
+	s.Require().EqualValues(2, s.nftKeeper.GetTotalSupply(ctx, "classID1"))
+
+	// the mints are coalesced into a single event
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(nft.EventTypeBatchMint, events[0].Type)
+	nfts, ok := events[0].GetAttribute(nft.AttributeKeyNFTs)
+	s.Require().True(ok)
+	s.Require().Equal("classID1/nftID1,classID1/nftID2", nfts.Value)
+
+	results, err = s.nftKeeper.BatchBurnWithResults(ctx, []*nft.BatchItem{
+		{ClassId: "classID1", Id: "nftID2"},
+		{ClassId: "classID1", Id: "nftID4"},
+	})
+	s.Require().NoError(err)
+	s.Require().True(results[0].Succeeded())
+	s.Require().Contains(results[1].Error, nft.ErrNFTNotExists.Error())
+	s.Require().False(s.nftKeeper.HasNFT(ctx, "classID1", "nftID2"))
+}
+
 func (s *TestSuite) saveClass(tokens []nft.NFT) {
 	classMap := groupByClassID(tokens)
 	for classID := range classMap {
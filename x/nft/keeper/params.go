@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+)
+
+// GetParams returns the parameters of the nft module, or the default ones if
+// they have not been set.
+func (k Keeper) GetParams(ctx context.Context) nft.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nft.DefaultParams()
+	}
+
+	var params nft.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the nft module.
+func (k Keeper) SetParams(ctx context.Context, params nft.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.Params failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(ParamsKey, bz)
+}
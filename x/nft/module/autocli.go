@@ -79,6 +79,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the parameters of the nft module.",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +100,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "BatchMint",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
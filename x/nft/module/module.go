@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/errors"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/nft/simulation"
@@ -47,7 +48,7 @@ func (AppModuleBasic) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	nft.RegisterMsgServer(registrar, am.keeper)
+	nft.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(registrar, am.keeper)
 	return nil
 }
@@ -164,6 +165,7 @@ func init() {
 type NftInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	StoreService store.KVStoreService
 	Cdc          codec.Codec
 	Registry     cdctypes.InterfaceRegistry
@@ -180,7 +182,13 @@ type NftOutputs struct {
 }
 
 func ProvideModule(in NftInputs) NftOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress("gov")
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper, authority.String())
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return NftOutputs{NFTKeeper: k, Module: m}
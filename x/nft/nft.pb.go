@@ -205,35 +205,84 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// Params defines the parameters of the nft module.
+type Params struct {
+	// max_batch_size is the maximum number of nfts of a batch message.
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*Params)(nil), "cosmos.nft.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x3b, 0x49, 0x9a, 0x7e, 0xdf, 0xad, 0x16, 0x19, 0x44, 0x52, 0x91, 0x50, 0x8a, 0x8b,
+	0x2e, 0x64, 0x42, 0xf5, 0x09, 0xac, 0x20, 0xba, 0x11, 0xa9, 0xae, 0xdc, 0x84, 0x9b, 0x3f, 0x6d,
+	0x06, 0x9b, 0x4c, 0xc9, 0x24, 0xd2, 0xf6, 0x05, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d,
+	0x4a, 0xfb, 0x22, 0x32, 0xd3, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0x61, 0xe6, 0x77, 0xb8,
+	0x70, 0x12, 0x0a, 0x99, 0x0a, 0xe9, 0x65, 0xa3, 0xc2, 0x7b, 0xe9, 0x07, 0x71, 0x81, 0x7d, 0x35,
+	0xb3, 0x69, 0x2e, 0x0a, 0x41, 0xe9, 0xc6, 0x65, 0x4a, 0xa9, 0xdc, 0xe3, 0xf6, 0x58, 0x88, 0xf1,
+	0x24, 0xf6, 0x74, 0x22, 0x28, 0x47, 0x1e, 0x66, 0xf3, 0x4d, 0xbc, 0xfb, 0x4e, 0xa0, 0x7e, 0x35,
+	0x41, 0x29, 0x69, 0x0b, 0x0c, 0x1e, 0x39, 0xa4, 0x43, 0x7a, 0xff, 0x87, 0x06, 0x8f, 0x28, 0x05,
+	0x2b, 0xc3, 0x34, 0x76, 0x0c, 0xad, 0xe8, 0x99, 0x1e, 0x81, 0x2d, 0xe7, 0x69, 0x20, 0x26, 0x8e,
+	0xa9, 0xd5, 0x6a, 0xa3, 0x1d, 0x68, 0x46, 0xb1, 0x0c, 0x73, 0x3e, 0x2d, 0xb8, 0xc8, 0x1c, 0x4b,
+	0x9b, 0xbf, 0x25, 0x7a, 0x00, 0x66, 0x99, 0x73, 0xa7, 0xae, 0x1d, 0x35, 0xd2, 0x36, 0xfc, 0x2b,
+	0x73, 0xee, 0x27, 0x28, 0x13, 0xc7, 0xd6, 0x72, 0xa3, 0xcc, 0xf9, 0x0d, 0xca, 0x84, 0xf6, 0xc0,
+	0x8a, 0xb0, 0x40, 0xa7, 0xd1, 0x21, 0xbd, 0xe6, 0xf9, 0x21, 0xdb, 0xe0, 0xb3, 0x1f, 0x7c, 0x76,
+	0x99, 0xcd, 0x87, 0x3a, 0xd1, 0x7d, 0x25, 0x60, 0xde, 0x5d, 0x3f, 0xaa, 0xc7, 0x42, 0xd5, 0xc2,
+	0xdf, 0x56, 0x68, 0xe8, 0xfd, 0x36, 0xaa, 0x7a, 0x19, 0xdb, 0x5e, 0x15, 0x89, 0xb9, 0x9b, 0xc4,
+	0xda, 0x4d, 0x02, 0x7f, 0x92, 0x30, 0xb0, 0xef, 0x31, 0xc7, 0x54, 0xd2, 0x53, 0x68, 0xa5, 0x38,
+	0xf3, 0x03, 0x2c, 0xc2, 0xc4, 0x97, 0x7c, 0x11, 0x6b, 0xa2, 0xfd, 0xe1, 0x5e, 0x8a, 0xb3, 0x81,
+	0x12, 0x1f, 0xf8, 0x22, 0x1e, 0x9c, 0x7d, 0xac, 0x5c, 0xb2, 0x5c, 0xb9, 0xe4, 0x6b, 0xe5, 0x92,
+	0xb7, 0xb5, 0x5b, 0x5b, 0xae, 0xdd, 0xda, 0xe7, 0xda, 0xad, 0x3d, 0x55, 0x17, 0x94, 0xd1, 0x33,
+	0xe3, 0xc2, 0x9b, 0xa9, 0xdb, 0x06, 0xb6, 0xfe, 0xf1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x13,
+	0xbd, 0xbf, 0xb1, 0xfc, 0x01, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +425,34 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +530,18 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovNft(uint64(m.MaxBatchSize))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1040,75 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,16 @@
+package nft
+
+import "fmt"
+
+// DefaultParams returns the default parameters of the nft module.
+func DefaultParams() Params {
+	return Params{MaxBatchSize: DefaultMaxBatchSize}
+}
+
+// Validate checks that the parameters are valid.
+func (p Params) Validate() error {
+	if p.MaxBatchSize == 0 {
+		return fmt.Errorf("max batch size must be positive")
+	}
+	return nil
+}
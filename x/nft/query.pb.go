@@ -1243,6 +1243,89 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1268,71 +1351,76 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.nft.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.nft.v1beta1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x41, 0x6f, 0xe3, 0x54,
-	0x10, 0xc7, 0xfb, 0x92, 0xa6, 0x85, 0x59, 0x01, 0xcb, 0x6c, 0xc5, 0x26, 0xde, 0xae, 0x37, 0xeb,
-	0xdd, 0x36, 0xd9, 0x2e, 0x6b, 0x37, 0xc9, 0x02, 0x12, 0xbb, 0x70, 0x28, 0x22, 0xa8, 0x20, 0x15,
-	0x48, 0x7b, 0x42, 0x42, 0xc8, 0x69, 0x9c, 0xc8, 0x22, 0xb5, 0xd3, 0xd8, 0x01, 0xaa, 0xaa, 0x07,
-	0x7a, 0x40, 0x54, 0x5c, 0x90, 0xe8, 0x81, 0x23, 0x07, 0x0e, 0x7c, 0x14, 0x8e, 0x95, 0xb8, 0x70,
-	0x44, 0x2d, 0x5f, 0x80, 0x6f, 0x80, 0xfc, 0x3c, 0x4e, 0x9d, 0xe6, 0xf9, 0xb9, 0x0e, 0x1c, 0x1d,
-	0xcf, 0xbc, 0xff, 0x2f, 0xe3, 0xf9, 0xfb, 0x9f, 0x80, 0xba, 0xeb, 0x7a, 0x7b, 0xae, 0x67, 0x38,
-	0x5d, 0xdf, 0xf8, 0xaa, 0xd6, 0xb6, 0x7c, 0xb3, 0x66, 0xec, 0x8f, 0xac, 0xe1, 0x81, 0x3e, 0x18,
-	0xba, 0xbe, 0x8b, 0x18, 0xde, 0xd7, 0x9d, 0xae, 0xaf, 0xd3, 0x7d, 0x65, 0x8d, 0x7a, 0xda, 0xa6,
-	0x67, 0x85, 0xc5, 0xe3, 0xd6, 0x81, 0xd9, 0xb3, 0x1d, 0xd3, 0xb7, 0x5d, 0x27, 0xec, 0x57, 0x96,
-	0x7b, 0xae, 0xdb, 0xeb, 0x5b, 0x86, 0x39, 0xb0, 0x0d, 0xd3, 0x71, 0x5c, 0x9f, 0xdf, 0xf4, 0xa2,
-	0xbb, 0x02, 0xf5, 0x40, 0x89, 0xdf, 0xd5, 0x9a, 0x70, 0xeb, 0xd3, 0xe0, 0xf4, 0x0d, 0xb3, 0x6f,
-	0x3a, 0xbb, 0x56, 0xcb, 0xda, 0x1f, 0x59, 0x9e, 0x8f, 0x25, 0x78, 0x61, 0xb7, 0x6f, 0x7a, 0xde,
-	0x17, 0x76, 0xa7, 0xc8, 0xca, 0xac, 0xfa, 0x62, 0x6b, 0x91, 0x5f, 0x6f, 0x76, 0x70, 0x09, 0x0a,
-	0xee, 0xd7, 0x8e, 0x35, 0x2c, 0xe6, 0xf8, 0xe7, 0xe1, 0x85, 0xb6, 0x0d, 0xe5, 0xf8, 0x39, 0x1b,
-	0x07, 0xfc, 0x6a, 0xdb, 0x1f, 0xda, 0x4e, 0x6f, 0xe6, 0x43, 0x75, 0x58, 0x9a, 0x84, 0xf3, 0x06,
-	0xae, 0xe3, 0x59, 0xf8, 0x1a, 0x2c, 0x98, 0x7b, 0xee, 0xc8, 0xf1, 0xf9, 0x31, 0xf3, 0x2d, 0xba,
-	0xd2, 0x9e, 0xc1, 0x7d, 0x09, 0x44, 0x4a, 0xf3, 0xbb, 0xf0, 0x2a, 0x2f, 0xff, 0x38, 0x90, 0xbe,
-	0x06, 0xf2, 0xcb, 0x90, 0xb3, 0x3b, 0xc4, 0x9b, 0xb3, 0x3b, 0xda, 0x47, 0xa0, 0x5e, 0xf6, 0x67,
-	0xfd, 0xfe, 0x57, 0x0f, 0x5b, 0x03, 0x8c, 0xc3, 0x10, 0xfa, 0x78, 0x4a, 0x2c, 0x3e, 0xa5, 0xb7,
-	0xe0, 0x5e, 0xa2, 0xb0, 0xb4, 0xd1, 0x20, 0x91, 0xed, 0xd1, 0x60, 0xd0, 0x3f, 0x48, 0xa7, 0xd4,
-	0x9e, 0x93, 0x52, 0xd8, 0x90, 0xf1, 0x3b, 0x6a, 0x4f, 0x68, 0xd5, 0x22, 0xb9, 0x94, 0xe7, 0xf1,
-	0x36, 0x6d, 0x94, 0x50, 0x2c, 0xa5, 0xf7, 0x07, 0x06, 0x37, 0x79, 0xfd, 0x56, 0x73, 0xc7, 0x9b,
-	0x75, 0xfd, 0xb0, 0x09, 0x70, 0xe9, 0xb5, 0x62, 0xbe, 0xcc, 0xaa, 0x37, 0xea, 0xab, 0x3a, 0x99,
-	0x35, 0x30, 0xa6, 0x1e, 0xba, 0x98, 0x5c, 0xa5, 0x7f, 0x62, 0xf6, 0x22, 0x03, 0xb5, 0x62, 0x9d,
-	0xda, 0x09, 0xa3, 0xd5, 0x0a, 0x69, 0x88, 0xfd, 0x31, 0xcc, 0x3b, 0x5d, 0xdf, 0x2b, 0xb2, 0x72,
-	0xbe, 0x7a, 0xa3, 0x7e, 0x5b, 0x9f, 0x7e, 0x09, 0xe8, 0x5b, 0xcd, 0x9d, 0x16, 0x2f, 0xc2, 0x0f,
-	0x26, 0x50, 0x72, 0x1c, 0xa5, 0x92, 0x8a, 0x12, 0x2a, 0x4d, 0xb0, 0x3c, 0x87, 0x57, 0x22, 0x94,
-	0x19, 0xd6, 0x72, 0x13, 0x96, 0xa3, 0xee, 0xff, 0xba, 0xe1, 0xef, 0x5c, 0x3e, 0xa1, 0xf1, 0x48,
-	0x1e, 0x41, 0xde, 0xe9, 0x86, 0xcf, 0x52, 0x32, 0x91, 0xa0, 0x46, 0xfb, 0x10, 0xee, 0x26, 0x90,
-	0x64, 0x3f, 0x4b, 0xa7, 0xc7, 0xf3, 0x5e, 0x80, 0x7a, 0x8d, 0x45, 0x7e, 0x46, 0x4e, 0xe7, 0xf5,
-	0x59, 0x5d, 0xf0, 0x3e, 0x99, 0x8e, 0xc4, 0x88, 0xd6, 0x80, 0x02, 0x2f, 0x20, 0xde, 0x92, 0x88,
-	0x37, 0xec, 0x08, 0xeb, 0xb4, 0x16, 0x59, 0x51, 0xc4, 0x30, 0xeb, 0x99, 0x9f, 0x93, 0x41, 0xf9,
-	0x87, 0xd6, 0x78, 0x12, 0x93, 0x36, 0x60, 0x33, 0xdb, 0xe0, 0x94, 0xd1, 0xeb, 0x7c, 0x7c, 0x3e,
-	0x81, 0x36, 0x20, 0x9c, 0x8e, 0x15, 0x99, 0x41, 0x82, 0x1a, 0x55, 0xfe, 0x6f, 0x8e, 0xa8, 0xff,
-	0xf3, 0x12, 0x14, 0x38, 0x16, 0x9e, 0x32, 0x58, 0xa4, 0xe8, 0xc0, 0x8a, 0x08, 0x41, 0x90, 0x94,
-	0x4a, 0x35, 0xbd, 0x30, 0x14, 0xd5, 0xde, 0x3c, 0xfe, 0xe3, 0xef, 0x9f, 0x72, 0xeb, 0xa8, 0x1b,
-	0x82, 0x44, 0x6e, 0x87, 0xc5, 0xc6, 0x21, 0x7f, 0xf5, 0x1c, 0x19, 0x87, 0xd1, 0xfe, 0x1c, 0xe1,
-	0x6f, 0x0c, 0x96, 0x44, 0x89, 0x86, 0x4f, 0xd3, 0xa4, 0x45, 0xbb, 0xa9, 0xbc, 0x91, 0xb1, 0x8b,
-	0xe8, 0x1f, 0x70, 0xfa, 0xbb, 0x78, 0x47, 0x42, 0x8f, 0x27, 0x0c, 0x0a, 0x3c, 0x86, 0x70, 0x25,
-	0x51, 0x25, 0x9e, 0xaf, 0xca, 0x6a, 0x5a, 0x19, 0xa9, 0xd7, 0xb8, 0xfa, 0x63, 0x7c, 0x24, 0x52,
-	0xe7, 0x23, 0x8b, 0x4d, 0xcc, 0x38, 0x0c, 0xc6, 0xf6, 0x0b, 0x03, 0x9c, 0x8e, 0x44, 0xac, 0xcb,
-	0x15, 0x85, 0x23, 0x6b, 0x64, 0xea, 0x21, 0xe4, 0xfb, 0x1c, 0xf9, 0x0e, 0x96, 0x12, 0x91, 0xf1,
-	0x7b, 0x06, 0x0b, 0x61, 0xbc, 0x61, 0xf2, 0x20, 0x26, 0xd2, 0x59, 0xa9, 0xa4, 0xd6, 0x91, 0xfc,
-	0x13, 0x2e, 0x5f, 0xc1, 0x15, 0x91, 0xbc, 0xc7, 0x6b, 0xe3, 0x4b, 0xf6, 0x2b, 0x83, 0x5b, 0x82,
-	0xa4, 0xc5, 0x46, 0x8a, 0x9e, 0x70, 0x5e, 0x4f, 0xb3, 0x35, 0x11, 0xb1, 0xc6, 0x89, 0x97, 0x51,
-	0x49, 0x26, 0xc6, 0x11, 0xcc, 0x07, 0x21, 0x8a, 0x0f, 0x13, 0x15, 0x62, 0x89, 0xaf, 0xac, 0xa4,
-	0x54, 0x91, 0x70, 0x99, 0x0b, 0x2b, 0x58, 0x34, 0xc4, 0x3f, 0x95, 0x3d, 0x3c, 0x66, 0x90, 0xdf,
-	0x6a, 0xee, 0xe0, 0x03, 0xd9, 0x81, 0x91, 0xea, 0x43, 0x79, 0x11, 0x89, 0xae, 0x73, 0xd1, 0x35,
-	0xac, 0x26, 0x89, 0x4e, 0x2d, 0xf4, 0xcf, 0x0c, 0x6e, 0x5e, 0x8d, 0x3b, 0x5c, 0x97, 0x89, 0x09,
-	0x1f, 0x4e, 0x2d, 0x43, 0x07, 0xb1, 0xde, 0xe3, 0xac, 0x25, 0xbc, 0x9d, 0xc0, 0x8a, 0xdf, 0x31,
-	0x28, 0xf0, 0xf7, 0xb3, 0xc4, 0xf7, 0xf1, 0x74, 0x95, 0xf8, 0x7e, 0x22, 0x17, 0x35, 0x9d, 0x2b,
-	0x57, 0x71, 0x55, 0xa4, 0x4c, 0x51, 0x10, 0x5f, 0xe3, 0xc0, 0xf4, 0xd3, 0x91, 0x28, 0x31, 0x7d,
-	0x62, 0x86, 0x4b, 0x4c, 0x9f, 0x9c, 0xb9, 0x72, 0xd3, 0x73, 0x4c, 0xfc, 0x96, 0xc1, 0x22, 0x25,
-	0xa0, 0x24, 0x65, 0x26, 0x33, 0x58, 0x92, 0x32, 0x57, 0xc2, 0x54, 0xfe, 0x9e, 0xa6, 0x89, 0x6d,
-	0xbc, 0xfe, 0xfb, 0xb9, 0xca, 0xce, 0xce, 0x55, 0xf6, 0xd7, 0xb9, 0xca, 0x7e, 0xbc, 0x50, 0xe7,
-	0xce, 0x2e, 0xd4, 0xb9, 0x3f, 0x2f, 0xd4, 0xb9, 0xcf, 0xe8, 0xbf, 0xa8, 0xd7, 0xf9, 0x52, 0xb7,
-	0x5d, 0xe3, 0x9b, 0xa0, 0xbb, 0xbd, 0xc0, 0xff, 0x2a, 0x36, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0x88, 0xda, 0x47, 0xc8, 0x0e, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdb, 0x64,
+	0x18, 0xee, 0x97, 0x36, 0x29, 0xbc, 0x93, 0xc6, 0xf6, 0x36, 0x62, 0xa9, 0xd7, 0xb9, 0x99, 0xb7,
+	0x26, 0x59, 0xc7, 0xec, 0x26, 0x19, 0x20, 0xb1, 0xc1, 0xa1, 0x88, 0xa0, 0x82, 0x54, 0x46, 0xda,
+	0x13, 0x12, 0x42, 0x4e, 0xe3, 0x44, 0x16, 0xa9, 0x9d, 0xc5, 0x0e, 0x50, 0x55, 0x3d, 0xb0, 0x03,
+	0x62, 0xe2, 0x82, 0xc4, 0x0e, 0x1c, 0x39, 0x70, 0xe0, 0xa7, 0x70, 0x9c, 0xc4, 0x85, 0x23, 0x6a,
+	0xf9, 0x01, 0xfc, 0x04, 0xe4, 0xcf, 0xaf, 0x13, 0xbb, 0xf9, 0x6c, 0xc7, 0x81, 0xa3, 0xfd, 0x3d,
+	0xcf, 0xfb, 0x3c, 0x7e, 0xbf, 0xef, 0xf5, 0x63, 0x83, 0x7c, 0x64, 0x3b, 0xc7, 0xb6, 0xa3, 0x59,
+	0x3d, 0x57, 0xfb, 0xaa, 0xde, 0x31, 0x5c, 0xbd, 0xae, 0x3d, 0x1d, 0x1b, 0xa3, 0x13, 0x75, 0x38,
+	0xb2, 0x5d, 0x1b, 0xd1, 0x5f, 0x57, 0xad, 0x9e, 0xab, 0xd2, 0xba, 0xb4, 0x4d, 0x9c, 0x8e, 0xee,
+	0x18, 0x3e, 0x78, 0x42, 0x1d, 0xea, 0x7d, 0xd3, 0xd2, 0x5d, 0xd3, 0xb6, 0x7c, 0xbe, 0xb4, 0xd1,
+	0xb7, 0xed, 0xfe, 0xc0, 0xd0, 0xf4, 0xa1, 0xa9, 0xe9, 0x96, 0x65, 0xbb, 0x7c, 0xd1, 0x09, 0x56,
+	0x05, 0xea, 0x9e, 0x12, 0x5f, 0x55, 0x5a, 0xb0, 0xf6, 0xa9, 0x57, 0x7d, 0x57, 0x1f, 0xe8, 0xd6,
+	0x91, 0xd1, 0x36, 0x9e, 0x8e, 0x0d, 0xc7, 0xc5, 0x75, 0x78, 0xe5, 0x68, 0xa0, 0x3b, 0xce, 0x17,
+	0x66, 0xb7, 0xc4, 0xca, 0xac, 0xf6, 0x6a, 0x7b, 0x95, 0x5f, 0xef, 0x75, 0xb1, 0x08, 0x79, 0xfb,
+	0x6b, 0xcb, 0x18, 0x95, 0x72, 0xfc, 0xbe, 0x7f, 0xa1, 0x1c, 0x40, 0x39, 0x5c, 0x67, 0xf7, 0x84,
+	0x5f, 0x1d, 0xb8, 0x23, 0xd3, 0xea, 0x2f, 0x5c, 0x54, 0x85, 0x62, 0xd4, 0x9c, 0x33, 0xb4, 0x2d,
+	0xc7, 0xc0, 0xd7, 0xa1, 0xa0, 0x1f, 0xdb, 0x63, 0xcb, 0xe5, 0x65, 0x56, 0xda, 0x74, 0xa5, 0x3c,
+	0x82, 0xdb, 0x09, 0x26, 0x52, 0xc8, 0xef, 0xc1, 0x75, 0x0e, 0xff, 0xc4, 0x93, 0x9e, 0xc3, 0xf2,
+	0x55, 0xc8, 0x99, 0x5d, 0xf2, 0x9b, 0x33, 0xbb, 0xca, 0xc7, 0x20, 0x4f, 0xf9, 0x59, 0x9f, 0xff,
+	0x72, 0xb1, 0x6d, 0xc0, 0xb0, 0x19, 0xb2, 0x3e, 0xe9, 0x12, 0x0b, 0x77, 0xe9, 0x6d, 0xd8, 0x8c,
+	0x15, 0x4e, 0x24, 0x6a, 0x24, 0x72, 0x30, 0x1e, 0x0e, 0x07, 0x27, 0xe9, 0x2e, 0x95, 0xc7, 0xb0,
+	0x19, 0x22, 0x64, 0x7c, 0x46, 0xe5, 0x01, 0xac, 0x85, 0xd8, 0xa9, 0xfb, 0xf1, 0x0e, 0x94, 0x43,
+	0xf0, 0x6c, 0x7b, 0xf9, 0x03, 0x83, 0x6b, 0x1c, 0xbf, 0xdf, 0x3a, 0x74, 0x16, 0x3d, 0x7e, 0xd8,
+	0x02, 0x98, 0xce, 0x5a, 0x69, 0xb9, 0xcc, 0x6a, 0x57, 0x1a, 0x15, 0x95, 0x86, 0xd5, 0x1b, 0x4c,
+	0xd5, 0x9f, 0x62, 0x9a, 0x2a, 0xf5, 0x89, 0xde, 0x0f, 0x06, 0xa8, 0x1d, 0x62, 0x2a, 0xcf, 0x19,
+	0x5c, 0x0f, 0xb9, 0x21, 0xef, 0xf7, 0x61, 0xc5, 0xea, 0xb9, 0x4e, 0x89, 0x95, 0x97, 0x6b, 0x57,
+	0x1a, 0x37, 0xd4, 0xd9, 0x97, 0x80, 0xba, 0xdf, 0x3a, 0x6c, 0x73, 0x10, 0x7e, 0x18, 0xb1, 0x92,
+	0xe3, 0x56, 0xaa, 0xa9, 0x56, 0x7c, 0xa5, 0x88, 0x97, 0xc7, 0xf0, 0x5a, 0x60, 0x65, 0x81, 0x63,
+	0xb9, 0x07, 0x1b, 0x01, 0xfb, 0xbf, 0x9e, 0xf0, 0x77, 0xa7, 0x3b, 0x34, 0x69, 0xc9, 0x3d, 0x58,
+	0xb6, 0x7a, 0xfe, 0x5e, 0x26, 0x74, 0xc4, 0xc3, 0x28, 0x1f, 0xc1, 0xad, 0x18, 0x27, 0xd9, 0x6b,
+	0xa9, 0xb4, 0x3d, 0xef, 0x7b, 0x56, 0xe7, 0x38, 0xc8, 0x8f, 0x40, 0x9e, 0xe2, 0xb3, 0x4e, 0xc1,
+	0x07, 0x80, 0x53, 0xf2, 0xc4, 0xad, 0x06, 0x79, 0x0e, 0x20, 0xbf, 0xeb, 0x22, 0xbf, 0x3e, 0xc3,
+	0xc7, 0x29, 0x6d, 0x1a, 0x45, 0x91, 0x87, 0x45, 0x6b, 0x7e, 0x4e, 0x03, 0xca, 0x6f, 0x1a, 0x93,
+	0x4e, 0x44, 0xc7, 0x80, 0x2d, 0x3c, 0x06, 0x2f, 0x18, 0x14, 0xa3, 0xf5, 0xc9, 0x68, 0x13, 0xfc,
+	0xee, 0x18, 0xc1, 0x30, 0x24, 0x58, 0x0d, 0x90, 0xff, 0xdf, 0x44, 0x14, 0x69, 0x43, 0x9e, 0xe8,
+	0x23, 0xfd, 0x38, 0x78, 0x68, 0x65, 0x0f, 0xd6, 0x22, 0x77, 0xc9, 0x6a, 0x03, 0x0a, 0x43, 0x7e,
+	0x87, 0xfa, 0x20, 0x89, 0x9c, 0x12, 0x87, 0x90, 0x8d, 0x7f, 0xae, 0x42, 0x9e, 0xd7, 0xc2, 0x17,
+	0x0c, 0x56, 0x29, 0x9b, 0xb0, 0x2a, 0x62, 0x0a, 0xa2, 0x58, 0xaa, 0xa5, 0x03, 0x7d, 0x73, 0xca,
+	0x5b, 0xcf, 0xfe, 0xf8, 0xfb, 0xa7, 0xdc, 0x0e, 0xaa, 0x9a, 0x20, 0xf2, 0x3b, 0x3e, 0x58, 0x3b,
+	0xe5, 0xef, 0xb6, 0x33, 0xed, 0x34, 0x38, 0xa0, 0x67, 0xf8, 0x1b, 0x83, 0xa2, 0x28, 0x32, 0xf1,
+	0x61, 0x9a, 0xb4, 0xe8, 0xf0, 0x4b, 0x6f, 0x66, 0x64, 0x91, 0xfb, 0x3b, 0xdc, 0xfd, 0x2d, 0xbc,
+	0x99, 0xe0, 0x1e, 0x9f, 0x33, 0xc8, 0xf3, 0x9c, 0xc3, 0xad, 0x58, 0x95, 0x70, 0x80, 0x4b, 0x95,
+	0x34, 0x18, 0xa9, 0xd7, 0xb9, 0xfa, 0x7d, 0xbc, 0x27, 0x52, 0xe7, 0x2d, 0x0b, 0x75, 0x4c, 0x3b,
+	0xf5, 0xda, 0xf6, 0x0b, 0x03, 0x9c, 0xcd, 0x5c, 0x6c, 0x24, 0x2b, 0x0a, 0x5b, 0xd6, 0xcc, 0xc4,
+	0x21, 0xcb, 0xb7, 0xb9, 0xe5, 0x9b, 0xb8, 0x1e, 0x6b, 0x19, 0xbf, 0x67, 0x50, 0xf0, 0xf3, 0x13,
+	0xe3, 0x1b, 0x11, 0x89, 0x7f, 0xa9, 0x9a, 0x8a, 0x23, 0xf9, 0x07, 0x5c, 0xbe, 0x8a, 0x5b, 0x22,
+	0x79, 0x87, 0x63, 0xc3, 0x87, 0xec, 0x57, 0x06, 0x6b, 0x82, 0x28, 0xc7, 0x66, 0x8a, 0x9e, 0xb0,
+	0x5f, 0x0f, 0xb3, 0x91, 0xc8, 0xb1, 0xc2, 0x1d, 0x6f, 0xa0, 0x14, 0xef, 0x18, 0xc7, 0xb0, 0xe2,
+	0xa5, 0x34, 0xde, 0x8d, 0x55, 0x08, 0x7d, 0x52, 0x48, 0x5b, 0x29, 0x28, 0x12, 0x2e, 0x73, 0x61,
+	0x09, 0x4b, 0x9a, 0xf8, 0x5b, 0xdc, 0xc1, 0x67, 0x0c, 0x96, 0xf7, 0x5b, 0x87, 0x78, 0x27, 0xa9,
+	0x60, 0xa0, 0x7a, 0x37, 0x19, 0x44, 0xa2, 0x3b, 0x5c, 0x74, 0x1b, 0x6b, 0x71, 0xa2, 0x33, 0x07,
+	0xfa, 0x67, 0x06, 0xd7, 0x2e, 0xe7, 0x29, 0xee, 0x24, 0x89, 0x09, 0x37, 0xa7, 0x9e, 0x81, 0x41,
+	0x5e, 0x37, 0xb9, 0xd7, 0x75, 0xbc, 0x11, 0xe3, 0x15, 0xbf, 0x63, 0x90, 0xe7, 0x01, 0x90, 0x30,
+	0xf7, 0xe1, 0xf8, 0x96, 0x2a, 0x69, 0x30, 0x52, 0x56, 0xb9, 0x72, 0x0d, 0x2b, 0x22, 0x65, 0xca,
+	0x9a, 0xf0, 0x31, 0xf6, 0x86, 0x7e, 0x36, 0x73, 0x13, 0x86, 0x3e, 0xf6, 0x23, 0x41, 0x6a, 0x66,
+	0xe2, 0xcc, 0x33, 0xf4, 0xdc, 0x26, 0x7e, 0xcb, 0x60, 0x95, 0x22, 0x36, 0x21, 0x65, 0xa2, 0x21,
+	0x2f, 0xd5, 0xd2, 0x81, 0xf3, 0xbc, 0xa7, 0x83, 0x74, 0x3e, 0x83, 0x82, 0x9f, 0x82, 0x09, 0xef,
+	0x9d, 0x48, 0xe0, 0x4a, 0xd5, 0x54, 0xdc, 0x3c, 0x53, 0xec, 0x47, 0xee, 0xee, 0x1b, 0xbf, 0x9f,
+	0xcb, 0xec, 0xe5, 0xb9, 0xcc, 0xfe, 0x3a, 0x97, 0xd9, 0x8f, 0x17, 0xf2, 0xd2, 0xcb, 0x0b, 0x79,
+	0xe9, 0xcf, 0x0b, 0x79, 0xe9, 0x33, 0xfa, 0xd7, 0x76, 0xba, 0x5f, 0xaa, 0xa6, 0xad, 0x7d, 0xe3,
+	0x91, 0x3b, 0x05, 0xfe, 0x2b, 0xdc, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x3e, 0xc1, 0x0b,
+	0xa8, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1382,6 +1470,8 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Params queries the parameters of the nft module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1500,6 +1590,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -1537,6 +1636,8 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Params queries the parameters of the nft module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1579,6 +1680,9 @@ func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1800,6 +1904,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1852,6 +1974,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -2693,6 +2819,64 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3056,6 +3240,28 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5334,6 +5540,142 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,199 @@
+package oracle
+
+import (
+	"bytes"
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prices flow from validators to the state in three steps:
+//
+//   - at height H-1, each validator extends its precommit vote with the prices
+//     its types.PriceProvider returns for the pairs of the params.
+//   - at height H, the proposer injects the extended commit info of height H-1
+//     at the top of its proposal as a price tx, which the other validators
+//     verify in ProcessProposal.
+//   - the PreBlocker aggregates the price votes of the price tx into median
+//     prices, before any module runs.
+//
+// The price tx is not a valid transaction: its execution fails without effect
+// and it is reported as a failed tx in the block results.
+
+// ExtendVoteHandler returns the handler extending votes with the prices of
+// provider. A validator whose provider fails votes no price, missing the vote.
+func ExtendVoteHandler(k keeper.Keeper, provider types.PriceProvider) sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		prices, err := provider.Prices(ctx)
+		if err != nil {
+			ctx.Logger().Error("failed to fetch oracle prices", "height", req.Height, "err", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		var vote types.PriceVote
+		for _, pair := range params.Pairs {
+			if price, ok := prices[pair]; ok && !price.IsNil() && price.IsPositive() {
+				vote.Prices = append(vote.Prices, types.PairPrice{Pair: pair, Price: price})
+			}
+		}
+
+		if len(vote.Prices) == 0 {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := vote.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler rejecting vote extensions
+// which are not valid price votes.
+func VerifyVoteExtensionHandler(k keeper.Keeper) sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := types.UnmarshalPriceVote(req.VoteExtension, params); err != nil {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// NewPrepareProposalHandler wraps next so that the proposal starts with the
+// price tx carrying the price votes of the previous height. The price tx is
+// only injected when vote extensions are enabled for the previous height.
+func NewPrepareProposalHandler(valStore baseapp.ValidatorStore, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		priceTx, err := encodePriceTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		// leave room for the price tx in the proposal
+		req.MaxTxBytes -= int64(len(priceTx))
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{priceTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// NewProcessProposalHandler wraps next so that proposals are rejected unless
+// they start with a valid price tx when vote extensions are enabled for the
+// previous height. next processes the proposal without the price tx.
+func NewProcessProposalHandler(valStore baseapp.ValidatorStore, next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			return reject, nil
+		}
+
+		extCommit, err := decodePriceTx(req.Txs[0])
+		if err != nil {
+			return reject, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			return reject, nil
+		}
+
+		txs := req.Txs
+		req.Txs = txs[1:]
+		defer func() { req.Txs = txs }()
+
+		return next(ctx, req)
+	}
+}
+
+// NewPreBlocker wraps next so that the prices of the price tx are aggregated
+// before any module runs.
+func NewPreBlocker(k keeper.Keeper, next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if len(req.Txs) > 0 && bytes.HasPrefix(req.Txs[0], types.PriceTxPrefix) {
+			extCommit, err := decodePriceTx(req.Txs[0])
+			if err != nil {
+				return nil, err
+			}
+
+			if err := k.AggregatePrices(ctx, extCommit); err != nil {
+				return nil, err
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// StaticPriceProvider is a types.PriceProvider returning fixed prices, for
+// testing purposes.
+type StaticPriceProvider map[string]math.LegacyDec
+
+// Prices implements types.PriceProvider.
+func (p StaticPriceProvider) Prices(_ context.Context) (map[string]math.LegacyDec, error) {
+	return p, nil
+}
+
+// voteExtensionsEnabled returns whether the votes of the height before height
+// carry vote extensions.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+func encodePriceTx(extCommit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, types.PriceTxPrefix...), bz...), nil
+}
+
+func decodePriceTx(tx []byte) (abci.ExtendedCommitInfo, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !bytes.HasPrefix(tx, types.PriceTxPrefix) {
+		return extCommit, errorsmod.Wrap(types.ErrInvalidPriceTx, "missing price tx prefix")
+	}
+
+	if err := extCommit.Unmarshal(tx[len(types.PriceTxPrefix):]); err != nil {
+		return extCommit, errorsmod.Wrap(types.ErrInvalidPriceTx, err.Error())
+	}
+
+	return extCommit, nil
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// powerPrice is a price voted by a validator with its voting power.
+type powerPrice struct {
+	price math.LegacyDec
+	power int64
+}
+
+// AggregatePrices stores the median price of each pair voted in the price
+// votes of extCommit, weighted by voting power, provided the votes for the pair
+// reach the quorum. Validators which didn't vote, or voted no price, miss the
+// vote. At the end of each miss window, the hooks are called for the validators
+// which missed too many votes and the miss counters are reset.
+func (k Keeper) AggregatePrices(ctx context.Context, extCommit abci.ExtendedCommitInfo) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var totalPower int64
+	votes := make(map[string][]powerPrice, len(params.Pairs))
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		var prices types.PriceVote
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			// invalid votes were rejected by VerifyVoteExtension, and are
+			// counted as missed should the params have changed since
+			prices, _ = types.UnmarshalPriceVote(vote.VoteExtension, params)
+		}
+
+		if len(prices.Prices) == 0 {
+			if err := k.missVote(ctx, vote.Validator.Address); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, p := range prices.Prices {
+			votes[p.Pair] = append(votes[p.Pair], powerPrice{price: p.Price, power: vote.Validator.Power})
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.HeaderInfo().Height
+	quorum := params.Quorum.MulInt64(totalPower)
+	for _, pair := range params.Pairs {
+		median, power := weightedMedian(votes[pair])
+		if power == 0 || math.LegacyNewDec(power).LT(quorum) {
+			continue
+		}
+
+		if err := k.Prices.Set(ctx, pair, types.Price{Price: median, Height: height}); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePriceUpdate,
+				sdk.NewAttribute(types.AttributeKeyPair, pair),
+				sdk.NewAttribute(types.AttributeKeyPrice, median.String()),
+			),
+		)
+	}
+
+	if height%params.MissWindow != 0 {
+		return nil
+	}
+
+	return k.endMissWindow(ctx, params)
+}
+
+// missVote increments the miss counter of the validator with consensus address
+// addr.
+func (k Keeper) missVote(ctx context.Context, addr []byte) error {
+	missed, err := k.MissCounters.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.MissCounters.Set(ctx, addr, missed+1)
+}
+
+// endMissWindow calls the hooks for the validators which missed more than
+// params.MaxMissedVotes votes in the window, and resets the miss counters.
+func (k Keeper) endMissWindow(ctx context.Context, params types.Params) error {
+	err := k.MissCounters.Walk(ctx, nil, func(addr sdk.ConsAddress, missed int64) (bool, error) {
+		if missed <= params.MaxMissedVotes {
+			return false, nil
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMissedPriceVotes,
+				sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyMissed, strconv.FormatInt(missed, 10)),
+			),
+		)
+
+		if k.hooks == nil {
+			return false, nil
+		}
+
+		return false, k.hooks.AfterMissedPriceVotes(ctx, addr, missed)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.MissCounters.Clear(ctx, nil)
+}
+
+// weightedMedian returns the median of prices weighted by voting power, and
+// the total voting power of prices.
+func weightedMedian(prices []powerPrice) (math.LegacyDec, int64) {
+	var total int64
+	for _, p := range prices {
+		total += p.power
+	}
+
+	if total == 0 {
+		return math.LegacyDec{}, 0
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool { return prices[i].price.LT(prices[j].price) })
+
+	var cumulative int64
+	for _, p := range prices {
+		cumulative += p.power
+		if 2*cumulative >= total {
+			return p.price, total
+		}
+	}
+
+	return prices[len(prices)-1].price, total
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Keeper stores the prices aggregated from the price votes validators submit
+// through their vote extensions, and tracks the validators failing to vote.
+type Keeper struct {
+	hooks     types.OracleHooks
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Prices key: pair, value: latest aggregated price
+	Prices collections.Map[string, types.Price]
+	// MissCounters key: validator consensus address, value: number of price
+	// votes missed in the current window
+	MissCounters collections.Map[sdk.ConsAddress, int64]
+}
+
+// NewKeeper returns an oracle keeper whose params are set by authority.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Prices:       collections.NewMap(sb, types.PricesKey, "prices", collections.StringKey, codec.CollValue[types.Price](cdc)),
+		MissCounters: collections.NewMap(sb, types.MissCountersKey, "miss_counters", sdk.ConsAddressKey, collections.Int64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetHooks sets the oracle hooks.
+func (k *Keeper) SetHooks(oh types.OracleHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+
+	k.hooks = oh
+
+	return k
+}
+
+// GetAuthority returns the x/oracle module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetParams sets the oracle params. Only the module authority can set them.
+func (k Keeper) SetParams(ctx context.Context, authority string, params types.Params) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	return k.Params.Set(ctx, params)
+}
+
+// GetParams returns the oracle params, or the default params if they were
+// never set.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return params, err
+}
+
+// GetPrice returns the latest price of pair. It returns ErrPriceStale, along
+// with the price, if the price was aggregated more than Params.MaxPriceAge
+// blocks ago: consumers which can tolerate stale prices can still use it.
+func (k Keeper) GetPrice(ctx context.Context, pair string) (types.Price, error) {
+	price, err := k.Prices.Get(ctx, pair)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Price{}, errorsmod.Wrapf(types.ErrPriceNotFound, "pair %s", pair)
+	} else if err != nil {
+		return types.Price{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Price{}, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	if age := height - price.Height; age > params.MaxPriceAge {
+		return price, errorsmod.Wrapf(types.ErrPriceStale, "price of %s is %d blocks old", pair, age)
+	}
+
+	return price, nil
+}
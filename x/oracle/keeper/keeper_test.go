@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// oracleHooks records the validators which missed too many votes.
+type oracleHooks struct {
+	missed map[string]int64
+}
+
+func (h oracleHooks) AfterMissedPriceVotes(_ context.Context, consAddr sdk.ConsAddress, missed int64) error {
+	h.missed[consAddr.String()] = missed
+	return nil
+}
+
+// priceVote returns the vote of a validator with power, voting price for
+// ATOM/USD, or missing the vote if price is 0.
+func priceVote(t *testing.T, addr string, power, price int64) abci.ExtendedVoteInfo {
+	t.Helper()
+
+	vote := abci.ExtendedVoteInfo{
+		Validator:   abci.Validator{Address: []byte(addr), Power: power},
+		BlockIdFlag: cmtproto.BlockIDFlagCommit,
+	}
+
+	if price > 0 {
+		prices := types.PriceVote{Prices: []types.PairPrice{{Pair: "ATOM/USD", Price: math.LegacyNewDec(price)}}}
+		bz, err := prices.Marshal()
+		require.NoError(t, err)
+		vote.VoteExtension = bz
+	}
+
+	return vote
+}
+
+func TestOracle(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, runtime.NewKVStoreService(key), "authority")
+	hooks := oracleHooks{missed: map[string]int64{}}
+	k.SetHooks(hooks)
+
+	params := types.DefaultParams()
+	params.Pairs = []string{"ATOM/USD", "OSMO/USD"}
+	params.MaxPriceAge = 2
+	params.MissWindow = 4
+	params.MaxMissedVotes = 1
+	require.ErrorIs(t, k.SetParams(ctx, "other", params), sdkerrors.ErrUnauthorized)
+	require.NoError(t, k.SetParams(ctx, "authority", params))
+
+	// the median is weighted by voting power
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+	require.NoError(t, k.AggregatePrices(ctx, abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		priceVote(t, "val1", 10, 100),
+		priceVote(t, "val2", 30, 11),
+		priceVote(t, "val3", 20, 10),
+		priceVote(t, "val4", 5, 0),
+	}}))
+
+	price, err := k.GetPrice(ctx, "ATOM/USD")
+	require.NoError(t, err)
+	require.True(t, math.LegacyNewDec(11).Equal(price.Price))
+	_, err = k.GetPrice(ctx, "OSMO/USD")
+	require.ErrorIs(t, err, types.ErrPriceNotFound)
+
+	// prices are not updated without quorum
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+	require.NoError(t, k.AggregatePrices(ctx, abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		priceVote(t, "val1", 10, 100),
+		priceVote(t, "val2", 30, 0),
+		priceVote(t, "val3", 20, 0),
+		priceVote(t, "val4", 5, 0),
+	}}))
+	price, err = k.GetPrice(ctx, "ATOM/USD")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), price.Height)
+
+	// prices become stale
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4})
+	require.NoError(t, k.AggregatePrices(ctx, abci.ExtendedCommitInfo{}))
+	price, err = k.GetPrice(ctx, "ATOM/USD")
+	require.ErrorIs(t, err, types.ErrPriceStale)
+	require.True(t, math.LegacyNewDec(11).Equal(price.Price))
+
+	// the validators which missed more than 1 vote in the window are reported
+	require.Equal(t, map[string]int64{sdk.ConsAddress("val4").String(): 2}, hooks.missed)
+
+	has, err := k.MissCounters.Has(ctx, sdk.ConsAddress("val2"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestUnmarshalPriceVote(t *testing.T) {
+	params := types.DefaultParams()
+	params.Pairs = []string{"ATOM/USD"}
+
+	marshal := func(prices ...types.PairPrice) []byte {
+		bz, err := (&types.PriceVote{Prices: prices}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	vote, err := types.UnmarshalPriceVote(marshal(types.PairPrice{Pair: "ATOM/USD", Price: math.LegacyNewDec(10)}), params)
+	require.NoError(t, err)
+	require.Len(t, vote.Prices, 1)
+
+	_, err = types.UnmarshalPriceVote(marshal(types.PairPrice{Pair: "OSMO/USD", Price: math.LegacyNewDec(10)}), params)
+	require.ErrorContains(t, err, "unknown pair")
+
+	_, err = types.UnmarshalPriceVote(marshal(
+		types.PairPrice{Pair: "ATOM/USD", Price: math.LegacyNewDec(10)},
+		types.PairPrice{Pair: "ATOM/USD", Price: math.LegacyNewDec(11)},
+	), params)
+	require.ErrorContains(t, err, "duplicate pair")
+
+	_, err = types.UnmarshalPriceVote(marshal(types.PairPrice{Pair: "ATOM/USD", Price: math.LegacyZeroDec()}), params)
+	require.ErrorContains(t, err, "must be positive")
+
+	_, err = types.UnmarshalPriceVote([]byte("not a vote"), params)
+	require.Error(t, err)
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidParams    = errors.Register(ModuleName, 2, "invalid oracle params")
+	ErrInvalidPriceVote = errors.Register(ModuleName, 3, "invalid price vote")
+	ErrPriceNotFound    = errors.Register(ModuleName, 4, "price not found")
+	ErrPriceStale       = errors.Register(ModuleName, 5, "price is stale")
+	ErrInvalidPriceTx   = errors.Register(ModuleName, 6, "invalid price tx")
+)
@@ -0,0 +1,11 @@
+package types
+
+// x/oracle module event types and attributes
+const (
+	EventTypePriceUpdate      = "price_update"
+	EventTypeMissedPriceVotes = "missed_price_votes"
+	AttributeKeyPair          = "pair"
+	AttributeKeyPrice         = "price"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyMissed        = "missed"
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceProvider provides the prices a validator votes for, typically fetched
+// by the node from external price feeds.
+type PriceProvider interface {
+	Prices(ctx context.Context) (map[string]math.LegacyDec, error)
+}
+
+// OracleHooks are called when validators fail to participate in the oracle,
+// so that the application can punish them, for instance by slashing or jailing
+// them through the slashing module.
+type OracleHooks interface {
+	// AfterMissedPriceVotes is called when the validator missed more than
+	// Params.MaxMissedVotes price votes in a window of Params.MissWindow blocks.
+	AfterMissedPriceVotes(ctx context.Context, consAddr sdk.ConsAddress, missed int64) error
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/oracle module.
+	ModuleName = "oracle"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey       = collections.NewPrefix(0)
+	PricesKey       = collections.NewPrefix(1)
+	MissCountersKey = collections.NewPrefix(2)
+)
+
+// PriceTxPrefix prefixes the pseudo-transaction injected by the proposer at
+// the top of the block, which carries the price votes of the previous height.
+var PriceTxPrefix = []byte("oracle/prices:")
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultParams returns the default oracle params, with no pairs.
+func DefaultParams() Params {
+	return Params{
+		Quorum:         math.LegacyNewDecWithPrec(5, 1),
+		MaxPriceAge:    10,
+		MissWindow:     1000,
+		MaxMissedVotes: 500,
+	}
+}
+
+// Validate validates the oracle params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Pairs))
+	for _, pair := range p.Pairs {
+		if pair == "" {
+			return fmt.Errorf("empty pair")
+		}
+		if seen[pair] {
+			return fmt.Errorf("duplicate pair %s", pair)
+		}
+		seen[pair] = true
+	}
+
+	if p.Quorum.IsNil() || !p.Quorum.IsPositive() || p.Quorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("quorum must be in (0, 1]: %s", p.Quorum)
+	}
+
+	if p.MaxPriceAge <= 0 {
+		return fmt.Errorf("max price age must be positive: %d", p.MaxPriceAge)
+	}
+
+	if p.MissWindow <= 0 {
+		return fmt.Errorf("miss window must be positive: %d", p.MissWindow)
+	}
+
+	if p.MaxMissedVotes < 0 || p.MaxMissedVotes >= p.MissWindow {
+		return fmt.Errorf("max missed votes must be in [0, %d): %d", p.MissWindow, p.MaxMissedVotes)
+	}
+
+	return nil
+}
+
+// HasPair returns whether prices are voted for pair.
+func (p Params) HasPair(pair string) bool {
+	for _, other := range p.Pairs {
+		if other == pair {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+// UnmarshalPriceVote decodes a vote and checks that its prices are positive
+// and for distinct pairs of params.
+func UnmarshalPriceVote(bz []byte, params Params) (PriceVote, error) {
+	var vote PriceVote
+	if len(bz) == 0 {
+		return vote, nil
+	}
+
+	if err := vote.Unmarshal(bz); err != nil {
+		return PriceVote{}, err
+	}
+
+	seen := make(map[string]bool, len(vote.Prices))
+	for _, p := range vote.Prices {
+		if !params.HasPair(p.Pair) {
+			return PriceVote{}, fmt.Errorf("unknown pair %s", p.Pair)
+		}
+		if seen[p.Pair] {
+			return PriceVote{}, fmt.Errorf("duplicate pair %s", p.Pair)
+		}
+		seen[p.Pair] = true
+
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return PriceVote{}, fmt.Errorf("price of %s must be positive: %s", p.Pair, p.Price)
+		}
+	}
+
+	return vote, nil
+}
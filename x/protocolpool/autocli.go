@@ -28,6 +28,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "YieldStrategy",
+					Use:            "yield-strategy [name]",
+					Short:          "Query the state of a yield strategy",
+					Example:        fmt.Sprintf(`$ %s query protocolpool yield-strategy staking`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "YieldStrategies",
+					Use:       "yield-strategies",
+					Short:     "Query the state of all the registered yield strategies",
+					Example:   fmt.Sprintf(`$ %s query protocolpool yield-strategies`, version.AppName),
+				},
+				{
+					RpcMethod:      "SubPool",
+					Use:            "sub-pool [name]",
+					Short:          "Query a sub-pool by name",
+					Example:        fmt.Sprintf(`$ %s query protocolpool sub-pool grants`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "SubPools",
+					Use:       "sub-pools",
+					Short:     "Query all the sub-pools",
+					Example:   fmt.Sprintf(`$ %s query protocolpool sub-pools`, version.AppName),
+				},
+				{
+					RpcMethod: "Donations",
+					Use:       "donations",
+					Short:     "Query the cumulative donations to the community pool, optionally of a single donor",
+					Example:   fmt.Sprintf(`$ %s query protocolpool donations --donor cosmos1...`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -60,6 +92,76 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s tx protocolpool claim-budget cosmos1... --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient_address"}},
 				},
+				{
+					RpcMethod:      "SetYieldStrategyCap",
+					Use:            "set-yield-strategy-cap-proposal [name] [cap]",
+					Short:          "Submit a proposal to set the cap of a yield strategy",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool set-yield-strategy-cap-proposal staking 0.2 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "cap"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "DepositToYieldStrategy",
+					Use:            "deposit-to-yield-strategy-proposal [name] [amount]",
+					Short:          "Submit a proposal to deposit community pool funds to a yield strategy",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool deposit-to-yield-strategy-proposal staking 100000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "WithdrawFromYieldStrategy",
+					Use:            "withdraw-from-yield-strategy-proposal [name] [amount]",
+					Short:          "Submit a proposal to withdraw principal from a yield strategy",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool withdraw-from-yield-strategy-proposal staking 100000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "EmergencyWithdrawYieldStrategy",
+					Use:            "emergency-withdraw-yield-strategy-proposal [name]",
+					Short:          "Submit a proposal to disable a yield strategy and withdraw all the funds deposited to it",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool emergency-withdraw-yield-strategy-proposal staking --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "HarvestYield",
+					Use:            "harvest-yield [name]",
+					Short:          "Harvest the yield of a yield strategy into the community pool",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool harvest-yield staking --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "CreateSubPool",
+					Use:            "create-sub-pool-proposal [name] [pool-authority] [ceiling]",
+					Short:          "Submit a proposal to create a sub-pool managed by its own authority",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool create-sub-pool-proposal grants cosmos1... 100000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "pool_authority"}, {ProtoField: "ceiling", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetSubPoolCeiling",
+					Use:            "set-sub-pool-ceiling-proposal [name] [ceiling]",
+					Short:          "Submit a proposal to replace the budget ceiling of a sub-pool",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool set-sub-pool-ceiling-proposal grants 200000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "ceiling", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "AllocateToSubPool",
+					Use:            "allocate-to-sub-pool-proposal [name] [amount]",
+					Short:          "Submit a proposal to allocate community pool funds to a sub-pool",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool allocate-to-sub-pool-proposal grants 50000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "DisburseFromSubPool",
+					Use:            "disburse-from-sub-pool [authority] [name] [recipient] [amount]",
+					Short:          "Disburse funds from the balance of a sub-pool to a recipient",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool disburse-from-sub-pool cosmos1... grants cosmos1... 1000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authority"}, {ProtoField: "name"}, {ProtoField: "recipient"}, {ProtoField: "amount", Varargs: true}},
+				},
 			},
 		},
 	}
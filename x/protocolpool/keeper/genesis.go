@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// InitGenesis initializes the protocolpool module's state from a given
+// genesis state. The yield strategies of the genesis state must be registered.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, info := range data.YieldStrategies {
+		if _, err := k.getYieldStrategy(info.Name); err != nil {
+			return err
+		}
+
+		if err := k.setYieldCap(ctx, info.Name, info.Cap); err != nil {
+			return err
+		}
+
+		if err := k.setNamedCoins(ctx, k.YieldPrincipal, info.Name, info.Principal); err != nil {
+			return err
+		}
+
+		if err := k.setNamedCoins(ctx, k.YieldHarvested, info.Name, info.Harvested); err != nil {
+			return err
+		}
+	}
+
+	for _, pool := range data.SubPools {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(pool.Authority); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid sub-pool authority address: %s", err)
+		}
+
+		if err := k.SubPoolAuthority.Set(ctx, pool.Name, pool.Authority); err != nil {
+			return err
+		}
+
+		if err := k.setNamedCoins(ctx, k.SubPoolCeiling, pool.Name, pool.Ceiling); err != nil {
+			return err
+		}
+
+		if err := k.setNamedCoins(ctx, k.SubPoolBalance, pool.Name, pool.Balance); err != nil {
+			return err
+		}
+	}
+
+	for _, donation := range data.Donations {
+		donor, err := k.authKeeper.AddressCodec().StringToBytes(donation.Donor)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid donor address: %s", err)
+		}
+
+		if err := k.Donations.Set(ctx, collections.Join(sdk.AccAddress(donor), donation.Amount.Denom), donation.Amount.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the protocolpool module's genesis state. Yield
+// strategies which were never enabled are left out.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	strategies, err := k.GetYieldStrategies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.DefaultGenesisState()
+	for _, info := range strategies {
+		if info.Cap.IsZero() && info.Principal.IsZero() && info.Harvested.IsZero() {
+			continue
+		}
+		genState.YieldStrategies = append(genState.YieldStrategies, info)
+	}
+
+	err = k.SubPoolAuthority.Walk(ctx, nil, func(name, _ string) (bool, error) {
+		pool, err := k.GetSubPool(ctx, name)
+		if err != nil {
+			return true, err
+		}
+		genState.SubPools = append(genState.SubPools, pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Donations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		donor, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genState.Donations = append(genState.Donations, types.Donation{Donor: donor, Amount: sdk.NewCoin(key.K2(), amount)})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
@@ -76,3 +76,89 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// YieldStrategy queries the state of a registered yield strategy.
+func (k Querier) YieldStrategy(ctx context.Context, req *types.QueryYieldStrategyRequest) (*types.QueryYieldStrategyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	info, err := k.Keeper.GetYieldStrategy(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, types.ErrYieldStrategyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "yield strategy %s not found", req.Name)
+		}
+		return nil, err
+	}
+
+	return &types.QueryYieldStrategyResponse{Strategy: info}, nil
+}
+
+// YieldStrategies queries the state of all the registered yield strategies.
+func (k Querier) YieldStrategies(ctx context.Context, req *types.QueryYieldStrategiesRequest) (*types.QueryYieldStrategiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	strategies, err := k.Keeper.GetYieldStrategies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryYieldStrategiesResponse{Strategies: strategies}, nil
+}
+
+// SubPool queries a sub-pool by name.
+func (k Querier) SubPool(ctx context.Context, req *types.QuerySubPoolRequest) (*types.QuerySubPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := k.Keeper.GetSubPool(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, types.ErrSubPoolNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sub-pool %s not found", req.Name)
+		}
+		return nil, err
+	}
+
+	return &types.QuerySubPoolResponse{SubPool: pool}, nil
+}
+
+// SubPools queries all the sub-pools.
+func (k Querier) SubPools(ctx context.Context, req *types.QuerySubPoolsRequest) (*types.QuerySubPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pools, pageRes, err := k.Keeper.GetSubPools(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySubPoolsResponse{SubPools: pools, Pagination: pageRes}, nil
+}
+
+// Donations queries the cumulative donations to the community pool, of all the
+// donors or of a single donor.
+func (k Querier) Donations(ctx context.Context, req *types.QueryDonationsRequest) (*types.QueryDonationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var donor sdk.AccAddress
+	if req.Donor != "" {
+		var err error
+		donor, err = k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Donor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid donor address: %s", err.Error())
+		}
+	}
+
+	donations, pageRes, err := k.Keeper.GetAllDonations(ctx, donor, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDonationsResponse{Donations: donations, Pagination: pageRes}, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type Keeper struct {
@@ -30,6 +31,24 @@ type Keeper struct {
 	// State
 	Schema         collections.Schema
 	BudgetProposal collections.Map[sdk.AccAddress, types.Budget]
+	// Donations key: donor | denom, value: cumulative amount donated
+	Donations collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+	// SubPoolAuthority key: sub-pool name, value: authority address
+	SubPoolAuthority collections.Map[string, string]
+	// SubPoolCeiling key: sub-pool name | denom, value: budget ceiling
+	SubPoolCeiling collections.Map[collections.Pair[string, string], math.Int]
+	// SubPoolBalance key: sub-pool name | denom, value: allocated funds not yet spent
+	SubPoolBalance collections.Map[collections.Pair[string, string], math.Int]
+	// YieldCap key: yield strategy name, value: cap of an enabled strategy, as a
+	// fixed point integer with math.LegacyPrecision decimals
+	YieldCap collections.Map[string, math.Int]
+	// YieldPrincipal key: yield strategy name | denom, value: principal deposited and not withdrawn
+	YieldPrincipal collections.Map[collections.Pair[string, string], math.Int]
+	// YieldHarvested key: yield strategy name | denom, value: cumulative yield harvested
+	YieldHarvested collections.Map[collections.Pair[string, string], math.Int]
+
+	// yieldStrategies are the yield strategies registered by the app, by name
+	yieldStrategies map[string]types.YieldStrategy
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService,
@@ -42,12 +61,20 @@ func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService,
 	sb := collections.NewSchemaBuilder(storeService)
 
 	keeper := Keeper{
-		storeService:   storeService,
-		authKeeper:     ak,
-		bankKeeper:     bk,
-		cdc:            cdc,
-		authority:      authority,
-		BudgetProposal: collections.NewMap(sb, types.BudgetKey, "budget", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
+		storeService:     storeService,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		cdc:              cdc,
+		authority:        authority,
+		BudgetProposal:   collections.NewMap(sb, types.BudgetKey, "budget", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
+		Donations:        collections.NewMap(sb, types.DonationsKey, "donations", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue),
+		SubPoolAuthority: collections.NewMap(sb, types.SubPoolAuthorityKey, "sub_pool_authority", collections.StringKey, collections.StringValue),
+		SubPoolCeiling:   collections.NewMap(sb, types.SubPoolCeilingKey, "sub_pool_ceiling", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		SubPoolBalance:   collections.NewMap(sb, types.SubPoolBalanceKey, "sub_pool_balance", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		YieldCap:         collections.NewMap(sb, types.YieldCapKey, "yield_cap", collections.StringKey, sdk.IntValue),
+		YieldPrincipal:   collections.NewMap(sb, types.YieldPrincipalKey, "yield_principal", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		YieldHarvested:   collections.NewMap(sb, types.YieldHarvestedKey, "yield_harvested", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		yieldStrategies:  make(map[string]types.YieldStrategy),
 	}
 
 	schema, err := sb.Build()
@@ -71,8 +98,82 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 }
 
 // FundCommunityPool allows an account to directly fund the community fund pool.
+// The amount is added to the cumulative donations of the sender and a donation
+// event is emitted for each denom.
 func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return k.trackDonation(ctx, sender, amount)
+}
+
+// trackDonation adds amount to the cumulative donations of donor and emits the
+// corresponding donation events.
+func (k Keeper) trackDonation(ctx context.Context, donor sdk.AccAddress, amount sdk.Coins) error {
+	donorStr, err := k.authKeeper.AddressCodec().BytesToString(donor)
+	if err != nil {
+		return err
+	}
+
+	events := make(sdk.Events, 0, len(amount))
+	for _, coin := range amount {
+		key := collections.Join(donor, coin.Denom)
+		total, err := k.Donations.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			total = math.ZeroInt()
+		}
+
+		total = total.Add(coin.Amount)
+		if err := k.Donations.Set(ctx, key, total); err != nil {
+			return err
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDonation,
+			sdk.NewAttribute(types.AttributeKeyDonor, donorStr),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalDonor, sdk.NewCoin(coin.Denom, total).String()),
+		))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+	return nil
+}
+
+// GetDonations returns the cumulative amount donated to the community pool by donor.
+func (k Keeper) GetDonations(ctx context.Context, donor sdk.AccAddress) (sdk.Coins, error) {
+	var total sdk.Coins
+	err := k.Donations.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](donor), func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		total = total.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+// GetAllDonations returns a page of cumulative donations, ordered by donor and
+// denom. If donor is not nil, only the donations of donor are returned.
+func (k Keeper) GetAllDonations(ctx context.Context, donor sdk.AccAddress, pageReq *query.PageRequest) ([]types.Donation, *query.PageResponse, error) {
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[sdk.AccAddress, string]])
+	if donor != nil {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](donor))
+	}
+
+	return query.CollectionPaginate(ctx, k.Donations, pageReq, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (types.Donation, error) {
+		donor, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return types.Donation{}, err
+		}
+
+		return types.Donation{Donor: donor, Amount: sdk.NewCoin(key.K2(), amount)}, nil
+	}, opts...)
 }
 
 // DistributeFromCommunityPool distributes funds from the protocolpool module account to
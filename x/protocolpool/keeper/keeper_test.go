@@ -28,6 +28,7 @@ type KeeperTestSuite struct {
 
 	ctx        sdk.Context
 	poolKeeper poolkeeper.Keeper
+	authKeeper *pooltestutil.MockAccountKeeper
 	bankKeeper *pooltestutil.MockBankKeeper
 	msgServer  pooltypes.MsgServer
 }
@@ -45,6 +46,8 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(poolAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
+	s.authKeeper = accountKeeper
+
 	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
 	s.bankKeeper = bankKeeper
 
@@ -110,6 +110,89 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	return &types.MsgCancelContinuousFundResponse{}, nil
 }
 
+func (k MsgServer) SetYieldStrategyCap(ctx context.Context, msg *types.MsgSetYieldStrategyCap) (*types.MsgSetYieldStrategyCapResponse, error) {
+	if err := k.Keeper.SetYieldStrategyCap(ctx, msg.Authority, msg.Name, msg.Cap); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetYieldStrategyCapResponse{}, nil
+}
+
+func (k MsgServer) DepositToYieldStrategy(ctx context.Context, msg *types.MsgDepositToYieldStrategy) (*types.MsgDepositToYieldStrategyResponse, error) {
+	if err := k.Keeper.DepositToYieldStrategy(ctx, msg.Authority, msg.Name, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositToYieldStrategyResponse{}, nil
+}
+
+func (k MsgServer) WithdrawFromYieldStrategy(ctx context.Context, msg *types.MsgWithdrawFromYieldStrategy) (*types.MsgWithdrawFromYieldStrategyResponse, error) {
+	if err := k.Keeper.WithdrawFromYieldStrategy(ctx, msg.Authority, msg.Name, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFromYieldStrategyResponse{}, nil
+}
+
+func (k MsgServer) EmergencyWithdrawYieldStrategy(ctx context.Context, msg *types.MsgEmergencyWithdrawYieldStrategy) (*types.MsgEmergencyWithdrawYieldStrategyResponse, error) {
+	loss, err := k.Keeper.EmergencyWithdrawYieldStrategy(ctx, msg.Authority, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEmergencyWithdrawYieldStrategyResponse{Loss: loss}, nil
+}
+
+func (k MsgServer) HarvestYield(ctx context.Context, msg *types.MsgHarvestYield) (*types.MsgHarvestYieldResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	amount, err := k.Keeper.HarvestYield(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgHarvestYieldResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CreateSubPool(ctx context.Context, msg *types.MsgCreateSubPool) (*types.MsgCreateSubPoolResponse, error) {
+	if err := k.Keeper.CreateSubPool(ctx, msg.Authority, msg.Name, msg.PoolAuthority, msg.Ceiling); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSubPoolResponse{}, nil
+}
+
+func (k MsgServer) SetSubPoolCeiling(ctx context.Context, msg *types.MsgSetSubPoolCeiling) (*types.MsgSetSubPoolCeilingResponse, error) {
+	if err := k.Keeper.SetSubPoolCeiling(ctx, msg.Authority, msg.Name, msg.Ceiling); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSubPoolCeilingResponse{}, nil
+}
+
+func (k MsgServer) AllocateToSubPool(ctx context.Context, msg *types.MsgAllocateToSubPool) (*types.MsgAllocateToSubPoolResponse, error) {
+	if err := k.Keeper.AllocateToSubPool(ctx, msg.Authority, msg.Name, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAllocateToSubPoolResponse{}, nil
+}
+
+func (k MsgServer) DisburseFromSubPool(ctx context.Context, msg *types.MsgDisburseFromSubPool) (*types.MsgDisburseFromSubPoolResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.DisburseFromSubPool(ctx, msg.Authority, msg.Name, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisburseFromSubPoolResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
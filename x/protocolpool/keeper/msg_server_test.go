@@ -3,10 +3,14 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/protocolpool/keeper"
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var (
@@ -337,3 +341,67 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgFundCommunityPoolTracksDonations() {
+	donor := sdk.AccAddress([]byte("donor________________"))
+	donorStr := donor.String()
+	barCoin := sdk.NewInt64Coin("bar", 10)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), donor, types.ModuleName, gomock.Any()).Return(nil).Times(2)
+
+	_, err := suite.msgServer.FundCommunityPool(suite.ctx, &types.MsgFundCommunityPool{
+		Depositor: donorStr,
+		Amount:    sdk.NewCoins(fooCoin, barCoin),
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.FundCommunityPool(suite.ctx, &types.MsgFundCommunityPool{
+		Depositor: donorStr,
+		Amount:    sdk.NewCoins(fooCoin2),
+	})
+	suite.Require().NoError(err)
+
+	donations, err := suite.poolKeeper.GetDonations(suite.ctx, donor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fooCoin.Add(fooCoin2), barCoin), donations)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeDonation, events[0].Type)
+	total, ok := events[0].GetAttribute(types.AttributeKeyTotalDonor)
+	suite.Require().True(ok)
+	suite.Require().Equal(fooCoin.Add(fooCoin2).String(), total.Value)
+
+	queryServer := keeper.NewQuerier(suite.poolKeeper)
+	res, err := queryServer.Donations(suite.ctx, &types.QueryDonationsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Donation{{Donor: donorStr, Amount: barCoin}}, res.Donations)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	other := sdk.AccAddress([]byte("other________________"))
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), other, types.ModuleName, gomock.Any()).Return(nil)
+	_, err = suite.msgServer.FundCommunityPool(suite.ctx, &types.MsgFundCommunityPool{
+		Depositor: other.String(),
+		Amount:    sdk.NewCoins(fooCoin),
+	})
+	suite.Require().NoError(err)
+
+	res, err = queryServer.Donations(suite.ctx, &types.QueryDonationsRequest{Donor: other.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Donation{{Donor: other.String(), Amount: fooCoin}}, res.Donations)
+
+	// the donations are exported and imported in genesis
+	genState, err := suite.poolKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Len(genState.Donations, 3)
+	suite.SetupTest()
+	suite.Require().NoError(suite.poolKeeper.InitGenesis(suite.ctx, genState))
+	donations, err = suite.poolKeeper.GetDonations(suite.ctx, donor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fooCoin.Add(fooCoin2), barCoin), donations)
+
+	genState.Donations = append(genState.Donations, genState.Donations[0])
+	suite.Require().ErrorContains(genState.Validate(), "duplicate donation")
+}
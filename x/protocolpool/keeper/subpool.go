@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CreateSubPool creates a sub-pool named name, managed by poolAuthority and
+// whose allocated balance can never exceed ceiling. Only the module authority
+// can create sub-pools.
+func (k Keeper) CreateSubPool(ctx context.Context, authority, name, poolAuthority string, ceiling sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := types.ValidateSubPoolName(name); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(poolAuthority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sub-pool authority address: %s", err)
+	}
+
+	if err := validateAmount(ceiling); err != nil {
+		return err
+	}
+
+	has, err := k.SubPoolAuthority.Has(ctx, name)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrap(types.ErrSubPoolExists, name)
+	}
+
+	if err := k.SubPoolAuthority.Set(ctx, name, poolAuthority); err != nil {
+		return err
+	}
+
+	return k.setNamedCoins(ctx, k.SubPoolCeiling, name, ceiling)
+}
+
+// SetSubPoolCeiling replaces the budget ceiling of a sub-pool. Lowering the
+// ceiling below the current balance prevents further allocations but does not
+// claw back funds already allocated. Only the module authority can update it.
+func (k Keeper) SetSubPoolCeiling(ctx context.Context, authority, name string, ceiling sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := validateAmount(ceiling); err != nil {
+		return err
+	}
+
+	if _, err := k.getSubPoolAuthority(ctx, name); err != nil {
+		return err
+	}
+
+	return k.setNamedCoins(ctx, k.SubPoolCeiling, name, ceiling)
+}
+
+// AllocateToSubPool earmarks amount of the community pool for a sub-pool. The
+// funds stay in the protocolpool module account, but can only be spent by the
+// sub-pool authority. Only the module authority can allocate funds, and only
+// from funds not already allocated to another sub-pool.
+func (k Keeper) AllocateToSubPool(ctx context.Context, authority, name string, amount sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	pool, err := k.GetSubPool(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	balance := pool.Balance.Add(amount...)
+	if !balance.IsAllLTE(pool.Ceiling) {
+		return errorsmod.Wrapf(types.ErrSubPoolCeiling, "sub-pool %s: balance %s, ceiling %s", name, balance, pool.Ceiling)
+	}
+
+	communityPool, err := k.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	allocated, err := k.GetAllocatedFunds(ctx)
+	if err != nil {
+		return err
+	}
+
+	unallocated, hasNeg := communityPool.SafeSub(allocated...)
+	if hasNeg || !amount.IsAllLTE(unallocated) {
+		return errorsmod.Wrapf(types.ErrInsufficientPool, "requested %s, available %s", amount, unallocated)
+	}
+
+	return k.setNamedCoins(ctx, k.SubPoolBalance, name, balance)
+}
+
+// DisburseFromSubPool sends amount from the balance of a sub-pool to
+// recipient. Only the sub-pool authority can disburse its funds.
+func (k Keeper) DisburseFromSubPool(ctx context.Context, sender, name string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	pool, err := k.GetSubPool(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if pool.Authority != sender {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid sub-pool authority; expected %s, got %s", pool.Authority, sender)
+	}
+
+	balance, hasNeg := pool.Balance.SafeSub(amount...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientSubPool, "sub-pool %s: balance %s, requested %s", name, pool.Balance, amount)
+	}
+
+	if err := k.setNamedCoins(ctx, k.SubPoolBalance, name, balance); err != nil {
+		return err
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, amount, recipient); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("transferred from sub-pool to recipient", "sub_pool", name, "amount", amount.String(), "recipient", recipient.String())
+
+	return nil
+}
+
+// GetSubPool returns the sub-pool named name.
+func (k Keeper) GetSubPool(ctx context.Context, name string) (types.SubPool, error) {
+	authority, err := k.getSubPoolAuthority(ctx, name)
+	if err != nil {
+		return types.SubPool{}, err
+	}
+
+	ceiling, err := k.getNamedCoins(ctx, k.SubPoolCeiling, name)
+	if err != nil {
+		return types.SubPool{}, err
+	}
+
+	balance, err := k.getNamedCoins(ctx, k.SubPoolBalance, name)
+	if err != nil {
+		return types.SubPool{}, err
+	}
+
+	return types.SubPool{Name: name, Authority: authority, Ceiling: ceiling, Balance: balance}, nil
+}
+
+// GetSubPools returns a page of sub-pools, ordered by name.
+func (k Keeper) GetSubPools(ctx context.Context, pageReq *query.PageRequest) ([]types.SubPool, *query.PageResponse, error) {
+	return query.CollectionPaginate(ctx, k.SubPoolAuthority, pageReq, func(name, _ string) (types.SubPool, error) {
+		return k.GetSubPool(ctx, name)
+	})
+}
+
+// GetAllocatedFunds returns the community pool funds allocated to sub-pools
+// and not spent yet.
+func (k Keeper) GetAllocatedFunds(ctx context.Context) (sdk.Coins, error) {
+	var allocated sdk.Coins
+	err := k.SubPoolBalance.Walk(ctx, nil, func(key collections.Pair[string, string], amount math.Int) (bool, error) {
+		allocated = allocated.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allocated, nil
+}
+
+func (k Keeper) getSubPoolAuthority(ctx context.Context, name string) (string, error) {
+	authority, err := k.SubPoolAuthority.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", errorsmod.Wrap(types.ErrSubPoolNotFound, name)
+		}
+		return "", err
+	}
+
+	return authority, nil
+}
+
+// getNamedCoins returns the coins stored under name, e.g. for a sub-pool.
+func (k Keeper) getNamedCoins(ctx context.Context, m collections.Map[collections.Pair[string, string], math.Int], name string) (sdk.Coins, error) {
+	var coins sdk.Coins
+	err := m.Walk(ctx, collections.NewPrefixedPairRange[string, string](name), func(key collections.Pair[string, string], amount math.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+// setNamedCoins replaces the coins stored under name, e.g. for a sub-pool,
+// removing the denoms which are not part of coins anymore.
+func (k Keeper) setNamedCoins(ctx context.Context, m collections.Map[collections.Pair[string, string], math.Int], name string, coins sdk.Coins) error {
+	if err := m.Clear(ctx, collections.NewPrefixedPairRange[string, string](name)); err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		if coin.IsZero() {
+			continue
+		}
+
+		if err := m.Set(ctx, collections.Join(name, coin.Denom), coin.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/protocolpool/keeper"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestSubPool() {
+	authority := suite.poolKeeper.GetAuthority()
+	committee := sdk.AccAddress([]byte("committee____________")).String()
+	foo := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("foo", amount)) }
+	ceiling := foo(100)
+	create := func(authority, name string) error {
+		_, err := suite.msgServer.CreateSubPool(suite.ctx, &types.MsgCreateSubPool{Authority: authority, Name: name, PoolAuthority: committee, Ceiling: ceiling})
+		return err
+	}
+	allocate := func(name string, amount sdk.Coins) error {
+		_, err := suite.msgServer.AllocateToSubPool(suite.ctx, &types.MsgAllocateToSubPool{Authority: authority, Name: name, Amount: amount})
+		return err
+	}
+	disburse := func(sender string, amount sdk.Coins) error {
+		_, err := suite.msgServer.DisburseFromSubPool(suite.ctx, &types.MsgDisburseFromSubPool{Authority: sender, Name: "grants", Recipient: recipientAddr.String(), Amount: amount})
+		return err
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(poolAcc).AnyTimes()
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(foo(120)).AnyTimes()
+
+	// only the module authority can create sub-pools
+	suite.Require().ErrorIs(create(committee, "grants"), types.ErrInvalidSigner)
+	suite.Require().NoError(create(authority, "grants"))
+	suite.Require().ErrorIs(create(authority, "grants"), types.ErrSubPoolExists)
+
+	// allocations are capped by the ceiling
+	suite.Require().ErrorIs(allocate("grants", foo(101)), types.ErrSubPoolCeiling)
+	suite.Require().NoError(allocate("grants", foo(80)))
+
+	// allocations are capped by the unallocated community pool funds
+	suite.Require().NoError(create(authority, "research"))
+	suite.Require().ErrorIs(allocate("research", foo(50)), types.ErrInsufficientPool)
+
+	// only the sub-pool authority can disburse, within the sub-pool balance
+	suite.Require().ErrorIs(disburse(authority, foo(10)), types.ErrInvalidSigner)
+	suite.Require().ErrorIs(disburse(committee, foo(81)), types.ErrInsufficientSubPool)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, foo(30)).Return(nil)
+	suite.Require().NoError(disburse(committee, foo(30)))
+
+	// lowering the ceiling does not claw back the balance
+	_, err := suite.msgServer.SetSubPoolCeiling(suite.ctx, &types.MsgSetSubPoolCeiling{Authority: authority, Name: "grants", Ceiling: foo(40)})
+	suite.Require().NoError(err)
+
+	grants := types.SubPool{
+		Name:      "grants",
+		Authority: committee,
+		Ceiling:   foo(40),
+		Balance:   foo(50),
+	}
+	research := types.SubPool{Name: "research", Authority: committee, Ceiling: ceiling}
+	queryServer := keeper.NewQuerier(suite.poolKeeper)
+	res, err := queryServer.SubPool(suite.ctx, &types.QuerySubPoolRequest{Name: "grants"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(grants, res.SubPool)
+
+	_, err = queryServer.SubPool(suite.ctx, &types.QuerySubPoolRequest{Name: "unknown"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	allRes, err := queryServer.SubPools(suite.ctx, &types.QuerySubPoolsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SubPool{grants}, allRes.SubPools)
+	allRes, err = queryServer.SubPools(suite.ctx, &types.QuerySubPoolsRequest{Pagination: &query.PageRequest{Key: allRes.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SubPool{research}, allRes.SubPools)
+
+	// the sub-pools are exported and imported in genesis
+	genState, err := suite.poolKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Equal([]types.SubPool{grants, research}, genState.SubPools)
+	suite.SetupTest()
+	suite.Require().NoError(suite.poolKeeper.InitGenesis(suite.ctx, genState))
+	pool, err := suite.poolKeeper.GetSubPool(suite.ctx, "grants")
+	suite.Require().NoError(err)
+	suite.Require().Equal(grants, pool)
+
+	genState.SubPools = append(genState.SubPools, grants)
+	suite.Require().ErrorContains(genState.Validate(), "duplicate sub-pool grants")
+}
@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterYieldStrategy registers a yield strategy under name. Registered
+// strategies are disabled until the module authority sets their cap. It panics
+// if a strategy is already registered under name, and must be called when
+// wiring the app.
+func (k Keeper) RegisterYieldStrategy(name string, strategy types.YieldStrategy) {
+	if _, ok := k.yieldStrategies[name]; ok {
+		panic(fmt.Sprintf("yield strategy %s already registered", name))
+	}
+
+	k.yieldStrategies[name] = strategy
+}
+
+// SetYieldStrategyCap sets the maximum fraction of the community pool funds
+// which can be deposited to a yield strategy. A zero cap disables the
+// strategy; lowering the cap below the current principal prevents further
+// deposits but does not withdraw funds. Only the module authority can set it.
+func (k Keeper) SetYieldStrategyCap(ctx context.Context, authority, name string, yieldCap math.LegacyDec) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if _, err := k.getYieldStrategy(name); err != nil {
+		return err
+	}
+
+	if err := types.ValidateYieldStrategyCap(yieldCap); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return k.setYieldCap(ctx, name, yieldCap)
+}
+
+// DepositToYieldStrategy deposits amount of the community pool to a yield
+// strategy. Only the module authority can deposit funds, only from funds not
+// allocated to a sub-pool, and only up to the cap of the strategy.
+func (k Keeper) DepositToYieldStrategy(ctx context.Context, authority, name string, amount sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	strategy, err := k.getYieldStrategy(name)
+	if err != nil {
+		return err
+	}
+
+	yieldCap, err := k.getYieldCap(ctx, name)
+	if err != nil {
+		return err
+	}
+	if yieldCap.IsZero() {
+		return errorsmod.Wrap(types.ErrYieldStrategyDisabled, name)
+	}
+
+	communityPool, err := k.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	allocated, err := k.GetAllocatedFunds(ctx)
+	if err != nil {
+		return err
+	}
+
+	unallocated, hasNeg := communityPool.SafeSub(allocated...)
+	if hasNeg || !amount.IsAllLTE(unallocated) {
+		return errorsmod.Wrapf(types.ErrInsufficientPool, "requested %s, available %s", amount, unallocated)
+	}
+
+	totalPrincipal, err := k.GetYieldPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	principal, err := k.getNamedCoins(ctx, k.YieldPrincipal, name)
+	if err != nil {
+		return err
+	}
+
+	// the cap applies to the funds of the pool, whether deposited or not
+	principal = principal.Add(amount...)
+	for _, coin := range amount {
+		funds := communityPool.AmountOf(coin.Denom).Add(totalPrincipal.AmountOf(coin.Denom))
+		if limit := yieldCap.MulInt(funds).TruncateInt(); principal.AmountOf(coin.Denom).GT(limit) {
+			return errorsmod.Wrapf(types.ErrYieldCap, "yield strategy %s: principal %s, cap %s%s", name, principal, limit, coin.Denom)
+		}
+	}
+
+	if err := k.setNamedCoins(ctx, k.YieldPrincipal, name, principal); err != nil {
+		return err
+	}
+
+	if err := strategy.Deposit(ctx, k.poolAddress(ctx), amount); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeYieldDeposit,
+		sdk.NewAttribute(types.AttributeKeyStrategy, name),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// WithdrawFromYieldStrategy withdraws amount of the principal deposited to a
+// yield strategy back to the community pool. Only the module authority can
+// withdraw funds.
+func (k Keeper) WithdrawFromYieldStrategy(ctx context.Context, authority, name string, amount sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	strategy, err := k.getYieldStrategy(name)
+	if err != nil {
+		return err
+	}
+
+	principal, err := k.getNamedCoins(ctx, k.YieldPrincipal, name)
+	if err != nil {
+		return err
+	}
+
+	remaining, hasNeg := principal.SafeSub(amount...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientPrincipal, "yield strategy %s: principal %s, requested %s", name, principal, amount)
+	}
+
+	if err := k.setNamedCoins(ctx, k.YieldPrincipal, name, remaining); err != nil {
+		return err
+	}
+
+	if err := strategy.Withdraw(ctx, k.poolAddress(ctx), amount); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeYieldWithdraw,
+		sdk.NewAttribute(types.AttributeKeyStrategy, name),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// EmergencyWithdrawYieldStrategy disables a yield strategy and withdraws all
+// the funds deposited to it, whatever its principal. It returns the loss, the
+// principal which could not be withdrawn, e.g. after a slashing, which is
+// written off. Only the module authority can trigger it.
+func (k Keeper) EmergencyWithdrawYieldStrategy(ctx context.Context, authority, name string) (sdk.Coins, error) {
+	if err := k.validateAuthority(authority); err != nil {
+		return nil, err
+	}
+
+	strategy, err := k.getYieldStrategy(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.YieldCap.Remove(ctx, name); err != nil {
+		return nil, err
+	}
+
+	principal, err := k.getNamedCoins(ctx, k.YieldPrincipal, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := k.poolAddress(ctx)
+	deposited, err := strategy.Deposited(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deposited.IsZero() {
+		if err := strategy.Withdraw(ctx, pool, deposited); err != nil {
+			return nil, err
+		}
+	}
+
+	loss := sdk.NewCoins()
+	for _, coin := range principal {
+		if withdrawn := deposited.AmountOf(coin.Denom); coin.Amount.GT(withdrawn) {
+			loss = loss.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(withdrawn)))
+		}
+	}
+
+	if err := k.setNamedCoins(ctx, k.YieldPrincipal, name, nil); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeYieldEmergencyWithdraw,
+		sdk.NewAttribute(types.AttributeKeyStrategy, name),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposited.String()),
+		sdk.NewAttribute(types.AttributeKeyLoss, loss.String()),
+	))
+
+	k.Logger(ctx).Error("emergency withdrawal from yield strategy", "strategy", name, "amount", deposited.String(), "loss", loss.String())
+
+	return loss, nil
+}
+
+// HarvestYield harvests the yield of the funds deposited to a yield strategy
+// into the community pool, and returns it. The yield is accounted separately
+// from the principal. Since it only moves funds to the pool, anyone can
+// trigger it, e.g. the app in a block hook.
+func (k Keeper) HarvestYield(ctx context.Context, name string) (sdk.Coins, error) {
+	strategy, err := k.getYieldStrategy(name)
+	if err != nil {
+		return nil, err
+	}
+
+	yield, err := strategy.Harvest(ctx, k.poolAddress(ctx))
+	if err != nil || yield.IsZero() {
+		return yield, err
+	}
+
+	harvested, err := k.getNamedCoins(ctx, k.YieldHarvested, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.setNamedCoins(ctx, k.YieldHarvested, name, harvested.Add(yield...)); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeYieldHarvest,
+		sdk.NewAttribute(types.AttributeKeyStrategy, name),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, yield.String()),
+	))
+
+	return yield, nil
+}
+
+// GetYieldStrategy returns the state of the yield strategy registered under
+// name.
+func (k Keeper) GetYieldStrategy(ctx context.Context, name string) (types.YieldStrategyInfo, error) {
+	if _, err := k.getYieldStrategy(name); err != nil {
+		return types.YieldStrategyInfo{}, err
+	}
+
+	yieldCap, err := k.getYieldCap(ctx, name)
+	if err != nil {
+		return types.YieldStrategyInfo{}, err
+	}
+
+	principal, err := k.getNamedCoins(ctx, k.YieldPrincipal, name)
+	if err != nil {
+		return types.YieldStrategyInfo{}, err
+	}
+
+	harvested, err := k.getNamedCoins(ctx, k.YieldHarvested, name)
+	if err != nil {
+		return types.YieldStrategyInfo{}, err
+	}
+
+	return types.YieldStrategyInfo{Name: name, Cap: yieldCap, Principal: principal, Harvested: harvested}, nil
+}
+
+// GetYieldStrategies returns the state of all the registered yield strategies,
+// by name.
+func (k Keeper) GetYieldStrategies(ctx context.Context) ([]types.YieldStrategyInfo, error) {
+	names := make([]string, 0, len(k.yieldStrategies))
+	for name := range k.yieldStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	strategies := make([]types.YieldStrategyInfo, 0, len(names))
+	for _, name := range names {
+		info, err := k.GetYieldStrategy(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		strategies = append(strategies, info)
+	}
+
+	return strategies, nil
+}
+
+// GetYieldPrincipal returns the community pool funds deposited to all the
+// yield strategies and not withdrawn yet.
+func (k Keeper) GetYieldPrincipal(ctx context.Context) (sdk.Coins, error) {
+	var principal sdk.Coins
+	err := k.YieldPrincipal.Walk(ctx, nil, func(key collections.Pair[string, string], amount math.Int) (bool, error) {
+		principal = principal.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return principal, nil
+}
+
+func (k Keeper) getYieldStrategy(name string) (types.YieldStrategy, error) {
+	strategy, ok := k.yieldStrategies[name]
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrYieldStrategyNotFound, name)
+	}
+
+	return strategy, nil
+}
+
+// getYieldCap returns the cap of a yield strategy, which is zero if it is
+// disabled.
+func (k Keeper) getYieldCap(ctx context.Context, name string) (math.LegacyDec, error) {
+	yieldCap, err := k.YieldCap.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyZeroDec(), nil
+		}
+		return math.LegacyDec{}, err
+	}
+
+	return math.LegacyNewDecFromBigIntWithPrec(yieldCap.BigInt(), math.LegacyPrecision), nil
+}
+
+// setYieldCap sets the cap of a yield strategy, disabling it if it is zero.
+func (k Keeper) setYieldCap(ctx context.Context, name string, yieldCap math.LegacyDec) error {
+	if yieldCap.IsZero() {
+		return k.YieldCap.Remove(ctx, name)
+	}
+
+	return k.YieldCap.Set(ctx, name, math.NewIntFromBigInt(yieldCap.BigInt()))
+}
+
+func (k Keeper) poolAddress(ctx context.Context) sdk.AccAddress {
+	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/protocolpool/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.YieldStrategy = StakingYieldStrategy{}
+
+// StakingYieldStrategy is a yield strategy delegating the bond denom funds
+// deposited to a validator, the yield being the staking rewards. Withdrawn
+// funds return to the community pool at the end of the unbonding period.
+type StakingYieldStrategy struct {
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
+	validator     sdk.ValAddress
+}
+
+// NewStakingYieldStrategy returns a yield strategy delegating to validator.
+func NewStakingYieldStrategy(sk types.StakingKeeper, dk types.DistributionKeeper, validator sdk.ValAddress) StakingYieldStrategy {
+	return StakingYieldStrategy{
+		stakingKeeper: sk,
+		distrKeeper:   dk,
+		validator:     validator,
+	}
+}
+
+func (s StakingYieldStrategy) Deposit(ctx context.Context, pool sdk.AccAddress, amount sdk.Coins) error {
+	bondAmt, err := s.bondAmount(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	validator, err := s.stakingKeeper.GetValidator(ctx, s.validator)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.stakingKeeper.Delegate(ctx, pool, bondAmt.Amount, stakingtypes.Unbonded, validator, true)
+	return err
+}
+
+func (s StakingYieldStrategy) Withdraw(ctx context.Context, pool sdk.AccAddress, amount sdk.Coins) error {
+	unbondAmt, err := s.bondAmount(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	shares, err := s.stakingKeeper.ValidateUnbondAmount(ctx, pool, s.validator, unbondAmt.Amount)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.stakingKeeper.Undelegate(ctx, pool, s.validator, shares)
+	return err
+}
+
+func (s StakingYieldStrategy) Deposited(ctx context.Context, pool sdk.AccAddress) (sdk.Coins, error) {
+	delegation, err := s.stakingKeeper.Delegation(ctx, pool, s.validator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoins(), nil
+		}
+		return nil, err
+	}
+
+	validator, err := s.stakingKeeper.GetValidator(ctx, s.validator)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, tokens)), nil
+}
+
+func (s StakingYieldStrategy) Harvest(ctx context.Context, pool sdk.AccAddress) (sdk.Coins, error) {
+	if _, err := s.stakingKeeper.Delegation(ctx, pool, s.validator); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoins(), nil
+		}
+		return nil, err
+	}
+
+	return s.distrKeeper.WithdrawDelegationRewards(ctx, pool, s.validator)
+}
+
+// bondAmount returns the amount of bond denom of amount, which must only
+// contain the bond denom.
+func (s StakingYieldStrategy) bondAmount(ctx context.Context, amount sdk.Coins) (sdk.Coin, error) {
+	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(amount) != 1 || amount[0].Denom != bondDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "staking yield strategy only accepts %s, got %s", bondDenom, amount)
+	}
+
+	return amount[0], nil
+}
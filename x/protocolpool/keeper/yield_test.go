@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/keeper"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// mockYieldStrategy is a yield strategy holding the funds deposited, and
+// accruing a fixed yield.
+type mockYieldStrategy struct {
+	deposited sdk.Coins
+	yield     sdk.Coins
+}
+
+func (s *mockYieldStrategy) Deposit(_ context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	s.deposited = s.deposited.Add(amount...)
+	return nil
+}
+
+func (s *mockYieldStrategy) Withdraw(_ context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	s.deposited = s.deposited.Sub(amount...)
+	return nil
+}
+
+func (s *mockYieldStrategy) Deposited(context.Context, sdk.AccAddress) (sdk.Coins, error) {
+	return s.deposited, nil
+}
+
+func (s *mockYieldStrategy) Harvest(context.Context, sdk.AccAddress) (sdk.Coins, error) {
+	return s.yield, nil
+}
+
+func (suite *KeeperTestSuite) TestYieldStrategy() {
+	authority := suite.poolKeeper.GetAuthority()
+	strategy := &mockYieldStrategy{yield: sdk.NewCoins(sdk.NewInt64Coin("foo", 3))}
+	foo := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("foo", amount)) }
+	setCap := func(authority, name string, yieldCap math.LegacyDec) error {
+		_, err := suite.msgServer.SetYieldStrategyCap(suite.ctx, &types.MsgSetYieldStrategyCap{Authority: authority, Name: name, Cap: yieldCap})
+		return err
+	}
+	deposit := func(amount sdk.Coins) error {
+		_, err := suite.msgServer.DepositToYieldStrategy(suite.ctx, &types.MsgDepositToYieldStrategy{Authority: authority, Name: "staking", Amount: amount})
+		return err
+	}
+	withdraw := func(amount sdk.Coins) error {
+		_, err := suite.msgServer.WithdrawFromYieldStrategy(suite.ctx, &types.MsgWithdrawFromYieldStrategy{Authority: authority, Name: "staking", Amount: amount})
+		return err
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(poolAcc).AnyTimes()
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(foo(100)).AnyTimes()
+
+	suite.poolKeeper.RegisterYieldStrategy("staking", strategy)
+	suite.Require().Panics(func() { suite.poolKeeper.RegisterYieldStrategy("staking", strategy) })
+
+	// strategies are disabled until the module authority sets their cap
+	suite.Require().ErrorIs(deposit(foo(10)), types.ErrYieldStrategyDisabled)
+	suite.Require().ErrorIs(setCap(recipientAddr.String(), "staking", math.LegacyNewDecWithPrec(2, 1)), types.ErrInvalidSigner)
+	suite.Require().ErrorIs(setCap(authority, "staking", math.LegacyNewDecWithPrec(15, 1)), sdkerrors.ErrInvalidRequest)
+	suite.Require().ErrorIs(setCap(authority, "unknown", math.LegacyNewDecWithPrec(2, 1)), types.ErrYieldStrategyNotFound)
+	suite.Require().NoError(setCap(authority, "staking", math.LegacyNewDecWithPrec(2, 1)))
+
+	// deposits are capped by the fraction of the pool funds
+	suite.Require().ErrorIs(deposit(foo(21)), types.ErrYieldCap)
+	suite.Require().NoError(deposit(foo(20)))
+	suite.Require().Equal(foo(20), strategy.deposited)
+
+	// withdrawals are capped by the principal
+	suite.Require().ErrorIs(withdraw(foo(21)), types.ErrInsufficientPrincipal)
+	suite.Require().NoError(withdraw(foo(5)))
+
+	// the yield is accounted separately from the principal
+	harvestRes, err := suite.msgServer.HarvestYield(suite.ctx, &types.MsgHarvestYield{Sender: recipientAddr.String(), Name: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(foo(3), harvestRes.Amount)
+
+	expected := types.YieldStrategyInfo{
+		Name:      "staking",
+		Cap:       math.LegacyNewDecWithPrec(2, 1),
+		Principal: foo(15),
+		Harvested: foo(3),
+	}
+	queryServer := keeper.NewQuerier(suite.poolKeeper)
+	res, err := queryServer.YieldStrategy(suite.ctx, &types.QueryYieldStrategyRequest{Name: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.Strategy)
+	_, err = queryServer.YieldStrategy(suite.ctx, &types.QueryYieldStrategyRequest{Name: "unknown"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	allRes, err := queryServer.YieldStrategies(suite.ctx, &types.QueryYieldStrategiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.YieldStrategyInfo{expected}, allRes.Strategies)
+
+	// the state of the strategies is exported and imported in genesis
+	genState, err := suite.poolKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Equal([]types.YieldStrategyInfo{expected}, genState.YieldStrategies)
+	suite.SetupTest()
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(poolAcc).AnyTimes()
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(foo(100)).AnyTimes()
+	suite.Require().ErrorIs(suite.poolKeeper.InitGenesis(suite.ctx, genState), types.ErrYieldStrategyNotFound)
+	suite.poolKeeper.RegisterYieldStrategy("staking", strategy)
+	suite.Require().NoError(suite.poolKeeper.InitGenesis(suite.ctx, genState))
+	info, err := suite.poolKeeper.GetYieldStrategy(suite.ctx, "staking")
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, info)
+
+	// an emergency withdrawal writes off the principal lost and disables the strategy
+	strategy.deposited = foo(10)
+	emergencyRes, err := suite.msgServer.EmergencyWithdrawYieldStrategy(suite.ctx, &types.MsgEmergencyWithdrawYieldStrategy{Authority: authority, Name: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(foo(5), emergencyRes.Loss)
+	suite.Require().True(strategy.deposited.IsZero())
+
+	info, err = suite.poolKeeper.GetYieldStrategy(suite.ctx, "staking")
+	suite.Require().NoError(err)
+	suite.Require().True(info.Cap.IsZero())
+	suite.Require().True(info.Principal.IsZero())
+
+	suite.Require().ErrorIs(deposit(foo(10)), types.ErrYieldStrategyDisabled)
+}
+
+func (suite *KeeperTestSuite) TestValidateYieldStrategiesGenesis() {
+	foo := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+	for name, strategies := range map[string][]types.YieldStrategyInfo{
+		"empty name":        {{Cap: math.LegacyZeroDec()}},
+		"duplicate":         {{Name: "staking", Cap: math.LegacyZeroDec()}, {Name: "staking", Cap: math.LegacyZeroDec()}},
+		"cap above 1":       {{Name: "staking", Cap: math.LegacyNewDec(2)}},
+		"nil cap":           {{Name: "staking", Principal: foo}},
+		"invalid principal": {{Name: "staking", Cap: math.LegacyZeroDec(), Principal: sdk.Coins{sdk.Coin{Denom: "foo", Amount: math.NewInt(-1)}}}},
+	} {
+		genState := types.GenesisState{YieldStrategies: strategies}
+		suite.Require().Error(genState.Validate(), name)
+	}
+
+	genState := types.GenesisState{YieldStrategies: []types.YieldStrategyInfo{{Name: "staking", Cap: math.LegacyOneDec(), Principal: foo}}}
+	suite.Require().NoError(genState.Validate())
+}
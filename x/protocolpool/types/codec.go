@@ -13,6 +13,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCommunityPoolSpend{},
 		&MsgSubmitBudgetProposal{},
 		&MsgClaimBudget{},
+		&MsgSetYieldStrategyCap{},
+		&MsgDepositToYieldStrategy{},
+		&MsgWithdrawFromYieldStrategy{},
+		&MsgEmergencyWithdrawYieldStrategy{},
+		&MsgHarvestYield{},
+		&MsgCreateSubPool{},
+		&MsgSetSubPoolCeiling{},
+		&MsgAllocateToSubPool{},
+		&MsgDisburseFromSubPool{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -3,3 +3,18 @@ package types
 import "cosmossdk.io/errors"
 
 var ErrInvalidSigner = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
+
+var (
+	ErrSubPoolNotFound     = errors.Register(ModuleName, 3, "sub-pool not found")
+	ErrSubPoolExists       = errors.Register(ModuleName, 4, "sub-pool already exists")
+	ErrSubPoolCeiling      = errors.Register(ModuleName, 5, "allocation exceeds sub-pool budget ceiling")
+	ErrInsufficientSubPool = errors.Register(ModuleName, 6, "insufficient sub-pool balance")
+	ErrInsufficientPool    = errors.Register(ModuleName, 7, "insufficient unallocated community pool funds")
+)
+
+var (
+	ErrYieldStrategyNotFound = errors.Register(ModuleName, 8, "yield strategy not found")
+	ErrYieldStrategyDisabled = errors.Register(ModuleName, 9, "yield strategy disabled")
+	ErrYieldCap              = errors.Register(ModuleName, 10, "deposit exceeds yield strategy cap")
+	ErrInsufficientPrincipal = errors.Register(ModuleName, 11, "insufficient yield strategy principal")
+)
@@ -0,0 +1,17 @@
+package types
+
+// protocolpool module event types
+const (
+	EventTypeDonation = "donation"
+
+	AttributeKeyDonor      = "donor"
+	AttributeKeyTotalDonor = "donor_total"
+
+	EventTypeYieldDeposit           = "yield_deposit"
+	EventTypeYieldWithdraw          = "yield_withdraw"
+	EventTypeYieldHarvest           = "yield_harvest"
+	EventTypeYieldEmergencyWithdraw = "yield_emergency_withdraw"
+
+	AttributeKeyStrategy = "strategy"
+	AttributeKeyLoss     = "loss"
+)
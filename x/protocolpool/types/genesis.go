@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultGenesisState returns the default genesis state of the protocolpool
+// module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the protocolpool genesis state.
+func (gs GenesisState) Validate() error {
+	strategies := make(map[string]bool, len(gs.YieldStrategies))
+	for _, info := range gs.YieldStrategies {
+		if info.Name == "" {
+			return errors.New("yield strategy name cannot be empty")
+		}
+		if strategies[info.Name] {
+			return fmt.Errorf("duplicate yield strategy %s", info.Name)
+		}
+		strategies[info.Name] = true
+
+		if err := ValidateYieldStrategyCap(info.Cap); err != nil {
+			return fmt.Errorf("yield strategy %s: %w", info.Name, err)
+		}
+		if err := info.Principal.Validate(); err != nil {
+			return fmt.Errorf("yield strategy %s principal: %w", info.Name, err)
+		}
+		if err := info.Harvested.Validate(); err != nil {
+			return fmt.Errorf("yield strategy %s harvested: %w", info.Name, err)
+		}
+	}
+
+	subPools := make(map[string]bool, len(gs.SubPools))
+	for _, pool := range gs.SubPools {
+		if err := ValidateSubPoolName(pool.Name); err != nil {
+			return err
+		}
+		if subPools[pool.Name] {
+			return fmt.Errorf("duplicate sub-pool %s", pool.Name)
+		}
+		subPools[pool.Name] = true
+
+		if pool.Authority == "" {
+			return fmt.Errorf("sub-pool %s authority cannot be empty", pool.Name)
+		}
+		if err := pool.Ceiling.Validate(); err != nil {
+			return fmt.Errorf("sub-pool %s ceiling: %w", pool.Name, err)
+		}
+		if err := pool.Balance.Validate(); err != nil {
+			return fmt.Errorf("sub-pool %s balance: %w", pool.Name, err)
+		}
+	}
+
+	donations := make(map[string]bool, len(gs.Donations))
+	for _, donation := range gs.Donations {
+		if donation.Donor == "" {
+			return errors.New("donor cannot be empty")
+		}
+		if err := donation.Amount.Validate(); err != nil {
+			return fmt.Errorf("donation of %s: %w", donation.Donor, err)
+		}
+
+		key := donation.Donor + "/" + donation.Amount.Denom
+		if donations[key] {
+			return fmt.Errorf("duplicate donation of %s by %s", donation.Amount.Denom, donation.Donor)
+		}
+		donations[key] = true
+	}
+
+	return nil
+}
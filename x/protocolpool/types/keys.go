@@ -19,3 +19,25 @@ const (
 )
 
 var BudgetKey = collections.NewPrefix(2)
+
+// DonationsKey is the prefix under which cumulative donations are stored,
+// keyed by donor address and denom.
+var DonationsKey = collections.NewPrefix(3)
+
+var (
+	// SubPoolAuthorityKey is the prefix under which the authority of each sub-pool is stored.
+	SubPoolAuthorityKey = collections.NewPrefix(4)
+	// SubPoolCeilingKey is the prefix under which the budget ceiling of each sub-pool is stored, keyed by sub-pool name and denom.
+	SubPoolCeilingKey = collections.NewPrefix(5)
+	// SubPoolBalanceKey is the prefix under which the allocated balance of each sub-pool is stored, keyed by sub-pool name and denom.
+	SubPoolBalanceKey = collections.NewPrefix(6)
+)
+
+var (
+	// YieldCapKey is the prefix under which the cap of each enabled yield strategy is stored.
+	YieldCapKey = collections.NewPrefix(7)
+	// YieldPrincipalKey is the prefix under which the principal deposited to each yield strategy is stored, keyed by strategy name and denom.
+	YieldPrincipalKey = collections.NewPrefix(8)
+	// YieldHarvestedKey is the prefix under which the cumulative yield harvested from each yield strategy is stored, keyed by strategy name and denom.
+	YieldHarvestedKey = collections.NewPrefix(9)
+)
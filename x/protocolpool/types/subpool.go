@@ -0,0 +1,23 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxSubPoolNameLength is the maximum length of a sub-pool name.
+const MaxSubPoolNameLength = 64
+
+// ValidateSubPoolName checks that name can be used as a sub-pool name.
+func ValidateSubPoolName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("sub-pool name cannot be empty")
+	}
+
+	if len(name) > MaxSubPoolNameLength {
+		return fmt.Errorf("sub-pool name cannot be longer than %d characters", MaxSubPoolNameLength)
+	}
+
+	return nil
+}
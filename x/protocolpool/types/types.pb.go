@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -142,8 +144,215 @@ func (m *Budget) GetPeriod() *time.Duration {
 	return nil
 }
 
+// YieldStrategyInfo is the state of a registered yield strategy, the principal
+// deposited being accounted separately from the yield harvested.
+type YieldStrategyInfo struct {
+	// name is the name under which the strategy is registered.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cap is the maximum fraction of the community pool funds, including the
+	// principal deposited to all the strategies, the principal of the strategy
+	// can reach. The strategy is disabled if it is zero.
+	Cap cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cap"`
+	// principal is the amount deposited to the strategy and not withdrawn yet.
+	Principal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=principal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"principal"`
+	// harvested is the cumulative yield harvested from the strategy.
+	Harvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=harvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"harvested"`
+}
+
+func (m *YieldStrategyInfo) Reset()         { *m = YieldStrategyInfo{} }
+func (m *YieldStrategyInfo) String() string { return proto.CompactTextString(m) }
+func (*YieldStrategyInfo) ProtoMessage()    {}
+func (*YieldStrategyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+func (m *YieldStrategyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldStrategyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldStrategyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldStrategyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldStrategyInfo.Merge(m, src)
+}
+func (m *YieldStrategyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldStrategyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldStrategyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldStrategyInfo proto.InternalMessageInfo
+
+func (m *YieldStrategyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *YieldStrategyInfo) GetPrincipal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Principal
+	}
+	return nil
+}
+
+func (m *YieldStrategyInfo) GetHarvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Harvested
+	}
+	return nil
+}
+
+// SubPool is a portion of the community pool managed by its own authority,
+// typically a group policy account of a grants committee. Governance sets the
+// budget ceiling of the sub-pool and allocates community pool funds to it; the
+// sub-pool authority can then disburse its balance without a governance
+// proposal.
+type SubPool struct {
+	// name is the unique name of the sub-pool.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// authority is the address of the account which can disburse the balance of
+	// the sub-pool.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ceiling is the maximum balance of the sub-pool.
+	Ceiling github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=ceiling,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ceiling"`
+	// balance is the community pool funds allocated to the sub-pool and not
+	// disbursed yet.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *SubPool) Reset()         { *m = SubPool{} }
+func (m *SubPool) String() string { return proto.CompactTextString(m) }
+func (*SubPool) ProtoMessage()    {}
+func (*SubPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *SubPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubPool.Merge(m, src)
+}
+func (m *SubPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubPool proto.InternalMessageInfo
+
+func (m *SubPool) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *SubPool) GetCeiling() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Ceiling
+	}
+	return nil
+}
+
+func (m *SubPool) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// Donation is the cumulative amount of a denom given to the community pool by
+// a donor.
+type Donation struct {
+	// donor is the address of the donor.
+	Donor string `protobuf:"bytes,1,opt,name=donor,proto3" json:"donor,omitempty"`
+	// amount is the cumulative amount donated.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *Donation) Reset()         { *m = Donation{} }
+func (m *Donation) String() string { return proto.CompactTextString(m) }
+func (*Donation) ProtoMessage()    {}
+func (*Donation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *Donation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Donation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Donation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Donation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Donation.Merge(m, src)
+}
+func (m *Donation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Donation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Donation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Donation proto.InternalMessageInfo
+
+func (m *Donation) GetDonor() string {
+	if m != nil {
+		return m.Donor
+	}
+	return ""
+}
+
+func (m *Donation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
+	proto.RegisterType((*YieldStrategyInfo)(nil), "cosmos.protocolpool.v1.YieldStrategyInfo")
+	proto.RegisterType((*SubPool)(nil), "cosmos.protocolpool.v1.SubPool")
+	proto.RegisterType((*Donation)(nil), "cosmos.protocolpool.v1.Donation")
 }
 
 func init() {
@@ -151,35 +360,49 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
-	0x1c, 0xbd, 0xd0, 0xe3, 0x68, 0xdd, 0x1e, 0x7f, 0xa2, 0x0a, 0xb9, 0x19, 0xd2, 0xa3, 0x2c, 0xb7,
-	0xe0, 0xe8, 0x60, 0x60, 0x00, 0x09, 0x9a, 0x02, 0x62, 0x60, 0x3a, 0x98, 0x58, 0x2c, 0x27, 0xf1,
-	0x05, 0x8b, 0x24, 0xbf, 0xc8, 0xfe, 0xdd, 0xa9, 0x7c, 0x8b, 0x8e, 0x7c, 0x10, 0x3e, 0x04, 0x63,
-	0x85, 0x18, 0xd8, 0x40, 0x77, 0x5f, 0x04, 0xc5, 0x76, 0xaa, 0xd2, 0x85, 0x6e, 0xce, 0xfb, 0xbd,
-	0xf7, 0xf2, 0xfc, 0xfc, 0x23, 0x47, 0x39, 0x98, 0x1a, 0x4c, 0xd2, 0x6a, 0x40, 0xc8, 0xa1, 0x6a,
-	0x01, 0xaa, 0x64, 0x35, 0x4b, 0xf0, 0x4b, 0x2b, 0x0d, 0xb3, 0x68, 0x78, 0xdf, 0x71, 0xd8, 0x65,
-	0x0e, 0x5b, 0xcd, 0xa2, 0xfd, 0x12, 0x4a, 0xb0, 0x60, 0xd2, 0x9d, 0xdc, 0x3c, 0x3a, 0x70, 0x6c,
-	0xee, 0x06, 0x97, 0xa5, 0x51, 0xec, 0x7f, 0x96, 0x09, 0x23, 0x93, 0xd5, 0x2c, 0x93, 0x28, 0x66,
-	0x49, 0x0e, 0xaa, 0xf1, 0xf3, 0xc3, 0x12, 0xa0, 0xac, 0xa4, 0x0b, 0x93, 0x2d, 0x17, 0x09, 0xaa,
-	0x5a, 0x1a, 0x14, 0x75, 0xdb, 0x1b, 0x5c, 0x25, 0x14, 0x4b, 0x2d, 0x50, 0x81, 0x37, 0x38, 0xfa,
-	0xb9, 0x45, 0x46, 0xe9, 0xb2, 0x28, 0x25, 0x86, 0xaf, 0xc9, 0x3d, 0x2d, 0x73, 0xd5, 0x2a, 0xd9,
-	0x20, 0x17, 0x45, 0xa1, 0xa5, 0x31, 0x34, 0x98, 0x04, 0xd3, 0x9d, 0x94, 0xfe, 0xf8, 0xf6, 0x68,
-	0xdf, 0x07, 0x3b, 0x76, 0x93, 0xf7, 0xa8, 0x55, 0x53, 0xce, 0xef, 0x5e, 0x48, 0x3c, 0x1e, 0x3e,
-	0x27, 0x7b, 0x08, 0x28, 0x2a, 0x9e, 0x59, 0x5b, 0x7a, 0x63, 0x12, 0x4c, 0x77, 0x1f, 0x1f, 0x30,
-	0x2f, 0xef, 0x6e, 0xc2, 0xfc, 0x4d, 0xd8, 0x09, 0xa8, 0x66, 0xbe, 0x6b, 0xe9, 0x3e, 0xc4, 0x4b,
-	0x72, 0x3b, 0xaf, 0x84, 0xaa, 0x65, 0xc1, 0x45, 0x0d, 0xcb, 0x06, 0xe9, 0xd6, 0xff, 0xf4, 0x63,
-	0x2f, 0x38, 0xb6, 0xfc, 0xf0, 0x05, 0x21, 0x06, 0x85, 0x46, 0xde, 0x55, 0x41, 0x87, 0x56, 0x1d,
-	0x31, 0x57, 0x03, 0xeb, 0x6b, 0x60, 0x1f, 0xfa, 0x9e, 0xd2, 0xe1, 0xd9, 0xef, 0xc3, 0x60, 0xbe,
-	0x63, 0x35, 0x1d, 0x1a, 0xbe, 0x25, 0x77, 0x1a, 0x79, 0x8a, 0xdc, 0xda, 0xf2, 0x85, 0x86, 0x9a,
-	0xde, 0xbc, 0xa6, 0xcb, 0xb8, 0x13, 0x9e, 0x74, 0xba, 0x37, 0x1a, 0xea, 0x30, 0x22, 0xdb, 0xa8,
-	0x45, 0x93, 0x7f, 0x92, 0x86, 0x8e, 0x26, 0xc1, 0x74, 0x38, 0xbf, 0xf8, 0x0e, 0x1f, 0x92, 0x71,
-	0x7f, 0xe6, 0x95, 0x5c, 0x20, 0xbd, 0x65, 0x09, 0x7b, 0x3d, 0xf8, 0x4e, 0x2e, 0x30, 0x7c, 0x4a,
-	0x46, 0xad, 0xd4, 0x0a, 0x0a, 0xba, 0xed, 0x5b, 0xb8, 0x9a, 0xe0, 0x95, 0x7f, 0xce, 0x74, 0xf8,
-	0xb5, 0x0b, 0xe0, 0xe9, 0xe9, 0xb3, 0xef, 0xeb, 0x38, 0x38, 0x5f, 0xc7, 0xc1, 0x9f, 0x75, 0x1c,
-	0x9c, 0x6d, 0xe2, 0xc1, 0xf9, 0x26, 0x1e, 0xfc, 0xda, 0xc4, 0x83, 0x8f, 0x0f, 0x5c, 0x8f, 0xa6,
-	0xf8, 0xcc, 0x14, 0x24, 0xa7, 0xff, 0xae, 0xb1, 0xdd, 0xe1, 0x6c, 0x64, 0xb1, 0x27, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x07, 0x16, 0x5b, 0x67, 0xea, 0x02, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x93, 0x34, 0x6d, 0xa6, 0xed, 0x7b, 0xaf, 0x56, 0xf5, 0xe4, 0x06, 0x29, 0x09, 0x61,
+	0x93, 0x4d, 0x6d, 0x02, 0x12, 0x2c, 0x40, 0x82, 0xba, 0x01, 0x81, 0xd4, 0x05, 0x72, 0xd9, 0xc0,
+	0xc6, 0x1a, 0xdb, 0x13, 0x67, 0x54, 0xdb, 0xd7, 0x9a, 0x99, 0x44, 0xcd, 0xbf, 0xe8, 0x92, 0x3d,
+	0x3b, 0xd6, 0xfd, 0x05, 0xac, 0xba, 0xac, 0x2a, 0x16, 0x88, 0x45, 0x8b, 0xda, 0x3f, 0x82, 0x66,
+	0x3c, 0xee, 0x97, 0x10, 0x61, 0xd3, 0x95, 0xc7, 0x77, 0xce, 0x39, 0xf7, 0xce, 0xb9, 0x77, 0x06,
+	0xf5, 0x42, 0xe0, 0x29, 0x70, 0x27, 0x67, 0x20, 0x20, 0x84, 0x24, 0x07, 0x48, 0x9c, 0xe9, 0xc0,
+	0x11, 0xb3, 0x9c, 0x70, 0x5b, 0x45, 0xcd, 0xff, 0x0b, 0x8c, 0x7d, 0x1d, 0x63, 0x4f, 0x07, 0xad,
+	0xf5, 0x18, 0x62, 0x50, 0x41, 0x47, 0xae, 0x8a, 0xfd, 0xd6, 0x46, 0x81, 0xf6, 0x8b, 0x8d, 0xeb,
+	0xd4, 0x56, 0x5b, 0x27, 0x0b, 0x30, 0x27, 0xce, 0x74, 0x10, 0x10, 0x81, 0x07, 0x4e, 0x08, 0x34,
+	0xd3, 0xfb, 0x9d, 0x18, 0x20, 0x4e, 0x48, 0x51, 0x4c, 0x30, 0x19, 0x39, 0x82, 0xa6, 0x84, 0x0b,
+	0x9c, 0xe6, 0xa5, 0xc0, 0x6d, 0x40, 0x34, 0x61, 0x58, 0x50, 0xd0, 0x02, 0xbd, 0x6f, 0x35, 0xd4,
+	0x70, 0x27, 0x51, 0x4c, 0x84, 0xf9, 0x0a, 0xad, 0x31, 0x12, 0xd2, 0x9c, 0x92, 0x4c, 0xf8, 0x38,
+	0x8a, 0x18, 0xe1, 0xdc, 0x32, 0xba, 0x46, 0xbf, 0xe9, 0x5a, 0x27, 0x87, 0x9b, 0xeb, 0xba, 0xb0,
+	0xad, 0x62, 0x67, 0x57, 0x30, 0x9a, 0xc5, 0xde, 0x7f, 0x97, 0x14, 0x1d, 0x37, 0x9f, 0xa3, 0x15,
+	0x01, 0x02, 0x27, 0x7e, 0xa0, 0x64, 0xad, 0x6a, 0xd7, 0xe8, 0x2f, 0x3f, 0xda, 0xb0, 0x35, 0x5d,
+	0x9e, 0xc4, 0xd6, 0x27, 0xb1, 0xb7, 0x81, 0x66, 0xde, 0xb2, 0x82, 0xeb, 0x22, 0x5e, 0xa2, 0x7f,
+	0xc2, 0x04, 0xd3, 0x94, 0x44, 0x3e, 0x4e, 0x61, 0x92, 0x09, 0xab, 0x36, 0x8f, 0xbf, 0xaa, 0x09,
+	0x5b, 0x0a, 0x6f, 0xbe, 0x40, 0x88, 0x0b, 0xcc, 0x84, 0x2f, 0xad, 0xb0, 0xea, 0x8a, 0xdd, 0xb2,
+	0x0b, 0x1b, 0xec, 0xd2, 0x06, 0xfb, 0x7d, 0xe9, 0x93, 0x5b, 0x3f, 0x38, 0xeb, 0x18, 0x5e, 0x53,
+	0x71, 0x64, 0xd4, 0x7c, 0x83, 0xfe, 0xcd, 0xc8, 0xbe, 0xf0, 0x95, 0xac, 0x3f, 0x62, 0x90, 0x5a,
+	0x0b, 0x7f, 0xa9, 0xb2, 0x2a, 0x89, 0xdb, 0x92, 0xf7, 0x9a, 0x41, 0x6a, 0xb6, 0xd0, 0x92, 0x60,
+	0x38, 0x0b, 0xc7, 0x84, 0x5b, 0x8d, 0xae, 0xd1, 0xaf, 0x7b, 0x97, 0xff, 0xe6, 0x03, 0xb4, 0x5a,
+	0xae, 0xfd, 0x84, 0x8c, 0x84, 0xb5, 0xa8, 0x00, 0x2b, 0x65, 0x70, 0x87, 0x8c, 0x84, 0xf9, 0x14,
+	0x35, 0x72, 0xc2, 0x28, 0x44, 0xd6, 0x92, 0x76, 0xe1, 0x76, 0x05, 0x43, 0xdd, 0x4e, 0xb7, 0xfe,
+	0x49, 0x16, 0xa0, 0xe1, 0xbd, 0xaf, 0x55, 0xb4, 0xf6, 0x81, 0x92, 0x24, 0xda, 0x15, 0x0c, 0x0b,
+	0x12, 0xcf, 0xde, 0x66, 0x23, 0x30, 0x4d, 0x54, 0xcf, 0x70, 0x4a, 0x8a, 0xa6, 0x7a, 0x6a, 0x6d,
+	0x6e, 0xa3, 0x5a, 0x88, 0x73, 0xd5, 0xa5, 0xa6, 0x3b, 0x38, 0x3a, 0xed, 0x54, 0x7e, 0x9c, 0x76,
+	0xee, 0x15, 0x66, 0xf3, 0x68, 0xcf, 0xa6, 0xe0, 0xa4, 0x58, 0x8c, 0xed, 0x1d, 0x12, 0xe3, 0x70,
+	0x36, 0x24, 0xe1, 0xc9, 0xe1, 0x26, 0xd2, 0xbd, 0x18, 0x92, 0xd0, 0x93, 0x6c, 0x93, 0xa2, 0x66,
+	0xce, 0x68, 0x16, 0xd2, 0x1c, 0x27, 0x56, 0xad, 0x5b, 0xfb, 0x63, 0xc3, 0xdc, 0x87, 0x32, 0xcb,
+	0x97, 0xb3, 0x4e, 0x3f, 0xa6, 0x62, 0x3c, 0x09, 0xec, 0x10, 0x52, 0x3d, 0xf5, 0xfa, 0xb3, 0xc9,
+	0xa3, 0x3d, 0x7d, 0x9f, 0x24, 0x81, 0x7b, 0x57, 0xea, 0x32, 0xd5, 0x18, 0xb3, 0x29, 0xe1, 0x82,
+	0x44, 0x56, 0xfd, 0x0e, 0x52, 0x5d, 0xaa, 0xf7, 0x3e, 0x57, 0xd1, 0xe2, 0xee, 0x24, 0x78, 0x07,
+	0x90, 0xfc, 0xd6, 0xba, 0x27, 0xa8, 0x89, 0x27, 0x62, 0x0c, 0x8c, 0x8a, 0x99, 0x55, 0x9d, 0x73,
+	0x51, 0xae, 0xa0, 0x26, 0x41, 0x8b, 0x21, 0xa1, 0x09, 0xcd, 0xe2, 0xbb, 0xf0, 0xaa, 0xd4, 0x96,
+	0x69, 0x02, 0x9c, 0xe0, 0x2c, 0x24, 0x77, 0xe1, 0x53, 0xa9, 0xdd, 0xe3, 0x68, 0x69, 0x08, 0x99,
+	0x1a, 0x42, 0xd3, 0x46, 0x0b, 0x11, 0x64, 0xc0, 0xe6, 0x3e, 0x1b, 0x05, 0x4c, 0xce, 0xb7, 0xbe,
+	0xe5, 0xf3, 0x5e, 0x09, 0xb7, 0x2e, 0x2b, 0xf4, 0x34, 0xdc, 0x7d, 0x76, 0x74, 0xde, 0x36, 0x8e,
+	0xcf, 0xdb, 0xc6, 0xcf, 0xf3, 0xb6, 0x71, 0x70, 0xd1, 0xae, 0x1c, 0x5f, 0xb4, 0x2b, 0xdf, 0x2f,
+	0xda, 0x95, 0x8f, 0xf7, 0x6f, 0x8c, 0xee, 0xfe, 0xcd, 0x67, 0x5a, 0x1d, 0x20, 0x68, 0xa8, 0xd8,
+	0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x95, 0xff, 0x18, 0xca, 0x05, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -276,6 +499,179 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *YieldStrategyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldStrategyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldStrategyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Harvested) > 0 {
+		for iNdEx := len(m.Harvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Harvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Principal) > 0 {
+		for iNdEx := len(m.Principal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Principal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Ceiling) > 0 {
+		for iNdEx := len(m.Ceiling) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ceiling[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Donation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Donation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Donation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Donor) > 0 {
+		i -= len(m.Donor)
+		copy(dAtA[i:], m.Donor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Donor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -326,6 +722,77 @@ func (m *Budget) Size() (n int) {
 	return n
 }
 
+func (m *YieldStrategyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Principal) > 0 {
+		for _, e := range m.Principal {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Harvested) > 0 {
+		for _, e := range m.Harvested {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Ceiling) > 0 {
+		for _, e := range m.Ceiling {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Donation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Donor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -632,6 +1099,487 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *YieldStrategyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldStrategyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldStrategyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = append(m.Principal, types.Coin{})
+			if err := m.Principal[len(m.Principal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Harvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Harvested = append(m.Harvested, types.Coin{})
+			if err := m.Harvested[len(m.Harvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceiling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ceiling = append(m.Ceiling, types.Coin{})
+			if err := m.Ceiling[len(m.Ceiling)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Donation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Donation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Donation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Donor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Donor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// YieldStrategy puts idle community pool funds to work, e.g. by delegating
+// them to a validator, the funds staying owned by the pool account. Strategies
+// are registered by the app, and the module authority sets how much of the
+// community pool each of them can be deposited.
+type YieldStrategy interface {
+	// Deposit moves amount from the pool account into the strategy.
+	Deposit(ctx context.Context, pool sdk.AccAddress, amount sdk.Coins) error
+	// Withdraw moves amount out of the strategy back to the pool account. The
+	// funds may be returned asynchronously, e.g. after an unbonding period.
+	Withdraw(ctx context.Context, pool sdk.AccAddress, amount sdk.Coins) error
+	// Deposited returns the funds of the pool account which can currently be
+	// withdrawn from the strategy, which may be less than the principal
+	// deposited after a loss, e.g. a slashing.
+	Deposited(ctx context.Context, pool sdk.AccAddress) (sdk.Coins, error)
+	// Harvest sends the yield accrued by the funds deposited to the pool
+	// account, and returns it.
+	Harvest(ctx context.Context, pool sdk.AccAddress) (sdk.Coins, error)
+}
+
+// ValidateYieldStrategyCap checks that yieldCap is a valid yield strategy cap,
+// between 0 and 1.
+func ValidateYieldStrategyCap(yieldCap math.LegacyDec) error {
+	if yieldCap.IsNil() || yieldCap.IsNegative() || yieldCap.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("yield strategy cap must be between 0 and 1, got %s", yieldCap)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package randomness
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/randomness/keeper"
+	"cosmossdk.io/x/randomness/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The beacon works in three steps:
+//
+//   - at height H-1, each validator extends its precommit vote with
+//     types.EntropySize bytes of local randomness.
+//   - at height H, the proposer injects the extended commit info of height H-1
+//     at the top of its proposal as a beacon tx, which the other validators
+//     verify in ProcessProposal.
+//   - the PreBlocker mixes the entropy of the beacon tx into the seed of height
+//     H, before any module runs.
+//
+// The beacon tx is not a valid transaction: its execution fails without
+// effect and it is reported as a failed tx in the block results.
+
+// ExtendVoteHandler returns the handler extending votes with entropy.
+func ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(_ sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		entropy := make([]byte, types.EntropySize)
+		if _, err := rand.Read(entropy); err != nil {
+			return nil, fmt.Errorf("failed to generate entropy: %w", err)
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: entropy}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler rejecting vote extensions
+// which are not types.EntropySize bytes of entropy.
+func VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) != types.EntropySize {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// NewPrepareProposalHandler wraps next so that the proposal starts with the
+// beacon tx carrying the vote extensions of the previous height. The beacon tx
+// is only injected when vote extensions are enabled for the previous height.
+func NewPrepareProposalHandler(valStore baseapp.ValidatorStore, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		beaconTx, err := encodeBeaconTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		// leave room for the beacon tx in the proposal
+		req.MaxTxBytes -= int64(len(beaconTx))
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{beaconTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// NewProcessProposalHandler wraps next so that proposals are rejected unless
+// they start with a valid beacon tx when vote extensions are enabled for the
+// previous height. next processes the proposal without the beacon tx.
+func NewProcessProposalHandler(valStore baseapp.ValidatorStore, next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			return reject, nil
+		}
+
+		extCommit, err := decodeBeaconTx(req.Txs[0])
+		if err != nil {
+			return reject, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), *extCommit); err != nil {
+			return reject, nil
+		}
+
+		txs := req.Txs
+		req.Txs = txs[1:]
+		defer func() { req.Txs = txs }()
+
+		return next(ctx, req)
+	}
+}
+
+// NewPreBlocker wraps next so that the seed of the block is updated before any
+// module runs.
+func NewPreBlocker(k keeper.Keeper, next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		var extCommit *abci.ExtendedCommitInfo
+		if len(req.Txs) > 0 && bytes.HasPrefix(req.Txs[0], types.BeaconTxPrefix) {
+			var err error
+			if extCommit, err = decodeBeaconTx(req.Txs[0]); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := k.UpdateSeed(ctx, extCommit); err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// voteExtensionsEnabled returns whether the votes of the height before height
+// carry vote extensions.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+func encodeBeaconTx(extCommit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, types.BeaconTxPrefix...), bz...), nil
+}
+
+func decodeBeaconTx(tx []byte) (*abci.ExtendedCommitInfo, error) {
+	if !bytes.HasPrefix(tx, types.BeaconTxPrefix) {
+		return nil, errorsmod.Wrap(types.ErrInvalidBeaconTx, "missing beacon tx prefix")
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(tx[len(types.BeaconTxPrefix):]); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBeaconTx, err.Error())
+	}
+
+	return &extCommit, nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/randomness/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper maintains a per-block random seed, derived from the previous seed and
+// the entropy validators contribute through their vote extensions, from which
+// modules draw domain-separated randomness.
+//
+// The seed is deterministic and public once the block is committed: it must
+// not be used where outcomes must stay secret until revealed, and the last
+// validators to sign can still choose whether to contribute, so it offers no
+// protection against a validator biasing outcomes it gains from.
+type Keeper struct {
+	// history is the number of seeds retained, 0 retaining all of them
+	history int64
+
+	Schema collections.Schema
+	// Seeds key: height, value: seed of the block
+	Seeds collections.Map[int64, []byte]
+	// LatestSeed is the height of the latest seed
+	LatestSeed collections.Item[int64]
+}
+
+// NewKeeper returns a randomness keeper retaining the seeds of the last history
+// blocks, or of all blocks if history is 0.
+func NewKeeper(storeService storetypes.KVStoreService, history int64) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		history:    history,
+		Seeds:      collections.NewMap(sb, types.SeedKey, "seeds", collections.Int64Key, collections.BytesValue),
+		LatestSeed: collections.NewItem(sb, types.LatestSeedKey, "latest_seed", collections.Int64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// UpdateSeed derives the seed of the current block from the seed of the
+// previous block and the entropy of the commit votes of extCommit, which can be
+// nil when vote extensions are not available, and stores it. It returns the
+// number of entropy contributions mixed in.
+func (k Keeper) UpdateSeed(ctx context.Context, extCommit *abci.ExtendedCommitInfo) (int, error) {
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+
+	_, prev, err := k.GetLatestSeed(ctx)
+	if err != nil && !errors.Is(err, types.ErrSeedNotFound) {
+		return 0, err
+	}
+
+	h := sha256.New()
+	h.Write(prev)
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(height)))
+
+	var contributions int
+	if extCommit != nil {
+		for _, vote := range extCommit.Votes {
+			if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) != types.EntropySize {
+				continue
+			}
+
+			h.Write(vote.Validator.Address)
+			h.Write(vote.VoteExtension)
+			contributions++
+		}
+	}
+
+	seed := h.Sum(nil)
+	if err := k.Seeds.Set(ctx, height, seed); err != nil {
+		return 0, err
+	}
+
+	if err := k.LatestSeed.Set(ctx, height); err != nil {
+		return 0, err
+	}
+
+	if k.history > 0 && height > k.history {
+		if err := k.Seeds.Clear(ctx, new(collections.Range[int64]).EndExclusive(height-k.history+1)); err != nil {
+			return 0, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNewSeed,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+			sdk.NewAttribute(types.AttributeKeySeed, hex.EncodeToString(seed)),
+			sdk.NewAttribute(types.AttributeKeyContributions, strconv.Itoa(contributions)),
+		),
+	)
+
+	return contributions, nil
+}
+
+// GetSeed returns the seed of the block at height.
+func (k Keeper) GetSeed(ctx context.Context, height int64) ([]byte, error) {
+	seed, err := k.Seeds.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrSeedNotFound, "height %d", height)
+	}
+
+	return seed, err
+}
+
+// GetLatestSeed returns the latest seed and its height.
+func (k Keeper) GetLatestSeed(ctx context.Context) (int64, []byte, error) {
+	height, err := k.LatestSeed.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil, types.ErrSeedNotFound
+	} else if err != nil {
+		return 0, nil, err
+	}
+
+	seed, err := k.GetSeed(ctx, height)
+	return height, seed, err
+}
+
+// Draw returns 32 random bytes derived from the seed of the current block,
+// domain and nonce. Modules pass their own domain, such as their module name,
+// so that their draws are independent from the draws of other modules, and a
+// distinct nonce for each draw in a block: the same domain and nonce always
+// yield the same bytes within a block.
+func (k Keeper) Draw(ctx context.Context, domain string, nonce []byte) ([]byte, error) {
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	seed, err := k.GetSeed(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(seed)
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(domain))))
+	h.Write([]byte(domain))
+	h.Write(nonce)
+
+	return h.Sum(nil), nil
+}
+
+// DrawUint64 returns a random integer in [0, bound) drawn as with Draw. The
+// bias of the modulo reduction is below bound/2^256, hence negligible.
+func (k Keeper) DrawUint64(ctx context.Context, domain string, nonce []byte, bound uint64) (uint64, error) {
+	if bound == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidDrawBound, "bound must be positive")
+	}
+
+	bz, err := k.Draw(ctx, domain, nonce)
+	if err != nil {
+		return 0, err
+	}
+
+	n := new(big.Int).SetBytes(bz)
+	return n.Mod(n, new(big.Int).SetUint64(bound)).Uint64(), nil
+}
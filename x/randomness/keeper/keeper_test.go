@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/randomness/keeper"
+	"cosmossdk.io/x/randomness/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func extCommit(entropy ...byte) *abci.ExtendedCommitInfo {
+	info := &abci.ExtendedCommitInfo{}
+	for i, b := range entropy {
+		info.Votes = append(info.Votes, abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: []byte{byte(i)}, Power: 1},
+			VoteExtension: bytes.Repeat([]byte{b}, types.EntropySize),
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	return info
+}
+
+func TestRandomness(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	k := keeper.NewKeeper(runtime.NewKVStoreService(key), 2)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+	_, err := k.Draw(ctx, "lottery", nil)
+	require.ErrorIs(t, err, types.ErrSeedNotFound)
+
+	contributions, err := k.UpdateSeed(ctx, nil)
+	require.NoError(t, err)
+	require.Zero(t, contributions)
+	seed1, err := k.GetSeed(ctx, 1)
+	require.NoError(t, err)
+
+	// only the entropy of commit votes is mixed in
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+	info := extCommit(1, 2, 3)
+	info.Votes[2].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	contributions, err = k.UpdateSeed(ctx, info)
+	require.NoError(t, err)
+	require.Equal(t, 2, contributions)
+
+	height, seed2, err := k.GetLatestSeed(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+	require.NotEqual(t, seed1, seed2)
+
+	// draws are deterministic and domain separated
+	a, err := k.Draw(ctx, "lottery", []byte{0})
+	require.NoError(t, err)
+	b, err := k.Draw(ctx, "lottery", []byte{0})
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+	c, err := k.Draw(ctx, "nft", []byte{0})
+	require.NoError(t, err)
+	require.NotEqual(t, a, c)
+	d, err := k.Draw(ctx, "lottery", []byte{1})
+	require.NoError(t, err)
+	require.NotEqual(t, a, d)
+
+	n, err := k.DrawUint64(ctx, "lottery", []byte{0}, 10)
+	require.NoError(t, err)
+	require.Less(t, n, uint64(10))
+	_, err = k.DrawUint64(ctx, "lottery", []byte{0}, 0)
+	require.ErrorIs(t, err, types.ErrInvalidDrawBound)
+
+	// the seeds of the last 2 blocks are retained
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3})
+	_, err = k.UpdateSeed(ctx, extCommit(4))
+	require.NoError(t, err)
+	_, err = k.GetSeed(ctx, 1)
+	require.ErrorIs(t, err, types.ErrSeedNotFound)
+	_, err = k.GetSeed(ctx, 2)
+	require.NoError(t, err)
+}
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/randomness module sentinel errors
+var (
+	ErrInvalidEntropy   = errors.Register(ModuleName, 2, "invalid entropy")
+	ErrSeedNotFound     = errors.Register(ModuleName, 3, "seed not found")
+	ErrInvalidBeaconTx  = errors.Register(ModuleName, 4, "invalid beacon tx")
+	ErrInvalidDrawBound = errors.Register(ModuleName, 5, "invalid draw bound")
+)
@@ -0,0 +1,9 @@
+package types
+
+// x/randomness module event types and attributes
+const (
+	EventTypeNewSeed          = "new_seed"
+	AttributeKeyHeight        = "height"
+	AttributeKeySeed          = "seed"
+	AttributeKeyContributions = "contributions"
+)
@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/randomness module.
+	ModuleName = "randomness"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+
+	// EntropySize is the size in bytes of the entropy a validator contributes
+	// through its vote extension.
+	EntropySize = 32
+)
+
+var (
+	SeedKey       = collections.NewPrefix(0)
+	LatestSeedKey = collections.NewPrefix(1)
+)
+
+// BeaconTxPrefix prefixes the pseudo-transaction injected by the proposer at
+// the top of the block, which carries the vote extensions of the previous
+// height.
+var BeaconTxPrefix = []byte("randomness/beacon:")
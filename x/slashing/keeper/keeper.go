@@ -6,6 +6,7 @@ import (
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -34,6 +35,8 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// DoubleSignDecay value: the start and cutoff of the decay, in nanoseconds
+	DoubleSignDecay collections.Item[collections.Pair[int64, int64]]
 }
 
 // NewKeeper creates a slashing keeper
@@ -67,6 +70,12 @@ func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, storeServi
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		DoubleSignDecay: collections.NewItem(
+			sb,
+			types.DoubleSignDecayKey,
+			"double_sign_decay",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Int64Key, collections.Int64Key)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -112,6 +112,42 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 	s.Require().NoError(s.slashingKeeper.Jail(s.ctx, consAddr))
 }
 
+func (s *KeeperTestSuite) TestDoubleSignDecay() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	fraction, err := keeper.SlashFractionDoubleSign(ctx)
+	require.NoError(err)
+
+	// without decay, the full fraction is slashed whatever the age
+	decayed, err := keeper.DecayedSlashFractionDoubleSign(ctx, 1000*time.Hour)
+	require.NoError(err)
+	require.Equal(fraction.String(), decayed.String())
+
+	decay := slashingtypes.DoubleSignDecay{Start: time.Hour, Cutoff: 3 * time.Hour}
+	require.ErrorIs(keeper.SetDoubleSignDecay(ctx, "invalid", decay), slashingtypes.ErrInvalidSigner)
+	require.Error(keeper.SetDoubleSignDecay(ctx, keeper.GetAuthority(), slashingtypes.DoubleSignDecay{Start: time.Hour}))
+	require.NoError(keeper.SetDoubleSignDecay(ctx, keeper.GetAuthority(), decay))
+
+	stored, err := keeper.GetDoubleSignDecay(ctx)
+	require.NoError(err)
+	require.Equal(decay, stored)
+
+	decayed, err = keeper.DecayedSlashFractionDoubleSign(ctx, 2*time.Hour)
+	require.NoError(err)
+	require.Equal(fraction.QuoInt64(2).String(), decayed.String())
+
+	decayed, err = keeper.DecayedSlashFractionDoubleSign(ctx, 3*time.Hour)
+	require.NoError(err)
+	require.True(decayed.IsZero())
+
+	// the zero value disables the decay
+	require.NoError(keeper.SetDoubleSignDecay(ctx, keeper.GetAuthority(), slashingtypes.DoubleSignDecay{}))
+	decayed, err = keeper.DecayedSlashFractionDoubleSign(ctx, 3*time.Hour)
+	require.NoError(err)
+	require.Equal(fraction.String(), decayed.String())
+}
+
 func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
@@ -2,9 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
@@ -40,6 +46,55 @@ func (k Keeper) SlashFractionDoubleSign(ctx context.Context) (sdkmath.LegacyDec,
 	return params.SlashFractionDoubleSign, err
 }
 
+// DecayedSlashFractionDoubleSign - fraction of power slashed in case of a
+// double sign committed age ago, decayed by the double sign decay
+func (k Keeper) DecayedSlashFractionDoubleSign(ctx context.Context, age time.Duration) (sdkmath.LegacyDec, error) {
+	fraction, err := k.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	decay, err := k.GetDoubleSignDecay(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	return decay.Apply(fraction, age), nil
+}
+
+// GetDoubleSignDecay returns the decay of the fraction slashed for a double
+// sign with the age of the infraction, disabled if not set.
+func (k Keeper) GetDoubleSignDecay(ctx context.Context) (types.DoubleSignDecay, error) {
+	decay, err := k.DoubleSignDecay.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DoubleSignDecay{}, nil
+		}
+		return types.DoubleSignDecay{}, err
+	}
+
+	return types.DoubleSignDecay{Start: time.Duration(decay.K1()), Cutoff: time.Duration(decay.K2())}, nil
+}
+
+// SetDoubleSignDecay sets the decay of the fraction slashed for a double sign
+// with the age of the infraction. The zero value disables it. Only the module
+// authority can set it.
+func (k Keeper) SetDoubleSignDecay(ctx context.Context, authority string, decay types.DoubleSignDecay) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	if err := decay.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !decay.Enabled() {
+		return k.DoubleSignDecay.Remove(ctx)
+	}
+
+	return k.DoubleSignDecay.Set(ctx, collections.Join(int64(decay.Start), int64(decay.Cutoff)))
+}
+
 // SlashFractionDowntime - fraction of power slashed for downtime
 func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
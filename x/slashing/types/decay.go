@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DoubleSignDecay makes the fraction slashed for a double sign decay with the
+// age of the infraction, so that old equivocations, whose evidence may have
+// been withheld, slash progressively less. Infractions up to Start old are
+// slashed the full fraction, which then decays linearly down to nothing for
+// infractions Cutoff old or older. The zero value disables the decay.
+type DoubleSignDecay struct {
+	Start  time.Duration
+	Cutoff time.Duration
+}
+
+// Enabled reports whether the decay is enabled.
+func (d DoubleSignDecay) Enabled() bool {
+	return d != DoubleSignDecay{}
+}
+
+// Validate returns an error if the decay is enabled with invalid durations.
+func (d DoubleSignDecay) Validate() error {
+	if !d.Enabled() {
+		return nil
+	}
+
+	if d.Start < 0 {
+		return fmt.Errorf("double sign decay start cannot be negative: %s", d.Start)
+	}
+
+	if d.Cutoff <= d.Start {
+		return fmt.Errorf("double sign decay cutoff must be after its start: cutoff %s, start %s", d.Cutoff, d.Start)
+	}
+
+	return nil
+}
+
+// Apply returns the fraction slashed for an infraction of the given age. The
+// decayed fraction is computed with integer math on the nanoseconds of the
+// durations, truncated, so that it never exceeds the linear decay.
+func (d DoubleSignDecay) Apply(fraction math.LegacyDec, age time.Duration) math.LegacyDec {
+	if !d.Enabled() || age <= d.Start {
+		return fraction
+	}
+
+	if age >= d.Cutoff {
+		return math.LegacyZeroDec()
+	}
+
+	return fraction.MulInt64(int64(d.Cutoff - age)).QuoInt64(int64(d.Cutoff - d.Start))
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+)
+
+func TestDoubleSignDecay(t *testing.T) {
+	fraction := math.LegacyNewDecWithPrec(5, 2)
+
+	disabled := types.DoubleSignDecay{}
+	require.NoError(t, disabled.Validate())
+	require.Equal(t, fraction.String(), disabled.Apply(fraction, 1000*time.Hour).String())
+
+	require.Error(t, types.DoubleSignDecay{Start: -time.Hour, Cutoff: time.Hour}.Validate())
+	require.Error(t, types.DoubleSignDecay{Start: time.Hour, Cutoff: time.Hour}.Validate())
+
+	decay := types.DoubleSignDecay{Start: 24 * time.Hour, Cutoff: 72 * time.Hour}
+	require.NoError(t, decay.Validate())
+
+	testCases := []struct {
+		age      time.Duration
+		expected math.LegacyDec
+	}{
+		{0, fraction},
+		{24 * time.Hour, fraction},
+		{36 * time.Hour, math.LegacyNewDecWithPrec(375, 4)},
+		{48 * time.Hour, math.LegacyNewDecWithPrec(25, 3)},
+		{72*time.Hour - time.Nanosecond, math.LegacyNewDecWithPrec(5, 2).MulInt64(1).QuoInt64(int64(48 * time.Hour))},
+		{72 * time.Hour, math.LegacyZeroDec()},
+		{1000 * time.Hour, math.LegacyZeroDec()},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected.String(), decay.Apply(fraction, tc.age).String(), tc.age)
+	}
+}
@@ -1,14 +1,20 @@
 package staking
 
 import (
+	"encoding/json"
 	"fmt"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -55,9 +61,35 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStatePendingStakeFlows(data.PendingStakeFlows, data.PendingStakeFlowId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStatePendingStakeFlows(flows []types.PendingStakeFlow, nextID uint64) error {
+	for i, pending := range flows {
+		if i > 0 && pending.Id <= flows[i-1].Id {
+			return fmt.Errorf("pending stake flows are not in queue order: %d after %d", pending.Id, flows[i-1].Id)
+		}
+
+		if pending.Id >= nextID {
+			return fmt.Errorf("pending stake flow id %d is not below the next id %d", pending.Id, nextID)
+		}
+
+		if pending.DelegatorAddress == "" || pending.ValidatorAddress == "" {
+			return fmt.Errorf("pending stake flow %d has an empty delegator or validator address", pending.Id)
+		}
+
+		if pending.Amount.IsNil() || pending.Amount.IsZero() {
+			return fmt.Errorf("pending stake flow %d has a zero amount", pending.Id)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -95,3 +127,70 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+// ValidatePoolBalances checks that the balances of the bonded and not bonded
+// pools in the bank genesis state hold the tokens of the validators, unbonding
+// delegations and queued delegations of the staking genesis state, as asserted
+// by InitGenesis.
+func ValidatePoolBalances(cdc codec.JSONCodec, addressCodec address.Codec, genesisData map[string]json.RawMessage) error {
+	var stakingGenesis types.GenesisState
+	if err := cdc.UnmarshalJSON(genesisData[types.ModuleName], &stakingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisData[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+
+	bondedTokens := math.ZeroInt()
+	notBondedTokens := math.ZeroInt()
+	for _, validator := range stakingGenesis.Validators {
+		if validator.IsBonded() {
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		} else {
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		}
+	}
+
+	for _, ubd := range stakingGenesis.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	for _, pending := range stakingGenesis.PendingStakeFlows {
+		if pending.IsDelegation() {
+			notBondedTokens = notBondedTokens.Add(pending.Amount)
+		}
+	}
+
+	bondDenom := stakingGenesis.Params.BondDenom
+	for _, pool := range []struct {
+		name   string
+		tokens math.Int
+	}{
+		{types.BondedPoolName, bondedTokens},
+		{types.NotBondedPoolName, notBondedTokens},
+	} {
+		addr, err := addressCodec.BytesToString(authtypes.NewModuleAddress(pool.name))
+		if err != nil {
+			return err
+		}
+
+		balance := sdk.NewCoins()
+		for _, b := range bankGenesis.Balances {
+			if b.Address == addr {
+				balance = sdk.NewCoins(b.Coins...)
+				break
+			}
+		}
+
+		expected := sdk.NewCoins(sdk.NewCoin(bondDenom, pool.tokens))
+		if !balance.Equal(expected) {
+			return fmt.Errorf("%s pool balance is different from its tokens: %s <-> %s", pool.name, balance, expected)
+		}
+	}
+
+	return nil
+}
@@ -1,17 +1,23 @@
 package staking_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/staking"
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -41,6 +47,23 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate pending stake flows
+		{"pending stake flows", func(data *types.GenesisState) {
+			data.PendingStakeFlows = []types.PendingStakeFlow{pendingFlow(1, 10), pendingFlow(3, -5)}
+			data.PendingStakeFlowId = 4
+		}, false},
+		{"pending stake flows out of order", func(data *types.GenesisState) {
+			data.PendingStakeFlows = []types.PendingStakeFlow{pendingFlow(3, 10), pendingFlow(1, -5)}
+			data.PendingStakeFlowId = 4
+		}, true},
+		{"pending stake flow id not below the next id", func(data *types.GenesisState) {
+			data.PendingStakeFlows = []types.PendingStakeFlow{pendingFlow(4, 10)}
+			data.PendingStakeFlowId = 4
+		}, true},
+		{"pending stake flow with a zero amount", func(data *types.GenesisState) {
+			data.PendingStakeFlows = []types.PendingStakeFlow{pendingFlow(1, 0)}
+			data.PendingStakeFlowId = 4
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -58,3 +81,49 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func pendingFlow(id uint64, amount int64) types.PendingStakeFlow {
+	return types.PendingStakeFlow{
+		Id:               id,
+		DelegatorAddress: sdk.AccAddress("delegator").String(),
+		ValidatorAddress: sdk.ValAddress("validator").String(),
+		Amount:           math.NewInt(amount),
+	}
+}
+
+func TestValidatePoolBalances(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	addressCodec := address.NewBech32Codec("cosmos")
+
+	bondedPool, err := addressCodec.BytesToString(authtypes.NewModuleAddress(types.BondedPoolName))
+	require.NoError(t, err)
+
+	stakingGenesis := types.DefaultGenesisState()
+	genesisData := func(balances ...banktypes.Balance) map[string]json.RawMessage {
+		bankGenesis := banktypes.DefaultGenesisState()
+		bankGenesis.Balances = balances
+		return map[string]json.RawMessage{
+			types.ModuleName:     cdc.MustMarshalJSON(stakingGenesis),
+			banktypes.ModuleName: cdc.MustMarshalJSON(bankGenesis),
+		}
+	}
+
+	require.NoError(t, staking.ValidatePoolBalances(cdc, addressCodec, genesisData()))
+
+	// the bonded pool holds tokens while there are no bonded validators
+	err = staking.ValidatePoolBalances(cdc, addressCodec, genesisData(banktypes.Balance{
+		Address: bondedPool,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(stakingGenesis.Params.BondDenom, 10)),
+	}))
+	require.ErrorContains(t, err, "bonded_tokens_pool pool balance is different from its tokens")
+
+	// queued delegations are escrowed in the not bonded pool
+	notBondedPool, err := addressCodec.BytesToString(authtypes.NewModuleAddress(types.NotBondedPoolName))
+	require.NoError(t, err)
+
+	stakingGenesis.PendingStakeFlows = []types.PendingStakeFlow{pendingFlow(0, 10), pendingFlow(1, -5)}
+	require.NoError(t, staking.ValidatePoolBalances(cdc, addressCodec, genesisData(banktypes.Balance{
+		Address: notBondedPool,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(stakingGenesis.Params.BondDenom, 10)),
+	})))
+}
@@ -12,10 +12,15 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter. It then executes
+// the delegations and undelegations queued by the stake flow limit.
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	return k.TrackHistoricalInfo(ctx)
+	if err := k.TrackHistoricalInfo(ctx); err != nil {
+		return err
+	}
+
+	return k.ProcessPendingStakeFlows(ctx)
 }
 
 // EndBlocker called at every block, update validator set
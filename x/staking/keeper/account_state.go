@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasAccountState reports whether addr has delegations, unbonding delegations
+// or redelegations. It implements the bank AccountStateCheckFn, so that such
+// accounts aren't reaped as dust.
+func (k Keeper) HasAccountState(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	has, err := hasAny(ctx, k.Delegations, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](addr))
+	if err != nil || has {
+		return has, err
+	}
+
+	has, err = hasAny(ctx, k.UnbondingDelegations, collections.NewPrefixedPairRange[[]byte, []byte](addr))
+	if err != nil || has {
+		return has, err
+	}
+
+	return hasAny(ctx, k.Redelegations, collections.NewPrefixedTripleRange[[]byte, []byte, []byte](addr))
+}
+
+// hasAny returns whether m has an entry in rng.
+func hasAny[K, V any](ctx context.Context, m collections.Map[K, V], rng collections.Ranger[K]) (bool, error) {
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
@@ -112,6 +112,28 @@ func (k Keeper) IterateDelegations(ctx context.Context, delAddr sdk.AccAddress,
 	})
 }
 
+// IterateValidatorDelegations iterates through all of the delegations to a validator
+func (k Keeper) IterateValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress,
+	fn func(index int64, del sdk.DelegationI) (stop bool),
+) error {
+	var i int64
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, sdk.AccAddress](valAddr)
+	return k.DelegationsByValidator.Walk(ctx, rng, func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], _ []byte) (stop bool, err error) {
+		del, err := k.Delegations.Get(ctx, collections.Join(key.K2(), key.K1()))
+		if err != nil {
+			return true, err
+		}
+
+		stop = fn(i, del)
+		if stop {
+			return true, nil
+		}
+		i++
+
+		return false, nil
+	})
+}
+
 // GetAllSDKDelegations returns all delegations used during genesis dump
 // TODO: remove this func, change all usage for iterate functionality
 func (k Keeper) GetAllSDKDelegations(ctx context.Context) (delegations []types.Delegation, err error) {
@@ -138,6 +138,27 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, pending := range data.PendingStakeFlows {
+		if err := k.PendingStakeFlows.Set(ctx, pending.Id, pending); err != nil {
+			panic(err)
+		}
+
+		// queued delegations are escrowed in the not bonded pool
+		if pending.IsDelegation() {
+			notBondedTokens = notBondedTokens.Add(pending.Amount)
+		}
+	}
+
+	if err := k.PendingStakeFlowID.Set(ctx, data.PendingStakeFlowId); err != nil {
+		panic(err)
+	}
+
+	if !data.BlockStakeFlow.IsNil() && !data.BlockStakeFlow.IsZero() {
+		if err := k.BlockStakeFlow.Set(ctx, data.BlockStakeFlow); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -271,6 +292,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var pendingStakeFlows []types.PendingStakeFlow
+
+	err = k.PendingStakeFlows.Walk(ctx, nil, func(_ uint64, pending types.PendingStakeFlow) (stop bool, err error) {
+		pendingStakeFlows = append(pendingStakeFlows, pending)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	pendingStakeFlowID, err := k.PendingStakeFlowID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	blockStakeFlow, err := k.getBlockStakeFlow(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -280,5 +321,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		PendingStakeFlows:    pendingStakeFlows,
+		PendingStakeFlowId:   pendingStakeFlowID,
+		BlockStakeFlow:       blockStakeFlow,
 	}
 }
@@ -87,12 +87,24 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 			panic(err)
 		}
 
+		// queued delegations are escrowed in the not bonded pool
+		err = k.PendingStakeFlows.Walk(ctx, nil, func(_ uint64, pending types.PendingStakeFlow) (stop bool, err error) {
+			if pending.IsDelegation() {
+				notBonded = notBonded.Add(pending.Amount)
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
 		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
 		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
 		broken := !poolBonded.Amount.Equal(bonded) || !poolNotBonded.Amount.Equal(notBonded)
 
 		// Bonded tokens should equal sum of tokens with bonded validators
 		// Not-bonded tokens should equal unbonding delegations	plus tokens on unbonded validators
+		// plus queued delegations
 		return sdk.FormatInvariant(types.ModuleName, "bonded and not bonded module account coins", fmt.Sprintf(
 			"\tPool's bonded tokens: %v\n"+
 				"\tsum of bonded tokens: %v\n"+
@@ -74,6 +74,8 @@ type Keeper struct {
 	authKeeper            types.AccountKeeper
 	bankKeeper            types.BankKeeper
 	hooks                 types.StakingHooks
+	valSetFilter          types.ValidatorSetFilter
+	moduleDelegators      *moduleDelegators
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -130,6 +132,11 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// BlockStakeFlow value: net stake bonded (positive) or unbonded (negative) in the current block
+	BlockStakeFlow collections.Item[math.Int]
+	// PendingStakeFlows key: sequence | value: PendingStakeFlow
+	PendingStakeFlows  collections.Map[uint64, types.PendingStakeFlow]
+	PendingStakeFlowID collections.Sequence
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -167,6 +174,7 @@ func NewKeeper(
 		authKeeper:            ak,
 		bankKeeper:            bk,
 		hooks:                 nil,
+		moduleDelegators:      &moduleDelegators{},
 		authority:             authority,
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
@@ -305,6 +313,10 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		BlockStakeFlow:     collections.NewItem(sb, types.BlockStakeFlowKey, "block_stake_flow", sdk.IntValue),
+		PendingStakeFlows:  collections.NewMap(sb, types.PendingStakeFlowsKey, "pending_stake_flows", collections.Uint64Key, codec.CollValue[types.PendingStakeFlow](cdc)),
+		PendingStakeFlowID: collections.NewSequence(sb, types.PendingStakeFlowSeqKey, "pending_stake_flow_id"),
 	}
 
 	schema, err := sb.Build()
@@ -341,6 +353,17 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetValidatorSetFilter sets the filter of the bonded validator set, used by
+// modules such as consumer chain modules to transform the validator updates
+// sent to CometBFT. Use types.NewMultiValidatorSetFilter to set several filters.
+func (k *Keeper) SetValidatorSetFilter(f types.ValidatorSetFilter) {
+	if k.valSetFilter != nil {
+		panic("cannot set validator set filter twice")
+	}
+
+	k.valSetFilter = f
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -251,7 +251,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e7a77898c3b368ff4b8e689a67733376f4147377337f970621e45cdd60a5dc6f",
 	)
 	s.Require().NoError(err)
 
@@ -266,7 +266,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e7a77898c3b368ff4b8e689a67733376f4147377337f970621e45cdd60a5dc6f",
 	)
 	s.Require().NoError(err)
 }
@@ -284,7 +284,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"658e762a01e73c785a12ef34e720d11bb0d88d67da1df9076f57f8944485e9a2",
 	)
 	s.Require().NoError(err)
 
@@ -297,7 +297,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"658e762a01e73c785a12ef34e720d11bb0d88d67da1df9076f57f8944485e9a2",
 	)
 
 	s.Require().NoError(err)
@@ -316,7 +316,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"f6f38e5ddbdce30335f1728ca52d0d3a0db30fe68db39b0819aa93d85661c852", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -329,7 +329,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"f6f38e5ddbdce30335f1728ca52d0d3a0db30fe68db39b0819aa93d85661c852",
 	)
 
 	s.Require().NoError(err)
@@ -360,7 +360,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"1a8d69284be01ed1ab740ba0edbb9333a3575b3ed648d7593e3053fe075ede7e",
 	)
 	s.Require().NoError(err)
 
@@ -384,7 +384,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"1a8d69284be01ed1ab740ba0edbb9333a3575b3ed648d7593e3053fe075ede7e",
 	)
 	s.Require().NoError(err)
 }
@@ -401,7 +401,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"fa73ffd09762bee8f752cf4debdc0ce44750be9cc1695f7be5751a9e6e71903b",
 	)
 	s.Require().NoError(err)
 
@@ -414,7 +414,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"fa73ffd09762bee8f752cf4debdc0ce44750be9cc1695f7be5751a9e6e71903b",
 	)
 	s.Require().NoError(err)
 }
@@ -448,7 +448,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"497118a4bb960f167b3f60d07e46ecd8327a8fdb7556d2e74fecb6c99aafa4f0",
 	)
 	s.Require().NoError(err)
 
@@ -474,7 +474,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"497118a4bb960f167b3f60d07e46ecd8327a8fdb7556d2e74fecb6c99aafa4f0",
 	)
 	s.Require().NoError(err)
 }
@@ -497,7 +497,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"977f2020d68c75fdb8fe52f806750a877322d8b6eb8a727f6b8ac898c87e5791",
 	)
 	s.Require().NoError(err)
 
@@ -512,7 +512,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"977f2020d68c75fdb8fe52f806750a877322d8b6eb8a727f6b8ac898c87e5791",
 	)
 	s.Require().NoError(err)
 }
@@ -540,7 +540,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"cf12fadbca835be0293ed816d2bbfa8328b260424954dbe35b7a9fa4214598be",
 	)
 	s.Require().NoError(err)
 
@@ -562,7 +562,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"cf12fadbca835be0293ed816d2bbfa8328b260424954dbe35b7a9fa4214598be",
 	)
 	s.Require().NoError(err)
 }
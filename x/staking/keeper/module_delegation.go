@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// moduleDelegators houses the modules registered to delegate the tokens of their
+// module account, in registration order.
+type moduleDelegators struct {
+	delegators []moduleDelegator
+}
+
+type moduleDelegator struct {
+	name  string
+	addr  sdk.AccAddress
+	hooks types.ModuleDelegationHooks
+}
+
+// SetModuleDelegationHooks registers moduleName to delegate the tokens of its
+// module account with DelegateFromModule, hooks being notified of the rewards
+// and slashes of its delegations.
+func (k *Keeper) SetModuleDelegationHooks(moduleName string, hooks types.ModuleDelegationHooks) {
+	addr := k.authKeeper.GetModuleAddress(moduleName)
+	if addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", moduleName))
+	}
+
+	if _, ok := k.moduleDelegator(addr); ok {
+		panic(fmt.Sprintf("cannot set module delegation hooks of %s twice", moduleName))
+	}
+
+	k.moduleDelegators.delegators = append(k.moduleDelegators.delegators, moduleDelegator{
+		name:  moduleName,
+		addr:  addr,
+		hooks: hooks,
+	})
+}
+
+func (k Keeper) moduleDelegator(addr sdk.AccAddress) (moduleDelegator, bool) {
+	for _, d := range k.moduleDelegators.delegators {
+		if d.addr.Equals(addr) {
+			return d, true
+		}
+	}
+
+	return moduleDelegator{}, false
+}
+
+func (k Keeper) moduleDelegatorByName(moduleName string) (moduleDelegator, error) {
+	for _, d := range k.moduleDelegators.delegators {
+		if d.name == moduleName {
+			return d, nil
+		}
+	}
+
+	return moduleDelegator{}, types.ErrModuleDelegatorNotRegistered.Wrap(moduleName)
+}
+
+// ModuleDelegatorName returns the name of the module delegating from delAddr,
+// if delAddr is the account of a module registered as delegator.
+func (k Keeper) ModuleDelegatorName(delAddr sdk.AccAddress) (string, bool) {
+	d, ok := k.moduleDelegator(delAddr)
+	return d.name, ok
+}
+
+// DelegateFromModule delegates amount of bond denom tokens of the module account
+// of moduleName, which must be registered as delegator, to valAddr, and returns
+// the shares issued.
+func (k Keeper) DelegateFromModule(ctx context.Context, moduleName string, valAddr sdk.ValAddress, amount math.Int) (math.LegacyDec, error) {
+	d, err := k.moduleDelegatorByName(moduleName)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !amount.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("invalid delegation amount: %s", amount)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, d.addr, amount, types.Unbonded, validator, true)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(d.addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delStr),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+
+	return newShares, nil
+}
+
+// UndelegateFromModule undelegates amount of bond denom tokens delegated by the
+// module account of moduleName to valAddr. The tokens are returned to the module
+// account at the returned completion time.
+func (k Keeper) UndelegateFromModule(ctx context.Context, moduleName string, valAddr sdk.ValAddress, amount math.Int) (time.Time, math.Int, error) {
+	d, err := k.moduleDelegatorByName(moduleName)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, d.addr, valAddr, amount)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	completionTime, undelegatedAmt, err := k.Undelegate(ctx, d.addr, valAddr, shares)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	valStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(d.addr)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, valStr),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delStr),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, undelegatedAmt).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return completionTime, undelegatedAmt, nil
+}
+
+// GetModuleDelegations returns the delegations of the module account of
+// moduleName, which must be registered as delegator.
+func (k Keeper) GetModuleDelegations(ctx context.Context, moduleName string) ([]types.Delegation, error) {
+	d, err := k.moduleDelegatorByName(moduleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetAllDelegatorDelegations(ctx, d.addr)
+}
+
+// AfterModuleDelegationRewards notifies the module delegating from delAddr, if
+// any, of the rewards of its delegation to valAddr withdrawn to its module
+// account. It is called by the distribution module.
+func (k Keeper) AfterModuleDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error {
+	d, ok := k.moduleDelegator(delAddr)
+	if !ok || d.hooks == nil {
+		return nil
+	}
+
+	return d.hooks.AfterModuleDelegationRewards(ctx, valAddr, rewards)
+}
+
+// beforeModuleDelegationsSlashed notifies the modules delegating to valAddr of
+// the slash of fraction of its tokens.
+func (k Keeper) beforeModuleDelegationsSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	for _, d := range k.moduleDelegators.delegators {
+		if d.hooks == nil {
+			continue
+		}
+
+		has, err := k.Delegations.Has(ctx, collections.Join(d.addr, valAddr))
+		if err != nil {
+			return err
+		}
+		if !has {
+			continue
+		}
+
+		if err := d.hooks.BeforeModuleDelegationSlashed(ctx, valAddr, fraction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
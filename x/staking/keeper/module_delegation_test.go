@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type moduleDelegationHooks struct {
+	rewards   sdk.Coins
+	fractions []math.LegacyDec
+}
+
+func (h *moduleDelegationHooks) AfterModuleDelegationRewards(_ context.Context, _ sdk.ValAddress, rewards sdk.Coins) error {
+	h.rewards = h.rewards.Add(rewards...)
+	return nil
+}
+
+func (h *moduleDelegationHooks) BeforeModuleDelegationSlashed(_ context.Context, _ sdk.ValAddress, fraction math.LegacyDec) error {
+	h.fractions = append(h.fractions, fraction)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestModuleDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	moduleAcc := authtypes.NewEmptyModuleAccount("liquid")
+	_, valAddrs := createValAddrs(1)
+
+	_, err := keeper.DelegateFromModule(ctx, "liquid", valAddrs[0], math.NewInt(10))
+	require.ErrorIs(err, stakingtypes.ErrModuleDelegatorNotRegistered)
+
+	hooks := &moduleDelegationHooks{}
+	s.accountKeeper.EXPECT().GetModuleAddress("liquid").Return(moduleAcc.GetAddress())
+	keeper.SetModuleDelegationHooks("liquid", hooks)
+
+	name, ok := keeper.ModuleDelegatorName(moduleAcc.GetAddress())
+	require.True(ok)
+	require.Equal("liquid", name)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(startTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	// the module delegates the tokens of its module account
+	amount := keeper.TokensFromConsensusPower(ctx, 4)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), moduleAcc.GetAddress(), stakingtypes.BondedPoolName, gomock.Any())
+	shares, err := keeper.DelegateFromModule(ctx, "liquid", valAddrs[0], amount)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount), shares)
+
+	delegations, err := keeper.GetModuleDelegations(ctx, "liquid")
+	require.NoError(err)
+	require.Len(delegations, 1)
+	require.Equal(shares, delegations[0].Shares)
+
+	// rewards withdrawn by the distribution module are forwarded to the hooks
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	require.NoError(keeper.AfterModuleDelegationRewards(ctx, moduleAcc.GetAddress(), valAddrs[0], rewards))
+	require.Equal(rewards, hooks.rewards)
+	require.NoError(keeper.AfterModuleDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0], rewards))
+	require.Equal(rewards, hooks.rewards)
+
+	// the module is notified of the slashes of the validator
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress()).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), bondedAcc.GetAddress(), gomock.Any())
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	_, err = keeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(keeper.PowerReduction(ctx)), math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(err)
+	require.Len(hooks.fractions, 1)
+
+	// and undelegates its tokens
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, undelegated, err := keeper.UndelegateFromModule(ctx, "liquid", valAddrs[0], math.NewInt(1000))
+	require.NoError(err)
+	require.True(undelegated.IsPositive())
+}
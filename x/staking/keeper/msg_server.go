@@ -140,6 +140,12 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, err
 	}
 
+	// the self-delegation cannot be queued, so it must fit within the stake
+	// flow limit of the block
+	if err := k.requireStakeFlow(ctx, msg.Value.Amount); err != nil {
+		return nil, err
+	}
+
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	// NOTE source will always be from a wallet which are unbonded
@@ -284,6 +290,19 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 		)
 	}
 
+	// delegations beyond the stake flow limit of the block are queued
+	admitted, err := k.admitStakeFlow(ctx, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if !admitted {
+		id, err := k.queueStakeFlow(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgDelegateResponse{Queued: true, StakeFlowId: id}, nil
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
@@ -428,6 +447,23 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 		)
 	}
 
+	// undelegations beyond the stake flow limit of the block are queued, and
+	// only start unbonding once executed
+	admitted, err := k.admitStakeFlow(ctx, msg.Amount.Amount.Neg())
+	if err != nil {
+		return nil, err
+	}
+	if !admitted {
+		id, err := k.queueStakeFlow(ctx, delegatorAddress, addr, msg.Amount.Amount.Neg())
+		if err != nil {
+			return nil, err
+		}
+		// the completion time depends on the block in which the undelegation
+		// is executed, which is not known yet, and is reported in the unbond
+		// event emitted then
+		return &types.MsgUndelegateResponse{Amount: msg.Amount, Queued: true, StakeFlowId: id}, nil
+	}
+
 	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -551,6 +587,10 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
 	}
 
+	if err := k.requireStakeFlow(ctx, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
 	// delegate back the unbonding delegation amount to the validator
 	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
 	if err != nil {
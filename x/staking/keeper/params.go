@@ -41,6 +41,20 @@ func (k Keeper) BondDenom(ctx context.Context) (string, error) {
 	return params.BondDenom, err
 }
 
+// ChainMetadataProvider returns a provider filling in the bond denom of the
+// chain metadata.
+func (k Keeper) ChainMetadataProvider() sdk.ChainMetadataProvider {
+	return func(ctx sdk.Context, md *sdk.ChainMetadata) error {
+		bondDenom, err := k.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+
+		md.BondDenom = bondDenom
+		return nil
+	}
+}
+
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
 // Currently, this returns a global variable that the app developer can tweak.
 // TODO: we might turn this into an on-chain param:
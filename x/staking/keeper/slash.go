@@ -172,6 +172,9 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		if err := k.Hooks().BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction); err != nil {
 			k.Logger(ctx).Error("failed to call before validator slashed hook", "error", err)
 		}
+		if err := k.beforeModuleDelegationsSlashed(ctx, operatorAddress, effectiveFraction); err != nil {
+			k.Logger(ctx).Error("failed to call before module delegation slashed hooks", "error", err)
+		}
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
@@ -0,0 +1,366 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetStakeFlowLimit returns the maximum net stake that can bond or unbond in a
+// block, zero if it is unlimited.
+func (k Keeper) GetStakeFlowLimit(ctx context.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if params.StakeFlowLimit.IsNil() {
+		return math.ZeroInt(), nil
+	}
+	return params.StakeFlowLimit, nil
+}
+
+// admitStakeFlow returns true if a delegation (positive amount) or an
+// undelegation (negative amount) can execute in the current block, and records
+// it in the net stake flow of the block. It returns false if the flow must be
+// queued behind the pending ones.
+func (k Keeper) admitStakeFlow(ctx context.Context, amount math.Int) (bool, error) {
+	limit, err := k.GetStakeFlowLimit(ctx)
+	if err != nil || limit.IsZero() {
+		return true, err
+	}
+
+	pending, err := k.hasPendingStakeFlows(ctx)
+	if err != nil || pending {
+		return false, err
+	}
+
+	flow, err := k.getBlockStakeFlow(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if amount.Abs().GT(types.StakeFlowRoom(flow, limit, amount.IsPositive())) {
+		return false, nil
+	}
+
+	return true, k.BlockStakeFlow.Set(ctx, flow.Add(amount))
+}
+
+// requireStakeFlow records a flow which cannot be queued in the net stake flow
+// of the block, and returns an error if it does not fit within the limit.
+func (k Keeper) requireStakeFlow(ctx context.Context, amount math.Int) error {
+	admitted, err := k.admitStakeFlow(ctx, amount)
+	if err != nil {
+		return err
+	}
+	if !admitted {
+		return errorsmod.Wrapf(types.ErrStakeFlowLimitExceeded, "cannot bond %s in this block", amount)
+	}
+	return nil
+}
+
+// queueStakeFlow queues a delegation (positive amount) or an undelegation
+// (negative amount) which does not fit in the current block. The tokens of a
+// delegation are escrowed in the not bonded pool until it executes.
+func (k Keeper) queueStakeFlow(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (uint64, error) {
+	if amount.IsPositive() {
+		bondDenom, err := k.BondDenom(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, coins); err != nil {
+			return 0, err
+		}
+	}
+
+	delegator, validator, err := k.stakeFlowAddresses(delAddr, valAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.PendingStakeFlowID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.PendingStakeFlows.Set(ctx, id, types.PendingStakeFlow{
+		Id:               id,
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+	}); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStakeFlowQueued,
+			sdk.NewAttribute(types.AttributeKeyFlowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// ProcessPendingStakeFlows resets the net stake flow of the block, then
+// executes the queued delegations and undelegations in order, for as long as
+// they fit within the limit. A flow which only partially fits executes in part,
+// the rest of it staying at the head of the queue. A flow which fails, for
+// instance because its validator is gone, is dropped and the tokens of a
+// delegation are returned to the delegator. The amount of a failed flow still
+// consumes the room of the block in both directions, so that the flows
+// processed in a block stay bounded by the limit even if they all fail. A zero
+// limit executes the whole queue in the block, such as the flows still queued
+// when governance removes the limit.
+func (k Keeper) ProcessPendingStakeFlows(ctx context.Context) error {
+	if err := k.BlockStakeFlow.Remove(ctx); err != nil {
+		return err
+	}
+
+	limit, err := k.GetStakeFlowLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	flow, failed := math.ZeroInt(), math.ZeroInt()
+	for {
+		id, pending, found, err := k.nextPendingStakeFlow(ctx)
+		if err != nil {
+			return err
+		}
+		if !found {
+			break
+		}
+
+		amount := pending.Amount
+		if !limit.IsZero() {
+			room := types.StakeFlowRoom(flow, limit, pending.IsDelegation()).Sub(failed)
+			if !room.IsPositive() {
+				break
+			}
+			if amount.Abs().GT(room) {
+				if !pending.IsDelegation() {
+					room = room.Neg()
+				}
+				amount = room
+			}
+		}
+
+		executed, err := k.executeStakeFlow(ctx, id, pending, amount)
+		if err != nil {
+			return err
+		}
+
+		if !executed {
+			failed = failed.Add(amount.Abs())
+		} else {
+			flow = flow.Add(amount)
+			if rest := pending.Amount.Sub(amount); !rest.IsZero() {
+				pending.Amount = rest
+				if err := k.PendingStakeFlows.Set(ctx, id, pending); err != nil {
+					return err
+				}
+				break
+			}
+		}
+
+		if err := k.PendingStakeFlows.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if flow.IsZero() {
+		return nil
+	}
+	return k.BlockStakeFlow.Set(ctx, flow)
+}
+
+// executeStakeFlow executes amount of a queued flow and returns true if it
+// succeeded. A failed flow is reported in an event rather than returned, its
+// state changes are discarded and the escrowed tokens of a delegation are
+// returned, as the whole flow is dropped from the queue.
+func (k Keeper) executeStakeFlow(ctx context.Context, id uint64, pending types.PendingStakeFlow, amount math.Int) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	var err error
+	if pending.IsDelegation() {
+		err = k.executePendingDelegation(cacheCtx, pending, amount)
+	} else {
+		err = k.executePendingUndelegation(cacheCtx, pending, amount.Neg())
+	}
+	if err == nil {
+		write()
+		return true, nil
+	}
+
+	if pending.IsDelegation() {
+		bondDenom, err := k.BondDenom(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(pending.DelegatorAddress)
+		if err != nil {
+			return false, err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, pending.Amount))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+			return false, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStakeFlowFailed,
+			sdk.NewAttribute(types.AttributeKeyFlowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+
+	return false, nil
+}
+
+func (k Keeper) executePendingDelegation(ctx context.Context, pending types.PendingStakeFlow, amount math.Int) error {
+	delAddr, valAddr, err := k.stakeFlowAddressBytes(pending)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// the tokens are escrowed in the not bonded pool
+	newShares, err := k.Delegate(ctx, delAddr, amount, types.Unbonded, validator, false)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, pending.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) executePendingUndelegation(ctx context.Context, pending types.PendingStakeFlow, amount math.Int) error {
+	delAddr, valAddr, err := k.stakeFlowAddressBytes(pending)
+	if err != nil {
+		return err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return err
+	}
+
+	completionTime, undelegatedAmt, err := k.Undelegate(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, pending.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, pending.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, undelegatedAmt).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) stakeFlowAddresses(delAddr, valAddr []byte) (string, string, error) {
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	validator, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	return delegator, validator, nil
+}
+
+func (k Keeper) stakeFlowAddressBytes(pending types.PendingStakeFlow) (sdk.AccAddress, sdk.ValAddress, error) {
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(pending.DelegatorAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(pending.ValidatorAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delAddr, valAddr, nil
+}
+
+func (k Keeper) getBlockStakeFlow(ctx context.Context) (math.Int, error) {
+	flow, err := k.BlockStakeFlow.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return flow, err
+}
+
+func (k Keeper) hasPendingStakeFlows(ctx context.Context) (bool, error) {
+	_, _, found, err := k.nextPendingStakeFlow(ctx)
+	return found, err
+}
+
+// nextPendingStakeFlow returns the flow at the head of the queue.
+func (k Keeper) nextPendingStakeFlow(ctx context.Context) (uint64, types.PendingStakeFlow, bool, error) {
+	it, err := k.PendingStakeFlows.Iterate(ctx, nil)
+	if err != nil {
+		return 0, types.PendingStakeFlow{}, false, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, types.PendingStakeFlow{}, false, nil
+	}
+
+	kv, err := it.KeyValue()
+	if err != nil {
+		return 0, types.PendingStakeFlow{}, false, err
+	}
+	return kv.Key, kv.Value, true, nil
+}
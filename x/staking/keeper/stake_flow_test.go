@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestStakeFlowLimit() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	setLimit := func(limit int64) error {
+		params, err := keeper.Params.Get(ctx)
+		require.NoError(err)
+		params.StakeFlowLimit = math.NewInt(limit)
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params})
+		return err
+	}
+	require.ErrorContains(setLimit(-1), "stake flow limit cannot be negative")
+	require.NoError(setLimit(100))
+
+	shares := func() string {
+		del, err := keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+		require.NoError(err)
+		return del.Shares.TruncateInt().String()
+	}
+	pending := func() []string {
+		var amounts []string
+		require.NoError(keeper.PendingStakeFlows.Walk(ctx, nil, func(_ uint64, f types.PendingStakeFlow) (bool, error) {
+			amounts = append(amounts, f.Amount.String())
+			return false, nil
+		}))
+		return amounts
+	}
+	delegate := func(amount int64) {
+		_, err := msgServer.Delegate(ctx, &types.MsgDelegate{
+			DelegatorAddress: Addr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		require.NoError(err)
+	}
+
+	// the first delegation fits, the second one does not and the third one is
+	// queued behind it
+	delegate(60)
+	require.Equal("70", shares())
+	delegate(60)
+	delegate(10)
+	require.Equal("70", shares())
+	require.Equal([]string{"60", "10"}, pending())
+
+	// both execute in the next block
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("140", shares())
+	require.Empty(pending())
+	flow, err := keeper.BlockStakeFlow.Get(ctx)
+	require.NoError(err)
+	require.Equal("70", flow.String())
+
+	// an undelegation beyond the limit is queued, then executes in part
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	res, err := msgServer.Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 130),
+	})
+	require.NoError(err)
+	require.True(res.Queued)
+	require.Equal(uint64(2), res.StakeFlowId)
+	require.True(res.CompletionTime.IsZero())
+	require.Equal("140", shares())
+	require.Equal([]string{"-130"}, pending())
+
+	// the queue is exported in genesis
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.ZeroInt()))
+	genesis := keeper.ExportGenesis(ctx)
+	require.Len(genesis.PendingStakeFlows, 1)
+	require.Equal(uint64(2), genesis.PendingStakeFlows[0].Id)
+	require.Equal(uint64(3), genesis.PendingStakeFlowId)
+
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("40", shares())
+	require.Equal([]string{"-30"}, pending())
+
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("10", shares())
+	require.Empty(pending())
+
+	// failed flows are dropped but still consume the room of the block, the
+	// last one is only processed in the next block
+	for i := 0; i < 3; i++ {
+		id, err := keeper.PendingStakeFlowID.Next(ctx)
+		require.NoError(err)
+		require.NoError(keeper.PendingStakeFlows.Set(ctx, id, types.PendingStakeFlow{
+			Id:               id,
+			DelegatorAddress: Addr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           math.NewInt(-60),
+		}))
+	}
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("10", shares())
+	require.Equal([]string{"-60"}, pending())
+	_, err = keeper.BlockStakeFlow.Get(ctx)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("10", shares())
+	require.Empty(pending())
+
+	// removing the limit executes the whole queue in the next block, then
+	// lets flows through
+	delegate(100)
+	delegate(500)
+	require.Equal([]string{"500"}, pending())
+	require.NoError(setLimit(0))
+	require.NoError(keeper.ProcessPendingStakeFlows(ctx))
+	require.Equal("610", shares())
+	require.Empty(pending())
+
+	delegate(1000)
+	require.Equal("1610", shares())
+	require.Empty(pending())
+}
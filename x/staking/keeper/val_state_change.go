@@ -136,6 +136,10 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 // CONTRACT: Only validators with non-zero power or zero-power that were bonded
 // at the previous block height or were removed from the validator set entirely
 // are returned to CometBFT.
+//
+// When a validator set filter is set, validators it excludes or jails are not
+// part of the bonded validator set, and the power of the other validators is
+// the one returned by the filter.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates []abci.ValidatorUpdate, err error) {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -161,6 +165,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 	}
 	defer iterator.Close()
 
+	// validators to jail, as requested by the validator set filter; they are
+	// jailed once the power index is iterated.
+	var toJail []types.Validator
+
 	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
 		// everything that is iterated in this loop is becoming or already a
 		// part of the bonded validator set
@@ -180,6 +188,29 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			break
 		}
 
+		newPower := validator.PotentialConsensusPower(powerReduction)
+		if k.valSetFilter != nil {
+			result, err := k.valSetFilter.FilterValidator(ctx, validator, newPower)
+			if err != nil {
+				return nil, err
+			}
+
+			if result.Jail {
+				toJail = append(toJail, validator)
+				continue
+			}
+
+			if result.Exclude {
+				continue
+			}
+
+			if result.Power <= 0 || result.Power > newPower {
+				return nil, fmt.Errorf("validator set filter returned invalid power %d for validator %s with power %d", result.Power, validator.GetOperator(), newPower)
+			}
+
+			newPower = result.Power
+		}
+
 		// apply the appropriate state change if necessary
 		switch {
 		case validator.IsUnbonded():
@@ -206,12 +237,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
-		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+			update := validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
 
 			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
 				return nil, err
@@ -224,6 +256,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		totalPower = totalPower.Add(math.NewInt(newPower))
 	}
 
+	for _, validator := range toJail {
+		if err := k.jailValidator(ctx, validator); err != nil {
+			return nil, err
+		}
+
+		sdk.UnwrapSDKContext(ctx).Logger().Info("validator jailed by the validator set filter", "validator", validator.GetOperator())
+	}
+
 	noLongerBonded, err := sortNoLongerBonded(last, k.validatorAddressCodec)
 	if err != nil {
 		return nil, err
@@ -291,6 +331,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		// - a validator can be unbonding state but jailed status false
 		// - a validator can be jailed and status can be unbonding
 		if !(validator.Jailed || validator.Status != types.Bonded) {
+			power := validator.ConsensusPower(powerReduction)
+			if k.valSetFilter != nil {
+				// the power of the validator may have been changed by the filter
+				power, err = k.GetLastValidatorPower(ctx, sdk.ValAddress(valAddr))
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			updates = append(updates, abci.ValidatorUpdate{
 				PubKey: oldCmtPk,
 				Power:  0,
@@ -298,7 +347,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 
 			updates = append(updates, abci.ValidatorUpdate{
 				PubKey: newCmtPk,
-				Power:  validator.ConsensusPower(powerReduction),
+				Power:  power,
 			})
 
 			if err := k.updateToNewPubkey(ctx, validator, history.OldConsPubkey, history.NewConsPubkey, history.Fee); err != nil {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -442,3 +443,64 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 	require.NoError(err)
 	require.Equal(stakingtypes.Unbonded, validator.Status)
 }
+
+type validatorSetFilterFn func(validator stakingtypes.Validator, power int64) stakingtypes.ValidatorFilterResult
+
+func (f validatorSetFilterFn) FilterValidator(_ context.Context, validator stakingtypes.Validator, power int64) (stakingtypes.ValidatorFilterResult, error) {
+	return f(validator, power), nil
+}
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesFilter() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	powers := []int64{100, 50, 20}
+	var validators [3]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], false)
+	}
+
+	// power capping, allowlist and jailing
+	capFilter := validatorSetFilterFn(func(_ stakingtypes.Validator, power int64) stakingtypes.ValidatorFilterResult {
+		return stakingtypes.ValidatorFilterResult{Power: min(power, 60)}
+	})
+	allowlistFilter := validatorSetFilterFn(func(validator stakingtypes.Validator, power int64) stakingtypes.ValidatorFilterResult {
+		return stakingtypes.ValidatorFilterResult{Power: power, Exclude: validator.GetOperator() == validators[1].GetOperator()}
+	})
+	jailFilter := validatorSetFilterFn(func(validator stakingtypes.Validator, power int64) stakingtypes.ValidatorFilterResult {
+		return stakingtypes.ValidatorFilterResult{Power: power, Jail: validator.GetOperator() == validators[2].GetOperator()}
+	})
+	keeper.SetValidatorSetFilter(stakingtypes.NewMultiValidatorSetFilter(capFilter, allowlistFilter, jailFilter))
+
+	// only the first validator is bonded, with a capped power
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+	expUpdate := validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx))
+	expUpdate.Power = 60
+	require.Equal(expUpdate, updates[0])
+
+	valAddr, err := keeper.ValidatorAddressCodec().StringToBytes(validators[0].GetOperator())
+	require.NoError(err)
+	power, err := keeper.GetLastValidatorPower(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(int64(60), power)
+
+	totalPower, err := keeper.LastTotalPower.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(60), totalPower)
+
+	validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address().Bytes()))
+	require.NoError(err)
+	require.False(validator.IsBonded())
+
+	validator, err = keeper.GetValidator(ctx, sdk.ValAddress(PKs[2].Address().Bytes()))
+	require.NoError(err)
+	require.True(validator.IsJailed())
+	require.False(validator.IsBonded())
+
+	// the filtered power is stable, so no update is sent in the next block
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+}
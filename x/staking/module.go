@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/staking/client/cli"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/simulation"
@@ -41,6 +42,7 @@ var (
 	_ module.HasInvariants       = AppModule{}
 	_ module.HasABCIGenesis      = AppModule{}
 	_ module.HasABCIEndBlock     = AppModule{}
+	_ module.HasGenesisChecks    = AppModule{}
 
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
@@ -124,6 +126,20 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// GenesisChecks returns the checks of the consistency of the staking genesis
+// state with the bank genesis state.
+func (am AppModule) GenesisChecks() []module.GenesisCheck {
+	return []module.GenesisCheck{
+		{
+			Name:    "pool-balances",
+			Modules: []string{types.ModuleName, banktypes.ModuleName},
+			Check: func(cdc codec.JSONCodec, genesisData map[string]json.RawMessage) error {
+				return ValidatePoolBalances(cdc, am.accountKeeper.AddressCodec(), genesisData)
+			},
+		},
+	}
+}
+
 // RegisterInvariants registers the staking module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
@@ -203,8 +219,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	StakingKeeper *keeper.Keeper
-	Module        appmodule.AppModule
+	StakingKeeper     *keeper.Keeper
+	Module            appmodule.AppModule
+	AccountStateCheck banktypes.AccountStateCheckWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -229,7 +246,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ConsensusAddressCodec,
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
-	return ModuleOutputs{StakingKeeper: k, Module: m}
+	return ModuleOutputs{
+		StakingKeeper:     k,
+		Module:            m,
+		AccountStateCheck: banktypes.AccountStateCheckWrapper{AccountStateCheckFn: k.HasAccountState},
+	}
 }
 
 func InvokeSetStakingHooks(
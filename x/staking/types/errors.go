@@ -52,4 +52,6 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+	ErrModuleDelegatorNotRegistered           = errors.Register(ModuleName, 49, "module is not registered as delegator")
+	ErrStakeFlowLimitExceeded                 = errors.Register(ModuleName, 50, "stake flow limit of the block exceeded")
 )
@@ -46,6 +46,12 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// pending_stake_flows defines the delegations and undelegations queued by the stake flow limit, in order.
+	PendingStakeFlows []PendingStakeFlow `protobuf:"bytes,9,rep,name=pending_stake_flows,json=pendingStakeFlows,proto3" json:"pending_stake_flows"`
+	// pending_stake_flow_id is the ID of the next queued stake flow.
+	PendingStakeFlowId uint64 `protobuf:"varint,10,opt,name=pending_stake_flow_id,json=pendingStakeFlowId,proto3" json:"pending_stake_flow_id,omitempty"`
+	// block_stake_flow is the net stake bonded (positive) or unbonded (negative) in the last block.
+	BlockStakeFlow cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=block_stake_flow,json=blockStakeFlow,proto3,customtype=cosmossdk.io/math.Int" json:"block_stake_flow"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +136,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetPendingStakeFlows() []PendingStakeFlow {
+	if m != nil {
+		return m.PendingStakeFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingStakeFlowId() uint64 {
+	if m != nil {
+		return m.PendingStakeFlowId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +201,44 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xc6, 0xed, 0x5f, 0xda, 0xfc, 0xb9, 0xf4, 0x57, 0xb5, 0xd7, 0x04, 0x99, 0x08, 0x39, 0x26,
+	0xea, 0x60, 0xb5, 0xaa, 0x4d, 0x82, 0xc4, 0xc0, 0xd6, 0x08, 0x81, 0x22, 0x55, 0xa2, 0x72, 0x08,
+	0x43, 0x17, 0xeb, 0x12, 0x1f, 0xae, 0x15, 0xc7, 0x67, 0xf9, 0xae, 0x4d, 0x79, 0x03, 0x88, 0x91,
+	0x97, 0xd0, 0x91, 0x91, 0xa1, 0x2f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x65,
+	0x20, 0xdf, 0x39, 0xc6, 0x6d, 0xe2, 0x81, 0xc5, 0xf2, 0xf9, 0xfb, 0x3c, 0x9f, 0xe7, 0xb1, 0x74,
+	0x77, 0x60, 0x77, 0x44, 0xe8, 0x84, 0x50, 0x93, 0x32, 0x34, 0xf6, 0x02, 0xd7, 0x3c, 0x6f, 0x0f,
+	0x31, 0x43, 0x6d, 0xd3, 0xc5, 0x01, 0xa6, 0x1e, 0x35, 0xc2, 0x88, 0x30, 0x02, 0x1f, 0x09, 0x95,
+	0x91, 0xa8, 0x8c, 0x44, 0xd5, 0xa8, 0xb9, 0xc4, 0x25, 0x5c, 0x62, 0xc6, 0x6f, 0x42, 0xdd, 0xc8,
+	0x63, 0x2e, 0xdc, 0x42, 0xf5, 0x58, 0xa8, 0x6c, 0x61, 0x4f, 0x02, 0xc4, 0x68, 0x1b, 0x4d, 0xbc,
+	0x80, 0x98, 0xfc, 0x29, 0x3e, 0xb5, 0x3e, 0x95, 0xc0, 0xc6, 0x1b, 0xd1, 0xa9, 0xcf, 0x10, 0xc3,
+	0xf0, 0x10, 0x14, 0x43, 0x14, 0xa1, 0x09, 0x55, 0x64, 0x4d, 0xd6, 0xab, 0x1d, 0xd5, 0x58, 0xdd,
+	0xd1, 0x38, 0xe6, 0xaa, 0x6e, 0xe5, 0xfa, 0xae, 0x29, 0x7d, 0xfd, 0xf5, 0x6d, 0x4f, 0xb6, 0x12,
+	0x23, 0x3c, 0x01, 0x5b, 0x3e, 0xa2, 0xcc, 0x66, 0x84, 0x21, 0xdf, 0x0e, 0xc9, 0x14, 0x47, 0xca,
+	0x7f, 0x9a, 0xac, 0x6f, 0x74, 0x9f, 0xc5, 0xe2, 0x1f, 0x77, 0xcd, 0xba, 0x60, 0x52, 0x67, 0x6c,
+	0x78, 0xc4, 0x9c, 0x20, 0x76, 0x6a, 0xf4, 0x02, 0x76, 0x7b, 0x75, 0x00, 0x92, 0xb0, 0x5e, 0xc0,
+	0x04, 0x73, 0x33, 0x26, 0xbd, 0x8b, 0x41, 0xc7, 0x31, 0x07, 0x7a, 0xa0, 0xce, 0xd9, 0xe7, 0xc8,
+	0xf7, 0x1c, 0xc4, 0x48, 0x24, 0xf8, 0x54, 0x29, 0x68, 0x05, 0xbd, 0xda, 0xd9, 0xcb, 0x6b, 0x7b,
+	0x84, 0x28, 0x7b, 0xbf, 0xf0, 0x70, 0x54, 0xb6, 0xf9, 0x8e, 0xbf, 0x34, 0xa6, 0xf0, 0x08, 0x80,
+	0x34, 0x85, 0x2a, 0x6b, 0x9c, 0xff, 0x34, 0x8f, 0x9f, 0x9a, 0xb3, 0xd8, 0x8c, 0x1f, 0xbe, 0x05,
+	0x55, 0x07, 0xfb, 0xd8, 0x45, 0xcc, 0x23, 0x01, 0x55, 0xd6, 0x39, 0xae, 0x95, 0x87, 0x7b, 0x95,
+	0x4a, 0xb3, 0xbc, 0x2c, 0x01, 0x8e, 0x41, 0xfd, 0x2c, 0x18, 0x92, 0xc0, 0xf1, 0x02, 0xd7, 0xce,
+	0xa2, 0x8b, 0x1c, 0xbd, 0x9f, 0x87, 0x1e, 0x2c, 0x4c, 0xab, 0x33, 0x6a, 0x67, 0xcb, 0x73, 0x0a,
+	0x07, 0xe0, 0xff, 0x08, 0x67, 0x43, 0x4a, 0x3c, 0x64, 0x37, 0x2f, 0xc4, 0xc2, 0xce, 0x4a, 0xfa,
+	0x7d, 0x0a, 0x6c, 0x80, 0x32, 0xbe, 0x08, 0x49, 0xc4, 0xb0, 0xa3, 0x94, 0x35, 0x59, 0x2f, 0x5b,
+	0xe9, 0x1a, 0x8e, 0xc0, 0x4e, 0x88, 0xc5, 0xdf, 0xc5, 0x74, 0x6c, 0x7f, 0xf0, 0xc9, 0x94, 0x2a,
+	0x15, 0x1e, 0xac, 0xe7, 0xee, 0x4a, 0x61, 0xe9, 0xc7, 0x8e, 0xd7, 0x3e, 0x99, 0x66, 0xc3, 0xb7,
+	0xc3, 0x07, 0x43, 0x0a, 0xdb, 0xa0, 0xbe, 0x1c, 0x62, 0x7b, 0x8e, 0x02, 0x34, 0x59, 0x5f, 0xb3,
+	0xe0, 0x43, 0x47, 0xcf, 0x81, 0x03, 0xb0, 0x35, 0xf4, 0xc9, 0x68, 0x9c, 0x31, 0x28, 0x55, 0x4d,
+	0xd6, 0x2b, 0xdd, 0xfd, 0x7f, 0xd8, 0xdd, 0xd6, 0x26, 0x87, 0xa4, 0xe0, 0xd6, 0x29, 0x80, 0xcb,
+	0x7b, 0x14, 0x76, 0x40, 0x09, 0x39, 0x4e, 0x84, 0xa9, 0x38, 0x8e, 0x95, 0xae, 0x72, 0x7b, 0x75,
+	0x50, 0x4b, 0x30, 0x87, 0x62, 0xd2, 0x67, 0x91, 0x17, 0xb8, 0xd6, 0x42, 0x08, 0x6b, 0x60, 0xfd,
+	0xef, 0x99, 0x2b, 0x58, 0x62, 0xf1, 0xb2, 0xfc, 0xf9, 0xb2, 0x29, 0xfd, 0xbe, 0x6c, 0x4a, 0xdd,
+	0x17, 0xd7, 0x33, 0x55, 0xbe, 0x99, 0xa9, 0xf2, 0xcf, 0x99, 0x2a, 0x7f, 0x99, 0xab, 0xd2, 0xcd,
+	0x5c, 0x95, 0xbe, 0xcf, 0x55, 0xe9, 0xe4, 0xc9, 0xbd, 0xe2, 0x17, 0xe9, 0x45, 0xc3, 0x3e, 0x86,
+	0x98, 0x0e, 0x8b, 0xfc, 0xc6, 0x78, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x45, 0x55, 0x8b,
+	0xdb, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +261,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BlockStakeFlow.Size()
+		i -= size
+		if _, err := m.BlockStakeFlow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.PendingStakeFlowId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingStakeFlowId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PendingStakeFlows) > 0 {
+		for iNdEx := len(m.PendingStakeFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingStakeFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +482,17 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.PendingStakeFlows) > 0 {
+		for _, e := range m.PendingStakeFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingStakeFlowId != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingStakeFlowId))
+	}
+	l = m.BlockStakeFlow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -738,6 +803,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStakeFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingStakeFlows = append(m.PendingStakeFlows, PendingStakeFlow{})
+			if err := m.PendingStakeFlows[len(m.PendingStakeFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStakeFlowId", wireType)
+			}
+			m.PendingStakeFlowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingStakeFlowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockStakeFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockStakeFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
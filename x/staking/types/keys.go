@@ -70,6 +70,10 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	BlockStakeFlowKey      = collections.NewPrefix(122) // key for the net stake bonded or unbonded in the current block
+	PendingStakeFlowsKey   = collections.NewPrefix(123) // prefix for the delegations and undelegations queued by the stake flow limit
+	PendingStakeFlowSeqKey = collections.NewPrefix(124) // key for the sequence of the queued delegations and undelegations
 )
 
 // UnbondingType defines the type of unbonding operation
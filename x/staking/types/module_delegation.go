@@ -0,0 +1,22 @@
+package types
+
+import (
+	context "context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleDelegationHooks is implemented by modules delegating the tokens of their
+// module account, e.g. liquid staking or yield strategies, to account for the
+// rewards and slashes of their delegations.
+type ModuleDelegationHooks interface {
+	// AfterModuleDelegationRewards is called once the rewards of a delegation
+	// of the module were withdrawn to its module account, so that the module
+	// can forward them.
+	AfterModuleDelegationRewards(ctx context.Context, valAddr sdk.ValAddress, rewards sdk.Coins) error
+	// BeforeModuleDelegationSlashed is called when a validator the module
+	// delegates to is slashed by fraction of its tokens.
+	BeforeModuleDelegationSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
+}
@@ -37,13 +37,16 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultStakeFlowLimit is zero, the stake flow is unlimited
+	DefaultStakeFlowLimit = math.ZeroInt()
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries, historicalEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
-	keyRotationFee sdk.Coin,
+	keyRotationFee sdk.Coin, stakeFlowLimit math.Int,
 ) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -53,6 +56,7 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+		StakeFlowLimit:    stakeFlowLimit,
 	}
 }
 
@@ -66,6 +70,7 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultStakeFlowLimit,
 	)
 }
 
@@ -119,6 +124,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStakeFlowLimit(p.StakeFlowLimit); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,3 +243,17 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateStakeFlowLimit(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil limit, as in the params stored before it existed, is unlimited
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("stake flow limit cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// IsDelegation returns true if the flow is a delegation.
+func (f PendingStakeFlow) IsDelegation() bool {
+	return f.Amount.IsPositive()
+}
+
+// StakeFlowRoom returns how much stake can still bond (or unbond, if bond is
+// false) in a block whose net stake flow is flow, without exceeding limit.
+func StakeFlowRoom(flow, limit math.Int, bond bool) math.Int {
+	if !bond {
+		flow = flow.Neg()
+	}
+
+	room := limit.Sub(flow)
+	if room.IsNegative() {
+		return math.ZeroInt()
+	}
+	return room
+}
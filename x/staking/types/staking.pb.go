@@ -1001,6 +1001,9 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// stake_flow_limit is the maximum net stake bonding or unbonding in a block, zero if unlimited.
+	// Delegations and undelegations beyond it are queued and executed in the following blocks.
+	StakeFlowLimit cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=stake_flow_limit,json=stakeFlowLimit,proto3,customtype=cosmossdk.io/math.Int" json:"stake_flow_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1414,6 +1417,53 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// PendingStakeFlow is a delegation or an undelegation queued because it did not fit within the stake flow limit of the
+// block it was sent in. A positive amount is a delegation, whose tokens are escrowed in the not bonded pool until it is
+// executed; a negative amount is an undelegation.
+type PendingStakeFlow struct {
+	// id is the position of the flow in the queue.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator_address is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount left to delegate (positive) or undelegate (negative).
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PendingStakeFlow) Reset()         { *m = PendingStakeFlow{} }
+func (m *PendingStakeFlow) String() string { return proto.CompactTextString(m) }
+func (*PendingStakeFlow) ProtoMessage()    {}
+func (*PendingStakeFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *PendingStakeFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingStakeFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingStakeFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingStakeFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingStakeFlow.Merge(m, src)
+}
+func (m *PendingStakeFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingStakeFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingStakeFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingStakeFlow proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1441,6 +1491,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*PendingStakeFlow)(nil), "cosmos.staking.v1beta1.PendingStakeFlow")
 }
 
 func init() {
@@ -1448,138 +1499,143 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
-	0x1d, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0xdb, 0x89, 0x9d, 0xe9, 0x97, 0xe3, 0xee, 0xc6, 0xae, 0xb7,
-	0xb0, 0x6d, 0xa1, 0x0e, 0x2d, 0xa8, 0x87, 0x80, 0x40, 0x75, 0xec, 0x6e, 0xbd, 0x1f, 0x49, 0x78,
-	0x4e, 0x02, 0xcb, 0xd7, 0xd3, 0xf8, 0xbd, 0xb1, 0xfd, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0xb7, 0xf5,
-	0x9d, 0xc3, 0x2a, 0x2b, 0xa4, 0x3d, 0x01, 0x12, 0xaa, 0xa8, 0xc4, 0x65, 0xb9, 0xed, 0xa1, 0xe2,
-	0xce, 0x6d, 0x41, 0x42, 0xaa, 0x7a, 0x42, 0x48, 0x04, 0xd4, 0x1e, 0xba, 0x82, 0x0b, 0xe2, 0xc4,
-	0x11, 0xcd, 0xbc, 0x79, 0x1f, 0x8e, 0xe3, 0xe6, 0xa3, 0x2b, 0xb4, 0x62, 0x2f, 0x91, 0x67, 0xe6,
-	0xff, 0xff, 0xbd, 0xff, 0xf7, 0xcc, 0xff, 0x1f, 0xb8, 0x64, 0x3a, 0xac, 0xe7, 0xb0, 0x65, 0xc6,
-	0xf1, 0x8e, 0x4d, 0xdb, 0xcb, 0x77, 0xaf, 0x37, 0x09, 0xc7, 0xd7, 0xfd, 0x75, 0xb9, 0xef, 0x3a,
-	0xdc, 0x41, 0xe7, 0x3c, 0xaa, 0xb2, 0xbf, 0xab, 0xa8, 0xf2, 0x67, 0xda, 0x4e, 0xdb, 0x91, 0x24,
-	0xcb, 0xe2, 0x97, 0x47, 0x9d, 0x5f, 0x6c, 0x3b, 0x4e, 0xbb, 0x4b, 0x96, 0xe5, 0xaa, 0x39, 0x68,
-	0x2d, 0x63, 0x3a, 0x54, 0x47, 0x4b, 0xfb, 0x8f, 0xac, 0x81, 0x8b, 0xb9, 0xed, 0x50, 0x75, 0x5e,
-	0xd8, 0x7f, 0xce, 0xed, 0x1e, 0x61, 0x1c, 0xf7, 0xfa, 0x3e, 0xb6, 0x27, 0x89, 0xe1, 0x7d, 0x54,
-	0x89, 0xa5, 0xb0, 0x95, 0x2a, 0x4d, 0xcc, 0x48, 0xa0, 0x87, 0xe9, 0xd8, 0x3e, 0xf6, 0x02, 0xee,
-	0xd9, 0xd4, 0x59, 0x96, 0x7f, 0xd5, 0xd6, 0x2b, 0x9c, 0x50, 0x8b, 0xb8, 0x3d, 0x9b, 0xf2, 0x65,
-	0x3e, 0xec, 0x13, 0xe6, 0xfd, 0x55, 0xa7, 0x17, 0x22, 0xa7, 0xb8, 0x69, 0xda, 0xd1, 0xc3, 0xd2,
-	0x2f, 0x34, 0x98, 0xbf, 0x63, 0x33, 0xee, 0xb8, 0xb6, 0x89, 0xbb, 0x75, 0xda, 0x72, 0xd0, 0xd7,
-	0x21, 0xd9, 0x21, 0xd8, 0x22, 0x6e, 0x4e, 0x2b, 0x6a, 0x97, 0x53, 0x37, 0x72, 0xe5, 0x10, 0xa0,
-	0xec, 0xf1, 0xde, 0x91, 0xe7, 0x95, 0xd9, 0x8f, 0xf7, 0x0a, 0x53, 0x1f, 0x3e, 0xff, 0xe8, 0xaa,
-	0xa6, 0x2b, 0x16, 0x54, 0x85, 0xe4, 0x5d, 0xdc, 0x65, 0x84, 0xe7, 0x62, 0xc5, 0xf8, 0xe5, 0xd4,
-	0x8d, 0x8b, 0xe5, 0x83, 0x6d, 0x5e, 0xde, 0xc6, 0x5d, 0xdb, 0xc2, 0xdc, 0x19, 0x45, 0xf1, 0x78,
-	0x57, 0x62, 0x39, 0xad, 0xf4, 0xbe, 0x06, 0xd9, 0x50, 0x32, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c,
-	0x4c, 0xe3, 0x7e, 0xbf, 0x83, 0x59, 0x47, 0x0a, 0x97, 0xd6, 0xfd, 0x25, 0xfa, 0x1a, 0x24, 0x84,
-	0x91, 0x73, 0x31, 0x29, 0x73, 0xbe, 0xec, 0x79, 0xa0, 0xec, 0x7b, 0xa0, 0xbc, 0xe9, 0x7b, 0xa0,
-	0x92, 0xf8, 0xe0, 0x6f, 0x05, 0x4d, 0x97, 0xd4, 0xe8, 0x75, 0xc8, 0xdc, 0xf5, 0x05, 0x61, 0x86,
-	0xc4, 0x8d, 0x4b, 0xdc, 0xf9, 0x70, 0xfb, 0x0e, 0x66, 0x9d, 0xd2, 0xcf, 0x63, 0x90, 0x59, 0x75,
-	0x7a, 0x3d, 0x9b, 0x31, 0xdb, 0xa1, 0x3a, 0xe6, 0x84, 0xa1, 0x37, 0x21, 0xe1, 0x62, 0x4e, 0xa4,
-	0x24, 0xb3, 0x95, 0x9b, 0x42, 0x8d, 0xbf, 0xec, 0x15, 0x2e, 0x78, 0x0a, 0x33, 0x6b, 0xa7, 0x6c,
-	0x3b, 0xcb, 0x3d, 0xcc, 0x3b, 0xe5, 0xb7, 0x49, 0x1b, 0x9b, 0xc3, 0x2a, 0x31, 0x9f, 0x3c, 0xba,
-	0x06, 0xca, 0x1e, 0x55, 0x62, 0x7a, 0x3a, 0x4b, 0x0c, 0xf4, 0x6d, 0x98, 0xe9, 0xe1, 0xfb, 0x86,
-	0xc4, 0x8b, 0xbd, 0x14, 0xde, 0x74, 0x0f, 0xdf, 0x17, 0xf2, 0xa1, 0x1f, 0x41, 0x46, 0x40, 0x9a,
-	0x1d, 0x4c, 0xdb, 0xc4, 0x43, 0x8e, 0xbf, 0x14, 0xf2, 0x5c, 0x0f, 0xdf, 0x5f, 0x95, 0x68, 0x02,
-	0x7f, 0x25, 0xf1, 0xc9, 0xc3, 0x82, 0x56, 0xfa, 0xbd, 0x06, 0x10, 0x1a, 0x06, 0x61, 0xc8, 0x9a,
-	0xc1, 0x4a, 0x7e, 0x94, 0xa9, 0x30, 0x7a, 0x7d, 0x52, 0x24, 0xec, 0x33, 0x6b, 0x65, 0x4e, 0x88,
-	0xf7, 0x78, 0xaf, 0xa0, 0x79, 0x5f, 0xcd, 0x98, 0x63, 0x66, 0x4f, 0x0d, 0xfa, 0x16, 0xe6, 0xc4,
-	0x38, 0xa2, 0xc3, 0x25, 0xa0, 0x70, 0xba, 0x07, 0x08, 0x1e, 0xb7, 0x38, 0x57, 0x3a, 0x7c, 0xa8,
-	0x41, 0xaa, 0x4a, 0x98, 0xe9, 0xda, 0x7d, 0x91, 0xc4, 0x22, 0xca, 0x7a, 0x0e, 0xb5, 0x77, 0x54,
-	0x0a, 0xcc, 0xea, 0xfe, 0x12, 0xe5, 0x61, 0xc6, 0xb6, 0x08, 0xe5, 0x36, 0x1f, 0x7a, 0x6e, 0xd2,
-	0x83, 0xb5, 0xe0, 0xba, 0x47, 0x9a, 0xcc, 0xf6, 0xed, 0xac, 0xfb, 0x4b, 0x74, 0x05, 0xb2, 0x8c,
-	0x98, 0x03, 0xd7, 0xe6, 0x43, 0xc3, 0x74, 0x28, 0xc7, 0x26, 0xcf, 0x25, 0x24, 0x49, 0xc6, 0xdf,
-	0x5f, 0xf5, 0xb6, 0x05, 0x88, 0x45, 0x38, 0xb6, 0xbb, 0x2c, 0x77, 0xca, 0x03, 0x51, 0x4b, 0x25,
-	0xea, 0xee, 0x34, 0xcc, 0x06, 0xa9, 0x83, 0x56, 0x21, 0xeb, 0xf4, 0x89, 0x2b, 0x7e, 0x1b, 0xd8,
-	0xb2, 0x5c, 0xc2, 0x98, 0x8a, 0xc6, 0xdc, 0x93, 0x47, 0xd7, 0xce, 0x28, 0x83, 0xdf, 0xf2, 0x4e,
-	0x1a, 0xdc, 0xb5, 0x69, 0x5b, 0xcf, 0xf8, 0x1c, 0x6a, 0x1b, 0xbd, 0x2b, 0x5c, 0x46, 0x19, 0xa1,
-	0x6c, 0xc0, 0x8c, 0xfe, 0xa0, 0xb9, 0x43, 0x86, 0xca, 0xa8, 0x67, 0xc6, 0x8c, 0x7a, 0x8b, 0x0e,
-	0x2b, 0xb9, 0x3f, 0x86, 0xd0, 0xa6, 0x3b, 0xec, 0x73, 0xa7, 0xbc, 0x31, 0x68, 0xbe, 0x45, 0x86,
-	0xc2, 0x55, 0x0a, 0x67, 0x43, 0xc2, 0xa0, 0x73, 0x90, 0xfc, 0x31, 0xb6, 0xbb, 0xc4, 0x92, 0x16,
-	0x99, 0xd1, 0xd5, 0x0a, 0xad, 0x40, 0x92, 0x71, 0xcc, 0x07, 0x4c, 0x9a, 0x61, 0xfe, 0x46, 0x69,
-	0x52, 0x6c, 0x54, 0x1c, 0x6a, 0x35, 0x24, 0xa5, 0xae, 0x38, 0xd0, 0x2a, 0x24, 0xb9, 0xb3, 0x43,
-	0xa8, 0x32, 0x50, 0xe5, 0x4b, 0x2a, 0x9a, 0xcf, 0x8e, 0x47, 0x73, 0x9d, 0xf2, 0x48, 0x1c, 0xd7,
-	0x29, 0xd7, 0x15, 0x2b, 0xfa, 0x01, 0x64, 0x2d, 0xd2, 0x25, 0x6d, 0x69, 0x39, 0xd6, 0xc1, 0x2e,
-	0x61, 0xb9, 0xa4, 0x84, 0xbb, 0x7e, 0xec, 0xe4, 0xd0, 0x33, 0x01, 0x54, 0x43, 0x22, 0xa1, 0x0d,
-	0x48, 0x59, 0x61, 0x38, 0xe5, 0xa6, 0xa5, 0x31, 0x5f, 0x9b, 0xa4, 0x63, 0x24, 0xf2, 0xa2, 0xb5,
-	0x30, 0x0a, 0x21, 0x22, 0x68, 0x40, 0x9b, 0x0e, 0xb5, 0x6c, 0xda, 0x36, 0x3a, 0xc4, 0x6e, 0x77,
-	0x78, 0x6e, 0xa6, 0xa8, 0x5d, 0x8e, 0xeb, 0x99, 0x60, 0xff, 0x8e, 0xdc, 0x46, 0x1b, 0x30, 0x1f,
-	0x92, 0xca, 0x0c, 0x99, 0x3d, 0x6e, 0x86, 0xcc, 0x05, 0x00, 0x82, 0x04, 0xbd, 0x03, 0x10, 0xe6,
-	0x60, 0x0e, 0x24, 0x5a, 0xe9, 0xf0, 0x6c, 0x8e, 0x2a, 0x13, 0x01, 0x40, 0xdf, 0x87, 0xd3, 0x3d,
-	0x9b, 0x1a, 0x8c, 0x74, 0x5b, 0x86, 0xb2, 0x9c, 0xc0, 0x4d, 0x1d, 0xdf, 0x9b, 0x0b, 0x3d, 0x9b,
-	0x36, 0x48, 0xb7, 0x55, 0x0d, 0x50, 0xd0, 0x37, 0xe0, 0x42, 0xa8, 0xbd, 0x43, 0x8d, 0x8e, 0xd3,
-	0xb5, 0x0c, 0x97, 0xb4, 0x0c, 0xd3, 0x19, 0x50, 0x9e, 0x4b, 0x4b, 0x9b, 0x9d, 0x0f, 0x48, 0xd6,
-	0xe9, 0x1d, 0xa7, 0x6b, 0xe9, 0xa4, 0xb5, 0x2a, 0x8e, 0xd1, 0x6b, 0x10, 0xaa, 0x6e, 0xd8, 0x16,
-	0xcb, 0xcd, 0x15, 0xe3, 0x97, 0x13, 0x7a, 0x3a, 0xd8, 0xac, 0x5b, 0x6c, 0x65, 0xe6, 0xbd, 0x87,
-	0x85, 0xa9, 0x4f, 0x1e, 0x16, 0xa6, 0x4a, 0xb7, 0x21, 0xbd, 0x8d, 0xbb, 0x2a, 0x8f, 0x08, 0x43,
-	0x37, 0x61, 0x16, 0xfb, 0x8b, 0x9c, 0x56, 0x8c, 0xbf, 0x30, 0x0f, 0x43, 0xd2, 0xd2, 0x6f, 0x35,
-	0x48, 0x56, 0xb7, 0x37, 0xb0, 0xed, 0xa2, 0x1a, 0x2c, 0x84, 0x81, 0x79, 0xd4, 0x94, 0x0e, 0x63,
-	0xd9, 0xcf, 0xe9, 0x35, 0x58, 0x08, 0x2e, 0xb0, 0x00, 0xc6, 0xbb, 0x57, 0x2e, 0x3e, 0x79, 0x74,
-	0xed, 0x55, 0x05, 0x13, 0x54, 0x92, 0x7d, 0x78, 0x77, 0xf7, 0xed, 0x47, 0x74, 0x7e, 0x13, 0xa6,
-	0x3d, 0x51, 0x19, 0xfa, 0x16, 0x9c, 0xea, 0x8b, 0x1f, 0x52, 0xd5, 0xd4, 0x8d, 0xa5, 0x89, 0x01,
-	0x2e, 0xe9, 0xa3, 0xe1, 0xe0, 0xf1, 0x95, 0xde, 0x8f, 0x01, 0x54, 0xb7, 0xb7, 0x37, 0x5d, 0xbb,
-	0xdf, 0x25, 0xfc, 0xd3, 0xd2, 0x7d, 0x0b, 0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7,
-	0x03, 0xfe, 0x86, 0x6b, 0x1e, 0x08, 0x6b, 0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x65, 0x7c,
-	0xdc, 0xb2, 0xdf, 0x85, 0x54, 0x68, 0x0c, 0x86, 0xea, 0x30, 0xc3, 0xd5, 0x6f, 0x65, 0xe0, 0xd2,
-	0x64, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07, 0xec, 0xa5, 0xff, 0x68, 0x00, 0x91, 0x1c, 0xf9, 0x6c,
-	0xc6, 0x18, 0xaa, 0x43, 0x52, 0x55, 0xe2, 0xf8, 0x49, 0x2b, 0xb1, 0x02, 0x88, 0x18, 0xf5, 0x67,
-	0x31, 0x38, 0xbd, 0xe5, 0x67, 0xef, 0x67, 0xdf, 0x06, 0x5b, 0x30, 0x4d, 0x28, 0x77, 0x6d, 0x69,
-	0x04, 0xe1, 0xf3, 0xaf, 0x4c, 0xf2, 0xf9, 0x01, 0x4a, 0xd5, 0x28, 0x77, 0x87, 0xd1, 0x08, 0xf0,
-	0xb1, 0x22, 0xf6, 0xf8, 0x55, 0x1c, 0x72, 0x93, 0x58, 0xc5, 0x6b, 0xd8, 0x74, 0x89, 0xdc, 0xf0,
-	0x2f, 0x19, 0x4d, 0x16, 0xcc, 0x79, 0x7f, 0x5b, 0xdd, 0x31, 0x3a, 0x88, 0x57, 0x99, 0x08, 0x2e,
-	0x41, 0x7a, 0xb2, 0x67, 0xd8, 0x7c, 0x88, 0x20, 0x6f, 0x99, 0x4d, 0xc8, 0xd8, 0xd4, 0xe6, 0x36,
-	0xee, 0x1a, 0x4d, 0xdc, 0xc5, 0xd4, 0xf4, 0x9f, 0xab, 0xc7, 0xba, 0x12, 0xe6, 0x15, 0x46, 0xc5,
-	0x83, 0x40, 0x35, 0x98, 0xf6, 0xd1, 0x12, 0xc7, 0x47, 0xf3, 0x79, 0xd1, 0x45, 0x48, 0x47, 0x2f,
-	0x06, 0xf9, 0xf4, 0x48, 0xe8, 0xa9, 0xc8, 0xbd, 0x70, 0xd8, 0xcd, 0x93, 0x7c, 0xe1, 0xcd, 0xa3,
-	0x5e, 0x77, 0xbf, 0x8e, 0xc3, 0x82, 0x4e, 0xac, 0xff, 0x7f, 0xb7, 0x6c, 0x00, 0x78, 0xa9, 0x2a,
-	0x2a, 0xa9, 0xf2, 0xcc, 0x09, 0xf2, 0x7d, 0xd6, 0x03, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f,
-	0x63, 0x90, 0x8e, 0x7a, 0xe8, 0x73, 0x79, 0x69, 0xa1, 0xb5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba,
-	0x32, 0xa9, 0x4c, 0x8d, 0x45, 0xf3, 0x21, 0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x1b, 0xd8, 0xc5, 0x3d,
-	0x86, 0xd6, 0xc7, 0x1e, 0xb2, 0x5e, 0x23, 0xb9, 0x38, 0x16, 0xcc, 0x55, 0x35, 0x7d, 0xf1, 0x62,
-	0xf9, 0x97, 0x93, 0xde, 0xb1, 0x5f, 0x80, 0x79, 0xd1, 0x10, 0x87, 0x9d, 0xbd, 0x34, 0xee, 0x9c,
-	0xec, 0x6b, 0x03, 0xed, 0x19, 0x2a, 0x40, 0x4a, 0x90, 0x85, 0x75, 0x58, 0xd0, 0x40, 0x0f, 0xdf,
-	0xaf, 0x79, 0x3b, 0xe8, 0x1a, 0xa0, 0x4e, 0x30, 0x98, 0x30, 0x42, 0x43, 0x08, 0xba, 0x85, 0xf0,
-	0xc4, 0x27, 0x7f, 0x15, 0x40, 0x48, 0x61, 0x58, 0x84, 0x3a, 0x3d, 0xd5, 0xd5, 0xcd, 0x8a, 0x9d,
-	0xaa, 0xd8, 0x40, 0x3f, 0xd5, 0xbc, 0xf7, 0xf0, 0xbe, 0xb6, 0x59, 0xb5, 0x23, 0x9b, 0x47, 0x48,
-	0x8a, 0x7f, 0xef, 0x15, 0xf2, 0x43, 0xdc, 0xeb, 0xae, 0x94, 0x0e, 0xc0, 0x29, 0x1d, 0xd4, 0xc9,
-	0x8b, 0x87, 0xf3, 0x68, 0xdb, 0x8d, 0xea, 0x90, 0xdd, 0x21, 0x43, 0xc3, 0x75, 0xb8, 0x57, 0x68,
-	0x5a, 0x84, 0xa8, 0xc6, 0x65, 0xd1, 0xf7, 0x6d, 0x13, 0x33, 0x12, 0x79, 0xe7, 0xdb, 0xb4, 0x92,
-	0x10, 0xd2, 0xe9, 0xf3, 0x3b, 0x64, 0xa8, 0x2b, 0xbe, 0xdb, 0x84, 0xac, 0x5c, 0x12, 0x99, 0xb2,
-	0xfb, 0xfc, 0xa3, 0xab, 0x4a, 0xe8, 0x6b, 0xcc, 0xda, 0x59, 0xbe, 0x1f, 0xcc, 0xe6, 0x3c, 0xf7,
-	0x8a, 0x47, 0x2f, 0x0a, 0x2f, 0x20, 0x9d, 0xb0, 0xbe, 0x68, 0x1e, 0x45, 0xb3, 0x11, 0x69, 0x0a,
-	0xb4, 0x17, 0x37, 0x1b, 0x21, 0xff, 0x48, 0xb3, 0x11, 0x49, 0xcf, 0x6f, 0x86, 0xf5, 0x3f, 0x76,
-	0x98, 0x36, 0xd1, 0xc8, 0x54, 0x4c, 0x32, 0xeb, 0xa7, 0x4a, 0x7f, 0xd2, 0x60, 0x71, 0x2c, 0x92,
-	0x03, 0x91, 0x4d, 0x40, 0x6e, 0xe4, 0x50, 0x46, 0xc4, 0x50, 0x89, 0x7e, 0xb2, 0xc4, 0x58, 0x70,
-	0xc7, 0x2e, 0x81, 0x4f, 0xe7, 0x22, 0x53, 0x55, 0xec, 0x0f, 0x1a, 0x9c, 0x89, 0x0a, 0x10, 0xa8,
-	0xd2, 0x80, 0x74, 0xf4, 0xd3, 0x4a, 0x89, 0x4b, 0x47, 0x51, 0x22, 0x2a, 0xff, 0x08, 0x08, 0xda,
-	0x0e, 0xab, 0x85, 0x37, 0x14, 0xbc, 0x7e, 0x64, 0xa3, 0xf8, 0x82, 0x1d, 0x58, 0x35, 0x3c, 0xdf,
-	0xfc, 0x53, 0x83, 0xc4, 0x86, 0xe3, 0x74, 0xd1, 0x4f, 0x60, 0x81, 0x3a, 0xdc, 0x10, 0x99, 0x45,
-	0x2c, 0x43, 0xcd, 0x08, 0xbc, 0x4a, 0x5c, 0x7b, 0xa1, 0xad, 0xfe, 0xb1, 0x57, 0x18, 0xe7, 0x1c,
-	0x35, 0xa0, 0x1a, 0x45, 0x51, 0x87, 0x57, 0x24, 0xd1, 0xa6, 0x37, 0x46, 0x68, 0xc1, 0xdc, 0xe8,
-	0xe7, 0xbc, 0x6a, 0x7d, 0xeb, 0xb0, 0xcf, 0xcd, 0x1d, 0xfa, 0xa9, 0x74, 0x33, 0xf2, 0x9d, 0x95,
-	0x19, 0xe1, 0xb5, 0x7f, 0x09, 0xcf, 0xbd, 0x0b, 0xd9, 0xa0, 0x54, 0x6d, 0xc9, 0x39, 0x16, 0x13,
-	0xa1, 0xe1, 0x8d, 0xb4, 0xfc, 0x46, 0xa1, 0x18, 0x9d, 0xd8, 0xe2, 0xa6, 0x69, 0x97, 0xf7, 0xf1,
-	0x8c, 0x98, 0x53, 0xf1, 0x96, 0x1e, 0xc7, 0x60, 0x71, 0xd5, 0xa1, 0x4c, 0x0d, 0x73, 0x54, 0x42,
-	0x7b, 0x23, 0xd8, 0x21, 0xba, 0x32, 0x61, 0xd4, 0x94, 0x1e, 0x1f, 0x28, 0x6d, 0x43, 0x46, 0xdc,
-	0xac, 0xa6, 0x43, 0x5f, 0x72, 0x9e, 0x34, 0xe7, 0x74, 0x2d, 0x25, 0xd1, 0x0e, 0x19, 0x0a, 0x5c,
-	0x4a, 0xee, 0x8d, 0xe0, 0xc6, 0x4f, 0x86, 0x4b, 0xc9, 0xbd, 0x08, 0xee, 0x39, 0x48, 0xaa, 0x67,
-	0x55, 0x42, 0x3e, 0x1a, 0xd4, 0x0a, 0xdd, 0x84, 0xb8, 0xa8, 0x82, 0xa7, 0x8e, 0x51, 0x37, 0x04,
-	0x43, 0xe4, 0x36, 0x6b, 0xc0, 0xa2, 0x1a, 0x10, 0xb0, 0xf5, 0x96, 0xb4, 0x28, 0x91, 0x0a, 0xbd,
-	0x45, 0x86, 0x07, 0x4c, 0x0b, 0xd2, 0x47, 0x9a, 0x16, 0x5c, 0xfd, 0x9d, 0x06, 0x10, 0xce, 0xc5,
-	0xd0, 0x97, 0xe1, 0x7c, 0x65, 0x7d, 0xad, 0x6a, 0x34, 0x36, 0x6f, 0x6d, 0x6e, 0x35, 0x8c, 0xad,
-	0xb5, 0xc6, 0x46, 0x6d, 0xb5, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xa7, 0xf2, 0x99, 0xdd, 0x07, 0xc5,
-	0xd4, 0x16, 0x65, 0x7d, 0x62, 0xda, 0x2d, 0x9b, 0x58, 0xe8, 0x8b, 0x70, 0x66, 0x94, 0x5a, 0xac,
-	0x6a, 0xd5, 0xac, 0x96, 0x4f, 0xef, 0x3e, 0x28, 0xce, 0x78, 0xad, 0x01, 0xb1, 0xd0, 0x65, 0x38,
-	0x3b, 0x4e, 0x57, 0x5f, 0x7b, 0x23, 0x1b, 0xcb, 0xcf, 0xed, 0x3e, 0x28, 0xce, 0x06, 0x3d, 0x04,
-	0x2a, 0x01, 0x8a, 0x52, 0x2a, 0xbc, 0x78, 0x1e, 0x76, 0x1f, 0x14, 0x93, 0x5e, 0xb6, 0xe4, 0x13,
-	0xef, 0xfd, 0x66, 0x69, 0xea, 0xea, 0x0f, 0x01, 0xea, 0xb4, 0xe5, 0x62, 0x53, 0x56, 0x85, 0x3c,
-	0x9c, 0xab, 0xaf, 0xdd, 0xd6, 0x6f, 0xad, 0x6e, 0xd6, 0xd7, 0xd7, 0x46, 0xc5, 0xde, 0x77, 0x56,
-	0x5d, 0xdf, 0xaa, 0xbc, 0x5d, 0x33, 0x1a, 0xf5, 0x37, 0xd6, 0xb2, 0x1a, 0x3a, 0x0f, 0xa7, 0x47,
-	0xce, 0xbe, 0xb3, 0xb6, 0x59, 0x7f, 0xa7, 0x96, 0x8d, 0x55, 0x6e, 0x7e, 0xfc, 0x74, 0x49, 0x7b,
-	0xfc, 0x74, 0x49, 0xfb, 0xfb, 0xd3, 0x25, 0xed, 0x83, 0x67, 0x4b, 0x53, 0x8f, 0x9f, 0x2d, 0x4d,
-	0xfd, 0xf9, 0xd9, 0xd2, 0xd4, 0xf7, 0x5e, 0x19, 0xc9, 0xc3, 0xf0, 0x26, 0x92, 0xff, 0xcc, 0x68,
-	0x26, 0x65, 0xd4, 0x7c, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xe0, 0x99, 0x08, 0x44,
-	0x1a, 0x00, 0x00,
+	// 2169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3d, 0x52, 0x22, 0x35, 0xfe, 0xa2, 0xe8, 0x44, 0x94, 0x19, 0xff,
+	0xff, 0x71, 0xdc, 0x9a, 0x8a, 0xdd, 0xc2, 0x07, 0xb5, 0x68, 0x61, 0x8a, 0x72, 0xc4, 0xc4, 0x91,
+	0xd4, 0xa5, 0xa4, 0x36, 0xfd, 0x5a, 0x0c, 0x77, 0x87, 0xe4, 0x56, 0xcb, 0x59, 0x76, 0x67, 0x64,
+	0x9b, 0xf7, 0x1e, 0x02, 0x05, 0x01, 0x7c, 0x6a, 0x0b, 0x14, 0x46, 0x0d, 0xf4, 0x92, 0xde, 0x72,
+	0x30, 0x7a, 0x2c, 0xd0, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x51, 0xa0, 0x4a, 0x61, 0x1f, 0x12,
+	0xb4, 0x97, 0xa2, 0xa7, 0x1e, 0x8b, 0x99, 0x9d, 0xfd, 0xa0, 0x28, 0x59, 0xa2, 0x6c, 0x14, 0x41,
+	0x7b, 0x11, 0x38, 0x33, 0xef, 0xfd, 0xf6, 0xbd, 0x37, 0xef, 0x63, 0xde, 0x13, 0x5c, 0x32, 0x5d,
+	0xd6, 0x75, 0xd9, 0x02, 0xe3, 0x78, 0xdb, 0xa6, 0xed, 0x85, 0x3b, 0xd7, 0x9a, 0x84, 0xe3, 0x6b,
+	0xc1, 0xba, 0xd2, 0xf3, 0x5c, 0xee, 0xa2, 0x73, 0x3e, 0x55, 0x25, 0xd8, 0x55, 0x54, 0xc5, 0x33,
+	0x6d, 0xb7, 0xed, 0x4a, 0x92, 0x05, 0xf1, 0xcb, 0xa7, 0x2e, 0xce, 0xb6, 0x5d, 0xb7, 0xed, 0x90,
+	0x05, 0xb9, 0x6a, 0xee, 0xb4, 0x16, 0x30, 0xed, 0xab, 0xa3, 0xb9, 0xfd, 0x47, 0xd6, 0x8e, 0x87,
+	0xb9, 0xed, 0x52, 0x75, 0x5e, 0xda, 0x7f, 0xce, 0xed, 0x2e, 0x61, 0x1c, 0x77, 0x7b, 0x01, 0xb6,
+	0x2f, 0x89, 0xe1, 0x7f, 0x54, 0x89, 0xa5, 0xb0, 0x95, 0x2a, 0x4d, 0xcc, 0x48, 0xa8, 0x87, 0xe9,
+	0xda, 0x01, 0xf6, 0x0c, 0xee, 0xda, 0xd4, 0x5d, 0x90, 0x7f, 0xd5, 0xd6, 0x2b, 0x9c, 0x50, 0x8b,
+	0x78, 0x5d, 0x9b, 0xf2, 0x05, 0xde, 0xef, 0x11, 0xe6, 0xff, 0x55, 0xa7, 0x17, 0x62, 0xa7, 0xb8,
+	0x69, 0xda, 0xf1, 0xc3, 0xf2, 0xcf, 0x34, 0x98, 0x5e, 0xb1, 0x19, 0x77, 0x3d, 0xdb, 0xc4, 0x4e,
+	0x9d, 0xb6, 0x5c, 0xf4, 0x35, 0x48, 0x77, 0x08, 0xb6, 0x88, 0x57, 0xd0, 0xe6, 0xb5, 0xcb, 0x99,
+	0xeb, 0x85, 0x4a, 0x04, 0x50, 0xf1, 0x79, 0x57, 0xe4, 0x79, 0x75, 0xf2, 0x93, 0xbd, 0xd2, 0xd8,
+	0x47, 0x9f, 0x7d, 0x7c, 0x45, 0xd3, 0x15, 0x0b, 0xaa, 0x41, 0xfa, 0x0e, 0x76, 0x18, 0xe1, 0x85,
+	0xc4, 0x7c, 0xf2, 0x72, 0xe6, 0xfa, 0xc5, 0xca, 0xc1, 0x36, 0xaf, 0x6c, 0x61, 0xc7, 0xb6, 0x30,
+	0x77, 0x07, 0x51, 0x7c, 0xde, 0xc5, 0x44, 0x41, 0x2b, 0x7f, 0xa0, 0x41, 0x3e, 0x92, 0x4c, 0x27,
+	0xa6, 0xeb, 0x59, 0xa8, 0x00, 0xe3, 0xb8, 0xd7, 0xeb, 0x60, 0xd6, 0x91, 0xc2, 0x65, 0xf5, 0x60,
+	0x89, 0xbe, 0x0a, 0x29, 0x61, 0xe4, 0x42, 0x42, 0xca, 0x5c, 0xac, 0xf8, 0x37, 0x50, 0x09, 0x6e,
+	0xa0, 0xb2, 0x11, 0xdc, 0x40, 0x35, 0x75, 0xff, 0xd3, 0x92, 0xa6, 0x4b, 0x6a, 0xf4, 0x3a, 0xe4,
+	0xee, 0x04, 0x82, 0x30, 0x43, 0xe2, 0x26, 0x25, 0xee, 0x74, 0xb4, 0xbd, 0x82, 0x59, 0xa7, 0xfc,
+	0xd3, 0x04, 0xe4, 0x96, 0xdc, 0x6e, 0xd7, 0x66, 0xcc, 0x76, 0xa9, 0x8e, 0x39, 0x61, 0xe8, 0x6d,
+	0x48, 0x79, 0x98, 0x13, 0x29, 0xc9, 0x64, 0xf5, 0x86, 0x50, 0xe3, 0xcf, 0x7b, 0xa5, 0x0b, 0xbe,
+	0xc2, 0xcc, 0xda, 0xae, 0xd8, 0xee, 0x42, 0x17, 0xf3, 0x4e, 0xe5, 0x36, 0x69, 0x63, 0xb3, 0x5f,
+	0x23, 0xe6, 0x93, 0x47, 0x57, 0x41, 0xd9, 0xa3, 0x46, 0x4c, 0x5f, 0x67, 0x89, 0x81, 0xbe, 0x05,
+	0x13, 0x5d, 0x7c, 0xcf, 0x90, 0x78, 0x89, 0x17, 0xc2, 0x1b, 0xef, 0xe2, 0x7b, 0x42, 0x3e, 0xf4,
+	0x43, 0xc8, 0x09, 0x48, 0xb3, 0x83, 0x69, 0x9b, 0xf8, 0xc8, 0xc9, 0x17, 0x42, 0x9e, 0xea, 0xe2,
+	0x7b, 0x4b, 0x12, 0x4d, 0xe0, 0x2f, 0xa6, 0x3e, 0x7f, 0x58, 0xd2, 0xca, 0xbf, 0xd3, 0x00, 0x22,
+	0xc3, 0x20, 0x0c, 0x79, 0x33, 0x5c, 0xc9, 0x8f, 0x32, 0xe5, 0x46, 0xaf, 0x1f, 0xe6, 0x09, 0xfb,
+	0xcc, 0x5a, 0x9d, 0x12, 0xe2, 0x3d, 0xde, 0x2b, 0x69, 0xfe, 0x57, 0x73, 0xe6, 0x90, 0xd9, 0x33,
+	0x3b, 0x3d, 0x0b, 0x73, 0x62, 0x1c, 0xf3, 0xc2, 0x25, 0xe0, 0xfd, 0x4f, 0x03, 0x40, 0xf0, 0xb9,
+	0xc5, 0xb9, 0xd2, 0xe1, 0x23, 0x0d, 0x32, 0x35, 0xc2, 0x4c, 0xcf, 0xee, 0x89, 0x20, 0x16, 0x5e,
+	0xd6, 0x75, 0xa9, 0xbd, 0xad, 0x42, 0x60, 0x52, 0x0f, 0x96, 0xa8, 0x08, 0x13, 0xb6, 0x45, 0x28,
+	0xb7, 0x79, 0xdf, 0xbf, 0x26, 0x3d, 0x5c, 0x0b, 0xae, 0xbb, 0xa4, 0xc9, 0xec, 0xc0, 0xce, 0x7a,
+	0xb0, 0x44, 0x6f, 0x40, 0x9e, 0x11, 0x73, 0xc7, 0xb3, 0x79, 0xdf, 0x30, 0x5d, 0xca, 0xb1, 0xc9,
+	0x0b, 0x29, 0x49, 0x92, 0x0b, 0xf6, 0x97, 0xfc, 0x6d, 0x01, 0x62, 0x11, 0x8e, 0x6d, 0x87, 0x15,
+	0x4e, 0xf9, 0x20, 0x6a, 0xa9, 0x44, 0xdd, 0x1d, 0x87, 0xc9, 0x30, 0x74, 0xd0, 0x12, 0xe4, 0xdd,
+	0x1e, 0xf1, 0xc4, 0x6f, 0x03, 0x5b, 0x96, 0x47, 0x18, 0x53, 0xde, 0x58, 0x78, 0xf2, 0xe8, 0xea,
+	0x19, 0x65, 0xf0, 0x9b, 0xfe, 0x49, 0x83, 0x7b, 0x36, 0x6d, 0xeb, 0xb9, 0x80, 0x43, 0x6d, 0xa3,
+	0xf7, 0xc4, 0x95, 0x51, 0x46, 0x28, 0xdb, 0x61, 0x46, 0x6f, 0xa7, 0xb9, 0x4d, 0xfa, 0xca, 0xa8,
+	0x67, 0x86, 0x8c, 0x7a, 0x93, 0xf6, 0xab, 0x85, 0x3f, 0x44, 0xd0, 0xa6, 0xd7, 0xef, 0x71, 0xb7,
+	0xb2, 0xbe, 0xd3, 0x7c, 0x87, 0xf4, 0xf5, 0x5c, 0x88, 0xb3, 0x2e, 0x61, 0xd0, 0x39, 0x48, 0xff,
+	0x08, 0xdb, 0x0e, 0xb1, 0xa4, 0x45, 0x26, 0x74, 0xb5, 0x42, 0x8b, 0x90, 0x66, 0x1c, 0xf3, 0x1d,
+	0x26, 0xcd, 0x30, 0x7d, 0xbd, 0x7c, 0x98, 0x6f, 0x54, 0x5d, 0x6a, 0x35, 0x24, 0xa5, 0xae, 0x38,
+	0xd0, 0x12, 0xa4, 0xb9, 0xbb, 0x4d, 0xa8, 0x32, 0x50, 0xf5, 0x4b, 0xca, 0x9b, 0xcf, 0x0e, 0x7b,
+	0x73, 0x9d, 0xf2, 0x98, 0x1f, 0xd7, 0x29, 0xd7, 0x15, 0x2b, 0xfa, 0x3e, 0xe4, 0x2d, 0xe2, 0x90,
+	0xb6, 0xb4, 0x1c, 0xeb, 0x60, 0x8f, 0xb0, 0x42, 0x5a, 0xc2, 0x5d, 0x1b, 0x39, 0x38, 0xf4, 0x5c,
+	0x08, 0xd5, 0x90, 0x48, 0x68, 0x1d, 0x32, 0x56, 0xe4, 0x4e, 0x85, 0x71, 0x69, 0xcc, 0xd7, 0x0e,
+	0xd3, 0x31, 0xe6, 0x79, 0xf1, 0x5c, 0x18, 0x87, 0x10, 0x1e, 0xb4, 0x43, 0x9b, 0x2e, 0xb5, 0x6c,
+	0xda, 0x36, 0x3a, 0xc4, 0x6e, 0x77, 0x78, 0x61, 0x62, 0x5e, 0xbb, 0x9c, 0xd4, 0x73, 0xe1, 0xfe,
+	0x8a, 0xdc, 0x46, 0xeb, 0x30, 0x1d, 0x91, 0xca, 0x08, 0x99, 0x1c, 0x35, 0x42, 0xa6, 0x42, 0x00,
+	0x41, 0x82, 0xde, 0x05, 0x88, 0x62, 0xb0, 0x00, 0x12, 0xad, 0x7c, 0x74, 0x34, 0xc7, 0x95, 0x89,
+	0x01, 0xa0, 0xef, 0xc1, 0xe9, 0xae, 0x4d, 0x0d, 0x46, 0x9c, 0x96, 0xa1, 0x2c, 0x27, 0x70, 0x33,
+	0xa3, 0xdf, 0xe6, 0x4c, 0xd7, 0xa6, 0x0d, 0xe2, 0xb4, 0x6a, 0x21, 0x0a, 0xfa, 0x3a, 0x5c, 0x88,
+	0xb4, 0x77, 0xa9, 0xd1, 0x71, 0x1d, 0xcb, 0xf0, 0x48, 0xcb, 0x30, 0xdd, 0x1d, 0xca, 0x0b, 0x59,
+	0x69, 0xb3, 0xf3, 0x21, 0xc9, 0x1a, 0x5d, 0x71, 0x1d, 0x4b, 0x27, 0xad, 0x25, 0x71, 0x8c, 0x5e,
+	0x83, 0x48, 0x75, 0xc3, 0xb6, 0x58, 0x61, 0x6a, 0x3e, 0x79, 0x39, 0xa5, 0x67, 0xc3, 0xcd, 0xba,
+	0xc5, 0x16, 0x27, 0xde, 0x7f, 0x58, 0x1a, 0xfb, 0xfc, 0x61, 0x69, 0xac, 0x7c, 0x0b, 0xb2, 0x5b,
+	0xd8, 0x51, 0x71, 0x44, 0x18, 0xba, 0x01, 0x93, 0x38, 0x58, 0x14, 0xb4, 0xf9, 0xe4, 0x73, 0xe3,
+	0x30, 0x22, 0x2d, 0xff, 0x5a, 0x83, 0x74, 0x6d, 0x6b, 0x1d, 0xdb, 0x1e, 0x5a, 0x86, 0x99, 0xc8,
+	0x31, 0x8f, 0x1b, 0xd2, 0x91, 0x2f, 0x07, 0x31, 0xbd, 0x0a, 0x33, 0x61, 0x01, 0x0b, 0x61, 0xfc,
+	0xba, 0x72, 0xf1, 0xc9, 0xa3, 0xab, 0xaf, 0x2a, 0x98, 0x30, 0x93, 0xec, 0xc3, 0xbb, 0xb3, 0x6f,
+	0x3f, 0xa6, 0xf3, 0xdb, 0x30, 0xee, 0x8b, 0xca, 0xd0, 0x37, 0xe1, 0x54, 0x4f, 0xfc, 0x90, 0xaa,
+	0x66, 0xae, 0xcf, 0x1d, 0xea, 0xe0, 0x92, 0x3e, 0xee, 0x0e, 0x3e, 0x5f, 0xf9, 0x83, 0x04, 0x40,
+	0x6d, 0x6b, 0x6b, 0xc3, 0xb3, 0x7b, 0x0e, 0xe1, 0x2f, 0x4b, 0xf7, 0x4d, 0x38, 0x1b, 0xe9, 0xce,
+	0x3c, 0x73, 0x74, 0xfd, 0x4f, 0x87, 0xfc, 0x0d, 0xcf, 0x3c, 0x10, 0xd6, 0x62, 0x3c, 0x84, 0x4d,
+	0x8e, 0x0e, 0x5b, 0x63, 0x7c, 0xd8, 0xb2, 0xdf, 0x81, 0x4c, 0x64, 0x0c, 0x86, 0xea, 0x30, 0xc1,
+	0xd5, 0x6f, 0x65, 0xe0, 0xf2, 0xe1, 0x06, 0x0e, 0xd8, 0xe2, 0x46, 0x0e, 0xd9, 0xcb, 0xff, 0xd2,
+	0x00, 0x62, 0x31, 0xf2, 0xc5, 0xf4, 0x31, 0x54, 0x87, 0xb4, 0xca, 0xc4, 0xc9, 0x93, 0x66, 0x62,
+	0x05, 0x10, 0x33, 0xea, 0x87, 0x09, 0x38, 0xbd, 0x19, 0x44, 0xef, 0x17, 0xdf, 0x06, 0x9b, 0x30,
+	0x4e, 0x28, 0xf7, 0x6c, 0x69, 0x04, 0x71, 0xe7, 0x6f, 0x1e, 0x76, 0xe7, 0x07, 0x28, 0xb5, 0x4c,
+	0xb9, 0xd7, 0x8f, 0x7b, 0x40, 0x80, 0x15, 0xb3, 0xc7, 0x2f, 0x92, 0x50, 0x38, 0x8c, 0x55, 0xbc,
+	0x86, 0x4d, 0x8f, 0xc8, 0x8d, 0xa0, 0xc8, 0x68, 0x32, 0x61, 0x4e, 0x07, 0xdb, 0xaa, 0xc6, 0xe8,
+	0x20, 0x5e, 0x65, 0xc2, 0xb9, 0x04, 0xe9, 0xc9, 0x9e, 0x61, 0xd3, 0x11, 0x82, 0xac, 0x32, 0x1b,
+	0x90, 0xb3, 0xa9, 0xcd, 0x6d, 0xec, 0x18, 0x4d, 0xec, 0x60, 0x6a, 0x06, 0xcf, 0xd5, 0x91, 0x4a,
+	0xc2, 0xb4, 0xc2, 0xa8, 0xfa, 0x10, 0x68, 0x19, 0xc6, 0x03, 0xb4, 0xd4, 0xe8, 0x68, 0x01, 0x2f,
+	0xba, 0x08, 0xd9, 0x78, 0x61, 0x90, 0x4f, 0x8f, 0x94, 0x9e, 0x89, 0xd5, 0x85, 0xa3, 0x2a, 0x4f,
+	0xfa, 0xb9, 0x95, 0x47, 0xbd, 0xee, 0x7e, 0x99, 0x84, 0x19, 0x9d, 0x58, 0xff, 0xfd, 0xd7, 0xb2,
+	0x0e, 0xe0, 0x87, 0xaa, 0xc8, 0xa4, 0x85, 0xd4, 0x49, 0xe3, 0x7d, 0xd2, 0x07, 0xa9, 0x31, 0xfe,
+	0x9f, 0xba, 0xa1, 0xbf, 0x24, 0x20, 0x1b, 0xbf, 0xa1, 0xff, 0xc9, 0xa2, 0x85, 0x56, 0xa3, 0x34,
+	0x95, 0x92, 0x69, 0xea, 0x8d, 0xc3, 0xd2, 0xd4, 0x90, 0x37, 0x1f, 0x91, 0x9f, 0x7e, 0x9b, 0x82,
+	0xf4, 0x3a, 0xf6, 0x70, 0x97, 0xa1, 0xb5, 0xa1, 0x87, 0xac, 0xdf, 0x48, 0xce, 0x0e, 0x39, 0x73,
+	0x4d, 0x4d, 0x5f, 0x7c, 0x5f, 0xfe, 0xf9, 0x61, 0xef, 0xd8, 0xff, 0x83, 0x69, 0xd1, 0x10, 0x87,
+	0x0a, 0xf9, 0xc6, 0x9d, 0x92, 0x7d, 0x6d, 0xa8, 0x3d, 0x43, 0x25, 0xc8, 0x08, 0xb2, 0x28, 0x0f,
+	0x0b, 0x1a, 0xe8, 0xe2, 0x7b, 0xcb, 0xfe, 0x0e, 0xba, 0x0a, 0xa8, 0x13, 0x0e, 0x26, 0x8c, 0xc8,
+	0x10, 0x82, 0x6e, 0x26, 0x3a, 0x09, 0xc8, 0x5f, 0x05, 0x10, 0x52, 0x18, 0x16, 0xa1, 0x6e, 0x57,
+	0x75, 0x75, 0x93, 0x62, 0xa7, 0x26, 0x36, 0xd0, 0x4f, 0x34, 0xff, 0x3d, 0xbc, 0xaf, 0x6d, 0x56,
+	0xed, 0xc8, 0xc6, 0x31, 0x82, 0xe2, 0x9f, 0x7b, 0xa5, 0x62, 0x1f, 0x77, 0x9d, 0xc5, 0xf2, 0x01,
+	0x38, 0xe5, 0x83, 0x3a, 0x79, 0xf1, 0x70, 0x1e, 0x6c, 0xbb, 0x51, 0x1d, 0xf2, 0xdb, 0xa4, 0x6f,
+	0x78, 0x2e, 0xf7, 0x13, 0x4d, 0x8b, 0x10, 0xd5, 0xb8, 0xcc, 0x06, 0x77, 0x2b, 0x26, 0x52, 0xb1,
+	0x77, 0xbe, 0x4d, 0xab, 0x29, 0x21, 0x9d, 0x3e, 0xbd, 0x4d, 0xfa, 0xba, 0xe2, 0xbb, 0x45, 0x08,
+	0xda, 0x84, 0xbc, 0x70, 0x03, 0x62, 0xb4, 0x1c, 0xf7, 0xae, 0xe1, 0xd8, 0x5d, 0xdb, 0x6f, 0x56,
+	0x46, 0xcd, 0x19, 0x12, 0xe4, 0x96, 0xe3, 0xde, 0xbd, 0x2d, 0x20, 0x16, 0x2f, 0x89, 0x00, 0xdc,
+	0xfd, 0xec, 0xe3, 0x2b, 0xca, 0x16, 0x57, 0x99, 0xb5, 0xbd, 0x70, 0x2f, 0x1c, 0xf9, 0xf9, 0x5e,
+	0x23, 0xde, 0xd2, 0x28, 0xaa, 0x6b, 0x3a, 0x61, 0x3d, 0x97, 0x32, 0xd9, 0xc3, 0xc4, 0x7a, 0x0d,
+	0xed, 0xf9, 0x3d, 0x4c, 0xc4, 0x3f, 0xd0, 0xc3, 0xc4, 0xa2, 0xfe, 0x1b, 0x51, 0x59, 0x49, 0x1c,
+	0x65, 0xa4, 0xb8, 0xc3, 0x2b, 0x26, 0x99, 0x4c, 0xc6, 0xca, 0x7f, 0xd4, 0x60, 0x76, 0x28, 0x40,
+	0x42, 0x91, 0x4d, 0x40, 0x5e, 0xec, 0x50, 0x3a, 0x5a, 0x5f, 0x89, 0x7e, 0xb2, 0x78, 0x9b, 0xf1,
+	0xf6, 0x9f, 0xbe, 0xa4, 0xfa, 0xa8, 0x92, 0xe3, 0xef, 0x35, 0x38, 0x13, 0x17, 0x20, 0x54, 0xa5,
+	0x01, 0xd9, 0xf8, 0xa7, 0x95, 0x12, 0x97, 0x8e, 0xa3, 0x44, 0x5c, 0xfe, 0x01, 0x10, 0xb4, 0x15,
+	0x25, 0x21, 0x7f, 0xd6, 0x78, 0xed, 0xd8, 0x46, 0x09, 0x04, 0x3b, 0x30, 0x19, 0xf9, 0x77, 0xf3,
+	0x77, 0x0d, 0x52, 0xeb, 0xae, 0xeb, 0xa0, 0x1f, 0xc3, 0x0c, 0x75, 0xb9, 0x21, 0x02, 0x96, 0x58,
+	0x86, 0x1a, 0x3d, 0xf8, 0x09, 0x7e, 0xf9, 0xb9, 0xb6, 0xfa, 0xdb, 0x5e, 0x69, 0x98, 0x73, 0xd0,
+	0x80, 0x6a, 0xc2, 0x45, 0x5d, 0x5e, 0x95, 0x44, 0x1b, 0x92, 0x06, 0xb5, 0x60, 0x6a, 0xf0, 0x73,
+	0x7e, 0x11, 0xb8, 0x79, 0xd4, 0xe7, 0xa6, 0x8e, 0xfc, 0x54, 0xb6, 0x19, 0xfb, 0xce, 0xe2, 0x84,
+	0xb8, 0xb5, 0x7f, 0x88, 0x9b, 0x7b, 0x0f, 0xf2, 0x61, 0x06, 0xdc, 0x94, 0xe3, 0x31, 0x26, 0x5c,
+	0xc3, 0x9f, 0x94, 0x05, 0xfd, 0xc7, 0x7c, 0x7c, 0x10, 0x2c, 0x26, 0xc9, 0x95, 0x7d, 0x3c, 0x03,
+	0xe6, 0x54, 0xbc, 0xe5, 0xc7, 0x09, 0x98, 0x5d, 0x72, 0x29, 0x53, 0x33, 0x22, 0x95, 0x27, 0xfc,
+	0xc9, 0x6e, 0x5f, 0x0c, 0x36, 0x0e, 0x9c, 0x60, 0x65, 0x87, 0xe7, 0x54, 0x5b, 0x90, 0x13, 0x05,
+	0xdb, 0x74, 0xe9, 0x0b, 0x8e, 0xa9, 0xa6, 0x5c, 0xc7, 0x52, 0x12, 0x89, 0x21, 0xd5, 0x16, 0xe4,
+	0x28, 0xb9, 0x3b, 0x80, 0x9b, 0x3c, 0x19, 0x2e, 0x25, 0x77, 0x63, 0xb8, 0xe7, 0xc4, 0x1c, 0x5d,
+	0xbe, 0xd6, 0x52, 0xf2, 0x2d, 0xa2, 0x56, 0xe8, 0x06, 0x24, 0x45, 0x72, 0x3d, 0x35, 0x42, 0xde,
+	0x10, 0x0c, 0xb1, 0x22, 0xd9, 0x80, 0x59, 0x35, 0x77, 0x60, 0x6b, 0x2d, 0x69, 0x51, 0x22, 0x15,
+	0x7a, 0x87, 0xf4, 0x0f, 0x18, 0x42, 0x64, 0x8f, 0x37, 0x84, 0xf8, 0x30, 0x01, 0xf9, 0x75, 0x22,
+	0xab, 0x65, 0x23, 0x48, 0xbc, 0x68, 0x1a, 0x12, 0xb6, 0x25, 0x2f, 0x24, 0xa5, 0x27, 0x6c, 0xeb,
+	0xe0, 0xd7, 0x4e, 0xe2, 0xe5, 0xb4, 0x4d, 0xc9, 0x93, 0xb7, 0x4d, 0x2b, 0x90, 0xc6, 0x5d, 0xf9,
+	0x88, 0xf3, 0x93, 0xd8, 0x9b, 0x23, 0x24, 0x31, 0xf5, 0x9f, 0x07, 0x9f, 0x3f, 0x32, 0xf2, 0x95,
+	0xdf, 0x68, 0x00, 0xd1, 0xf8, 0x11, 0x7d, 0x19, 0xce, 0x57, 0xd7, 0x56, 0x6b, 0x46, 0x63, 0xe3,
+	0xe6, 0xc6, 0x66, 0xc3, 0xd8, 0x5c, 0x6d, 0xac, 0x2f, 0x2f, 0xd5, 0x6f, 0xd5, 0x97, 0x6b, 0xf9,
+	0xb1, 0x62, 0x6e, 0xf7, 0xc1, 0x7c, 0x66, 0x93, 0xb2, 0x1e, 0x31, 0xed, 0x96, 0x4d, 0x2c, 0xf4,
+	0xff, 0x70, 0x66, 0x90, 0x5a, 0xac, 0x96, 0x6b, 0x79, 0xad, 0x98, 0xdd, 0x7d, 0x30, 0x3f, 0xe1,
+	0x77, 0x60, 0xc4, 0x42, 0x97, 0xe1, 0xec, 0x30, 0x5d, 0x7d, 0xf5, 0xad, 0x7c, 0xa2, 0x38, 0xb5,
+	0xfb, 0x60, 0x7e, 0x32, 0x6c, 0xd5, 0x50, 0x19, 0x50, 0x9c, 0x52, 0xe1, 0x25, 0x8b, 0xb0, 0xfb,
+	0x60, 0x3e, 0xed, 0x67, 0x8f, 0x62, 0xea, 0xfd, 0x5f, 0xcd, 0x8d, 0x5d, 0xf9, 0x01, 0x40, 0x9d,
+	0xb6, 0x3c, 0x6c, 0xca, 0x2c, 0x59, 0x84, 0x73, 0xf5, 0xd5, 0x5b, 0xfa, 0xcd, 0xa5, 0x8d, 0xfa,
+	0xda, 0xea, 0xa0, 0xd8, 0xfb, 0xce, 0x6a, 0x6b, 0x9b, 0xd5, 0xdb, 0xcb, 0x46, 0xa3, 0xfe, 0xd6,
+	0x6a, 0x5e, 0x43, 0xe7, 0xe1, 0xf4, 0xc0, 0xd9, 0xb7, 0x57, 0x37, 0xea, 0xef, 0x2e, 0xe7, 0x13,
+	0xd5, 0x1b, 0x9f, 0x3c, 0x9d, 0xd3, 0x1e, 0x3f, 0x9d, 0xd3, 0xfe, 0xfa, 0x74, 0x4e, 0xbb, 0xff,
+	0x6c, 0x6e, 0xec, 0xf1, 0xb3, 0xb9, 0xb1, 0x3f, 0x3d, 0x9b, 0x1b, 0xfb, 0xee, 0x2b, 0x03, 0xd6,
+	0x8e, 0x2a, 0xb3, 0xfc, 0x9f, 0x51, 0x33, 0x2d, 0xa3, 0xe8, 0x2b, 0xff, 0x0e, 0x00, 0x00, 0xff,
+	0xff, 0x94, 0x9a, 0xf9, 0x3e, 0xab, 0x1b, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1588,726 +1644,728 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11501 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x1c, 0xc7,
-		0x75, 0x1f, 0xbe, 0x3d, 0xef, 0xb9, 0xf3, 0xea, 0xad, 0x5d, 0x00, 0x83, 0x01, 0x89, 0x5d, 0x36,
-		0x45, 0x12, 0x04, 0xc9, 0x05, 0x09, 0x12, 0x20, 0xb9, 0x90, 0xc4, 0xff, 0xcc, 0xec, 0x00, 0x3b,
-		0xe0, 0xbe, 0xd8, 0x33, 0x0b, 0x11, 0xb4, 0xad, 0x76, 0x6f, 0x4f, 0xed, 0x6e, 0x13, 0x33, 0xdd,
-		0xa3, 0xe9, 0x1e, 0x60, 0x97, 0x1f, 0xfe, 0x47, 0x8e, 0xec, 0x44, 0x86, 0x1f, 0x91, 0x63, 0x1f,
-		0x5b, 0xb6, 0x04, 0x5b, 0xb2, 0x63, 0x4b, 0x76, 0xe2, 0xc4, 0xb6, 0x14, 0x3f, 0xe2, 0x13, 0xc7,
-		0xce, 0x71, 0x12, 0xdb, 0x27, 0xc9, 0x51, 0xfc, 0x21, 0x71, 0x72, 0x8e, 0x19, 0x5b, 0xf2, 0x89,
-		0x14, 0x59, 0x4e, 0xfc, 0x90, 0x13, 0xe7, 0xe8, 0x24, 0x27, 0xa7, 0x5e, 0xfd, 0x98, 0xc7, 0xce,
-		0x2c, 0x44, 0xca, 0x8a, 0x9d, 0x2f, 0xc0, 0x74, 0xd5, 0xbd, 0xbf, 0xaa, 0xba, 0x75, 0xeb, 0xd6,
-		0xad, 0x5b, 0x8f, 0x85, 0xdf, 0xbf, 0x0a, 0x8b, 0x7b, 0xb6, 0xbd, 0xd7, 0xc6, 0x17, 0xba, 0x3d,
-		0xdb, 0xb5, 0x77, 0xfa, 0xbb, 0x17, 0x5a, 0xd8, 0x31, 0x7a, 0x66, 0xd7, 0xb5, 0x7b, 0x4b, 0x34,
-		0x0d, 0x15, 0x18, 0xc5, 0x92, 0xa0, 0x50, 0xd6, 0x61, 0xf6, 0xaa, 0xd9, 0xc6, 0x2b, 0x1e, 0x61,
-		0x03, 0xbb, 0xe8, 0x05, 0x88, 0xed, 0x9a, 0x6d, 0x5c, 0x94, 0x16, 0xa3, 0xe7, 0x32, 0x17, 0xdf,
-		0xb1, 0x34, 0xc0, 0xb4, 0x14, 0xe6, 0xd8, 0x22, 0xc9, 0x2a, 0xe5, 0x50, 0xfe, 0x77, 0x0c, 0xe6,
-		0x46, 0xe4, 0x22, 0x04, 0x31, 0x4b, 0xef, 0x10, 0x44, 0xe9, 0x5c, 0x5a, 0xa5, 0xbf, 0x51, 0x11,
-		0x92, 0x5d, 0xdd, 0xb8, 0xa5, 0xef, 0xe1, 0x62, 0x84, 0x26, 0x8b, 0x4f, 0x74, 0x16, 0xa0, 0x85,
-		0xbb, 0xd8, 0x6a, 0x61, 0xcb, 0x38, 0x2c, 0x46, 0x17, 0xa3, 0xe7, 0xd2, 0x6a, 0x20, 0x05, 0x3d,
-		0x01, 0xb3, 0xdd, 0xfe, 0x4e, 0xdb, 0x34, 0xb4, 0x00, 0x19, 0x2c, 0x46, 0xcf, 0xc5, 0x55, 0x99,
-		0x65, 0xac, 0xf8, 0xc4, 0x8f, 0x41, 0xe1, 0x0e, 0xd6, 0x6f, 0x05, 0x49, 0x33, 0x94, 0x34, 0x4f,
-		0x92, 0x03, 0x84, 0x55, 0xc8, 0x76, 0xb0, 0xe3, 0xe8, 0x7b, 0x58, 0x73, 0x0f, 0xbb, 0xb8, 0x18,
-		0xa3, 0xad, 0x5f, 0x1c, 0x6a, 0xfd, 0x60, 0xcb, 0x33, 0x9c, 0xab, 0x79, 0xd8, 0xc5, 0xa8, 0x0c,
-		0x69, 0x6c, 0xf5, 0x3b, 0x0c, 0x21, 0x3e, 0x46, 0x7e, 0x35, 0xab, 0xdf, 0x19, 0x44, 0x49, 0x11,
-		0x36, 0x0e, 0x91, 0x74, 0x70, 0xef, 0xb6, 0x69, 0xe0, 0x62, 0x82, 0x02, 0x3c, 0x36, 0x04, 0xd0,
-		0x60, 0xf9, 0x83, 0x18, 0x82, 0x0f, 0x55, 0x21, 0x8d, 0x0f, 0x5c, 0x6c, 0x39, 0xa6, 0x6d, 0x15,
-		0x93, 0x14, 0xe4, 0x91, 0x11, 0xbd, 0x88, 0xdb, 0xad, 0x41, 0x08, 0x9f, 0x0f, 0x5d, 0x86, 0xa4,
-		0xdd, 0x75, 0x4d, 0xdb, 0x72, 0x8a, 0xa9, 0x45, 0xe9, 0x5c, 0xe6, 0xe2, 0x03, 0x23, 0x15, 0x61,
-		0x93, 0xd1, 0xa8, 0x82, 0x18, 0xd5, 0x41, 0x76, 0xec, 0x7e, 0xcf, 0xc0, 0x9a, 0x61, 0xb7, 0xb0,
-		0x66, 0x5a, 0xbb, 0x76, 0x31, 0x4d, 0x01, 0x16, 0x86, 0x1b, 0x42, 0x09, 0xab, 0x76, 0x0b, 0xd7,
-		0xad, 0x5d, 0x5b, 0xcd, 0x3b, 0xa1, 0x6f, 0x74, 0x12, 0x12, 0xce, 0xa1, 0xe5, 0xea, 0x07, 0xc5,
-		0x2c, 0xd5, 0x10, 0xfe, 0x45, 0x54, 0x07, 0xb7, 0x4c, 0x52, 0x5c, 0x31, 0xc7, 0x54, 0x87, 0x7f,
-		0x2a, 0xbf, 0x94, 0x80, 0xc2, 0x34, 0xca, 0x77, 0x05, 0xe2, 0xbb, 0xa4, 0xfd, 0xc5, 0xc8, 0x71,
-		0xa4, 0xc3, 0x78, 0xc2, 0xe2, 0x4d, 0xdc, 0xa7, 0x78, 0xcb, 0x90, 0xb1, 0xb0, 0xe3, 0xe2, 0x16,
-		0xd3, 0x95, 0xe8, 0x94, 0xda, 0x06, 0x8c, 0x69, 0x58, 0xd9, 0x62, 0xf7, 0xa5, 0x6c, 0xaf, 0x42,
-		0xc1, 0xab, 0x92, 0xd6, 0xd3, 0xad, 0x3d, 0xa1, 0xb5, 0x17, 0x26, 0xd5, 0x64, 0xa9, 0x26, 0xf8,
-		0x54, 0xc2, 0xa6, 0xe6, 0x71, 0xe8, 0x1b, 0xad, 0x00, 0xd8, 0x16, 0xb6, 0x77, 0xb5, 0x16, 0x36,
-		0xda, 0xc5, 0xd4, 0x18, 0x29, 0x6d, 0x12, 0x92, 0x21, 0x29, 0xd9, 0x2c, 0xd5, 0x68, 0xa3, 0x17,
-		0x7d, 0x25, 0x4c, 0x8e, 0xd1, 0xa1, 0x75, 0x36, 0xfc, 0x86, 0xf4, 0x70, 0x1b, 0xf2, 0x3d, 0x4c,
-		0x46, 0x04, 0x6e, 0xf1, 0x96, 0xa5, 0x69, 0x25, 0x96, 0x26, 0xb6, 0x4c, 0xe5, 0x6c, 0xac, 0x61,
-		0xb9, 0x5e, 0xf0, 0x13, 0x3d, 0x0c, 0x5e, 0x82, 0x46, 0xd5, 0x0a, 0xa8, 0x7d, 0xca, 0x8a, 0xc4,
-		0x0d, 0xbd, 0x83, 0x4b, 0x6f, 0x40, 0x3e, 0x2c, 0x1e, 0x34, 0x0f, 0x71, 0xc7, 0xd5, 0x7b, 0x2e,
-		0xd5, 0xc2, 0xb8, 0xca, 0x3e, 0x90, 0x0c, 0x51, 0x6c, 0xb5, 0xa8, 0xfd, 0x8b, 0xab, 0xe4, 0x27,
-		0xfa, 0xff, 0xfc, 0x06, 0x47, 0x69, 0x83, 0x1f, 0x1d, 0xee, 0xd1, 0x10, 0xf2, 0x60, 0xbb, 0x4b,
-		0xcf, 0x43, 0x2e, 0xd4, 0x80, 0x69, 0x8b, 0x56, 0x7e, 0x3a, 0x06, 0x27, 0x46, 0x62, 0xa3, 0x57,
-		0x61, 0xbe, 0x6f, 0x99, 0x96, 0x8b, 0x7b, 0xdd, 0x1e, 0x26, 0x2a, 0xcb, 0xca, 0x2a, 0x7e, 0x3e,
-		0x39, 0x46, 0xe9, 0xb6, 0x83, 0xd4, 0x0c, 0x45, 0x9d, 0xeb, 0x0f, 0x27, 0xa2, 0x9b, 0x90, 0x21,
-		0xfa, 0xa1, 0xf7, 0x74, 0x0a, 0xc8, 0x46, 0xe3, 0xc5, 0xe9, 0x9a, 0xbc, 0xb4, 0xe2, 0x73, 0x56,
-		0xa2, 0x1f, 0x94, 0x22, 0x6a, 0x10, 0x0b, 0xed, 0x43, 0xf6, 0x36, 0xee, 0x99, 0xbb, 0xa6, 0xc1,
-		0xb0, 0x89, 0x38, 0xf3, 0x17, 0x5f, 0x98, 0x12, 0xfb, 0x46, 0x80, 0xb5, 0xe1, 0xea, 0x2e, 0x5e,
-		0x86, 0xed, 0x8d, 0x1b, 0x35, 0xb5, 0x7e, 0xb5, 0x5e, 0x5b, 0x51, 0x43, 0xc8, 0xa5, 0x4f, 0x49,
-		0x90, 0x09, 0xd4, 0x85, 0x98, 0x2d, 0xab, 0xdf, 0xd9, 0xc1, 0x3d, 0x2e, 0x71, 0xfe, 0x85, 0xce,
-		0x40, 0x7a, 0xb7, 0xdf, 0x6e, 0x33, 0xb5, 0x61, 0x73, 0x5e, 0x8a, 0x24, 0x10, 0x95, 0x21, 0x56,
-		0x8a, 0x1b, 0x02, 0x6a, 0xa5, 0xc8, 0x6f, 0xf4, 0x30, 0x64, 0x4c, 0x47, 0xeb, 0xe1, 0x2e, 0xd6,
-		0x5d, 0xdc, 0x2a, 0xc6, 0x16, 0xa5, 0x73, 0xa9, 0x4a, 0xa4, 0x28, 0xa9, 0x60, 0x3a, 0x2a, 0x4f,
-		0x45, 0x25, 0x48, 0x09, 0xdd, 0x2b, 0xc6, 0x09, 0x85, 0xea, 0x7d, 0xb3, 0x3c, 0xce, 0x9d, 0x10,
-		0x79, 0xec, 0x5b, 0x79, 0x0e, 0x66, 0x87, 0x1a, 0x89, 0x0a, 0x90, 0x59, 0xa9, 0x55, 0xd7, 0xca,
-		0x6a, 0xb9, 0x59, 0xdf, 0xdc, 0x90, 0x67, 0x50, 0x1e, 0x02, 0xed, 0x96, 0xa5, 0xf3, 0xe9, 0xd4,
-		0x17, 0x92, 0xf2, 0xfb, 0xdf, 0xff, 0xfe, 0xf7, 0x47, 0x94, 0x5f, 0x4b, 0xc0, 0xfc, 0x28, 0x2b,
-		0x37, 0xd2, 0xe0, 0xfa, 0x32, 0x89, 0x86, 0x64, 0x52, 0x86, 0x78, 0x5b, 0xdf, 0xc1, 0x6d, 0xda,
-		0xb8, 0xfc, 0xc5, 0x27, 0xa6, 0xb2, 0xa3, 0x4b, 0x6b, 0x84, 0x45, 0x65, 0x9c, 0xe8, 0xdd, 0x5c,
-		0x72, 0x71, 0x8a, 0x70, 0x7e, 0x3a, 0x04, 0x62, 0xfd, 0xb8, 0x94, 0xcf, 0x40, 0x9a, 0xfc, 0xcf,
-		0xba, 0x25, 0xc1, 0xba, 0x85, 0x24, 0xd0, 0x6e, 0x29, 0x41, 0x8a, 0x1a, 0xb6, 0x16, 0xf6, 0xba,
-		0x4c, 0x7c, 0x13, 0x53, 0xd0, 0xc2, 0xbb, 0x7a, 0xbf, 0xed, 0x6a, 0xb7, 0xf5, 0x76, 0x1f, 0x53,
-		0x13, 0x95, 0x56, 0xb3, 0x3c, 0xf1, 0x06, 0x49, 0x43, 0x0b, 0x90, 0x61, 0x76, 0xd0, 0xb4, 0x5a,
-		0xf8, 0x80, 0xce, 0x84, 0x71, 0x95, 0x99, 0xc6, 0x3a, 0x49, 0x21, 0xc5, 0xbf, 0xee, 0xd8, 0x96,
-		0x30, 0x26, 0xb4, 0x08, 0x92, 0x40, 0x8b, 0x7f, 0x7e, 0x70, 0x12, 0x7e, 0x70, 0x74, 0xf3, 0x86,
-		0xac, 0xdf, 0x63, 0x50, 0xa0, 0x14, 0xcf, 0xf2, 0xb1, 0xaa, 0xb7, 0x8b, 0xb3, 0x54, 0x01, 0xf2,
-		0x2c, 0x79, 0x93, 0xa7, 0x2a, 0x3f, 0x1f, 0x81, 0x18, 0x9d, 0x0a, 0x0a, 0x90, 0x69, 0xde, 0xdc,
-		0xaa, 0x69, 0x2b, 0x9b, 0xdb, 0x95, 0xb5, 0x9a, 0x2c, 0x91, 0xae, 0xa7, 0x09, 0x57, 0xd7, 0x36,
-		0xcb, 0x4d, 0x39, 0xe2, 0x7d, 0xd7, 0x37, 0x9a, 0x97, 0x9f, 0x93, 0xa3, 0x1e, 0xc3, 0x36, 0x4b,
-		0x88, 0x05, 0x09, 0x9e, 0xbd, 0x28, 0xc7, 0x91, 0x0c, 0x59, 0x06, 0x50, 0x7f, 0xb5, 0xb6, 0x72,
-		0xf9, 0x39, 0x39, 0x11, 0x4e, 0x79, 0xf6, 0xa2, 0x9c, 0x44, 0x39, 0x48, 0xd3, 0x94, 0xca, 0xe6,
-		0xe6, 0x9a, 0x9c, 0xf2, 0x30, 0x1b, 0x4d, 0xb5, 0xbe, 0x71, 0x4d, 0x4e, 0x7b, 0x98, 0xd7, 0xd4,
-		0xcd, 0xed, 0x2d, 0x19, 0x3c, 0x84, 0xf5, 0x5a, 0xa3, 0x51, 0xbe, 0x56, 0x93, 0x33, 0x1e, 0x45,
-		0xe5, 0x66, 0xb3, 0xd6, 0x90, 0xb3, 0xa1, 0x6a, 0x3d, 0x7b, 0x51, 0xce, 0x79, 0x45, 0xd4, 0x36,
-		0xb6, 0xd7, 0xe5, 0x3c, 0x9a, 0x85, 0x1c, 0x2b, 0x42, 0x54, 0xa2, 0x30, 0x90, 0x74, 0xf9, 0x39,
-		0x59, 0xf6, 0x2b, 0xc2, 0x50, 0x66, 0x43, 0x09, 0x97, 0x9f, 0x93, 0x91, 0x52, 0x85, 0x38, 0x55,
-		0x43, 0x84, 0x20, 0xbf, 0x56, 0xae, 0xd4, 0xd6, 0xb4, 0xcd, 0x2d, 0x32, 0x68, 0xca, 0x6b, 0xb2,
-		0xe4, 0xa7, 0xa9, 0xb5, 0x57, 0xb6, 0xeb, 0x6a, 0x6d, 0x45, 0x8e, 0x04, 0xd3, 0xb6, 0x6a, 0xe5,
-		0x66, 0x6d, 0x45, 0x8e, 0x2a, 0x06, 0xcc, 0x8f, 0x9a, 0x02, 0x47, 0x0e, 0xa1, 0x80, 0x2e, 0x44,
-		0xc6, 0xe8, 0x02, 0xc5, 0x1a, 0xd4, 0x05, 0xe5, 0x73, 0x11, 0x98, 0x1b, 0xe1, 0x06, 0x8c, 0x2c,
-		0xe4, 0x25, 0x88, 0x33, 0x5d, 0x66, 0xa6, 0xf8, 0xf1, 0x91, 0xfe, 0x04, 0xd5, 0xec, 0x21, 0xe7,
-		0x88, 0xf2, 0x05, 0xdd, 0xc6, 0xe8, 0x18, 0xb7, 0x91, 0x40, 0x0c, 0x29, 0xec, 0x37, 0x0d, 0x4d,
-		0xd7, 0xcc, 0xa3, 0xb9, 0x3c, 0x8d, 0x47, 0x43, 0xd3, 0x8e, 0x37, 0x6d, 0xc7, 0x47, 0x4c, 0xdb,
-		0x57, 0x60, 0x76, 0x08, 0x68, 0xea, 0xe9, 0xf3, 0x03, 0x12, 0x14, 0xc7, 0x09, 0x67, 0x82, 0x49,
-		0x8c, 0x84, 0x4c, 0xe2, 0x95, 0x41, 0x09, 0x3e, 0x34, 0xbe, 0x13, 0x86, 0xfa, 0xfa, 0x13, 0x12,
-		0x9c, 0x1c, 0xbd, 0x3c, 0x18, 0x59, 0x87, 0x77, 0x43, 0xa2, 0x83, 0xdd, 0x7d, 0x5b, 0x38, 0xc2,
-		0x8f, 0x8e, 0x70, 0xaf, 0x48, 0xf6, 0x60, 0x67, 0x73, 0xae, 0xa0, 0x7f, 0x16, 0x1d, 0xe7, 0xe3,
-		0xb3, 0xda, 0x0c, 0xd5, 0xf4, 0xdb, 0x23, 0x70, 0x62, 0x24, 0xf8, 0xc8, 0x8a, 0x3e, 0x08, 0x60,
-		0x5a, 0xdd, 0xbe, 0xcb, 0x9c, 0x5d, 0x66, 0x89, 0xd3, 0x34, 0x85, 0x1a, 0x2f, 0x62, 0x65, 0xfb,
-		0xae, 0x97, 0xcf, 0x26, 0x51, 0x60, 0x49, 0x94, 0xe0, 0x05, 0xbf, 0xa2, 0x31, 0x5a, 0xd1, 0xb3,
-		0x63, 0x5a, 0x3a, 0xa4, 0x98, 0x4f, 0x83, 0x6c, 0xb4, 0x4d, 0x6c, 0xb9, 0x9a, 0xe3, 0xf6, 0xb0,
-		0xde, 0x31, 0xad, 0x3d, 0x36, 0xcf, 0x2e, 0xc7, 0x77, 0xf5, 0xb6, 0x83, 0xd5, 0x02, 0xcb, 0x6e,
-		0x88, 0x5c, 0xc2, 0x41, 0x15, 0xa8, 0x17, 0xe0, 0x48, 0x84, 0x38, 0x58, 0xb6, 0xc7, 0xa1, 0x7c,
-		0x4f, 0x1a, 0x32, 0x81, 0xc5, 0x14, 0x7a, 0x08, 0xb2, 0xaf, 0xeb, 0xb7, 0x75, 0x4d, 0x2c, 0x90,
-		0x99, 0x24, 0x32, 0x24, 0x6d, 0x8b, 0x2f, 0x92, 0x9f, 0x86, 0x79, 0x4a, 0x62, 0xf7, 0x5d, 0xdc,
-		0xd3, 0x8c, 0xb6, 0xee, 0x38, 0x54, 0x68, 0x29, 0x4a, 0x8a, 0x48, 0xde, 0x26, 0xc9, 0xaa, 0x8a,
-		0x1c, 0x74, 0x09, 0xe6, 0x28, 0x47, 0xa7, 0xdf, 0x76, 0xcd, 0x6e, 0x1b, 0x6b, 0x64, 0xc9, 0xee,
-		0xd0, 0x29, 0xc7, 0xab, 0xd9, 0x2c, 0xa1, 0x58, 0xe7, 0x04, 0xa4, 0x46, 0x0e, 0x5a, 0x81, 0x07,
-		0x29, 0xdb, 0x1e, 0xb6, 0x70, 0x4f, 0x77, 0xb1, 0x86, 0xdf, 0xd7, 0xd7, 0xdb, 0x8e, 0xa6, 0x5b,
-		0x2d, 0x6d, 0x5f, 0x77, 0xf6, 0x8b, 0xf3, 0x9e, 0x5b, 0x72, 0x9a, 0x10, 0x5e, 0xe3, 0x74, 0x35,
-		0x4a, 0x56, 0xb6, 0x5a, 0xab, 0xba, 0xb3, 0x8f, 0x96, 0xe1, 0x24, 0x45, 0x71, 0xdc, 0x9e, 0x69,
-		0xed, 0x69, 0xc6, 0x3e, 0x36, 0x6e, 0x69, 0x7d, 0x77, 0xf7, 0x85, 0xe2, 0x99, 0x60, 0xf9, 0xb4,
-		0x86, 0x0d, 0x4a, 0x53, 0x25, 0x24, 0xdb, 0xee, 0xee, 0x0b, 0xa8, 0x01, 0x59, 0xd2, 0x19, 0x1d,
-		0xf3, 0x0d, 0xac, 0xed, 0xda, 0x3d, 0x3a, 0x87, 0xe6, 0x47, 0x98, 0xa6, 0x80, 0x04, 0x97, 0x36,
-		0x39, 0xc3, 0xba, 0xdd, 0xc2, 0xcb, 0xf1, 0xc6, 0x56, 0xad, 0xb6, 0xa2, 0x66, 0x04, 0xca, 0x55,
-		0xbb, 0x47, 0x14, 0x6a, 0xcf, 0xf6, 0x04, 0x9c, 0x61, 0x0a, 0xb5, 0x67, 0x0b, 0xf1, 0x5e, 0x82,
-		0x39, 0xc3, 0x60, 0x6d, 0x36, 0x0d, 0x8d, 0x2f, 0xac, 0x9d, 0xa2, 0x1c, 0x12, 0x96, 0x61, 0x5c,
-		0x63, 0x04, 0x5c, 0xc7, 0x1d, 0xf4, 0x22, 0x9c, 0xf0, 0x85, 0x15, 0x64, 0x9c, 0x1d, 0x6a, 0xe5,
-		0x20, 0xeb, 0x25, 0x98, 0xeb, 0x1e, 0x0e, 0x33, 0xa2, 0x50, 0x89, 0xdd, 0xc3, 0x41, 0xb6, 0xe7,
-		0x61, 0xbe, 0xbb, 0xdf, 0x1d, 0xe6, 0x3b, 0x1f, 0xe4, 0x43, 0xdd, 0xfd, 0xee, 0x20, 0xe3, 0x23,
-		0x34, 0xca, 0xd2, 0xc3, 0x06, 0xf5, 0x0e, 0x4f, 0x05, 0xc9, 0x03, 0x19, 0x68, 0x09, 0x64, 0xc3,
-		0xd0, 0xb0, 0xa5, 0xef, 0xb4, 0xb1, 0xa6, 0xf7, 0xb0, 0xa5, 0x3b, 0xc5, 0x05, 0x4a, 0x1c, 0x73,
-		0x7b, 0x7d, 0xac, 0xe6, 0x0d, 0xa3, 0x46, 0x33, 0xcb, 0x34, 0x0f, 0x9d, 0x87, 0x59, 0x7b, 0xe7,
-		0x75, 0x83, 0x69, 0xa4, 0xd6, 0xed, 0xe1, 0x5d, 0xf3, 0xa0, 0xf8, 0x0e, 0x2a, 0xde, 0x02, 0xc9,
-		0xa0, 0xfa, 0xb8, 0x45, 0x93, 0xd1, 0xe3, 0x20, 0x1b, 0xce, 0xbe, 0xde, 0xeb, 0x52, 0x93, 0xec,
-		0x74, 0x75, 0x03, 0x17, 0x1f, 0x61, 0xa4, 0x2c, 0x7d, 0x43, 0x24, 0x93, 0x11, 0xe1, 0xdc, 0x31,
-		0x77, 0x5d, 0x81, 0xf8, 0x18, 0x1b, 0x11, 0x34, 0x8d, 0xa3, 0x9d, 0x03, 0x99, 0x48, 0x22, 0x54,
-		0xf0, 0x39, 0x4a, 0x96, 0xef, 0xee, 0x77, 0x83, 0xe5, 0x3e, 0x0c, 0x39, 0x42, 0xe9, 0x17, 0xfa,
-		0x38, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x12, 0x9f, 0x83, 0x93, 0x84, 0xa8, 0x83, 0x5d, 0xbd, 0xa5,
-		0xbb, 0x7a, 0x80, 0xfa, 0x49, 0x4a, 0x4d, 0xc4, 0xbe, 0xce, 0x33, 0x43, 0xf5, 0xec, 0xf5, 0x77,
-		0x0e, 0x3d, 0xc5, 0x7a, 0x8a, 0xd5, 0x93, 0xa4, 0x09, 0xd5, 0x7a, 0xdb, 0x56, 0x53, 0xca, 0x32,
-		0x64, 0x83, 0x7a, 0x8f, 0xd2, 0xc0, 0x34, 0x5f, 0x96, 0x88, 0x13, 0x54, 0xdd, 0x5c, 0x21, 0xee,
-		0xcb, 0x6b, 0x35, 0x39, 0x42, 0xdc, 0xa8, 0xb5, 0x7a, 0xb3, 0xa6, 0xa9, 0xdb, 0x1b, 0xcd, 0xfa,
-		0x7a, 0x4d, 0x8e, 0x06, 0x1c, 0xfb, 0xeb, 0xb1, 0xd4, 0xa3, 0xf2, 0x63, 0xca, 0x2f, 0x47, 0x21,
-		0x1f, 0x5e, 0x5b, 0xa3, 0x77, 0xc2, 0x29, 0x11, 0x22, 0x73, 0xb0, 0xab, 0xdd, 0x31, 0x7b, 0x74,
-		0x40, 0x76, 0x74, 0x36, 0x39, 0x7a, 0xfa, 0x33, 0xcf, 0xa9, 0x1a, 0xd8, 0x7d, 0x8f, 0xd9, 0x23,
-		0xc3, 0xad, 0xa3, 0xbb, 0x68, 0x0d, 0x16, 0x2c, 0x5b, 0x73, 0x5c, 0xdd, 0x6a, 0xe9, 0xbd, 0x96,
-		0xe6, 0x07, 0x27, 0x35, 0xdd, 0x30, 0xb0, 0xe3, 0xd8, 0x6c, 0x22, 0xf4, 0x50, 0x1e, 0xb0, 0xec,
-		0x06, 0x27, 0xf6, 0x67, 0x88, 0x32, 0x27, 0x1d, 0x50, 0xdf, 0xe8, 0x38, 0xf5, 0x3d, 0x03, 0xe9,
-		0x8e, 0xde, 0xd5, 0xb0, 0xe5, 0xf6, 0x0e, 0xa9, 0x7f, 0x9e, 0x52, 0x53, 0x1d, 0xbd, 0x5b, 0x23,
-		0xdf, 0xe8, 0x06, 0x3c, 0xea, 0x93, 0x6a, 0x6d, 0xbc, 0xa7, 0x1b, 0x87, 0x1a, 0x75, 0xc6, 0x69,
-		0xa0, 0x47, 0x33, 0x6c, 0x6b, 0xb7, 0x6d, 0x1a, 0xae, 0x43, 0xed, 0x03, 0xb3, 0x71, 0x8a, 0xcf,
-		0xb1, 0x46, 0x19, 0xae, 0x3b, 0xb6, 0x45, 0x7d, 0xf0, 0xaa, 0xa0, 0x7e, 0xfb, 0x7a, 0x38, 0xdc,
-		0x4b, 0x31, 0x39, 0x7e, 0x3d, 0x96, 0x8a, 0xcb, 0x89, 0xeb, 0xb1, 0x54, 0x42, 0x4e, 0x5e, 0x8f,
-		0xa5, 0x52, 0x72, 0xfa, 0x7a, 0x2c, 0x95, 0x96, 0x41, 0xf9, 0xb6, 0x34, 0x64, 0x83, 0x2b, 0x03,
-		0xb2, 0xd0, 0x32, 0xe8, 0xdc, 0x28, 0x51, 0xeb, 0xf9, 0xf0, 0x91, 0xeb, 0x88, 0xa5, 0x2a, 0x99,
-		0x34, 0x97, 0x13, 0xcc, 0x0d, 0x57, 0x19, 0x27, 0x71, 0x58, 0x88, 0x5a, 0x63, 0xe6, 0xf6, 0xa4,
-		0x54, 0xfe, 0x85, 0xae, 0x41, 0xe2, 0x75, 0x87, 0x62, 0x27, 0x28, 0xf6, 0x3b, 0x8e, 0xc6, 0xbe,
-		0xde, 0xa0, 0xe0, 0xe9, 0xeb, 0x0d, 0x6d, 0x63, 0x53, 0x5d, 0x2f, 0xaf, 0xa9, 0x9c, 0x1d, 0x9d,
-		0x86, 0x58, 0x5b, 0x7f, 0xe3, 0x30, 0x3c, 0xbd, 0xd2, 0x24, 0xb4, 0x04, 0x85, 0xbe, 0xc5, 0x56,
-		0xdd, 0xa4, 0xab, 0x08, 0x55, 0x21, 0x48, 0x95, 0xf7, 0x73, 0xd7, 0x08, 0xfd, 0x94, 0xea, 0x71,
-		0x1a, 0x62, 0x77, 0xb0, 0x7e, 0x2b, 0x3c, 0x09, 0xd2, 0x24, 0x74, 0x0e, 0xb2, 0x2d, 0xbc, 0xd3,
-		0xdf, 0xd3, 0x7a, 0xb8, 0xa5, 0x1b, 0x6e, 0xd8, 0xf4, 0x67, 0x68, 0x96, 0x4a, 0x73, 0xd0, 0xcb,
-		0x90, 0x26, 0x7d, 0x64, 0xd1, 0x3e, 0x9e, 0xa5, 0x22, 0x78, 0xea, 0x68, 0x11, 0xf0, 0x2e, 0x16,
-		0x4c, 0xaa, 0xcf, 0x8f, 0xae, 0x43, 0xc2, 0xd5, 0x7b, 0x7b, 0xd8, 0xa5, 0x96, 0x3f, 0x3f, 0x22,
-		0x5c, 0x35, 0x02, 0xa9, 0x49, 0x39, 0x88, 0x58, 0xa9, 0x8e, 0x72, 0x04, 0xb4, 0x0a, 0x49, 0xf6,
-		0xcb, 0x29, 0xce, 0x2d, 0x46, 0x8f, 0x0f, 0xa6, 0x0a, 0xf6, 0xb7, 0xd1, 0x66, 0x5d, 0x80, 0x38,
-		0x55, 0x36, 0x04, 0xc0, 0xd5, 0x4d, 0x9e, 0x41, 0x29, 0x88, 0x55, 0x37, 0x55, 0x62, 0xb7, 0x64,
-		0xc8, 0xb2, 0x54, 0x6d, 0xab, 0x5e, 0xab, 0xd6, 0xe4, 0x88, 0x72, 0x09, 0x12, 0x4c, 0x83, 0x88,
-		0x4d, 0xf3, 0x74, 0x48, 0x9e, 0xe1, 0x9f, 0x1c, 0x43, 0x12, 0xb9, 0xdb, 0xeb, 0x95, 0x9a, 0x2a,
-		0x47, 0x94, 0x6d, 0x28, 0x0c, 0x48, 0x1d, 0x9d, 0x80, 0x59, 0xb5, 0xd6, 0xac, 0x6d, 0x90, 0x55,
-		0x9b, 0xb6, 0xbd, 0xf1, 0xf2, 0xc6, 0xe6, 0x7b, 0x36, 0xe4, 0x99, 0x70, 0xb2, 0x30, 0x90, 0x12,
-		0x9a, 0x07, 0xd9, 0x4f, 0x6e, 0x6c, 0x6e, 0xab, 0xb4, 0x36, 0xdf, 0x19, 0x01, 0x79, 0x50, 0x6c,
-		0xe8, 0x14, 0xcc, 0x35, 0xcb, 0xea, 0xb5, 0x5a, 0x53, 0x63, 0x2b, 0x51, 0x0f, 0x7a, 0x1e, 0xe4,
-		0x60, 0xc6, 0xd5, 0x3a, 0x5d, 0x68, 0x2f, 0xc0, 0x99, 0x60, 0x6a, 0xed, 0xd5, 0x66, 0x6d, 0xa3,
-		0x41, 0x0b, 0x2f, 0x6f, 0x5c, 0x23, 0xd6, 0x7a, 0x00, 0x4f, 0xac, 0x7d, 0xa3, 0xa4, 0xaa, 0x61,
-		0xbc, 0xda, 0xda, 0x8a, 0x1c, 0x1b, 0x4c, 0xde, 0xdc, 0xa8, 0x6d, 0x5e, 0x95, 0xe3, 0x83, 0xa5,
-		0xd3, 0xf5, 0x70, 0x02, 0x95, 0xe0, 0xe4, 0x60, 0xaa, 0x56, 0xdb, 0x68, 0xaa, 0x37, 0xe5, 0xe4,
-		0x60, 0xc1, 0x8d, 0x9a, 0x7a, 0xa3, 0x5e, 0xad, 0xc9, 0x29, 0x74, 0x12, 0x50, 0xb8, 0x46, 0xcd,
-		0xd5, 0xcd, 0x15, 0x39, 0x3d, 0x64, 0x9f, 0x14, 0x07, 0xb2, 0xc1, 0x45, 0xe9, 0xd7, 0xc4, 0x34,
-		0x2a, 0x1f, 0x8e, 0x40, 0x26, 0xb0, 0xc8, 0x24, 0xab, 0x03, 0xbd, 0xdd, 0xb6, 0xef, 0x68, 0x7a,
-		0xdb, 0xd4, 0x1d, 0x6e, 0xbd, 0x80, 0x26, 0x95, 0x49, 0xca, 0xb4, 0xd6, 0x62, 0xfa, 0xf9, 0x22,
-		0xf1, 0xf5, 0x38, 0x5f, 0xc4, 0xe5, 0x84, 0xf2, 0xc3, 0x12, 0xc8, 0x83, 0xab, 0xc7, 0x81, 0xe6,
-		0x4b, 0xe3, 0x9a, 0xff, 0x35, 0xe9, 0xbb, 0x8f, 0x4a, 0x90, 0x0f, 0x2f, 0x19, 0x07, 0xaa, 0xf7,
-		0xd0, 0x5f, 0x6a, 0xf5, 0x7e, 0x2f, 0x02, 0xb9, 0xd0, 0x42, 0x71, 0xda, 0xda, 0xbd, 0x0f, 0x66,
-		0xcd, 0x16, 0xee, 0x74, 0x6d, 0x17, 0x5b, 0xc6, 0xa1, 0xd6, 0xc6, 0xb7, 0x71, 0xbb, 0xa8, 0x50,
-		0x13, 0x7f, 0xe1, 0xe8, 0xa5, 0xe8, 0x52, 0xdd, 0xe7, 0x5b, 0x23, 0x6c, 0xcb, 0x73, 0xf5, 0x95,
-		0xda, 0xfa, 0xd6, 0x66, 0xb3, 0xb6, 0x51, 0xbd, 0x29, 0xac, 0x8b, 0x2a, 0x9b, 0x03, 0x64, 0x6f,
-		0xa3, 0xd1, 0xde, 0x02, 0x79, 0xb0, 0x52, 0xc4, 0x56, 0x8c, 0xa8, 0x96, 0x3c, 0x83, 0xe6, 0xa0,
-		0xb0, 0xb1, 0xa9, 0x35, 0xea, 0x2b, 0x35, 0xad, 0x76, 0xf5, 0x6a, 0xad, 0xda, 0x6c, 0xb0, 0xe0,
-		0xa2, 0x47, 0xdd, 0x94, 0x23, 0x41, 0x11, 0xff, 0x50, 0x14, 0xe6, 0x46, 0xd4, 0x04, 0x95, 0x79,
-		0x58, 0x80, 0x45, 0x2a, 0x9e, 0x9a, 0xa6, 0xf6, 0x4b, 0xc4, 0x31, 0xdf, 0xd2, 0x7b, 0x2e, 0x8f,
-		0x22, 0x3c, 0x0e, 0x44, 0x4a, 0x96, 0x4b, 0xfc, 0x84, 0x1e, 0x0f, 0xda, 0xb2, 0x58, 0x41, 0xc1,
-		0x4f, 0x67, 0x71, 0xdb, 0x27, 0x01, 0x75, 0x6d, 0xc7, 0x74, 0xcd, 0xdb, 0x58, 0x33, 0x2d, 0x11,
-		0xe1, 0x8d, 0x2d, 0x4a, 0xe7, 0x62, 0xaa, 0x2c, 0x72, 0xea, 0x96, 0xeb, 0x51, 0x5b, 0x78, 0x4f,
-		0x1f, 0xa0, 0x26, 0x7e, 0x4c, 0x54, 0x95, 0x45, 0x8e, 0x47, 0xfd, 0x10, 0x64, 0x5b, 0x76, 0x9f,
-		0x2c, 0xa8, 0x18, 0x1d, 0xb1, 0x16, 0x92, 0x9a, 0x61, 0x69, 0x1e, 0x09, 0x5f, 0x2a, 0xfb, 0xa1,
-		0xe5, 0xac, 0x9a, 0x61, 0x69, 0x8c, 0xe4, 0x31, 0x28, 0xe8, 0x7b, 0x7b, 0x3d, 0x02, 0x2e, 0x80,
-		0xd8, 0xe2, 0x3f, 0xef, 0x25, 0x53, 0xc2, 0xd2, 0x75, 0x48, 0x09, 0x39, 0x10, 0x7f, 0x98, 0x48,
-		0x42, 0xeb, 0xb2, 0x88, 0x56, 0xe4, 0x5c, 0x5a, 0x4d, 0x59, 0x22, 0xf3, 0x21, 0xc8, 0x9a, 0x8e,
-		0xe6, 0xef, 0x6d, 0x46, 0x16, 0x23, 0xe7, 0x52, 0x6a, 0xc6, 0x74, 0xbc, 0x3d, 0x12, 0xe5, 0x13,
-		0x11, 0xc8, 0x87, 0x77, 0x6d, 0xd1, 0x0a, 0xa4, 0xda, 0x36, 0xdf, 0x64, 0x61, 0x47, 0x06, 0xce,
-		0x4d, 0xd8, 0xe8, 0x5d, 0x5a, 0xe3, 0xf4, 0xaa, 0xc7, 0x59, 0xfa, 0x37, 0x12, 0xa4, 0x44, 0x32,
-		0x3a, 0x09, 0xb1, 0xae, 0xee, 0xee, 0x53, 0xb8, 0x78, 0x25, 0x22, 0x4b, 0x2a, 0xfd, 0x26, 0xe9,
-		0x4e, 0x57, 0x67, 0xfb, 0x44, 0x3c, 0x9d, 0x7c, 0x93, 0x7e, 0x6d, 0x63, 0xbd, 0x45, 0x23, 0x0b,
-		0x76, 0xa7, 0x83, 0x2d, 0xd7, 0x11, 0xfd, 0xca, 0xd3, 0xab, 0x3c, 0x19, 0x3d, 0x01, 0xb3, 0x6e,
-		0x4f, 0x37, 0xdb, 0x21, 0xda, 0x18, 0xa5, 0x95, 0x45, 0x86, 0x47, 0xbc, 0x0c, 0xa7, 0x05, 0x6e,
-		0x0b, 0xbb, 0xba, 0xb1, 0x8f, 0x5b, 0x3e, 0x53, 0x82, 0x46, 0x10, 0x4f, 0x71, 0x82, 0x15, 0x9e,
-		0x2f, 0x78, 0x95, 0xcf, 0x44, 0x60, 0x56, 0xc4, 0x42, 0x5a, 0x9e, 0xb0, 0xd6, 0x01, 0x74, 0xcb,
-		0xb2, 0xdd, 0xa0, 0xb8, 0x86, 0x55, 0x79, 0x88, 0x6f, 0xa9, 0xec, 0x31, 0xa9, 0x01, 0x80, 0xd2,
-		0x1f, 0x4a, 0x00, 0x7e, 0xd6, 0x58, 0xb9, 0x2d, 0x40, 0x86, 0xef, 0xc9, 0xd3, 0x83, 0x1d, 0x2c,
-		0x7c, 0x06, 0x2c, 0xe9, 0xaa, 0xd9, 0xa6, 0x41, 0xce, 0x1d, 0xbc, 0x67, 0x5a, 0x7c, 0x77, 0x86,
-		0x7d, 0x88, 0x20, 0x67, 0xcc, 0xdf, 0x9e, 0x54, 0x21, 0xe5, 0xe0, 0x8e, 0x6e, 0xb9, 0xa6, 0xc1,
-		0xf7, 0x5b, 0x2e, 0x1f, 0xab, 0xf2, 0x4b, 0x0d, 0xce, 0xad, 0x7a, 0x38, 0xca, 0x39, 0x48, 0x89,
-		0x54, 0xe2, 0xf8, 0x6d, 0x6c, 0x6e, 0xd4, 0xe4, 0x19, 0x94, 0x84, 0x68, 0xa3, 0xd6, 0x94, 0x25,
-		0xb2, 0x88, 0x2d, 0xaf, 0xd5, 0xcb, 0x0d, 0x39, 0x52, 0xf9, 0xff, 0x61, 0xce, 0xb0, 0x3b, 0x83,
-		0x05, 0x56, 0xe4, 0x81, 0x00, 0xa2, 0xb3, 0x2a, 0xbd, 0xf6, 0x14, 0x27, 0xda, 0xb3, 0xdb, 0xba,
-		0xb5, 0xb7, 0x64, 0xf7, 0xf6, 0xfc, 0x63, 0x31, 0x64, 0xad, 0xe1, 0x04, 0x0e, 0xc7, 0x74, 0x77,
-		0xfe, 0x42, 0x92, 0x7e, 0x34, 0x12, 0xbd, 0xb6, 0x55, 0xf9, 0xa9, 0x48, 0xe9, 0x1a, 0x63, 0xdc,
-		0x12, 0xcd, 0x51, 0xf1, 0x6e, 0x1b, 0x1b, 0xa4, 0xf2, 0xf0, 0x47, 0x4f, 0xc0, 0xfc, 0x9e, 0xbd,
-		0x67, 0x53, 0xa4, 0x0b, 0xe4, 0x17, 0x3f, 0x57, 0x93, 0xf6, 0x52, 0x4b, 0x13, 0x0f, 0xe1, 0x2c,
-		0x6f, 0xc0, 0x1c, 0x27, 0xd6, 0xe8, 0xf6, 0x3d, 0x0b, 0x55, 0xa0, 0x23, 0xe3, 0xe4, 0xc5, 0x9f,
-		0xfd, 0x03, 0xea, 0x95, 0xa8, 0xb3, 0x9c, 0x95, 0xe4, 0xb1, 0x68, 0xc6, 0xb2, 0x0a, 0x27, 0x42,
-		0x78, 0xcc, 0x46, 0xe0, 0xde, 0x04, 0xc4, 0x7f, 0xce, 0x11, 0xe7, 0x02, 0x88, 0x0d, 0xce, 0xba,
-		0x5c, 0x85, 0xdc, 0x71, 0xb0, 0xfe, 0x05, 0xc7, 0xca, 0xe2, 0x20, 0xc8, 0x35, 0x28, 0x50, 0x10,
-		0xa3, 0xef, 0xb8, 0x76, 0x87, 0x1a, 0xe0, 0xa3, 0x61, 0xfe, 0xe5, 0x1f, 0xb0, 0x41, 0x9b, 0x27,
-		0x6c, 0x55, 0x8f, 0x6b, 0x79, 0x19, 0xe8, 0x89, 0x85, 0x16, 0x36, 0xda, 0x13, 0x10, 0x7e, 0x83,
-		0x57, 0xc4, 0xa3, 0x5f, 0xbe, 0x01, 0xf3, 0xe4, 0x37, 0xb5, 0x8f, 0xc1, 0x9a, 0x4c, 0x0e, 0xaa,
-		0x17, 0xff, 0xed, 0x07, 0x98, 0x5d, 0x98, 0xf3, 0x00, 0x02, 0x75, 0x0a, 0xf4, 0xe2, 0x1e, 0x76,
-		0x5d, 0xdc, 0x73, 0x34, 0xbd, 0x3d, 0xaa, 0x7a, 0x81, 0xa8, 0x64, 0xf1, 0x07, 0xbf, 0x14, 0xee,
-		0xc5, 0x6b, 0x8c, 0xb3, 0xdc, 0x6e, 0x2f, 0x6f, 0xc3, 0xa9, 0x11, 0x5a, 0x31, 0x05, 0xe6, 0x0f,
-		0x71, 0xcc, 0xf9, 0x21, 0xcd, 0x20, 0xb0, 0x5b, 0x20, 0xd2, 0xbd, 0xbe, 0x9c, 0x02, 0xf3, 0x23,
-		0x1c, 0x13, 0x71, 0x5e, 0xd1, 0xa5, 0x04, 0xf1, 0x3a, 0xcc, 0xde, 0xc6, 0xbd, 0x1d, 0xdb, 0xe1,
-		0x91, 0xe0, 0x29, 0xe0, 0x3e, 0xca, 0xe1, 0x0a, 0x9c, 0x91, 0x86, 0x86, 0x09, 0xd6, 0x8b, 0x90,
-		0xda, 0xd5, 0x0d, 0x3c, 0x05, 0xc4, 0x3d, 0x0e, 0x91, 0x24, 0xf4, 0x84, 0xb5, 0x0c, 0xd9, 0x3d,
-		0x9b, 0x4f, 0x91, 0x93, 0xd9, 0x7f, 0x98, 0xb3, 0x67, 0x04, 0x0f, 0x87, 0xe8, 0xda, 0xdd, 0x7e,
-		0x9b, 0xcc, 0x9f, 0x93, 0x21, 0x7e, 0x44, 0x40, 0x08, 0x1e, 0x0e, 0x71, 0x0c, 0xb1, 0x7e, 0x4c,
-		0x40, 0x38, 0x01, 0x79, 0xbe, 0x04, 0x19, 0xdb, 0x6a, 0x1f, 0xda, 0xd6, 0x34, 0x95, 0xf8, 0x38,
-		0x47, 0x00, 0xce, 0x42, 0x00, 0xae, 0x40, 0x7a, 0xda, 0x8e, 0xf8, 0xf1, 0x2f, 0x89, 0xe1, 0x21,
-		0x7a, 0xe0, 0x1a, 0x14, 0x84, 0x81, 0x32, 0x6d, 0x6b, 0x0a, 0x88, 0x9f, 0xe0, 0x10, 0xf9, 0x00,
-		0x1b, 0x6f, 0x86, 0x8b, 0x1d, 0x77, 0x0f, 0x4f, 0x03, 0xf2, 0x09, 0xd1, 0x0c, 0xce, 0xc2, 0x45,
-		0xb9, 0x83, 0x2d, 0x63, 0x7f, 0x3a, 0x84, 0x4f, 0x0a, 0x51, 0x0a, 0x1e, 0x02, 0x51, 0x85, 0x5c,
-		0x47, 0xef, 0x39, 0xfb, 0x7a, 0x7b, 0xaa, 0xee, 0xf8, 0x49, 0x8e, 0x91, 0xf5, 0x98, 0xb8, 0x44,
-		0xfa, 0xd6, 0x71, 0x60, 0x7e, 0x4a, 0x48, 0x24, 0xc0, 0xc6, 0x87, 0x9e, 0xe3, 0xd2, 0xb0, 0xf9,
-		0x71, 0xd0, 0xfe, 0x9e, 0x18, 0x7a, 0x8c, 0x77, 0x3d, 0x88, 0x78, 0x05, 0xd2, 0x8e, 0xf9, 0xc6,
-		0x54, 0x30, 0x7f, 0x5f, 0xf4, 0x34, 0x65, 0x20, 0xcc, 0x37, 0xe1, 0xf4, 0xc8, 0x69, 0x62, 0x0a,
-		0xb0, 0x9f, 0xe6, 0x60, 0x27, 0x47, 0x4c, 0x15, 0xdc, 0x24, 0x1c, 0x17, 0xf2, 0x1f, 0x08, 0x93,
-		0x80, 0x07, 0xb0, 0xb6, 0xc8, 0xa2, 0xc5, 0xd1, 0x77, 0x8f, 0x27, 0xb5, 0x7f, 0x28, 0xa4, 0xc6,
-		0x78, 0x43, 0x52, 0x6b, 0xc2, 0x49, 0x8e, 0x78, 0xbc, 0x7e, 0xfd, 0x19, 0x61, 0x58, 0x19, 0xf7,
-		0x76, 0xb8, 0x77, 0xbf, 0x01, 0x4a, 0x9e, 0x38, 0x85, 0x77, 0xec, 0x68, 0x1d, 0xbd, 0x3b, 0x05,
-		0xf2, 0xcf, 0x72, 0x64, 0x61, 0xf1, 0x3d, 0xf7, 0xda, 0x59, 0xd7, 0xbb, 0x04, 0xfc, 0x55, 0x28,
-		0x0a, 0xf0, 0xbe, 0xd5, 0xc3, 0x86, 0xbd, 0x67, 0x99, 0x6f, 0xe0, 0xd6, 0x14, 0xd0, 0x3f, 0x37,
-		0xd0, 0x55, 0xdb, 0x01, 0x76, 0x82, 0x5c, 0x07, 0xd9, 0xf3, 0x55, 0x34, 0xb3, 0xd3, 0xb5, 0x7b,
-		0xee, 0x04, 0xc4, 0x4f, 0x89, 0x9e, 0xf2, 0xf8, 0xea, 0x94, 0x6d, 0xb9, 0x06, 0xec, 0x2c, 0xc9,
-		0xb4, 0x2a, 0xf9, 0x69, 0x0e, 0x94, 0xf3, 0xb9, 0xb8, 0xe1, 0x30, 0xec, 0x4e, 0x57, 0xef, 0x4d,
-		0x63, 0xff, 0xfe, 0x91, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2, 0xd1, 0x91, 0xd9, 0x7e, 0x0a,
-		0x84, 0x9f, 0x17, 0x86, 0x43, 0xf0, 0x70, 0x08, 0xe1, 0x30, 0x4c, 0x01, 0xf1, 0x0b, 0x02, 0x42,
-		0xf0, 0x10, 0x88, 0x57, 0xfc, 0x89, 0xb6, 0x87, 0xf7, 0x4c, 0xc7, 0xe5, 0x87, 0xc1, 0x8e, 0x86,
-		0xfa, 0xc5, 0x2f, 0x85, 0x9d, 0x30, 0x35, 0xc0, 0x4a, 0x2c, 0x11, 0xdf, 0x48, 0xa1, 0x4b, 0xb6,
-		0xc9, 0x15, 0xfb, 0x25, 0x61, 0x89, 0x02, 0x6c, 0xa4, 0x6e, 0x01, 0x0f, 0x91, 0x88, 0xdd, 0x20,
-		0x0b, 0x95, 0x29, 0xe0, 0xfe, 0xf1, 0x40, 0xe5, 0x1a, 0x82, 0x97, 0x60, 0x06, 0xfc, 0x9f, 0xbe,
-		0x75, 0x0b, 0x1f, 0x4e, 0xa5, 0x9d, 0xbf, 0x3c, 0xe0, 0xff, 0x6c, 0x33, 0x4e, 0x66, 0x43, 0x0a,
-		0x03, 0xfe, 0x14, 0x9a, 0x74, 0xd6, 0xb3, 0xf8, 0x2d, 0x5f, 0xe6, 0xed, 0x0d, 0xbb, 0x53, 0xcb,
-		0x6b, 0x44, 0xc9, 0xc3, 0x4e, 0xcf, 0x64, 0xb0, 0x0f, 0x7c, 0xd9, 0xd3, 0xf3, 0x90, 0xcf, 0xb3,
-		0x7c, 0x15, 0x72, 0x21, 0x87, 0x67, 0x32, 0xd4, 0xb7, 0x72, 0xa8, 0x6c, 0xd0, 0xdf, 0x59, 0xbe,
-		0x04, 0x31, 0xe2, 0xbc, 0x4c, 0x66, 0xff, 0x36, 0xce, 0x4e, 0xc9, 0x97, 0xdf, 0x05, 0x29, 0xe1,
-		0xb4, 0x4c, 0x66, 0xfd, 0x9b, 0x9c, 0xd5, 0x63, 0x21, 0xec, 0xc2, 0x61, 0x99, 0xcc, 0xfe, 0xb7,
-		0x04, 0xbb, 0x60, 0x21, 0xec, 0xd3, 0x8b, 0xf0, 0x57, 0xbf, 0x23, 0xc6, 0x27, 0x1d, 0x21, 0xbb,
-		0x2b, 0x90, 0xe4, 0x9e, 0xca, 0x64, 0xee, 0x6f, 0xe7, 0x85, 0x0b, 0x8e, 0xe5, 0xe7, 0x21, 0x3e,
-		0xa5, 0xc0, 0xbf, 0x8b, 0xb3, 0x32, 0xfa, 0xe5, 0x2a, 0x64, 0x02, 0xde, 0xc9, 0x64, 0xf6, 0xef,
-		0xe6, 0xec, 0x41, 0x2e, 0x52, 0x75, 0xee, 0x9d, 0x4c, 0x06, 0xf8, 0xdb, 0xa2, 0xea, 0x9c, 0x83,
-		0x88, 0x4d, 0x38, 0x26, 0x93, 0xb9, 0x3f, 0x24, 0xa4, 0x2e, 0x58, 0x96, 0x5f, 0x82, 0xb4, 0x37,
-		0xd9, 0x4c, 0xe6, 0xff, 0x1e, 0xce, 0xef, 0xf3, 0x10, 0x09, 0x04, 0x26, 0xbb, 0xc9, 0x10, 0x7f,
-		0x47, 0x48, 0x20, 0xc0, 0x45, 0x86, 0xd1, 0xa0, 0x03, 0x33, 0x19, 0xe9, 0x7b, 0xc5, 0x30, 0x1a,
-		0xf0, 0x5f, 0x48, 0x6f, 0x52, 0x9b, 0x3f, 0x19, 0xe2, 0xfb, 0x44, 0x6f, 0x52, 0x7a, 0x52, 0x8d,
-		0x41, 0x8f, 0x60, 0x32, 0xc6, 0x0f, 0x88, 0x6a, 0x0c, 0x38, 0x04, 0xcb, 0x5b, 0x80, 0x86, 0xbd,
-		0x81, 0xc9, 0x78, 0x1f, 0xe6, 0x78, 0xb3, 0x43, 0xce, 0xc0, 0xf2, 0x7b, 0xe0, 0xe4, 0x68, 0x4f,
-		0x60, 0x32, 0xea, 0x0f, 0x7e, 0x79, 0x60, 0xed, 0x16, 0x74, 0x04, 0x96, 0x9b, 0xfe, 0x94, 0x12,
-		0xf4, 0x02, 0x26, 0xc3, 0xfe, 0xd0, 0x97, 0xc3, 0x86, 0x3b, 0xe8, 0x04, 0x2c, 0x97, 0x01, 0xfc,
-		0x09, 0x78, 0x32, 0xd6, 0x47, 0x39, 0x56, 0x80, 0x89, 0x0c, 0x0d, 0x3e, 0xff, 0x4e, 0xe6, 0xbf,
-		0x27, 0x86, 0x06, 0xe7, 0x20, 0x43, 0x43, 0x4c, 0xbd, 0x93, 0xb9, 0x7f, 0x58, 0x0c, 0x0d, 0xc1,
-		0x42, 0x34, 0x3b, 0x30, 0xbb, 0x4d, 0x46, 0xf8, 0xb8, 0xd0, 0xec, 0x00, 0xd7, 0xf2, 0x06, 0xcc,
-		0x0e, 0x4d, 0x88, 0x93, 0xa1, 0x7e, 0x94, 0x43, 0xc9, 0x83, 0xf3, 0x61, 0x70, 0xf2, 0xe2, 0x93,
-		0xe1, 0x64, 0xb4, 0x1f, 0x1b, 0x98, 0xbc, 0xf8, 0x5c, 0xb8, 0x7c, 0x05, 0x52, 0x56, 0xbf, 0xdd,
-		0x26, 0x83, 0x07, 0x1d, 0x7d, 0xda, 0xb7, 0xf8, 0x5f, 0xbe, 0xc2, 0xa5, 0x23, 0x18, 0x96, 0x2f,
-		0x41, 0x1c, 0x77, 0x76, 0x70, 0x6b, 0x12, 0xe7, 0x17, 0xbf, 0x22, 0x0c, 0x26, 0xa1, 0x5e, 0x7e,
-		0x09, 0x80, 0x85, 0x46, 0xe8, 0x36, 0xfc, 0x04, 0xde, 0x3f, 0xfc, 0x0a, 0x3f, 0x5e, 0xe7, 0xb3,
-		0xf8, 0x00, 0xec, 0xb0, 0xde, 0xd1, 0x00, 0x5f, 0x0a, 0x03, 0xd0, 0x1e, 0x79, 0x11, 0x92, 0xaf,
-		0x3b, 0xb6, 0xe5, 0xea, 0x7b, 0x93, 0xb8, 0xff, 0x88, 0x73, 0x0b, 0x7a, 0x22, 0xb0, 0x8e, 0xdd,
-		0xc3, 0xae, 0xbe, 0xe7, 0x4c, 0xe2, 0xfd, 0xaf, 0x9c, 0xd7, 0x63, 0x20, 0xcc, 0x86, 0xee, 0xb8,
-		0xd3, 0xb4, 0xfb, 0xbf, 0x09, 0x66, 0xc1, 0x40, 0x2a, 0x4d, 0x7e, 0xdf, 0xc2, 0x87, 0x93, 0x78,
-		0xff, 0x58, 0x54, 0x9a, 0xd3, 0x2f, 0xbf, 0x0b, 0xd2, 0xe4, 0x27, 0x3b, 0x33, 0x3b, 0x81, 0xf9,
-		0x4f, 0x38, 0xb3, 0xcf, 0x41, 0x4a, 0x76, 0xdc, 0x96, 0x6b, 0x4e, 0x16, 0xf6, 0x9f, 0xf2, 0x9e,
-		0x16, 0xf4, 0xcb, 0x65, 0xc8, 0x38, 0x6e, 0xab, 0xd5, 0xe7, 0xfe, 0xe9, 0x04, 0xf6, 0x3f, 0xfb,
-		0x8a, 0x17, 0xb2, 0xf0, 0x78, 0x48, 0x6f, 0xdf, 0xb9, 0xe5, 0x76, 0x6d, 0xba, 0xdf, 0x32, 0x09,
-		0xe1, 0xcb, 0x1c, 0x21, 0xc0, 0xb2, 0x5c, 0x85, 0x2c, 0x69, 0x8b, 0xb8, 0x8b, 0x30, 0x09, 0xe2,
-		0xcf, 0xb9, 0x00, 0x42, 0x4c, 0x95, 0x6f, 0xfe, 0x8d, 0xcf, 0x9e, 0x95, 0x3e, 0xf3, 0xd9, 0xb3,
-		0xd2, 0xef, 0x7d, 0xf6, 0xac, 0xf4, 0xa1, 0xcf, 0x9d, 0x9d, 0xf9, 0xcc, 0xe7, 0xce, 0xce, 0xfc,
-		0xce, 0xe7, 0xce, 0xce, 0x8c, 0x8e, 0x12, 0xc3, 0x35, 0xfb, 0x9a, 0xcd, 0xe2, 0xc3, 0xaf, 0x3d,
-		0xb2, 0x67, 0xba, 0xfb, 0xfd, 0x9d, 0x25, 0xc3, 0xee, 0x5c, 0x30, 0x6c, 0xa7, 0x63, 0x3b, 0x17,
-		0xc2, 0x71, 0x5d, 0xfa, 0x0b, 0xfe, 0x97, 0x44, 0xd6, 0xcc, 0xe1, 0x70, 0xae, 0x6e, 0x1d, 0x8e,
-		0xbb, 0x4c, 0x79, 0x19, 0xa2, 0x65, 0xeb, 0x10, 0x9d, 0x66, 0x06, 0x4e, 0xeb, 0xf7, 0xda, 0xfc,
-		0xe0, 0x66, 0x92, 0x7c, 0x6f, 0xf7, 0xda, 0x68, 0xde, 0x3f, 0x5d, 0x2d, 0x9d, 0xcb, 0xf2, 0x23,
-		0xd3, 0x95, 0xef, 0x96, 0x8e, 0xd7, 0x92, 0x54, 0xd9, 0x3a, 0xa4, 0x0d, 0xd9, 0x92, 0x5e, 0x7b,
-		0x72, 0x62, 0x9c, 0xfb, 0x96, 0x65, 0xdf, 0xb1, 0x48, 0xb5, 0xbb, 0x3b, 0x22, 0xc6, 0x7d, 0x76,
-		0x30, 0xc6, 0xfd, 0x1e, 0xdc, 0x6e, 0xbf, 0x4c, 0xe8, 0x9a, 0x84, 0x65, 0x27, 0xc1, 0xee, 0x08,
-		0xc0, 0xf7, 0x46, 0xe0, 0xec, 0x50, 0x38, 0x9b, 0x2b, 0xc1, 0x38, 0x21, 0x2c, 0x43, 0x6a, 0x45,
-		0xe8, 0x56, 0x11, 0x92, 0x0e, 0x36, 0x6c, 0xab, 0xe5, 0x50, 0x41, 0x44, 0x55, 0xf1, 0x49, 0x04,
-		0x61, 0xe9, 0x96, 0xed, 0xf0, 0xa3, 0xcf, 0xec, 0xa3, 0xf2, 0x91, 0x63, 0x0a, 0x22, 0x27, 0x4a,
-		0x12, 0xd2, 0x78, 0x66, 0x4a, 0x69, 0x88, 0x46, 0x84, 0x22, 0xff, 0xd3, 0x4a, 0xe5, 0x07, 0x22,
-		0xb0, 0x30, 0x28, 0x15, 0x32, 0xb2, 0x1c, 0x57, 0xef, 0x74, 0xc7, 0x89, 0xe5, 0x0a, 0xa4, 0x9b,
-		0x82, 0xe6, 0xd8, 0x72, 0xb9, 0x77, 0x4c, 0xb9, 0xe4, 0xbd, 0xa2, 0x84, 0x60, 0x2e, 0x4e, 0x29,
-		0x18, 0xaf, 0x1d, 0xf7, 0x25, 0x99, 0xff, 0x99, 0x80, 0xd3, 0x6c, 0x38, 0x69, 0x6c, 0x28, 0xb1,
-		0x0f, 0x2e, 0x93, 0x6c, 0x30, 0x6b, 0xf2, 0x3e, 0x89, 0xf2, 0x32, 0xcc, 0xd5, 0x89, 0xb5, 0x20,
-		0xab, 0x20, 0x7f, 0x87, 0x67, 0xe4, 0xe9, 0xf0, 0xc5, 0x90, 0xc3, 0xcf, 0xf7, 0xb7, 0x82, 0x49,
-		0xca, 0xb7, 0x48, 0x20, 0x37, 0x0c, 0xbd, 0xad, 0xf7, 0xbe, 0x5a, 0x28, 0xf4, 0x3c, 0x00, 0x3b,
-		0xee, 0xe1, 0x5d, 0xdc, 0xcc, 0x5f, 0x2c, 0x2e, 0x05, 0x1b, 0xb7, 0xc4, 0x4a, 0xa2, 0x47, 0xa8,
-		0xd2, 0x94, 0x96, 0xfc, 0x3c, 0xff, 0x2a, 0x80, 0x9f, 0x81, 0xce, 0xc0, 0xa9, 0x46, 0xb5, 0xbc,
-		0x56, 0x56, 0xc5, 0x21, 0xa1, 0xc6, 0x56, 0xad, 0xca, 0xae, 0x59, 0xcd, 0xa0, 0x93, 0x80, 0x82,
-		0x99, 0xde, 0xa1, 0xa6, 0x13, 0x30, 0x1b, 0x4c, 0x67, 0x77, 0x5e, 0x22, 0xc4, 0x53, 0x34, 0x3b,
-		0xdd, 0x36, 0xa6, 0x3b, 0x8f, 0x9a, 0x29, 0xa4, 0x36, 0xd9, 0x09, 0xf9, 0xcd, 0x7f, 0xc7, 0xee,
-		0x41, 0xcc, 0xf9, 0xec, 0x9e, 0xcc, 0x97, 0xd7, 0x60, 0x56, 0x37, 0x0c, 0xdc, 0x0d, 0x41, 0x4e,
-		0x30, 0xd5, 0x04, 0x90, 0xee, 0xa5, 0x72, 0x4e, 0x1f, 0xed, 0x79, 0x48, 0x38, 0xb4, 0xf5, 0x93,
-		0x20, 0x7e, 0x8b, 0x43, 0x70, 0xf2, 0x65, 0x0b, 0x66, 0xd9, 0xbd, 0x3e, 0x1c, 0xa8, 0xc6, 0xd1,
-		0x71, 0x86, 0x7f, 0xf2, 0xa9, 0xa7, 0xe9, 0xce, 0xea, 0x43, 0xe1, 0x6e, 0x19, 0xa1, 0x4e, 0xaa,
-		0xcc, 0xb1, 0xfd, 0x8a, 0x62, 0xc8, 0x8b, 0xf2, 0x78, 0x85, 0x8f, 0x2e, 0xec, 0x57, 0x78, 0x61,
-		0x67, 0x47, 0xe9, 0x40, 0xa0, 0xa4, 0x1c, 0x47, 0x65, 0x19, 0x95, 0xda, 0xb8, 0x31, 0xfd, 0xda,
-		0x13, 0xc3, 0xb3, 0x13, 0xfb, 0xef, 0x29, 0x8a, 0x7c, 0x25, 0x58, 0x8c, 0x37, 0xf6, 0x3e, 0x12,
-		0x83, 0x59, 0xbd, 0x63, 0x5a, 0xf6, 0x05, 0xfa, 0x2f, 0x1f, 0x73, 0x71, 0xfa, 0x31, 0xc5, 0xa6,
-		0xe4, 0x65, 0x36, 0x14, 0x26, 0x6b, 0xcc, 0x9f, 0x7c, 0xe7, 0x4f, 0xc4, 0xfd, 0xe1, 0xb2, 0xbc,
-		0x0e, 0xb2, 0x38, 0x12, 0x8c, 0x2d, 0xc3, 0x6e, 0x4d, 0x15, 0xa5, 0xf8, 0x53, 0x81, 0x21, 0xe2,
-		0x5b, 0x35, 0xce, 0xba, 0xfc, 0x4e, 0x48, 0x79, 0x30, 0x93, 0x3c, 0x13, 0x01, 0xe2, 0x71, 0x10,
-		0xbf, 0x84, 0x8d, 0xcc, 0x69, 0xbc, 0xd0, 0x2f, 0x0b, 0x7e, 0x36, 0x42, 0x37, 0x48, 0x6b, 0xae,
-		0x41, 0xbe, 0x65, 0x5b, 0xae, 0x66, 0x77, 0x4c, 0x17, 0x77, 0xba, 0xee, 0x44, 0xbf, 0xee, 0xcf,
-		0x19, 0x48, 0x4a, 0xcd, 0x11, 0xbe, 0x4d, 0xc1, 0x46, 0x6a, 0xc2, 0x6e, 0xfd, 0x4d, 0x53, 0x93,
-		0xff, 0xee, 0xd5, 0x84, 0xf2, 0x90, 0x9a, 0xdc, 0x97, 0x76, 0x38, 0xad, 0x5b, 0xdc, 0x98, 0xbb,
-		0x07, 0x4c, 0x0b, 0x3c, 0xed, 0xf8, 0x44, 0x14, 0xce, 0x72, 0xe2, 0x1d, 0xdd, 0xc1, 0x17, 0x6e,
-		0x3f, 0xb3, 0x83, 0x5d, 0xfd, 0x99, 0x0b, 0x86, 0x6d, 0x8a, 0x99, 0x7c, 0x8e, 0x1b, 0x6b, 0x92,
-		0xbf, 0xc4, 0xf3, 0x4b, 0x23, 0xb7, 0xbb, 0x4b, 0xe3, 0x8d, 0x7c, 0x69, 0x58, 0x07, 0x95, 0x36,
-		0xc4, 0xaa, 0xb6, 0x69, 0x91, 0xb9, 0xad, 0x85, 0x2d, 0xbb, 0xc3, 0xcd, 0x2d, 0xfb, 0x40, 0xab,
-		0x90, 0xd0, 0x3b, 0x76, 0xdf, 0x72, 0x99, 0xa9, 0xad, 0x3c, 0xfd, 0x1b, 0x6f, 0x2e, 0xcc, 0xfc,
-		0xc7, 0x37, 0x17, 0x4e, 0x30, 0x58, 0xa7, 0x75, 0x6b, 0xc9, 0xb4, 0x2f, 0x74, 0x74, 0x77, 0x9f,
-		0x0c, 0xdf, 0xdf, 0xfe, 0xf4, 0x53, 0xc0, 0xcb, 0xab, 0x5b, 0xee, 0x27, 0x3f, 0xff, 0x33, 0xe7,
-		0x25, 0x95, 0xf3, 0x2f, 0xc7, 0xbe, 0xf0, 0xb1, 0x05, 0x49, 0xe9, 0x42, 0x72, 0x05, 0x1b, 0x47,
-		0x14, 0x58, 0x1f, 0x28, 0xf0, 0x19, 0x5e, 0xe0, 0x99, 0xe1, 0x02, 0xd9, 0x81, 0xbd, 0x15, 0x6c,
-		0x04, 0x8a, 0x5d, 0xc1, 0x46, 0xb8, 0xc4, 0xca, 0xca, 0xef, 0xfc, 0xfe, 0xd9, 0x99, 0xf7, 0x7f,
-		0xf6, 0xec, 0xcc, 0xd8, 0x2e, 0x53, 0x26, 0x77, 0x99, 0xd7, 0x53, 0x3f, 0x11, 0x83, 0x07, 0xe9,
-		0xfd, 0xd2, 0x5e, 0xc7, 0xb4, 0xdc, 0x0b, 0x46, 0xef, 0xb0, 0xeb, 0xda, 0x64, 0xe0, 0xda, 0xbb,
-		0xbc, 0xa3, 0x66, 0xfd, 0xec, 0x25, 0x96, 0x3d, 0xba, 0x9b, 0x94, 0x5d, 0x88, 0x6f, 0x11, 0x3e,
-		0x22, 0x08, 0xd7, 0x76, 0xf5, 0x36, 0xf7, 0x36, 0xd8, 0x07, 0x49, 0x65, 0x77, 0x52, 0x23, 0x2c,
-		0xd5, 0x14, 0xd7, 0x51, 0xdb, 0x58, 0xdf, 0x65, 0x57, 0x7b, 0xa2, 0xd4, 0x4d, 0x4d, 0x91, 0x04,
-		0x7a, 0x8b, 0x67, 0x1e, 0xe2, 0x7a, 0x9f, 0x1d, 0x98, 0x89, 0x12, 0xff, 0x95, 0x7e, 0x28, 0x2f,
-		0x43, 0x92, 0xef, 0x9b, 0x23, 0x19, 0xa2, 0xb7, 0xf0, 0x21, 0x2d, 0x27, 0xab, 0x92, 0x9f, 0x68,
-		0x09, 0xe2, 0xb4, 0xf2, 0xfc, 0xce, 0x62, 0x71, 0x69, 0xa8, 0xf6, 0x4b, 0xb4, 0x92, 0x2a, 0x23,
-		0x53, 0xae, 0x43, 0x6a, 0xc5, 0x26, 0xda, 0x13, 0x46, 0x4b, 0x33, 0x34, 0x5a, 0xe7, 0x6e, 0x9f,
-		0xf7, 0x9d, 0xca, 0x3e, 0xd0, 0x49, 0x48, 0xb0, 0xab, 0x5e, 0xfc, 0xd0, 0x0f, 0xff, 0x52, 0xaa,
-		0x90, 0xa4, 0xd8, 0x9b, 0x5d, 0xef, 0x7a, 0xb5, 0x14, 0xb8, 0x5e, 0xcd, 0xe1, 0x23, 0x7e, 0x65,
-		0x11, 0xc4, 0x5a, 0xba, 0xab, 0xf3, 0x76, 0xd3, 0xdf, 0xca, 0xbb, 0x21, 0xc5, 0x41, 0x1c, 0x74,
-		0x11, 0xa2, 0x76, 0xd7, 0xe1, 0xc7, 0x76, 0x4a, 0xe3, 0x9a, 0xb2, 0xd9, 0xad, 0xc4, 0x88, 0x52,
-		0xa9, 0x84, 0xb8, 0xb2, 0x31, 0x56, 0x2d, 0x9e, 0x0b, 0xa9, 0x45, 0x07, 0xbb, 0x3b, 0xbb, 0xae,
-		0xff, 0x83, 0x75, 0xe7, 0x90, 0x2a, 0x78, 0x8a, 0x72, 0x2f, 0x02, 0x67, 0x03, 0xb9, 0xb7, 0x71,
-		0xcf, 0x31, 0x6d, 0x8b, 0x69, 0x13, 0xd7, 0x14, 0x14, 0xa8, 0x20, 0xcf, 0x1f, 0xa3, 0x2a, 0xef,
-		0x82, 0x68, 0xb9, 0xdb, 0x45, 0x25, 0x48, 0xd1, 0x6f, 0xc3, 0x66, 0xba, 0x12, 0x53, 0xbd, 0x6f,
-		0x92, 0xe7, 0xd8, 0xbb, 0xee, 0x1d, 0xbd, 0xe7, 0xdd, 0x84, 0x16, 0xdf, 0xca, 0x8b, 0x90, 0xae,
-		0xda, 0x96, 0x83, 0x2d, 0xa7, 0x4f, 0x7d, 0xd8, 0x9d, 0xb6, 0x6d, 0xdc, 0xe2, 0x08, 0xec, 0x83,
-		0x08, 0x5b, 0xef, 0x76, 0x29, 0x67, 0x4c, 0x25, 0x3f, 0xf9, 0xe8, 0xd9, 0x1c, 0x2b, 0x9e, 0x4b,
-		0xc7, 0x13, 0x0f, 0x6f, 0xa0, 0xef, 0x8c, 0x4a, 0xf0, 0xc0, 0xf0, 0x40, 0xba, 0x85, 0x0f, 0x9d,
-		0xe3, 0x8e, 0xa3, 0x57, 0x21, 0xbd, 0x45, 0x9f, 0x95, 0x79, 0x19, 0x1f, 0xa2, 0x12, 0x24, 0x71,
-		0xeb, 0xe2, 0xa5, 0x4b, 0xcf, 0xbc, 0xc8, 0xb4, 0x7c, 0x75, 0x46, 0x15, 0x09, 0xe8, 0x2c, 0xa4,
-		0x1d, 0x6c, 0x74, 0x2f, 0x5e, 0xba, 0x7c, 0xeb, 0x19, 0xa6, 0x56, 0xab, 0x33, 0xaa, 0x9f, 0xb4,
-		0x9c, 0x22, 0x2d, 0xfe, 0xc2, 0xc7, 0x17, 0xa4, 0x4a, 0x1c, 0xa2, 0x4e, 0xbf, 0xf3, 0xb6, 0xe9,
-		0xc6, 0x1f, 0x27, 0x60, 0x31, 0x90, 0xcb, 0x26, 0x85, 0xdb, 0x7a, 0xdb, 0x6c, 0xe9, 0xfe, 0x63,
-		0x40, 0x72, 0xa0, 0xfd, 0x94, 0x62, 0x8c, 0xb5, 0x3f, 0x52, 0x8a, 0xca, 0xcf, 0x49, 0x90, 0xbd,
-		0x21, 0x90, 0x1b, 0xd8, 0x45, 0x57, 0x00, 0xbc, 0x92, 0xc4, 0x50, 0x39, 0xb3, 0x34, 0x58, 0xd6,
-		0x92, 0xc7, 0xa3, 0x06, 0xc8, 0xd1, 0xf3, 0x54, 0x01, 0xbb, 0xb6, 0xc3, 0x6f, 0xc5, 0x4e, 0x60,
-		0xf5, 0x88, 0xd1, 0x93, 0x80, 0xa8, 0x55, 0xd3, 0x6e, 0xdb, 0xae, 0x69, 0xed, 0x69, 0x5d, 0xfb,
-		0x0e, 0x7f, 0x6b, 0x20, 0xaa, 0xca, 0x34, 0xe7, 0x06, 0xcd, 0xd8, 0x22, 0xe9, 0xa4, 0xd2, 0x69,
-		0x0f, 0x85, 0x2c, 0xc7, 0xf4, 0x56, 0xab, 0x87, 0x1d, 0x87, 0x1b, 0x2e, 0xf1, 0x89, 0xae, 0x40,
-		0xb2, 0xdb, 0xdf, 0xd1, 0x84, 0x95, 0xc8, 0x5c, 0x7c, 0x60, 0xd4, 0x98, 0x17, 0xba, 0xc1, 0x47,
-		0x7d, 0xa2, 0xdb, 0xdf, 0x21, 0x9a, 0xf2, 0x10, 0x64, 0x47, 0x54, 0x26, 0x73, 0xdb, 0xaf, 0x07,
-		0x7d, 0xc9, 0x88, 0xb7, 0x40, 0xeb, 0xf6, 0x4c, 0xbb, 0x67, 0xba, 0x87, 0xf4, 0xb8, 0x5d, 0x54,
-		0x95, 0x45, 0xc6, 0x16, 0x4f, 0x57, 0x6e, 0x41, 0xa1, 0x41, 0xdd, 0x74, 0xbf, 0xe6, 0x97, 0xfc,
-		0xfa, 0x49, 0x93, 0xeb, 0x37, 0xb6, 0x66, 0x91, 0xa1, 0x9a, 0x9d, 0xff, 0xf7, 0x12, 0x64, 0x2a,
-		0x64, 0xe0, 0xd6, 0x57, 0xae, 0xb6, 0xf5, 0x3d, 0xf4, 0x0c, 0x9c, 0xa8, 0xac, 0x6d, 0x56, 0x5f,
-		0xd6, 0xea, 0x2b, 0xda, 0xd5, 0xb5, 0xf2, 0x35, 0xff, 0x7c, 0x6f, 0xe9, 0xe4, 0xdd, 0x7b, 0x8b,
-		0x28, 0x40, 0xbb, 0x6d, 0xd1, 0xb5, 0x24, 0xba, 0x00, 0xf3, 0x61, 0x96, 0x72, 0xa5, 0x51, 0xdb,
-		0x68, 0xca, 0x52, 0xe9, 0xc4, 0xdd, 0x7b, 0x8b, 0xb3, 0x01, 0x8e, 0xf2, 0x8e, 0x83, 0x2d, 0x77,
-		0x98, 0xa1, 0xba, 0xb9, 0xbe, 0x5e, 0x6f, 0xca, 0x91, 0x21, 0x86, 0xaa, 0xdd, 0xe9, 0x98, 0x2e,
-		0x7a, 0x1c, 0x66, 0xc3, 0x0c, 0x1b, 0xf5, 0x35, 0x39, 0x5a, 0x42, 0x77, 0xef, 0x2d, 0xe6, 0x03,
-		0xd4, 0x1b, 0x66, 0xbb, 0x94, 0xfa, 0xe0, 0x8f, 0x9d, 0x9d, 0xf9, 0xe4, 0xdf, 0x3d, 0x2b, 0x55,
-		0xd6, 0xc7, 0x8e, 0xb9, 0x67, 0x8f, 0x37, 0xe6, 0xc2, 0xf3, 0xf6, 0x57, 0x1e, 0x08, 0x99, 0x1b,
-		0xee, 0x87, 0x05, 0x8c, 0xf1, 0xb4, 0xc3, 0x6d, 0x52, 0x6c, 0xa1, 0x74, 0xb4, 0x7b, 0x50, 0x9a,
-		0x30, 0x29, 0x94, 0x26, 0x1a, 0x06, 0xe5, 0x45, 0xc8, 0x6d, 0xe9, 0x3d, 0xb7, 0x81, 0xdd, 0x55,
-		0xac, 0xb7, 0x70, 0x2f, 0xec, 0x3f, 0xe4, 0x84, 0xff, 0x80, 0x20, 0x46, 0x9d, 0x04, 0x36, 0x7f,
-		0xd2, 0xdf, 0xca, 0x3e, 0xc4, 0xe8, 0x49, 0x62, 0xcf, 0xb7, 0xe0, 0x1c, 0xcc, 0xb7, 0x20, 0x33,
-		0xc3, 0xa1, 0x8b, 0x1d, 0x11, 0xfe, 0xa2, 0x1f, 0xe8, 0x39, 0xe1, 0x21, 0x44, 0x8f, 0xf6, 0x10,
-		0xf8, 0xf0, 0xe2, 0x7e, 0x42, 0x1b, 0x92, 0xbc, 0x8b, 0xbd, 0x8a, 0x48, 0x7e, 0x45, 0xd0, 0x3a,
-		0x14, 0xba, 0x7a, 0xcf, 0xa5, 0xf7, 0x14, 0xf7, 0x69, 0x2b, 0xf8, 0x08, 0x5e, 0x18, 0xb6, 0x27,
-		0xa1, 0xc6, 0xf2, 0x52, 0x72, 0xdd, 0x60, 0xa2, 0xf2, 0x9f, 0x63, 0x90, 0xe0, 0xc2, 0x78, 0x17,
-		0x24, 0xb9, 0x58, 0xf9, 0x98, 0x7b, 0x70, 0x69, 0x78, 0x9a, 0x5d, 0xf2, 0xa6, 0x43, 0x8e, 0x27,
-		0x78, 0xd0, 0xa3, 0x90, 0x32, 0xf6, 0x75, 0xd3, 0xd2, 0xcc, 0x16, 0x77, 0x40, 0x33, 0x9f, 0x7d,
-		0x73, 0x21, 0x59, 0x25, 0x69, 0xf5, 0x15, 0x35, 0x49, 0x33, 0xeb, 0x2d, 0xe2, 0xd3, 0xec, 0x63,
-		0x73, 0x6f, 0xdf, 0xe5, 0x76, 0x83, 0x7f, 0xa1, 0x17, 0x20, 0x46, 0x14, 0x82, 0xdf, 0x62, 0x2f,
-		0x0d, 0xad, 0x2c, 0xbc, 0xd0, 0x4f, 0x25, 0x45, 0x0a, 0xfe, 0xd0, 0x7f, 0x5a, 0x90, 0x54, 0xca,
-		0x81, 0xaa, 0x90, 0x6b, 0xeb, 0x8e, 0xab, 0xd1, 0xf9, 0x98, 0x14, 0x1f, 0xa7, 0x10, 0xa7, 0x87,
-		0x05, 0xc2, 0x05, 0xcb, 0xab, 0x9e, 0x21, 0x5c, 0x2c, 0xa9, 0x85, 0xce, 0x81, 0x4c, 0x41, 0x0c,
-		0x3a, 0x02, 0x99, 0x97, 0x98, 0xa0, 0x72, 0xcf, 0x93, 0x74, 0x36, 0x30, 0xa9, 0xaf, 0x78, 0x06,
-		0xd2, 0xf4, 0xde, 0x2c, 0x25, 0x61, 0xc7, 0xd7, 0x53, 0x24, 0x81, 0x66, 0x3e, 0x06, 0x05, 0xdf,
-		0xea, 0x33, 0x92, 0x14, 0x43, 0xf1, 0x93, 0x29, 0xe1, 0xd3, 0x30, 0x6f, 0xe1, 0x03, 0x7a, 0xa0,
-		0x3e, 0x44, 0x9d, 0xa6, 0xd4, 0x88, 0xe4, 0xdd, 0x08, 0x73, 0x3c, 0x02, 0x79, 0x43, 0x08, 0x9f,
-		0xd1, 0x02, 0xa5, 0xcd, 0x79, 0xa9, 0x94, 0xec, 0x34, 0xa4, 0xf4, 0x6e, 0x97, 0x11, 0x64, 0xb8,
-		0xd5, 0xef, 0x76, 0x69, 0xd6, 0x79, 0x98, 0xa5, 0x6d, 0xec, 0x61, 0xa7, 0xdf, 0x76, 0x39, 0x48,
-		0x96, 0xd2, 0x14, 0x48, 0x86, 0xca, 0xd2, 0x29, 0xed, 0xc3, 0x90, 0xc3, 0xb7, 0xcd, 0x16, 0xb6,
-		0x0c, 0xcc, 0xe8, 0x72, 0x94, 0x2e, 0x2b, 0x12, 0x29, 0xd1, 0xe3, 0xe0, 0x59, 0x73, 0x4d, 0xcc,
-		0x34, 0x79, 0x86, 0x27, 0xd2, 0xcb, 0x2c, 0x59, 0x29, 0x42, 0x6c, 0x45, 0x77, 0x75, 0xe2, 0x2e,
-		0xb9, 0x07, 0x6c, 0xfa, 0xcc, 0xaa, 0xe4, 0xa7, 0xf2, 0x0b, 0x51, 0x88, 0xdd, 0xb0, 0x5d, 0x8c,
-		0x9e, 0x0d, 0xb8, 0xb2, 0xf9, 0x51, 0xfa, 0xdc, 0x30, 0xf7, 0x2c, 0xdc, 0x5a, 0x77, 0xf6, 0x02,
-		0x8f, 0xdc, 0xf8, 0xea, 0x14, 0x09, 0xa9, 0xd3, 0x3c, 0xc4, 0x7b, 0x76, 0xdf, 0x6a, 0x89, 0x83,
-		0xdf, 0xf4, 0x03, 0xd5, 0x20, 0xe5, 0x69, 0x49, 0x6c, 0x92, 0x96, 0x14, 0x88, 0x96, 0x10, 0x1d,
-		0xe6, 0x09, 0x6a, 0x72, 0x87, 0x2b, 0x4b, 0x05, 0xd2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x9d, 0xc2,
-		0xfa, 0x6c, 0x64, 0x8a, 0xf4, 0xfa, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xd9, 0xcb, 0xe0, 0xd2, 0x0b,
-		0xa9, 0x15, 0x7f, 0x70, 0x27, 0x49, 0xdb, 0xe5, 0xab, 0x15, 0x7b, 0x74, 0xe7, 0x01, 0x48, 0x3b,
-		0xe6, 0x9e, 0xa5, 0xbb, 0xfd, 0x1e, 0xe6, 0x9a, 0xe7, 0x27, 0x90, 0x5c, 0xff, 0x12, 0x04, 0xd3,
-		0xb4, 0xc0, 0xcb, 0x6d, 0x17, 0x60, 0xce, 0x7f, 0x33, 0xcd, 0x47, 0x61, 0x5a, 0x86, 0xbc, 0xac,
-		0x86, 0xc8, 0x51, 0x7e, 0x55, 0x82, 0x04, 0x9f, 0xb1, 0xfc, 0x6e, 0x90, 0x46, 0x77, 0x43, 0x64,
-		0x5c, 0x37, 0x44, 0xef, 0xbf, 0x1b, 0xca, 0x00, 0x5e, 0x35, 0x1d, 0xfe, 0xac, 0xca, 0x08, 0xb7,
-		0x8a, 0x55, 0xb1, 0x61, 0xee, 0xf1, 0x71, 0x1f, 0x60, 0x52, 0x7e, 0x57, 0x22, 0x1e, 0x3e, 0xcf,
-		0x47, 0x65, 0xc8, 0x89, 0x7a, 0x69, 0xbb, 0x6d, 0x7d, 0x8f, 0xab, 0xe2, 0x83, 0x63, 0x2b, 0x47,
-		0x66, 0x61, 0x35, 0xc3, 0xeb, 0x43, 0xfd, 0x89, 0x91, 0xdd, 0x1a, 0x19, 0xd3, 0xad, 0x21, 0x3d,
-		0x8a, 0xde, 0x9f, 0x1e, 0x85, 0x7a, 0x3c, 0x36, 0xd0, 0xe3, 0xca, 0xef, 0x4b, 0xfc, 0xc5, 0xb6,
-		0x16, 0xbb, 0xc1, 0xf1, 0x97, 0xd5, 0x55, 0xaf, 0x71, 0xdd, 0x6a, 0xe1, 0x96, 0x36, 0xd4, 0x67,
-		0x0f, 0x0f, 0x23, 0x86, 0xeb, 0xec, 0xf7, 0x1d, 0x12, 0x28, 0x0d, 0xbf, 0x0f, 0x3f, 0x1d, 0x81,
-		0xd9, 0x21, 0xfa, 0xbf, 0x7a, 0x7d, 0x19, 0x1e, 0xbd, 0xf1, 0x29, 0x47, 0x6f, 0x62, 0xec, 0xe8,
-		0xfd, 0x54, 0x84, 0x06, 0x00, 0xba, 0xb6, 0xa3, 0xb7, 0xbf, 0x16, 0xb6, 0xf7, 0x0c, 0xa4, 0xbb,
-		0x76, 0x5b, 0x63, 0x39, 0xec, 0xea, 0x4d, 0xaa, 0x6b, 0xb7, 0xd5, 0x21, 0x35, 0x8b, 0xbf, 0x45,
-		0x86, 0x39, 0xf1, 0x16, 0x74, 0x42, 0x72, 0x70, 0x40, 0xf5, 0x20, 0xcb, 0x44, 0xc1, 0xbd, 0xa6,
-		0xa7, 0x89, 0x0c, 0xa8, 0x1b, 0x26, 0x0d, 0x7b, 0x79, 0xac, 0xda, 0x8c, 0x52, 0xe5, 0x74, 0x84,
-		0x83, 0x39, 0x19, 0xa3, 0x22, 0x47, 0x41, 0x8b, 0xa5, 0x72, 0x3a, 0xe5, 0xfb, 0x25, 0x80, 0x35,
-		0x22, 0x59, 0xda, 0x5e, 0xe2, 0xef, 0x38, 0xb4, 0x0a, 0x5a, 0xa8, 0xe4, 0xb3, 0xe3, 0x3a, 0x8d,
-		0x97, 0x9f, 0x75, 0x82, 0xf5, 0xae, 0x42, 0xce, 0xd7, 0x6d, 0x07, 0x8b, 0xca, 0x9c, 0x3d, 0x62,
-		0x55, 0xda, 0xc0, 0xae, 0x9a, 0xbd, 0x1d, 0xf8, 0x52, 0xfe, 0x99, 0x04, 0x69, 0x5a, 0xa7, 0x75,
-		0xec, 0xea, 0xa1, 0x3e, 0x94, 0xee, 0xbf, 0x0f, 0x1f, 0x04, 0x60, 0x30, 0x8e, 0xf9, 0x06, 0xe6,
-		0x9a, 0x95, 0xa6, 0x29, 0x0d, 0xf3, 0x0d, 0x8c, 0x2e, 0x7b, 0x02, 0x8f, 0x1e, 0x2d, 0x70, 0xb1,
-		0x6a, 0xe5, 0x62, 0x3f, 0x05, 0x49, 0xfa, 0xa6, 0xe8, 0x81, 0xc3, 0x17, 0xa2, 0x09, 0xab, 0xdf,
-		0x69, 0x1e, 0x38, 0xca, 0xeb, 0x90, 0x6c, 0x1e, 0xb0, 0x78, 0xe2, 0x19, 0x48, 0xf7, 0x6c, 0x9b,
-		0x7b, 0x7f, 0xcc, 0xeb, 0x4e, 0x91, 0x04, 0xea, 0xec, 0x88, 0x18, 0x5a, 0xc4, 0x8f, 0xa1, 0xf9,
-		0x41, 0xc0, 0xe8, 0x54, 0x41, 0x40, 0xb2, 0xfa, 0xcc, 0x85, 0x46, 0x12, 0x7a, 0x12, 0x4e, 0x35,
-		0xea, 0xd7, 0x36, 0x6a, 0x2b, 0xda, 0x7a, 0xe3, 0xda, 0xc0, 0xb5, 0xfa, 0x52, 0xe1, 0xee, 0xbd,
-		0xc5, 0x0c, 0x5f, 0x76, 0x8e, 0xa3, 0xde, 0x52, 0x6b, 0x37, 0x36, 0x9b, 0x35, 0x59, 0x62, 0xd4,
-		0x5b, 0x3d, 0x7c, 0xdb, 0x76, 0xd9, 0xa3, 0xbd, 0x4f, 0xc3, 0xe9, 0x11, 0xd4, 0xde, 0xe2, 0x73,
-		0xf6, 0xee, 0xbd, 0xc5, 0xdc, 0x56, 0x0f, 0x33, 0x2d, 0xa3, 0x1c, 0x4b, 0x50, 0x1c, 0xe6, 0xd8,
-		0xdc, 0xda, 0x6c, 0x94, 0xd7, 0xe4, 0xc5, 0x92, 0x7c, 0xf7, 0xde, 0x62, 0x56, 0x98, 0x0c, 0x42,
-		0xff, 0xf6, 0xaf, 0x3e, 0xbf, 0x3d, 0x15, 0x8a, 0x1a, 0xb3, 0x75, 0x5d, 0x57, 0xef, 0xe9, 0x9d,
-		0xe3, 0x2e, 0x3f, 0x27, 0x6c, 0xf8, 0x2b, 0x3f, 0x17, 0x81, 0x82, 0xb7, 0xb8, 0xd9, 0xa2, 0x25,
-		0xa0, 0x67, 0x83, 0x11, 0xbf, 0xcc, 0xd8, 0xb9, 0x83, 0x51, 0x8b, 0x80, 0xe0, 0x3b, 0x21, 0x25,
-		0x9c, 0x64, 0x3e, 0xa8, 0x16, 0x47, 0xcc, 0x6f, 0x9c, 0x82, 0xb3, 0x7a, 0x1c, 0xe8, 0x25, 0x48,
-		0x7b, 0x43, 0xcc, 0x7b, 0x26, 0x6d, 0xfc, 0x98, 0xe4, 0xfc, 0x3e, 0x0f, 0x7a, 0xd1, 0x5f, 0xc6,
-		0xc5, 0xc6, 0x2d, 0x0c, 0x6f, 0x30, 0x02, 0xce, 0xec, 0x2d, 0xe1, 0x9e, 0x86, 0x98, 0xbe, 0x63,
-		0x98, 0xdc, 0x00, 0x3f, 0x30, 0xcc, 0x57, 0xae, 0x54, 0xeb, 0x9c, 0x89, 0x52, 0x2a, 0x75, 0x1e,
-		0x4c, 0xe1, 0xf2, 0xa2, 0x8f, 0xd2, 0x1c, 0x68, 0x6c, 0x2d, 0xcc, 0xbc, 0x8b, 0x54, 0x47, 0x3f,
-		0xa8, 0xd0, 0xe5, 0xf0, 0x29, 0x48, 0x92, 0xcc, 0x3d, 0xfe, 0x50, 0x41, 0x54, 0x4d, 0x74, 0xf4,
-		0x83, 0x6b, 0xba, 0x73, 0x3d, 0x96, 0x8a, 0xca, 0x31, 0xe5, 0x27, 0x89, 0xa7, 0x12, 0x92, 0x0a,
-		0x7a, 0x02, 0x10, 0xe1, 0xd0, 0xf7, 0xb0, 0x46, 0x86, 0x2e, 0x15, 0xaf, 0xc0, 0x2d, 0x74, 0xf4,
-		0x83, 0xf2, 0x1e, 0xde, 0xe8, 0x77, 0x68, 0x05, 0x1c, 0xb4, 0x0e, 0xb2, 0x20, 0x16, 0x3d, 0xcb,
-		0xc5, 0x7f, 0x7a, 0xf8, 0x61, 0x5c, 0x4e, 0xc0, 0x26, 0x80, 0x0f, 0x93, 0x09, 0x20, 0xcf, 0xf0,
-		0xbc, 0x23, 0x1e, 0xa1, 0xa6, 0x44, 0xc3, 0x4d, 0x51, 0x5e, 0x82, 0xc2, 0x40, 0x0f, 0x20, 0x05,
-		0x72, 0x3c, 0x62, 0x45, 0xb7, 0xcf, 0xd9, 0x0a, 0x27, 0xad, 0x66, 0x58, 0x64, 0x8a, 0x1e, 0x27,
-		0x58, 0x4e, 0xfd, 0xe2, 0xc7, 0x16, 0x24, 0xba, 0x99, 0xf3, 0x04, 0xe4, 0x42, 0x7d, 0x20, 0xa2,
-		0xc8, 0x92, 0x1f, 0x45, 0xf6, 0x89, 0x5f, 0x83, 0x2c, 0x31, 0x40, 0xb8, 0xc5, 0x69, 0x1f, 0x85,
-		0x02, 0x33, 0x90, 0x83, 0xb2, 0x66, 0x0e, 0xcf, 0xba, 0x10, 0xb8, 0x22, 0x3c, 0xa0, 0xb0, 0xd8,
-		0x33, 0x82, 0xea, 0x9a, 0xee, 0x28, 0x9b, 0x00, 0x7e, 0xa7, 0xa2, 0x32, 0x3c, 0x48, 0x8c, 0x47,
-		0xf0, 0xb8, 0x27, 0x7f, 0x20, 0x2b, 0xe4, 0x31, 0x96, 0x08, 0x91, 0x7f, 0xa4, 0x93, 0x3d, 0x93,
-		0xb5, 0x4a, 0x29, 0x2a, 0xaf, 0x7c, 0xf2, 0xb3, 0x67, 0xa5, 0xb7, 0xc7, 0x16, 0xfc, 0x87, 0x9b,
-		0x70, 0x26, 0x90, 0x49, 0x14, 0x30, 0x14, 0x88, 0x2a, 0x04, 0xf4, 0x95, 0x64, 0x4e, 0x0a, 0x28,
-		0x1d, 0x19, 0xff, 0x2d, 0x1d, 0x6d, 0x76, 0x26, 0x47, 0x9b, 0x26, 0xc7, 0xbb, 0x46, 0x07, 0xe5,
-		0xff, 0x69, 0x1a, 0x92, 0x2a, 0x7e, 0x5f, 0x1f, 0x3b, 0x2e, 0xba, 0x08, 0x31, 0x6c, 0xec, 0xdb,
-		0xa3, 0x62, 0xa0, 0xa4, 0x81, 0x4b, 0x9c, 0xae, 0x66, 0xec, 0xdb, 0xab, 0x33, 0x2a, 0xa5, 0x45,
-		0x97, 0x20, 0xbe, 0xdb, 0xee, 0xf3, 0xf0, 0xd5, 0x80, 0xcd, 0x0a, 0x32, 0x5d, 0x25, 0x44, 0xab,
-		0x33, 0x2a, 0xa3, 0x26, 0x45, 0xd1, 0x17, 0xcd, 0xa3, 0x47, 0x17, 0x55, 0xb7, 0x76, 0x69, 0x51,
-		0x84, 0x16, 0x55, 0x00, 0x4c, 0xcb, 0x74, 0x35, 0x1a, 0xda, 0xe1, 0x56, 0xe3, 0xa1, 0xf1, 0x9c,
-		0xa6, 0x4b, 0x83, 0x41, 0xab, 0x33, 0x6a, 0xda, 0x14, 0x1f, 0xa4, 0xba, 0xef, 0xeb, 0xe3, 0xde,
-		0x21, 0xf7, 0xd6, 0xc6, 0x56, 0xf7, 0x15, 0x42, 0x44, 0xaa, 0x4b, 0xa9, 0x89, 0x91, 0x65, 0x4f,
-		0xed, 0xb9, 0x07, 0xfc, 0x01, 0xd9, 0x85, 0x71, 0x9c, 0xf4, 0xbd, 0xbd, 0xe6, 0xc1, 0xea, 0x8c,
-		0x9a, 0x34, 0xd8, 0x4f, 0xf4, 0x82, 0xe7, 0x82, 0x65, 0x86, 0xbd, 0x9e, 0x10, 0x2f, 0x0b, 0xfb,
-		0xcc, 0x08, 0x57, 0x0c, 0x6d, 0x40, 0xbe, 0x6d, 0x3a, 0xae, 0xe6, 0x58, 0x7a, 0xd7, 0xd9, 0xb7,
-		0x5d, 0x87, 0xc6, 0x4f, 0x32, 0x17, 0x1f, 0x19, 0x87, 0xb0, 0x66, 0x3a, 0x6e, 0x43, 0x10, 0xaf,
-		0xce, 0xa8, 0xb9, 0x76, 0x30, 0x81, 0xe0, 0xd9, 0xbb, 0xbb, 0xb8, 0xe7, 0x01, 0xd2, 0x38, 0xcb,
-		0x11, 0x78, 0x9b, 0x84, 0x5a, 0xf0, 0x13, 0x3c, 0x3b, 0x98, 0x80, 0xbe, 0x01, 0xe6, 0xda, 0xb6,
-		0xde, 0xf2, 0xe0, 0x34, 0x63, 0xbf, 0x6f, 0xdd, 0xa2, 0x41, 0x99, 0xcc, 0xc5, 0xc7, 0xc7, 0x56,
-		0xd2, 0xd6, 0x5b, 0x02, 0xa2, 0x4a, 0x18, 0x56, 0x67, 0xd4, 0xd9, 0xf6, 0x60, 0x22, 0x7a, 0x2f,
-		0xcc, 0xeb, 0xdd, 0x6e, 0xfb, 0x70, 0x10, 0xbd, 0x40, 0xd1, 0xcf, 0x8f, 0x43, 0x2f, 0x13, 0x9e,
-		0x41, 0x78, 0xa4, 0x0f, 0xa5, 0xa2, 0x26, 0xc8, 0xdd, 0x1e, 0xa6, 0x37, 0xa6, 0xba, 0xdc, 0xcb,
-		0xa0, 0xaf, 0x53, 0x65, 0x2e, 0x3e, 0x36, 0x0e, 0x7b, 0x8b, 0xd1, 0x0b, 0xa7, 0x64, 0x75, 0x46,
-		0x2d, 0x74, 0xc3, 0x49, 0x0c, 0xd5, 0x36, 0x30, 0x7d, 0x3c, 0x8f, 0xa3, 0xce, 0x4e, 0x42, 0xa5,
-		0xf4, 0x61, 0xd4, 0x50, 0x12, 0xaa, 0x41, 0x86, 0x2d, 0x45, 0x35, 0x62, 0x0c, 0xe9, 0x9b, 0x56,
-		0x99, 0x8b, 0xca, 0xd8, 0x11, 0x4a, 0x49, 0x6f, 0xd8, 0x2e, 0x5e, 0x9d, 0x51, 0x01, 0x7b, 0x5f,
-		0x48, 0x87, 0x13, 0xf4, 0x79, 0xaf, 0x43, 0x2d, 0x6c, 0x78, 0x8b, 0x73, 0x14, 0xf0, 0x89, 0x71,
-		0x80, 0xf4, 0x85, 0xeb, 0xc3, 0x1b, 0x41, 0x3b, 0xbc, 0x3a, 0xa3, 0xce, 0xdd, 0x1e, 0x4e, 0x26,
-		0x2a, 0xb6, 0x6b, 0x5a, 0x7a, 0xdb, 0x7c, 0x03, 0xb3, 0x29, 0x94, 0x3e, 0x6c, 0x79, 0x84, 0x8a,
-		0x5d, 0xe5, 0xd4, 0x74, 0x62, 0x25, 0x2a, 0xb6, 0x1b, 0x4c, 0xa8, 0x24, 0xf9, 0x59, 0x4f, 0xef,
-		0xa1, 0xb6, 0xa4, 0x9c, 0x62, 0x8f, 0xb3, 0x5d, 0x8f, 0xa5, 0x40, 0xce, 0x28, 0x8f, 0x41, 0x26,
-		0x60, 0x98, 0x50, 0x11, 0x92, 0xfc, 0x34, 0x8c, 0x38, 0x35, 0xca, 0x3f, 0x95, 0x3c, 0x64, 0x83,
-		0xc6, 0x48, 0xf9, 0x90, 0xe4, 0x71, 0xd2, 0x37, 0x25, 0x8a, 0xe1, 0x88, 0x74, 0xda, 0xf7, 0x54,
-		0x1e, 0x16, 0x53, 0x9b, 0xc8, 0x67, 0xdb, 0xaf, 0x59, 0x9a, 0xc8, 0x67, 0x56, 0xb4, 0x00, 0x99,
-		0xee, 0xc5, 0xae, 0x47, 0x12, 0xa5, 0x24, 0xd0, 0xbd, 0xd8, 0x15, 0x04, 0x0f, 0x41, 0x96, 0xb4,
-		0x5b, 0x0b, 0xfa, 0x4b, 0x69, 0x35, 0x43, 0xd2, 0x38, 0x89, 0xf2, 0xaf, 0x22, 0x20, 0x0f, 0x1a,
-		0x30, 0x2f, 0x54, 0x2d, 0x1d, 0x3b, 0x54, 0x7d, 0x7a, 0x30, 0x48, 0xee, 0xc7, 0xc5, 0xd7, 0x40,
-		0xf6, 0xc3, 0xbb, 0x6c, 0xaa, 0x19, 0xef, 0xff, 0x0d, 0x38, 0xaa, 0x6a, 0xc1, 0x18, 0xf0, 0x5c,
-		0xaf, 0x86, 0x36, 0x2b, 0xc5, 0x9f, 0xfc, 0x18, 0xec, 0x70, 0xcf, 0x89, 0xd9, 0xee, 0xb6, 0x74,
-		0x17, 0x8b, 0xf8, 0x58, 0x60, 0xdf, 0xf2, 0x51, 0x28, 0xe8, 0xdd, 0xae, 0xe6, 0xb8, 0xba, 0x8b,
-		0xb9, 0xaf, 0xc1, 0x22, 0x0f, 0x39, 0xbd, 0xdb, 0xa5, 0x4f, 0xab, 0x33, 0x5f, 0xe3, 0x11, 0xc8,
-		0x13, 0x9b, 0x6e, 0xea, 0x6d, 0xe1, 0x2a, 0x24, 0x98, 0x4b, 0xc2, 0x53, 0x99, 0x77, 0xa0, 0xb4,
-		0xbc, 0x1e, 0xa7, 0xf6, 0xdc, 0x5b, 0x53, 0x49, 0x81, 0x35, 0x15, 0xe2, 0x6f, 0x7d, 0x30, 0xf9,
-		0x88, 0xf7, 0x51, 0x46, 0x6f, 0x1a, 0xcc, 0xd3, 0xf5, 0xd7, 0x6d, 0x16, 0x2c, 0x49, 0xa9, 0xec,
-		0x43, 0x51, 0x21, 0x1f, 0xb6, 0xfd, 0x28, 0x0f, 0x11, 0xf7, 0x80, 0x97, 0x12, 0x71, 0x0f, 0x88,
-		0xa7, 0xeb, 0xbd, 0xb7, 0x9b, 0x1f, 0x31, 0xdb, 0x71, 0x3e, 0x3f, 0xd6, 0xa1, 0x14, 0x20, 0x17,
-		0x9a, 0x13, 0x94, 0x93, 0x30, 0x3f, 0xca, 0xc4, 0x2b, 0xfb, 0x5e, 0x7a, 0xc8, 0x54, 0xa3, 0x4b,
-		0x90, 0xf2, 0x6c, 0xfc, 0x88, 0xd5, 0x31, 0x2d, 0x56, 0x10, 0xab, 0x1e, 0x69, 0x28, 0x9c, 0x1f,
-		0x09, 0x85, 0xf3, 0x95, 0x6f, 0x86, 0xe2, 0x38, 0xfb, 0x3d, 0x10, 0xe4, 0x8b, 0x79, 0x02, 0x3b,
-		0x09, 0x09, 0xfe, 0xb0, 0x65, 0x84, 0x6e, 0x60, 0xf1, 0x2f, 0x22, 0x48, 0x66, 0xcb, 0xa3, 0x6c,
-		0x5f, 0x8b, 0x7e, 0x28, 0x1a, 0x9c, 0x1e, 0x6b, 0xc3, 0xc7, 0x6f, 0x85, 0x31, 0x20, 0xbe, 0x15,
-		0x66, 0x88, 0xea, 0x38, 0xb4, 0xad, 0xe2, 0x20, 0x0b, 0xfb, 0x52, 0x3e, 0x1c, 0x85, 0x93, 0xa3,
-		0x2d, 0x39, 0x5a, 0x84, 0x2c, 0xf1, 0x5b, 0xdd, 0xb0, 0x8b, 0x0b, 0x1d, 0xfd, 0xa0, 0xc9, 0xfd,
-		0x5b, 0xbe, 0x95, 0x10, 0xf1, 0xb6, 0x12, 0xd0, 0x36, 0xcc, 0xb6, 0x6d, 0x43, 0x6f, 0x6b, 0x81,
-		0xad, 0x1c, 0x3e, 0x88, 0x1e, 0x1e, 0x12, 0x76, 0x38, 0x64, 0x48, 0x0c, 0x0e, 0xd7, 0xff, 0x02,
-		0xc5, 0x58, 0xf3, 0x76, 0x7d, 0xd0, 0x0a, 0x64, 0x3a, 0xa6, 0xb3, 0x83, 0xf7, 0xf5, 0xdb, 0xa6,
-		0xdd, 0xe3, 0xa3, 0x69, 0x58, 0x69, 0xd6, 0x7d, 0x1a, 0xb1, 0xc3, 0x14, 0x60, 0x0b, 0x74, 0x49,
-		0x7c, 0xe4, 0xc6, 0x57, 0xe2, 0xd8, 0xd6, 0x64, 0xdc, 0x1e, 0x52, 0x72, 0xec, 0x1e, 0xd2, 0xa8,
-		0x0d, 0x9b, 0xd4, 0xe8, 0x0d, 0x9b, 0x0f, 0x06, 0xbb, 0x26, 0x3c, 0xf7, 0x0d, 0xed, 0xe1, 0xa0,
-		0x06, 0xcc, 0x73, 0xfe, 0x56, 0x48, 0xf6, 0x23, 0x8e, 0x3a, 0xb0, 0xf1, 0x35, 0x28, 0x73, 0x24,
-		0xd8, 0xc7, 0x8b, 0x3d, 0x7a, 0x7f, 0x62, 0x17, 0x9b, 0xa8, 0xb1, 0xc0, 0x26, 0xea, 0xff, 0x65,
-		0x5d, 0xf1, 0x81, 0x28, 0xcc, 0x0e, 0x39, 0x12, 0x23, 0x77, 0x87, 0xc7, 0x45, 0x64, 0x45, 0xc3,
-		0xa2, 0xc7, 0x6e, 0x18, 0xef, 0xeb, 0xd8, 0xe4, 0xbe, 0x8e, 0xbf, 0x85, 0x7d, 0x9d, 0xb8, 0xbf,
-		0xbe, 0x7e, 0x5b, 0x7b, 0xe1, 0xa3, 0x12, 0x94, 0xc6, 0x7b, 0x5f, 0x23, 0xbb, 0xe3, 0x58, 0x1b,
-		0x08, 0xe3, 0xe6, 0xb8, 0x47, 0x20, 0x3f, 0xe0, 0x1b, 0x32, 0x55, 0xce, 0x85, 0x56, 0xe1, 0xca,
-		0xb7, 0x46, 0xbd, 0x89, 0x27, 0xe4, 0xc0, 0x8d, 0x18, 0xad, 0xaf, 0xc0, 0x5c, 0x0b, 0x1b, 0x66,
-		0xeb, 0x7e, 0x07, 0xeb, 0x2c, 0xe7, 0xfe, 0x7f, 0x63, 0x75, 0x58, 0x4b, 0xbe, 0x0f, 0x20, 0xa5,
-		0x62, 0xa7, 0x4b, 0xfc, 0x31, 0x54, 0x81, 0x34, 0x3e, 0x30, 0x70, 0xd7, 0xf5, 0x0f, 0x55, 0x8c,
-		0x5a, 0x22, 0x30, 0xea, 0x9a, 0xa0, 0x24, 0x0b, 0x64, 0x8f, 0x0d, 0x3d, 0xcb, 0x63, 0x00, 0xe3,
-		0x97, 0xf3, 0x9c, 0x3d, 0x18, 0x04, 0xb8, 0x2c, 0x82, 0x00, 0xd1, 0xb1, 0xeb, 0x5b, 0xc6, 0x35,
-		0x10, 0x05, 0x78, 0x96, 0x47, 0x01, 0x62, 0x13, 0x0a, 0x0b, 0x85, 0x01, 0xaa, 0xa1, 0x30, 0x40,
-		0x62, 0x42, 0x33, 0xc7, 0xc4, 0x01, 0x2e, 0x8b, 0x38, 0x40, 0x72, 0x42, 0x8d, 0x07, 0x02, 0x01,
-		0xef, 0x0a, 0x04, 0x02, 0xd2, 0xc3, 0xd1, 0xd6, 0x10, 0xeb, 0x88, 0x48, 0xc0, 0x8b, 0x5e, 0x24,
-		0x20, 0x3b, 0x36, 0x8a, 0xc0, 0x99, 0x07, 0x43, 0x01, 0x9b, 0x43, 0xa1, 0x80, 0x1c, 0xff, 0xc3,
-		0x56, 0xe3, 0x20, 0x26, 0xc4, 0x02, 0x36, 0x87, 0x62, 0x01, 0xf9, 0x09, 0x80, 0x13, 0x82, 0x01,
-		0xdf, 0x38, 0x3a, 0x18, 0x30, 0x7e, 0xb9, 0xce, 0xab, 0x39, 0x5d, 0x34, 0x40, 0x1b, 0x13, 0x0d,
-		0x90, 0xc7, 0xae, 0x5c, 0x19, 0xfc, 0xd4, 0xe1, 0x80, 0xed, 0x11, 0xe1, 0x00, 0xb6, 0x70, 0x3f,
-		0x37, 0x16, 0x7c, 0x8a, 0x78, 0xc0, 0xf6, 0x88, 0x78, 0x00, 0x9a, 0x08, 0x3b, 0x31, 0x20, 0x70,
-		0x35, 0x1c, 0x10, 0x98, 0x1b, 0xe3, 0x75, 0xfa, 0xa3, 0x7d, 0x4c, 0x44, 0x60, 0x67, 0x5c, 0x44,
-		0x80, 0xad, 0xda, 0x9f, 0x1c, 0x8b, 0x78, 0x8c, 0x90, 0xc0, 0xe6, 0x50, 0x48, 0xe0, 0xc4, 0x04,
-		0x4d, 0x9b, 0x3e, 0x26, 0xc0, 0x9e, 0x6d, 0x67, 0x0f, 0xb6, 0x83, 0x9c, 0xb9, 0x1e, 0x4b, 0x65,
-		0xe4, 0xac, 0xf2, 0x38, 0xf1, 0x60, 0x06, 0xec, 0x1c, 0x59, 0x2b, 0xe0, 0x5e, 0xcf, 0xee, 0x89,
-		0x7b, 0x0c, 0xf4, 0x43, 0x39, 0x47, 0xd6, 0x88, 0xbe, 0x4d, 0x3b, 0x22, 0x7e, 0x40, 0xd7, 0x64,
-		0x01, 0x3b, 0xa6, 0xfc, 0xa2, 0xe4, 0xf3, 0xd2, 0x08, 0x42, 0x70, 0x7d, 0x99, 0xe6, 0xeb, 0xcb,
-		0x40, 0x54, 0x21, 0x12, 0x8e, 0x2a, 0x2c, 0x40, 0x86, 0xac, 0xb5, 0x06, 0x02, 0x06, 0x7a, 0xd7,
-		0x0b, 0x18, 0x88, 0x03, 0x54, 0x2c, 0xf6, 0xc0, 0xa7, 0x25, 0xb6, 0x9b, 0x58, 0xf0, 0x0e, 0x93,
-		0xb1, 0xa5, 0x2e, 0x7a, 0x0a, 0xe6, 0x02, 0xb4, 0xde, 0x1a, 0x8e, 0xad, 0x9e, 0x65, 0x8f, 0xba,
-		0xcc, 0x17, 0x73, 0xbf, 0x2e, 0xf9, 0x12, 0xf2, 0x23, 0x0d, 0xa3, 0x82, 0x02, 0xd2, 0x5b, 0x14,
-		0x14, 0x88, 0xdc, 0x77, 0x50, 0x20, 0xb8, 0x26, 0x8d, 0x86, 0xd7, 0xa4, 0xff, 0x43, 0xf2, 0xfb,
-		0xc4, 0x5b, 0xe2, 0x1b, 0x76, 0x0b, 0xf3, 0x55, 0x22, 0xfd, 0x4d, 0x5c, 0x92, 0xb6, 0xbd, 0xc7,
-		0xd7, 0x82, 0xe4, 0x27, 0xa1, 0xf2, 0x26, 0x9e, 0x34, 0x9f, 0x57, 0xbc, 0x05, 0x66, 0x3c, 0x78,
-		0x8f, 0x83, 0x5f, 0x6e, 0x48, 0xf8, 0x97, 0x1b, 0xbc, 0xcb, 0xc7, 0xc9, 0xc0, 0xe5, 0x63, 0xf4,
-		0x02, 0xa4, 0x69, 0xb0, 0x5f, 0xb3, 0xbb, 0xe2, 0x6f, 0x8c, 0x9d, 0x19, 0x7f, 0xb1, 0xc1, 0xa1,
-		0x47, 0xae, 0xd9, 0x65, 0x08, 0xdf, 0xe3, 0x48, 0x87, 0x3c, 0x8e, 0x07, 0x20, 0x4d, 0x6a, 0xcf,
-		0xfe, 0x56, 0x06, 0xf0, 0x9b, 0xeb, 0x22, 0x41, 0xf9, 0xa9, 0x08, 0x14, 0x06, 0x26, 0x9a, 0x91,
-		0x6d, 0x1f, 0xb5, 0x8d, 0x3c, 0x9d, 0x3c, 0xce, 0x02, 0xec, 0xe9, 0x8e, 0x76, 0x47, 0xb7, 0x5c,
-		0xfe, 0x27, 0xf1, 0xa2, 0x6a, 0x20, 0x05, 0x95, 0x20, 0x45, 0xbe, 0xfa, 0x0e, 0xff, 0xa3, 0x78,
-		0x51, 0xd5, 0xfb, 0x46, 0xab, 0x90, 0xc0, 0xb7, 0xe9, 0xeb, 0xae, 0xec, 0x8d, 0xe4, 0x93, 0xc3,
-		0xcb, 0x61, 0x92, 0x5d, 0x29, 0x92, 0xce, 0xfe, 0xe2, 0x9b, 0x0b, 0x32, 0xa3, 0x7e, 0xd2, 0xbb,
-		0x30, 0xa6, 0x72, 0xfe, 0xb0, 0x14, 0x52, 0x03, 0x52, 0xa0, 0x71, 0xc0, 0xac, 0x58, 0xde, 0x13,
-		0x99, 0xb2, 0x33, 0xde, 0x6a, 0xae, 0x83, 0x3b, 0x5d, 0xdb, 0x6e, 0x6b, 0x6c, 0x8c, 0x97, 0x21,
-		0x1f, 0x9e, 0x57, 0xd9, 0xdf, 0xb1, 0x72, 0x75, 0xd3, 0xd2, 0x42, 0x4e, 0x70, 0x96, 0x25, 0xb2,
-		0x31, 0x75, 0x3d, 0x96, 0x92, 0xe4, 0xc8, 0xf5, 0x58, 0x2a, 0x22, 0x47, 0x95, 0x2d, 0x38, 0x31,
-		0x72, 0x5e, 0x45, 0xcf, 0x43, 0xda, 0x9f, 0x92, 0xd9, 0x31, 0xfd, 0x23, 0x22, 0x2d, 0x3e, 0xad,
-		0xf2, 0x2b, 0x92, 0x0f, 0x19, 0x8e, 0xdd, 0xd4, 0x20, 0xc1, 0xce, 0x4c, 0xf2, 0xf3, 0x31, 0x4f,
-		0x4d, 0x37, 0x23, 0x2f, 0xb1, 0x03, 0x95, 0x2a, 0x67, 0x56, 0xde, 0x0b, 0x09, 0x96, 0x82, 0x32,
-		0x90, 0xf4, 0xdf, 0x91, 0x06, 0x48, 0x94, 0xab, 0xd5, 0xda, 0x96, 0x78, 0x14, 0xb6, 0xb2, 0xa9,
-		0x36, 0xe5, 0x08, 0x49, 0x56, 0x6b, 0xd7, 0x6b, 0xd5, 0xa6, 0x1c, 0x45, 0xb3, 0x90, 0x63, 0xbf,
-		0xb5, 0xab, 0x9b, 0xea, 0x7a, 0xb9, 0x29, 0xc7, 0x02, 0x49, 0x8d, 0xda, 0xc6, 0x4a, 0x4d, 0x95,
-		0xe3, 0xca, 0x33, 0x70, 0x7a, 0xec, 0x1c, 0xee, 0x07, 0x66, 0xa4, 0x40, 0x60, 0x46, 0xf9, 0x70,
-		0x84, 0x2c, 0x6a, 0xc6, 0x4d, 0xcc, 0xe8, 0xfa, 0x40, 0xc3, 0x2f, 0x1e, 0x63, 0x56, 0x1f, 0x68,
-		0x3d, 0x59, 0xc7, 0xf4, 0xf0, 0x2e, 0x76, 0x8d, 0x7d, 0xe6, 0x28, 0x30, 0x0b, 0x94, 0x53, 0x73,
-		0x3c, 0x95, 0x32, 0x39, 0x8c, 0xec, 0x75, 0x6c, 0xb8, 0x1a, 0x53, 0x22, 0x87, 0xff, 0xa1, 0xe4,
-		0x1c, 0x4b, 0x6d, 0xb0, 0x44, 0xe5, 0x9b, 0x8f, 0x25, 0xcb, 0x34, 0xc4, 0xd5, 0x5a, 0x53, 0xbd,
-		0x29, 0x47, 0x11, 0x82, 0x3c, 0xfd, 0xa9, 0x35, 0x36, 0xca, 0x5b, 0x8d, 0xd5, 0x4d, 0x22, 0xcb,
-		0x39, 0x28, 0x08, 0x59, 0x8a, 0xc4, 0xb8, 0xf2, 0x04, 0x9c, 0x1a, 0xe3, 0x55, 0x8c, 0x38, 0xc4,
-		0xfa, 0x71, 0x29, 0x48, 0x1d, 0xf6, 0x0c, 0x36, 0x21, 0xe1, 0xb8, 0xba, 0xdb, 0x77, 0xb8, 0x10,
-		0x9f, 0x9f, 0xd6, 0xcd, 0x58, 0x12, 0x3f, 0x1a, 0x94, 0x5d, 0xe5, 0x30, 0xca, 0x25, 0xc8, 0x87,
-		0x73, 0xc6, 0xcb, 0xc0, 0x57, 0xa2, 0x88, 0x72, 0x05, 0xd0, 0xb0, 0xf7, 0x31, 0x62, 0x79, 0x29,
-		0x8d, 0x5a, 0x5e, 0xfe, 0xb8, 0x04, 0x67, 0x8e, 0xf0, 0x34, 0xd0, 0x2b, 0x03, 0x8d, 0x7c, 0xf1,
-		0x38, 0x7e, 0x0a, 0xfb, 0xab, 0xa4, 0x87, 0x03, 0xcd, 0x7c, 0x16, 0xb2, 0xc1, 0xf4, 0xe9, 0x1a,
-		0xf9, 0xc5, 0x88, 0x3f, 0x88, 0xc3, 0xeb, 0x60, 0xdf, 0x04, 0x4a, 0x5f, 0xa5, 0x09, 0x7c, 0x27,
-		0x80, 0x7b, 0x20, 0x4e, 0x51, 0xf3, 0x79, 0xf4, 0xc1, 0x11, 0xf1, 0x45, 0x6c, 0x34, 0x0f, 0xf8,
-		0x20, 0x48, 0xbb, 0xfc, 0x97, 0x83, 0x1a, 0xc1, 0xa0, 0x40, 0x9f, 0xce, 0xb1, 0x8e, 0xf7, 0x67,
-		0x92, 0xa7, 0x9b, 0x8c, 0xfd, 0xe0, 0x01, 0x4b, 0x76, 0xd0, 0x4d, 0x38, 0x35, 0xe0, 0x28, 0x78,
-		0xd0, 0xb1, 0x69, 0xfd, 0x85, 0x13, 0x61, 0x7f, 0x41, 0x40, 0x07, 0x67, 0xfb, 0x78, 0x78, 0xb6,
-		0xbf, 0x09, 0xe0, 0x07, 0x07, 0xfc, 0x63, 0x7f, 0x52, 0xf0, 0xd8, 0xdf, 0x25, 0x88, 0x13, 0x4d,
-		0x12, 0x72, 0x1a, 0x36, 0xc5, 0x44, 0x13, 0x02, 0xc1, 0x05, 0x46, 0xad, 0x98, 0x80, 0x86, 0x03,
-		0xb4, 0x63, 0x8a, 0x78, 0x57, 0xb8, 0x88, 0x87, 0xc6, 0x86, 0x7a, 0x47, 0x17, 0xf5, 0x06, 0xc4,
-		0x69, 0xcf, 0x8f, 0xbc, 0x4b, 0xf9, 0x4d, 0x00, 0xba, 0xeb, 0xf6, 0xcc, 0x9d, 0xbe, 0x5f, 0xc0,
-		0xc2, 0x68, 0xcd, 0x29, 0x0b, 0xba, 0xca, 0x03, 0x5c, 0x85, 0xe6, 0x7d, 0xd6, 0x80, 0x1a, 0x05,
-		0x00, 0x95, 0x0d, 0xc8, 0x87, 0x79, 0x47, 0xdf, 0x0d, 0xf5, 0x1f, 0x57, 0x49, 0x0b, 0xff, 0xc6,
-		0xf3, 0x8e, 0xf8, 0x83, 0x47, 0xf4, 0x43, 0x79, 0x7f, 0x04, 0xb2, 0x41, 0xc5, 0xfb, 0xeb, 0xe7,
-		0x82, 0x28, 0xdf, 0x26, 0x41, 0xca, 0x6b, 0xfe, 0x11, 0x87, 0x9d, 0xfd, 0x3b, 0xc2, 0xde, 0xe6,
-		0x05, 0xdb, 0x26, 0x8a, 0x7a, 0xdb, 0x44, 0x57, 0xbc, 0xe9, 0x6f, 0x5c, 0x40, 0x24, 0x28, 0x6b,
-		0x71, 0xe0, 0x90, 0xcf, 0xf6, 0x57, 0xa6, 0xbb, 0x8a, 0x37, 0x0f, 0xf1, 0xe0, 0x35, 0x3a, 0xf6,
-		0xa1, 0xb4, 0x02, 0x27, 0x8c, 0xd8, 0x40, 0x0c, 0xde, 0xd9, 0x93, 0x8e, 0x7d, 0x67, 0xcf, 0x2b,
-		0x25, 0x12, 0x2c, 0xe5, 0x7b, 0x25, 0x48, 0x89, 0x31, 0x81, 0xde, 0x1d, 0x3c, 0x79, 0x26, 0xb6,
-		0x36, 0xc7, 0xda, 0x23, 0x8e, 0x1f, 0x38, 0x78, 0x36, 0x74, 0xe0, 0x3a, 0x7a, 0xdc, 0x03, 0xd7,
-		0xdc, 0xb3, 0xfb, 0x33, 0x09, 0xe4, 0xc1, 0x11, 0xfb, 0x55, 0xd7, 0x6e, 0x78, 0x9a, 0x8b, 0x8e,
-		0x98, 0xe6, 0xc6, 0x9d, 0xa2, 0x8e, 0x8d, 0x3b, 0x45, 0x3d, 0xdc, 0xea, 0xf8, 0x7d, 0xb6, 0xfa,
-		0x03, 0x11, 0xc8, 0x04, 0xc2, 0xa3, 0xe8, 0xb9, 0xd0, 0x89, 0xec, 0xc5, 0xa3, 0x42, 0xa9, 0x81,
-		0x23, 0xd9, 0x21, 0x31, 0x45, 0x8e, 0x2f, 0xa6, 0xb7, 0xfe, 0x76, 0xd6, 0xe8, 0x0b, 0xac, 0xf1,
-		0x31, 0x17, 0x58, 0xff, 0x86, 0x04, 0x29, 0xcf, 0xed, 0x3e, 0xee, 0x26, 0xe6, 0x49, 0x48, 0x70,
-		0xcf, 0x92, 0xed, 0x62, 0xf2, 0xaf, 0x91, 0x61, 0xe5, 0x12, 0xa4, 0xc4, 0x5f, 0x40, 0xe4, 0xb3,
-		0x9a, 0xf7, 0x7d, 0xfe, 0x45, 0xc8, 0x04, 0x36, 0x80, 0x89, 0x69, 0xdc, 0xa8, 0xbd, 0x47, 0x9e,
-		0x29, 0x25, 0xef, 0xde, 0x5b, 0x8c, 0x6e, 0xe0, 0x3b, 0x64, 0x34, 0xab, 0xb5, 0xea, 0x6a, 0xad,
-		0xfa, 0xb2, 0x2c, 0x95, 0x32, 0x77, 0xef, 0x2d, 0x26, 0x55, 0x4c, 0x23, 0x8a, 0xe7, 0x5f, 0x86,
-		0xc2, 0x40, 0xc7, 0x84, 0xdd, 0x16, 0x04, 0xf9, 0x95, 0xed, 0xad, 0xb5, 0x7a, 0xb5, 0xdc, 0xac,
-		0x69, 0xec, 0xdc, 0x2e, 0x3a, 0x05, 0x73, 0x6b, 0xf5, 0x6b, 0xab, 0x4d, 0xad, 0xba, 0x56, 0xaf,
-		0x6d, 0x34, 0xb5, 0x72, 0xb3, 0x59, 0xae, 0xbe, 0x2c, 0x47, 0x2e, 0xde, 0xcb, 0x40, 0xac, 0x5c,
-		0xa9, 0xd6, 0x51, 0x15, 0x62, 0x34, 0x14, 0x72, 0xe4, 0x09, 0xb0, 0xd2, 0xd1, 0xb1, 0x61, 0x74,
-		0x15, 0xe2, 0x34, 0x4a, 0x82, 0x8e, 0x3e, 0x12, 0x56, 0x9a, 0x10, 0x2c, 0x26, 0x95, 0xa1, 0x23,
-		0xf2, 0xc8, 0x33, 0x62, 0xa5, 0xa3, 0x63, 0xc7, 0x68, 0x0d, 0x92, 0x62, 0x91, 0x3c, 0xe9, 0xe0,
-		0x56, 0x69, 0x62, 0x40, 0x97, 0x34, 0x8d, 0x05, 0x1b, 0x8e, 0x3e, 0x3e, 0x56, 0x9a, 0x10, 0x55,
-		0x46, 0x75, 0xef, 0x3e, 0xd3, 0x84, 0x13, 0x61, 0xa5, 0x49, 0x71, 0x62, 0xa4, 0x42, 0xda, 0x0f,
-		0xe3, 0x4c, 0x3e, 0x14, 0x57, 0x9a, 0x22, 0x60, 0x8e, 0xde, 0x0b, 0xb9, 0xf0, 0x52, 0x77, 0xba,
-		0x53, 0x67, 0xa5, 0x29, 0x23, 0xd2, 0x04, 0x3f, 0xbc, 0xee, 0x9d, 0xee, 0x14, 0x5a, 0x69, 0xca,
-		0x00, 0x35, 0x7a, 0x1d, 0x66, 0x87, 0xd7, 0xa5, 0xd3, 0x1f, 0x4a, 0x2b, 0x1d, 0x23, 0x64, 0x8d,
-		0x3a, 0x80, 0x46, 0xac, 0x67, 0x8f, 0x71, 0x46, 0xad, 0x74, 0x9c, 0x08, 0x36, 0x6a, 0x41, 0x61,
-		0x70, 0x91, 0x38, 0xed, 0x99, 0xb5, 0xd2, 0xd4, 0xd1, 0x6c, 0x56, 0x4a, 0x78, 0x71, 0x39, 0xed,
-		0x19, 0xb6, 0xd2, 0xd4, 0xc1, 0x6d, 0xb4, 0x0d, 0x10, 0x58, 0x1f, 0x4e, 0x71, 0xa6, 0xad, 0x34,
-		0x4d, 0x98, 0x1b, 0x75, 0x61, 0x6e, 0xd4, 0xc2, 0xf1, 0x38, 0x47, 0xdc, 0x4a, 0xc7, 0x8a, 0x7e,
-		0x13, 0x7d, 0x0e, 0x2f, 0x01, 0xa7, 0x3b, 0xf2, 0x56, 0x9a, 0x32, 0x0c, 0x5e, 0x29, 0x8f, 0x3d,
-		0xe7, 0xfc, 0xd8, 0x91, 0xe7, 0x9c, 0xfd, 0x93, 0xcb, 0xde, 0xd9, 0xe6, 0x5f, 0x7f, 0x0e, 0xde,
-		0xc1, 0x5f, 0xd0, 0x71, 0x5c, 0xfd, 0x96, 0x69, 0xed, 0x79, 0x4f, 0x19, 0xf1, 0x6f, 0x7e, 0xc8,
-		0xf9, 0x24, 0x7f, 0xae, 0x47, 0xa4, 0x4e, 0x78, 0xd0, 0x68, 0xec, 0x2b, 0x8f, 0x93, 0xee, 0x43,
-		0x4c, 0x3e, 0xbe, 0x7c, 0xc4, 0x63, 0x49, 0x13, 0x9e, 0x64, 0x1a, 0xf1, 0x98, 0x52, 0xe9, 0xc8,
-		0x37, 0x06, 0x4a, 0x47, 0x9d, 0xfb, 0x56, 0x7e, 0x40, 0x82, 0xfc, 0xaa, 0xe9, 0xb8, 0x76, 0xcf,
-		0x34, 0xf4, 0x36, 0x9d, 0x5e, 0xae, 0x4c, 0x7b, 0x2b, 0xab, 0x92, 0x26, 0xee, 0x0a, 0x7f, 0x6f,
-		0x89, 0xdf, 0x14, 0x5a, 0x81, 0xc4, 0x6d, 0xbd, 0xcd, 0xee, 0x44, 0x05, 0x1f, 0x5b, 0x1b, 0x94,
-		0x79, 0xc0, 0x8f, 0x0a, 0xa2, 0x30, 0xde, 0xe5, 0x48, 0x51, 0x52, 0xbe, 0x43, 0x02, 0xd9, 0xaf,
-		0x99, 0x8a, 0x0d, 0xbb, 0xd7, 0xa2, 0x4b, 0x81, 0x6e, 0x37, 0x70, 0x56, 0x40, 0x7c, 0x52, 0x97,
-		0xcf, 0xec, 0x60, 0xcf, 0x6f, 0x1b, 0xef, 0x64, 0xc5, 0x02, 0x0e, 0xd6, 0x88, 0x2b, 0xe7, 0xd1,
-		0x51, 0x57, 0xce, 0x95, 0xef, 0xa7, 0x37, 0x5c, 0x3a, 0x1d, 0xd3, 0x21, 0xa3, 0x43, 0xa5, 0x8b,
-		0xfb, 0xeb, 0x10, 0xeb, 0xe9, 0x2e, 0x5f, 0xf2, 0x56, 0x2e, 0x1f, 0xfb, 0xc9, 0x28, 0xd6, 0x66,
-		0x8a, 0x81, 0x5e, 0x81, 0x54, 0x47, 0x3f, 0xd0, 0x28, 0x5e, 0xe4, 0xab, 0xc2, 0x4b, 0x76, 0xf4,
-		0x03, 0x52, 0x3f, 0xf4, 0x5e, 0x28, 0x10, 0x48, 0x63, 0x5f, 0xb7, 0xf6, 0x30, 0x43, 0x8e, 0x7e,
-		0x55, 0xc8, 0xb9, 0x8e, 0x7e, 0x50, 0xa5, 0x68, 0x04, 0x9f, 0x3f, 0xad, 0xf5, 0x6b, 0x12, 0x8f,
-		0x63, 0x50, 0xc1, 0x20, 0x1d, 0x64, 0xc3, 0xfb, 0xa2, 0x85, 0x8a, 0x4d, 0x97, 0xc7, 0xc6, 0x69,
-		0xc2, 0x80, 0x58, 0x2b, 0x39, 0x52, 0xbd, 0xcf, 0xbc, 0xb9, 0x20, 0xb1, 0x52, 0x0b, 0xc6, 0x90,
-		0xd8, 0x33, 0x2c, 0x3c, 0xa3, 0x4d, 0xd9, 0xe1, 0x39, 0xe1, 0x55, 0x33, 0x40, 0x60, 0xdc, 0x24,
-		0x9f, 0xb7, 0xe1, 0x93, 0x12, 0x64, 0x56, 0x02, 0x8f, 0x39, 0x16, 0x21, 0xd9, 0xb1, 0x2d, 0xf3,
-		0x16, 0xee, 0x79, 0xbb, 0x66, 0xec, 0x93, 0x78, 0xbe, 0xec, 0x6f, 0x02, 0xba, 0x87, 0xe2, 0xbd,
-		0x23, 0xf1, 0x4d, 0xb8, 0xee, 0xe0, 0x1d, 0xc7, 0x14, 0x72, 0x56, 0xc5, 0x27, 0x7a, 0x1c, 0x64,
-		0x07, 0x1b, 0xfd, 0x9e, 0xe9, 0x1e, 0x6a, 0x86, 0x6d, 0xb9, 0xba, 0xe1, 0xf2, 0xc8, 0x40, 0x41,
-		0xa4, 0x57, 0x59, 0x32, 0x01, 0x69, 0x61, 0x57, 0x37, 0xdb, 0xec, 0xac, 0x68, 0x5a, 0x15, 0x9f,
-		0xbc, 0xaa, 0x77, 0x93, 0xc1, 0x95, 0x71, 0x15, 0x64, 0xbb, 0x8b, 0x7b, 0xa1, 0x23, 0x32, 0x4c,
-		0x1b, 0x8b, 0xbf, 0xfd, 0xe9, 0xa7, 0xe6, 0xb9, 0xc0, 0xf9, 0xf1, 0x0a, 0xf6, 0xb7, 0x0b, 0xd4,
-		0x82, 0xe0, 0x10, 0x67, 0x67, 0x6e, 0x86, 0xf6, 0xc9, 0xfa, 0x3b, 0xfe, 0xc3, 0x36, 0xf3, 0x43,
-		0x42, 0x2d, 0x5b, 0x87, 0x95, 0xe2, 0x6f, 0xf9, 0xd0, 0xfe, 0xca, 0xf9, 0x65, 0x7c, 0x18, 0xdc,
-		0x34, 0xa3, 0x30, 0x64, 0x51, 0xf1, 0xba, 0x6e, 0xb6, 0xc5, 0x9f, 0x4f, 0x55, 0xf9, 0x17, 0x5a,
-		0xf6, 0x02, 0x9e, 0x31, 0xba, 0x42, 0x53, 0xc6, 0xe9, 0x46, 0xc5, 0xb6, 0x5a, 0xe1, 0xc8, 0x26,
-		0xaa, 0x42, 0xc2, 0xb5, 0x6f, 0x61, 0x8b, 0x0b, 0xa8, 0xf2, 0xc4, 0x31, 0xde, 0x86, 0x53, 0x39,
-		0x2b, 0xfa, 0x46, 0x90, 0x5b, 0xb8, 0x8d, 0xf7, 0xd8, 0x0d, 0xce, 0x7d, 0xbd, 0x87, 0xd9, 0xfb,
-		0x01, 0xf7, 0xf5, 0xf2, 0x5b, 0xc1, 0x83, 0x6a, 0x50, 0x24, 0xb4, 0x15, 0x7e, 0x2e, 0x34, 0xc9,
-		0xb7, 0xb3, 0xc7, 0xb4, 0x31, 0xa0, 0x79, 0x41, 0x5b, 0x18, 0x7a, 0x5e, 0xf4, 0x71, 0x90, 0xfb,
-		0xd6, 0x8e, 0x6d, 0xd1, 0xbf, 0x3a, 0xc8, 0xd7, 0x75, 0x29, 0xb6, 0x77, 0xea, 0xa5, 0xf3, 0xbd,
-		0xd3, 0x2d, 0xc8, 0xfb, 0xa4, 0x74, 0x84, 0xa4, 0x8f, 0x3b, 0x42, 0x72, 0x1e, 0x00, 0x21, 0x41,
-		0xeb, 0x00, 0xfe, 0x18, 0xa4, 0x9b, 0x77, 0x99, 0xf1, 0x3d, 0xe6, 0x8f, 0xe6, 0x60, 0x63, 0x02,
-		0x00, 0xe8, 0x1b, 0x60, 0xae, 0x63, 0x5a, 0x9a, 0x83, 0xdb, 0xbb, 0x1a, 0x97, 0x1c, 0xc1, 0xcd,
-		0x1c, 0xbf, 0x37, 0x67, 0x3b, 0xa6, 0xd5, 0xc0, 0xed, 0xdd, 0x15, 0x0f, 0x05, 0xbd, 0x13, 0xce,
-		0xf8, 0xad, 0xb7, 0x2d, 0x6d, 0xdf, 0x6e, 0xb7, 0xb4, 0x1e, 0xde, 0xd5, 0x0c, 0xfa, 0xba, 0x5f,
-		0x96, 0xca, 0xec, 0x94, 0x47, 0xb2, 0x69, 0xad, 0xda, 0xed, 0x96, 0x8a, 0x77, 0xab, 0x24, 0x1b,
-		0x3d, 0x0c, 0x7e, 0xd3, 0x35, 0xb3, 0xe5, 0x14, 0x73, 0x8b, 0xd1, 0x73, 0x31, 0x35, 0xeb, 0x25,
-		0xd6, 0x5b, 0xce, 0x72, 0xea, 0x83, 0x1f, 0x5b, 0x98, 0xf9, 0xc2, 0xc7, 0x16, 0x66, 0x94, 0xab,
-		0xf4, 0x95, 0x2b, 0x3e, 0x8e, 0xb0, 0x83, 0x2e, 0x43, 0x5a, 0x17, 0x1f, 0xec, 0x0e, 0xdb, 0x11,
-		0xe3, 0xd0, 0x27, 0x55, 0x7e, 0x52, 0x82, 0xc4, 0xca, 0x8d, 0x2d, 0xdd, 0xec, 0xa1, 0x1a, 0xcc,
-		0xfa, 0x8a, 0x39, 0xed, 0x90, 0xf6, 0x75, 0x59, 0x8c, 0xe9, 0x8d, 0x71, 0x87, 0xe7, 0xd2, 0x95,
-		0x87, 0x7e, 0xfb, 0xd3, 0x4f, 0x3d, 0xc8, 0x61, 0x6e, 0x0c, 0x9c, 0xa3, 0x13, 0x78, 0x83, 0xe7,
-		0xeb, 0x02, 0x6d, 0xbe, 0x0e, 0x49, 0x56, 0x55, 0x07, 0xbd, 0x04, 0xf1, 0x2e, 0xf9, 0xc1, 0xf7,
-		0x04, 0xce, 0x8e, 0x55, 0x70, 0x4a, 0x1f, 0x54, 0x07, 0xc6, 0xa7, 0x7c, 0x47, 0x04, 0x60, 0xe5,
-		0xc6, 0x8d, 0x66, 0xcf, 0xec, 0xb6, 0xb1, 0xfb, 0x56, 0xb5, 0x7d, 0x1b, 0x4e, 0x04, 0x6e, 0x67,
-		0xf7, 0x8c, 0xe3, 0xb7, 0x7f, 0xce, 0xbf, 0xa8, 0xdd, 0x33, 0x46, 0xc2, 0xb6, 0x1c, 0xd7, 0x83,
-		0x8d, 0x1e, 0x1f, 0x76, 0xc5, 0x71, 0x87, 0x25, 0xfb, 0x2a, 0x64, 0x7c, 0x61, 0x38, 0xa8, 0x0e,
-		0x29, 0x97, 0xff, 0xe6, 0x02, 0x56, 0xc6, 0x0b, 0x58, 0xb0, 0x05, 0x85, 0xec, 0xb1, 0x2b, 0x7f,
-		0x21, 0x01, 0x04, 0xc6, 0xc8, 0xd7, 0xa7, 0x8e, 0xa1, 0x3a, 0x24, 0xb8, 0x25, 0x8e, 0xde, 0xf7,
-		0x1b, 0x9c, 0x0c, 0x20, 0x20, 0xd4, 0xef, 0x8a, 0xc0, 0xdc, 0xb6, 0x18, 0xbd, 0x5f, 0xff, 0x32,
-		0xd8, 0x86, 0x24, 0xb6, 0xdc, 0x9e, 0xe9, 0xed, 0x6a, 0x3d, 0x3d, 0xae, 0xcf, 0x47, 0x34, 0xaa,
-		0x66, 0xb9, 0xbd, 0xc3, 0xa0, 0x06, 0x08, 0xac, 0x80, 0x3c, 0x3e, 0x12, 0x85, 0xe2, 0x38, 0x56,
-		0xe2, 0x0d, 0x1b, 0x3d, 0x4c, 0x13, 0xc2, 0x97, 0x56, 0xf3, 0x22, 0x99, 0xcf, 0x31, 0x2a, 0x10,
-		0xaf, 0x8c, 0x28, 0x17, 0x21, 0xbd, 0x3f, 0x37, 0x2c, 0xef, 0x23, 0xd0, 0x59, 0xa6, 0x09, 0x05,
-		0x71, 0x0b, 0x66, 0x47, 0x6f, 0xeb, 0x96, 0x21, 0xdc, 0xd5, 0x63, 0x4d, 0x09, 0xe2, 0x26, 0x4d,
-		0x85, 0x41, 0xa0, 0x1a, 0x24, 0x05, 0x5a, 0xec, 0xf8, 0x68, 0x82, 0x17, 0x3d, 0x04, 0xd9, 0xe0,
-		0xc4, 0x40, 0x5d, 0x8f, 0x98, 0x9a, 0x09, 0xcc, 0x0b, 0x93, 0x66, 0x9e, 0xc4, 0x91, 0x33, 0x0f,
-		0xf7, 0xee, 0x7e, 0x84, 0x1e, 0x56, 0x6f, 0xfd, 0xd5, 0xef, 0x96, 0x2d, 0x00, 0x36, 0x54, 0x89,
-		0x25, 0xe5, 0x3d, 0x73, 0x1f, 0xe3, 0x3d, 0xcd, 0x40, 0x56, 0x1c, 0xf7, 0x6b, 0xd5, 0x43, 0xbf,
-		0x1b, 0x81, 0x6c, 0xb0, 0x87, 0xfe, 0x5a, 0x4e, 0x5a, 0x68, 0xc3, 0x37, 0x53, 0xec, 0x42, 0xcf,
-		0xe3, 0xe3, 0xcc, 0xd4, 0x90, 0x36, 0x4f, 0xb0, 0x4f, 0x9f, 0x8f, 0x42, 0x82, 0x9f, 0xb8, 0xdb,
-		0x1c, 0x72, 0x64, 0xa5, 0x49, 0x4f, 0x12, 0xe4, 0xc4, 0x93, 0x04, 0x23, 0xfd, 0xd8, 0x47, 0x20,
-		0x4f, 0x16, 0xc4, 0xa1, 0x63, 0x7c, 0xd2, 0xb9, 0x1c, 0x5d, 0xd7, 0xfa, 0x87, 0xd6, 0xd1, 0x02,
-		0x64, 0x08, 0x99, 0x6f, 0x87, 0x09, 0x0d, 0x74, 0xf4, 0x83, 0x1a, 0x4b, 0x41, 0x4f, 0x01, 0xda,
-		0xf7, 0x02, 0x13, 0x9a, 0x2f, 0x08, 0x42, 0x37, 0xeb, 0xe7, 0x08, 0xf2, 0x07, 0x01, 0x48, 0x2d,
-		0x34, 0xf6, 0xec, 0x34, 0x7f, 0xd4, 0x9b, 0xa4, 0xac, 0xd0, 0xa7, 0xa7, 0xbf, 0x55, 0x62, 0xfe,
-		0xf0, 0xc0, 0xb2, 0x99, 0x2f, 0x47, 0x9a, 0x53, 0x0c, 0x8a, 0x3f, 0x7d, 0x73, 0xa1, 0x74, 0xa8,
-		0x77, 0xda, 0xcb, 0xca, 0x08, 0x1c, 0x65, 0xd4, 0x4a, 0x9e, 0x38, 0xce, 0xe1, 0x65, 0x37, 0xaa,
-		0x83, 0x7c, 0x0b, 0x1f, 0x6a, 0x3d, 0xfe, 0x37, 0xb9, 0xb5, 0x5d, 0x8c, 0xf9, 0xc2, 0xe5, 0xf4,
-		0xd2, 0x88, 0x47, 0xc0, 0x97, 0xaa, 0xb6, 0x69, 0xf1, 0x2d, 0xb0, 0xfc, 0x2d, 0x7c, 0xa8, 0x72,
-		0xbe, 0xab, 0x18, 0x2f, 0xbf, 0x83, 0x8c, 0x94, 0xbb, 0x9f, 0xff, 0x99, 0xf3, 0x67, 0x02, 0x0f,
-		0x5a, 0x1f, 0x78, 0xb1, 0x39, 0xd6, 0xbd, 0xc4, 0xe9, 0x45, 0xfe, 0x04, 0xe4, 0x1d, 0xfb, 0x5f,
-		0x07, 0x08, 0x2c, 0x0a, 0xa4, 0xa3, 0x17, 0x1b, 0x3e, 0x7f, 0x68, 0xb1, 0x11, 0x18, 0x9e, 0xef,
-		0xf6, 0xed, 0x7f, 0x64, 0x52, 0x6b, 0x82, 0x9a, 0xc9, 0x99, 0xe8, 0xa8, 0x9f, 0x51, 0xfe, 0xb5,
-		0x04, 0xa7, 0x87, 0x34, 0xd9, 0xab, 0xb2, 0x01, 0xa8, 0x17, 0xc8, 0xa4, 0x1a, 0x21, 0xf6, 0x99,
-		0xef, 0x6f, 0x60, 0xcc, 0xf6, 0x86, 0x26, 0x81, 0xb7, 0x66, 0x22, 0xe3, 0x56, 0xec, 0x37, 0x25,
-		0x98, 0x0f, 0x56, 0xc0, 0x6b, 0x4a, 0x03, 0xb2, 0xc1, 0xa2, 0x79, 0x23, 0xde, 0x31, 0x4d, 0x23,
-		0x82, 0xf5, 0x0f, 0x81, 0xa0, 0x1b, 0xbe, 0xb5, 0x60, 0x41, 0xc1, 0x67, 0xa6, 0x16, 0x8a, 0xa8,
-		0xd8, 0x48, 0xab, 0xc1, 0xfa, 0xe6, 0x0f, 0x25, 0x88, 0x6d, 0xd9, 0x76, 0x1b, 0xbd, 0x0f, 0x66,
-		0x2d, 0xdb, 0xd5, 0xc8, 0xc8, 0xc2, 0x2d, 0x8d, 0xc7, 0x08, 0x98, 0x25, 0xae, 0x1d, 0x29, 0xab,
-		0x2f, 0xbe, 0xb9, 0x30, 0xcc, 0x39, 0xea, 0x51, 0xf9, 0x82, 0x65, 0xbb, 0x15, 0x4a, 0xd4, 0x64,
-		0x61, 0x84, 0x5d, 0xc8, 0x85, 0x8b, 0x63, 0xd6, 0xba, 0x3c, 0xa9, 0xb8, 0xdc, 0xc4, 0xa2, 0xb2,
-		0x3b, 0x81, 0x72, 0xd8, 0x1b, 0xd1, 0x7f, 0x4c, 0x7a, 0xee, 0x26, 0xc8, 0x37, 0x06, 0xcf, 0x2f,
-		0xd5, 0x20, 0x29, 0xce, 0x2b, 0x49, 0x53, 0x1e, 0x85, 0x0a, 0x8a, 0x93, 0xf3, 0x2a, 0x9f, 0x89,
-		0xc0, 0xe9, 0xaa, 0x6d, 0x39, 0x3c, 0x98, 0xc3, 0x07, 0x34, 0x0b, 0xc1, 0x1e, 0xa2, 0xc7, 0xc7,
-		0x84, 0x9a, 0xb2, 0xc3, 0x01, 0xa5, 0x1b, 0x50, 0x20, 0x33, 0xab, 0x61, 0x5b, 0x5f, 0x65, 0x3c,
-		0x29, 0x67, 0xb7, 0x5b, 0xbc, 0x46, 0xb7, 0xf0, 0x21, 0xc1, 0xb5, 0xf0, 0x9d, 0x10, 0x6e, 0xf4,
-		0xfe, 0x70, 0x2d, 0x7c, 0x27, 0x80, 0xeb, 0x6f, 0x95, 0xc7, 0x42, 0x5b, 0xe5, 0x97, 0x21, 0x4a,
-		0xac, 0x60, 0xfc, 0x18, 0x76, 0x83, 0x30, 0x04, 0x66, 0xb3, 0x06, 0x9c, 0xe6, 0x01, 0x02, 0x67,
-		0x73, 0x97, 0x4a, 0x14, 0xd3, 0x06, 0xbd, 0x8c, 0x0f, 0x47, 0x44, 0x0b, 0xb2, 0x53, 0x45, 0x0b,
-		0xce, 0xff, 0xbc, 0x04, 0xe0, 0xc7, 0xc5, 0xd0, 0x93, 0x70, 0xaa, 0xb2, 0xb9, 0xb1, 0xa2, 0x35,
-		0x9a, 0xe5, 0xe6, 0x76, 0x23, 0xfc, 0x27, 0x63, 0xc4, 0x23, 0x58, 0x4e, 0x17, 0x1b, 0xe6, 0xae,
-		0x89, 0x5b, 0xe8, 0x51, 0x98, 0x0f, 0x53, 0x93, 0xaf, 0xda, 0x8a, 0x2c, 0x95, 0xb2, 0x77, 0xef,
-		0x2d, 0xa6, 0xd8, 0xd2, 0x00, 0xb7, 0xd0, 0x39, 0x38, 0x31, 0x4c, 0x57, 0xdf, 0xb8, 0x26, 0x47,
-		0x4a, 0xb9, 0xbb, 0xf7, 0x16, 0xd3, 0xde, 0x1a, 0x02, 0x29, 0x80, 0x82, 0x94, 0x1c, 0x2f, 0x5a,
-		0x82, 0xbb, 0xf7, 0x16, 0x13, 0x6c, 0xb4, 0x94, 0x62, 0x1f, 0xfc, 0xb1, 0xb3, 0x33, 0xe7, 0xbf,
-		0x09, 0xa0, 0x6e, 0xed, 0xf6, 0x74, 0x83, 0x5a, 0x85, 0x12, 0x9c, 0xac, 0x6f, 0x5c, 0x55, 0xcb,
-		0xd5, 0x66, 0x7d, 0x73, 0x63, 0xe0, 0x2f, 0xdd, 0x84, 0xf3, 0x56, 0x36, 0xb7, 0x2b, 0x6b, 0x35,
-		0xad, 0x51, 0xbf, 0xb6, 0xc1, 0x76, 0xfc, 0x43, 0x79, 0xef, 0xd9, 0x68, 0xd6, 0xd7, 0x6b, 0x72,
-		0xa4, 0x72, 0x79, 0xec, 0x8e, 0xd2, 0x03, 0xa1, 0x71, 0xe8, 0xcf, 0x44, 0xa1, 0x6d, 0xa4, 0xff,
-		0x13, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x1a, 0x0c, 0x35, 0x2d, 0xa5, 0x00, 0x00,
+		// 11536 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x5c, 0xc7,
+		0x75, 0x18, 0xbc, 0xf3, 0xdc, 0x99, 0x33, 0xaf, 0xbb, 0xbd, 0x0b, 0x70, 0x30, 0x20, 0x81, 0xe5,
+		0xa5, 0x48, 0x82, 0xa0, 0xb8, 0x20, 0x41, 0x02, 0x24, 0x07, 0x92, 0xf8, 0xcd, 0xce, 0x0e, 0x80,
+		0x05, 0xf7, 0xc5, 0x3b, 0xbb, 0x10, 0x49, 0xdb, 0xba, 0xbe, 0x3b, 0xd3, 0xbb, 0x3b, 0xc4, 0xcc,
+		0xbd, 0xa3, 0xb9, 0x77, 0x00, 0xac, 0x7e, 0x7c, 0xa5, 0x44, 0x76, 0x22, 0xc3, 0x8f, 0xc8, 0xb1,
+		0xcb, 0x96, 0x2d, 0xc1, 0x96, 0xec, 0xd8, 0x92, 0xed, 0x38, 0xb1, 0x2d, 0xf9, 0x15, 0x57, 0x1c,
+		0x3b, 0x95, 0x87, 0xed, 0x4a, 0x52, 0x8a, 0x7f, 0x24, 0x4e, 0xaa, 0xcc, 0xd8, 0x94, 0x2b, 0x56,
+		0x64, 0x39, 0xf1, 0x83, 0x4e, 0x9c, 0x52, 0x25, 0x95, 0x3a, 0xfd, 0xb8, 0x8f, 0x79, 0xec, 0x9d,
+		0x01, 0x49, 0xd9, 0xb1, 0xf3, 0x67, 0x77, 0xba, 0xfb, 0x9c, 0xd3, 0xdd, 0xa7, 0x4f, 0x9f, 0x3e,
+		0x7d, 0xfa, 0x74, 0x5f, 0xf8, 0xdd, 0xcb, 0xb0, 0xb8, 0x6f, 0x59, 0xfb, 0x6d, 0x7a, 0xae, 0xdb,
+		0xb3, 0x1c, 0x6b, 0xb7, 0xbf, 0x77, 0xae, 0x49, 0xed, 0x46, 0xaf, 0xd5, 0x75, 0xac, 0xde, 0x12,
+		0xcb, 0x23, 0x05, 0x0e, 0xb1, 0x24, 0x21, 0xd4, 0x75, 0x98, 0xbb, 0xdc, 0x6a, 0xd3, 0x15, 0x17,
+		0xb0, 0x4e, 0x1d, 0xf2, 0x1c, 0xc4, 0xf7, 0x5a, 0x6d, 0x5a, 0x8c, 0x2c, 0xc6, 0xce, 0x64, 0xce,
+		0xbf, 0x6b, 0x69, 0x00, 0x69, 0x29, 0x88, 0xb1, 0x85, 0xd9, 0x1a, 0xc3, 0x50, 0xff, 0x77, 0x1c,
+		0xe6, 0x47, 0x94, 0x12, 0x02, 0x71, 0xd3, 0xe8, 0x20, 0xc5, 0xc8, 0x99, 0xb4, 0xc6, 0x7e, 0x93,
+		0x22, 0xcc, 0x76, 0x8d, 0xc6, 0x0d, 0x63, 0x9f, 0x16, 0xa3, 0x2c, 0x5b, 0x26, 0xc9, 0x29, 0x80,
+		0x26, 0xed, 0x52, 0xb3, 0x49, 0xcd, 0xc6, 0x61, 0x31, 0xb6, 0x18, 0x3b, 0x93, 0xd6, 0x7c, 0x39,
+		0xe4, 0x71, 0x98, 0xeb, 0xf6, 0x77, 0xdb, 0xad, 0x86, 0xee, 0x03, 0x83, 0xc5, 0xd8, 0x99, 0x84,
+		0xa6, 0xf0, 0x82, 0x15, 0x0f, 0xf8, 0x51, 0x28, 0xdc, 0xa2, 0xc6, 0x0d, 0x3f, 0x68, 0x86, 0x81,
+		0xe6, 0x31, 0xdb, 0x07, 0x58, 0x85, 0x6c, 0x87, 0xda, 0xb6, 0xb1, 0x4f, 0x75, 0xe7, 0xb0, 0x4b,
+		0x8b, 0x71, 0xd6, 0xfb, 0xc5, 0xa1, 0xde, 0x0f, 0xf6, 0x3c, 0x23, 0xb0, 0xb6, 0x0f, 0xbb, 0x94,
+		0x54, 0x20, 0x4d, 0xcd, 0x7e, 0x87, 0x53, 0x48, 0x8c, 0xe1, 0x5f, 0xcd, 0xec, 0x77, 0x06, 0xa9,
+		0xa4, 0x10, 0x4d, 0x90, 0x98, 0xb5, 0x69, 0xef, 0x66, 0xab, 0x41, 0x8b, 0x49, 0x46, 0xe0, 0xd1,
+		0x21, 0x02, 0x75, 0x5e, 0x3e, 0x48, 0x43, 0xe2, 0x91, 0x2a, 0xa4, 0xe9, 0x6d, 0x87, 0x9a, 0x76,
+		0xcb, 0x32, 0x8b, 0xb3, 0x8c, 0xc8, 0xc3, 0x23, 0x46, 0x91, 0xb6, 0x9b, 0x83, 0x24, 0x3c, 0x3c,
+		0x72, 0x11, 0x66, 0xad, 0xae, 0xd3, 0xb2, 0x4c, 0xbb, 0x98, 0x5a, 0x8c, 0x9c, 0xc9, 0x9c, 0xbf,
+		0x7f, 0xa4, 0x20, 0x6c, 0x72, 0x18, 0x4d, 0x02, 0x93, 0x55, 0x50, 0x6c, 0xab, 0xdf, 0x6b, 0x50,
+		0xbd, 0x61, 0x35, 0xa9, 0xde, 0x32, 0xf7, 0xac, 0x62, 0x9a, 0x11, 0x38, 0x3d, 0xdc, 0x11, 0x06,
+		0x58, 0xb5, 0x9a, 0x74, 0xd5, 0xdc, 0xb3, 0xb4, 0xbc, 0x1d, 0x48, 0x93, 0xe3, 0x90, 0xb4, 0x0f,
+		0x4d, 0xc7, 0xb8, 0x5d, 0xcc, 0x32, 0x09, 0x11, 0x29, 0x14, 0x1d, 0xda, 0x6c, 0x61, 0x75, 0xc5,
+		0x1c, 0x17, 0x1d, 0x91, 0x54, 0x7f, 0x31, 0x09, 0x85, 0x49, 0x84, 0xef, 0x12, 0x24, 0xf6, 0xb0,
+		0xff, 0xc5, 0xe8, 0x34, 0xdc, 0xe1, 0x38, 0x41, 0xf6, 0x26, 0xef, 0x91, 0xbd, 0x15, 0xc8, 0x98,
+		0xd4, 0x76, 0x68, 0x93, 0xcb, 0x4a, 0x6c, 0x42, 0x69, 0x03, 0x8e, 0x34, 0x2c, 0x6c, 0xf1, 0x7b,
+		0x12, 0xb6, 0x97, 0xa1, 0xe0, 0x36, 0x49, 0xef, 0x19, 0xe6, 0xbe, 0x94, 0xda, 0x73, 0x61, 0x2d,
+		0x59, 0xaa, 0x49, 0x3c, 0x0d, 0xd1, 0xb4, 0x3c, 0x0d, 0xa4, 0xc9, 0x0a, 0x80, 0x65, 0x52, 0x6b,
+		0x4f, 0x6f, 0xd2, 0x46, 0xbb, 0x98, 0x1a, 0xc3, 0xa5, 0x4d, 0x04, 0x19, 0xe2, 0x92, 0xc5, 0x73,
+		0x1b, 0x6d, 0xf2, 0xbc, 0x27, 0x84, 0xb3, 0x63, 0x64, 0x68, 0x9d, 0x4f, 0xbf, 0x21, 0x39, 0xdc,
+		0x81, 0x7c, 0x8f, 0xe2, 0x8c, 0xa0, 0x4d, 0xd1, 0xb3, 0x34, 0x6b, 0xc4, 0x52, 0x68, 0xcf, 0x34,
+		0x81, 0xc6, 0x3b, 0x96, 0xeb, 0xf9, 0x93, 0xe4, 0x21, 0x70, 0x33, 0x74, 0x26, 0x56, 0xc0, 0xf4,
+		0x53, 0x56, 0x66, 0x6e, 0x18, 0x1d, 0x5a, 0xfa, 0x10, 0xe4, 0x83, 0xec, 0x21, 0x0b, 0x90, 0xb0,
+		0x1d, 0xa3, 0xe7, 0x30, 0x29, 0x4c, 0x68, 0x3c, 0x41, 0x14, 0x88, 0x51, 0xb3, 0xc9, 0xf4, 0x5f,
+		0x42, 0xc3, 0x9f, 0xe4, 0xff, 0xf3, 0x3a, 0x1c, 0x63, 0x1d, 0x7e, 0x64, 0x78, 0x44, 0x03, 0x94,
+		0x07, 0xfb, 0x5d, 0x7a, 0x16, 0x72, 0x81, 0x0e, 0x4c, 0x5a, 0xb5, 0xfa, 0x93, 0x71, 0x38, 0x36,
+		0x92, 0x36, 0x79, 0x19, 0x16, 0xfa, 0x66, 0xcb, 0x74, 0x68, 0xaf, 0xdb, 0xa3, 0x28, 0xb2, 0xbc,
+		0xae, 0xe2, 0xef, 0xcf, 0x8e, 0x11, 0xba, 0x1d, 0x3f, 0x34, 0xa7, 0xa2, 0xcd, 0xf7, 0x87, 0x33,
+		0xc9, 0x2b, 0x90, 0x41, 0xf9, 0x30, 0x7a, 0x06, 0x23, 0xc8, 0x67, 0xe3, 0xf9, 0xc9, 0xba, 0xbc,
+		0xb4, 0xe2, 0x61, 0x2e, 0xc7, 0x3e, 0x1a, 0x89, 0x6a, 0x7e, 0x5a, 0xe4, 0x00, 0xb2, 0x37, 0x69,
+		0xaf, 0xb5, 0xd7, 0x6a, 0x70, 0xda, 0xc8, 0xce, 0xfc, 0xf9, 0xe7, 0x26, 0xa4, 0x7d, 0xdd, 0x87,
+		0x5a, 0x77, 0x0c, 0x87, 0x96, 0x61, 0x67, 0xe3, 0x7a, 0x4d, 0x5b, 0xbd, 0xbc, 0x5a, 0x5b, 0xd1,
+		0x02, 0x94, 0x4b, 0x9f, 0x8b, 0x40, 0xc6, 0xd7, 0x16, 0x54, 0x5b, 0x66, 0xbf, 0xb3, 0x4b, 0x7b,
+		0x82, 0xe3, 0x22, 0x45, 0x4e, 0x42, 0x7a, 0xaf, 0xdf, 0x6e, 0x73, 0xb1, 0xe1, 0x6b, 0x5e, 0x0a,
+		0x33, 0x50, 0x64, 0x50, 0x4b, 0x09, 0x45, 0xc0, 0xb4, 0x14, 0xfe, 0x26, 0x0f, 0x41, 0xa6, 0x65,
+		0xeb, 0x3d, 0xda, 0xa5, 0x86, 0x43, 0x9b, 0xc5, 0xf8, 0x62, 0xe4, 0x4c, 0x6a, 0x39, 0x5a, 0x8c,
+		0x68, 0xd0, 0xb2, 0x35, 0x91, 0x4b, 0x4a, 0x90, 0x92, 0xb2, 0x57, 0x4c, 0x20, 0x84, 0xe6, 0xa6,
+		0x79, 0x99, 0xc0, 0x4e, 0xca, 0x32, 0x9e, 0x56, 0x9f, 0x81, 0xb9, 0xa1, 0x4e, 0x92, 0x02, 0x64,
+		0x56, 0x6a, 0xd5, 0xb5, 0x8a, 0x56, 0xd9, 0x5e, 0xdd, 0xdc, 0x50, 0x66, 0x48, 0x1e, 0x7c, 0xfd,
+		0x56, 0x22, 0x67, 0xd3, 0xa9, 0x2f, 0xcd, 0x2a, 0x1f, 0xfe, 0xf0, 0x87, 0x3f, 0x1c, 0x55, 0x7f,
+		0x35, 0x09, 0x0b, 0xa3, 0xb4, 0xdc, 0x48, 0x85, 0xeb, 0xf1, 0x24, 0x16, 0xe0, 0x49, 0x05, 0x12,
+		0x6d, 0x63, 0x97, 0xb6, 0x59, 0xe7, 0xf2, 0xe7, 0x1f, 0x9f, 0x48, 0x8f, 0x2e, 0xad, 0x21, 0x8a,
+		0xc6, 0x31, 0xc9, 0xfb, 0x04, 0xe7, 0x12, 0x8c, 0xc2, 0xd9, 0xc9, 0x28, 0xa0, 0xf6, 0x13, 0x5c,
+		0x3e, 0x09, 0x69, 0xfc, 0xcf, 0x87, 0x25, 0xc9, 0x87, 0x05, 0x33, 0xd8, 0xb0, 0x94, 0x20, 0xc5,
+		0x14, 0x5b, 0x93, 0xba, 0x43, 0x26, 0xd3, 0xa8, 0x0a, 0x9a, 0x74, 0xcf, 0xe8, 0xb7, 0x1d, 0xfd,
+		0xa6, 0xd1, 0xee, 0x53, 0xa6, 0xa2, 0xd2, 0x5a, 0x56, 0x64, 0x5e, 0xc7, 0x3c, 0x72, 0x1a, 0x32,
+		0x5c, 0x0f, 0xb6, 0xcc, 0x26, 0xbd, 0xcd, 0x56, 0xc2, 0x84, 0xc6, 0x55, 0xe3, 0x2a, 0xe6, 0x60,
+		0xf5, 0xaf, 0xd9, 0x96, 0x29, 0x95, 0x09, 0xab, 0x02, 0x33, 0x58, 0xf5, 0xcf, 0x0e, 0x2e, 0xc2,
+		0x0f, 0x8c, 0xee, 0xde, 0x90, 0xf6, 0x7b, 0x14, 0x0a, 0x0c, 0xe2, 0x69, 0x31, 0x57, 0x8d, 0x76,
+		0x71, 0x8e, 0x09, 0x40, 0x9e, 0x67, 0x6f, 0x8a, 0x5c, 0xf5, 0xe7, 0xa2, 0x10, 0x67, 0x4b, 0x41,
+		0x01, 0x32, 0xdb, 0xaf, 0x6c, 0xd5, 0xf4, 0x95, 0xcd, 0x9d, 0xe5, 0xb5, 0x9a, 0x12, 0xc1, 0xa1,
+		0x67, 0x19, 0x97, 0xd7, 0x36, 0x2b, 0xdb, 0x4a, 0xd4, 0x4d, 0xaf, 0x6e, 0x6c, 0x5f, 0x7c, 0x46,
+		0x89, 0xb9, 0x08, 0x3b, 0x3c, 0x23, 0xee, 0x07, 0x78, 0xfa, 0xbc, 0x92, 0x20, 0x0a, 0x64, 0x39,
+		0x81, 0xd5, 0x97, 0x6b, 0x2b, 0x17, 0x9f, 0x51, 0x92, 0xc1, 0x9c, 0xa7, 0xcf, 0x2b, 0xb3, 0x24,
+		0x07, 0x69, 0x96, 0xb3, 0xbc, 0xb9, 0xb9, 0xa6, 0xa4, 0x5c, 0x9a, 0xf5, 0x6d, 0x6d, 0x75, 0xe3,
+		0x8a, 0x92, 0x76, 0x69, 0x5e, 0xd1, 0x36, 0x77, 0xb6, 0x14, 0x70, 0x29, 0xac, 0xd7, 0xea, 0xf5,
+		0xca, 0x95, 0x9a, 0x92, 0x71, 0x21, 0x96, 0x5f, 0xd9, 0xae, 0xd5, 0x95, 0x6c, 0xa0, 0x59, 0x4f,
+		0x9f, 0x57, 0x72, 0x6e, 0x15, 0xb5, 0x8d, 0x9d, 0x75, 0x25, 0x4f, 0xe6, 0x20, 0xc7, 0xab, 0x90,
+		0x8d, 0x28, 0x0c, 0x64, 0x5d, 0x7c, 0x46, 0x51, 0xbc, 0x86, 0x70, 0x2a, 0x73, 0x81, 0x8c, 0x8b,
+		0xcf, 0x28, 0x44, 0xad, 0x42, 0x82, 0x89, 0x21, 0x21, 0x90, 0x5f, 0xab, 0x2c, 0xd7, 0xd6, 0xf4,
+		0xcd, 0x2d, 0x9c, 0x34, 0x95, 0x35, 0x25, 0xe2, 0xe5, 0x69, 0xb5, 0x97, 0x76, 0x56, 0xb5, 0xda,
+		0x8a, 0x12, 0xf5, 0xe7, 0x6d, 0xd5, 0x2a, 0xdb, 0xb5, 0x15, 0x25, 0xa6, 0x36, 0x60, 0x61, 0xd4,
+		0x12, 0x38, 0x72, 0x0a, 0xf9, 0x64, 0x21, 0x3a, 0x46, 0x16, 0x18, 0xad, 0x41, 0x59, 0x50, 0xbf,
+		0x18, 0x85, 0xf9, 0x11, 0x66, 0xc0, 0xc8, 0x4a, 0x5e, 0x80, 0x04, 0x97, 0x65, 0xae, 0x8a, 0x1f,
+		0x1b, 0x69, 0x4f, 0x30, 0xc9, 0x1e, 0x32, 0x8e, 0x18, 0x9e, 0xdf, 0x6c, 0x8c, 0x8d, 0x31, 0x1b,
+		0x91, 0xc4, 0x90, 0xc0, 0x7e, 0xc3, 0xd0, 0x72, 0xcd, 0x2d, 0x9a, 0x8b, 0x93, 0x58, 0x34, 0x2c,
+		0x6f, 0xba, 0x65, 0x3b, 0x31, 0x62, 0xd9, 0xbe, 0x04, 0x73, 0x43, 0x84, 0x26, 0x5e, 0x3e, 0x3f,
+		0x12, 0x81, 0xe2, 0x38, 0xe6, 0x84, 0xa8, 0xc4, 0x68, 0x40, 0x25, 0x5e, 0x1a, 0xe4, 0xe0, 0x83,
+		0xe3, 0x07, 0x61, 0x68, 0xac, 0x3f, 0x13, 0x81, 0xe3, 0xa3, 0xb7, 0x07, 0x23, 0xdb, 0xf0, 0x3e,
+		0x48, 0x76, 0xa8, 0x73, 0x60, 0x49, 0x43, 0xf8, 0x91, 0x11, 0xe6, 0x15, 0x16, 0x0f, 0x0e, 0xb6,
+		0xc0, 0x22, 0xcf, 0x0f, 0xb6, 0xf5, 0xf4, 0xb8, 0xcd, 0xca, 0x50, 0x4b, 0xbf, 0x25, 0x0a, 0xc7,
+		0x46, 0x12, 0x1f, 0xd9, 0xd0, 0x07, 0x00, 0x5a, 0x66, 0xb7, 0xef, 0x70, 0x63, 0x97, 0x6b, 0xe2,
+		0x34, 0xcb, 0x61, 0xca, 0x0b, 0xb5, 0x6c, 0xdf, 0x71, 0xcb, 0xf9, 0x22, 0x0a, 0x3c, 0x8b, 0x01,
+		0x3c, 0xe7, 0x35, 0x34, 0xce, 0x1a, 0x7a, 0x6a, 0x4c, 0x4f, 0x87, 0x04, 0xf3, 0x49, 0x50, 0x1a,
+		0xed, 0x16, 0x35, 0x1d, 0xdd, 0x76, 0x7a, 0xd4, 0xe8, 0xb4, 0xcc, 0x7d, 0xbe, 0xce, 0x96, 0x13,
+		0x7b, 0x46, 0xdb, 0xa6, 0x5a, 0x81, 0x17, 0xd7, 0x65, 0x29, 0x62, 0x30, 0x01, 0xea, 0xf9, 0x30,
+		0x92, 0x01, 0x0c, 0x5e, 0xec, 0x62, 0xa8, 0xdf, 0x99, 0x86, 0x8c, 0x6f, 0x33, 0x45, 0x1e, 0x84,
+		0xec, 0x6b, 0xc6, 0x4d, 0x43, 0x97, 0x1b, 0x64, 0xce, 0x89, 0x0c, 0xe6, 0x6d, 0xf1, 0x2c, 0xf2,
+		0x24, 0x2c, 0x30, 0x10, 0xab, 0xef, 0xd0, 0x9e, 0xde, 0x68, 0x1b, 0xb6, 0xcd, 0x98, 0x96, 0x62,
+		0xa0, 0x04, 0xcb, 0x36, 0xb1, 0xa8, 0x2a, 0x4b, 0xc8, 0x05, 0x98, 0x67, 0x18, 0x9d, 0x7e, 0xdb,
+		0x69, 0x75, 0xdb, 0x54, 0xc7, 0x2d, 0xbb, 0x5d, 0x04, 0x7f, 0xcb, 0xe6, 0x10, 0x62, 0x5d, 0x00,
+		0x60, 0x8b, 0x6c, 0xb2, 0x02, 0x0f, 0x30, 0xb4, 0x7d, 0x6a, 0xd2, 0x9e, 0xe1, 0x50, 0x9d, 0x7e,
+		0xb0, 0x6f, 0xb4, 0x6d, 0xdd, 0x30, 0x9b, 0xfa, 0x81, 0x61, 0x1f, 0x14, 0x17, 0x5c, 0xb3, 0xe4,
+		0x04, 0x02, 0x5e, 0x11, 0x70, 0x35, 0x06, 0x56, 0x31, 0x9b, 0x57, 0x0d, 0xfb, 0x80, 0x94, 0xe1,
+		0x38, 0xa3, 0x62, 0x3b, 0xbd, 0x96, 0xb9, 0xaf, 0x37, 0x0e, 0x68, 0xe3, 0x86, 0xde, 0x77, 0xf6,
+		0x9e, 0x2b, 0x9e, 0xf4, 0xd7, 0xcf, 0x5a, 0x58, 0x67, 0x30, 0x55, 0x04, 0xd9, 0x71, 0xf6, 0x9e,
+		0x23, 0x75, 0xc8, 0xe2, 0x60, 0x74, 0x5a, 0x1f, 0xa2, 0xfa, 0x9e, 0xd5, 0x63, 0x6b, 0x68, 0x7e,
+		0x84, 0x6a, 0xf2, 0x71, 0x70, 0x69, 0x53, 0x20, 0xac, 0x5b, 0x4d, 0x5a, 0x4e, 0xd4, 0xb7, 0x6a,
+		0xb5, 0x15, 0x2d, 0x23, 0xa9, 0x5c, 0xb6, 0x7a, 0x28, 0x50, 0xfb, 0x96, 0xcb, 0xe0, 0x0c, 0x17,
+		0xa8, 0x7d, 0x4b, 0xb2, 0xf7, 0x02, 0xcc, 0x37, 0x1a, 0xbc, 0xcf, 0xad, 0x86, 0x2e, 0x36, 0xd6,
+		0x76, 0x51, 0x09, 0x30, 0xab, 0xd1, 0xb8, 0xc2, 0x01, 0x84, 0x8c, 0xdb, 0xe4, 0x79, 0x38, 0xe6,
+		0x31, 0xcb, 0x8f, 0x38, 0x37, 0xd4, 0xcb, 0x41, 0xd4, 0x0b, 0x30, 0xdf, 0x3d, 0x1c, 0x46, 0x24,
+		0x81, 0x1a, 0xbb, 0x87, 0x83, 0x68, 0xcf, 0xc2, 0x42, 0xf7, 0xa0, 0x3b, 0x8c, 0x77, 0xd6, 0x8f,
+		0x47, 0xba, 0x07, 0xdd, 0x41, 0xc4, 0x87, 0x99, 0x97, 0xa5, 0x47, 0x1b, 0xcc, 0x3a, 0xbc, 0xcf,
+		0x0f, 0xee, 0x2b, 0x20, 0x4b, 0xa0, 0x34, 0x1a, 0x3a, 0x35, 0x8d, 0xdd, 0x36, 0xd5, 0x8d, 0x1e,
+		0x35, 0x0d, 0xbb, 0x78, 0x9a, 0x01, 0xc7, 0x9d, 0x5e, 0x9f, 0x6a, 0xf9, 0x46, 0xa3, 0xc6, 0x0a,
+		0x2b, 0xac, 0x8c, 0x9c, 0x85, 0x39, 0x6b, 0xf7, 0xb5, 0x06, 0x97, 0x48, 0xbd, 0xdb, 0xa3, 0x7b,
+		0xad, 0xdb, 0xc5, 0x77, 0x31, 0xf6, 0x16, 0xb0, 0x80, 0xc9, 0xe3, 0x16, 0xcb, 0x26, 0x8f, 0x81,
+		0xd2, 0xb0, 0x0f, 0x8c, 0x5e, 0x97, 0xa9, 0x64, 0xbb, 0x6b, 0x34, 0x68, 0xf1, 0x61, 0x0e, 0xca,
+		0xf3, 0x37, 0x64, 0x36, 0xce, 0x08, 0xfb, 0x56, 0x6b, 0xcf, 0x91, 0x14, 0x1f, 0xe5, 0x33, 0x82,
+		0xe5, 0x09, 0x6a, 0x67, 0x40, 0x41, 0x4e, 0x04, 0x2a, 0x3e, 0xc3, 0xc0, 0xf2, 0xdd, 0x83, 0xae,
+		0xbf, 0xde, 0x87, 0x20, 0xd7, 0x3d, 0xf0, 0x57, 0xfa, 0x18, 0x37, 0xdc, 0xba, 0x07, 0xbe, 0x1a,
+		0x9f, 0x81, 0xe3, 0x08, 0xd4, 0xa1, 0x8e, 0xd1, 0x34, 0x1c, 0xc3, 0x07, 0xfd, 0x6e, 0x06, 0x8d,
+		0x6c, 0x5f, 0x17, 0x85, 0x81, 0x76, 0xf6, 0xfa, 0xbb, 0x87, 0xae, 0x60, 0x3d, 0xc1, 0xdb, 0x89,
+		0x79, 0x52, 0xb4, 0xde, 0xb1, 0xdd, 0x94, 0x5a, 0x86, 0xac, 0x5f, 0xee, 0x49, 0x1a, 0xb8, 0xe4,
+		0x2b, 0x11, 0x34, 0x82, 0xaa, 0x9b, 0x2b, 0x68, 0xbe, 0xbc, 0x5a, 0x53, 0xa2, 0x68, 0x46, 0xad,
+		0xad, 0x6e, 0xd7, 0x74, 0x6d, 0x67, 0x63, 0x7b, 0x75, 0xbd, 0xa6, 0xc4, 0x7c, 0x86, 0xfd, 0xb5,
+		0x78, 0xea, 0x11, 0xe5, 0x51, 0xf5, 0x97, 0x62, 0x90, 0x0f, 0xee, 0xad, 0xc9, 0x7b, 0xe0, 0x3e,
+		0xe9, 0x22, 0xb3, 0xa9, 0xa3, 0xdf, 0x6a, 0xf5, 0xd8, 0x84, 0xec, 0x18, 0x7c, 0x71, 0x74, 0xe5,
+		0x67, 0x41, 0x40, 0xd5, 0xa9, 0xf3, 0xfe, 0x56, 0x0f, 0xa7, 0x5b, 0xc7, 0x70, 0xc8, 0x1a, 0x9c,
+		0x36, 0x2d, 0xdd, 0x76, 0x0c, 0xb3, 0x69, 0xf4, 0x9a, 0xba, 0xe7, 0x9c, 0xd4, 0x8d, 0x46, 0x83,
+		0xda, 0xb6, 0xc5, 0x17, 0x42, 0x97, 0xca, 0xfd, 0xa6, 0x55, 0x17, 0xc0, 0xde, 0x0a, 0x51, 0x11,
+		0xa0, 0x03, 0xe2, 0x1b, 0x1b, 0x27, 0xbe, 0x27, 0x21, 0xdd, 0x31, 0xba, 0x3a, 0x35, 0x9d, 0xde,
+		0x21, 0xb3, 0xcf, 0x53, 0x5a, 0xaa, 0x63, 0x74, 0x6b, 0x98, 0x26, 0xd7, 0xe1, 0x11, 0x0f, 0x54,
+		0x6f, 0xd3, 0x7d, 0xa3, 0x71, 0xa8, 0x33, 0x63, 0x9c, 0x39, 0x7a, 0xf4, 0x86, 0x65, 0xee, 0xb5,
+		0x5b, 0x0d, 0xc7, 0x2e, 0x66, 0x5c, 0x1d, 0xa7, 0x7a, 0x18, 0x6b, 0x0c, 0xe1, 0x9a, 0x6d, 0x99,
+		0xcc, 0x06, 0xaf, 0x4a, 0xe8, 0x77, 0x6e, 0x84, 0x83, 0xa3, 0x14, 0x57, 0x12, 0xd7, 0xe2, 0xa9,
+		0x84, 0x92, 0xbc, 0x16, 0x4f, 0x25, 0x95, 0xd9, 0x6b, 0xf1, 0x54, 0x4a, 0x49, 0x5f, 0x8b, 0xa7,
+		0xd2, 0x0a, 0xa8, 0xdf, 0x9c, 0x86, 0xac, 0x7f, 0x67, 0x80, 0x1b, 0xad, 0x06, 0x5b, 0x1b, 0x23,
+		0x4c, 0x7b, 0x3e, 0x74, 0xe4, 0x3e, 0x62, 0xa9, 0x8a, 0x8b, 0x66, 0x39, 0xc9, 0xcd, 0x70, 0x8d,
+		0x63, 0xa2, 0xc1, 0x82, 0x62, 0x4d, 0xb9, 0xd9, 0x93, 0xd2, 0x44, 0x8a, 0x5c, 0x81, 0xe4, 0x6b,
+		0x36, 0xa3, 0x9d, 0x64, 0xb4, 0xdf, 0x75, 0x34, 0xed, 0x6b, 0x75, 0x46, 0x3c, 0x7d, 0xad, 0xae,
+		0x6f, 0x6c, 0x6a, 0xeb, 0x95, 0x35, 0x4d, 0xa0, 0x93, 0x13, 0x10, 0x6f, 0x1b, 0x1f, 0x3a, 0x0c,
+		0x2e, 0xaf, 0x2c, 0x8b, 0x2c, 0x41, 0xa1, 0x6f, 0xf2, 0x5d, 0x37, 0x0e, 0x15, 0x42, 0x15, 0xfc,
+		0x50, 0x79, 0xaf, 0x74, 0x0d, 0xe1, 0x27, 0x14, 0x8f, 0x13, 0x10, 0x47, 0x37, 0x70, 0x70, 0x11,
+		0x64, 0x59, 0xe4, 0x0c, 0x64, 0x9b, 0x74, 0xb7, 0xbf, 0xaf, 0xf7, 0x68, 0xd3, 0x68, 0x38, 0x41,
+		0xd5, 0x9f, 0x61, 0x45, 0x1a, 0x2b, 0x21, 0x2f, 0x42, 0x1a, 0xc7, 0xc8, 0x64, 0x63, 0x3c, 0xc7,
+		0x58, 0xf0, 0xc4, 0xd1, 0x2c, 0xe0, 0xff, 0x35, 0x89, 0xa4, 0x79, 0xf8, 0xe4, 0x1a, 0x24, 0x1d,
+		0xa3, 0xb7, 0x4f, 0x1d, 0xa6, 0xf9, 0xf3, 0xe7, 0x97, 0x26, 0xa1, 0xb4, 0xcd, 0x30, 0x90, 0xad,
+		0x4c, 0x46, 0x05, 0x05, 0x72, 0x15, 0x66, 0xf9, 0x2f, 0xbb, 0x38, 0xbf, 0x18, 0x9b, 0x9e, 0x98,
+		0x26, 0xd1, 0xdf, 0x41, 0x9d, 0x75, 0x0e, 0x12, 0x4c, 0xd8, 0x08, 0x80, 0x10, 0x37, 0x65, 0x86,
+		0xa4, 0x20, 0x5e, 0xdd, 0xd4, 0x50, 0x6f, 0x29, 0x90, 0xe5, 0xb9, 0xfa, 0xd6, 0x6a, 0xad, 0x5a,
+		0x53, 0xa2, 0xea, 0x05, 0x48, 0x72, 0x09, 0x42, 0x9d, 0xe6, 0xca, 0x90, 0x32, 0x23, 0x92, 0x82,
+		0x46, 0x44, 0x96, 0xee, 0xac, 0x2f, 0xd7, 0x34, 0x25, 0xaa, 0xee, 0x40, 0x61, 0x80, 0xeb, 0xe4,
+		0x18, 0xcc, 0x69, 0xb5, 0xed, 0xda, 0x06, 0xee, 0xda, 0xf4, 0x9d, 0x8d, 0x17, 0x37, 0x36, 0xdf,
+		0x8f, 0x2e, 0x8f, 0x40, 0xb6, 0x54, 0x90, 0x11, 0xb2, 0x00, 0x8a, 0x97, 0x5d, 0xdf, 0xdc, 0xd1,
+		0x58, 0x6b, 0xbe, 0x2d, 0x0a, 0xca, 0x20, 0xdb, 0xc8, 0x7d, 0x30, 0xbf, 0x5d, 0xd1, 0xae, 0xd4,
+		0xb6, 0x75, 0xbe, 0x13, 0x75, 0x49, 0x2f, 0x80, 0xe2, 0x2f, 0xb8, 0xbc, 0xca, 0x36, 0xda, 0xa7,
+		0xe1, 0xa4, 0x3f, 0xb7, 0xf6, 0xf2, 0x76, 0x6d, 0xa3, 0xce, 0x2a, 0xaf, 0x6c, 0x5c, 0x41, 0x6d,
+		0x3d, 0x40, 0x4f, 0xee, 0x7d, 0x63, 0xd8, 0xd4, 0x20, 0xbd, 0xda, 0xda, 0x8a, 0x12, 0x1f, 0xcc,
+		0xde, 0xdc, 0xa8, 0x6d, 0x5e, 0x56, 0x12, 0x83, 0xb5, 0xb3, 0xfd, 0x70, 0x92, 0x94, 0xe0, 0xf8,
+		0x60, 0xae, 0x5e, 0xdb, 0xd8, 0xd6, 0x5e, 0x51, 0x66, 0x07, 0x2b, 0xae, 0xd7, 0xb4, 0xeb, 0xab,
+		0xd5, 0x9a, 0x92, 0x22, 0xc7, 0x81, 0x04, 0x5b, 0xb4, 0x7d, 0x75, 0x73, 0x45, 0x49, 0x0f, 0xe9,
+		0x27, 0xd5, 0x86, 0xac, 0x7f, 0x53, 0xfa, 0x35, 0x51, 0x8d, 0xea, 0xc7, 0xa3, 0x90, 0xf1, 0x6d,
+		0x32, 0x71, 0x77, 0x60, 0xb4, 0xdb, 0xd6, 0x2d, 0xdd, 0x68, 0xb7, 0x0c, 0x5b, 0x68, 0x2f, 0x60,
+		0x59, 0x15, 0xcc, 0x99, 0x54, 0x5b, 0x4c, 0xbe, 0x5e, 0x24, 0xff, 0x32, 0xae, 0x17, 0x09, 0x25,
+		0xa9, 0xfe, 0x40, 0x04, 0x94, 0xc1, 0xdd, 0xe3, 0x40, 0xf7, 0x23, 0xe3, 0xba, 0xff, 0x35, 0x19,
+		0xbb, 0x4f, 0x46, 0x20, 0x1f, 0xdc, 0x32, 0x0e, 0x34, 0xef, 0xc1, 0xbf, 0xd0, 0xe6, 0xfd, 0x4e,
+		0x14, 0x72, 0x81, 0x8d, 0xe2, 0xa4, 0xad, 0xfb, 0x20, 0xcc, 0xb5, 0x9a, 0xb4, 0xd3, 0xb5, 0x1c,
+		0x3c, 0x6d, 0xd4, 0xdb, 0xf4, 0x26, 0x6d, 0x17, 0x55, 0xa6, 0xe2, 0xcf, 0x1d, 0xbd, 0x15, 0x5d,
+		0x5a, 0xf5, 0xf0, 0xd6, 0x10, 0xad, 0x3c, 0xbf, 0xba, 0x52, 0x5b, 0xdf, 0xda, 0xdc, 0xae, 0x6d,
+		0x54, 0x5f, 0x91, 0xda, 0x45, 0x53, 0x5a, 0x03, 0x60, 0xef, 0xa0, 0xd2, 0xde, 0x02, 0x65, 0xb0,
+		0x51, 0xa8, 0x2b, 0x46, 0x34, 0x4b, 0x99, 0x21, 0xf3, 0x50, 0xd8, 0xd8, 0xd4, 0xeb, 0xab, 0x2b,
+		0x35, 0xbd, 0x76, 0xf9, 0x72, 0xad, 0xba, 0x5d, 0xe7, 0xce, 0x45, 0x17, 0x7a, 0x5b, 0x89, 0xfa,
+		0x59, 0xfc, 0xfd, 0x31, 0x98, 0x1f, 0xd1, 0x12, 0x52, 0x11, 0x6e, 0x01, 0xee, 0xa9, 0x78, 0x62,
+		0x92, 0xd6, 0x2f, 0xa1, 0x61, 0xbe, 0x65, 0xf4, 0x1c, 0xe1, 0x45, 0x78, 0x0c, 0x90, 0x4b, 0xa6,
+		0x83, 0x76, 0x42, 0x4f, 0x38, 0x6d, 0xb9, 0xaf, 0xa0, 0xe0, 0xe5, 0x73, 0xbf, 0xed, 0xbb, 0x81,
+		0x74, 0x2d, 0xbb, 0xe5, 0xb4, 0x6e, 0xe2, 0x19, 0xa6, 0xf4, 0xf0, 0xa2, 0xef, 0x20, 0xae, 0x29,
+		0xb2, 0x64, 0xd5, 0x74, 0x5c, 0x68, 0x93, 0xee, 0x1b, 0x03, 0xd0, 0x68, 0xc7, 0xc4, 0x34, 0x45,
+		0x96, 0xb8, 0xd0, 0x0f, 0x42, 0xb6, 0x69, 0xf5, 0x71, 0x43, 0xc5, 0xe1, 0x50, 0x5b, 0x44, 0xb4,
+		0x0c, 0xcf, 0x73, 0x41, 0xc4, 0x56, 0xd9, 0x73, 0x2d, 0x67, 0xb5, 0x0c, 0xcf, 0xe3, 0x20, 0x8f,
+		0x42, 0xc1, 0xd8, 0xdf, 0xef, 0x21, 0x71, 0x49, 0x88, 0x6f, 0xfe, 0xf3, 0x6e, 0x36, 0x03, 0x2c,
+		0x5d, 0x83, 0x94, 0xe4, 0x03, 0xda, 0xc3, 0xc8, 0x09, 0xbd, 0xcb, 0x3d, 0x5a, 0x51, 0xf4, 0x36,
+		0x9b, 0xb2, 0xf0, 0x41, 0xc8, 0xb6, 0x6c, 0xdd, 0x3b, 0xdb, 0x8c, 0x2e, 0x46, 0xcf, 0xa4, 0xb4,
+		0x4c, 0xcb, 0x76, 0xcf, 0x48, 0xd4, 0xcf, 0x44, 0x21, 0x1f, 0x3c, 0xb5, 0x25, 0x2b, 0x90, 0x6a,
+		0x5b, 0xe2, 0x90, 0x85, 0x87, 0x0c, 0x9c, 0x09, 0x39, 0xe8, 0x5d, 0x5a, 0x13, 0xf0, 0x9a, 0x8b,
+		0x59, 0xfa, 0x37, 0x11, 0x48, 0xc9, 0x6c, 0x72, 0x1c, 0xe2, 0x5d, 0xc3, 0x39, 0x60, 0xe4, 0x12,
+		0xcb, 0x51, 0x25, 0xa2, 0xb1, 0x34, 0xe6, 0xdb, 0x5d, 0x83, 0x9f, 0x13, 0x89, 0x7c, 0x4c, 0xe3,
+		0xb8, 0xb6, 0xa9, 0xd1, 0x64, 0x9e, 0x05, 0xab, 0xd3, 0xa1, 0xa6, 0x63, 0xcb, 0x71, 0x15, 0xf9,
+		0x55, 0x91, 0x8d, 0xc1, 0x03, 0x4e, 0xcf, 0x68, 0xb5, 0x03, 0xb0, 0x71, 0x06, 0xab, 0xc8, 0x02,
+		0x17, 0xb8, 0x0c, 0x27, 0x24, 0xdd, 0x26, 0x75, 0x8c, 0xc6, 0x01, 0x6d, 0x7a, 0x48, 0x49, 0xe6,
+		0x41, 0xbc, 0x4f, 0x00, 0xac, 0x88, 0x72, 0x89, 0xab, 0x7e, 0x21, 0x0a, 0x73, 0xd2, 0x17, 0xd2,
+		0x74, 0x99, 0xb5, 0x0e, 0x60, 0x98, 0xa6, 0xe5, 0xf8, 0xd9, 0x35, 0x2c, 0xca, 0x43, 0x78, 0x4b,
+		0x15, 0x17, 0x49, 0xf3, 0x11, 0x28, 0xfd, 0x41, 0x04, 0xc0, 0x2b, 0x1a, 0xcb, 0xb7, 0xd3, 0x90,
+		0x11, 0x67, 0xf2, 0x2c, 0xb0, 0x83, 0xbb, 0xcf, 0x80, 0x67, 0xa1, 0xd7, 0x04, 0x9d, 0x9c, 0xbb,
+		0x74, 0xbf, 0x65, 0x8a, 0xd3, 0x19, 0x9e, 0x90, 0x4e, 0xce, 0xb8, 0x77, 0x3c, 0xa9, 0x41, 0xca,
+		0xa6, 0x1d, 0xc3, 0x74, 0x5a, 0x0d, 0x71, 0xde, 0x72, 0x71, 0xaa, 0xc6, 0x2f, 0xd5, 0x05, 0xb6,
+		0xe6, 0xd2, 0x51, 0xcf, 0x40, 0x4a, 0xe6, 0xa2, 0xe1, 0xb7, 0xb1, 0xb9, 0x51, 0x53, 0x66, 0xc8,
+		0x2c, 0xc4, 0xea, 0xb5, 0x6d, 0x25, 0x82, 0x9b, 0xd8, 0xca, 0xda, 0x6a, 0xa5, 0xae, 0x44, 0x97,
+		0xff, 0x7f, 0x98, 0x6f, 0x58, 0x9d, 0xc1, 0x0a, 0x97, 0x95, 0x01, 0x07, 0xa2, 0x7d, 0x35, 0xf2,
+		0xea, 0x13, 0x02, 0x68, 0xdf, 0x6a, 0x1b, 0xe6, 0xfe, 0x92, 0xd5, 0xdb, 0xf7, 0xc2, 0x62, 0x70,
+		0xaf, 0x61, 0xfb, 0x82, 0x63, 0xba, 0xbb, 0x7f, 0x1e, 0x89, 0xfc, 0x50, 0x34, 0x76, 0x65, 0x6b,
+		0xf9, 0xc7, 0xa3, 0xa5, 0x2b, 0x1c, 0x71, 0x4b, 0x76, 0x47, 0xa3, 0x7b, 0x6d, 0xda, 0xc0, 0xc6,
+		0xc3, 0x1f, 0x3e, 0x0e, 0x0b, 0xfb, 0xd6, 0xbe, 0xc5, 0x28, 0x9d, 0xc3, 0x5f, 0xbc, 0x11, 0x24,
+		0xed, 0xe6, 0x96, 0x42, 0x83, 0x70, 0xca, 0x1b, 0x30, 0x2f, 0x80, 0x75, 0x76, 0x7c, 0xcf, 0x5d,
+		0x15, 0xe4, 0x48, 0x3f, 0x79, 0xf1, 0xa7, 0x7f, 0x8f, 0x59, 0x25, 0xda, 0x9c, 0x40, 0xc5, 0x32,
+		0xee, 0xcd, 0x28, 0x6b, 0x70, 0x2c, 0x40, 0x8f, 0xeb, 0x08, 0xda, 0x0b, 0xa1, 0xf8, 0xcf, 0x05,
+		0xc5, 0x79, 0x1f, 0xc5, 0xba, 0x40, 0x2d, 0x57, 0x21, 0x37, 0x0d, 0xad, 0x7f, 0x21, 0x68, 0x65,
+		0xa9, 0x9f, 0xc8, 0x15, 0x28, 0x30, 0x22, 0x8d, 0xbe, 0xed, 0x58, 0x1d, 0xa6, 0x80, 0x8f, 0x26,
+		0xf3, 0x2f, 0x7f, 0x8f, 0x4f, 0xda, 0x3c, 0xa2, 0x55, 0x5d, 0xac, 0x72, 0x19, 0x58, 0xc4, 0x02,
+		0x9e, 0xee, 0x86, 0x50, 0xf8, 0x35, 0xd1, 0x10, 0x17, 0xbe, 0x7c, 0x1d, 0x16, 0xf0, 0x37, 0xd3,
+		0x8f, 0xfe, 0x96, 0x84, 0x3b, 0xd5, 0x8b, 0xff, 0xf6, 0x23, 0x5c, 0x2f, 0xcc, 0xbb, 0x04, 0x7c,
+		0x6d, 0xf2, 0x8d, 0xe2, 0x3e, 0x75, 0x1c, 0xda, 0xb3, 0x75, 0xa3, 0x3d, 0xaa, 0x79, 0x3e, 0xaf,
+		0x64, 0xf1, 0xfb, 0xbe, 0x12, 0x1c, 0xc5, 0x2b, 0x1c, 0xb3, 0xd2, 0x6e, 0x97, 0x77, 0xe0, 0xbe,
+		0x11, 0x52, 0x31, 0x01, 0xcd, 0xef, 0x17, 0x34, 0x17, 0x86, 0x24, 0x03, 0xc9, 0x6e, 0x81, 0xcc,
+		0x77, 0xc7, 0x72, 0x02, 0x9a, 0x9f, 0x10, 0x34, 0x89, 0xc0, 0x95, 0x43, 0x8a, 0x14, 0xaf, 0xc1,
+		0xdc, 0x4d, 0xda, 0xdb, 0xb5, 0x6c, 0xe1, 0x09, 0x9e, 0x80, 0xdc, 0x27, 0x05, 0xb9, 0x82, 0x40,
+		0x64, 0xae, 0x61, 0xa4, 0xf5, 0x3c, 0xa4, 0xf6, 0x8c, 0x06, 0x9d, 0x80, 0xc4, 0x5d, 0x41, 0x62,
+		0x16, 0xe1, 0x11, 0xb5, 0x02, 0xd9, 0x7d, 0x4b, 0x2c, 0x91, 0xe1, 0xe8, 0x3f, 0x20, 0xd0, 0x33,
+		0x12, 0x47, 0x90, 0xe8, 0x5a, 0xdd, 0x7e, 0x1b, 0xd7, 0xcf, 0x70, 0x12, 0x3f, 0x28, 0x49, 0x48,
+		0x1c, 0x41, 0x62, 0x0a, 0xb6, 0x7e, 0x4a, 0x92, 0xb0, 0x7d, 0xfc, 0x7c, 0x01, 0x0f, 0x88, 0xdb,
+		0x87, 0x96, 0x39, 0x49, 0x23, 0x3e, 0x2d, 0x28, 0x80, 0x40, 0x41, 0x02, 0x97, 0x20, 0x3d, 0xe9,
+		0x40, 0xfc, 0xc8, 0x57, 0xe4, 0xf4, 0x90, 0x23, 0x70, 0x05, 0x0a, 0x52, 0x41, 0x61, 0x08, 0x50,
+		0x38, 0x89, 0x1f, 0x15, 0x24, 0xf2, 0x3e, 0x34, 0xd1, 0x0d, 0x87, 0xda, 0xce, 0x3e, 0x9d, 0x84,
+		0xc8, 0x67, 0x64, 0x37, 0x04, 0x8a, 0x60, 0xe5, 0x2e, 0x35, 0x1b, 0x07, 0x93, 0x51, 0xf8, 0xac,
+		0x64, 0xa5, 0xc4, 0x41, 0x12, 0x55, 0xc8, 0x75, 0x8c, 0x9e, 0x7d, 0x60, 0xb4, 0x27, 0x1a, 0x8e,
+		0x1f, 0x13, 0x34, 0xb2, 0x2e, 0x92, 0xe0, 0x48, 0xdf, 0x9c, 0x86, 0xcc, 0x8f, 0x4b, 0x8e, 0xf4,
+		0xcd, 0x00, 0xa1, 0x2d, 0x58, 0xb0, 0x1d, 0xe6, 0x36, 0x9f, 0x86, 0xda, 0x4f, 0xc8, 0xa9, 0xc7,
+		0x71, 0xd7, 0xfd, 0x14, 0x2f, 0x41, 0xda, 0x6e, 0x7d, 0x68, 0x22, 0x32, 0x7f, 0x5f, 0x8e, 0x34,
+		0x43, 0x40, 0xe4, 0x57, 0xe0, 0xc4, 0xc8, 0x65, 0x62, 0x02, 0x62, 0x3f, 0x29, 0x88, 0x1d, 0x1f,
+		0xb1, 0x54, 0x08, 0x95, 0x30, 0x2d, 0xc9, 0x7f, 0x20, 0x55, 0x02, 0x1d, 0xa0, 0xb5, 0x85, 0x9b,
+		0x16, 0xdb, 0xd8, 0x9b, 0x8e, 0x6b, 0xff, 0x50, 0x72, 0x8d, 0xe3, 0x06, 0xb8, 0xb6, 0x0d, 0xc7,
+		0x05, 0xc5, 0xe9, 0xc6, 0xf5, 0xa7, 0xa4, 0x62, 0xe5, 0xd8, 0x3b, 0xc1, 0xd1, 0xfd, 0x3a, 0x28,
+		0xb9, 0xec, 0x94, 0xd6, 0xb1, 0xad, 0xa3, 0xaf, 0x39, 0x9c, 0xf2, 0x4f, 0x0b, 0xca, 0x52, 0xe3,
+		0xbb, 0xe6, 0xb5, 0xbd, 0x6e, 0x74, 0x91, 0xf8, 0xcb, 0x50, 0x94, 0xc4, 0xfb, 0x66, 0x8f, 0x36,
+		0xac, 0x7d, 0xb3, 0xf5, 0x21, 0xda, 0x9c, 0x80, 0xf4, 0xcf, 0x0c, 0x0c, 0xd5, 0x8e, 0x0f, 0x1d,
+		0x29, 0xaf, 0x82, 0xe2, 0xda, 0x2a, 0x7a, 0xab, 0xd3, 0xb5, 0x7a, 0x4e, 0x08, 0xc5, 0xcf, 0xc9,
+		0x91, 0x72, 0xf1, 0x56, 0x19, 0x5a, 0xb9, 0x06, 0x3c, 0x96, 0x64, 0x52, 0x91, 0xfc, 0xbc, 0x20,
+		0x94, 0xf3, 0xb0, 0x84, 0xe2, 0x68, 0x58, 0x9d, 0xae, 0xd1, 0x9b, 0x44, 0xff, 0xfd, 0xac, 0x54,
+		0x1c, 0x02, 0x45, 0x28, 0x0e, 0xb4, 0xe8, 0x70, 0xb5, 0x9f, 0x80, 0xc2, 0xcf, 0x49, 0xc5, 0x21,
+		0x71, 0x04, 0x09, 0x69, 0x30, 0x4c, 0x40, 0xe2, 0xe7, 0x25, 0x09, 0x89, 0x83, 0x24, 0x5e, 0xf2,
+		0x16, 0xda, 0x1e, 0xdd, 0x6f, 0xd9, 0x8e, 0x08, 0x06, 0x3b, 0x9a, 0xd4, 0x2f, 0x7c, 0x25, 0x68,
+		0x84, 0x69, 0x3e, 0x54, 0xd4, 0x44, 0xe2, 0x20, 0x85, 0x6d, 0xd9, 0xc2, 0x1b, 0xf6, 0x8b, 0x52,
+		0x13, 0xf9, 0xd0, 0xb0, 0x6d, 0x3e, 0x0b, 0x11, 0xd9, 0xde, 0xc0, 0x8d, 0xca, 0x04, 0xe4, 0xfe,
+		0xd1, 0x40, 0xe3, 0xea, 0x12, 0x17, 0x69, 0xfa, 0xec, 0x9f, 0xbe, 0x79, 0x83, 0x1e, 0x4e, 0x24,
+		0x9d, 0xbf, 0x34, 0x60, 0xff, 0xec, 0x70, 0x4c, 0xae, 0x43, 0x0a, 0x03, 0xf6, 0x14, 0x09, 0x8b,
+		0xf5, 0x2c, 0xfe, 0x8d, 0x37, 0x45, 0x7f, 0x83, 0xe6, 0x54, 0x79, 0x0d, 0x14, 0x91, 0xe3, 0x19,
+		0xb0, 0xa1, 0xc4, 0x3e, 0xf2, 0xa6, 0x2b, 0xe7, 0x01, 0x9b, 0xa7, 0x7c, 0x19, 0x72, 0x01, 0x83,
+		0x27, 0x9c, 0xd4, 0x37, 0x09, 0x52, 0x59, 0xbf, 0xbd, 0x53, 0xbe, 0x00, 0x71, 0x34, 0x5e, 0xc2,
+		0xd1, 0xbf, 0x59, 0xa0, 0x33, 0xf0, 0xf2, 0x7b, 0x21, 0x25, 0x8d, 0x96, 0x70, 0xd4, 0xbf, 0x25,
+		0x50, 0x5d, 0x14, 0x44, 0x97, 0x06, 0x4b, 0x38, 0xfa, 0xdf, 0x96, 0xe8, 0x12, 0x05, 0xd1, 0x27,
+		0x67, 0xe1, 0xaf, 0x7c, 0x6b, 0x9c, 0xa3, 0x4b, 0x94, 0x32, 0xc6, 0xb2, 0x70, 0x4b, 0x25, 0x1c,
+		0xfb, 0x5b, 0x44, 0xe5, 0x12, 0xa3, 0xfc, 0x2c, 0x24, 0x26, 0x64, 0xf8, 0xb7, 0x0b, 0x54, 0x0e,
+		0x5f, 0xae, 0x42, 0xc6, 0x67, 0x9d, 0x84, 0xa3, 0x7f, 0x87, 0x40, 0xf7, 0x63, 0x61, 0xd3, 0x85,
+		0x75, 0x12, 0x4e, 0xe0, 0xef, 0xc8, 0xa6, 0x0b, 0x0c, 0x64, 0x9b, 0x34, 0x4c, 0xc2, 0xb1, 0x3f,
+		0x26, 0xb9, 0x2e, 0x51, 0xca, 0x2f, 0x40, 0xda, 0x5d, 0x6c, 0xc2, 0xf1, 0xbf, 0x53, 0xe0, 0x7b,
+		0x38, 0xc8, 0x81, 0xbe, 0x39, 0x05, 0x89, 0xbf, 0x2b, 0x39, 0xe0, 0xc3, 0xc2, 0x69, 0x34, 0x68,
+		0xc0, 0x84, 0x53, 0xfa, 0x2e, 0x39, 0x8d, 0x06, 0xec, 0x17, 0x1c, 0x4d, 0xa6, 0xf3, 0xc3, 0x49,
+		0x7c, 0xb7, 0x1c, 0x4d, 0x06, 0x8f, 0xcd, 0x18, 0xb4, 0x08, 0xc2, 0x69, 0x7c, 0xaf, 0x6c, 0xc6,
+		0x80, 0x41, 0x50, 0xde, 0x02, 0x32, 0x6c, 0x0d, 0x84, 0xd3, 0xfb, 0xb8, 0xa0, 0x37, 0x37, 0x64,
+		0x0c, 0x94, 0xdf, 0x0f, 0xc7, 0x47, 0x5b, 0x02, 0xe1, 0x54, 0xbf, 0xef, 0xcd, 0x81, 0xbd, 0x9b,
+		0xdf, 0x10, 0x28, 0x6f, 0xc3, 0xc2, 0x28, 0x2b, 0x20, 0x9c, 0xec, 0xf7, 0xbf, 0x19, 0x54, 0xdc,
+		0x7e, 0x23, 0xa0, 0x5c, 0x01, 0xf0, 0x16, 0xe0, 0x70, 0x5a, 0x9f, 0x14, 0xb4, 0x7c, 0x48, 0x38,
+		0x35, 0xc4, 0xfa, 0x1b, 0x8e, 0x7f, 0x57, 0x4e, 0x0d, 0x81, 0x81, 0x53, 0x43, 0x2e, 0xbd, 0xe1,
+		0xd8, 0x3f, 0x20, 0xa7, 0x86, 0x44, 0x41, 0xc9, 0xf6, 0xad, 0x6e, 0xe1, 0x14, 0x3e, 0x2d, 0x25,
+		0xdb, 0x87, 0x55, 0xde, 0x80, 0xb9, 0xa1, 0x05, 0x31, 0x9c, 0xd4, 0x0f, 0x09, 0x52, 0xca, 0xe0,
+		0x7a, 0xe8, 0x5f, 0xbc, 0xc4, 0x62, 0x18, 0x4e, 0xed, 0x87, 0x07, 0x16, 0x2f, 0xb1, 0x16, 0x96,
+		0x2f, 0x41, 0xca, 0xec, 0xb7, 0xdb, 0x38, 0x79, 0xc8, 0xd1, 0xd1, 0xbe, 0xc5, 0xff, 0xf2, 0x55,
+		0xc1, 0x1d, 0x89, 0x50, 0xbe, 0x00, 0x09, 0xda, 0xd9, 0xa5, 0xcd, 0x30, 0xcc, 0x2f, 0x7f, 0x55,
+		0x2a, 0x4c, 0x84, 0x2e, 0xbf, 0x00, 0xc0, 0x5d, 0x23, 0xec, 0x18, 0x3e, 0x04, 0xf7, 0x0f, 0xbe,
+		0x2a, 0xc2, 0xeb, 0x3c, 0x14, 0x8f, 0x00, 0x0f, 0xd6, 0x3b, 0x9a, 0xc0, 0x57, 0x82, 0x04, 0xd8,
+		0x88, 0x3c, 0x0f, 0xb3, 0x78, 0x90, 0xe6, 0x18, 0xfb, 0x61, 0xd8, 0x7f, 0x28, 0xb0, 0x25, 0x3c,
+		0x32, 0xac, 0x63, 0xf5, 0xa8, 0x63, 0xec, 0xdb, 0x61, 0xb8, 0xff, 0x55, 0xe0, 0xba, 0x08, 0x88,
+		0xdc, 0x30, 0x6c, 0x67, 0x92, 0x7e, 0xff, 0x37, 0x89, 0x2c, 0x11, 0xb0, 0xd1, 0xf8, 0xfb, 0x06,
+		0x3d, 0x0c, 0xc3, 0xfd, 0x23, 0xd9, 0x68, 0x01, 0x5f, 0x7e, 0x2f, 0xa4, 0xf1, 0x27, 0x8f, 0x99,
+		0x0d, 0x41, 0xfe, 0x63, 0x81, 0xec, 0x61, 0x60, 0xcd, 0xb6, 0xd3, 0x74, 0x5a, 0xe1, 0xcc, 0xfe,
+		0x13, 0x31, 0xd2, 0x12, 0xbe, 0x5c, 0x81, 0x8c, 0xed, 0x34, 0x9b, 0x7d, 0x61, 0x9f, 0x86, 0xa0,
+		0xff, 0xe9, 0x57, 0x5d, 0x97, 0x85, 0x8b, 0x83, 0xa3, 0x7d, 0xeb, 0x86, 0xd3, 0xb5, 0xd8, 0x79,
+		0x4b, 0x18, 0x85, 0x37, 0x05, 0x05, 0x1f, 0x4a, 0xb9, 0x0a, 0x59, 0xec, 0x8b, 0xbc, 0x8b, 0x10,
+		0x46, 0xe2, 0xcf, 0x04, 0x03, 0x02, 0x48, 0xcb, 0xdf, 0xf8, 0x6b, 0x6f, 0x9c, 0x8a, 0x7c, 0xe1,
+		0x8d, 0x53, 0x91, 0xdf, 0x79, 0xe3, 0x54, 0xe4, 0x63, 0x5f, 0x3c, 0x35, 0xf3, 0x85, 0x2f, 0x9e,
+		0x9a, 0xf9, 0xad, 0x2f, 0x9e, 0x9a, 0x19, 0xed, 0x25, 0x86, 0x2b, 0xd6, 0x15, 0x8b, 0xfb, 0x87,
+		0x5f, 0x7d, 0x78, 0xbf, 0xe5, 0x1c, 0xf4, 0x77, 0x97, 0x1a, 0x56, 0xe7, 0x5c, 0xc3, 0xb2, 0x3b,
+		0x96, 0x7d, 0x2e, 0xe8, 0xd7, 0x65, 0xbf, 0xe0, 0x7f, 0x45, 0xe0, 0x04, 0x27, 0xe3, 0xb9, 0x73,
+		0x0d, 0xf3, 0x70, 0xdc, 0x65, 0xca, 0x8b, 0x10, 0xab, 0x98, 0x87, 0xe4, 0x04, 0x57, 0x70, 0x7a,
+		0xbf, 0xd7, 0x16, 0x81, 0x9b, 0xb3, 0x98, 0xde, 0xe9, 0xb5, 0xd1, 0xcd, 0x2e, 0xa3, 0xab, 0xf1,
+		0x38, 0x87, 0x27, 0x96, 0xbf, 0x23, 0x32, 0x5d, 0x4f, 0x52, 0x15, 0xf3, 0x90, 0x75, 0x64, 0x2b,
+		0xf2, 0xea, 0xbb, 0x43, 0xfd, 0xdc, 0x37, 0x4c, 0xeb, 0x96, 0x89, 0xcd, 0xee, 0xee, 0x4a, 0x1f,
+		0xf7, 0xa9, 0x41, 0x1f, 0xf7, 0xfb, 0x69, 0xbb, 0xfd, 0x22, 0xc2, 0x61, 0xf8, 0x82, 0xbd, 0x9b,
+		0xe4, 0x77, 0x04, 0xe0, 0xbb, 0xa2, 0x70, 0x6a, 0xc8, 0x9d, 0x2d, 0x84, 0x60, 0x1c, 0x13, 0xca,
+		0x90, 0x5a, 0x91, 0xb2, 0x55, 0xc4, 0xab, 0x8c, 0x0d, 0xcb, 0x6c, 0xda, 0x8c, 0x11, 0x31, 0x4d,
+		0x26, 0x91, 0x11, 0xa6, 0x61, 0x5a, 0xb6, 0x08, 0x7d, 0xe6, 0x89, 0xe5, 0x4f, 0x4c, 0xc9, 0x88,
+		0x9c, 0xac, 0x49, 0x72, 0xe3, 0xa9, 0x09, 0xb9, 0x21, 0x3b, 0x11, 0xf0, 0xfc, 0x4f, 0xca, 0x95,
+		0xef, 0x8d, 0xc2, 0xe9, 0x41, 0xae, 0xe0, 0xcc, 0xb2, 0x1d, 0xa3, 0xd3, 0x1d, 0xc7, 0x96, 0x4b,
+		0x90, 0xde, 0x96, 0x30, 0x53, 0xf3, 0xe5, 0xee, 0x94, 0x7c, 0xc9, 0xbb, 0x55, 0x49, 0xc6, 0x9c,
+		0x9f, 0x90, 0x31, 0x6e, 0x3f, 0xee, 0x89, 0x33, 0x3f, 0x39, 0x0b, 0x27, 0xf8, 0x74, 0xd2, 0xf9,
+		0x54, 0xe2, 0x09, 0xc1, 0x93, 0xac, 0xbf, 0x28, 0xfc, 0x9c, 0x44, 0x7d, 0x11, 0xe6, 0x57, 0x51,
+		0x5b, 0xe0, 0x2e, 0xc8, 0x3b, 0xe1, 0x19, 0x19, 0x1d, 0xbe, 0x18, 0x30, 0xf8, 0xc5, 0xf9, 0x96,
+		0x3f, 0x4b, 0xfd, 0xd9, 0x08, 0x28, 0xf5, 0x86, 0xd1, 0x36, 0x7a, 0x6f, 0x95, 0x14, 0x79, 0x16,
+		0x80, 0x87, 0x7b, 0xb8, 0x17, 0x37, 0xf3, 0xe7, 0x8b, 0x4b, 0xfe, 0xce, 0x2d, 0xf1, 0x9a, 0x90,
+		0x39, 0x5a, 0x9a, 0xc1, 0xe2, 0x4f, 0x72, 0x1e, 0x8e, 0x89, 0x98, 0x11, 0x8c, 0xfa, 0x46, 0x37,
+		0x5c, 0xc3, 0xc2, 0xa3, 0x43, 0x71, 0xfc, 0x38, 0xcf, 0x0b, 0x2b, 0x58, 0x56, 0x13, 0x45, 0x67,
+		0x5f, 0x06, 0xf0, 0x88, 0x91, 0x93, 0x70, 0x5f, 0xbd, 0x5a, 0x59, 0xab, 0x68, 0x32, 0xb0, 0xa8,
+		0xbe, 0x55, 0xab, 0xf2, 0xab, 0x59, 0x33, 0x18, 0x93, 0xe3, 0x2f, 0x74, 0x03, 0xa1, 0x8e, 0xc1,
+		0x9c, 0x3f, 0x9f, 0xdf, 0x93, 0x89, 0xa2, 0x75, 0xd9, 0xea, 0x74, 0xdb, 0x94, 0x9d, 0x56, 0xea,
+		0x2d, 0xc9, 0xe9, 0x70, 0xc3, 0xe5, 0xd7, 0xff, 0x1d, 0xbf, 0x3b, 0x31, 0xef, 0xa1, 0xbb, 0xe3,
+		0x54, 0x5e, 0x83, 0x39, 0x8c, 0xe6, 0xec, 0x06, 0x48, 0x86, 0xa8, 0x77, 0x24, 0xc8, 0xce, 0x5f,
+		0x05, 0xa6, 0x47, 0xed, 0x59, 0x48, 0xda, 0xac, 0xf7, 0x61, 0x24, 0x7e, 0x43, 0x90, 0x10, 0xe0,
+		0x65, 0x13, 0xe6, 0xf8, 0x5d, 0x40, 0xea, 0x6b, 0xc6, 0xd1, 0xbe, 0x89, 0x7f, 0xfc, 0xb9, 0x27,
+		0xd9, 0x69, 0xec, 0x83, 0xc1, 0xa1, 0x1c, 0x21, 0x82, 0x9a, 0x22, 0x68, 0x7b, 0x0d, 0xa5, 0x90,
+		0x97, 0xf5, 0x89, 0x06, 0x1f, 0x5d, 0xd9, 0x2f, 0x8b, 0xca, 0x4e, 0x8d, 0x92, 0x1b, 0x5f, 0x4d,
+		0x39, 0x41, 0x95, 0x17, 0x2c, 0xd7, 0xc6, 0xe9, 0x81, 0x57, 0x1f, 0x1f, 0x5e, 0xd1, 0xf8, 0xbf,
+		0x27, 0x18, 0xe5, 0x4b, 0xfe, 0x6a, 0xdc, 0xf9, 0xfa, 0x89, 0x38, 0xcc, 0x31, 0x51, 0x3c, 0xc7,
+		0xfe, 0x8a, 0x79, 0x9a, 0x60, 0x89, 0x09, 0x0e, 0x32, 0x2f, 0xf2, 0xe9, 0x13, 0x2e, 0x31, 0x7f,
+		0xfc, 0x6d, 0x3f, 0x9a, 0xf0, 0xa6, 0x58, 0x79, 0x1d, 0x14, 0x19, 0x46, 0x2c, 0xa7, 0x40, 0x38,
+		0x8d, 0x3f, 0x91, 0x34, 0xa4, 0x4f, 0x4c, 0x4e, 0x91, 0xf2, 0x7b, 0xf0, 0x74, 0x50, 0x90, 0x09,
+		0xb3, 0x66, 0x24, 0x11, 0x17, 0x03, 0x6d, 0x19, 0x3e, 0x9b, 0x27, 0xb1, 0x5c, 0xdf, 0x94, 0xf8,
+		0x7c, 0x56, 0x63, 0x54, 0x45, 0xf9, 0x0a, 0xe4, 0x9b, 0x96, 0xe9, 0xe8, 0x56, 0xa7, 0xe5, 0xd0,
+		0x4e, 0xd7, 0x09, 0xb5, 0x05, 0xff, 0x8c, 0x13, 0x49, 0x69, 0x39, 0xc4, 0xdb, 0x94, 0x68, 0xd8,
+		0x12, 0x7e, 0x53, 0x70, 0x92, 0x96, 0xfc, 0x77, 0xb7, 0x25, 0x0c, 0x07, 0x5b, 0x72, 0x4f, 0xd2,
+		0x61, 0x37, 0x6f, 0x88, 0x05, 0xc0, 0xb9, 0xcd, 0xa5, 0xc0, 0x95, 0x8e, 0xcf, 0xc4, 0xe0, 0x94,
+		0x00, 0xde, 0x35, 0x6c, 0x7a, 0xee, 0xe6, 0x53, 0xbb, 0xd4, 0x31, 0x9e, 0x3a, 0xd7, 0xb0, 0x5a,
+		0x72, 0xf5, 0x9f, 0xe7, 0xe5, 0x4b, 0x58, 0xbe, 0x24, 0xca, 0x4b, 0x23, 0x8f, 0xc8, 0x4b, 0xe3,
+		0x17, 0x86, 0xd2, 0xb0, 0x0c, 0xaa, 0x6d, 0x88, 0x57, 0xad, 0x96, 0x89, 0xeb, 0x61, 0x93, 0x9a,
+		0x56, 0x47, 0xa8, 0x68, 0x9e, 0x20, 0x57, 0x21, 0x69, 0x74, 0xac, 0xbe, 0xe9, 0x70, 0xf5, 0xbc,
+		0xfc, 0xe4, 0xaf, 0xbd, 0x7e, 0x7a, 0xe6, 0x3f, 0xbe, 0x7e, 0xfa, 0x18, 0x27, 0x6b, 0x37, 0x6f,
+		0x2c, 0xb5, 0xac, 0x73, 0x1d, 0xc3, 0x39, 0xc0, 0xe9, 0xfb, 0x9b, 0x9f, 0x7f, 0x02, 0x44, 0x7d,
+		0xab, 0xa6, 0xf3, 0xd9, 0xdf, 0xff, 0xa9, 0xb3, 0x11, 0x4d, 0xe0, 0x97, 0xe3, 0x5f, 0xfa, 0xd4,
+		0xe9, 0x88, 0xda, 0x85, 0xd9, 0x15, 0xda, 0x38, 0xa2, 0xc2, 0xd5, 0x81, 0x0a, 0x9f, 0x12, 0x15,
+		0x9e, 0x1c, 0xae, 0x90, 0x07, 0xf9, 0xad, 0xd0, 0x86, 0xaf, 0xda, 0x15, 0xda, 0x08, 0xd6, 0xb8,
+		0xbc, 0xf2, 0x5b, 0xbf, 0x7b, 0x6a, 0xe6, 0xc3, 0x6f, 0x9c, 0x9a, 0x19, 0x3b, 0x64, 0x6a, 0xf8,
+		0x90, 0xb9, 0x23, 0xf5, 0xa3, 0x71, 0x78, 0x80, 0xdd, 0x49, 0xed, 0x75, 0x5a, 0xa6, 0x73, 0xae,
+		0xd1, 0x3b, 0xec, 0x3a, 0x16, 0x4e, 0x5c, 0x6b, 0x4f, 0x0c, 0xd4, 0x9c, 0x57, 0xbc, 0xc4, 0x8b,
+		0x47, 0x0f, 0x93, 0xba, 0x07, 0x89, 0x2d, 0xc4, 0x43, 0x46, 0x38, 0x96, 0x63, 0xb4, 0x85, 0x85,
+		0xc2, 0x13, 0x98, 0xcb, 0xef, 0xb1, 0x46, 0x79, 0x6e, 0x4b, 0x5e, 0x61, 0x6d, 0x53, 0x63, 0x8f,
+		0x5f, 0x07, 0x8a, 0x31, 0xd3, 0x36, 0x85, 0x19, 0xec, 0xe6, 0xcf, 0x02, 0x24, 0x8c, 0x3e, 0x0f,
+		0xb2, 0x89, 0xa1, 0xcd, 0xcb, 0x12, 0xea, 0x8b, 0x30, 0x2b, 0xce, 0xda, 0x31, 0xca, 0xe4, 0x06,
+		0x3d, 0x64, 0xf5, 0x64, 0x35, 0xfc, 0x49, 0x96, 0x20, 0xc1, 0x1a, 0x2f, 0xee, 0x39, 0x16, 0x97,
+		0x86, 0x5a, 0xbf, 0xc4, 0x1a, 0xa9, 0x71, 0x30, 0xf5, 0x1a, 0xa4, 0x56, 0x2c, 0x94, 0x9e, 0x20,
+		0xb5, 0x34, 0xa7, 0xc6, 0xda, 0xdc, 0xed, 0x8b, 0xb1, 0xd3, 0x78, 0x02, 0x83, 0xd9, 0xf9, 0xf5,
+		0x30, 0x11, 0x28, 0x24, 0x52, 0x6a, 0x15, 0x66, 0x19, 0xed, 0xcd, 0xae, 0x7b, 0x25, 0x3b, 0xe2,
+		0xbb, 0x92, 0x2d, 0xc8, 0x47, 0xbd, 0xc6, 0x12, 0x88, 0xe3, 0x15, 0x10, 0xd1, 0x6f, 0xf6, 0x5b,
+		0x7d, 0x1f, 0xa4, 0x04, 0x11, 0x9b, 0x9c, 0x87, 0x98, 0xd5, 0xb5, 0x45, 0xa8, 0x4f, 0x69, 0x5c,
+		0x57, 0x36, 0xbb, 0xcb, 0x71, 0x14, 0x2a, 0x0d, 0x81, 0x97, 0x37, 0xc6, 0x8a, 0xc5, 0x33, 0x01,
+		0xb1, 0xe8, 0x50, 0x67, 0x77, 0xcf, 0xf1, 0x7e, 0xf0, 0xe1, 0x1c, 0x12, 0x05, 0x57, 0x50, 0xee,
+		0x46, 0xe1, 0x94, 0xaf, 0xf4, 0x26, 0xed, 0xa1, 0xb3, 0x89, 0x4b, 0x93, 0x90, 0x14, 0xe2, 0x6b,
+		0xa0, 0x28, 0x1f, 0x23, 0x2a, 0xef, 0x85, 0x58, 0xa5, 0xdb, 0xc5, 0x1b, 0xd2, 0x2c, 0xdd, 0xb0,
+		0xb8, 0xac, 0xc4, 0x35, 0x37, 0x8d, 0x65, 0xb6, 0xb5, 0xe7, 0xdc, 0x32, 0x7a, 0xee, 0xed, 0x69,
+		0x99, 0x56, 0x9f, 0x87, 0x74, 0xd5, 0x32, 0x6d, 0x6a, 0xda, 0x7d, 0x66, 0xf7, 0xee, 0xb6, 0xad,
+		0xc6, 0x0d, 0x41, 0x81, 0x27, 0x90, 0xd9, 0x46, 0xb7, 0xcb, 0x30, 0xe3, 0x1a, 0xfe, 0x14, 0xb3,
+		0x67, 0x73, 0x2c, 0x7b, 0x2e, 0x4c, 0xc7, 0x1e, 0xd1, 0x41, 0x97, 0x3f, 0xff, 0x33, 0x02, 0xf7,
+		0x0f, 0x4f, 0xa4, 0x1b, 0xf4, 0xd0, 0x9e, 0x76, 0x1e, 0xbd, 0x0c, 0xe9, 0x2d, 0xf6, 0x14, 0xcd,
+		0x8b, 0xf4, 0x90, 0x94, 0xf0, 0xbd, 0x92, 0xf3, 0x17, 0x2e, 0x3c, 0xf5, 0x3c, 0x97, 0xf2, 0xab,
+		0x33, 0x9a, 0xcc, 0x20, 0xa7, 0x20, 0x6d, 0xd3, 0x46, 0xf7, 0xfc, 0x85, 0x8b, 0x37, 0x9e, 0xe2,
+		0x62, 0x75, 0x75, 0x46, 0xf3, 0xb2, 0xca, 0x29, 0xec, 0xf1, 0x97, 0x3e, 0x7d, 0x3a, 0xb2, 0x9c,
+		0x80, 0x98, 0xdd, 0xef, 0xbc, 0x63, 0xb2, 0xf1, 0x47, 0x49, 0x58, 0xf4, 0x95, 0xf2, 0x45, 0xe1,
+		0xa6, 0xd1, 0x6e, 0x35, 0x0d, 0xef, 0x01, 0x21, 0xc5, 0xd7, 0x7f, 0x06, 0x31, 0x46, 0xdb, 0x1f,
+		0xc9, 0x45, 0xf5, 0x67, 0x22, 0x90, 0xbd, 0x2e, 0x29, 0xe3, 0x8b, 0x43, 0x97, 0x00, 0xdc, 0x9a,
+		0xe4, 0x54, 0x39, 0xb9, 0x34, 0x58, 0xd7, 0x92, 0x8b, 0xa3, 0xf9, 0xc0, 0xc9, 0xb3, 0x4c, 0x00,
+		0xbb, 0x96, 0x2d, 0x6e, 0xd2, 0x86, 0xa0, 0xba, 0xc0, 0x18, 0xb4, 0xc9, 0xb4, 0x9a, 0x7e, 0xd3,
+		0x72, 0x30, 0x8c, 0xa4, 0x6b, 0xdd, 0x12, 0xef, 0x13, 0xc4, 0x34, 0x85, 0x95, 0x5c, 0x67, 0x05,
+		0x5b, 0x98, 0x8f, 0x8d, 0x4e, 0xbb, 0x54, 0x70, 0x0b, 0x67, 0x34, 0x9b, 0x3d, 0x6a, 0xdb, 0x42,
+		0x71, 0xc9, 0x24, 0x5e, 0xdf, 0xed, 0xf6, 0x77, 0x75, 0xa9, 0x25, 0xf0, 0x02, 0xf4, 0x88, 0x39,
+		0x2f, 0x65, 0x43, 0xcc, 0xfa, 0x64, 0xb7, 0xbf, 0x8b, 0x92, 0xf2, 0x20, 0x64, 0x47, 0x34, 0x26,
+		0x73, 0xd3, 0x6b, 0x07, 0x7b, 0xfd, 0x48, 0xf4, 0x40, 0xef, 0xf6, 0x5a, 0x56, 0xaf, 0xe5, 0x1c,
+		0xb2, 0x1d, 0x44, 0x4c, 0x53, 0x64, 0xc1, 0x96, 0xc8, 0x57, 0x6f, 0x40, 0xa1, 0xce, 0xcc, 0x74,
+		0xaf, 0xe5, 0x17, 0xbc, 0xf6, 0x45, 0xc2, 0xdb, 0x37, 0xb6, 0x65, 0xd1, 0xa1, 0x96, 0x9d, 0xfd,
+		0xf7, 0x11, 0xc8, 0x2c, 0xe3, 0xc4, 0x5d, 0x5d, 0xb9, 0xdc, 0x36, 0xf6, 0xc9, 0x53, 0x70, 0x6c,
+		0x79, 0x6d, 0xb3, 0xfa, 0xa2, 0xbe, 0xba, 0xa2, 0x5f, 0x5e, 0xab, 0x5c, 0xf1, 0x62, 0x82, 0x4b,
+		0xc7, 0xef, 0xdc, 0x5d, 0x24, 0x3e, 0xd8, 0x1d, 0x93, 0xed, 0x3f, 0xc9, 0x39, 0x58, 0x08, 0xa2,
+		0x54, 0x96, 0xeb, 0x18, 0x20, 0x1c, 0x29, 0x1d, 0xbb, 0x73, 0x77, 0x71, 0xce, 0x87, 0x51, 0xd9,
+		0xb5, 0xa9, 0xe9, 0x0c, 0x23, 0x54, 0x37, 0xd7, 0xd7, 0x57, 0xb7, 0x95, 0xe8, 0x10, 0x02, 0x06,
+		0x66, 0xb6, 0x1c, 0xf2, 0x18, 0xcc, 0x05, 0x11, 0x36, 0x56, 0xd7, 0x94, 0x58, 0x89, 0xdc, 0xb9,
+		0xbb, 0x98, 0xf7, 0x41, 0x6f, 0xb4, 0xda, 0xa5, 0xd4, 0x47, 0x7f, 0xf8, 0xd4, 0xcc, 0x67, 0xff,
+		0xde, 0xa9, 0xc8, 0xf2, 0xfa, 0xd8, 0x39, 0xf7, 0xf4, 0x74, 0x73, 0x2e, 0xb8, 0x6e, 0x7f, 0xf5,
+		0x7e, 0xb8, 0x7f, 0xb0, 0x30, 0xa0, 0x8c, 0x27, 0x9d, 0x6e, 0x61, 0xfe, 0x88, 0xd2, 0xd1, 0xe6,
+		0x41, 0x29, 0x64, 0x51, 0x28, 0x85, 0x2a, 0x06, 0xf5, 0x79, 0xc8, 0x61, 0x08, 0x71, 0x9d, 0x3a,
+		0x57, 0xa9, 0xd1, 0xa4, 0xbd, 0xa0, 0xfd, 0x90, 0x93, 0xf6, 0x03, 0x81, 0x38, 0x33, 0x12, 0xf8,
+		0xfa, 0xc9, 0x7e, 0xab, 0x07, 0x10, 0x47, 0x54, 0xcf, 0xb6, 0x10, 0x18, 0x2c, 0x81, 0xb9, 0xbb,
+		0x87, 0x0e, 0xb5, 0x05, 0x0a, 0x4f, 0x90, 0x67, 0xa4, 0x85, 0x10, 0x3b, 0xda, 0x42, 0x10, 0xd3,
+		0x4b, 0xd8, 0x09, 0x6d, 0x98, 0x15, 0x43, 0xec, 0x36, 0x24, 0xe2, 0x35, 0x84, 0xac, 0x43, 0xa1,
+		0x6b, 0xf4, 0x1c, 0x76, 0xb7, 0xf1, 0x80, 0xf5, 0x42, 0xcc, 0xe0, 0xd3, 0xc3, 0xfa, 0x24, 0xd0,
+		0x59, 0x51, 0x4b, 0xae, 0xeb, 0xcf, 0x54, 0xff, 0x73, 0x1c, 0x92, 0x82, 0x19, 0xef, 0x85, 0x59,
+		0xc1, 0x56, 0x31, 0xe7, 0x1e, 0x58, 0x1a, 0x5e, 0x66, 0x97, 0xdc, 0xe5, 0x50, 0xd0, 0x93, 0x38,
+		0xe4, 0x11, 0x48, 0x35, 0x0e, 0x8c, 0x96, 0xa9, 0xb7, 0x9a, 0xc2, 0x00, 0xcd, 0xbc, 0xf1, 0xfa,
+		0xe9, 0xd9, 0x2a, 0xe6, 0xad, 0xae, 0x68, 0xb3, 0xac, 0x70, 0xb5, 0x89, 0x36, 0xcd, 0x01, 0x6d,
+		0xed, 0x1f, 0x38, 0x42, 0x6f, 0x88, 0x14, 0x3e, 0xe8, 0x86, 0x02, 0x21, 0x6e, 0xbe, 0x97, 0x86,
+		0x76, 0x16, 0xae, 0xbb, 0x68, 0x39, 0x85, 0x15, 0x7f, 0xec, 0x3f, 0x9d, 0x8e, 0x68, 0x0c, 0x83,
+		0x54, 0x21, 0xd7, 0x36, 0x6c, 0x47, 0x67, 0xeb, 0x31, 0x56, 0x9f, 0x60, 0x24, 0x4e, 0x0c, 0x33,
+		0x44, 0x30, 0x56, 0x34, 0x3d, 0x83, 0x58, 0x3c, 0xab, 0x89, 0x17, 0x73, 0x19, 0x91, 0x06, 0x9b,
+		0x81, 0xdc, 0x4a, 0x4c, 0x32, 0xbe, 0xe7, 0x31, 0x9f, 0x4f, 0x4c, 0x66, 0x2b, 0x9e, 0x84, 0x34,
+		0xbb, 0x6b, 0xcb, 0x40, 0x78, 0xc8, 0x7b, 0x0a, 0x33, 0x58, 0xe1, 0xa3, 0x50, 0xf0, 0xb4, 0x3e,
+		0x07, 0x49, 0x71, 0x2a, 0x5e, 0x36, 0x03, 0x7c, 0x12, 0x16, 0x4c, 0x7a, 0xdb, 0xd1, 0xbd, 0x6c,
+		0x0e, 0x9d, 0x66, 0xd0, 0x04, 0xcb, 0xae, 0x07, 0x31, 0x1e, 0x86, 0x7c, 0x43, 0x32, 0x9f, 0xc3,
+		0x02, 0x83, 0xcd, 0xb9, 0xb9, 0x0c, 0xec, 0x04, 0xa4, 0x8c, 0x6e, 0x97, 0x03, 0x64, 0x84, 0xd6,
+		0xef, 0x76, 0x59, 0xd1, 0x59, 0x98, 0x63, 0x7d, 0xec, 0x51, 0xbb, 0xdf, 0x76, 0x04, 0x91, 0x2c,
+		0x83, 0x29, 0x60, 0x81, 0xc6, 0xf3, 0x19, 0xec, 0x43, 0x90, 0xa3, 0x37, 0xf1, 0xbe, 0x41, 0x83,
+		0x72, 0xb8, 0x1c, 0x83, 0xcb, 0xca, 0x4c, 0x06, 0xf4, 0x18, 0xb8, 0xda, 0x5c, 0x97, 0x2b, 0x4d,
+		0x9e, 0xd3, 0x93, 0xf9, 0x15, 0x9e, 0xad, 0x16, 0x21, 0xbe, 0x62, 0x38, 0x06, 0x9a, 0x4b, 0xce,
+		0x6d, 0xbe, 0x7c, 0x66, 0x35, 0xfc, 0xa9, 0xfe, 0x7c, 0x0c, 0xe2, 0xd7, 0x2d, 0x87, 0x92, 0xa7,
+		0x7d, 0xa6, 0x6c, 0x7e, 0x94, 0x3c, 0xd7, 0x5b, 0xfb, 0x26, 0x6d, 0xae, 0xdb, 0xfb, 0xbe, 0x87,
+		0x71, 0x3c, 0x71, 0x8a, 0x06, 0xc4, 0x69, 0x01, 0x12, 0x3d, 0xab, 0x6f, 0x36, 0x65, 0xb0, 0x38,
+		0x4b, 0x90, 0x1a, 0xa4, 0x5c, 0x29, 0x89, 0x87, 0x49, 0x49, 0x01, 0xa5, 0x04, 0x65, 0x58, 0x64,
+		0x68, 0xb3, 0xbb, 0x42, 0x58, 0x96, 0x21, 0xed, 0x2a, 0xaf, 0x62, 0x62, 0x0a, 0x81, 0xf5, 0xd0,
+		0x70, 0x89, 0x74, 0xc7, 0xde, 0x65, 0x1e, 0x97, 0x38, 0xc5, 0x2d, 0x10, 0xdc, 0x0b, 0x88, 0x95,
+		0x78, 0xa4, 0x67, 0x96, 0xf5, 0xcb, 0x13, 0x2b, 0xfe, 0x50, 0xcf, 0xfd, 0x18, 0x7d, 0xb7, 0x6f,
+		0x1a, 0x4e, 0xbf, 0x47, 0x85, 0xe4, 0x79, 0x19, 0x58, 0xea, 0x5d, 0x9c, 0xe0, 0x92, 0xe6, 0x65,
+		0x90, 0x73, 0x30, 0xef, 0x26, 0x74, 0x8f, 0x0a, 0x97, 0x32, 0xe2, 0x16, 0xd5, 0x65, 0x89, 0xfa,
+		0x2b, 0x11, 0x48, 0x8a, 0x15, 0xcb, 0x1b, 0x86, 0xc8, 0xe8, 0x61, 0x88, 0x8e, 0x1b, 0x86, 0xd8,
+		0xbd, 0x0f, 0x43, 0x05, 0xc0, 0x6d, 0xa6, 0x2d, 0x9e, 0x62, 0x19, 0x61, 0x56, 0xf1, 0x26, 0xd6,
+		0x5b, 0xfb, 0x62, 0xde, 0xfb, 0x90, 0xd4, 0xdf, 0x8e, 0x40, 0xda, 0x2d, 0x27, 0x15, 0xc8, 0xc9,
+		0x76, 0xe9, 0x7b, 0x6d, 0x63, 0x5f, 0x88, 0xe2, 0x03, 0x63, 0x1b, 0x87, 0xab, 0xb0, 0x96, 0x11,
+		0xed, 0xc1, 0xc4, 0xe8, 0x61, 0x8d, 0x8e, 0x19, 0xd6, 0x80, 0x1c, 0xc5, 0xee, 0x4d, 0x8e, 0x02,
+		0x23, 0x1e, 0x1f, 0x18, 0x71, 0xf5, 0x77, 0x23, 0xe2, 0x95, 0xb7, 0x26, 0x6d, 0xfe, 0x45, 0x0e,
+		0xd5, 0xab, 0x42, 0xb6, 0x9a, 0xb4, 0xa9, 0x0f, 0x8d, 0xd9, 0x43, 0xc3, 0x14, 0x83, 0x6d, 0xf6,
+		0xc6, 0x8e, 0x48, 0x2a, 0x75, 0x6f, 0x0c, 0x3f, 0x1f, 0x85, 0xb9, 0x21, 0xf8, 0xbf, 0x7a, 0x63,
+		0x19, 0x9c, 0xbd, 0x89, 0x09, 0x67, 0x6f, 0x72, 0xec, 0xec, 0xfd, 0x5c, 0x94, 0x39, 0x00, 0xba,
+		0x96, 0x6d, 0xb4, 0xbf, 0x16, 0xba, 0xf7, 0x24, 0xa4, 0xbb, 0x56, 0x5b, 0xe7, 0x25, 0xfc, 0xba,
+		0x4e, 0xaa, 0x6b, 0xb5, 0xb5, 0x21, 0x31, 0x4b, 0xbc, 0x4d, 0x8a, 0x39, 0xf9, 0x36, 0x0c, 0xc2,
+		0xec, 0xe0, 0x84, 0xea, 0x41, 0x96, 0xb3, 0x42, 0x58, 0x4d, 0x4f, 0x22, 0x0f, 0xf0, 0x57, 0x31,
+		0x32, 0x6c, 0xe5, 0xf1, 0x66, 0x73, 0x48, 0x2d, 0x79, 0xe0, 0x62, 0x70, 0x23, 0xa3, 0x18, 0x1d,
+		0x87, 0xc1, 0xa5, 0x58, 0x13, 0x70, 0xea, 0xf7, 0x44, 0x00, 0xd6, 0x90, 0xb3, 0xac, 0xbf, 0x68,
+		0xef, 0xd8, 0xac, 0x09, 0x7a, 0xa0, 0xe6, 0x53, 0xe3, 0x06, 0x4d, 0xd4, 0x9f, 0xb5, 0xfd, 0xed,
+		0xae, 0x42, 0xce, 0x93, 0x6d, 0x9b, 0xca, 0xc6, 0x9c, 0x3a, 0x62, 0x57, 0x5a, 0xa7, 0x8e, 0x96,
+		0xbd, 0xe9, 0x4b, 0xa9, 0xff, 0x34, 0x02, 0x69, 0xd6, 0x26, 0x7c, 0x63, 0x24, 0x30, 0x86, 0x91,
+		0x7b, 0x1f, 0xc3, 0x07, 0x00, 0x38, 0x19, 0x8c, 0x7a, 0x11, 0x92, 0x95, 0x66, 0x39, 0x18, 0xcb,
+		0x42, 0x2e, 0xba, 0x0c, 0x8f, 0x1d, 0xcd, 0x70, 0xb9, 0x6b, 0x15, 0x6c, 0xbf, 0x0f, 0x66, 0xd9,
+		0x3b, 0xa4, 0xb7, 0x6d, 0xb1, 0x11, 0xc5, 0xa7, 0xac, 0xb6, 0x6f, 0xdb, 0xea, 0x6b, 0x30, 0xbb,
+		0x7d, 0x9b, 0xfb, 0x13, 0x4f, 0x42, 0xba, 0x67, 0x59, 0xc2, 0xfa, 0xe3, 0x56, 0x77, 0x0a, 0x33,
+		0x98, 0xb1, 0x23, 0x7d, 0x68, 0x51, 0xcf, 0x87, 0xe6, 0x39, 0x01, 0x63, 0x13, 0x39, 0x01, 0x71,
+		0xf7, 0x99, 0x0b, 0xcc, 0x24, 0xf2, 0x6e, 0xb8, 0xaf, 0xbe, 0x7a, 0x65, 0xa3, 0xb6, 0xa2, 0xaf,
+		0xd7, 0xaf, 0x0c, 0x5c, 0xc5, 0x2f, 0x15, 0xee, 0xdc, 0x5d, 0xcc, 0x88, 0x6d, 0xe7, 0x38, 0xe8,
+		0x2d, 0xad, 0x76, 0x7d, 0x73, 0xbb, 0xa6, 0x44, 0x38, 0xf4, 0x56, 0x8f, 0xde, 0xb4, 0x1c, 0xfe,
+		0xd0, 0xef, 0x93, 0x70, 0x62, 0x04, 0xb4, 0xbb, 0xf9, 0x9c, 0xbb, 0x73, 0x77, 0x31, 0xb7, 0xd5,
+		0xa3, 0x5c, 0xca, 0x18, 0xc6, 0x12, 0x14, 0x87, 0x31, 0x36, 0xb7, 0x36, 0xeb, 0x95, 0x35, 0x65,
+		0xb1, 0xa4, 0xdc, 0xb9, 0xbb, 0x98, 0x95, 0x2a, 0x03, 0xe1, 0xdf, 0xf9, 0xdd, 0xe7, 0xb7, 0xa4,
+		0xe0, 0x81, 0xc1, 0xc2, 0x73, 0x5d, 0xa3, 0x67, 0x74, 0xa6, 0xdd, 0x7e, 0x86, 0x04, 0x09, 0xa8,
+		0x3f, 0x13, 0x85, 0x82, 0xbb, 0xb9, 0xd9, 0x62, 0x35, 0x90, 0xa7, 0xfd, 0x1e, 0xbf, 0xcc, 0xd8,
+		0xb5, 0x83, 0x43, 0x4b, 0x87, 0x20, 0x1e, 0xf5, 0x08, 0x23, 0x59, 0x4c, 0xaa, 0xc5, 0x11, 0xeb,
+		0x9b, 0x80, 0x10, 0xa8, 0x2e, 0x06, 0x79, 0x01, 0xd2, 0xee, 0x14, 0x73, 0x9f, 0x56, 0x1b, 0x3f,
+		0x27, 0x05, 0xbe, 0x87, 0x83, 0xaf, 0x9d, 0xc9, 0x6d, 0x5c, 0x7c, 0xdc, 0xc6, 0xf0, 0x3a, 0x07,
+		0x10, 0xc8, 0x12, 0x9e, 0x3c, 0x09, 0x71, 0x63, 0xb7, 0xd1, 0x2a, 0x26, 0x86, 0x5d, 0x2e, 0x1c,
+		0xaf, 0xb2, 0x5c, 0x5d, 0x15, 0x48, 0x0c, 0x52, 0x5d, 0x15, 0xce, 0x14, 0xc1, 0x2f, 0xf6, 0x90,
+		0xcd, 0x6d, 0x9d, 0xef, 0x85, 0xb9, 0x75, 0x91, 0xea, 0x18, 0xb7, 0x97, 0x31, 0x8d, 0x13, 0x10,
+		0x0b, 0xf7, 0xc5, 0xe3, 0x06, 0x31, 0x2d, 0xd9, 0x31, 0x6e, 0x5f, 0x31, 0xec, 0x6b, 0xf1, 0x54,
+		0x4c, 0x89, 0xab, 0x3f, 0x86, 0x96, 0x4a, 0x80, 0x2b, 0xe4, 0x71, 0x20, 0x88, 0x81, 0x67, 0x70,
+		0x38, 0x75, 0x19, 0x7b, 0x25, 0xdd, 0x42, 0xc7, 0xb8, 0x5d, 0xd9, 0xa7, 0x1b, 0xfd, 0x0e, 0x6b,
+		0x80, 0x4d, 0xf0, 0xc0, 0x4e, 0x00, 0xcb, 0x91, 0x15, 0xec, 0x3f, 0x31, 0xfc, 0x98, 0xae, 0x00,
+		0xe0, 0x0b, 0xc0, 0xc7, 0x71, 0x01, 0xc8, 0x73, 0x7a, 0xb2, 0x24, 0xd8, 0x95, 0x58, 0xb0, 0x2b,
+		0xea, 0x0b, 0x50, 0x18, 0x18, 0x01, 0xa2, 0x42, 0x4e, 0x78, 0xac, 0xd8, 0x91, 0x3b, 0xdf, 0xe1,
+		0xa4, 0xb5, 0x0c, 0xf7, 0x4c, 0xb1, 0x10, 0x84, 0x72, 0xea, 0x17, 0xd0, 0x4d, 0x8a, 0x87, 0x39,
+		0x8f, 0x43, 0x2e, 0x30, 0x06, 0xd2, 0x8b, 0x1c, 0xf1, 0xbc, 0xc8, 0x1e, 0xf0, 0xab, 0x90, 0x45,
+		0x05, 0x44, 0x9b, 0x02, 0xf6, 0x11, 0x28, 0x70, 0x05, 0x39, 0xc8, 0x6b, 0x6e, 0xf0, 0xac, 0x4b,
+		0x86, 0xab, 0x90, 0xf3, 0xe0, 0x3c, 0xb6, 0x67, 0x24, 0xd4, 0x15, 0xc3, 0x56, 0x37, 0x01, 0xbc,
+		0x41, 0x25, 0x15, 0x78, 0x00, 0x95, 0x87, 0x3f, 0x44, 0x54, 0x3c, 0xaa, 0x15, 0xb0, 0x18, 0x4b,
+		0x08, 0xe4, 0x85, 0x81, 0xf2, 0xa7, 0xb5, 0xae, 0x32, 0x88, 0xe5, 0x97, 0x3e, 0xfb, 0xc6, 0xa9,
+		0xc8, 0x3b, 0xa3, 0x0b, 0xfe, 0xc3, 0x2b, 0x70, 0xd2, 0x57, 0x88, 0x02, 0x18, 0x70, 0x44, 0x15,
+		0xbc, 0xc2, 0x25, 0x2c, 0x0c, 0x73, 0x28, 0x1d, 0xe9, 0xff, 0x2d, 0x1d, 0xad, 0x76, 0xc2, 0xbd,
+		0x4d, 0xe1, 0xfe, 0xae, 0xd1, 0x4e, 0xf9, 0x7f, 0x92, 0x86, 0x59, 0x8d, 0x7e, 0xb0, 0x4f, 0x6d,
+		0x87, 0x9c, 0x87, 0x38, 0x6d, 0x1c, 0x58, 0xa3, 0x7c, 0xa0, 0xd8, 0xc1, 0x25, 0x01, 0x57, 0x6b,
+		0x1c, 0x58, 0x57, 0x67, 0x34, 0x06, 0x4b, 0x2e, 0x40, 0x62, 0xaf, 0xdd, 0x17, 0xee, 0xab, 0x01,
+		0x9d, 0xe5, 0x47, 0xba, 0x8c, 0x40, 0x57, 0x67, 0x34, 0x0e, 0x8d, 0x55, 0xb1, 0x57, 0xd0, 0x63,
+		0x47, 0x57, 0x85, 0x57, 0xa5, 0xb1, 0x2a, 0x84, 0x25, 0xcb, 0x00, 0x2d, 0xb3, 0xe5, 0xe8, 0xcc,
+		0xb5, 0x53, 0x4c, 0x0c, 0x2b, 0xab, 0x20, 0x66, 0xcb, 0x61, 0xce, 0x20, 0x3c, 0x3a, 0x68, 0xc9,
+		0x04, 0x36, 0xf7, 0x83, 0x7d, 0xda, 0x3b, 0x2c, 0x26, 0x8f, 0x6e, 0xee, 0x4b, 0x08, 0x84, 0xcd,
+		0x65, 0xd0, 0xa8, 0x64, 0xf9, 0xf3, 0x7c, 0xce, 0xed, 0x62, 0x6a, 0x58, 0xcd, 0xf9, 0x31, 0xd9,
+		0x1b, 0x7d, 0xdb, 0xb7, 0xf1, 0x3c, 0xa3, 0xc1, 0x7f, 0x92, 0xe7, 0x5c, 0x13, 0x2c, 0x33, 0x6c,
+		0xf5, 0x04, 0x70, 0xb9, 0xdb, 0x67, 0x46, 0x9a, 0x62, 0x64, 0x03, 0xf2, 0xed, 0x96, 0xed, 0xe8,
+		0xb6, 0x69, 0x74, 0xed, 0x03, 0xcb, 0xb1, 0x99, 0xff, 0x04, 0x5f, 0x0d, 0x1f, 0x43, 0x61, 0xad,
+		0x65, 0x3b, 0x75, 0x09, 0x7c, 0x75, 0x46, 0xcb, 0xb5, 0xfd, 0x19, 0x48, 0xcf, 0xda, 0xdb, 0xa3,
+		0x3d, 0x97, 0x60, 0x31, 0x77, 0x34, 0xbd, 0x4d, 0x84, 0x96, 0xf8, 0x48, 0xcf, 0xf2, 0x67, 0x90,
+		0xaf, 0x83, 0xf9, 0xb6, 0x65, 0x34, 0x5d, 0x72, 0x7a, 0xe3, 0xa0, 0x6f, 0xde, 0x60, 0x4e, 0x19,
+		0x7c, 0x28, 0x75, 0x5c, 0x23, 0x2d, 0xa3, 0x29, 0x49, 0x54, 0x11, 0xe1, 0xea, 0x8c, 0x36, 0xd7,
+		0x1e, 0xcc, 0x24, 0x1f, 0x80, 0x05, 0xa3, 0xdb, 0x6d, 0x1f, 0x0e, 0x52, 0x2f, 0x30, 0xea, 0x67,
+		0xc7, 0x51, 0xaf, 0x20, 0xce, 0x20, 0x79, 0x62, 0x0c, 0xe5, 0x92, 0x6d, 0x74, 0x27, 0x51, 0x76,
+		0xcb, 0xaa, 0x2b, 0xac, 0x0c, 0xf6, 0xa2, 0x15, 0x7e, 0x5e, 0x60, 0x0c, 0xed, 0x2d, 0x0e, 0x2f,
+		0x8d, 0x92, 0xab, 0x33, 0xe8, 0x79, 0x0a, 0x64, 0x71, 0xaa, 0x56, 0x83, 0xb2, 0x07, 0xf7, 0x04,
+		0xd5, 0xb9, 0x30, 0xaa, 0x0c, 0x3e, 0x48, 0x35, 0x90, 0x45, 0x6a, 0x90, 0xe1, 0x5b, 0x51, 0x3c,
+		0x98, 0xa1, 0xec, 0x1d, 0xac, 0xcc, 0x79, 0x75, 0xec, 0x0c, 0x65, 0xa0, 0xe8, 0xe5, 0xba, 0x3a,
+		0xa3, 0x01, 0x75, 0x53, 0xc4, 0x80, 0x63, 0xec, 0x49, 0xb0, 0x43, 0x3d, 0xa8, 0x78, 0x8b, 0xf3,
+		0x8c, 0xe0, 0xe3, 0xe3, 0x08, 0xb2, 0x57, 0xb1, 0x0f, 0xaf, 0xfb, 0xf5, 0xf0, 0xd5, 0x19, 0x6d,
+		0xfe, 0xe6, 0x70, 0x36, 0x8a, 0xd8, 0x5e, 0xcb, 0x34, 0xda, 0xf8, 0x32, 0x25, 0xb7, 0x66, 0x16,
+		0x8e, 0x16, 0xb1, 0xcb, 0x02, 0x9a, 0x2d, 0xac, 0x28, 0x62, 0x7b, 0xfe, 0x8c, 0xe5, 0x59, 0x11,
+		0x1f, 0xea, 0x3e, 0xee, 0x36, 0xab, 0xa4, 0xf8, 0x83, 0x6e, 0xd7, 0xe2, 0x29, 0x50, 0x32, 0xea,
+		0xa3, 0x90, 0xf1, 0x29, 0x26, 0x3c, 0x85, 0x12, 0xd1, 0x30, 0x32, 0xd2, 0x54, 0x24, 0xd5, 0x3c,
+		0x64, 0xfd, 0xca, 0x48, 0xfd, 0x58, 0x04, 0x32, 0x3e, 0x3d, 0x83, 0x98, 0x7e, 0x8f, 0x74, 0xda,
+		0xb3, 0x54, 0x1e, 0x92, 0x4b, 0x9b, 0x2c, 0xe7, 0xc7, 0xaf, 0x59, 0x96, 0x29, 0x56, 0x56, 0x7c,
+		0x50, 0xa2, 0x7b, 0xbe, 0xeb, 0x82, 0xc4, 0x18, 0x08, 0x74, 0xcf, 0x77, 0x25, 0xc0, 0x83, 0x90,
+		0xc5, 0x7e, 0xeb, 0x7e, 0x7b, 0x29, 0xad, 0x65, 0x30, 0x4f, 0x80, 0xa8, 0xff, 0x2a, 0x0a, 0xca,
+		0xa0, 0x02, 0x73, 0x5d, 0xd5, 0x91, 0xa9, 0x5d, 0xd5, 0x27, 0x06, 0x9d, 0xe4, 0x9e, 0x5f, 0x7c,
+		0x0d, 0x14, 0xcf, 0xbd, 0xcb, 0x97, 0x9a, 0xf1, 0xf6, 0xdf, 0x80, 0xa1, 0xaa, 0x15, 0x1a, 0xc1,
+		0x0c, 0x72, 0x39, 0x70, 0x58, 0x29, 0x3f, 0x13, 0x32, 0x38, 0xe0, 0xae, 0x11, 0xb3, 0xd3, 0x6d,
+		0x1a, 0x0e, 0x95, 0xfe, 0x31, 0x0f, 0x13, 0x6d, 0x0d, 0xf4, 0x26, 0xdb, 0x8e, 0xe1, 0x50, 0x61,
+		0x6b, 0x70, 0xcf, 0x43, 0xce, 0xe8, 0x76, 0xd9, 0x73, 0xec, 0xdc, 0xd6, 0x78, 0x18, 0xf2, 0xa8,
+		0xd3, 0x5b, 0x46, 0x5b, 0x9a, 0x0a, 0x49, 0x6e, 0x92, 0x88, 0x5c, 0x6e, 0x1d, 0xa8, 0x4d, 0xc8,
+		0xfa, 0xf5, 0xb9, 0xbb, 0xa7, 0x8a, 0xf8, 0xf6, 0x54, 0x44, 0xbc, 0x0f, 0xc2, 0xf9, 0x23, 0xdf,
+		0x54, 0x19, 0x7d, 0x68, 0xb0, 0xc0, 0xf6, 0x5f, 0x37, 0xb9, 0xb3, 0x24, 0xa5, 0xf1, 0x84, 0xaa,
+		0x41, 0x3e, 0xa8, 0xfb, 0x49, 0x1e, 0xa2, 0xce, 0x6d, 0x51, 0x4b, 0xd4, 0xb9, 0x8d, 0x96, 0xae,
+		0xfb, 0x46, 0x6f, 0x7e, 0xc4, 0x6a, 0x27, 0xf0, 0x3c, 0x5f, 0x87, 0x5a, 0x80, 0x9c, 0xa4, 0xc9,
+		0x37, 0xe5, 0xc7, 0x61, 0x61, 0x94, 0x8a, 0x57, 0x0f, 0x60, 0x61, 0x94, 0xaa, 0x26, 0x17, 0x20,
+		0xe5, 0xea, 0xf8, 0x11, 0xbb, 0x63, 0x56, 0xad, 0x04, 0xd6, 0x5c, 0xd0, 0x80, 0x3b, 0x3f, 0x1a,
+		0x70, 0xe7, 0xab, 0xdf, 0x08, 0xc5, 0x71, 0xfa, 0x7b, 0xc0, 0xc9, 0x17, 0x77, 0x19, 0x76, 0x1c,
+		0x92, 0xe2, 0x31, 0xcc, 0x28, 0x3b, 0xc0, 0x12, 0x29, 0x64, 0x24, 0xd7, 0xe5, 0x31, 0x96, 0xcd,
+		0x13, 0xaa, 0x0e, 0x27, 0xc6, 0xea, 0xf0, 0xf1, 0x47, 0x61, 0x9c, 0x90, 0x38, 0x0a, 0x6b, 0xc8,
+		0xe6, 0xd8, 0xac, 0xaf, 0x32, 0x90, 0x85, 0xa7, 0xd4, 0x8f, 0xc7, 0xe0, 0xf8, 0x68, 0x4d, 0x4e,
+		0x16, 0x21, 0x8b, 0x76, 0xab, 0x13, 0x34, 0x71, 0xa1, 0x63, 0xdc, 0xde, 0x16, 0xf6, 0xad, 0x38,
+		0x4a, 0x88, 0xba, 0x47, 0x09, 0x64, 0x07, 0xe6, 0xda, 0x56, 0xc3, 0x68, 0xeb, 0xbe, 0xa3, 0x1c,
+		0x31, 0x89, 0x1e, 0x1a, 0x62, 0x76, 0xd0, 0x65, 0x88, 0x0a, 0x47, 0xc8, 0x7f, 0x81, 0xd1, 0x58,
+		0x73, 0x4f, 0x7d, 0xc8, 0x0a, 0x64, 0x3a, 0x2d, 0x7b, 0x97, 0x1e, 0x18, 0x37, 0x5b, 0x56, 0x4f,
+		0xcc, 0xa6, 0x61, 0xa1, 0x59, 0xf7, 0x60, 0xe4, 0x09, 0x93, 0x0f, 0xcd, 0x37, 0x24, 0x89, 0x91,
+		0x07, 0x5f, 0xc9, 0xa9, 0xb5, 0xc9, 0xb8, 0x33, 0xa4, 0xd9, 0xb1, 0x67, 0x48, 0xa3, 0x0e, 0x6c,
+		0x52, 0xa3, 0x0f, 0x6c, 0x3e, 0xea, 0x1f, 0x9a, 0xe0, 0xda, 0x37, 0x74, 0x86, 0x43, 0xea, 0xb0,
+		0x20, 0xf0, 0x9b, 0x01, 0xde, 0x8f, 0x08, 0x75, 0xe0, 0xf3, 0x6b, 0x90, 0xe7, 0x44, 0xa2, 0x8f,
+		0x67, 0x7b, 0xec, 0xde, 0xd8, 0x2e, 0x0f, 0x51, 0xe3, 0xbe, 0x43, 0xd4, 0xff, 0xcb, 0x86, 0xe2,
+		0x23, 0x31, 0x98, 0x1b, 0x32, 0x24, 0x46, 0x9e, 0x0e, 0x8f, 0xf3, 0xc8, 0xca, 0x8e, 0xc5, 0xa6,
+		0xee, 0x98, 0x18, 0xeb, 0x78, 0xf8, 0x58, 0x27, 0xde, 0xc6, 0xb1, 0x4e, 0xde, 0xdb, 0x58, 0xbf,
+		0xa3, 0xa3, 0xf0, 0xc9, 0x08, 0x94, 0xc6, 0x5b, 0x5f, 0x23, 0x87, 0x63, 0xaa, 0x03, 0x84, 0x71,
+		0x6b, 0xdc, 0xc3, 0x90, 0x1f, 0xb0, 0x0d, 0xb9, 0x28, 0xe7, 0x02, 0xbb, 0x70, 0xf5, 0x9b, 0x62,
+		0xb0, 0x30, 0xca, 0x80, 0x1b, 0x31, 0x5b, 0x5f, 0x82, 0xf9, 0x26, 0x6d, 0xb4, 0x9a, 0xf7, 0x3a,
+		0x59, 0xe7, 0x04, 0xf6, 0xff, 0x9b, 0xab, 0xc3, 0x52, 0xf2, 0xdd, 0x00, 0x29, 0x8d, 0xda, 0x5d,
+		0xb4, 0xc7, 0xf0, 0x38, 0x82, 0xde, 0x6e, 0xd0, 0xae, 0xe3, 0x05, 0x55, 0x8c, 0xda, 0x22, 0x70,
+		0xe8, 0x9a, 0x84, 0xc4, 0x0d, 0xb2, 0x8b, 0x86, 0x27, 0x33, 0xcc, 0x07, 0x30, 0x7e, 0x3b, 0x2f,
+		0xd0, 0xfd, 0x4e, 0x80, 0x8b, 0xd2, 0x09, 0x10, 0x1b, 0xbb, 0xbf, 0xe5, 0x58, 0x03, 0x5e, 0x80,
+		0xa7, 0x85, 0x17, 0x20, 0x1e, 0x52, 0x59, 0xc0, 0x0d, 0x50, 0x0d, 0xb8, 0x01, 0x92, 0x21, 0xdd,
+		0x1c, 0xe3, 0x07, 0xb8, 0x28, 0xfd, 0x00, 0xb3, 0x21, 0x2d, 0x1e, 0x70, 0x04, 0xbc, 0xd7, 0xe7,
+		0x08, 0x48, 0x0f, 0x7b, 0x5b, 0x03, 0xa8, 0x23, 0x3c, 0x01, 0xcf, 0xbb, 0x9e, 0x80, 0xec, 0x58,
+		0x2f, 0x82, 0x40, 0x1e, 0x74, 0x05, 0x6c, 0x0e, 0xb9, 0x02, 0x72, 0xe2, 0x63, 0x58, 0xe3, 0x48,
+		0x84, 0xf8, 0x02, 0x36, 0x87, 0x7c, 0x01, 0xf9, 0x10, 0x82, 0x21, 0xce, 0x80, 0xaf, 0x1f, 0xed,
+		0x0c, 0x18, 0xbf, 0x5d, 0x17, 0xcd, 0x9c, 0xcc, 0x1b, 0xa0, 0x8f, 0xf1, 0x06, 0x28, 0x63, 0x77,
+		0xae, 0x9c, 0xfc, 0xc4, 0xee, 0x80, 0x9d, 0x11, 0xee, 0x00, 0xbe, 0x71, 0x3f, 0x33, 0x96, 0xf8,
+		0x04, 0xfe, 0x80, 0x9d, 0x11, 0xfe, 0x00, 0x12, 0x4a, 0x36, 0xd4, 0x21, 0x70, 0x39, 0xe8, 0x10,
+		0x98, 0x1f, 0x63, 0x75, 0x7a, 0xb3, 0x7d, 0x8c, 0x47, 0x60, 0x77, 0x9c, 0x47, 0x80, 0xef, 0xda,
+		0xdf, 0x3d, 0x96, 0xe2, 0x14, 0x2e, 0x81, 0xcd, 0x21, 0x97, 0xc0, 0xb1, 0x10, 0x49, 0x9b, 0xdc,
+		0x27, 0xc0, 0x9f, 0x7a, 0xe7, 0x8f, 0xbc, 0x83, 0x92, 0xb9, 0x16, 0x4f, 0x65, 0x94, 0xac, 0xfa,
+		0x18, 0xcc, 0x49, 0x52, 0xae, 0x9e, 0xc3, 0xbd, 0x02, 0xed, 0xf5, 0xac, 0x9e, 0xbc, 0xc7, 0xc0,
+		0x12, 0xea, 0x19, 0xc8, 0xba, 0xa0, 0x47, 0xfb, 0x0f, 0xd8, 0x9e, 0xcc, 0xa7, 0xc7, 0xd4, 0x5f,
+		0x88, 0x40, 0xd6, 0xaf, 0xa2, 0x02, 0xfb, 0xcb, 0xb4, 0xd8, 0x5f, 0xfa, 0xbc, 0x0a, 0xd1, 0xa0,
+		0x57, 0x01, 0x9f, 0x60, 0xee, 0x0e, 0x39, 0x0c, 0x8c, 0xae, 0xeb, 0x30, 0x90, 0x01, 0x54, 0xdc,
+		0xf7, 0x20, 0x96, 0x25, 0x7e, 0x9a, 0x58, 0x70, 0x83, 0xc9, 0xf8, 0x56, 0x97, 0x3c, 0x01, 0xf3,
+		0x3e, 0x58, 0x77, 0x0f, 0xc7, 0x77, 0xcf, 0x8a, 0x0b, 0x5d, 0x11, 0x9b, 0xb9, 0x7f, 0x16, 0x81,
+		0xb9, 0x21, 0x15, 0x39, 0xd2, 0x29, 0x10, 0x79, 0x9b, 0x9c, 0x02, 0xd1, 0x7b, 0x76, 0x0a, 0xf8,
+		0xf7, 0xa4, 0xb1, 0xe0, 0x9e, 0xf4, 0x7f, 0x44, 0x20, 0x17, 0xd0, 0xd4, 0x38, 0x04, 0x0d, 0xab,
+		0x49, 0xc5, 0x2e, 0x91, 0xfd, 0x46, 0x93, 0xa4, 0x6d, 0xed, 0x8b, 0xbd, 0x20, 0xfe, 0x44, 0x28,
+		0x77, 0xe1, 0x49, 0x8b, 0x75, 0xc5, 0xdd, 0x60, 0x26, 0xfc, 0xf7, 0x38, 0xc4, 0xe5, 0x86, 0xa4,
+		0x77, 0xb9, 0xc1, 0xbd, 0xb0, 0x3c, 0xeb, 0xbb, 0xb0, 0x4c, 0x9e, 0x83, 0x34, 0x73, 0xf6, 0xeb,
+		0x56, 0x97, 0x2f, 0xd6, 0x03, 0xa6, 0x4d, 0xf0, 0x62, 0x83, 0xcd, 0x42, 0xae, 0xd9, 0x2f, 0x9f,
+		0xc5, 0x91, 0x0e, 0x58, 0x1c, 0xf7, 0x43, 0x1a, 0x5b, 0xcf, 0xbf, 0xaf, 0x01, 0xe2, 0xb6, 0xbb,
+		0xcc, 0x50, 0x7f, 0x3c, 0x0a, 0x85, 0x81, 0x85, 0x66, 0x64, 0xdf, 0x47, 0x1d, 0x23, 0x4f, 0xc6,
+		0x8f, 0x53, 0x00, 0xfb, 0x86, 0xad, 0xdf, 0x32, 0x4c, 0x47, 0x7c, 0x46, 0x2f, 0xa6, 0xf9, 0x72,
+		0xf0, 0x22, 0x03, 0xa6, 0xfa, 0xb6, 0xf8, 0x90, 0x5e, 0x4c, 0x73, 0xd3, 0x78, 0x1b, 0x89, 0xde,
+		0x64, 0x2f, 0xc2, 0xf2, 0x77, 0x95, 0x8f, 0x0f, 0x6f, 0x87, 0xb1, 0x78, 0xb9, 0x88, 0x83, 0xfd,
+		0xe5, 0xd7, 0x4f, 0x2b, 0x1c, 0xfa, 0xdd, 0xee, 0x85, 0x31, 0x4d, 0xe0, 0x07, 0xb9, 0x90, 0x1a,
+		0xe0, 0x02, 0xf3, 0x03, 0x66, 0xe5, 0xf6, 0x1e, 0x79, 0xca, 0x63, 0xbc, 0xb5, 0x5c, 0x87, 0x76,
+		0xba, 0x96, 0xd5, 0xd6, 0xf9, 0x1c, 0xaf, 0x40, 0xde, 0xe5, 0x15, 0x5f, 0x4d, 0xd9, 0xb7, 0xaf,
+		0x1c, 0x74, 0x8d, 0x05, 0x8c, 0xe0, 0x2c, 0xcf, 0xe4, 0x73, 0xea, 0x5a, 0x3c, 0x15, 0x51, 0xf0,
+		0x91, 0xf0, 0xa8, 0x12, 0x53, 0xb7, 0xe0, 0xd8, 0xc8, 0x75, 0x95, 0x3c, 0x0b, 0x69, 0x6f, 0x49,
+		0xe6, 0x61, 0xfa, 0x47, 0x78, 0x5a, 0x3c, 0x58, 0xf5, 0x97, 0x23, 0x70, 0x6c, 0xe4, 0xca, 0x4a,
+		0x6a, 0x90, 0xe4, 0x31, 0x93, 0x22, 0x3e, 0xe6, 0x89, 0xc9, 0x56, 0xe4, 0x25, 0x1e, 0x50, 0xa9,
+		0x09, 0x64, 0xf5, 0x03, 0x90, 0xe4, 0x39, 0x24, 0x03, 0xb3, 0xde, 0xdb, 0xd3, 0x00, 0xc9, 0x4a,
+		0xb5, 0x5a, 0xdb, 0x92, 0x0f, 0xc9, 0x2e, 0x6f, 0x6a, 0xf8, 0x29, 0x3b, 0x80, 0xa4, 0x56, 0xbb,
+		0x56, 0xab, 0x6e, 0x2b, 0x31, 0xfc, 0xf8, 0x1b, 0xff, 0xad, 0x5f, 0xc6, 0x2f, 0x09, 0x6c, 0x2b,
+		0x71, 0x5f, 0x56, 0xbd, 0xb6, 0xb1, 0x52, 0xd3, 0x94, 0x84, 0xfa, 0x14, 0x9c, 0x90, 0xed, 0x18,
+		0xf6, 0x08, 0xb9, 0x8e, 0x99, 0x88, 0xcf, 0x31, 0x83, 0xaf, 0xd0, 0x97, 0xc6, 0x2f, 0xcc, 0xf8,
+		0xa1, 0x87, 0x40, 0xc7, 0xcf, 0x4f, 0xb1, 0xaa, 0x0f, 0xf4, 0x1e, 0xf7, 0x31, 0x3d, 0xba, 0x47,
+		0x9d, 0xc6, 0x01, 0x37, 0x14, 0xb8, 0x06, 0xca, 0x69, 0x39, 0x91, 0xcb, 0x90, 0x6c, 0x0e, 0xf6,
+		0x1a, 0x6d, 0x38, 0x3a, 0x17, 0x22, 0x5b, 0x7c, 0x5c, 0x39, 0xc7, 0x73, 0xeb, 0x3c, 0x53, 0xfd,
+		0xc6, 0xa9, 0x78, 0x99, 0x86, 0x84, 0x56, 0xc3, 0xcf, 0x05, 0xc4, 0xf0, 0x0b, 0x77, 0xec, 0xa7,
+		0x5e, 0xdf, 0xa8, 0x6c, 0xd5, 0xaf, 0x6e, 0x22, 0x2f, 0xe7, 0xa1, 0x20, 0x79, 0x29, 0x33, 0x13,
+		0xea, 0xe3, 0x70, 0xdf, 0x18, 0xab, 0x62, 0x44, 0x10, 0xeb, 0xa7, 0x23, 0x7e, 0xe8, 0xa0, 0x65,
+		0xb0, 0x09, 0x49, 0xdb, 0x31, 0x9c, 0xbe, 0x2d, 0x98, 0xf8, 0xec, 0xa4, 0x66, 0xc6, 0x92, 0xfc,
+		0x51, 0x67, 0xe8, 0x9a, 0x20, 0xa3, 0x5e, 0x80, 0x7c, 0xb0, 0x64, 0x3c, 0x0f, 0x3c, 0x21, 0x8a,
+		0xaa, 0x97, 0x80, 0x0c, 0x5b, 0x1f, 0x23, 0xb6, 0x97, 0x91, 0x51, 0xdb, 0xcb, 0x1f, 0x89, 0xc0,
+		0xc9, 0x23, 0x2c, 0x0d, 0xf2, 0xd2, 0x40, 0x27, 0x9f, 0x9f, 0xc6, 0x4e, 0xe1, 0x5f, 0x32, 0x3d,
+		0x1c, 0xe8, 0xe6, 0xd3, 0x90, 0xf5, 0xe7, 0x4f, 0xd6, 0xc9, 0x2f, 0x47, 0xe1, 0xd8, 0x48, 0xa3,
+		0xc5, 0xa7, 0x02, 0x23, 0x6f, 0x51, 0x05, 0xbe, 0x07, 0xc0, 0xb9, 0x2d, 0xa3, 0xa8, 0xc5, 0x3a,
+		0xfa, 0xc0, 0x08, 0xff, 0x22, 0x6d, 0x6c, 0xdf, 0x16, 0x93, 0x20, 0xed, 0x88, 0x5f, 0xe8, 0x3f,
+		0xf1, 0x39, 0x05, 0xfa, 0x6c, 0x8d, 0xb5, 0xdd, 0x4f, 0x2b, 0x4f, 0xb6, 0x18, 0x2b, 0x37, 0x83,
+		0xd9, 0x36, 0x79, 0x05, 0xee, 0x1b, 0x30, 0x14, 0x5c, 0xd2, 0xf1, 0x49, 0xed, 0x85, 0x63, 0x41,
+		0x7b, 0x41, 0x92, 0xf6, 0xaf, 0xf6, 0x89, 0xe0, 0x6a, 0xff, 0x0a, 0x80, 0xe7, 0x1c, 0xf0, 0xc2,
+		0xfe, 0x22, 0xfe, 0xb0, 0xbf, 0x0b, 0x90, 0x40, 0x49, 0x92, 0x7c, 0x1a, 0x56, 0xc5, 0x28, 0x09,
+		0x3e, 0xe7, 0x02, 0x87, 0x56, 0x5b, 0x40, 0x86, 0x1d, 0xb4, 0x63, 0xaa, 0x78, 0x6f, 0xb0, 0x8a,
+		0x07, 0xc7, 0xba, 0x7a, 0x47, 0x57, 0xf5, 0x21, 0x48, 0xb0, 0x91, 0x1f, 0x79, 0x97, 0xf2, 0x1b,
+		0x00, 0x0c, 0xc7, 0xe9, 0xb5, 0x76, 0xfb, 0x5e, 0x05, 0xa7, 0x47, 0x4b, 0x4e, 0x45, 0xc2, 0x2d,
+		0xdf, 0x2f, 0x44, 0x68, 0xc1, 0x43, 0xf5, 0x89, 0x91, 0x8f, 0xa0, 0xba, 0x01, 0xf9, 0x20, 0xee,
+		0xe8, 0xbb, 0xa1, 0xde, 0x83, 0x2c, 0x69, 0x69, 0xdf, 0xb8, 0xd6, 0x91, 0x78, 0x24, 0x89, 0x25,
+		0xd4, 0x0f, 0x47, 0x21, 0xeb, 0x17, 0xbc, 0xbf, 0x7e, 0x26, 0x88, 0xfa, 0xcd, 0x11, 0x48, 0xb9,
+		0xdd, 0x3f, 0x22, 0xd8, 0xd9, 0xbb, 0x23, 0xec, 0x1e, 0x5e, 0xf0, 0x63, 0xa2, 0x98, 0x7b, 0x4c,
+		0x74, 0xc9, 0x5d, 0xfe, 0xc6, 0x39, 0x44, 0xfc, 0xbc, 0x96, 0x01, 0x87, 0x62, 0xb5, 0xbf, 0x34,
+		0xd9, 0x55, 0x3c, 0x3c, 0xc2, 0xf2, 0x5d, 0xa3, 0xe3, 0x09, 0xb5, 0xe9, 0x8b, 0x30, 0xe2, 0x13,
+		0xd1, 0x7f, 0x67, 0x2f, 0x32, 0xf5, 0x9d, 0x3d, 0xb7, 0x96, 0xa8, 0xbf, 0x96, 0xef, 0x8a, 0x40,
+		0x4a, 0xce, 0x09, 0xf2, 0x3e, 0x7f, 0xe4, 0x99, 0x3c, 0xda, 0x1c, 0xab, 0x8f, 0x04, 0x7d, 0x0f,
+		0x65, 0x38, 0xe0, 0x3a, 0x36, 0x6d, 0xc0, 0xb5, 0xb0, 0xec, 0xfe, 0x14, 0x3f, 0xff, 0x32, 0x30,
+		0x63, 0xdf, 0x72, 0xeb, 0x86, 0x97, 0xb9, 0xd8, 0x88, 0x65, 0x6e, 0x5c, 0x14, 0x75, 0x7c, 0x5c,
+		0x14, 0xf5, 0x70, 0xaf, 0x13, 0xf7, 0xd8, 0xeb, 0x8f, 0x44, 0x21, 0xe3, 0x73, 0x8f, 0x92, 0x67,
+		0x02, 0x11, 0xd9, 0x8b, 0x47, 0xb9, 0x52, 0x7d, 0x21, 0xd9, 0x01, 0x36, 0x45, 0xa7, 0x67, 0xd3,
+		0xdb, 0x7f, 0x3b, 0x6b, 0xf4, 0x05, 0xd6, 0xc4, 0x98, 0x0b, 0xac, 0x7f, 0x33, 0x02, 0x29, 0xd7,
+		0xec, 0x9e, 0xf6, 0x10, 0xf3, 0x38, 0x24, 0x85, 0x65, 0xc9, 0x4f, 0x31, 0x45, 0x6a, 0xa4, 0x5b,
+		0xb9, 0x04, 0x29, 0xf9, 0xd5, 0x44, 0xb1, 0xaa, 0xb9, 0xe9, 0xb3, 0xcf, 0x43, 0xc6, 0x77, 0x00,
+		0x8c, 0xaa, 0x71, 0xa3, 0xf6, 0x7e, 0x65, 0xa6, 0x34, 0x7b, 0xe7, 0xee, 0x62, 0x6c, 0x83, 0xde,
+		0xc2, 0xd9, 0xac, 0xd5, 0xaa, 0x57, 0x6b, 0xd5, 0x17, 0x95, 0x48, 0x29, 0x73, 0xe7, 0xee, 0xe2,
+		0xac, 0x46, 0x99, 0x47, 0xf1, 0xec, 0x8b, 0x50, 0x18, 0x18, 0x98, 0xa0, 0xd9, 0x42, 0x20, 0xbf,
+		0xb2, 0xb3, 0xb5, 0xb6, 0x5a, 0xad, 0x6c, 0xd7, 0x74, 0x1e, 0xb7, 0x8b, 0x1f, 0xa5, 0x59, 0x5b,
+		0xbd, 0x72, 0x75, 0x5b, 0xaf, 0xae, 0xad, 0xd6, 0x36, 0xb6, 0xf5, 0xca, 0xf6, 0x76, 0xa5, 0xfa,
+		0xa2, 0x12, 0x3d, 0x7f, 0x37, 0x03, 0x71, 0x8c, 0xce, 0x23, 0x55, 0x88, 0x33, 0x57, 0xc8, 0x91,
+		0x11, 0x60, 0xa5, 0xa3, 0x7d, 0xc3, 0xe4, 0x32, 0x24, 0x98, 0x97, 0x84, 0x1c, 0x1d, 0x12, 0x56,
+		0x0a, 0x71, 0x16, 0x63, 0x63, 0xd8, 0x8c, 0x3c, 0x32, 0x46, 0xac, 0x74, 0xb4, 0xef, 0x98, 0xac,
+		0xc1, 0xac, 0xdc, 0x24, 0x87, 0x05, 0x6e, 0x95, 0x42, 0x1d, 0xba, 0xd8, 0x35, 0xee, 0x6c, 0x38,
+		0x3a, 0x7c, 0xac, 0x14, 0xe2, 0x55, 0xc6, 0x27, 0x34, 0xc4, 0x76, 0x34, 0x24, 0x22, 0xac, 0x14,
+		0xe6, 0x27, 0x26, 0x1a, 0xa4, 0x3d, 0x37, 0x4e, 0x78, 0x50, 0x5c, 0x69, 0x02, 0x87, 0x39, 0xf9,
+		0x00, 0xe4, 0x82, 0x5b, 0xdd, 0xc9, 0xa2, 0xce, 0x4a, 0x13, 0x7a, 0xa4, 0x91, 0x7e, 0x70, 0xdf,
+		0x3b, 0x59, 0x14, 0x5a, 0x69, 0x42, 0x07, 0x35, 0x79, 0x0d, 0xe6, 0x86, 0xf7, 0xa5, 0x93, 0x07,
+		0xa5, 0x95, 0xa6, 0x70, 0x59, 0x93, 0x0e, 0x90, 0x11, 0xfb, 0xd9, 0x29, 0x62, 0xd4, 0x4a, 0xd3,
+		0x78, 0xb0, 0x49, 0x13, 0x0a, 0x83, 0x9b, 0xc4, 0x49, 0x63, 0xd6, 0x4a, 0x13, 0x7b, 0xb3, 0x79,
+		0x2d, 0xc1, 0xcd, 0xe5, 0xa4, 0x31, 0x6c, 0xa5, 0x89, 0x9d, 0xdb, 0x64, 0x07, 0xc0, 0xb7, 0x3f,
+		0x9c, 0x20, 0xa6, 0xad, 0x34, 0x89, 0x9b, 0x9b, 0x74, 0x61, 0x7e, 0xd4, 0xc6, 0x71, 0x9a, 0x10,
+		0xb7, 0xd2, 0x54, 0xde, 0x6f, 0x94, 0xe7, 0xe0, 0x16, 0x70, 0xb2, 0x90, 0xb7, 0xd2, 0x84, 0x6e,
+		0xf0, 0xe5, 0xca, 0xd8, 0x38, 0xe7, 0x47, 0x8f, 0x8c, 0x73, 0xf6, 0x22, 0x97, 0xdd, 0xd8, 0xe6,
+		0x9f, 0x78, 0x16, 0xde, 0x25, 0x5e, 0xd0, 0xb1, 0x1d, 0xe3, 0x46, 0xcb, 0xdc, 0x77, 0x9f, 0x32,
+		0x12, 0x69, 0x11, 0xe4, 0x7c, 0x9c, 0x43, 0x2d, 0xc9, 0xdc, 0x90, 0x07, 0x8d, 0xc6, 0xbe, 0x0c,
+		0x19, 0x76, 0x1f, 0x22, 0x3c, 0x7c, 0xf9, 0x88, 0xc7, 0x92, 0x42, 0x9e, 0x64, 0x1a, 0xf1, 0x98,
+		0x52, 0xe9, 0xc8, 0x37, 0x06, 0x4a, 0x47, 0xc5, 0x7d, 0xab, 0xdf, 0x1b, 0x81, 0xfc, 0xd5, 0x96,
+		0xed, 0x58, 0xbd, 0x56, 0xc3, 0x68, 0xb3, 0xe5, 0xe5, 0xd2, 0xa4, 0xb7, 0xb2, 0x96, 0xd3, 0x68,
+		0xae, 0x88, 0xf7, 0x96, 0x38, 0x0a, 0x59, 0x81, 0xe4, 0x4d, 0xa3, 0xcd, 0xef, 0x44, 0xf9, 0x1f,
+		0x5b, 0x1b, 0xe4, 0xb9, 0xcf, 0x8e, 0xf2, 0x53, 0xe1, 0xb8, 0xe5, 0x68, 0x31, 0xa2, 0x7e, 0x6b,
+		0x04, 0x14, 0xaf, 0x65, 0x1a, 0x6d, 0x58, 0xbd, 0x26, 0xdb, 0x0a, 0x74, 0xbb, 0xbe, 0x58, 0x01,
+		0x99, 0x24, 0xcf, 0x08, 0x23, 0x2b, 0x1a, 0x6a, 0x64, 0xc5, 0x7d, 0x06, 0xd6, 0x88, 0x2b, 0xe7,
+		0xb1, 0x51, 0x57, 0xce, 0xd5, 0xef, 0x61, 0x37, 0x5c, 0x3a, 0x9d, 0x96, 0x8d, 0xb3, 0x43, 0x63,
+		0x9b, 0xfb, 0x6b, 0x10, 0xef, 0x19, 0x8e, 0xd8, 0xf2, 0x2e, 0x5f, 0x9c, 0xfa, 0xc9, 0x28, 0xde,
+		0x67, 0x46, 0x83, 0xbc, 0x04, 0x78, 0x43, 0x42, 0x67, 0xf4, 0xa2, 0x6f, 0x89, 0x1e, 0x5e, 0x14,
+		0xc1, 0xf6, 0x91, 0x0f, 0x00, 0xde, 0xf3, 0xc0, 0x93, 0x65, 0x73, 0x9f, 0x72, 0xca, 0xb1, 0xb7,
+		0x44, 0x39, 0xd7, 0x31, 0x6e, 0x57, 0x19, 0x35, 0xa4, 0x2f, 0x9e, 0xd6, 0xfa, 0xd5, 0x08, 0x80,
+		0xc7, 0x18, 0x62, 0xe0, 0xa1, 0x8b, 0x4c, 0xb1, 0x4a, 0xe5, 0xa1, 0xcb, 0xa3, 0xe3, 0x24, 0x61,
+		0x80, 0xad, 0xcb, 0x39, 0x6c, 0xde, 0x17, 0x5e, 0x3f, 0x1d, 0xe1, 0xb5, 0x16, 0x1a, 0x43, 0x6c,
+		0xcf, 0x70, 0xf7, 0x8c, 0x3e, 0xe1, 0x80, 0xe7, 0xa4, 0x55, 0xcd, 0x09, 0x02, 0xc7, 0xc6, 0x72,
+		0xd1, 0x87, 0xcf, 0x46, 0x20, 0xb3, 0xe2, 0x7b, 0x00, 0x12, 0x4f, 0xcd, 0x2c, 0xb3, 0x75, 0x83,
+		0xf6, 0xdc, 0x53, 0x33, 0x9e, 0x44, 0xcb, 0x97, 0x7f, 0x47, 0xd0, 0x39, 0x94, 0xef, 0x1d, 0xc9,
+		0x34, 0x62, 0xdd, 0xa2, 0xbb, 0x76, 0x4b, 0xf2, 0x59, 0x93, 0x49, 0x0c, 0x81, 0xb0, 0x69, 0xa3,
+		0x8f, 0xce, 0x7c, 0xfc, 0x84, 0xa8, 0x83, 0x5f, 0x1b, 0xe6, 0x9e, 0x81, 0x82, 0xcc, 0xaf, 0xf2,
+		0x6c, 0x24, 0xd2, 0xa4, 0x8e, 0xd1, 0x6a, 0xf3, 0x58, 0xd1, 0xb4, 0x26, 0x93, 0xa2, 0xa9, 0x77,
+		0x66, 0xfd, 0x3b, 0xe3, 0x2a, 0x28, 0x56, 0x97, 0xf6, 0x02, 0x21, 0x32, 0x5c, 0x1a, 0x8b, 0xbf,
+		0xf9, 0xf9, 0x27, 0x16, 0x04, 0xc3, 0x45, 0x78, 0x05, 0xff, 0xde, 0x81, 0x56, 0x90, 0x18, 0x22,
+		0x9b, 0xbc, 0x12, 0x38, 0x27, 0xeb, 0xef, 0x7a, 0x0f, 0xdb, 0x2c, 0x0c, 0x31, 0xb5, 0x62, 0x1e,
+		0x2e, 0x17, 0x7f, 0xc3, 0x23, 0xed, 0xed, 0x9c, 0xf1, 0x29, 0x19, 0xdf, 0xa1, 0x19, 0x23, 0x83,
+		0x9b, 0x8a, 0xd7, 0x8c, 0x56, 0x5b, 0x7e, 0x72, 0x55, 0x13, 0x29, 0x52, 0x76, 0x1d, 0x9e, 0x71,
+		0xb6, 0x43, 0x53, 0xc7, 0xc9, 0xc6, 0xb2, 0x65, 0x36, 0x83, 0x9e, 0x4d, 0x52, 0x85, 0xa4, 0x63,
+		0xdd, 0xa0, 0xa6, 0x60, 0xd0, 0xf2, 0xe3, 0x53, 0xbc, 0x0d, 0xa7, 0x09, 0x54, 0xf2, 0xf5, 0xa0,
+		0x34, 0x69, 0x9b, 0xee, 0x33, 0xce, 0xe1, 0x97, 0xe8, 0x29, 0x7f, 0x3f, 0xe0, 0x9e, 0x5e, 0x7e,
+		0x2b, 0xb8, 0xa4, 0xea, 0x8c, 0x12, 0xd9, 0x0a, 0x3e, 0x31, 0x3a, 0x2b, 0x8e, 0xb3, 0xc7, 0xf4,
+		0xd1, 0x27, 0x79, 0x7e, 0x5d, 0xe8, 0x27, 0x81, 0x12, 0xd4, 0x37, 0x77, 0x2d, 0x93, 0x7d, 0xa9,
+		0x50, 0xec, 0xeb, 0x52, 0xfc, 0xec, 0xd4, 0xcd, 0x17, 0x67, 0xa7, 0x5b, 0x90, 0xf7, 0x40, 0xd9,
+		0x0c, 0x49, 0x4f, 0x3b, 0x43, 0x72, 0x2e, 0x01, 0x04, 0xc1, 0x4f, 0x1a, 0x7a, 0x73, 0xb0, 0x08,
+		0x22, 0x46, 0x25, 0x74, 0x36, 0xfb, 0x3b, 0xe3, 0x23, 0x80, 0xd7, 0x2c, 0x3a, 0x2d, 0x53, 0xb7,
+		0x69, 0x7b, 0x4f, 0x17, 0x9c, 0x43, 0xba, 0x99, 0xe9, 0x47, 0x73, 0xae, 0xd3, 0x32, 0xeb, 0xb4,
+		0xbd, 0xb7, 0xe2, 0x52, 0x21, 0xef, 0x81, 0x93, 0x5e, 0xef, 0x2d, 0x53, 0x3f, 0xb0, 0xda, 0x4d,
+		0xbd, 0x47, 0xf7, 0xf4, 0x06, 0x7b, 0xdd, 0x2f, 0xcb, 0x78, 0x76, 0x9f, 0x0b, 0xb2, 0x69, 0x5e,
+		0xb5, 0xda, 0x4d, 0x8d, 0xee, 0x55, 0xb1, 0x18, 0x0f, 0xd2, 0x3c, 0xec, 0x56, 0x13, 0xa3, 0x52,
+		0x62, 0x18, 0x1a, 0xef, 0x66, 0xae, 0x36, 0xed, 0x72, 0xea, 0xa3, 0x9f, 0x3a, 0x3d, 0xf3, 0xa5,
+		0x4f, 0x9d, 0x9e, 0x51, 0x2f, 0xb3, 0x57, 0xae, 0xc4, 0x3c, 0xa2, 0x36, 0xb9, 0x08, 0x69, 0x43,
+		0x26, 0xf8, 0x1d, 0xb6, 0x23, 0xe6, 0xa1, 0x07, 0x8a, 0xd7, 0xf7, 0x92, 0x2b, 0xd7, 0xb7, 0x8c,
+		0x56, 0x8f, 0xd4, 0xf0, 0x5d, 0x53, 0x29, 0x98, 0x93, 0x4e, 0x69, 0x4f, 0x96, 0xe5, 0x9c, 0xde,
+		0x18, 0x17, 0x3c, 0x97, 0x5e, 0x7e, 0xf0, 0x37, 0x3f, 0xff, 0xc4, 0x03, 0x82, 0xcc, 0xf5, 0x81,
+		0x38, 0x3a, 0x49, 0x6f, 0x30, 0xbe, 0xce, 0xd7, 0xe7, 0x6b, 0x30, 0xcb, 0x9b, 0x6a, 0x93, 0x17,
+		0x20, 0xd1, 0xc5, 0x1f, 0xc5, 0x48, 0xe0, 0xa9, 0xd3, 0x61, 0x01, 0x67, 0xf0, 0x7e, 0x71, 0xe0,
+		0x78, 0xea, 0xb7, 0x46, 0x01, 0x56, 0xae, 0x5f, 0xdf, 0xee, 0xb5, 0xba, 0x6d, 0xea, 0xbc, 0x5d,
+		0x7d, 0xdf, 0x81, 0x63, 0x5e, 0xdf, 0xed, 0x5e, 0x63, 0xfa, 0xfe, 0xcf, 0x7b, 0x17, 0xb5, 0x7b,
+		0x8d, 0x91, 0x64, 0x9b, 0xb6, 0xe3, 0x92, 0x8d, 0x4d, 0x4f, 0x76, 0xc5, 0x76, 0x86, 0x39, 0xfb,
+		0x32, 0x64, 0x3c, 0x66, 0xd8, 0x64, 0x15, 0x52, 0x8e, 0xf8, 0x2d, 0x18, 0xac, 0x8e, 0x67, 0xb0,
+		0x44, 0xf3, 0x33, 0xd9, 0x45, 0x57, 0xff, 0x3c, 0x02, 0xe0, 0x9b, 0x23, 0x7f, 0x39, 0x65, 0x0c,
+		0x1d, 0x08, 0x42, 0x13, 0xc7, 0xee, 0xf9, 0x0d, 0x4e, 0x4e, 0xc0, 0xc7, 0xd4, 0x6f, 0x8f, 0xe2,
+		0xb7, 0x86, 0xc5, 0xec, 0xfd, 0xcb, 0xcf, 0x83, 0x1d, 0x98, 0xa5, 0xa6, 0xd3, 0x6b, 0xb9, 0xa7,
+		0x5a, 0x4f, 0x8e, 0x1b, 0xf3, 0x11, 0x9d, 0xaa, 0x99, 0x4e, 0xef, 0xd0, 0x2f, 0x01, 0x92, 0x96,
+		0x8f, 0x1f, 0x9f, 0x88, 0x41, 0x71, 0x1c, 0x2a, 0x5a, 0xc3, 0x8d, 0x1e, 0x65, 0x19, 0xc1, 0x4b,
+		0xab, 0x79, 0x99, 0x2d, 0xd6, 0x18, 0x0d, 0xd0, 0x2a, 0x43, 0xe1, 0x42, 0xd0, 0x7b, 0x33, 0xc3,
+		0xf2, 0x1e, 0x05, 0xb6, 0xca, 0x6c, 0x43, 0x41, 0xde, 0x82, 0xd9, 0x35, 0xda, 0x06, 0xde, 0x00,
+		0x8f, 0x4d, 0xbf, 0x24, 0xc8, 0x9b, 0x34, 0xcb, 0x9c, 0x04, 0xa9, 0xc1, 0xac, 0xa4, 0x16, 0x9f,
+		0x9e, 0x9a, 0xc4, 0xc5, 0xdb, 0x4e, 0xfe, 0x85, 0x81, 0x99, 0x1e, 0x71, 0x2d, 0xe3, 0xe6, 0xad,
+		0x36, 0xc3, 0x56, 0x9e, 0xe4, 0x91, 0x2b, 0x8f, 0xb0, 0xee, 0x7e, 0x90, 0x05, 0xab, 0x37, 0xff,
+		0xea, 0x0f, 0xcb, 0x16, 0x00, 0x9f, 0xaa, 0xa8, 0x49, 0x8b, 0xf1, 0x7b, 0x9d, 0xef, 0x69, 0x4e,
+		0x64, 0xc5, 0x76, 0xbe, 0x56, 0x23, 0xf4, 0xdb, 0x51, 0xc8, 0xfa, 0x47, 0xe8, 0xaf, 0xe5, 0xa2,
+		0x45, 0x36, 0x3c, 0x35, 0xc5, 0x2f, 0xf4, 0x3c, 0x36, 0x4e, 0x4d, 0x0d, 0x49, 0x73, 0x88, 0x7e,
+		0xfa, 0xe5, 0x38, 0x24, 0x45, 0xc4, 0xdd, 0xe6, 0x90, 0x21, 0x1b, 0x09, 0x7b, 0x92, 0x20, 0x27,
+		0x9f, 0x24, 0x18, 0x69, 0xc7, 0x3e, 0x0c, 0xf8, 0x50, 0x81, 0x1e, 0x08, 0xe3, 0xc3, 0xa3, 0x0e,
+		0xdc, 0xd7, 0xba, 0xbd, 0xb7, 0x31, 0x92, 0x11, 0xc1, 0x3c, 0x3d, 0x8c, 0x30, 0x78, 0x77, 0xaa,
+		0xc6, 0x73, 0xc8, 0x13, 0x40, 0x0e, 0x5c, 0xc7, 0x84, 0xee, 0x31, 0x02, 0xe1, 0xe6, 0xbc, 0x12,
+		0x09, 0x8e, 0x6f, 0xb2, 0x58, 0x66, 0x53, 0xe7, 0xcf, 0x4e, 0x8b, 0x47, 0xbd, 0x31, 0x67, 0x05,
+		0x33, 0xc8, 0x37, 0x45, 0xb8, 0x3d, 0x3c, 0xb0, 0x6d, 0x16, 0xdb, 0x91, 0xed, 0x09, 0x26, 0xc5,
+		0x9f, 0xbc, 0x7e, 0xba, 0x74, 0x68, 0x74, 0xda, 0x65, 0x75, 0x04, 0x1d, 0x75, 0xd4, 0x4e, 0x1e,
+		0x0d, 0xe7, 0xe0, 0xb6, 0x9b, 0xac, 0x82, 0x82, 0xef, 0x2f, 0xf4, 0xc4, 0x77, 0xbc, 0xf5, 0x3d,
+		0x4a, 0xc5, 0xc6, 0xe5, 0xc4, 0xd2, 0x88, 0x47, 0xc0, 0x97, 0xf0, 0x31, 0x6d, 0x71, 0x04, 0x96,
+		0xbf, 0x41, 0x0f, 0x35, 0x81, 0x77, 0x99, 0x52, 0x0c, 0x12, 0x46, 0x31, 0xa0, 0xfa, 0x5e, 0xdb,
+		0xba, 0xa5, 0xb7, 0x5b, 0x9d, 0x16, 0xdf, 0xac, 0x4c, 0xab, 0x33, 0x18, 0x91, 0xcb, 0x6d, 0xeb,
+		0xd6, 0x1a, 0x92, 0x28, 0xbf, 0x0b, 0x27, 0xe0, 0x9d, 0xdf, 0xff, 0xa9, 0xb3, 0x27, 0x7d, 0xef,
+		0x64, 0xdf, 0x76, 0x5d, 0x7e, 0x5c, 0x6a, 0xd0, 0x96, 0x26, 0xde, 0xba, 0xe6, 0xde, 0x26, 0x58,
+		0x07, 0xf0, 0xed, 0x35, 0x22, 0x47, 0xef, 0x61, 0x3c, 0xfc, 0xc0, 0x1e, 0xc6, 0x37, 0xeb, 0xdf,
+		0xe7, 0x2d, 0x2b, 0xd1, 0x30, 0x26, 0xf9, 0x05, 0x5e, 0x20, 0x31, 0x65, 0x32, 0xa3, 0xfe, 0xeb,
+		0x08, 0x06, 0x9d, 0x0d, 0x4c, 0x10, 0xb7, 0xc9, 0x0d, 0x20, 0x3d, 0x5f, 0x21, 0x13, 0x34, 0x79,
+		0x7c, 0x7d, 0x6f, 0xf3, 0x6d, 0xae, 0x37, 0x58, 0xfa, 0x36, 0xad, 0x8f, 0x42, 0x39, 0xfe, 0x7a,
+		0x04, 0xaf, 0xd1, 0x34, 0x87, 0xb9, 0x5f, 0x87, 0xac, 0xbf, 0x6a, 0xd1, 0x89, 0x77, 0x4d, 0xd2,
+		0x09, 0x7f, 0xfb, 0x03, 0x44, 0xc8, 0x75, 0x4f, 0x09, 0x71, 0x5f, 0xe3, 0x53, 0x13, 0x33, 0x45,
+		0x36, 0x6c, 0xa4, 0x32, 0xe2, 0x63, 0xf3, 0x07, 0x11, 0x88, 0x6f, 0x59, 0x56, 0x9b, 0x7c, 0x10,
+		0xe6, 0x4c, 0xcb, 0xd1, 0x71, 0xc2, 0xd2, 0xa6, 0x2e, 0x5c, 0x0f, 0x5c, 0xc1, 0xd7, 0x8e, 0xe4,
+		0xd5, 0x97, 0x5f, 0x3f, 0x3d, 0x8c, 0x39, 0xea, 0xad, 0xfa, 0x82, 0x69, 0x39, 0xcb, 0x0c, 0x68,
+		0x9b, 0xc1, 0x90, 0x3d, 0xc8, 0x05, 0xab, 0xe3, 0x8b, 0x40, 0x25, 0xac, 0xba, 0x5c, 0x68, 0x55,
+		0xd9, 0x5d, 0x5f, 0x3d, 0xfc, 0xe9, 0xe9, 0x3f, 0xc2, 0x91, 0x7b, 0x05, 0x94, 0xeb, 0x83, 0x61,
+		0x51, 0x35, 0x98, 0x95, 0x61, 0x50, 0x91, 0x09, 0x23, 0xac, 0xfc, 0xec, 0x14, 0xb8, 0xea, 0x17,
+		0xa2, 0x70, 0x02, 0xa3, 0xa5, 0x84, 0x8f, 0x48, 0xe8, 0x09, 0xee, 0xd9, 0x3d, 0x44, 0xc7, 0xc6,
+		0x48, 0x0f, 0x56, 0x76, 0xd8, 0x4f, 0x75, 0x1d, 0x0a, 0xb8, 0x60, 0xa3, 0x8f, 0xe9, 0xad, 0xb9,
+		0xa9, 0x72, 0x56, 0xbb, 0x29, 0x5a, 0x84, 0x4e, 0xaa, 0xeb, 0x50, 0x30, 0xe9, 0xad, 0x00, 0xdd,
+		0xd8, 0xbd, 0xd1, 0x35, 0xe9, 0x2d, 0x1f, 0x5d, 0xef, 0x04, 0x3e, 0x1e, 0x38, 0x81, 0xbf, 0x08,
+		0x31, 0x54, 0xae, 0x89, 0x29, 0xf4, 0x06, 0x22, 0xf8, 0x16, 0xc9, 0x3a, 0x9c, 0x10, 0x7e, 0x07,
+		0x7b, 0x73, 0x8f, 0x71, 0x94, 0xb2, 0x0e, 0xbd, 0x48, 0x0f, 0x47, 0x38, 0x21, 0xb2, 0x93, 0x39,
+		0x21, 0xbe, 0x3d, 0x0a, 0xca, 0x16, 0x65, 0xab, 0x65, 0x5d, 0x2a, 0x5e, 0x0c, 0xea, 0x11, 0x0f,
+		0x92, 0xc5, 0xb5, 0x68, 0xab, 0x39, 0xda, 0xda, 0x89, 0xbe, 0x3d, 0xdb, 0xa6, 0xd8, 0xbd, 0x6f,
+		0x9b, 0xbc, 0xef, 0x45, 0xc4, 0xdf, 0xe2, 0xf7, 0x22, 0x5c, 0x26, 0x9f, 0xfd, 0xb9, 0x08, 0x80,
+		0xe7, 0x7e, 0xc4, 0xd7, 0xc3, 0x96, 0x37, 0x37, 0x56, 0xf4, 0xfa, 0x76, 0x65, 0x7b, 0xa7, 0x1e,
+		0xfc, 0x32, 0x8f, 0x7c, 0x6b, 0xcc, 0xee, 0xd2, 0x46, 0x6b, 0xaf, 0x45, 0x9b, 0xe4, 0x11, 0x58,
+		0x08, 0x42, 0x63, 0xaa, 0xb6, 0xa2, 0x44, 0x4a, 0xd9, 0x3b, 0x77, 0x17, 0x53, 0x7c, 0x07, 0x46,
+		0xf1, 0xe5, 0xdc, 0x63, 0xc3, 0x70, 0xf8, 0x55, 0x9f, 0x68, 0x29, 0x77, 0xe7, 0xee, 0x62, 0xda,
+		0xdd, 0xaa, 0x11, 0x15, 0x88, 0x1f, 0x52, 0xd0, 0x8b, 0x95, 0xe0, 0xce, 0xdd, 0xc5, 0x24, 0xd7,
+		0x1e, 0xa5, 0x38, 0xbe, 0x28, 0x76, 0xf6, 0x1b, 0x00, 0x56, 0xcd, 0xbd, 0x9e, 0xd1, 0x60, 0x5a,
+		0xb2, 0x04, 0xc7, 0x57, 0x37, 0x2e, 0x6b, 0x95, 0xea, 0xf6, 0xea, 0xe6, 0x46, 0xb0, 0xd9, 0x03,
+		0x65, 0x2b, 0x9b, 0x3b, 0xcb, 0x6b, 0x35, 0x1d, 0xdf, 0x30, 0xe3, 0x81, 0x15, 0x81, 0xb2, 0xf7,
+		0x6f, 0x6c, 0xaf, 0xae, 0xd7, 0x94, 0xe8, 0xf2, 0xc5, 0xb1, 0x07, 0x77, 0xf7, 0x07, 0xb8, 0xed,
+		0xad, 0xcc, 0x81, 0xd3, 0xba, 0xff, 0x33, 0x00, 0x5e, 0x5f, 0x92, 0x7d, 0xc8, 0xa6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2534,6 +2592,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.StakeFlowLimit.Equal(that1.StakeFlowLimit) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3474,6 +3535,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.StakeFlowLimit.Size()
+		i -= size
+		if _, err := m.StakeFlowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3841,6 +3912,58 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingStakeFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingStakeFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingStakeFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4220,6 +4343,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.StakeFlowLimit.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4335,6 +4460,28 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *PendingStakeFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7182,6 +7329,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFlowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFlowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8045,6 +8226,173 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingStakeFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingStakeFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingStakeFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
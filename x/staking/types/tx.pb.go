@@ -247,6 +247,10 @@ var xxx_messageInfo_MsgDelegate proto.InternalMessageInfo
 
 // MsgDelegateResponse defines the Msg/Delegate response type.
 type MsgDelegateResponse struct {
+	// queued is true if the delegation was queued by the stake flow limit, to be executed in a later block.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// stake_flow_id is the ID of the queued delegation, set if queued is true.
+	StakeFlowId uint64 `protobuf:"varint,2,opt,name=stake_flow_id,json=stakeFlowId,proto3" json:"stake_flow_id,omitempty"`
 }
 
 func (m *MsgDelegateResponse) Reset()         { *m = MsgDelegateResponse{} }
@@ -282,6 +286,20 @@ func (m *MsgDelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateResponse proto.InternalMessageInfo
 
+func (m *MsgDelegateResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgDelegateResponse) GetStakeFlowId() uint64 {
+	if m != nil {
+		return m.StakeFlowId
+	}
+	return 0
+}
+
 // MsgBeginRedelegate defines a SDK message for performing a redelegation
 // of coins from a delegator and source validator to a destination validator.
 type MsgBeginRedelegate struct {
@@ -412,11 +430,17 @@ var xxx_messageInfo_MsgUndelegate proto.InternalMessageInfo
 
 // MsgUndelegateResponse defines the Msg/Undelegate response type.
 type MsgUndelegateResponse struct {
+	// completion_time is the time at which the undelegated tokens are released. It is zero if queued is true.
 	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 	// amount returns the amount of undelegated coins
 	//
 	// Since: cosmos-sdk 0.50
 	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// queued is true if the undelegation was queued by the stake flow limit. It only starts unbonding once executed, in a
+	// later block, and its completion time is reported in the unbond event emitted then.
+	Queued bool `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// stake_flow_id is the ID of the queued undelegation, set if queued is true.
+	StakeFlowId uint64 `protobuf:"varint,4,opt,name=stake_flow_id,json=stakeFlowId,proto3" json:"stake_flow_id,omitempty"`
 }
 
 func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
@@ -466,6 +490,20 @@ func (m *MsgUndelegateResponse) GetAmount() types1.Coin {
 	return types1.Coin{}
 }
 
+func (m *MsgUndelegateResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgUndelegateResponse) GetStakeFlowId() uint64 {
+	if m != nil {
+		return m.StakeFlowId
+	}
+	return 0
+}
+
 // MsgCancelUnbondingDelegation defines the SDK message for performing a cancel unbonding delegation for delegator
 //
 // Since: cosmos-sdk 0.46
@@ -752,83 +790,86 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x49, 0xfa, 0xcd, 0xe4, 0x9b, 0x5f, 0x9b, 0xa4, 0x75, 0x36, 0xa9, 0x5d, 0xb6,
-	0x81, 0x44, 0x41, 0xb6, 0xdb, 0x14, 0x15, 0x61, 0x2a, 0xd4, 0x38, 0x29, 0x50, 0xc0, 0x28, 0xda,
-	0x90, 0x22, 0x21, 0x24, 0x33, 0xde, 0x9d, 0x6c, 0x56, 0xf1, 0xce, 0x6c, 0x77, 0xc6, 0x69, 0x7d,
-	0x43, 0x9c, 0x80, 0x0b, 0x95, 0x38, 0x23, 0x95, 0x03, 0x12, 0xdc, 0x72, 0xc8, 0xbf, 0x80, 0x54,
-	0x71, 0xaa, 0x72, 0x42, 0x3d, 0x04, 0x94, 0x1c, 0xd2, 0xff, 0x80, 0x03, 0x17, 0xb4, 0xbb, 0xb3,
-	0xeb, 0xdd, 0xf5, 0xcf, 0x04, 0x7a, 0xe9, 0x25, 0xb1, 0xdf, 0x7c, 0xde, 0x67, 0xe6, 0xbd, 0xcf,
-	0x9b, 0x37, 0xcf, 0x20, 0xab, 0x12, 0x6a, 0x12, 0x5a, 0xa0, 0x0c, 0xee, 0x1a, 0x58, 0x2f, 0xec,
-	0x5d, 0xaf, 0x22, 0x06, 0xaf, 0x17, 0xd8, 0xc3, 0xbc, 0x65, 0x13, 0x46, 0xc4, 0x8b, 0x1e, 0x20,
-	0xcf, 0x01, 0x79, 0x0e, 0x90, 0x66, 0x75, 0x42, 0xf4, 0x1a, 0x2a, 0xb8, 0xa8, 0x6a, 0x7d, 0xbb,
-	0x00, 0x71, 0xc3, 0x73, 0x91, 0xb2, 0xf1, 0x25, 0x66, 0x98, 0x88, 0x32, 0x68, 0x5a, 0x1c, 0x30,
-	0xad, 0x13, 0x9d, 0xb8, 0x1f, 0x0b, 0xce, 0x27, 0x6e, 0x9d, 0xf5, 0x76, 0xaa, 0x78, 0x0b, 0x7c,
-	0x5b, 0x6f, 0x29, 0xc3, 0x4f, 0x59, 0x85, 0x14, 0x05, 0x47, 0x54, 0x89, 0x81, 0xf9, 0xfa, 0x42,
-	0x87, 0x28, 0xfc, 0x43, 0x7b, 0xa8, 0x4b, 0x1c, 0x65, 0x52, 0x07, 0xe1, 0xfc, 0xe3, 0x0b, 0x93,
-	0xd0, 0x34, 0x30, 0x29, 0xb8, 0x7f, 0x3d, 0x93, 0xfc, 0xf7, 0x00, 0x10, 0xcb, 0x54, 0x5f, 0xb3,
-	0x11, 0x64, 0xe8, 0x1e, 0xac, 0x19, 0x1a, 0x64, 0xc4, 0x16, 0x37, 0xc0, 0x88, 0x86, 0xa8, 0x6a,
-	0x1b, 0x16, 0x33, 0x08, 0x4e, 0x0b, 0x57, 0x84, 0xa5, 0x91, 0x95, 0xab, 0xf9, 0xf6, 0x39, 0xca,
-	0xaf, 0x37, 0xa1, 0xa5, 0xe1, 0x27, 0x47, 0xd9, 0xc4, 0xcf, 0xa7, 0xfb, 0xcb, 0x82, 0x12, 0xa6,
-	0x10, 0x15, 0x00, 0x54, 0x62, 0x9a, 0x06, 0xa5, 0x0e, 0x61, 0xd2, 0x25, 0x5c, 0xec, 0x44, 0xb8,
-	0x16, 0x20, 0x15, 0xc8, 0x10, 0x0d, 0x93, 0x86, 0x58, 0xc4, 0x2f, 0xc0, 0x94, 0x69, 0xe0, 0x0a,
-	0x45, 0xb5, 0xed, 0x8a, 0x86, 0x6a, 0x48, 0x87, 0xee, 0x69, 0x53, 0x57, 0x84, 0xa5, 0xe1, 0xd2,
-	0x35, 0xc7, 0xe7, 0xd9, 0x51, 0x76, 0xc6, 0xdb, 0x83, 0x6a, 0xbb, 0x79, 0x83, 0x14, 0x4c, 0xc8,
-	0x76, 0xf2, 0x77, 0x31, 0x3b, 0x3c, 0xc8, 0x01, 0xbe, 0xf9, 0x5d, 0xcc, 0x3c, 0xea, 0x49, 0xd3,
-	0xc0, 0x9b, 0xa8, 0xb6, 0xbd, 0x1e, 0x50, 0x89, 0xef, 0x81, 0x49, 0x4e, 0x4c, 0xec, 0x0a, 0xd4,
-	0x34, 0x1b, 0x51, 0x9a, 0x1e, 0x70, 0xf9, 0xa5, 0xc3, 0x83, 0xdc, 0x34, 0xa7, 0x58, 0xf5, 0x56,
-	0x36, 0x99, 0x6d, 0x60, 0x3d, 0x2d, 0x28, 0x13, 0x81, 0x13, 0x5f, 0x11, 0x3f, 0x06, 0x93, 0x7b,
-	0x7e, 0x76, 0x03, 0xa2, 0x41, 0x97, 0xe8, 0x95, 0xc3, 0x83, 0xdc, 0x65, 0x4e, 0x14, 0x28, 0x10,
-	0x61, 0x54, 0x26, 0xf6, 0x62, 0x76, 0xf1, 0x5d, 0x30, 0x64, 0xd5, 0xab, 0xbb, 0xa8, 0x91, 0x1e,
-	0x72, 0x53, 0x39, 0x9d, 0xf7, 0x8a, 0x31, 0xef, 0x17, 0x63, 0x7e, 0x15, 0x37, 0x4a, 0xe9, 0xdf,
-	0x9a, 0x67, 0x54, 0xed, 0x86, 0xc5, 0x48, 0x7e, 0xa3, 0x5e, 0xfd, 0x10, 0x35, 0x14, 0xee, 0x2d,
-	0x16, 0xc1, 0xe0, 0x1e, 0xac, 0xd5, 0x51, 0xfa, 0x82, 0x4b, 0x33, 0xeb, 0x2b, 0xe2, 0x54, 0x60,
-	0x48, 0x0e, 0x23, 0x22, 0xac, 0xe7, 0x52, 0xbc, 0xfd, 0xf5, 0xe3, 0x6c, 0xe2, 0xf9, 0xe3, 0x6c,
-	0xe2, 0xab, 0xd3, 0xfd, 0xe5, 0xd6, 0xf0, 0xbe, 0x3d, 0xdd, 0x5f, 0xe6, 0x71, 0xe5, 0xa8, 0xb6,
-	0x5b, 0x68, 0x2d, 0x33, 0x79, 0x1e, 0x48, 0xad, 0x56, 0x05, 0x51, 0x8b, 0x60, 0x8a, 0xe4, 0x9f,
-	0x52, 0x60, 0xa2, 0x4c, 0xf5, 0x3b, 0x9a, 0xc1, 0x5e, 0x64, 0x65, 0xb6, 0x95, 0x26, 0x79, 0x7e,
-	0x69, 0xee, 0x81, 0xf1, 0x66, 0x8d, 0x56, 0x6c, 0xc8, 0x10, 0xaf, 0xc8, 0xdc, 0xb3, 0xa3, 0xec,
-	0x5c, 0x6b, 0x35, 0x7e, 0x84, 0x74, 0xa8, 0x36, 0xd6, 0x91, 0x1a, 0xaa, 0xc9, 0x75, 0xa4, 0x2a,
-	0x63, 0x6a, 0xe4, 0x16, 0x88, 0x9f, 0xb6, 0xaf, 0x76, 0xaf, 0x1a, 0x17, 0xfb, 0xac, 0xf4, 0x36,
-	0x45, 0x5e, 0x7c, 0xa7, 0xb7, 0x8e, 0x73, 0x51, 0x1d, 0x23, 0x92, 0xc8, 0x12, 0x48, 0xc7, 0x6d,
-	0x81, 0x86, 0x3f, 0x24, 0xc1, 0x48, 0x99, 0xea, 0x7c, 0x37, 0x24, 0xde, 0x69, 0x77, 0xa1, 0x04,
-	0x37, 0x84, 0x74, 0xa7, 0x0b, 0xd5, 0xef, 0x75, 0xfa, 0x17, 0x9a, 0xdd, 0x02, 0x43, 0xd0, 0x24,
-	0x75, 0xcc, 0x5c, 0xa9, 0xfa, 0xbd, 0x07, 0xdc, 0xa7, 0xf8, 0x56, 0x24, 0x81, 0x2d, 0xf1, 0x39,
-	0x09, 0xbc, 0x18, 0x4d, 0xa0, 0x9f, 0x0f, 0x79, 0x06, 0x4c, 0x85, 0xbe, 0x06, 0x69, 0xfb, 0x26,
-	0xe5, 0xb6, 0xe5, 0x12, 0xd2, 0x0d, 0xac, 0x20, 0xed, 0x3f, 0xce, 0xde, 0x16, 0x98, 0x69, 0x66,
-	0x8f, 0xda, 0xea, 0xd9, 0x33, 0x38, 0x15, 0xf8, 0x6f, 0xda, 0x6a, 0x5b, 0x5a, 0x8d, 0xb2, 0x80,
-	0x36, 0x75, 0x76, 0xda, 0x75, 0xca, 0x5a, 0xb5, 0x19, 0x38, 0x87, 0x36, 0xb7, 0x7b, 0x6b, 0x13,
-	0x6b, 0x52, 0xb1, 0xa4, 0xcb, 0x96, 0xdb, 0xa4, 0x62, 0x56, 0x5f, 0x29, 0x51, 0x71, 0x6f, 0xbb,
-	0x55, 0x43, 0xce, 0x55, 0xaa, 0x38, 0x13, 0x00, 0xef, 0x49, 0x52, 0x4b, 0x47, 0xfe, 0xc4, 0x1f,
-	0x0f, 0x4a, 0xa3, 0xce, 0x39, 0x1f, 0xfd, 0x91, 0x15, 0xbc, 0xb3, 0x8e, 0x35, 0x19, 0x1c, 0x8c,
-	0xfc, 0x63, 0x12, 0x8c, 0x96, 0xa9, 0xbe, 0x85, 0xb5, 0x97, 0xfa, 0xda, 0xbc, 0xdd, 0x5b, 0x9a,
-	0x74, 0x54, 0x9a, 0x66, 0x46, 0xe4, 0x5f, 0x04, 0x30, 0x13, 0xb1, 0xbc, 0x48, 0x45, 0x42, 0x81,
-	0x26, 0xcf, 0x1e, 0xa8, 0xfc, 0x3c, 0x09, 0xe6, 0x9d, 0x77, 0x0e, 0x62, 0x15, 0xd5, 0xb6, 0x70,
-	0x95, 0x60, 0xcd, 0xc0, 0x7a, 0x68, 0xcc, 0x78, 0x19, 0xe5, 0x15, 0x17, 0xc1, 0xb8, 0xea, 0xbc,
-	0xec, 0x8e, 0x0a, 0x3b, 0xc8, 0xd0, 0x77, 0xbc, 0x0b, 0x9c, 0x52, 0xc6, 0x7c, 0xf3, 0xfb, 0xae,
-	0xb5, 0xf8, 0x41, 0xef, 0x3a, 0x58, 0x8c, 0xcd, 0x11, 0x9d, 0x32, 0x29, 0xbf, 0x06, 0x16, 0xba,
-	0xad, 0x07, 0x0d, 0xf6, 0x57, 0x01, 0x8c, 0x3b, 0xe5, 0x63, 0x69, 0x90, 0xa1, 0x0d, 0x68, 0x43,
-	0x93, 0x8a, 0x37, 0xc1, 0x30, 0xac, 0xb3, 0x1d, 0x62, 0x1b, 0xac, 0xd1, 0x33, 0xfb, 0x4d, 0xa8,
-	0xb8, 0x0a, 0x86, 0x2c, 0x97, 0x81, 0x17, 0x47, 0xa6, 0xd3, 0x34, 0xe2, 0xed, 0x13, 0xc9, 0x95,
-	0xe7, 0x58, 0x7c, 0xd3, 0x09, 0xbd, 0x49, 0xe9, 0x84, 0xbc, 0x10, 0x0a, 0xf9, 0x61, 0x30, 0xf1,
-	0xc7, 0xce, 0x2c, 0xcf, 0x82, 0x4b, 0x31, 0x53, 0x10, 0xe2, 0x5f, 0x82, 0xfb, 0xb6, 0x28, 0x84,
-	0x41, 0x86, 0xd6, 0x08, 0xa6, 0xde, 0xe8, 0xd7, 0xbe, 0x4a, 0x84, 0xf3, 0x57, 0x49, 0x19, 0x00,
-	0x8c, 0x1e, 0x54, 0xf8, 0x38, 0x9a, 0x3c, 0xd7, 0x38, 0x3a, 0x8c, 0xd1, 0x83, 0x0d, 0x97, 0xa0,
-	0xb8, 0xda, 0x7b, 0x1a, 0xc9, 0x44, 0xab, 0x21, 0x1e, 0xa1, 0x7c, 0x19, 0xcc, 0xb5, 0x31, 0xfb,
-	0x89, 0x59, 0xf9, 0xfe, 0x02, 0x48, 0x95, 0xa9, 0x2e, 0xde, 0x07, 0xe3, 0xf1, 0xdf, 0x3d, 0xcb,
-	0x9d, 0xa4, 0x6b, 0x1d, 0x53, 0xa5, 0x95, 0xfe, 0xb1, 0x41, 0x6f, 0xda, 0x05, 0xa3, 0xd1, 0x71,
-	0x76, 0xa9, 0x0b, 0x49, 0x04, 0x29, 0x5d, 0xeb, 0x17, 0x19, 0x6c, 0xf6, 0x39, 0xf8, 0x5f, 0x30,
-	0x77, 0x5d, 0xed, 0xe2, 0xed, 0x83, 0xa4, 0xd7, 0xfb, 0x00, 0x05, 0xec, 0xf7, 0xc1, 0x78, 0x7c,
-	0x3c, 0xe9, 0x96, 0xbd, 0x18, 0xb6, 0x6b, 0xf6, 0x3a, 0xbd, 0xb5, 0x55, 0x00, 0x42, 0x6f, 0xe2,
-	0xab, 0x5d, 0x18, 0x9a, 0x30, 0x29, 0xd7, 0x17, 0x2c, 0xd8, 0xe3, 0x3b, 0x01, 0xcc, 0x76, 0x6e,
-	0xd4, 0x6f, 0x74, 0xd3, 0xbc, 0x93, 0x97, 0x74, 0xeb, 0x3c, 0x5e, 0xc1, 0x89, 0x76, 0xc0, 0xff,
-	0x23, 0x6d, 0x6a, 0xb1, 0x5b, 0x40, 0x21, 0xa0, 0x54, 0xe8, 0x13, 0x18, 0xec, 0xc4, 0xc0, 0x44,
-	0x4b, 0xb7, 0xe8, 0x56, 0x13, 0x71, 0xb0, 0x74, 0xe3, 0x0c, 0x60, 0x7f, 0x57, 0x69, 0xf0, 0x4b,
-	0xa7, 0x15, 0x96, 0x6e, 0x3e, 0x39, 0xce, 0x08, 0x4f, 0x8f, 0x33, 0xc2, 0x9f, 0xc7, 0x19, 0xe1,
-	0xd1, 0x49, 0x26, 0xf1, 0xf4, 0x24, 0x93, 0xf8, 0xfd, 0x24, 0x93, 0xf8, 0x6c, 0x3e, 0xf2, 0xbb,
-	0xa6, 0xd9, 0x0b, 0x59, 0xc3, 0x42, 0xb4, 0x3a, 0xe4, 0xb6, 0x98, 0x1b, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0xdc, 0x49, 0x1f, 0xe2, 0x11, 0x00, 0x00,
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x89, 0xdb, 0x4c, 0xc8, 0xd7, 0xe6, 0xa3, 0xce, 0x26, 0xb5, 0xcb, 0x36, 0x90,
+	0x28, 0xc8, 0x76, 0x9b, 0xa2, 0x22, 0x4c, 0x85, 0x1a, 0x27, 0x2d, 0x04, 0x30, 0x0a, 0x1b, 0x52,
+	0x24, 0x84, 0x64, 0xc6, 0xbb, 0x93, 0xcd, 0x2a, 0xde, 0x19, 0x67, 0x67, 0x9c, 0xd4, 0x37, 0xc4,
+	0x09, 0xb8, 0x50, 0x89, 0x33, 0x52, 0x39, 0x20, 0x71, 0xcc, 0x21, 0xff, 0x02, 0x52, 0xc5, 0xa9,
+	0xca, 0x09, 0xf5, 0x10, 0x50, 0x22, 0x94, 0xfe, 0x07, 0x1c, 0xb8, 0xa0, 0xdd, 0x1d, 0xaf, 0x77,
+	0xd7, 0x9f, 0x09, 0xf4, 0xd2, 0x4b, 0xe2, 0x7d, 0xf3, 0x9b, 0xdf, 0x9b, 0xf7, 0xde, 0x6f, 0xde,
+	0xbe, 0x05, 0x29, 0x95, 0x50, 0x93, 0xd0, 0x2c, 0x65, 0x70, 0xc7, 0xc0, 0x7a, 0x76, 0xef, 0x66,
+	0x09, 0x31, 0x78, 0x33, 0xcb, 0x1e, 0x66, 0x2a, 0x16, 0x61, 0x44, 0x9c, 0x72, 0x01, 0x19, 0x0e,
+	0xc8, 0x70, 0x80, 0x34, 0xad, 0x13, 0xa2, 0x97, 0x51, 0xd6, 0x41, 0x95, 0xaa, 0x5b, 0x59, 0x88,
+	0x6b, 0xee, 0x16, 0x29, 0x15, 0x5e, 0x62, 0x86, 0x89, 0x28, 0x83, 0x66, 0x85, 0x03, 0x26, 0x74,
+	0xa2, 0x13, 0xe7, 0x67, 0xd6, 0xfe, 0xc5, 0xad, 0xd3, 0xae, 0xa7, 0xa2, 0xbb, 0xc0, 0xdd, 0xba,
+	0x4b, 0x49, 0x7e, 0xca, 0x12, 0xa4, 0xc8, 0x3b, 0xa2, 0x4a, 0x0c, 0xcc, 0xd7, 0xe7, 0xda, 0x44,
+	0x51, 0x3f, 0xb4, 0x8b, 0xba, 0xc2, 0x51, 0x26, 0xb5, 0x11, 0xf6, 0x3f, 0xbe, 0x30, 0x06, 0x4d,
+	0x03, 0x93, 0xac, 0xf3, 0xd7, 0x35, 0xc9, 0xff, 0xf4, 0x01, 0xb1, 0x40, 0xf5, 0x15, 0x0b, 0x41,
+	0x86, 0x1e, 0xc0, 0xb2, 0xa1, 0x41, 0x46, 0x2c, 0x71, 0x1d, 0x0c, 0x6a, 0x88, 0xaa, 0x96, 0x51,
+	0x61, 0x06, 0xc1, 0x09, 0xe1, 0x9a, 0xb0, 0x30, 0xb8, 0x74, 0x3d, 0xd3, 0x3a, 0x47, 0x99, 0xd5,
+	0x06, 0x34, 0x3f, 0xf0, 0xe4, 0x38, 0x15, 0xf9, 0xe5, 0xec, 0x60, 0x51, 0x50, 0xfc, 0x14, 0xa2,
+	0x02, 0x80, 0x4a, 0x4c, 0xd3, 0xa0, 0xd4, 0x26, 0x8c, 0x3a, 0x84, 0xf3, 0xed, 0x08, 0x57, 0x3c,
+	0xa4, 0x02, 0x19, 0xa2, 0x7e, 0x52, 0x1f, 0x8b, 0xf8, 0x25, 0x18, 0x37, 0x0d, 0x5c, 0xa4, 0xa8,
+	0xbc, 0x55, 0xd4, 0x50, 0x19, 0xe9, 0xd0, 0x39, 0x6d, 0xec, 0x9a, 0xb0, 0x30, 0x90, 0xbf, 0x61,
+	0xef, 0x79, 0x76, 0x9c, 0x9a, 0x74, 0x7d, 0x50, 0x6d, 0x27, 0x63, 0x90, 0xac, 0x09, 0xd9, 0x76,
+	0x66, 0x0d, 0xb3, 0xa3, 0xc3, 0x34, 0xe0, 0xce, 0xd7, 0x30, 0x73, 0xa9, 0xc7, 0x4c, 0x03, 0x6f,
+	0xa0, 0xf2, 0xd6, 0xaa, 0x47, 0x25, 0xbe, 0x07, 0xc6, 0x38, 0x31, 0xb1, 0x8a, 0x50, 0xd3, 0x2c,
+	0x44, 0x69, 0xa2, 0xcf, 0xe1, 0x97, 0x8e, 0x0e, 0xd3, 0x13, 0x9c, 0x62, 0xd9, 0x5d, 0xd9, 0x60,
+	0x96, 0x81, 0xf5, 0x84, 0xa0, 0x8c, 0x7a, 0x9b, 0xf8, 0x8a, 0xf8, 0x31, 0x18, 0xdb, 0xab, 0x67,
+	0xd7, 0x23, 0xea, 0x77, 0x88, 0x5e, 0x3d, 0x3a, 0x4c, 0x5f, 0xe5, 0x44, 0x5e, 0x05, 0x02, 0x8c,
+	0xca, 0xe8, 0x5e, 0xc8, 0x2e, 0xde, 0x07, 0xf1, 0x4a, 0xb5, 0xb4, 0x83, 0x6a, 0x89, 0xb8, 0x93,
+	0xca, 0x89, 0x8c, 0x2b, 0xc6, 0x4c, 0x5d, 0x8c, 0x99, 0x65, 0x5c, 0xcb, 0x27, 0x7e, 0x6b, 0x9c,
+	0x51, 0xb5, 0x6a, 0x15, 0x46, 0x32, 0xeb, 0xd5, 0xd2, 0x87, 0xa8, 0xa6, 0xf0, 0xdd, 0x62, 0x0e,
+	0xf4, 0xef, 0xc1, 0x72, 0x15, 0x25, 0x2e, 0x39, 0x34, 0xd3, 0xf5, 0x8a, 0xd8, 0x0a, 0xf4, 0x95,
+	0xc3, 0x08, 0x14, 0xd6, 0xdd, 0x92, 0xbb, 0xfb, 0xcd, 0xe3, 0x54, 0xe4, 0xf9, 0xe3, 0x54, 0xe4,
+	0xeb, 0xb3, 0x83, 0xc5, 0xe6, 0xf0, 0xbe, 0x3b, 0x3b, 0x58, 0xe4, 0x71, 0xa5, 0xa9, 0xb6, 0x93,
+	0x6d, 0x96, 0x99, 0x3c, 0x0b, 0xa4, 0x66, 0xab, 0x82, 0x68, 0x85, 0x60, 0x8a, 0xe4, 0x9f, 0x63,
+	0x60, 0xb4, 0x40, 0xf5, 0x7b, 0x9a, 0xc1, 0x5e, 0xa4, 0x32, 0x5b, 0x96, 0x26, 0x7a, 0xf1, 0xd2,
+	0x3c, 0x00, 0x23, 0x0d, 0x8d, 0x16, 0x2d, 0xc8, 0x10, 0x57, 0x64, 0xfa, 0xd9, 0x71, 0x6a, 0xa6,
+	0x59, 0x8d, 0x1f, 0x21, 0x1d, 0xaa, 0xb5, 0x55, 0xa4, 0xfa, 0x34, 0xb9, 0x8a, 0x54, 0x65, 0x58,
+	0x0d, 0xdc, 0x02, 0xf1, 0xb3, 0xd6, 0x6a, 0x77, 0xd5, 0x38, 0xdf, 0xa3, 0xd2, 0x5b, 0x88, 0x3c,
+	0xf7, 0x6e, 0xf7, 0x3a, 0xce, 0x04, 0xeb, 0x18, 0x28, 0x89, 0x2c, 0x81, 0x44, 0xd8, 0xe6, 0xd5,
+	0xf0, 0xc7, 0x28, 0x18, 0x2c, 0x50, 0x9d, 0x7b, 0x43, 0xe2, 0xbd, 0x56, 0x17, 0x4a, 0x70, 0x42,
+	0x48, 0xb4, 0xbb, 0x50, 0xbd, 0x5e, 0xa7, 0xff, 0x50, 0xb3, 0x3b, 0x20, 0x0e, 0x4d, 0x52, 0xc5,
+	0x2c, 0x11, 0x3b, 0xc7, 0x3d, 0xe0, 0x7b, 0x72, 0x6f, 0x07, 0x12, 0xd8, 0x14, 0x9f, 0x9d, 0xc0,
+	0xa9, 0x60, 0x02, 0xeb, 0xf9, 0x90, 0x3f, 0x01, 0xe3, 0xbe, 0xc7, 0x7a, 0xda, 0xc4, 0x29, 0x10,
+	0xdf, 0xad, 0xa2, 0x2a, 0xd2, 0x9c, 0xdc, 0x5c, 0x56, 0xf8, 0x93, 0x28, 0x83, 0x21, 0x5b, 0xe2,
+	0xa8, 0xb8, 0x55, 0x26, 0xfb, 0x45, 0x43, 0x73, 0x62, 0xee, 0x53, 0x06, 0x1d, 0xe3, 0xfd, 0x32,
+	0xd9, 0x5f, 0xd3, 0xe4, 0x6f, 0x63, 0x4e, 0x4b, 0xcf, 0x23, 0xdd, 0xc0, 0x0a, 0xd2, 0xfe, 0xe7,
+	0xcc, 0x6f, 0x82, 0xc9, 0x46, 0xe6, 0xa9, 0xa5, 0x9e, 0x3f, 0xfb, 0xe3, 0xde, 0xfe, 0x0d, 0x4b,
+	0x6d, 0x49, 0xab, 0x51, 0xe6, 0xd1, 0xc6, 0xce, 0x4f, 0xbb, 0x4a, 0x59, 0x73, 0x5d, 0xfb, 0x2e,
+	0x50, 0xd7, 0xbb, 0xdd, 0xeb, 0x1a, 0x6a, 0x70, 0xa1, 0xa4, 0xcb, 0x15, 0x20, 0x35, 0x5b, 0xbd,
+	0x2a, 0x2b, 0x4e, 0xa7, 0xa8, 0x94, 0x91, 0x7d, 0x0d, 0x8b, 0xf6, 0xf4, 0xc0, 0xfb, 0x99, 0xd4,
+	0xd4, 0xcd, 0x3f, 0xad, 0x8f, 0x16, 0xf9, 0x21, 0xfb, 0x9c, 0x8f, 0xfe, 0x48, 0x09, 0xee, 0x59,
+	0x87, 0x1b, 0x0c, 0x36, 0x46, 0xfe, 0x29, 0x0a, 0x86, 0x0a, 0x54, 0xdf, 0xc4, 0xda, 0x4b, 0x7d,
+	0xe5, 0xde, 0xe9, 0x5e, 0x9a, 0x44, 0xb0, 0x34, 0x8d, 0x8c, 0xc8, 0x7f, 0x09, 0x60, 0x32, 0x60,
+	0x79, 0x91, 0x15, 0xf1, 0x05, 0x1a, 0x3d, 0x7f, 0xa0, 0xbe, 0x4e, 0x10, 0xeb, 0xdc, 0x09, 0xfa,
+	0x9a, 0x3b, 0xc1, 0xf3, 0x28, 0x98, 0xb5, 0xdf, 0xaf, 0x10, 0xab, 0xa8, 0xbc, 0x89, 0x4b, 0x04,
+	0x6b, 0x06, 0xd6, 0x7d, 0xe3, 0xcd, 0xcb, 0x28, 0x0d, 0x71, 0x1e, 0x8c, 0xa8, 0x16, 0x72, 0x02,
+	0x2c, 0x6e, 0x23, 0x43, 0xdf, 0x76, 0x2f, 0x7f, 0x4c, 0x19, 0xae, 0x9b, 0xdf, 0x77, 0xac, 0xb9,
+	0x0f, 0xba, 0x6b, 0x68, 0x3e, 0x34, 0xbf, 0xb4, 0xcb, 0xa4, 0xfc, 0x3a, 0x98, 0xeb, 0xb4, 0xee,
+	0xbd, 0x0f, 0x7f, 0x15, 0xc0, 0x88, 0x2d, 0xbd, 0x8a, 0x06, 0x19, 0x5a, 0x87, 0x16, 0x34, 0xa9,
+	0x78, 0x1b, 0x0c, 0xc0, 0x2a, 0xdb, 0x26, 0x96, 0xc1, 0x6a, 0x5d, 0xb3, 0xdf, 0x80, 0x8a, 0xcb,
+	0x20, 0x5e, 0x71, 0x18, 0xb8, 0xb0, 0x92, 0xed, 0xa6, 0x20, 0xd7, 0x4f, 0x20, 0x57, 0xee, 0xc6,
+	0xdc, 0x5b, 0x76, 0xe8, 0x0d, 0x4a, 0x3b, 0xe4, 0x39, 0x5f, 0xc8, 0x0f, 0xbd, 0x2f, 0x8d, 0xd0,
+	0x99, 0xe5, 0x69, 0x70, 0x25, 0x64, 0xf2, 0x42, 0xfc, 0x5b, 0x70, 0xde, 0x69, 0x0a, 0x61, 0x90,
+	0xa1, 0x15, 0x82, 0xa9, 0x3b, 0x72, 0xb6, 0x56, 0x89, 0x70, 0x71, 0x95, 0x14, 0x00, 0xc0, 0x68,
+	0xbf, 0xc8, 0xc7, 0xe0, 0xe8, 0x85, 0xc6, 0xe0, 0x01, 0x8c, 0xf6, 0xd7, 0x1d, 0x82, 0xdc, 0x72,
+	0xf7, 0x29, 0x28, 0x19, 0x54, 0x43, 0x38, 0x42, 0xf9, 0x2a, 0x98, 0x69, 0x61, 0xae, 0x27, 0x66,
+	0xe9, 0x87, 0x4b, 0x20, 0x56, 0xa0, 0xba, 0xb8, 0x0b, 0x46, 0xc2, 0xdf, 0x5b, 0x8b, 0xed, 0x4a,
+	0xd7, 0x3c, 0x1e, 0x4b, 0x4b, 0xbd, 0x63, 0xbd, 0xbe, 0xb6, 0x03, 0x86, 0x82, 0x63, 0xf4, 0x42,
+	0x07, 0x92, 0x00, 0x52, 0xba, 0xd1, 0x2b, 0xd2, 0x73, 0xf6, 0x05, 0xb8, 0xec, 0xcd, 0x7b, 0xd7,
+	0x3b, 0xec, 0xae, 0x83, 0xa4, 0x37, 0x7a, 0x00, 0x79, 0xec, 0xbb, 0x60, 0x24, 0x3c, 0xda, 0x74,
+	0xca, 0x5e, 0x08, 0x2b, 0x2d, 0xf5, 0x8e, 0xf5, 0x5c, 0x96, 0x00, 0xf0, 0xbd, 0x4f, 0x5f, 0xeb,
+	0xc0, 0xd0, 0x80, 0x49, 0xe9, 0x9e, 0x60, 0x9e, 0x8f, 0xef, 0x05, 0x30, 0xdd, 0xbe, 0x51, 0xbf,
+	0xd9, 0xa9, 0xe6, 0xed, 0x76, 0x49, 0x77, 0x2e, 0xb2, 0xcb, 0x3b, 0xd1, 0x36, 0x78, 0x25, 0xd0,
+	0xa6, 0xe6, 0x3b, 0x05, 0xe4, 0x03, 0x4a, 0xd9, 0x1e, 0x81, 0x9e, 0x27, 0x06, 0x46, 0x9b, 0xba,
+	0x45, 0x27, 0x4d, 0x84, 0xc1, 0xd2, 0xad, 0x73, 0x80, 0xeb, 0x5e, 0xa5, 0xfe, 0xaf, 0xec, 0x56,
+	0x98, 0xbf, 0xfd, 0xe4, 0x24, 0x29, 0x3c, 0x3d, 0x49, 0x0a, 0x7f, 0x9e, 0x24, 0x85, 0x47, 0xa7,
+	0xc9, 0xc8, 0xd3, 0xd3, 0x64, 0xe4, 0xf7, 0xd3, 0x64, 0xe4, 0xf3, 0xd9, 0xc0, 0xf7, 0x54, 0xa3,
+	0x17, 0xb2, 0x5a, 0x05, 0xd1, 0x52, 0xdc, 0x69, 0x31, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0xc1, 0x7c, 0xbc, 0x81, 0x5a, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1465,6 +1506,21 @@ func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StakeFlowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakeFlowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1620,6 +1676,21 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StakeFlowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakeFlowId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1950,6 +2021,12 @@ func (m *MsgDelegateResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Queued {
+		n += 2
+	}
+	if m.StakeFlowId != 0 {
+		n += 1 + sovTx(uint64(m.StakeFlowId))
+	}
 	return n
 }
 
@@ -2016,6 +2093,12 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Queued {
+		n += 2
+	}
+	if m.StakeFlowId != 0 {
+		n += 1 + sovTx(uint64(m.StakeFlowId))
+	}
 	return n
 }
 
@@ -2852,6 +2935,45 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFlowId", wireType)
+			}
+			m.StakeFlowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeFlowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3377,6 +3499,45 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFlowId", wireType)
+			}
+			m.StakeFlowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeFlowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
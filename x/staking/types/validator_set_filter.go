@@ -0,0 +1,54 @@
+package types
+
+import (
+	context "context"
+)
+
+// ValidatorFilterResult is the outcome of filtering a validator of the bonded
+// validator set.
+type ValidatorFilterResult struct {
+	// Power is the consensus power of the validator sent to CometBFT. It must be
+	// positive and can't exceed the consensus power of the validator.
+	Power int64
+	// Exclude removes the validator from the bonded validator set, its place
+	// being taken by the next validator by power.
+	Exclude bool
+	// Jail excludes the validator from the bonded validator set and jails it,
+	// e.g. on evidence of misbehavior reported by another chain.
+	Jail bool
+}
+
+// ValidatorSetFilter filters and transforms the bonded validator set computed
+// by the staking module at end block, e.g. to cap the power of validators,
+// restrict the validator set to an allowlist or jail validators based on
+// external evidence, without replacing the staking module.
+type ValidatorSetFilter interface {
+	// FilterValidator is called for each validator about to be part of the
+	// bonded validator set, in decreasing order of power, with its consensus
+	// power.
+	FilterValidator(ctx context.Context, validator Validator, power int64) (ValidatorFilterResult, error)
+}
+
+var _ ValidatorSetFilter = MultiValidatorSetFilter{}
+
+// MultiValidatorSetFilter combines validator set filters, run in sequence: each
+// filter gets the power returned by the previous one, and a validator excluded
+// by a filter isn't passed to the next ones.
+type MultiValidatorSetFilter []ValidatorSetFilter
+
+func NewMultiValidatorSetFilter(filters ...ValidatorSetFilter) MultiValidatorSetFilter {
+	return filters
+}
+
+func (f MultiValidatorSetFilter) FilterValidator(ctx context.Context, validator Validator, power int64) (ValidatorFilterResult, error) {
+	result := ValidatorFilterResult{Power: power}
+	for i := range f {
+		var err error
+		result, err = f[i].FilterValidator(ctx, validator, result.Power)
+		if err != nil || result.Exclude || result.Jail {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
@@ -122,6 +122,9 @@ func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlo
 		// Returning an error will end up in a panic
 		return nil, errors.New(downgradeMsg)
 	}
+
+	k.LogUpgradeReadiness(ctx, plan)
+
 	return &sdk.ResponsePreBlock{
 		ConsensusParamsChanged: false,
 	}, nil
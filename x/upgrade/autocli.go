@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "UpgradeReadiness",
+					Use:       "readiness",
+					Short:     "Query how ready the node is for the scheduled upgrade",
+					Long:      "Gets the countdown to the scheduled upgrade, and whether the queried node runs the upgraded binary or has installed it for cosmovisor, if an upgrade is scheduled",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
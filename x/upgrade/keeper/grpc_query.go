@@ -77,3 +77,17 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(ctx context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	readiness, err := k.GetUpgradeReadiness(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QueryUpgradeReadinessResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &types.QueryUpgradeReadinessResponse{Readiness: readiness}, nil
+}
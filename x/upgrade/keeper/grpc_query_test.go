@@ -54,6 +54,22 @@ func (suite *UpgradeTestSuite) SetupTest() {
 	suite.queryClient = types.NewQueryClient(queryHelper)
 }
 
+func (suite *UpgradeTestSuite) TestQueryUpgradeReadiness() {
+	suite.T().Setenv("DAEMON_HOME", "")
+
+	res, err := suite.queryClient.UpgradeReadiness(context.Background(), &types.QueryUpgradeReadinessRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.Readiness)
+
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	res, err = suite.queryClient.UpgradeReadiness(context.Background(), &types.QueryUpgradeReadinessRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.UpgradeReadiness{Plan: plan, BlocksRemaining: 5}, res.Readiness)
+	suite.Require().False(res.Readiness.Ready())
+}
+
 func (suite *UpgradeTestSuite) TestQueryCurrentPlan() {
 	var (
 		req         *types.QueryCurrentPlanRequest
@@ -41,6 +41,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	readinessWindow    int64                           // number of blocks before the upgrade height from which its readiness is logged
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -58,6 +59,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeService corestore.KVStore
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionModifier:    vs,
 		authority:          authority,
+		readinessWindow:    DefaultReadinessWindow,
 	}
 
 	if upgradePlan, err := k.ReadUpgradeInfoFromDisk(); err == nil && upgradePlan.Height > 0 {
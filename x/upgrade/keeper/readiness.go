@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The environment variables and directory layout used by cosmovisor to locate
+// the binaries of upgrades.
+const (
+	envDaemonHome = "DAEMON_HOME"
+	envDaemonName = "DAEMON_NAME"
+)
+
+// UpgradeBinaryPath returns the path at which cosmovisor expects the binary of
+// the upgrade named name, or an empty string if the node doesn't run under
+// cosmovisor.
+func (k Keeper) UpgradeBinaryPath(name string) string {
+	home, daemon := os.Getenv(envDaemonHome), os.Getenv(envDaemonName)
+	if home == "" || daemon == "" {
+		return ""
+	}
+
+	return filepath.Join(home, "cosmovisor", "upgrades", url.PathEscape(name), "bin", daemon)
+}
+
+// GetUpgradeReadiness returns the countdown to the scheduled upgrade and
+// whether the node is ready for it. It returns ErrNoUpgradePlanFound if no
+// upgrade is scheduled.
+func (k Keeper) GetUpgradeReadiness(ctx context.Context) (*types.UpgradeReadiness, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.upgradeReadiness(ctx, plan), nil
+}
+
+func (k Keeper) upgradeReadiness(ctx context.Context, plan types.Plan) *types.UpgradeReadiness {
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	readiness := &types.UpgradeReadiness{
+		Plan:              plan,
+		BlocksRemaining:   max(plan.Height-height, 0),
+		HandlerRegistered: k.HasHandler(plan.Name),
+		BinaryPath:        k.UpgradeBinaryPath(plan.Name),
+		Skipped:           k.IsSkipHeight(plan.Height),
+	}
+
+	if readiness.BinaryPath != "" {
+		info, err := os.Stat(readiness.BinaryPath)
+		readiness.BinaryPresent = err == nil && !info.IsDir() && info.Mode()&0o111 != 0
+	}
+
+	return readiness
+}
+
+// CanPropose returns ErrUpgradeHeightReached if a block at height must not be
+// proposed by this binary, as it is at or past the height of the scheduled
+// upgrade, which the binary can't apply. Blocks built by an outdated binary
+// could be invalid once the upgrade is applied.
+func (k Keeper) CanPropose(ctx context.Context, height int64) error {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !plan.ShouldExecute(height) || k.HasHandler(plan.Name) || k.IsSkipHeight(plan.Height) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrUpgradeHeightReached, "upgrade %q is due at height %d", plan.Name, plan.Height)
+}
+
+// countdownInterval is the interval in blocks at which the countdown to the
+// scheduled upgrade is logged.
+const countdownInterval = 100
+
+// DefaultReadinessWindow is the default number of blocks before the upgrade
+// height from which the upgrade readiness is logged.
+const DefaultReadinessWindow = 1000
+
+// SetReadinessWindow sets the number of blocks before the upgrade height from
+// which LogUpgradeReadiness checks and logs the upgrade readiness. A window of
+// 0 disables it.
+func (k *Keeper) SetReadinessWindow(blocks int64) {
+	k.readinessWindow = blocks
+}
+
+// LogUpgradeReadiness logs the countdown to plan, reporting whether the
+// upgraded binary is installed, every countdownInterval blocks within the
+// readiness window and at each of the last countdownInterval blocks before the
+// upgrade. Outside of these blocks it doesn't touch the filesystem.
+func (k Keeper) LogUpgradeReadiness(ctx context.Context, plan types.Plan) {
+	remaining := plan.Height - sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	if remaining <= 0 || remaining > k.readinessWindow {
+		return
+	}
+
+	if remaining > countdownInterval && remaining%countdownInterval != 0 {
+		return
+	}
+
+	readiness := k.upgradeReadiness(ctx, plan)
+	logger := k.Logger(ctx).With("upgrade", plan.Name, "height", plan.Height, "blocks_remaining", readiness.BlocksRemaining)
+	if readiness.Ready() {
+		logger.Info("upgrade scheduled", "binary", readiness.BinaryPath)
+		return
+	}
+
+	if readiness.BinaryPath == "" {
+		logger.Info("upgrade scheduled, the upgraded binary must be installed before the upgrade height")
+		return
+	}
+
+	logger.Error("upgrade scheduled but the upgraded binary is not installed", "binary", readiness.BinaryPath)
+}
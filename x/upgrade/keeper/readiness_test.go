@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func (s *KeeperTestSuite) TestUpgradeReadiness() {
+	_, err := s.upgradeKeeper.GetUpgradeReadiness(s.ctx)
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+	s.Require().NoError(s.upgradeKeeper.CanPropose(s.ctx, 100))
+
+	plan := types.Plan{Name: "v2", Height: 50}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	// the node doesn't run under cosmovisor
+	s.T().Setenv("DAEMON_HOME", "")
+	readiness, err := s.upgradeKeeper.GetUpgradeReadiness(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(40), readiness.BlocksRemaining)
+	s.Require().Empty(readiness.BinaryPath)
+	s.Require().False(readiness.Ready())
+
+	// the upgraded binary is installed for cosmovisor
+	home := s.T().TempDir()
+	s.T().Setenv("DAEMON_HOME", home)
+	s.T().Setenv("DAEMON_NAME", "simd")
+	binary := filepath.Join(home, "cosmovisor", "upgrades", "v2", "bin", "simd")
+	s.Require().Equal(binary, s.upgradeKeeper.UpgradeBinaryPath("v2"))
+
+	readiness, err = s.upgradeKeeper.GetUpgradeReadiness(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(readiness.BinaryPresent)
+
+	s.Require().NoError(os.MkdirAll(filepath.Dir(binary), 0o755))
+	s.Require().NoError(os.WriteFile(binary, []byte("#!/bin/sh"), 0o755))
+	readiness, err = s.upgradeKeeper.GetUpgradeReadiness(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(readiness.BinaryPresent)
+	s.Require().True(readiness.Ready())
+
+	// the outdated binary refuses to propose from the upgrade height
+	s.Require().NoError(s.upgradeKeeper.CanPropose(s.ctx, 49))
+	s.Require().ErrorIs(s.upgradeKeeper.CanPropose(s.ctx, 50), types.ErrUpgradeHeightReached)
+	s.Require().ErrorIs(s.upgradeKeeper.CanPropose(s.ctx, 51), types.ErrUpgradeHeightReached)
+
+	// the upgraded binary proposes
+	s.upgradeKeeper.SetUpgradeHandler("v2", func(_ context.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	s.Require().NoError(s.upgradeKeeper.CanPropose(s.ctx, 50))
+}
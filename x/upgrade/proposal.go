@@ -0,0 +1,24 @@
+package upgrade
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/upgrade/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPrepareProposalHandler wraps next so that a binary which can't apply the
+// scheduled upgrade refuses to build proposals at or past the upgrade height:
+// it proposes no txs, leaving the block to the upgraded validators, rather than
+// a block built with outdated logic.
+func NewPrepareProposalHandler(k *keeper.Keeper, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if err := k.CanPropose(ctx, req.Height); err != nil {
+			k.Logger(ctx).Error("refusing to build proposal", "height", req.Height, "err", err)
+			return &abci.ResponsePrepareProposal{Txs: [][]byte{}}, nil
+		}
+
+		return next(ctx, req)
+	}
+}
@@ -16,4 +16,6 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrUpgradeHeightReached error if a block is proposed at or past the height of an upgrade the binary can't apply
+	ErrUpgradeHeightReached = errors.Register(ModuleName, 7, "upgrade height reached, binary must be upgraded")
 )
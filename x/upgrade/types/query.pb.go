@@ -492,6 +492,92 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryUpgradeReadinessRequest is the request type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessRequest struct {
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+// QueryUpgradeReadinessResponse is the response type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// readiness is the readiness of the node for the scheduled upgrade, nil if
+	// no upgrade is scheduled.
+	Readiness *UpgradeReadiness `protobuf:"bytes,1,opt,name=readiness,proto3" json:"readiness,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetReadiness() *UpgradeReadiness {
+	if m != nil {
+		return m.Readiness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +589,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
 }
 
 func init() {
@@ -510,47 +598,51 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x18, 0x65, 0x0a, 0x22, 0x7c, 0x35, 0x48, 0x26, 0xb1, 0xac, 0x6b, 0x5d, 0x71, 0x40, 0x2d, 0x41,
+	0x76, 0xa1, 0xa8, 0x31, 0x18, 0x8d, 0x4a, 0x62, 0xc4, 0x28, 0xd1, 0x1a, 0x3d, 0x78, 0x69, 0x06,
+	0x76, 0x52, 0x36, 0xb6, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x2e, 0x9e, 0x3c, 0x9a, 0x18, 0xaf,
+	0xde, 0x3c, 0xe8, 0xd1, 0xbf, 0xc2, 0x23, 0x89, 0x17, 0x0f, 0x1e, 0x0c, 0xf5, 0x0f, 0x31, 0x3b,
+	0x3b, 0x5b, 0xb7, 0x3f, 0xb6, 0x14, 0x6f, 0xdd, 0x99, 0xf7, 0xde, 0xf7, 0xbe, 0x99, 0xef, 0x4d,
+	0x81, 0x6c, 0x7a, 0xbc, 0xe1, 0x71, 0x2b, 0xf4, 0x6b, 0x01, 0xb5, 0x99, 0xb5, 0xb3, 0xb4, 0xc1,
+	0x04, 0x5d, 0xb2, 0xb6, 0x43, 0x16, 0xec, 0x9a, 0x7e, 0xe0, 0x09, 0x0f, 0x17, 0x62, 0x8c, 0xa9,
+	0x30, 0xa6, 0xc2, 0xe8, 0xc5, 0x9a, 0xe7, 0xd5, 0xea, 0xcc, 0xa2, 0xbe, 0x63, 0x51, 0xd7, 0xf5,
+	0x04, 0x15, 0x8e, 0xe7, 0xf2, 0x98, 0xa5, 0xcf, 0x66, 0x28, 0x27, 0x2a, 0x12, 0x45, 0xce, 0xc2,
+	0xd4, 0xb3, 0xa8, 0xd4, 0x6a, 0x18, 0x04, 0xcc, 0x15, 0x4f, 0xeb, 0xd4, 0xad, 0xb0, 0xed, 0x90,
+	0x71, 0x41, 0x1e, 0x83, 0xd6, 0xbd, 0xc5, 0x7d, 0xcf, 0xe5, 0x0c, 0x2f, 0xc2, 0x88, 0x5f, 0xa7,
+	0xae, 0x86, 0xa6, 0x51, 0x29, 0x5f, 0x2e, 0x9a, 0xbd, 0x1d, 0x9a, 0x92, 0x23, 0x91, 0x64, 0x41,
+	0x15, 0xba, 0xe7, 0xfb, 0x75, 0x87, 0xd9, 0xa9, 0x42, 0x18, 0xc3, 0x88, 0x4b, 0x1b, 0x4c, 0x8a,
+	0x8d, 0x57, 0xe4, 0x6f, 0x52, 0x06, 0xad, 0x1b, 0xae, 0x8a, 0x17, 0x60, 0x74, 0x8b, 0x39, 0xb5,
+	0x2d, 0x21, 0x19, 0xc3, 0x15, 0xf5, 0x45, 0xd6, 0x80, 0x48, 0xce, 0x8b, 0xd8, 0x85, 0xbd, 0x1a,
+	0xa1, 0x5d, 0x1e, 0xf2, 0xe7, 0x82, 0x0a, 0x96, 0x54, 0xbb, 0x00, 0xf9, 0x3a, 0xe5, 0xa2, 0xda,
+	0x26, 0x01, 0xd1, 0xd2, 0x43, 0xb9, 0xb2, 0x92, 0xd3, 0x10, 0x71, 0x60, 0xa6, 0xaf, 0x94, 0x72,
+	0x72, 0x13, 0x34, 0xd5, 0xb2, 0x5d, 0xdd, 0x4c, 0x20, 0x55, 0x1e, 0x61, 0xb4, 0xdc, 0x34, 0x2a,
+	0x9d, 0xaa, 0x14, 0xc2, 0x9e, 0x0a, 0x51, 0x91, 0x47, 0x23, 0x63, 0x68, 0x32, 0x47, 0x6e, 0x83,
+	0x2e, 0x4b, 0x3d, 0xf1, 0xec, 0xb0, 0xce, 0x5e, 0xb2, 0x80, 0x47, 0x97, 0x98, 0x72, 0xdb, 0x90,
+	0x1b, 0xd5, 0xd4, 0x11, 0x41, 0xbc, 0xb4, 0x1e, 0x1d, 0x54, 0x03, 0xce, 0xf5, 0xa4, 0x2b, 0x87,
+	0xeb, 0x70, 0x5a, 0xf1, 0x77, 0xd4, 0x96, 0x86, 0xa6, 0x87, 0x4b, 0xf9, 0xf2, 0xa5, 0xac, 0x3b,
+	0x6b, 0x13, 0xaa, 0x4c, 0x34, 0xda, 0x74, 0xc9, 0x14, 0x9c, 0x89, 0xef, 0x25, 0x14, 0x5b, 0x5e,
+	0xe0, 0x88, 0xdd, 0x64, 0x5a, 0xca, 0x50, 0xe8, 0xdc, 0x50, 0x16, 0x34, 0x38, 0x49, 0x6d, 0x3b,
+	0x60, 0x9c, 0x2b, 0xfb, 0xc9, 0x27, 0x31, 0xa0, 0x98, 0x3e, 0xe5, 0x0a, 0xa3, 0xb6, 0xe3, 0x32,
+	0x9e, 0x34, 0x4f, 0x6a, 0x70, 0x3e, 0x63, 0x5f, 0x49, 0x3f, 0x80, 0xf1, 0x20, 0x59, 0x54, 0xb3,
+	0x58, 0xca, 0xea, 0xab, 0x4b, 0xe4, 0x1f, 0xb5, 0xfc, 0x65, 0x0c, 0x4e, 0xc8, 0x4a, 0xf8, 0x13,
+	0x82, 0x7c, 0x6a, 0xe0, 0xb1, 0x95, 0x25, 0x97, 0x91, 0x1a, 0x7d, 0x71, 0x70, 0x42, 0xdc, 0x04,
+	0xb9, 0xfa, 0xf6, 0xc7, 0x9f, 0x0f, 0xb9, 0xcb, 0x78, 0xd6, 0xca, 0x48, 0xec, 0x66, 0x4c, 0xaa,
+	0x46, 0x39, 0xc2, 0x9f, 0x11, 0xe4, 0x53, 0xa1, 0x38, 0xc2, 0x60, 0x77, 0xda, 0xf4, 0xc5, 0xc1,
+	0x09, 0xca, 0xe0, 0xb2, 0x34, 0xb8, 0x80, 0xe7, 0xb3, 0x0c, 0xd2, 0x98, 0x24, 0x0d, 0x5a, 0x7b,
+	0xd1, 0xa0, 0xee, 0xe3, 0x5f, 0x08, 0x0a, 0xbd, 0xd3, 0x83, 0x57, 0xfa, 0x3a, 0xe8, 0x9b, 0x5e,
+	0xfd, 0xd6, 0x7f, 0x71, 0x55, 0x23, 0x6b, 0xb2, 0x91, 0xbb, 0xf8, 0x8e, 0xd5, 0xff, 0x6d, 0xec,
+	0x0a, 0xb3, 0xb5, 0x97, 0x7a, 0x32, 0xf6, 0xdf, 0xe5, 0x10, 0xfe, 0x8a, 0x60, 0xa2, 0x3d, 0x72,
+	0xb8, 0xdc, 0xd7, 0x5a, 0xcf, 0x78, 0xeb, 0xcb, 0xc7, 0xe2, 0xa8, 0x36, 0x2c, 0xd9, 0xc6, 0x1c,
+	0xbe, 0x92, 0xd5, 0x46, 0x47, 0xe2, 0xf1, 0x47, 0x04, 0xe3, 0xad, 0x5c, 0xe2, 0x85, 0xfe, 0x03,
+	0xd0, 0x11, 0x6c, 0xdd, 0x1c, 0x14, 0xae, 0xdc, 0xcd, 0x49, 0x77, 0x33, 0xf8, 0x62, 0xe6, 0xb4,
+	0xb4, 0x9c, 0x7c, 0x43, 0x30, 0xd9, 0x19, 0x4b, 0x7c, 0x6d, 0x90, 0x1b, 0xee, 0x7c, 0x2a, 0xf4,
+	0xeb, 0xc7, 0x64, 0x29, 0xb3, 0x4b, 0xd2, 0xec, 0x3c, 0x9e, 0x3b, 0x62, 0x22, 0xaa, 0xad, 0xb7,
+	0xe2, 0xfe, 0x8d, 0xef, 0x87, 0x06, 0x3a, 0x38, 0x34, 0xd0, 0xef, 0x43, 0x03, 0xbd, 0x6f, 0x1a,
+	0x43, 0x07, 0x4d, 0x63, 0xe8, 0x67, 0xd3, 0x18, 0x7a, 0x55, 0x8c, 0x35, 0xb8, 0xfd, 0xda, 0x74,
+	0x3c, 0xeb, 0x4d, 0x4b, 0x4b, 0xec, 0xfa, 0x8c, 0x6f, 0x8c, 0xca, 0x3f, 0xdc, 0xe5, 0xbf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x02, 0x16, 0x86, 0xfd, 0xf2, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +676,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness queries how ready the queried node is for the scheduled
+	// upgrade: the countdown to the upgrade height, and whether the node runs or
+	// has installed the upgraded binary.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -640,6 +736,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -661,6 +766,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness queries how ready the queried node is for the scheduled
+	// upgrade: the countdown to the upgrade height, and whether the node runs or
+	// has installed the upgraded binary.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -682,6 +791,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -777,6 +889,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -801,6 +931,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1100,6 +1234,64 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Readiness != nil {
+		{
+			size, err := m.Readiness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1233,6 +1425,28 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Readiness != nil {
+		l = m.Readiness.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1977,6 +2191,142 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Readiness == nil {
+				m.Readiness = &UpgradeReadiness{}
+			}
+			if err := m.Readiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
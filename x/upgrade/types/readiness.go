@@ -0,0 +1,7 @@
+package types
+
+// Ready returns whether the node will go through the upgrade: either it skips
+// it, it already runs the upgraded binary, or cosmovisor will switch to it.
+func (r UpgradeReadiness) Ready() bool {
+	return r.Skipped || r.HandlerRegistered || r.BinaryPresent
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// MovePrefix moves at most limit entries stored under fromPrefix in the from
+// store to toPrefix in the to store, keeping the remainder of each key, and
+// returns the number of entries moved and whether the source prefix is empty.
+// A limit of zero or less moves every entry at once.
+//
+// Moved entries are deleted from the source, so MovePrefix can be called again
+// in later blocks (e.g. from an EndBlocker) until it reports completion. This
+// lets modules rename or consolidate their keys without dumping and
+// reimporting state, spreading the work over several blocks when the prefix
+// holds too many entries for a single upgrade block. To rename a whole module
+// store, re-rooting it in the commitment tree, use StoreUpgrades.Renamed
+// instead.
+func MovePrefix(from corestore.KVStore, fromPrefix []byte, to corestore.KVStore, toPrefix []byte, limit int) (moved int, done bool, err error) {
+	if len(fromPrefix) == 0 {
+		return 0, false, errors.New("source prefix cannot be empty")
+	}
+
+	// moving a prefix inside itself would never terminate, as moved keys would
+	// be found again under the source prefix. Stores are not compared, so
+	// overlapping prefixes are rejected even across distinct stores.
+	if bytes.HasPrefix(toPrefix, fromPrefix) || bytes.HasPrefix(fromPrefix, toPrefix) {
+		return 0, false, fmt.Errorf("prefixes %X and %X overlap", fromPrefix, toPrefix)
+	}
+
+	type entry struct{ key, value []byte }
+	var batch []entry
+
+	// collect the batch first, as stores do not allow writes while iterating.
+	it, err := from.Iterator(fromPrefix, storetypes.PrefixEndBytes(fromPrefix))
+	if err != nil {
+		return 0, false, err
+	}
+	for ; it.Valid(); it.Next() {
+		if limit > 0 && len(batch) == limit {
+			break
+		}
+		batch = append(batch, entry{key: bytes.Clone(it.Key()), value: bytes.Clone(it.Value())})
+	}
+	// a valid iterator means entries remain after this batch
+	done = !it.Valid()
+	if err := it.Close(); err != nil {
+		return 0, false, err
+	}
+
+	for _, e := range batch {
+		newKey := make([]byte, 0, len(toPrefix)+len(e.key)-len(fromPrefix))
+		newKey = append(newKey, toPrefix...)
+		newKey = append(newKey, e.key[len(fromPrefix):]...)
+
+		if err := to.Set(newKey, e.value); err != nil {
+			return moved, false, err
+		}
+		if err := from.Delete(e.key); err != nil {
+			return moved, false, err
+		}
+		moved++
+	}
+
+	return moved, done, nil
+}
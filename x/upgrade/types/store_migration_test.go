@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestMovePrefix(t *testing.T) {
+	oldKey := storetypes.NewKVStoreKey("old")
+	newKey := storetypes.NewKVStoreKey("new")
+	testCtx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"old": oldKey, "new": newKey},
+		map[string]*storetypes.TransientStoreKey{},
+		map[string]*storetypes.MemoryStoreKey{},
+	)
+
+	from := runtime.NewKVStoreService(oldKey).OpenKVStore(testCtx)
+	to := runtime.NewKVStoreService(newKey).OpenKVStore(testCtx)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, from.Set([]byte(fmt.Sprintf("a/%d", i)), []byte{byte(i)}))
+	}
+	// keys outside of the prefix are left untouched
+	require.NoError(t, from.Set([]byte("b/0"), []byte{42}))
+
+	_, _, err := types.MovePrefix(from, []byte("a/"), from, []byte("a/b/"), 0)
+	require.ErrorContains(t, err, "overlap")
+
+	moved, done, err := types.MovePrefix(from, []byte("a/"), to, []byte("x/"), 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, moved)
+	require.False(t, done)
+
+	moved, done, err = types.MovePrefix(from, []byte("a/"), to, []byte("x/"), 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, moved)
+	require.False(t, done)
+
+	moved, done, err = types.MovePrefix(from, []byte("a/"), to, []byte("x/"), 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	require.True(t, done)
+
+	for i := 0; i < 5; i++ {
+		has, err := from.Has([]byte(fmt.Sprintf("a/%d", i)))
+		require.NoError(t, err)
+		require.False(t, has)
+
+		v, err := to.Get([]byte(fmt.Sprintf("x/%d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(i)}, v)
+	}
+
+	v, err := from.Get([]byte("b/0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{42}, v)
+}
@@ -225,11 +225,65 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// UpgradeReadiness reports how ready a node is for the scheduled upgrade.
+type UpgradeReadiness struct {
+	// plan is the scheduled upgrade plan.
+	Plan Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+	// blocks_remaining is the number of blocks before the upgrade height, 0 once
+	// it is reached.
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// handler_registered tells whether the running binary registers the upgrade
+	// handler of the plan, i.e. is the upgraded binary.
+	HandlerRegistered bool `protobuf:"varint,3,opt,name=handler_registered,json=handlerRegistered,proto3" json:"handler_registered,omitempty"`
+	// binary_path is the path at which cosmovisor expects the upgraded binary,
+	// empty if the node doesn't run under cosmovisor.
+	BinaryPath string `protobuf:"bytes,4,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+	// binary_present tells whether the upgraded binary is installed at
+	// binary_path.
+	BinaryPresent bool `protobuf:"varint,5,opt,name=binary_present,json=binaryPresent,proto3" json:"binary_present,omitempty"`
+	// skipped tells whether the node is configured to skip the upgrade.
+	Skipped bool `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *UpgradeReadiness) Reset()         { *m = UpgradeReadiness{} }
+func (m *UpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReadiness) ProtoMessage()    {}
+func (*UpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *UpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReadiness.Merge(m, src)
+}
+func (m *UpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReadiness proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*UpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.UpgradeReadiness")
 }
 
 func init() {
@@ -237,40 +291,47 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xc0, 0x82, 0x30, 0x0d, 0x02, 0x23, 0xe2, 0xd2, 0xe0, 0xb6, 0x69, 0x34, 0xa9, 0x24,
+	0xec, 0x06, 0xbc, 0xd5, 0x83, 0xb1, 0x3d, 0xaa, 0x09, 0x0e, 0xea, 0xc1, 0x4b, 0x33, 0xed, 0x3e,
+	0xb6, 0x93, 0x6e, 0x67, 0x36, 0x3b, 0x03, 0xda, 0xaf, 0xa0, 0x17, 0x3e, 0x82, 0x47, 0xe3, 0x89,
+	0x83, 0x1f, 0x82, 0x78, 0xe2, 0x68, 0x62, 0xe2, 0x1f, 0x38, 0xe0, 0xcd, 0xaf, 0x60, 0x76, 0x66,
+	0xb7, 0x69, 0x14, 0x8d, 0x31, 0x5e, 0x9a, 0x79, 0xbf, 0xf7, 0x7e, 0xf3, 0x7b, 0xef, 0x37, 0xaf,
+	0x8b, 0x6f, 0xf4, 0xa4, 0x1a, 0x4a, 0x15, 0xec, 0x27, 0x51, 0xca, 0x42, 0x08, 0x0e, 0xb6, 0xba,
+	0xa0, 0xd9, 0x56, 0x11, 0xfb, 0x49, 0x2a, 0xb5, 0x24, 0xab, 0xb6, 0xca, 0x2f, 0xd0, 0xbc, 0xaa,
+	0xb2, 0x16, 0x49, 0x19, 0xc5, 0x10, 0x98, 0xaa, 0xee, 0xfe, 0x5e, 0xc0, 0xc4, 0xc8, 0x52, 0x2a,
+	0x2b, 0x91, 0x8c, 0xa4, 0x39, 0x06, 0xd9, 0x29, 0x47, 0xab, 0x3f, 0x13, 0x34, 0x1f, 0x82, 0xd2,
+	0x6c, 0x98, 0xe4, 0x05, 0x6b, 0x56, 0xa9, 0x63, 0x99, 0xb9, 0xac, 0x4d, 0x2d, 0xb3, 0x21, 0x17,
+	0x32, 0x30, 0xbf, 0x16, 0xaa, 0x7f, 0x47, 0xd8, 0xd9, 0x89, 0x99, 0x20, 0x04, 0x3b, 0x82, 0x0d,
+	0xc1, 0x45, 0x35, 0xd4, 0x98, 0xa7, 0xe6, 0x4c, 0xee, 0x62, 0x27, 0xbb, 0xdd, 0x9d, 0xaa, 0xa1,
+	0x46, 0x79, 0xbb, 0xe2, 0x5b, 0x69, 0xbf, 0x90, 0xf6, 0x1f, 0x17, 0xd2, 0xad, 0xc5, 0xe3, 0x4f,
+	0xd5, 0xd2, 0xe1, 0xe7, 0x2a, 0x7a, 0x73, 0x7e, 0xb4, 0x81, 0x5c, 0x44, 0x0d, 0x91, 0xac, 0xe2,
+	0xd9, 0x3e, 0xf0, 0xa8, 0xaf, 0xdd, 0xe9, 0x1a, 0x6a, 0x4c, 0xd3, 0x3c, 0xca, 0xc4, 0xb8, 0xd8,
+	0x93, 0xae, 0x63, 0xc5, 0xb2, 0x33, 0x79, 0x80, 0xaf, 0xe6, 0xe6, 0x84, 0x9d, 0x5e, 0xcc, 0x41,
+	0xe8, 0x8e, 0xd2, 0x4c, 0x83, 0x3b, 0x63, 0xd4, 0x57, 0x7e, 0x51, 0xbf, 0x27, 0x46, 0xad, 0x29,
+	0x17, 0xd1, 0x2b, 0x05, 0xad, 0x6d, 0x58, 0xbb, 0x19, 0xa9, 0xe9, 0x7e, 0x7b, 0x5d, 0x45, 0x2f,
+	0xcf, 0x8f, 0x36, 0x16, 0xad, 0x03, 0x9b, 0x2a, 0x1c, 0x04, 0xd9, 0xa0, 0xf5, 0x8f, 0x08, 0x5f,
+	0xdb, 0x95, 0x7b, 0xfa, 0x39, 0x4b, 0xe1, 0x89, 0x65, 0xee, 0xa4, 0x32, 0x91, 0x8a, 0xc5, 0x64,
+	0x05, 0xcf, 0x68, 0xae, 0xe3, 0xc2, 0x05, 0x1b, 0x90, 0x1a, 0x2e, 0x87, 0xa0, 0x7a, 0x29, 0x4f,
+	0x34, 0x97, 0xc2, 0xb8, 0x31, 0x4f, 0x27, 0x21, 0x72, 0x07, 0x3b, 0x49, 0xcc, 0x84, 0x99, 0xb2,
+	0xbc, 0xbd, 0xee, 0x5f, 0xfc, 0xd8, 0x7e, 0xa6, 0xdf, 0x9a, 0xcf, 0xac, 0x32, 0x36, 0x51, 0x43,
+	0x6a, 0xde, 0xcf, 0x5a, 0x7d, 0xff, 0x6e, 0xb3, 0x92, 0xb3, 0x22, 0x79, 0x30, 0x66, 0xb4, 0xa5,
+	0xd0, 0x20, 0x74, 0x36, 0x48, 0x7d, 0x62, 0x90, 0xdf, 0xf4, 0xef, 0xa2, 0xfa, 0x5b, 0x84, 0xaf,
+	0xb7, 0x99, 0xe8, 0x41, 0xfc, 0x9f, 0x67, 0x6c, 0x3e, 0xfa, 0xbb, 0x36, 0x1b, 0x13, 0x6d, 0xfe,
+	0xb1, 0x11, 0x17, 0xd5, 0xdb, 0x78, 0xe1, 0xa1, 0x0c, 0xf7, 0x63, 0x78, 0x0a, 0xa9, 0xe2, 0xf2,
+	0xe2, 0x25, 0x74, 0xf1, 0xa5, 0x03, 0x9b, 0x36, 0x5d, 0x39, 0xb4, 0x08, 0x9b, 0x4e, 0xd6, 0x51,
+	0xfd, 0xd5, 0x14, 0x5e, 0xca, 0xaf, 0xa6, 0xc0, 0x42, 0x2e, 0x40, 0xa9, 0xf1, 0x83, 0xa0, 0x7f,
+	0x78, 0x10, 0x72, 0x0b, 0x2f, 0x75, 0x63, 0xd9, 0x1b, 0xa8, 0x4e, 0x0a, 0x43, 0xc6, 0x05, 0x17,
+	0x91, 0x91, 0x9e, 0xa6, 0x8b, 0x16, 0xa7, 0x05, 0x4c, 0x36, 0x31, 0xe9, 0x33, 0x11, 0xc6, 0x90,
+	0x76, 0x52, 0x88, 0xb8, 0xd2, 0x90, 0x42, 0x68, 0xd6, 0x60, 0x8e, 0x2e, 0xe7, 0x19, 0x3a, 0x4e,
+	0x90, 0x2a, 0x2e, 0x77, 0xb9, 0x60, 0xe9, 0xa8, 0x93, 0x30, 0xdd, 0xcf, 0xd7, 0x1f, 0x5b, 0x68,
+	0x87, 0xe9, 0x3e, 0xb9, 0x89, 0x2f, 0x17, 0x05, 0x29, 0x28, 0x10, 0xda, 0x6c, 0xff, 0x1c, 0x5d,
+	0xc8, 0x6b, 0x2c, 0x98, 0x79, 0xa2, 0x06, 0x3c, 0x49, 0x20, 0x74, 0x67, 0x4d, 0xbe, 0x08, 0x5b,
+	0xcd, 0xe3, 0xaf, 0x5e, 0xe9, 0xf8, 0xd4, 0x43, 0x27, 0xa7, 0x1e, 0xfa, 0x72, 0xea, 0xa1, 0xc3,
+	0x33, 0xaf, 0x74, 0x72, 0xe6, 0x95, 0x3e, 0x9c, 0x79, 0xa5, 0x67, 0xeb, 0xd6, 0x07, 0x15, 0x0e,
+	0x7c, 0x2e, 0x83, 0x17, 0xe3, 0x6f, 0x96, 0x1e, 0x25, 0xa0, 0xba, 0xb3, 0xe6, 0xaf, 0x75, 0xfb,
+	0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x35, 0x6f, 0x68, 0xd2, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -574,6 +635,81 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BinaryPresent {
+		i--
+		if m.BinaryPresent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BinaryPath) > 0 {
+		i -= len(m.BinaryPath)
+		copy(dAtA[i:], m.BinaryPath)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.BinaryPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HandlerRegistered {
+		i--
+		if m.HandlerRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -663,6 +799,33 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *UpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovUpgrade(uint64(m.BlocksRemaining))
+	}
+	if m.HandlerRegistered {
+		n += 2
+	}
+	l = len(m.BinaryPath)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.BinaryPresent {
+		n += 2
+	}
+	if m.Skipped {
+		n += 2
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1233,6 +1396,200 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandlerRegistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HandlerRegistered = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BinaryPresent = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0